@@ -0,0 +1,393 @@
+package algofft
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+)
+
+// AccuracyReport summarizes the numerical error of a plan, measured by the
+// Accuracy methods against the reference DFT on random inputs in [-1, 1).
+//
+// Errors are relative to the largest reference value of each trial, so they
+// are comparable across sizes and normalization conventions. ULP statistics
+// are taken per real and imaginary spectrum component in the plan's precision,
+// skipping components smaller than accuracyULPFloor times the largest one:
+// their ULP counts say little about the transform.
+type AccuracyReport struct {
+	// Trials is the number of random inputs measured.
+	Trials int
+
+	// Bins is the number of spectrum bins compared per trial: all of them up
+	// to verifyNaiveMaxSize bins, a fixed sample spread over the spectrum
+	// above.
+	Bins int
+
+	// MaxRelError is the largest forward error of any bin.
+	MaxRelError float64
+
+	// RMSRelError is the root-mean-square forward error over all bins and
+	// trials, relative to the root-mean-square reference value.
+	RMSRelError float64
+
+	// RoundTripError is the largest error of Inverse(Forward(x)) against x.
+	RoundTripError float64
+
+	// MaxULP and MeanULP are the largest and the mean forward error in units
+	// in the last place.
+	MaxULP  float64
+	MeanULP float64
+}
+
+const (
+	// accuracySampleBins is the number of bins compared per trial when the
+	// spectrum is too large for the full reference DFT.
+	accuracySampleBins = 64
+
+	// accuracyULPFloor excludes near-zero components from ULP statistics.
+	accuracyULPFloor = 1e-3
+)
+
+// accuracyRun runs one trial: it transforms input (real plans ignore the
+// imaginary parts) and returns the spectrum and the round-trip result.
+type accuracyRun func(input []complex128) (spectrum, roundTrip []complex128, err error)
+
+// accuracyTarget describes the transform an accuracyRun computes.
+type accuracyTarget struct {
+	dims         []int   // transform dimensions, row-major
+	real         bool    // real input; the last dimension keeps n/2+1 bins
+	single       bool    // float32 precision
+	forwardScale float64 // normalization of the forward transform
+	roundTrip    float64 // normalization of Inverse(Forward(x))
+}
+
+// measureAccuracy runs trials random inputs through run and compares them
+// against the reference DFT.
+func measureAccuracy(target accuracyTarget, trials int, run accuracyRun) (AccuracyReport, error) {
+	trials = max(trials, 1)
+
+	size := 1
+	specDims := append([]int(nil), target.dims...)
+
+	for _, n := range target.dims {
+		size *= n
+	}
+
+	if target.real {
+		specDims[len(specDims)-1] = specDims[len(specDims)-1]/2 + 1
+	}
+
+	bins := spectrumBins(specDims)
+	report := AccuracyReport{Trials: trials, Bins: len(bins)}
+	input := make([]complex128, size)
+	index := make([]int, len(specDims))
+	want := make([]complex128, len(bins))
+
+	var (
+		sumErr2, sumRef2, sumULP float64
+		countULP                 int
+	)
+
+	for trial := range trials {
+		rng := rand.New(rand.NewPCG(verifySeed, uint64(trial))) //nolint:gosec
+
+		for i := range input {
+			re, im := rng.Float64()*2-1, 0.0
+			if !target.real {
+				im = rng.Float64()*2 - 1
+			}
+
+			if target.single {
+				re, im = float64(float32(re)), float64(float32(im))
+			}
+
+			input[i] = complex(re, im)
+		}
+
+		spectrum, roundTrip, err := run(input)
+		if err != nil {
+			return AccuracyReport{}, err
+		}
+
+		var maxErr, maxRef float64
+
+		for i, bin := range bins {
+			unravelIndex(index, specDims, bin)
+			want[i] = dftBin(input, target.dims, index, false) * complex(target.forwardScale, 0)
+
+			diff := cmplx.Abs(spectrum[bin] - want[i])
+			ref := cmplx.Abs(want[i])
+			sumErr2 += diff * diff
+			sumRef2 += ref * ref
+			maxErr = max(maxErr, diff)
+			maxRef = max(maxRef, ref)
+		}
+
+		if maxRef > 0 {
+			report.MaxRelError = max(report.MaxRelError, maxErr/maxRef)
+		}
+
+		for i, bin := range bins {
+			for _, pair := range [2][2]float64{
+				{real(spectrum[bin]), real(want[i])},
+				{imag(spectrum[bin]), imag(want[i])},
+			} {
+				if math.Abs(pair[1]) < accuracyULPFloor*maxRef {
+					continue
+				}
+
+				ulps := math.Abs(pair[0]-pair[1]) / ulp(pair[1], target.single)
+				report.MaxULP = max(report.MaxULP, ulps)
+				sumULP += ulps
+				countULP++
+			}
+		}
+
+		var rtErr, rtRef float64
+
+		for i, v := range input {
+			expected := v * complex(target.roundTrip, 0)
+			rtErr = max(rtErr, cmplx.Abs(roundTrip[i]-expected))
+			rtRef = max(rtRef, cmplx.Abs(expected))
+		}
+
+		if rtRef > 0 {
+			report.RoundTripError = max(report.RoundTripError, rtErr/rtRef)
+		}
+	}
+
+	if sumRef2 > 0 {
+		report.RMSRelError = math.Sqrt(sumErr2 / sumRef2)
+	}
+
+	if countULP > 0 {
+		report.MeanULP = sumULP / float64(countULP)
+	}
+
+	return report, nil
+}
+
+// spectrumBins returns the flat spectrum indices to compare: every bin of
+// small spectra, accuracySampleBins spread over larger ones.
+func spectrumBins(specDims []int) []int {
+	size := 1
+	for _, n := range specDims {
+		size *= n
+	}
+
+	count := size
+	if size > verifyNaiveMaxSize {
+		count = accuracySampleBins
+	}
+
+	bins := make([]int, count)
+	for i := range bins {
+		bins[i] = i
+		if count < size {
+			bins[i] = (i*size/count + i) % size
+		}
+	}
+
+	return bins
+}
+
+// unravelIndex writes the row-major multi-index of flat into index.
+func unravelIndex(index, dims []int, flat int) {
+	for d := len(dims) - 1; d >= 0; d-- {
+		index[d] = flat % dims[d]
+		flat /= dims[d]
+	}
+}
+
+// ulp returns the spacing of float32 or float64 values at |x|.
+func ulp(x float64, single bool) float64 {
+	x = math.Abs(x)
+	if single {
+		f := float32(x)
+		return float64(math.Nextafter32(f, float32(math.Inf(1))) - f)
+	}
+
+	return math.Nextafter(x, math.Inf(1)) - x
+}
+
+// complexTrial returns an accuracyRun for complex transforms of type T.
+func complexTrial[T Complex](size int, forward, inverse func(dst, src []T) error) accuracyRun {
+	src := make([]T, size)
+	dst := make([]T, size)
+	spectrum := make([]complex128, size)
+	roundTrip := make([]complex128, size)
+
+	return func(input []complex128) ([]complex128, []complex128, error) {
+		for i, v := range input {
+			src[i] = T(v)
+		}
+
+		if err := forward(dst, src); err != nil {
+			return nil, nil, err
+		}
+
+		for i, v := range dst {
+			spectrum[i] = complex128(v)
+		}
+
+		if err := inverse(src, dst); err != nil {
+			return nil, nil, err
+		}
+
+		for i, v := range src {
+			roundTrip[i] = complex128(v)
+		}
+
+		return spectrum, roundTrip, nil
+	}
+}
+
+// realTrial returns an accuracyRun for real transforms of type F with a
+// spectrum of specLen bins of type C.
+func realTrial[F Float, C Complex](size, specLen int, forward func(dst []C, src []F) error, inverse func(dst []F, src []C) error) accuracyRun {
+	src := make([]F, size)
+	dst := make([]C, specLen)
+	spectrum := make([]complex128, specLen)
+	roundTrip := make([]complex128, size)
+
+	return func(input []complex128) ([]complex128, []complex128, error) {
+		for i, v := range input {
+			src[i] = F(real(v))
+		}
+
+		if err := forward(dst, src); err != nil {
+			return nil, nil, err
+		}
+
+		for i, v := range dst {
+			spectrum[i] = complex128(v)
+		}
+
+		if err := inverse(src, dst); err != nil {
+			return nil, nil, err
+		}
+
+		for i, v := range src {
+			roundTrip[i] = complex(float64(v), 0)
+		}
+
+		return spectrum, roundTrip, nil
+	}
+}
+
+// isSingle reports whether T is complex64.
+func isSingle[T Complex]() bool {
+	var zero T
+	_, ok := any(zero).(complex64)
+
+	return ok
+}
+
+// Accuracy measures the plan's error against the reference DFT over trials
+// random inputs (at least one); see AccuracyReport. It allocates and runs
+// both directions, so it belongs in validation code, not hot paths.
+func (p *Plan[T]) Accuracy(trials int) (AccuracyReport, error) {
+	work := NewWorkspace[T](p.WorkspaceSize())
+	target := accuracyTarget{
+		dims:         []int{p.n},
+		single:       isSingle[T](),
+		forwardScale: p.forwardScale,
+		roundTrip:    p.forwardScale * p.inverseScale,
+	}
+
+	return measureAccuracy(target, trials, complexTrial(p.n,
+		func(dst, src []T) error { return p.ForwardWithWorkspace(dst, src, work) },
+		func(dst, src []T) error { return p.InverseWithWorkspace(dst, src, work) },
+	))
+}
+
+// Accuracy measures the plan's error like Plan.Accuracy.
+func (p *Plan2D[T]) Accuracy(trials int) (AccuracyReport, error) {
+	target := accuracyTarget{
+		dims:         []int{p.rows, p.cols},
+		single:       isSingle[T](),
+		forwardScale: p.forwardScale,
+		roundTrip:    p.forwardScale * p.inverseScale,
+	}
+
+	return measureAccuracy(target, trials, complexTrial(p.Len(), p.Forward, p.Inverse))
+}
+
+// Accuracy measures the plan's error like Plan.Accuracy.
+func (p *Plan3D[T]) Accuracy(trials int) (AccuracyReport, error) {
+	target := accuracyTarget{
+		dims:         []int{p.depth, p.height, p.width},
+		single:       isSingle[T](),
+		forwardScale: p.forwardScale,
+		roundTrip:    p.forwardScale * p.inverseScale,
+	}
+
+	return measureAccuracy(target, trials, complexTrial(p.Len(), p.Forward, p.Inverse))
+}
+
+// Accuracy measures the plan's error like Plan.Accuracy.
+func (p *PlanND[T]) Accuracy(trials int) (AccuracyReport, error) {
+	target := accuracyTarget{
+		dims:         p.Dims(),
+		single:       isSingle[T](),
+		forwardScale: p.forwardScale,
+		roundTrip:    p.forwardScale * p.inverseScale,
+	}
+
+	return measureAccuracy(target, trials, complexTrial(p.Len(), p.Forward, p.Inverse))
+}
+
+// Accuracy measures the plan's error like Plan.Accuracy, on the n/2+1
+// spectrum bins Forward returns.
+func (p *PlanRealT[F, C]) Accuracy(trials int) (AccuracyReport, error) {
+	target := accuracyTarget{
+		dims:         []int{p.n},
+		real:         true,
+		single:       isSingle[C](),
+		forwardScale: p.forwardScale,
+		roundTrip:    p.forwardScale * p.inverseScale,
+	}
+
+	return measureAccuracy(target, trials, realTrial(p.n, p.SpectrumLen(), p.Forward, p.Inverse))
+}
+
+// Accuracy measures the plan's error like PlanRealT.Accuracy.
+func (p *PlanReal) Accuracy(trials int) (AccuracyReport, error) {
+	target := accuracyTarget{
+		dims:         []int{p.n},
+		real:         true,
+		single:       true,
+		forwardScale: p.forwardScale,
+		roundTrip:    p.forwardScale * p.inverseScale,
+	}
+
+	return measureAccuracy(target, trials, realTrial(p.n, p.SpectrumLen(), p.Forward, p.Inverse))
+}
+
+// Accuracy measures the plan's error like PlanRealT.Accuracy, on the compact
+// rows×(cols/2+1) spectrum.
+func (p *PlanReal2D) Accuracy(trials int) (AccuracyReport, error) {
+	target := accuracyTarget{
+		dims:         []int{p.Rows(), p.Cols()},
+		real:         true,
+		single:       true,
+		forwardScale: p.plan.grid.forwardScale,
+		roundTrip:    p.plan.grid.forwardScale * p.plan.grid.inverseScale,
+	}
+
+	return measureAccuracy(target, trials, realTrial(p.Len(), p.SpectrumLen(), p.Forward, p.Inverse))
+}
+
+// Accuracy measures the plan's error like PlanRealT.Accuracy, on the compact
+// depth×height×(width/2+1) spectrum.
+func (p *PlanReal3D) Accuracy(trials int) (AccuracyReport, error) {
+	target := accuracyTarget{
+		dims:         []int{p.Depth(), p.Height(), p.Width()},
+		real:         true,
+		single:       true,
+		forwardScale: p.plan.grid.forwardScale,
+		roundTrip:    p.plan.grid.forwardScale * p.plan.grid.inverseScale,
+	}
+
+	return measureAccuracy(target, trials, realTrial(p.Len(), p.SpectrumLen(), p.Forward, p.Inverse))
+}
@@ -0,0 +1,137 @@
+package algofft
+
+import (
+	"testing"
+)
+
+func checkAccuracy(t *testing.T, name string, report AccuracyReport, err error, trials, bins int, tol float64) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("%s: Accuracy failed: %v", name, err)
+	}
+
+	if report.Trials != trials || report.Bins != bins {
+		t.Errorf("%s: Trials/Bins = %d/%d, want %d/%d", name, report.Trials, report.Bins, trials, bins)
+	}
+
+	if report.MaxRelError > tol || report.RMSRelError > tol || report.RoundTripError > tol {
+		t.Errorf("%s: errors max=%g rms=%g round trip=%g, want <= %g", name, report.MaxRelError, report.RMSRelError, report.RoundTripError, tol)
+	}
+
+	if report.MeanULP > report.MaxULP || report.MaxULP <= 0 {
+		t.Errorf("%s: ULP max=%g mean=%g", name, report.MaxULP, report.MeanULP)
+	}
+}
+
+func TestAccuracy_Plan(t *testing.T) {
+	t.Parallel()
+
+	plan32, err := NewPlanWithOptions[complex64](256, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	report, err := plan32.Accuracy(3)
+	checkAccuracy(t, "complex64/256", report, err, 3, 256, 1e-5)
+
+	plan64, err := NewPlanT[complex128](4096)
+	if err != nil {
+		t.Fatalf("NewPlanT failed: %v", err)
+	}
+
+	report, err = plan64.Accuracy(0)
+	checkAccuracy(t, "complex128/4096", report, err, 1, accuracySampleBins, 1e-13)
+
+	prime, err := NewPlanWithOptions[complex128](97, PlanOptions{Normalization: NormNone})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	report, err = prime.Accuracy(2)
+	checkAccuracy(t, "complex128/97", report, err, 2, 97, 1e-12)
+}
+
+func TestAccuracy_DetectsBrokenCodelet(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanT[complex64](64)
+	if err != nil {
+		t.Fatalf("NewPlanT failed: %v", err)
+	}
+
+	if plan.forwardCodelet == nil {
+		t.Skipf("no codelet bound for size 64 (algorithm %q)", plan.Algorithm())
+	}
+
+	plan.forwardCodelet = brokenCodelet[complex64]
+
+	report, err := plan.Accuracy(1)
+	if err != nil {
+		t.Fatalf("Accuracy failed: %v", err)
+	}
+
+	if report.MaxRelError < 0.1 || report.RoundTripError < 0.1 {
+		t.Errorf("broken codelet: max=%g round trip=%g, want large errors", report.MaxRelError, report.RoundTripError)
+	}
+}
+
+func TestAccuracy_RealAndMultiDim(t *testing.T) {
+	t.Parallel()
+
+	real32, err := NewPlanReal(128)
+	if err != nil {
+		t.Fatalf("NewPlanReal failed: %v", err)
+	}
+
+	report, err := real32.Accuracy(2)
+	checkAccuracy(t, "PlanReal/128", report, err, 2, 65, 1e-5)
+
+	real64, err := NewPlanRealTWithOptions[float64, complex128](45, PlanOptions{Normalization: NormForward})
+	if err != nil {
+		t.Fatalf("NewPlanRealTWithOptions failed: %v", err)
+	}
+
+	report, err = real64.Accuracy(2)
+	checkAccuracy(t, "PlanRealT/45", report, err, 2, 23, 1e-12)
+
+	plan2D, err := NewPlan2D64(8, 12)
+	if err != nil {
+		t.Fatalf("NewPlan2D64 failed: %v", err)
+	}
+
+	report, err = plan2D.Accuracy(1)
+	checkAccuracy(t, "Plan2D/8x12", report, err, 1, 96, 1e-12)
+
+	plan3D, err := NewPlan3D32(4, 4, 8)
+	if err != nil {
+		t.Fatalf("NewPlan3D32 failed: %v", err)
+	}
+
+	report, err = plan3D.Accuracy(1)
+	checkAccuracy(t, "Plan3D/4x4x8", report, err, 1, 128, 1e-5)
+
+	planND, err := NewPlanNDWithOptions[complex128]([]int{2, 3, 4, 5}, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlanNDWithOptions failed: %v", err)
+	}
+
+	report, err = planND.Accuracy(1)
+	checkAccuracy(t, "PlanND/2x3x4x5", report, err, 1, 120, 1e-12)
+
+	real2D, err := NewPlanReal2D(8, 16)
+	if err != nil {
+		t.Fatalf("NewPlanReal2D failed: %v", err)
+	}
+
+	report, err = real2D.Accuracy(1)
+	checkAccuracy(t, "PlanReal2D/8x16", report, err, 1, 72, 1e-5)
+
+	real3D, err := NewPlanReal3D(4, 4, 8)
+	if err != nil {
+		t.Fatalf("NewPlanReal3D failed: %v", err)
+	}
+
+	report, err = real3D.Accuracy(1)
+	checkAccuracy(t, "PlanReal3D/4x4x8", report, err, 1, 80, 1e-5)
+}
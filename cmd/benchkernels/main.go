@@ -104,6 +104,7 @@ func benchmarkSize(rnd *rand.Rand, n, iters, warmup int, mode string) []benchRes
 	strategies := []algofft.KernelStrategy{
 		algofft.KernelDIT,
 		algofft.KernelStockham,
+		algofft.KernelSplitRadix,
 		algofft.KernelSixStep,
 		algofft.KernelEightStep,
 	}
@@ -240,6 +241,8 @@ func strategyName(strategy algofft.KernelStrategy) string {
 		return "DIT"
 	case algofft.KernelStockham:
 		return "Stockham"
+	case algofft.KernelSplitRadix:
+		return "SplitRadix"
 	case algofft.KernelSixStep:
 		return "SixStep"
 	case algofft.KernelEightStep:
@@ -255,6 +258,8 @@ func strategyConst(strategy algofft.KernelStrategy) string {
 		return "KernelDIT"
 	case algofft.KernelStockham:
 		return "KernelStockham"
+	case algofft.KernelSplitRadix:
+		return "KernelSplitRadix"
 	case algofft.KernelSixStep:
 		return "KernelSixStep"
 	case algofft.KernelEightStep:
@@ -304,6 +309,8 @@ func strategyToAlgorithmName(strategy algofft.KernelStrategy) string {
 		return "dit_fallback"
 	case algofft.KernelStockham:
 		return "stockham"
+	case algofft.KernelSplitRadix:
+		return "splitradix"
 	case algofft.KernelSixStep:
 		return "sixstep"
 	case algofft.KernelEightStep:
@@ -0,0 +1,349 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// emitter writes the straight-line body of one codelet. Values are the names
+// of local variables holding complex numbers; every operation declares a new
+// temporary, so the generated code is in SSA form.
+type emitter struct {
+	b    strings.Builder
+	next int
+	sign float64 // -1 forward, +1 inverse
+}
+
+const eps = 1e-12
+
+func (e *emitter) tmp(expr string) string {
+	name := "t" + strconv.Itoa(e.next)
+	e.next++
+
+	fmt.Fprintf(&e.b, "\t%s := %s\n", name, expr)
+
+	return name
+}
+
+func (e *emitter) add(a, b string) string { return e.tmp(a + " + " + b) }
+func (e *emitter) sub(a, b string) string { return e.tmp(a + " - " + b) }
+
+// mulI returns a·(s·i) for s = ±1.
+func (e *emitter) mulI(a string, s float64) string {
+	if s > 0 {
+		return e.tmp(fmt.Sprintf("complex(-imag(%s), real(%s))", a, a))
+	}
+
+	return e.tmp(fmt.Sprintf("complex(imag(%s), -real(%s))", a, a))
+}
+
+// mulW returns a·w, specialising the trivial and eighth roots of unity.
+func (e *emitter) mulW(a string, w complex128) string {
+	re, im := real(w), imag(w)
+
+	switch {
+	case math.Abs(im) < eps && math.Abs(re-1) < eps:
+		return a
+	case math.Abs(im) < eps && math.Abs(re+1) < eps:
+		return e.tmp("-" + a)
+	case math.Abs(re) < eps:
+		return e.mulI(a, math.Copysign(1, im))
+	case math.Abs(math.Abs(re)-math.Abs(im)) < eps:
+		// (x + iy)(σr + iσi)c = c((σr x - σi y) + i(σr y + σi x))
+		c := lit(math.Abs(re))
+		x, y := "real("+a+")", "imag("+a+")"
+
+		return e.tmp(fmt.Sprintf("complex((%s)*%s, (%s)*%s)",
+			signedSum(re, x, -im, y), c, signedSum(re, y, im, x), c))
+	default:
+		return e.tmp(fmt.Sprintf("%s * complex(%s, %s)", a, lit(re), lit(im)))
+	}
+}
+
+// signedSum formats sign(p)·x + sign(q)·y.
+func signedSum(p float64, x string, q float64, y string) string {
+	out := x
+	if p < 0 {
+		out = "-" + x
+	}
+
+	if q < 0 {
+		return out + " - " + y
+	}
+
+	return out + " + " + y
+}
+
+// term formats c·x as a summand, or as the leading term of a sum.
+func term(c float64, x string, first bool) string {
+	switch {
+	case first:
+		return lit(c) + "*" + x
+	case c < 0:
+		return " - " + lit(-c) + "*" + x
+	default:
+		return " + " + lit(c) + "*" + x
+	}
+}
+
+func lit(v float64) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+
+	return s
+}
+
+// root returns exp(sign·2πi·k/n).
+func (e *emitter) root(k, n int) complex128 {
+	k %= n
+	// Exact values for the quarter turns keep the specialisations in mulW.
+	switch {
+	case k == 0:
+		return 1
+	case 4*k == n:
+		return complex(0, e.sign)
+	case 2*k == n:
+		return -1
+	case 4*k == 3*n:
+		return complex(0, -e.sign)
+	}
+
+	s, c := sincosTurn(k, n)
+
+	return complex(c, e.sign*s)
+}
+
+// sincosTurn returns sin and cos of 2π·k/n, reducing the angle to the first
+// octant first so that symmetric constants come out exact.
+func sincosTurn(k, n int) (float64, float64) {
+	// Angles are counted in units of 2π/(8n), so the octant boundaries are
+	// integers.
+	den := 8 * n
+	num := (k%n + n) % n * 8
+	sSign, cSign := 1.0, 1.0
+
+	if num > den/2 {
+		num, sSign = den-num, -1
+	}
+
+	if num > den/4 {
+		num, cSign = den/2-num, -1
+	}
+
+	swap := num > den/8
+	if swap {
+		num = den/4 - num
+	}
+
+	s, c := math.Sincos(2 * math.Pi * float64(num) / float64(den))
+	if swap {
+		s, c = c, s
+	}
+
+	return sSign * s, cSign * c
+}
+
+// dft emits the DFT of x and returns its outputs in natural order.
+func (e *emitter) dft(x []string) []string {
+	n := len(x)
+
+	switch {
+	case n == 1:
+		return x
+	case n == 2:
+		return []string{e.add(x[0], x[1]), e.sub(x[0], x[1])}
+	case n == 4:
+		return e.dft4(x)
+	case isPrime(n):
+		return e.dftOdd(x)
+	}
+
+	if n1, n2, ok := coprimeSplit(n); ok {
+		return e.pfa(x, n1, n2)
+	}
+
+	return e.cooleyTukey(x, radixOf(n))
+}
+
+func (e *emitter) dft4(x []string) []string {
+	t0, t1 := e.add(x[0], x[2]), e.sub(x[0], x[2])
+	t2, t3 := e.add(x[1], x[3]), e.sub(x[1], x[3])
+	r := e.mulI(t3, e.sign)
+
+	return []string{e.add(t0, t2), e.add(t1, r), e.sub(t0, t2), e.sub(t1, r)}
+}
+
+// dftOdd emits an odd-prime DFT by folding the inputs into conjugate pairs:
+// with t_j = x_j + x_{p-j} and u_j = x_j - x_{p-j},
+// y_k = x_0 + Σ cos(2πjk/p)·t_j + sign·i·Σ sin(2πjk/p)·u_j and y_{p-k} is
+// its mirror.
+func (e *emitter) dftOdd(x []string) []string {
+	p := len(x)
+	m := (p - 1) / 2
+
+	t := make([]string, m+1)
+	u := make([]string, m+1)
+
+	for j := 1; j <= m; j++ {
+		t[j] = e.add(x[j], x[p-j])
+		u[j] = e.sub(x[j], x[p-j])
+	}
+
+	y := make([]string, p)
+
+	sum := x[0]
+	for j := 1; j <= m; j++ {
+		sum += " + " + t[j]
+	}
+
+	y[0] = e.tmp(sum)
+
+	for k := 1; k <= m; k++ {
+		var ar, ai, br, bi strings.Builder
+
+		ar.WriteString("real(" + x[0] + ")")
+		ai.WriteString("imag(" + x[0] + ")")
+
+		for j := 1; j <= m; j++ {
+			s, c := sincosTurn(j*k, p)
+			ar.WriteString(term(c, "real("+t[j]+")", false))
+			ai.WriteString(term(c, "imag("+t[j]+")", false))
+			br.WriteString(term(s, "real("+u[j]+")", j == 1))
+			bi.WriteString(term(s, "imag("+u[j]+")", j == 1))
+		}
+
+		are, aim := e.tmp(ar.String()), e.tmp(ai.String())
+		bre, bim := e.tmp(br.String()), e.tmp(bi.String())
+
+		// y_k = A + sign·i·B, y_{p-k} = A - sign·i·B
+		plus := e.tmp(fmt.Sprintf("complex(%s - %s, %s + %s)", are, bim, aim, bre))
+		minus := e.tmp(fmt.Sprintf("complex(%s + %s, %s - %s)", are, bim, aim, bre))
+
+		if e.sign > 0 {
+			y[k], y[p-k] = plus, minus
+		} else {
+			y[k], y[p-k] = minus, plus
+		}
+	}
+
+	return y
+}
+
+// pfa emits a Good–Thomas transform for n = n1·n2 with coprime factors:
+// the input index (n2·i1 + n1·i2) mod n feeds n1 DFTs of size n2 and then n2
+// DFTs of size n1, without twiddles; output (k1, k2) lands at the CRT index.
+func (e *emitter) pfa(x []string, n1, n2 int) []string {
+	n := n1 * n2
+
+	rows := make([][]string, n1)
+	for i1 := range n1 {
+		row := make([]string, n2)
+		for i2 := range n2 {
+			row[i2] = x[(n2*i1+n1*i2)%n]
+		}
+
+		rows[i1] = e.dft(row)
+	}
+
+	y := make([]string, n)
+	col := make([]string, n1)
+
+	for k2 := range n2 {
+		for i1 := range n1 {
+			col[i1] = rows[i1][k2]
+		}
+
+		out := e.dft(col)
+		for k1, v := range out {
+			y[crt(k1, n1, k2, n2)] = v
+		}
+	}
+
+	return y
+}
+
+// cooleyTukey emits a decimation-in-time step of radix r: r DFTs of size
+// n/r over the decimated inputs, twiddles W_n^(j·k) and n/r DFTs of size r.
+func (e *emitter) cooleyTukey(x []string, r int) []string {
+	n := len(x)
+	m := n / r
+
+	subs := make([][]string, r)
+	for j := range r {
+		sub := make([]string, m)
+		for i := range m {
+			sub[i] = x[i*r+j]
+		}
+
+		subs[j] = e.dft(sub)
+	}
+
+	y := make([]string, n)
+	col := make([]string, r)
+
+	for k := range m {
+		for j := range r {
+			col[j] = e.mulW(subs[j][k], e.root(j*k, n))
+		}
+
+		out := e.dft(col)
+		for q, v := range out {
+			y[k+m*q] = v
+		}
+	}
+
+	return y
+}
+
+// coprimeSplit splits n into its smallest prime-power factor and the
+// coprime rest.
+func coprimeSplit(n int) (int, int, bool) {
+	p := smallestFactor(n)
+
+	pk := 1
+	for n%(pk*p) == 0 {
+		pk *= p
+	}
+
+	if pk == n {
+		return 0, 0, false
+	}
+
+	return pk, n / pk, true
+}
+
+// radixOf picks the Cooley–Tukey radix of a prime power.
+func radixOf(n int) int {
+	if n%4 == 0 {
+		return 4
+	}
+
+	return smallestFactor(n)
+}
+
+func smallestFactor(n int) int {
+	for p := 2; p*p <= n; p++ {
+		if n%p == 0 {
+			return p
+		}
+	}
+
+	return n
+}
+
+func isPrime(n int) bool {
+	return n > 1 && smallestFactor(n) == n
+}
+
+// crt returns the k in [0, n1·n2) with k ≡ k1 mod n1 and k ≡ k2 mod n2.
+func crt(k1, n1, k2, n2 int) int {
+	for k := k2; ; k += n2 {
+		if k%n1 == k1 {
+			return k
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Command genfft generates straight-line FFT codelets for small sizes.
+//
+// For every requested size it writes dft_<n>_gen.go into the output
+// directory, holding fully unrolled forward and inverse transforms for
+// complex64 and complex128, and codelet_init_gen.go, which registers them in
+// the codelet registries. Sizes are factored with the prime-factor algorithm
+// where the factors are coprime, radix-4/radix-p Cooley–Tukey steps for prime
+// powers and conjugate-pair butterflies for odd primes; twiddles are folded
+// into constants, so the codelets ignore their twiddle and scratch arguments.
+//
+// Usage (from internal/kernels, see the go:generate directive there):
+//
+//	go run ../../cmd/genfft -out . -sizes 6,10,12
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by genfft. DO NOT EDIT.\n\n"
+
+// defaultSizes are the codelets shipped in internal/kernels: the smooth
+// sizes below 100 that have no hand-written codelet.
+const defaultSizes = "3,5,6,7,9,10,12,15,20,24,30,36,40,48,60,80,96"
+
+// codeletPriority ranks generated codelets among codelets of the same size.
+const codeletPriority = 10
+
+func main() {
+	var (
+		out      = flag.String("out", ".", "output directory")
+		pkg      = flag.String("pkg", "kernels", "package name of the generated files")
+		sizeList = flag.String("sizes", defaultSizes, "comma-separated codelet sizes")
+	)
+
+	flag.Parse()
+
+	sizes, err := parseSizes(*sizeList)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, n := range sizes {
+		err := writeSource(filepath.Join(*out, fmt.Sprintf("dft_%d_gen.go", n)), codeletFile(*pkg, n))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	err = writeSource(filepath.Join(*out, "codelet_init_gen.go"), registrationFile(*pkg, sizes))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseSizes(list string) ([]int, error) {
+	seen := make(map[int]bool)
+
+	var sizes []int
+
+	for field := range strings.SplitSeq(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 2 {
+			return nil, fmt.Errorf("invalid size %q", field)
+		}
+
+		if !seen[n] {
+			seen[n] = true
+			sizes = append(sizes, n)
+		}
+	}
+
+	sort.Ints(sizes)
+
+	return sizes, nil
+}
+
+func writeSource(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return os.WriteFile(path, formatted, 0o644)
+}
+
+// codeletFile generates the four codelets of size n.
+func codeletFile(pkg string, n int) []byte {
+	var b bytes.Buffer
+
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n", pkg)
+
+	for _, prec := range []int{64, 128} {
+		for _, inverse := range []bool{false, true} {
+			b.WriteString("\n")
+			writeCodelet(&b, n, prec, inverse)
+		}
+	}
+
+	return b.Bytes()
+}
+
+func writeCodelet(b *bytes.Buffer, n, prec int, inverse bool) {
+	dir, sign := "forward", -1.0
+	if inverse {
+		dir, sign = "inverse", 1.0
+	}
+
+	name := codeletName(dir, n, prec)
+	typ := fmt.Sprintf("complex%d", prec)
+
+	fmt.Fprintf(b, "// %s computes a %d-point %s DFT of %s data", name, n, dir, typ)
+
+	if inverse {
+		fmt.Fprintf(b, ",\n// including the 1/%d scaling", n)
+	}
+
+	b.WriteString(".\n// The twiddle and scratch arguments are unused; dst and src may alias.\n")
+	b.WriteString("//\n//nolint:funlen\n")
+	fmt.Fprintf(b, "func %s(dst, src, twiddle, scratch []%s) bool {\n", name, typ)
+	fmt.Fprintf(b, "\tconst n = %d\n\n", n)
+	b.WriteString("\tif len(dst) < n || len(src) < n {\n\t\treturn false\n\t}\n\n")
+	b.WriteString("\ts := src[:n]\n\td := dst[:n]\n\n")
+
+	x := make([]string, n)
+	for i := range x {
+		x[i] = "x" + strconv.Itoa(i)
+		fmt.Fprintf(b, "\t%s := s[%d]\n", x[i], i)
+	}
+
+	e := &emitter{sign: sign}
+	y := e.dft(x)
+
+	b.WriteString("\n")
+	b.WriteString(e.b.String())
+	b.WriteString("\n")
+
+	for k, v := range y {
+		if inverse {
+			scale := lit(1 / float64(n))
+			fmt.Fprintf(b, "\td[%d] = complex(real(%s)*%s, imag(%s)*%s)\n", k, v, scale, v, scale)
+		} else {
+			fmt.Fprintf(b, "\td[%d] = %s\n", k, v)
+		}
+	}
+
+	b.WriteString("\n\treturn true\n}\n")
+}
+
+func codeletName(dir string, n, prec int) string {
+	return fmt.Sprintf("%sDFT%dComplex%d", dir, n, prec)
+}
+
+// registrationFile generates the functions registering all codelets.
+func registrationFile(pkg string, sizes []int) []byte {
+	var b bytes.Buffer
+
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n", pkg)
+
+	for _, prec := range []int{64, 128} {
+		fmt.Fprintf(&b, "\n// registerGeneratedCodelets%d registers the straight-line complex%d\n", prec, prec)
+		b.WriteString("// codelets generated by cmd/genfft.\n")
+		fmt.Fprintf(&b, "func registerGeneratedCodelets%d() {\n", prec)
+
+		for _, n := range sizes {
+			fmt.Fprintf(&b, "\tRegistry%d.Register(CodeletEntry[complex%d]{\n", prec, prec)
+			fmt.Fprintf(&b, "\t\tSize: %d,\n", n)
+			fmt.Fprintf(&b, "\t\tForward: wrapCodelet%d(%s),\n", prec, codeletName("forward", n, prec))
+			fmt.Fprintf(&b, "\t\tInverse: wrapCodelet%d(%s),\n", prec, codeletName("inverse", n, prec))
+			b.WriteString("\t\tAlgorithm: KernelDIT,\n")
+			b.WriteString("\t\tSIMDLevel: SIMDNone,\n")
+			fmt.Fprintf(&b, "\t\tSignature: \"dit%d_genfft_generic\",\n", n)
+			fmt.Fprintf(&b, "\t\tPriority: %d,\n", codeletPriority)
+			b.WriteString("\t\tKernelType: KernelTypeCore,\n")
+			b.WriteString("\t})\n")
+		}
+
+		b.WriteString("}\n")
+	}
+
+	return b.Bytes()
+}
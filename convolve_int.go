@@ -0,0 +1,148 @@
+package algofft
+
+import (
+	m "github.com/cwbudde/algo-fft/internal/math"
+	"github.com/cwbudde/algo-fft/internal/ntt"
+)
+
+// convolveIntDirectMax is the shorter input length up to which integer
+// convolutions use the direct O(n·m) sum, which is faster than three NTTs.
+const convolveIntDirectMax = 32
+
+// ConvolveModular computes the linear convolution of a and b modulo a prime
+// using number-theoretic transforms. The result is exact: dst[k] =
+// Σ a[i]·b[k-i] mod modulus, with inputs reduced modulo modulus first.
+// The dst slice must have length len(a)+len(b)-1.
+//
+// modulus must be an odd prime below 2^63 with 2^k | modulus-1 for the
+// smallest power of two 2^k ≥ len(dst); see NewPlanNTT.
+//
+// Returns ErrNilSlice if any slice is nil.
+// Returns ErrInvalidLength if a or b is empty.
+// Returns ErrLengthMismatch if len(dst) != len(a)+len(b)-1.
+// Returns ErrInvalidModulus if modulus does not support the length.
+func ConvolveModular(dst, a, b []uint64, modulus uint64) error {
+	err := validateConvolveInt(dst, a, b)
+	if err != nil {
+		return err
+	}
+
+	plan, err := NewPlanNTT(m.NextPowerOfTwo(len(dst)), modulus)
+	if err != nil {
+		return err
+	}
+
+	result := convolveNTT(plan, a, b)
+	copy(dst, result)
+
+	return nil
+}
+
+// ConvolveInt64 computes the linear convolution of a and b exactly, using
+// number-theoretic transforms over three primes combined by the Chinese
+// remainder theorem. The result equals the direct sum Σ a[i]·b[k-i] evaluated
+// in int64 arithmetic, including its wraparound when a value does not fit.
+// The dst slice must have length len(a)+len(b)-1.
+//
+// Returns ErrNilSlice if any slice is nil.
+// Returns ErrInvalidLength if a or b is empty or the result exceeds 2^51
+// elements.
+// Returns ErrLengthMismatch if len(dst) != len(a)+len(b)-1.
+func ConvolveInt64(dst, a, b []int64) error {
+	err := validateConvolveInt(dst, a, b)
+	if err != nil {
+		return err
+	}
+
+	if min(len(a), len(b)) <= convolveIntDirectMax {
+		convolveInt64Direct(dst, a, b)
+		return nil
+	}
+
+	n := m.NextPowerOfTwo(len(dst))
+	if uint64(n) > 1<<ntt.CRTMaxLog2 {
+		return ErrInvalidLength
+	}
+
+	var residues [3][]uint64
+
+	for i, prime := range [3]uint64{ntt.Prime1, ntt.Prime2, ntt.Prime3} {
+		plan, err := NewPlanNTT(n, prime)
+		if err != nil {
+			return err
+		}
+
+		residues[i] = convolveNTT(plan, residuesInt64(a, prime), residuesInt64(b, prime))
+	}
+
+	crt := ntt.NewCRT()
+	for k := range dst {
+		dst[k] = crt.Int64(residues[0][k], residues[1][k], residues[2][k])
+	}
+
+	return nil
+}
+
+func validateConvolveInt[T any](dst, a, b []T) error {
+	if dst == nil || a == nil || b == nil {
+		return ErrNilSlice
+	}
+
+	if len(a) == 0 || len(b) == 0 {
+		return ErrInvalidLength
+	}
+
+	if len(dst) != len(a)+len(b)-1 {
+		return ErrLengthMismatch
+	}
+
+	return nil
+}
+
+// convolveNTT returns the cyclic convolution of a and b, zero-padded to the
+// plan length, modulo the plan's prime.
+func convolveNTT(plan *PlanNTT, a, b []uint64) []uint64 {
+	n := plan.Len()
+	aFreq := make([]uint64, n)
+	bFreq := make([]uint64, n)
+
+	copy(aFreq, a)
+	copy(bFreq, b)
+
+	// The padded buffers have the plan length, so these cannot fail.
+	_ = plan.Forward(aFreq, aFreq)
+	_ = plan.Forward(bFreq, bFreq)
+
+	plan.table.MulPointwise(aFreq, aFreq, bFreq)
+
+	_ = plan.Inverse(aFreq, aFreq)
+
+	return aFreq
+}
+
+// residuesInt64 returns x modulo prime, in [0, prime).
+func residuesInt64(x []int64, prime uint64) []uint64 {
+	out := make([]uint64, len(x))
+	p := int64(prime)
+
+	for i, v := range x {
+		r := v % p
+		if r < 0 {
+			r += p
+		}
+
+		out[i] = uint64(r)
+	}
+
+	return out
+}
+
+func convolveInt64Direct(dst, a, b []int64) {
+	clear(dst)
+
+	for i, av := range a {
+		for j, bv := range b {
+			dst[i+j] += av * bv
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func randomInt64Slice(n int, limit int64, seed uint64) []int64 {
+	rng := rand.New(rand.NewPCG(seed, seed^0x9e3779b9)) //nolint:gosec
+	out := make([]int64, n)
+
+	for i := range out {
+		if limit == math.MaxInt64 {
+			out[i] = int64(rng.Uint64())
+		} else {
+			out[i] = rng.Int64N(2*limit+1) - limit
+		}
+	}
+
+	return out
+}
+
+func TestConvolveInt64_MatchesDirect(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		aLen, bLen int
+		limit      int64
+	}{
+		{1, 1, 100},
+		{5, 40, 1000},           // direct path
+		{100, 37, 1 << 20},      // NTT path, no overflow
+		{300, 1000, 1 << 31},    // large exact values
+		{64, 64, math.MaxInt64}, // full range, wraps like int64 arithmetic
+	}
+
+	for _, c := range cases {
+		a := randomInt64Slice(c.aLen, c.limit, uint64(c.aLen))
+		b := randomInt64Slice(c.bLen, c.limit, uint64(c.bLen)+7)
+
+		want := make([]int64, c.aLen+c.bLen-1)
+		convolveInt64Direct(want, a, b)
+
+		got := make([]int64, len(want))
+		if err := ConvolveInt64(got, a, b); err != nil {
+			t.Fatalf("%dx%d: ConvolveInt64 failed: %v", c.aLen, c.bLen, err)
+		}
+
+		if !slices.Equal(got, want) {
+			t.Errorf("%dx%d (limit %d): result differs from direct convolution", c.aLen, c.bLen, c.limit)
+		}
+	}
+}
+
+func TestConvolveModular(t *testing.T) {
+	t.Parallel()
+
+	const modulus = 998244353
+
+	rng := rand.New(rand.NewPCG(20, 20)) //nolint:gosec
+
+	a := make([]uint64, 200)
+	b := make([]uint64, 77)
+
+	for i := range a {
+		a[i] = rng.Uint64() // reduced by ConvolveModular
+	}
+
+	for i := range b {
+		b[i] = rng.Uint64N(modulus)
+	}
+
+	got := make([]uint64, len(a)+len(b)-1)
+	if err := ConvolveModular(got, a, b, modulus); err != nil {
+		t.Fatalf("ConvolveModular failed: %v", err)
+	}
+
+	p := big.NewInt(modulus)
+
+	for k := range got {
+		sum := new(big.Int)
+
+		for i := max(0, k-len(b)+1); i <= min(k, len(a)-1); i++ {
+			term := new(big.Int).SetUint64(a[i])
+			term.Mul(term, new(big.Int).SetUint64(b[k-i]))
+			sum.Add(sum, term)
+		}
+
+		if want := sum.Mod(sum, p).Uint64(); got[k] != want {
+			t.Fatalf("dst[%d] = %d, want %d", k, got[k], want)
+		}
+	}
+}
+
+func TestConvolveInt_Errors(t *testing.T) {
+	t.Parallel()
+
+	a := []int64{1, 2, 3}
+
+	if err := ConvolveInt64(nil, a, a); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: %v, want ErrNilSlice", err)
+	}
+
+	if err := ConvolveInt64([]int64{}, []int64{}, a); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("empty input: %v, want ErrInvalidLength", err)
+	}
+
+	if err := ConvolveInt64(make([]int64, 4), a, a); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short dst: %v, want ErrLengthMismatch", err)
+	}
+
+	u := make([]uint64, 40)
+	dst := make([]uint64, 79)
+
+	// 97-1 = 96 = 3·2^5 supports lengths up to 32 only.
+	if err := ConvolveModular(dst, u, u, 97); !errors.Is(err, ErrInvalidModulus) {
+		t.Errorf("modulus 97 for length 128: %v, want ErrInvalidModulus", err)
+	}
+
+	if err := ConvolveModular(dst, u, u, 1<<20+1); !errors.Is(err, ErrInvalidModulus) {
+		t.Errorf("composite modulus: %v, want ErrInvalidModulus", err)
+	}
+}
+
+func TestPlanNTT(t *testing.T) {
+	t.Parallel()
+
+	const modulus = 998244353
+
+	plan, err := NewPlanNTT(1024, modulus)
+	if err != nil {
+		t.Fatalf("NewPlanNTT failed: %v", err)
+	}
+
+	if plan.Len() != 1024 || plan.Modulus() != modulus {
+		t.Errorf("Len/Modulus = %d/%d", plan.Len(), plan.Modulus())
+	}
+
+	src := make([]uint64, 1024)
+	for i := range src {
+		src[i] = uint64(i) * 7919
+	}
+
+	// The transform of an impulse at 0 is all ones.
+	impulse := make([]uint64, 1024)
+	impulse[0] = 1
+
+	if err := plan.Forward(impulse, impulse); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	for k, v := range impulse {
+		if v != 1 {
+			t.Fatalf("impulse spectrum[%d] = %d, want 1", k, v)
+		}
+	}
+
+	spectrum := make([]uint64, 1024)
+	back := make([]uint64, 1024)
+
+	if err := plan.Forward(spectrum, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := plan.Inverse(back, spectrum); err != nil {
+		t.Fatalf("Inverse failed: %v", err)
+	}
+
+	if !slices.Equal(back, src) {
+		t.Error("Inverse(Forward(x)) != x")
+	}
+
+	if _, err := NewPlanNTT(48, modulus); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("NewPlanNTT(48) error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := NewPlanNTT(1<<24, modulus); !errors.Is(err, ErrInvalidModulus) {
+		t.Errorf("NewPlanNTT(2^24) error = %v, want ErrInvalidModulus", err)
+	}
+
+	if err := plan.Forward(spectrum[:10], src); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Forward(short) error = %v, want ErrLengthMismatch", err)
+	}
+}
@@ -233,6 +233,15 @@
 //		log.Fatal(err)
 //	}
 //
+// For integer sequences (polynomial multiplication, big-number arithmetic),
+// ConvolveInt64 and ConvolveModular use number-theoretic transforms and are
+// exact; PlanNTT exposes the transform itself:
+//
+//	product := make([]int64, len(p)+len(q)-1)
+//	if err := algofft.ConvolveInt64(product, p, q); err != nil {
+//		log.Fatal(err)
+//	}
+//
 // # Correlation
 //
 // Cross-correlation and auto-correlation:
@@ -294,10 +303,13 @@
 //
 // The library supports several transform types:
 //   - Complex FFT: forward and inverse transforms of complex-valued signals
-//   - Real FFT: optimized transforms for real-valued input signals (PlanReal)
+//   - Real FFT: optimized transforms for real-valued input signals (PlanReal, PlanReal2DT, PlanReal3DT)
 //   - Multi-dimensional: 2D, 3D, and arbitrary N-dimensional FFTs
 //   - Batch: efficient processing of multiple transforms with same Plan
 //   - Strided: transform non-contiguous data without copying
+//   - DCT/DST: types I–IV on the real FFT (PlanDCT, PlanDST)
+//   - DHT: 1D and N-D Hartley transforms from the real FFT (PlanDHT)
+//   - MDCT: windowed lapped transform with overlap-add synthesis (MDCT)
 //
 // # Size Support
 //
@@ -24,6 +24,43 @@ var (
 	// expected symmetry constraints (e.g., non-real DC or Nyquist bins).
 	ErrInvalidSpectrum = errors.New("algo-fft: invalid spectrum")
 
+	// ErrPrecisionMismatch is returned when split-complex float slices do not
+	// match the plan precision (float32 for complex64, float64 for complex128).
+	ErrPrecisionMismatch = errors.New("algo-fft: precision mismatch")
+
+	// ErrWorkspaceRequired is returned by transforms that need scratch space
+	// on a plan created with WorkspaceExternal; use the WithWorkspace methods.
+	ErrWorkspaceRequired = errors.New("algo-fft: workspace required")
+
+	// ErrWorkspaceTooSmall is returned when a caller-provided workspace is
+	// shorter than the plan's WorkspaceSize().
+	ErrWorkspaceTooSmall = errors.New("algo-fft: workspace too small")
+
+	// ErrWorkspaceMisaligned is returned when a caller-provided workspace does
+	// not start on a WorkspaceAlignment-byte boundary.
+	ErrWorkspaceMisaligned = errors.New("algo-fft: workspace misaligned")
+
+	// ErrVerificationFailed is returned by plan constructors when
+	// PlanOptions.Verify finds no codelet or kernel matching the reference DFT.
+	ErrVerificationFailed = errors.New("algo-fft: plan verification failed")
+
+	// ErrInvalidModulus is returned when a number-theoretic transform modulus
+	// is not an odd prime below 2^63 with 2^k | modulus-1 for the transform
+	// length 2^k.
+	ErrInvalidModulus = errors.New("algo-fft: invalid NTT modulus")
+
+	// ErrInvalidTrigType is returned when a DCT or DST plan is requested for
+	// a type other than TypeI to TypeIV.
+	ErrInvalidTrigType = errors.New("algo-fft: invalid DCT/DST type")
+
+	// ErrInvalidRealFormat is returned when a packed real spectrum format is
+	// not one of the RealFormat constants.
+	ErrInvalidRealFormat = errors.New("algo-fft: invalid real spectrum format")
+
+	// ErrInvalidWindow is returned when an MDCT is requested with an unknown
+	// window or a negative Kaiser-Bessel-derived alpha.
+	ErrInvalidWindow = errors.New("algo-fft: invalid MDCT window")
+
 	// ErrNotImplemented is returned for features that are not yet implemented.
 	// This is a temporary error used during development.
 	ErrNotImplemented = errors.New("algo-fft: not implemented")
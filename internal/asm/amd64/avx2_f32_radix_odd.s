@@ -0,0 +1,121 @@
+//go:build amd64 && asm && !purego
+
+// ===========================================================================
+// AVX2 Odd-Radix Butterfly (complex64) for AMD64
+// ===========================================================================
+// Processes 4 radix-r butterflies (r = 7, 11 or 13) in parallel using YMM
+// registers. Each YMM holds one row of 4 complex64 lanes.
+//
+// With m = (r-1)/2, the inputs are folded into conjugate pairs
+//   t_j = a_j + a_{r-j},  u_j = a_j - a_{r-j}   (j = 1..m)
+// and the outputs are
+//   y_0     = a_0 + Σ t_j
+//   y_k     = A_k - i·B_k,  y_{r-k} = A_k + i·B_k   (k = 1..m)
+// with A_k = a_0 + Σ cos(2πjk/r)·t_j and B_k = Σ sin(2πjk/r)·u_j.
+// The inverse butterfly is obtained by passing a negated sine table.
+// ===========================================================================
+
+#include "textflag.h"
+
+// Sign mask negating the real part of each complex64 lane.
+DATA radixodd_negre<>+0x00(SB)/4, $0x80000000
+DATA radixodd_negre<>+0x04(SB)/4, $0x00000000
+DATA radixodd_negre<>+0x08(SB)/4, $0x80000000
+DATA radixodd_negre<>+0x0C(SB)/4, $0x00000000
+DATA radixodd_negre<>+0x10(SB)/4, $0x80000000
+DATA radixodd_negre<>+0x14(SB)/4, $0x00000000
+DATA radixodd_negre<>+0x18(SB)/4, $0x80000000
+DATA radixodd_negre<>+0x1C(SB)/4, $0x00000000
+GLOBL radixodd_negre<>(SB), RODATA|NOPTR, $32
+
+// ===========================================================================
+// Function: ButterflyOddAVX2Complex64
+// ===========================================================================
+// func ButterflyOddAVX2Complex64(y, a []complex64, cos, sin []float32, radix int)
+TEXT ·ButterflyOddAVX2Complex64(SB), NOSPLIT, $0-104
+	MOVQ y_base+0(FP), DI
+	MOVQ a_base+24(FP), SI
+	MOVQ cos_base+48(FP), AX
+	MOVQ sin_base+72(FP), BX
+	MOVQ radix+96(FP), CX
+
+	MOVQ CX, R10
+	SHRQ $1, R10                   // R10 = m = (radix-1)/2
+
+	// R9 = byte offset of the last row, (radix-1)*32
+	MOVQ CX, R9
+	DECQ R9
+	SHLQ $5, R9
+
+	VMOVUPS (SI), Y0               // Y0 = a_0
+	VMOVAPS Y0, Y1                 // Y1 = y_0 accumulator
+
+	// -----------------------------------------------------------------------
+	// Fold: a_j <- t_j, a_{r-j} <- u_j
+	// -----------------------------------------------------------------------
+	LEAQ 32(SI), R11               // &a_j
+	LEAQ (SI)(R9*1), R12           // &a_{r-j}
+	MOVQ R10, R13
+
+radixodd_fold:
+	VMOVUPS (R11), Y2
+	VMOVUPS (R12), Y3
+	VADDPS  Y3, Y2, Y4             // t_j
+	VSUBPS  Y3, Y2, Y5             // u_j
+	VMOVUPS Y4, (R11)
+	VMOVUPS Y5, (R12)
+	VADDPS  Y4, Y1, Y1
+	ADDQ    $32, R11
+	SUBQ    $32, R12
+	DECQ    R13
+	JNZ     radixodd_fold
+
+	VMOVUPS Y1, (DI)               // y_0
+
+	// -----------------------------------------------------------------------
+	// Output pairs y_k, y_{r-k} for k = 1..m
+	// -----------------------------------------------------------------------
+	VMOVUPS radixodd_negre<>(SB), Y15
+	LEAQ    32(DI), R11            // &y_k
+	LEAQ    (DI)(R9*1), R12        // &y_{r-k}
+	MOVQ    $1, R14                // k
+
+radixodd_k:
+	VMOVAPS Y0, Y1                 // A = a_0
+	VXORPS  Y2, Y2, Y2             // B = 0
+	LEAQ    32(SI), DX             // &t_1
+	LEAQ    (SI)(R9*1), R8         // &u_1
+	XORQ    R13, R13               // j-1
+
+radixodd_j:
+	VBROADCASTSS (AX)(R13*4), Y3   // cos(2πjk/r)
+	VBROADCASTSS (BX)(R13*4), Y4   // ±sin(2πjk/r)
+	VMOVUPS      (DX), Y5
+	VMOVUPS      (R8), Y6
+	VFMADD231PS  Y5, Y3, Y1        // A += c·t_j
+	VFMADD231PS  Y6, Y4, Y2        // B += s·u_j
+	ADDQ         $32, DX
+	SUBQ         $32, R8
+	INCQ         R13
+	CMPQ         R13, R10
+	JLT          radixodd_j
+
+	// Y3 = i·B = (-Im B, Re B)
+	VPERMILPS $0xB1, Y2, Y3
+	VXORPS    Y15, Y3, Y3
+
+	VSUBPS  Y3, Y1, Y4             // y_k = A - i·B
+	VADDPS  Y3, Y1, Y5             // y_{r-k} = A + i·B
+	VMOVUPS Y4, (R11)
+	VMOVUPS Y5, (R12)
+
+	ADDQ $32, R11
+	SUBQ $32, R12
+	LEAQ (AX)(R10*4), AX           // next table row
+	LEAQ (BX)(R10*4), BX
+	INCQ R14
+	CMPQ R14, R10
+	JLE  radixodd_k
+
+	VZEROUPPER
+	RET
@@ -663,6 +663,15 @@ func ScaleComplex128AVX2Asm(dst []complex128, scale float64)
 //go:noescape
 func ScaleComplex128SSE2Asm(dst []complex128, scale float64)
 
+// Split-complex (planar) radix-2 butterflies, updating a and b in place.
+// Only whole vectors are processed (8 float32 or 4 float64 per iteration).
+
+//go:noescape
+func SplitButterflyFloat32AVX2Asm(aRe, aIm, bRe, bIm, wRe, wIm []float32)
+
+//go:noescape
+func SplitButterflyFloat64AVX2Asm(aRe, aIm, bRe, bIm, wRe, wIm []float64)
+
 // Inverse real FFT repack helpers (complex64 only).
 
 //go:noescape
@@ -713,6 +722,19 @@ func Butterfly5ForwardAVX2Complex64(y0, y1, y2, y3, y4, a0, a1, a2, a3, a4 []com
 //go:noescape
 func Butterfly5InverseAVX2Complex64(y0, y1, y2, y3, y4, a0, a1, a2, a3, a4 []complex64)
 
+// ============================================================================
+// Odd-Radix (7, 11, 13) FFT Butterfly Operations
+// ============================================================================
+
+// ButterflyOddAVX2Complex64 processes 4 radix-r butterflies (r = 7, 11 or 13)
+// in parallel. a and y hold r rows of 4 lanes each (a[4*j+l]); a is used as
+// scratch and overwritten. cos and sin hold the m×m tables cos(2πjk/r) and
+// sin(2πjk/r) for k, j = 1..m, m = (r-1)/2, row-major by k. Passing a negated
+// sine table yields the inverse butterfly.
+//
+//go:noescape
+func ButterflyOddAVX2Complex64(y, a []complex64, cos, sin []float32, radix int)
+
 // ============================================================================
 // Size-384 Mixed-Radix (128×3) FFT Operations
 // ============================================================================
@@ -27,13 +27,16 @@ const (
 
 // Re-export kernel strategy constants from planner.
 const (
-	KernelAuto      = planner.KernelAuto
-	KernelDIT       = planner.KernelDIT
-	KernelStockham  = planner.KernelStockham
-	KernelSixStep   = planner.KernelSixStep
-	KernelEightStep = planner.KernelEightStep
-	KernelBluestein = planner.KernelBluestein
-	KernelRecursive = planner.KernelRecursive
+	KernelAuto       = planner.KernelAuto
+	KernelDIT        = planner.KernelDIT
+	KernelStockham   = planner.KernelStockham
+	KernelSixStep    = planner.KernelSixStep
+	KernelEightStep  = planner.KernelEightStep
+	KernelBluestein  = planner.KernelBluestein
+	KernelRecursive  = planner.KernelRecursive
+	KernelRader      = planner.KernelRader
+	KernelPFA        = planner.KernelPFA
+	KernelSplitRadix = planner.KernelSplitRadix
 )
 
 // Re-export functions and variables from planner.
@@ -45,6 +48,8 @@ var (
 	DefaultWisdom           = planner.DefaultWisdom
 	NewWisdom               = planner.NewWisdom
 	CPUFeatureMask          = planner.CPUFeatureMask
+	RaderApplicable         = planner.RaderApplicable
+	PFAApplicable           = planner.PFAApplicable
 )
 
 // Wrapper functions for generic planner functions.
@@ -52,10 +57,22 @@ func EstimatePlan[T Complex](n int, features cpu.Features, wisdom WisdomStore, s
 	return planner.EstimatePlan[T](n, features, wisdom, strategy)
 }
 
+func FallbackPlan[T Complex](n int, features cpu.Features, strategy KernelStrategy, rejected []string) PlanEstimate[T] {
+	return planner.FallbackPlan[T](n, features, strategy, rejected)
+}
+
 func HasCodelet[T Complex](n int, features cpu.Features) bool {
 	return planner.HasCodelet[T](n, features)
 }
 
+func BluesteinSizes[T Complex](n int, features cpu.Features) []int {
+	return planner.BluesteinSizes[T](n, features)
+}
+
+func RaderConvolutionLength[T Complex](n int, features cpu.Features) int {
+	return planner.RaderConvolutionLength[T](n, features)
+}
+
 // Re-export transform types for backward compatibility.
 type DecomposeStrategy = transform.DecomposeStrategy
 
@@ -100,12 +117,12 @@ func ConjugatePackedTwiddles[T Complex](packed *PackedTwiddles[T]) *PackedTwiddl
 	return transform.ConjugatePackedTwiddles[T](packed)
 }
 
-func ForwardStockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T]) bool {
-	return transform.ForwardStockhamPacked[T](dst, src, twiddle, scratch, packed)
+func ForwardStockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T], scale float64) bool {
+	return transform.ForwardStockhamPacked[T](dst, src, twiddle, scratch, packed, scale)
 }
 
-func InverseStockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T]) bool {
-	return transform.InverseStockhamPacked[T](dst, src, twiddle, scratch, packed)
+func InverseStockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T], scale float64) bool {
+	return transform.InverseStockhamPacked[T](dst, src, twiddle, scratch, packed, scale)
 }
 
 // Re-export transpose types and functions from internal/math.
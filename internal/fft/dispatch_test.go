@@ -46,6 +46,7 @@ func TestSelectKernelsWithStrategy(t *testing.T) {
 		KernelStockham,
 		KernelSixStep,
 		KernelEightStep,
+		KernelSplitRadix,
 	}
 
 	strategyNames := []string{
@@ -54,6 +55,7 @@ func TestSelectKernelsWithStrategy(t *testing.T) {
 		"Stockham",
 		"SixStep",
 		"EightStep",
+		"SplitRadix",
 	}
 
 	for i, strategy := range strategies {
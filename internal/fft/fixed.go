@@ -0,0 +1,198 @@
+package fft
+
+import (
+	"math"
+	"unsafe"
+
+	"github.com/cwbudde/algo-fft/internal/fftypes"
+)
+
+// Fixed-point split-complex kernels follow the float split kernels in
+// split.go: an iterative radix-2 DIT transform over bit-reversed input with
+// the same stage-contiguous twiddle layout, and the inverse through the swap
+// identity. Samples are Q15 (int16) or Q31 (int32); products are formed in
+// int64 and rounded back to the sample format.
+//
+// Each stage uses block floating point: before it, the whole block is shifted
+// right by as many bits (0 to 2) as needed to keep the largest magnitude at
+// most fixedHeadroom of full scale. A radix-2 butterfly grows a component by
+// at most 1+√2, so no stage can overflow. The shifts are summed into the
+// exponent the kernels return: result * 2^exponent is the unnormalized
+// transform of the input.
+
+// fixedHeadroom is the largest magnitude, as a fraction of full scale, a
+// stage accepts without shifting; (1+√2)·0.4 < 1 leaves room for rounding.
+const fixedHeadroom = 0.4
+
+// fixedBits returns the number of fractional bits of T: 15 or 31.
+func fixedBits[T fftypes.Fixed]() uint {
+	var zero T
+	return uint(unsafe.Sizeof(zero))*8 - 1
+}
+
+// ComputeFixedTwiddles returns the forward twiddle table of ComputeSplitTwiddles
+// quantized to T, with +1 saturated to the largest representable value.
+func ComputeFixedTwiddles[T fftypes.Fixed](n int) (re, im []T) {
+	if n < 2 {
+		return nil, nil
+	}
+
+	fullScale := math.Ldexp(1, int(fixedBits[T]()))
+	maxValue := fullScale - 1
+
+	quantize := func(x float64) T {
+		return T(max(-fullScale, min(maxValue, math.Round(x*fullScale))))
+	}
+
+	re = make([]T, n-1)
+	im = make([]T, n-1)
+
+	for half := 1; half < n; half <<= 1 {
+		for j := range half {
+			theta := -math.Pi * float64(j) / float64(half)
+			re[half-1+j] = quantize(math.Cos(theta))
+			im[half-1+j] = quantize(math.Sin(theta))
+		}
+	}
+
+	return re, im
+}
+
+// ForwardFixed computes the forward FFT of a power-of-two length
+// split-complex fixed-point signal with per-stage block floating point and
+// returns the exponent: DFT(src) ≈ dst * 2^exponent. dst and src may be the
+// same arrays.
+func ForwardFixed[T fftypes.Fixed](dstRe, dstIm, srcRe, srcIm, twRe, twIm []T, bitrev []int) int {
+	return fixedTransform(dstRe, dstIm, srcRe, srcIm, twRe, twIm, bitrev)
+}
+
+// InverseFixed computes the inverse FFT like ForwardFixed and returns the
+// exponent including the 1/n factor: IDFT(src) ≈ dst * 2^exponent.
+func InverseFixed[T fftypes.Fixed](dstRe, dstIm, srcRe, srcIm, twRe, twIm []T, bitrev []int) int {
+	shifts := fixedTransform(dstIm, dstRe, srcIm, srcRe, twRe, twIm, bitrev)
+
+	return shifts - log2(len(dstRe))
+}
+
+func fixedTransform[T fftypes.Fixed](dstRe, dstIm, srcRe, srcIm, twRe, twIm []T, bitrev []int) int {
+	n := len(dstRe)
+	if n == 0 {
+		return 0
+	}
+
+	dstRe = dstRe[:n]
+	dstIm = dstIm[:n]
+	srcRe = srcRe[:n]
+	srcIm = srcIm[:n]
+	bitrev = bitrev[:n]
+
+	// Bit reversal is an involution, so the in-place case is a set of swaps.
+	if &dstRe[0] == &srcRe[0] && &dstIm[0] == &srcIm[0] {
+		for i, j := range bitrev {
+			if i < j {
+				dstRe[i], dstRe[j] = dstRe[j], dstRe[i]
+				dstIm[i], dstIm[j] = dstIm[j], dstIm[i]
+			}
+		}
+	} else {
+		for i, j := range bitrev {
+			dstRe[i] = srcRe[j]
+			dstIm[i] = srcIm[j]
+		}
+	}
+
+	fracBits := fixedBits[T]()
+	limit := int64(fixedHeadroom * math.Ldexp(1, int(fracBits)))
+
+	peak := max(fixedPeak(dstRe), fixedPeak(dstIm))
+	exponent := 0
+
+	for half := 1; half < n; half <<= 1 {
+		shift := fixedShift(peak, limit)
+		exponent += int(shift)
+
+		wRe := twRe[half-1 : 2*half-1]
+		wIm := twIm[half-1 : 2*half-1]
+		peak = 0
+
+		for base := 0; base < n; base += 2 * half {
+			peak = max(peak, fixedButterfly(
+				dstRe[base:base+half], dstIm[base:base+half],
+				dstRe[base+half:base+2*half], dstIm[base+half:base+2*half],
+				wRe, wIm, shift, fracBits,
+			))
+		}
+	}
+
+	return exponent
+}
+
+// fixedButterfly runs one block of radix-2 butterflies on inputs shifted
+// right by shift bits and returns the largest output magnitude.
+func fixedButterfly[T fftypes.Fixed](aRe, aIm, bRe, bIm, wRe, wIm []T, shift, fracBits uint) int64 {
+	n := len(aRe)
+	aIm = aIm[:n]
+	bRe = bRe[:n]
+	bIm = bIm[:n]
+	wRe = wRe[:n]
+	wIm = wIm[:n]
+
+	round := int64(1) << (fracBits - 1)
+
+	var peak int64
+
+	for j := range n {
+		ar := roundShift(int64(aRe[j]), shift)
+		ai := roundShift(int64(aIm[j]), shift)
+		br := roundShift(int64(bRe[j]), shift)
+		bi := roundShift(int64(bIm[j]), shift)
+		wr, wi := int64(wRe[j]), int64(wIm[j])
+
+		tr := (br*wr - bi*wi + round) >> fracBits
+		ti := (br*wi + bi*wr + round) >> fracBits
+
+		aRe[j], aIm[j] = T(ar+tr), T(ai+ti)
+		bRe[j], bIm[j] = T(ar-tr), T(ai-ti)
+
+		peak = max(peak, abs64(ar+tr), abs64(ai+ti), abs64(ar-tr), abs64(ai-ti))
+	}
+
+	return peak
+}
+
+// fixedShift returns the smallest shift (at most 2) that brings peak to limit.
+func fixedShift(peak, limit int64) uint {
+	var shift uint
+	for shift < 2 && roundShift(peak, shift) > limit {
+		shift++
+	}
+
+	return shift
+}
+
+// fixedPeak returns the largest magnitude in x.
+func fixedPeak[T fftypes.Fixed](x []T) int64 {
+	var peak int64
+	for _, v := range x {
+		peak = max(peak, abs64(int64(v)))
+	}
+
+	return peak
+}
+
+// roundShift shifts x right by shift bits, rounding half up.
+func roundShift(x int64, shift uint) int64 {
+	if shift == 0 {
+		return x
+	}
+
+	return (x + 1<<(shift-1)) >> shift
+}
+
+func abs64(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,149 @@
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	mathpkg "github.com/cwbudde/algo-fft/internal/math"
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+// fixedSignal returns a deterministic test signal with components in
+// [-amplitude, amplitude].
+func fixedSignal(n int, amplitude float64) (re, im []float64) {
+	re = make([]float64, n)
+	im = make([]float64, n)
+
+	for i := range n {
+		re[i] = amplitude * math.Sin(float64(i)*0.37+0.2)
+		im[i] = amplitude * math.Cos(float64(i)*1.21)
+	}
+
+	return re, im
+}
+
+// fixedError returns the largest error of dst * 2^exponent against the
+// reference spectrum, relative to its largest bin.
+func fixedError[T int16 | int32](dstRe, dstIm []T, exponent int, want []complex128) float64 {
+	var maxErr, maxRef float64
+
+	for k, w := range want {
+		got := complex(math.Ldexp(float64(dstRe[k]), exponent), math.Ldexp(float64(dstIm[k]), exponent))
+		maxErr = max(maxErr, cmplx.Abs(got-w))
+		maxRef = max(maxRef, cmplx.Abs(w))
+	}
+
+	return maxErr / maxRef
+}
+
+func TestFixedMatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 8, 64, 1024} {
+		re, im := fixedSignal(n, 30000)
+
+		srcRe := make([]int16, n)
+		srcIm := make([]int16, n)
+		src := make([]complex128, n)
+
+		for i := range n {
+			srcRe[i], srcIm[i] = int16(re[i]), int16(im[i])
+			src[i] = complex(float64(srcRe[i]), float64(srcIm[i]))
+		}
+
+		twRe, twIm := ComputeFixedTwiddles[int16](n)
+		bitrev := mathpkg.ComputeBitReversalIndices(n)
+
+		dstRe := make([]int16, n)
+		dstIm := make([]int16, n)
+		exponent := ForwardFixed(dstRe, dstIm, srcRe, srcIm, twRe, twIm, bitrev)
+
+		// Each shifted bit costs one bit of the 15-bit mantissa.
+		if err := fixedError(dstRe, dstIm, exponent, reference.NaiveDFT128(src)); err > 2e-3 {
+			t.Errorf("n=%d: forward error %g (exponent %d)", n, err, exponent)
+		}
+
+		// The inverse sees the forward mantissas, so the exponents add up.
+		// Rounding noise of 2*log2(n) Q15 stages approaches 1% at n=1024.
+		exponent += InverseFixed(dstRe, dstIm, dstRe, dstIm, twRe, twIm, bitrev)
+
+		if err := fixedError(dstRe, dstIm, exponent, src); err > 3e-2 {
+			t.Errorf("n=%d: round-trip error %g (exponent %d)", n, err, exponent)
+		}
+	}
+}
+
+func TestFixedQ31Precision(t *testing.T) {
+	t.Parallel()
+
+	const n = 256
+
+	re, im := fixedSignal(n, 2e9)
+
+	srcRe := make([]int32, n)
+	srcIm := make([]int32, n)
+	src := make([]complex128, n)
+
+	for i := range n {
+		srcRe[i], srcIm[i] = int32(re[i]), int32(im[i])
+		src[i] = complex(float64(srcRe[i]), float64(srcIm[i]))
+	}
+
+	twRe, twIm := ComputeFixedTwiddles[int32](n)
+	dstRe := make([]int32, n)
+	dstIm := make([]int32, n)
+	exponent := ForwardFixed(dstRe, dstIm, srcRe, srcIm, twRe, twIm, mathpkg.ComputeBitReversalIndices(n))
+
+	if err := fixedError(dstRe, dstIm, exponent, reference.NaiveDFT128(src)); err > 1e-7 {
+		t.Errorf("forward error %g (exponent %d)", err, exponent)
+	}
+}
+
+// TestFixedNoOverflow drives every stage with full-scale inputs that add
+// coherently, the worst case for growth.
+func TestFixedNoOverflow(t *testing.T) {
+	t.Parallel()
+
+	const n = 512
+
+	twRe, twIm := ComputeFixedTwiddles[int16](n)
+	bitrev := mathpkg.ComputeBitReversalIndices(n)
+
+	for _, value := range []int16{math.MinInt16, math.MaxInt16} {
+		re := make([]int16, n)
+		im := make([]int16, n)
+
+		for i := range n {
+			re[i], im[i] = value, value
+		}
+
+		exponent := ForwardFixed(re, im, re, im, twRe, twIm, bitrev)
+
+		// DC holds n*value; all other bins are zero.
+		dc := math.Ldexp(float64(re[0]), exponent)
+		if want := float64(n) * float64(value); math.Abs(dc-want) > math.Abs(want)*1e-3 || re[0] == 0 {
+			t.Errorf("value %d: DC = %v (raw %d, exponent %d), want %v", value, dc, re[0], exponent, want)
+		}
+
+		for k := 1; k < n; k++ {
+			if abs64(int64(re[k])) > 2 || abs64(int64(im[k])) > 2 {
+				t.Fatalf("value %d: bin %d = (%d, %d), want ~0", value, k, re[k], im[k])
+			}
+		}
+	}
+}
+
+func TestFixedTwiddles(t *testing.T) {
+	t.Parallel()
+
+	re, im := ComputeFixedTwiddles[int16](8)
+	if re[0] != math.MaxInt16 || im[0] != 0 {
+		t.Errorf("W^0 = (%d, %d), want (%d, 0)", re[0], im[0], math.MaxInt16)
+	}
+
+	// W_4^1 = -i
+	if re[2] != 0 || im[2] != math.MinInt16 {
+		t.Errorf("W_4^1 = (%d, %d), want (0, %d)", re[2], im[2], math.MinInt16)
+	}
+}
@@ -27,6 +27,18 @@ var (
 	forwardStockhamComplex128 = kernels.ForwardStockhamComplex128
 	inverseStockhamComplex128 = kernels.InverseStockhamComplex128
 
+	// Portable radix-8/4 Stockham kernels.
+	forwardStockhamPortableComplex64  = kernels.ForwardStockhamPortableComplex64
+	inverseStockhamPortableComplex64  = kernels.InverseStockhamPortableComplex64
+	forwardStockhamPortableComplex128 = kernels.ForwardStockhamPortableComplex128
+	inverseStockhamPortableComplex128 = kernels.InverseStockhamPortableComplex128
+
+	// Split-radix kernels.
+	forwardSplitRadixComplex64  = kernels.ForwardSplitRadixComplex64
+	inverseSplitRadixComplex64  = kernels.InverseSplitRadixComplex64
+	forwardSplitRadixComplex128 = kernels.ForwardSplitRadixComplex128
+	inverseSplitRadixComplex128 = kernels.InverseSplitRadixComplex128
+
 	// Packed Stockham kernels.
 	StockhamPackedAvailable = transform.StockhamPackedAvailable
 
@@ -26,6 +26,10 @@ func autoKernelComplex64(strategy KernelStrategy) Kernels[complex64] {
 	return Kernels[complex64]{
 		Forward: func(dst, src, twiddle, scratch []complex64) bool {
 			if !m.IsPowerOf2(len(src)) {
+				if strategy == KernelPFA && forwardPFAComplex64(dst, src, twiddle, scratch) {
+					return true
+				}
+
 				if m.IsHighlyComposite(len(src)) {
 					return forwardMixedRadixComplex64(dst, src, twiddle, scratch)
 				}
@@ -37,17 +41,23 @@ func autoKernelComplex64(strategy KernelStrategy) Kernels[complex64] {
 			case KernelDIT:
 				return forwardDITComplex64(dst, src, twiddle, scratch)
 			case KernelStockham:
-				return forwardStockhamComplex64(dst, src, twiddle, scratch)
+				return forwardStockhamPortableComplex64(dst, src, twiddle, scratch)
+			case KernelSplitRadix:
+				return forwardSplitRadixComplex64(dst, src, twiddle, scratch)
 			case KernelSixStep:
 				return kernels.ForwardSixStepComplex64(dst, src, twiddle, scratch)
 			case KernelEightStep:
 				return kernels.ForwardEightStepComplex64(dst, src, twiddle, scratch)
 			default:
-				return forwardStockhamComplex64(dst, src, twiddle, scratch)
+				return forwardStockhamPortableComplex64(dst, src, twiddle, scratch)
 			}
 		},
 		Inverse: func(dst, src, twiddle, scratch []complex64) bool {
 			if !m.IsPowerOf2(len(src)) {
+				if strategy == KernelPFA && inversePFAComplex64(dst, src, twiddle, scratch) {
+					return true
+				}
+
 				if m.IsHighlyComposite(len(src)) {
 					return inverseMixedRadixComplex64(dst, src, twiddle, scratch)
 				}
@@ -59,13 +69,15 @@ func autoKernelComplex64(strategy KernelStrategy) Kernels[complex64] {
 			case KernelDIT:
 				return inverseDITComplex64(dst, src, twiddle, scratch)
 			case KernelStockham:
-				return inverseStockhamComplex64(dst, src, twiddle, scratch)
+				return inverseStockhamPortableComplex64(dst, src, twiddle, scratch)
+			case KernelSplitRadix:
+				return inverseSplitRadixComplex64(dst, src, twiddle, scratch)
 			case KernelSixStep:
 				return kernels.InverseSixStepComplex64(dst, src, twiddle, scratch)
 			case KernelEightStep:
 				return kernels.InverseEightStepComplex64(dst, src, twiddle, scratch)
 			default:
-				return inverseStockhamComplex64(dst, src, twiddle, scratch)
+				return inverseStockhamPortableComplex64(dst, src, twiddle, scratch)
 			}
 		},
 	}
@@ -75,6 +87,10 @@ func autoKernelComplex128(strategy KernelStrategy) Kernels[complex128] {
 	return Kernels[complex128]{
 		Forward: func(dst, src, twiddle, scratch []complex128) bool {
 			if !m.IsPowerOf2(len(src)) {
+				if strategy == KernelPFA && forwardPFAComplex128(dst, src, twiddle, scratch) {
+					return true
+				}
+
 				if m.IsHighlyComposite(len(src)) {
 					return forwardMixedRadixComplex128(dst, src, twiddle, scratch)
 				}
@@ -86,17 +102,23 @@ func autoKernelComplex128(strategy KernelStrategy) Kernels[complex128] {
 			case KernelDIT:
 				return forwardDITComplex128(dst, src, twiddle, scratch)
 			case KernelStockham:
-				return forwardStockhamComplex128(dst, src, twiddle, scratch)
+				return forwardStockhamPortableComplex128(dst, src, twiddle, scratch)
+			case KernelSplitRadix:
+				return forwardSplitRadixComplex128(dst, src, twiddle, scratch)
 			case KernelSixStep:
 				return kernels.ForwardSixStepComplex128(dst, src, twiddle, scratch)
 			case KernelEightStep:
 				return kernels.ForwardEightStepComplex128(dst, src, twiddle, scratch)
 			default:
-				return forwardStockhamComplex128(dst, src, twiddle, scratch)
+				return forwardStockhamPortableComplex128(dst, src, twiddle, scratch)
 			}
 		},
 		Inverse: func(dst, src, twiddle, scratch []complex128) bool {
 			if !m.IsPowerOf2(len(src)) {
+				if strategy == KernelPFA && inversePFAComplex128(dst, src, twiddle, scratch) {
+					return true
+				}
+
 				if m.IsHighlyComposite(len(src)) {
 					return inverseMixedRadixComplex128(dst, src, twiddle, scratch)
 				}
@@ -108,13 +130,15 @@ func autoKernelComplex128(strategy KernelStrategy) Kernels[complex128] {
 			case KernelDIT:
 				return inverseDITComplex128(dst, src, twiddle, scratch)
 			case KernelStockham:
-				return inverseStockhamComplex128(dst, src, twiddle, scratch)
+				return inverseStockhamPortableComplex128(dst, src, twiddle, scratch)
+			case KernelSplitRadix:
+				return inverseSplitRadixComplex128(dst, src, twiddle, scratch)
 			case KernelSixStep:
 				return kernels.InverseSixStepComplex128(dst, src, twiddle, scratch)
 			case KernelEightStep:
 				return kernels.InverseEightStepComplex128(dst, src, twiddle, scratch)
 			default:
-				return inverseStockhamComplex128(dst, src, twiddle, scratch)
+				return inverseStockhamPortableComplex128(dst, src, twiddle, scratch)
 			}
 		},
 	}
@@ -1,6 +1,7 @@
 package fft
 
 import (
+	"math/cmplx"
 	"testing"
 
 	"github.com/cwbudde/algo-fft/internal/cpu"
@@ -166,7 +167,7 @@ func TestAutoKernelComplex64_NonComposite(t *testing.T) {
 	t.Parallel()
 
 	// Prime numbers and non-highly-composite non-power-of-2 sizes
-	sizes := []int{7, 11, 13, 17}
+	sizes := []int{17, 19, 23, 34}
 
 	for _, size := range sizes {
 		t.Run("NonComposite_"+string(rune(size)), func(t *testing.T) {
@@ -247,7 +248,7 @@ func TestAutoKernelComplex128(t *testing.T) {
 func TestAutoKernelComplex128_NonComposite(t *testing.T) {
 	t.Parallel()
 
-	size := 7 // Prime number
+	size := 17 // Prime number
 
 	input := make([]complex128, size)
 	for i := range input {
@@ -263,12 +264,12 @@ func TestAutoKernelComplex128_NonComposite(t *testing.T) {
 	// Should fail for prime size
 	ok := kernels.Forward(output, input, twiddle, scratch)
 	if ok {
-		t.Error("autoKernelComplex128 should fail for prime size 7, but succeeded")
+		t.Error("autoKernelComplex128 should fail for prime size 17, but succeeded")
 	}
 
 	ok = kernels.Inverse(output, input, twiddle, scratch)
 	if ok {
-		t.Error("autoKernelComplex128 inverse should fail for prime size 7, but succeeded")
+		t.Error("autoKernelComplex128 inverse should fail for prime size 17, but succeeded")
 	}
 }
 
@@ -293,6 +294,7 @@ func TestAutoKernel_StrategySelection(t *testing.T) {
 		{"Auto_Large_Should_Use_Stockham", 2048, planner.KernelAuto, false},
 		{"Forced_DIT", 2048, planner.KernelDIT, true},
 		{"Forced_Stockham", 512, planner.KernelStockham, false},
+		{"Forced_SplitRadix", 4096, planner.KernelSplitRadix, false},
 	}
 
 	for _, tt := range tests {
@@ -320,3 +322,44 @@ func TestAutoKernel_StrategySelection(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectKernelsWithStrategy_SplitRadix(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{ForceGeneric: true}
+
+	for _, n := range []int{8, 256, 4096} {
+		src := make([]complex128, n)
+		for i := range src {
+			src[i] = complex(float64(i%7), float64(i%5)-2)
+		}
+
+		twiddle := mathpkg.ComputeTwiddleFactors[complex128](n)
+		scratch := make([]complex128, n)
+		want := make([]complex128, n)
+		got := make([]complex128, n)
+
+		dit := SelectKernelsWithStrategy[complex128](features, KernelDIT)
+		split := SelectKernelsWithStrategy[complex128](features, KernelSplitRadix)
+
+		if !dit.Forward(want, src, twiddle, scratch) || !split.Forward(got, src, twiddle, scratch) {
+			t.Fatalf("n=%d: Forward failed", n)
+		}
+
+		for i := range want {
+			if cmplx.Abs(got[i]-want[i]) > 1e-9*float64(n) {
+				t.Fatalf("n=%d: split radix [%d] = %v, want %v", n, i, got[i], want[i])
+			}
+		}
+
+		if !split.Inverse(got, got, twiddle, scratch) {
+			t.Fatalf("n=%d: Inverse failed", n)
+		}
+
+		for i := range src {
+			if cmplx.Abs(got[i]-src[i]) > 1e-12*float64(n) {
+				t.Fatalf("n=%d: round trip [%d] = %v, want %v", n, i, got[i], src[i])
+			}
+		}
+	}
+}
@@ -16,7 +16,7 @@ type PlannerMode uint8
 
 const (
 	PlannerEstimate   PlannerMode = iota // Use heuristics only (fast, no benchmarking)
-	PlannerMeasure                       // Quick benchmark: test DIT and Stockham
+	PlannerMeasure                       // Quick benchmark: test DIT, Stockham and split radix
 	PlannerPatient                       // Moderate benchmark: test common strategies
 	PlannerExhaustive                    // Thorough benchmark: test all strategies
 )
@@ -66,19 +66,26 @@ func selectStrategiesToTest(mode PlannerMode, n int) []KernelStrategy {
 		return []KernelStrategy{KernelBluestein}
 	}
 
+	// Other sizes run mixed radix under every strategy; coprime
+	// factorizations can also use the prime-factor algorithm
+	if !m.IsPowerOf2(n) && PFAApplicable(n) {
+		return []KernelStrategy{KernelDIT, KernelPFA}
+	}
+
 	switch mode {
 	case PlannerEstimate:
 		// Estimate mode doesn't benchmark, but return default if called
 		return []KernelStrategy{KernelDIT, KernelStockham}
 	case PlannerMeasure:
-		// Quick: test the two most common strategies
-		return []KernelStrategy{KernelDIT, KernelStockham}
+		// Quick: test the common strategies and split radix, which is the
+		// fastest portable kernel for large sizes
+		return []KernelStrategy{KernelDIT, KernelStockham, KernelSplitRadix}
 	case PlannerPatient:
 		// Moderate: add SixStep for larger sizes
-		return []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep}
+		return []KernelStrategy{KernelDIT, KernelStockham, KernelSplitRadix, KernelSixStep}
 	case PlannerExhaustive:
 		// Thorough: test all power-of-two strategies
-		return []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep, KernelEightStep}
+		return []KernelStrategy{KernelDIT, KernelStockham, KernelSplitRadix, KernelSixStep, KernelEightStep}
 	}
 
 	return []KernelStrategy{KernelDIT, KernelStockham}
@@ -140,6 +147,12 @@ func MeasureAndSelect[T Complex](
 	return estimateWithStrategy[T](n, features, best.Strategy)
 }
 
+// RecordWisdom stores a planning decision made outside MeasureAndSelect,
+// e.g. the Rader/Bluestein comparison run by the root package.
+func RecordWisdom[T Complex](n int, features cpu.Features, wisdom WisdomRecorder, algorithm string) {
+	recordToWisdom[T](n, features, wisdom, algorithm)
+}
+
 func recordToWisdom[T Complex](n int, features cpu.Features, wisdom WisdomRecorder, algorithm string) {
 	if wisdom == nil {
 		return
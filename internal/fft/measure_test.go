@@ -39,19 +39,19 @@ func TestSelectStrategiesToTest(t *testing.T) {
 			name:     "Measure mode power-of-two",
 			mode:     PlannerMeasure,
 			n:        1024,
-			expected: []KernelStrategy{KernelDIT, KernelStockham},
+			expected: []KernelStrategy{KernelDIT, KernelStockham, KernelSplitRadix},
 		},
 		{
 			name:     "Patient mode power-of-two",
 			mode:     PlannerPatient,
 			n:        1024,
-			expected: []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep},
+			expected: []KernelStrategy{KernelDIT, KernelStockham, KernelSplitRadix, KernelSixStep},
 		},
 		{
 			name:     "Exhaustive mode power-of-two",
 			mode:     PlannerExhaustive,
 			n:        1024,
-			expected: []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep, KernelEightStep},
+			expected: []KernelStrategy{KernelDIT, KernelStockham, KernelSplitRadix, KernelSixStep, KernelEightStep},
 		},
 		{
 			name:     "Prime size uses Bluestein only",
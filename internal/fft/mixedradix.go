@@ -21,28 +21,126 @@ func inverseMixedRadixComplex128(dst, src, twiddle, scratch []complex128) bool {
 }
 
 func mixedRadixForward[T Complex](dst, src, twiddle, scratch []T) bool {
-	return mixedRadixTransform(dst, src, twiddle, scratch, false)
+	return mixedRadixTransform(dst, src, twiddle, scratch, nil, false, 1)
 }
 
 func mixedRadixInverse[T Complex](dst, src, twiddle, scratch []T) bool {
-	return mixedRadixTransform(dst, src, twiddle, scratch, true)
+	return mixedRadixTransform(dst, src, twiddle, scratch, nil, true, 1)
+}
+
+// MixedRadixForward computes a forward mixed-radix FFT with an explicit stage
+// schedule, as returned by MixedRadixSchedule. A nil schedule selects the
+// default decomposition. scratch needs MixedRadixScratchSize elements; a
+// schedule that ends in a codelet fails with less. The output is multiplied
+// by scale in the kernel's final pass.
+func MixedRadixForward[T Complex](dst, src, twiddle, scratch []T, radices []int, scale float64) bool {
+	return mixedRadixTransform(dst, src, twiddle, scratch, radices, false, scale)
+}
+
+// MixedRadixInverse is the inverse counterpart of MixedRadixForward. scale
+// is applied on top of the built-in 1/N, in the same pass.
+func MixedRadixInverse[T Complex](dst, src, twiddle, scratch []T, radices []int, scale float64) bool {
+	return mixedRadixTransform(dst, src, twiddle, scratch, radices, true, scale)
+}
+
+// MixedRadixSchedule returns the stage radices for a mixed-radix FFT of size
+// n. Supported radices from hints (2, 3, 4, 5, 7, 11 and 13) are taken first,
+// in order and for as long as each divides the remaining size; the rest of
+// the schedule is the default decomposition. Returns nil if n cannot be
+// decomposed. The result is owned by the caller.
+func MixedRadixSchedule[T Complex](n int, hints []int) []int {
+	var zero T
+
+	_, is128 := any(zero).(complex128)
+
+	if len(hints) == 0 {
+		if n < 2 {
+			return nil
+		}
+
+		return defaultMixedRadixSchedule(n, is128)
+	}
+
+	var schedule []int
+
+	for _, r := range hints {
+		if !mixedRadixSupported(r) {
+			continue
+		}
+
+		for n > 1 && n%r == 0 && len(schedule) < mixedRadixMaxStages {
+			schedule = append(schedule, r)
+			n /= r
+		}
+	}
+
+	if n == 1 {
+		return schedule
+	}
+
+	var radices [mixedRadixMaxStages]int
+
+	hasCodelet := func(size int) bool { return mixedRadixLeafCodelet(size, is128) }
+
+	count := mixedRadixSchedule(n, &radices, hasCodelet)
+	if count == 0 || len(schedule)+count > mixedRadixMaxStages {
+		return nil
+	}
+
+	return append(schedule, radices[:count]...)
+}
+
+// mixedRadixProduct returns the transform size covered by a schedule.
+func mixedRadixProduct(radices []int) int {
+	product := 1
+	for _, r := range radices {
+		product *= r
+	}
+
+	return product
+}
+
+// mixedRadixSupported reports whether the ping-pong recursion has a butterfly
+// for radix r.
+func mixedRadixSupported(r int) bool {
+	switch r {
+	case 2, 3, 4, 5, 7, 11, 13:
+		return true
+	default:
+		return false
+	}
 }
 
 // Recursion hooks for SIMD acceleration.
 // By default, these point to the pure Go implementations.
 // SIMD-optimized files (like mixedradix_avx2.go) can override these in init().
+// leaf is the mixedRadixLeafBuffers area of the transform, or nil.
 var (
-	recursiveStep64  func(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse bool)
-	recursiveStep128 func(dst, src, work []complex128, n, stride, step int, radices []int, twiddle []complex128, inverse bool)
+	recursiveStep64  func(dst, src, work []complex64, n, stride, step int, radices []int, twiddle, leaf []complex64, inverse bool)
+	recursiveStep128 func(dst, src, work []complex128, n, stride, step int, radices []int, twiddle, leaf []complex128, inverse bool)
 )
 
+// mixedRadixLeafCodelet reports whether the recursion hooks run a registered
+// codelet for sub-transforms of size n instead of recursing, which also lets
+// the scheduler end a schedule with such a codelet. The pure Go recursion
+// only has radix-2..5, 7, 11 and 13 butterflies, so by default no codelet is
+// used; SIMD hooks that dispatch codelets override it.
+//
+//nolint:gochecknoglobals
+var mixedRadixLeafCodelet = func(n int, is128 bool) bool { return false }
+
 //nolint:gochecknoinits
 func init() {
 	recursiveStep64 = mixedRadixRecursivePingPongComplex64
 	recursiveStep128 = mixedRadixRecursivePingPongComplex128
 }
 
-func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, inverse bool) bool {
+// mixedRadixTransform runs the ping-pong recursion with the given stage
+// schedule, or the default schedule for len(src) if radices is nil. Plans
+// pass the schedule they computed at construction; only direct kernel calls
+// derive it per transform. The output is multiplied by scale (and 1/N for the
+// inverse) while it is copied back or in a single pass over dst.
+func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, radices []int, inverse bool, scale float64) bool {
 	n := len(src)
 	if n == 0 {
 		return true
@@ -57,25 +155,29 @@ func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, inverse bool
 		return true
 	}
 
-	var (
-		radices    [mixedRadixMaxStages]int
-		hasCodelet func(int) bool
-		zero       T
-	)
+	var zero T
 
-	// Determine which registry to check based on type T
+	_, is128 := any(zero).(complex128)
 
-	switch any(zero).(type) {
-	case complex64:
-		hasCodelet = kernels.Registry64.Has
-	case complex128:
-		hasCodelet = kernels.Registry128.Has
-	default:
-		hasCodelet = func(int) bool { return false }
+	if radices == nil {
+		radices = defaultMixedRadixSchedule(n, is128)
+		if radices == nil {
+			return false
+		}
+
+		// Without room for the leaf buffers, recurse down to butterflies.
+		if len(scratch) < MixedRadixScratchSize[T](n, radices) {
+			radices = mixedRadixButterflySchedule(n)
+			if radices == nil {
+				return false
+			}
+		}
+	} else if mixedRadixProduct(radices) != n {
+		return false
 	}
 
-	stageCount := mixedRadixSchedule(n, &radices, hasCodelet)
-	if stageCount == 0 {
+	leaf := mixedRadixLeafBuffers(scratch, twiddle, n, radices, is128)
+	if leaf == nil && !mixedRadixButterfliesOnly(radices) {
 		return false
 	}
 
@@ -94,8 +196,9 @@ func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, inverse bool
 			any(work).([]complex64),    //nolint:forcetypeassert
 			any(src).([]complex64),     //nolint:forcetypeassert
 			any(scratch).([]complex64), //nolint:forcetypeassert
-			n, 1, 1, radices[:stageCount],
+			n, 1, 1, radices,
 			any(twiddle).([]complex64), //nolint:forcetypeassert
+			any(leaf).([]complex64),    //nolint:forcetypeassert
 			inverse,
 		)
 	case complex128:
@@ -103,28 +206,137 @@ func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, inverse bool
 			any(work).([]complex128),    //nolint:forcetypeassert
 			any(src).([]complex128),     //nolint:forcetypeassert
 			any(scratch).([]complex128), //nolint:forcetypeassert
-			n, 1, 1, radices[:stageCount],
+			n, 1, 1, radices,
 			any(twiddle).([]complex128), //nolint:forcetypeassert
+			any(leaf).([]complex128),    //nolint:forcetypeassert
 			inverse,
 		)
 	default:
 		return false
 	}
 
-	if !workIsDst {
-		copy(dst, work)
+	if inverse {
+		scale /= float64(n)
 	}
 
-	if inverse {
-		scale := complexFromFloat64[T](1.0/float64(n), 0)
-		for i := range dst {
-			dst[i] *= scale
+	finishScaled(dst[:n], work[:n], workIsDst, scale)
+
+	return true
+}
+
+// MixedRadixScratchSize returns the scratch length a mixed-radix transform
+// of size n with the given schedule (nil for the default) needs: n for the
+// ping-pong recursion plus twice the size of a codelet leaf, which holds the
+// leaf's twiddles and the codelet's own scratch.
+func MixedRadixScratchSize[T Complex](n int, radices []int) int {
+	var zero T
+
+	_, is128 := any(zero).(complex128)
+
+	if radices == nil {
+		radices = defaultMixedRadixSchedule(n, is128)
+	}
+
+	return n + 2*mixedRadixLeafSize(n, radices, is128)
+}
+
+// mixedRadixLeafSize returns the size of the sub-transforms the recursion
+// hooks hand to a codelet, the first one top-down for which
+// mixedRadixLeafCodelet holds, or 0 if the schedule recurses down to
+// butterflies.
+func mixedRadixLeafSize(n int, radices []int, is128 bool) int {
+	for _, r := range radices {
+		if n > 1 && mixedRadixLeafCodelet(n, is128) {
+			return n
+		}
+
+		n /= r
+	}
+
+	return 0
+}
+
+// mixedRadixLeafBuffers carves the codelet leaf area from scratch[n:]: the
+// leaf's twiddles, gathered once per transform, followed by the codelet's
+// scratch. Returns nil if the schedule has no codelet leaf or scratch is too
+// short.
+func mixedRadixLeafBuffers[T Complex](scratch, twiddle []T, n int, radices []int, is128 bool) []T {
+	size := mixedRadixLeafSize(n, radices, is128)
+	if size == 0 || len(scratch) < n+2*size {
+		return nil
+	}
+
+	leaf := scratch[n : n+2*size]
+
+	step := n / size
+	for i := range size {
+		leaf[i] = twiddle[i*step]
+	}
+
+	return leaf
+}
+
+// mixedRadixButterfliesOnly reports whether every stage of a schedule has a
+// butterfly in the pure Go recursion, so it runs without codelet leaves.
+func mixedRadixButterfliesOnly(radices []int) bool {
+	for _, r := range radices {
+		if !mixedRadixSupported(r) {
+			return false
 		}
 	}
 
 	return true
 }
 
+// mixedRadixButterflySchedule returns the default schedule for n without
+// codelet stages.
+func mixedRadixButterflySchedule(n int) []int {
+	var radices [mixedRadixMaxStages]int
+
+	count := mixedRadixSchedule(n, &radices, func(int) bool { return false })
+	if count == 0 {
+		return nil
+	}
+
+	return append([]int(nil), radices[:count]...)
+}
+
+// finishScaled moves a kernel result from work into dst (unless it is
+// already there) and multiplies it by scale, touching each element once.
+func finishScaled[T Complex](dst, work []T, workIsDst bool, scale float64) {
+	switch {
+	case scale == 1:
+		if !workIsDst {
+			copy(dst, work)
+		}
+	case workIsDst:
+		factor := complexFromFloat64[T](scale, 0)
+		for i := range dst {
+			dst[i] *= factor
+		}
+	default:
+		factor := complexFromFloat64[T](scale, 0)
+		for i, v := range work {
+			dst[i] = v * factor
+		}
+	}
+}
+
+// defaultMixedRadixSchedule returns the default radix schedule for n, or nil
+// if n cannot be decomposed.
+func defaultMixedRadixSchedule(n int, is128 bool) []int {
+	hasCodelet := func(size int) bool { return mixedRadixLeafCodelet(size, is128) }
+
+	var radices [mixedRadixMaxStages]int
+
+	count := mixedRadixSchedule(n, &radices, hasCodelet)
+	if count == 0 {
+		return nil
+	}
+
+	return append([]int(nil), radices[:count]...)
+}
+
 func mixedRadixSchedule(n int, radices *[mixedRadixMaxStages]int, hasCodelet func(int) bool) int {
 	if n < 2 {
 		return 0
@@ -162,6 +374,15 @@ func mixedRadixSchedule(n int, radices *[mixedRadixMaxStages]int, hasCodelet fun
 		}
 
 		switch {
+		case n%13 == 0:
+			radices[count] = 13
+			n /= 13
+		case n%11 == 0:
+			radices[count] = 11
+			n /= 11
+		case n%7 == 0:
+			radices[count] = 7
+			n /= 7
 		case n%5 == 0:
 			radices[count] = 5
 			n /= 5
@@ -226,7 +447,7 @@ func mixedRadixPermutation(x, n int, radices []int, count int) int {
 
 // mixedRadixRecursivePingPongComplex64 is a specialized complex64 version that calls
 // type-specific butterfly functions to avoid generic overhead.
-func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse bool) {
+func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride, step int, radices []int, twiddle, leaf []complex64, inverse bool) {
 	if n == 1 {
 		dst[0] = src[0]
 		return
@@ -244,7 +465,7 @@ func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride,
 				dst[j*span+i] = src[j*stride+i*stride]
 			}
 		} else {
-			recursiveStep64(work[j*span:], src[j*stride:], dst[j*span:], span, stride*radix, step*radix, nextRadices, twiddle, inverse)
+			recursiveStep64(work[j*span:], src[j*stride:], dst[j*span:], span, stride*radix, step*radix, nextRadices, twiddle, leaf, inverse)
 		}
 	}
 
@@ -256,6 +477,13 @@ func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride,
 		input = work
 	}
 
+	// Radix-7, 11 and 13 levels use the folded odd-radix butterflies.
+	if radix > 5 {
+		mixedRadixOddStageComplex64(dst, input, twiddle, radix, span, step, inverse)
+
+		return
+	}
+
 	// Apply radix-r butterfly with type-specific functions
 	for k := range span {
 		switch radix {
@@ -359,7 +587,7 @@ func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride,
 
 // mixedRadixRecursivePingPongComplex128 is a specialized complex128 version that calls
 // type-specific butterfly functions to avoid generic overhead.
-func mixedRadixRecursivePingPongComplex128(dst, src, work []complex128, n, stride, step int, radices []int, twiddle []complex128, inverse bool) {
+func mixedRadixRecursivePingPongComplex128(dst, src, work []complex128, n, stride, step int, radices []int, twiddle, leaf []complex128, inverse bool) {
 	if n == 1 {
 		dst[0] = src[0]
 		return
@@ -377,7 +605,7 @@ func mixedRadixRecursivePingPongComplex128(dst, src, work []complex128, n, strid
 				dst[j*span+i] = src[j*stride+i*stride]
 			}
 		} else {
-			recursiveStep128(work[j*span:], src[j*stride:], dst[j*span:], span, stride*radix, step*radix, nextRadices, twiddle, inverse)
+			recursiveStep128(work[j*span:], src[j*stride:], dst[j*span:], span, stride*radix, step*radix, nextRadices, twiddle, leaf, inverse)
 		}
 	}
 
@@ -389,6 +617,13 @@ func mixedRadixRecursivePingPongComplex128(dst, src, work []complex128, n, strid
 		input = work
 	}
 
+	// Radix-7, 11 and 13 levels use the folded odd-radix butterflies.
+	if radix > 5 {
+		mixedRadixOddStageComplex128(dst, input, twiddle, radix, span, step, inverse)
+
+		return
+	}
+
 	// Apply radix-r butterfly with type-specific functions
 	for k := range span {
 		switch radix {
@@ -534,6 +769,13 @@ func mixedRadixRecursivePingPong[T Complex](dst, src, work []T, n, stride, step
 		input = work
 	}
 
+	// Radix-7, 11 and 13 levels use the folded odd-radix butterflies.
+	if radix > 5 {
+		mixedRadixOddStage(dst, input, twiddle, radix, span, step, inverse)
+
+		return
+	}
+
 	// Apply radix-r butterfly, reading from input and writing to dst
 	for k := range span {
 		switch radix {
@@ -11,122 +11,105 @@ func init() {
 	// Override the recursion hooks with AVX2-aware versions.
 	recursiveStep64 = mixedRadixRecursivePingPongComplex64AVX2
 	recursiveStep128 = mixedRadixRecursivePingPongComplex128AVX2
+	mixedRadixLeafCodelet = hasAVX2LeafCodelet
 }
 
-// mixedRadixRecursivePingPongComplex64AVX2 checks for AVX2 codelets before recursing.
-func mixedRadixRecursivePingPongComplex64AVX2(dst, src, work []complex64, n, stride, step int, radices []int, twiddle []complex64, inverse bool) {
-	// Optimization: check if we have an AVX2 codelet for this sub-transform size.
-	// We only do this for n > 1 (base cases are handled by the pure Go recursion anyway).
-	if n > 1 {
+// hasAVX2LeafCodelet reports whether the AVX2 hooks above will dispatch a
+// codelet of size n instead of recursing.
+func hasAVX2LeafCodelet(n int, is128 bool) bool {
+	features := cpu.DetectFeatures()
+
+	if is128 {
+		entry := kernels.Registry128.Lookup(n, features)
+		return entry != nil && entry.SIMDLevel >= kernels.SIMDAVX2
+	}
+
+	entry := kernels.Registry64.Lookup(n, features)
+
+	return entry != nil && entry.SIMDLevel >= kernels.SIMDAVX2
+}
+
+// mixedRadixRecursivePingPongComplex64AVX2 checks for AVX2 codelets before
+// recursing. The codelet's twiddles and scratch come from leaf, which
+// mixedRadixTransform sizes for exactly this sub-transform size; without it
+// the sub-transform recurses to butterflies.
+func mixedRadixRecursivePingPongComplex64AVX2(dst, src, work []complex64, n, stride, step int, radices []int, twiddle, leaf []complex64, inverse bool) {
+	if n > 1 && len(leaf) == 2*n {
 		features := cpu.DetectFeatures()
 		if entry := kernels.Registry64.Lookup(n, features); entry != nil && entry.SIMDLevel >= kernels.SIMDAVX2 {
-			// Found an AVX2 kernel for this sub-transform!
-
-			// 1. Prepare Input
-			var inputBuf []complex64
-			if stride == 1 {
-				inputBuf = src[:n]
-			} else {
-				// Gather strided input into 'work' buffer (scratch space)
+			// Gather strided input into work, which is free at this level
+			inputBuf := src[:n]
+			if stride != 1 {
 				inputBuf = work[:n]
 				for i := range n {
 					inputBuf[i] = src[i*stride]
 				}
 			}
 
-			// 2. Prepare Twiddles
-			twiddleBuf := make([]complex64, n)
-			for i := range n {
-				twiddleBuf[i] = twiddle[i*step]
-			}
-
-			// 3. Prepare Scratch for Kernel
-			kernelScratch := make([]complex64, n)
-
-			// 4. Call Kernel
-			codeletTwiddle := twiddleBuf
+			codeletTwiddle, kernelScratch := leaf[:n], leaf[n:]
 			if prepared := kernels.GetPreparedTwiddle64(entry, n, inverse); prepared != nil {
 				codeletTwiddle = prepared
 			}
 
-			success := false
-			if inverse {
-				if entry.Inverse != nil {
-					entry.Inverse(dst[:n], inputBuf, codeletTwiddle, kernelScratch)
-					// Undo built-in scaling of the Inverse codelet (1/n)
-					scale := complex64(complex(float32(n), 0))
-					for i := range n {
-						dst[i] *= scale
-					}
-					success = true
-				}
-			} else {
-				if entry.Forward != nil {
-					entry.Forward(dst[:n], inputBuf, codeletTwiddle, kernelScratch)
-					success = true
-				}
+			if !inverse && entry.Forward != nil {
+				entry.Forward(dst[:n], inputBuf, codeletTwiddle, kernelScratch)
+				return
 			}
 
-			if success {
+			if inverse && entry.Inverse != nil {
+				entry.Inverse(dst[:n], inputBuf, codeletTwiddle, kernelScratch)
+
+				// Undo built-in scaling of the Inverse codelet (1/n)
+				scale := complex64(complex(float32(n), 0))
+				for i := range n {
+					dst[i] *= scale
+				}
+
 				return
 			}
 		}
 	}
 
 	// Fallback to pure Go implementation.
-	mixedRadixRecursivePingPongComplex64(dst, src, work, n, stride, step, radices, twiddle, inverse)
+	mixedRadixRecursivePingPongComplex64(dst, src, work, n, stride, step, radices, twiddle, leaf, inverse)
 }
 
 // mixedRadixRecursivePingPongComplex128AVX2 is the complex128 version.
-func mixedRadixRecursivePingPongComplex128AVX2(dst, src, work []complex128, n, stride, step int, radices []int, twiddle []complex128, inverse bool) {
-	if n > 1 {
+func mixedRadixRecursivePingPongComplex128AVX2(dst, src, work []complex128, n, stride, step int, radices []int, twiddle, leaf []complex128, inverse bool) {
+	if n > 1 && len(leaf) == 2*n {
 		features := cpu.DetectFeatures()
 		if entry := kernels.Registry128.Lookup(n, features); entry != nil && entry.SIMDLevel >= kernels.SIMDAVX2 {
-			var inputBuf []complex128
-			if stride == 1 {
-				inputBuf = src[:n]
-			} else {
+			inputBuf := src[:n]
+			if stride != 1 {
 				inputBuf = work[:n]
 				for i := range n {
 					inputBuf[i] = src[i*stride]
 				}
 			}
 
-			twiddleBuf := make([]complex128, n)
-			for i := range n {
-				twiddleBuf[i] = twiddle[i*step]
-			}
-
-			kernelScratch := make([]complex128, n)
-
-			success := false
-			codeletTwiddle := twiddleBuf
+			codeletTwiddle, kernelScratch := leaf[:n], leaf[n:]
 			if prepared := kernels.GetPreparedTwiddle128(entry, n, inverse); prepared != nil {
 				codeletTwiddle = prepared
 			}
 
-			if inverse {
-				if entry.Inverse != nil {
-					entry.Inverse(dst[:n], inputBuf, codeletTwiddle, kernelScratch)
-					// Undo built-in scaling of the Inverse codelet (1/n)
-					scale := complex128(complex(float64(n), 0))
-					for i := range n {
-						dst[i] *= scale
-					}
-					success = true
-				}
-			} else {
-				if entry.Forward != nil {
-					entry.Forward(dst[:n], inputBuf, codeletTwiddle, kernelScratch)
-					success = true
-				}
+			if !inverse && entry.Forward != nil {
+				entry.Forward(dst[:n], inputBuf, codeletTwiddle, kernelScratch)
+				return
 			}
 
-			if success {
+			if inverse && entry.Inverse != nil {
+				entry.Inverse(dst[:n], inputBuf, codeletTwiddle, kernelScratch)
+
+				// Undo built-in scaling of the Inverse codelet (1/n)
+				scale := complex128(complex(float64(n), 0))
+				for i := range n {
+					dst[i] *= scale
+				}
+
 				return
 			}
 		}
 	}
 
-	mixedRadixRecursivePingPongComplex128(dst, src, work, n, stride, step, radices, twiddle, inverse)
+	mixedRadixRecursivePingPongComplex128(dst, src, work, n, stride, step, radices, twiddle, leaf, inverse)
 }
@@ -0,0 +1,103 @@
+package fft
+
+import "github.com/cwbudde/algo-fft/internal/kernels"
+
+// mixedRadixMaxOddRadix bounds the odd radices (7, 11, 13) the ping-pong
+// recursion runs with the kernels' ButterflyOdd functions.
+const mixedRadixMaxOddRadix = 13
+
+// mixedRadixOddStageComplex64 applies one radix-7, 11 or 13 ping-pong level:
+// for every k < span it twiddles input[j*span+k] and writes the butterfly
+// outputs to dst[j*span+k]. input and dst may alias. With AVX2, four
+// consecutive k are transformed at once.
+func mixedRadixOddStageComplex64(dst, input, twiddle []complex64, radix, span, step int, inverse bool) {
+	k := 0
+
+	if span >= 4 && kernels.ButterflyOddAVX2Available() {
+		var a, y [4 * mixedRadixMaxOddRadix]complex64
+
+		for ; k+4 <= span; k += 4 {
+			for j := range radix {
+				row := input[j*span+k : j*span+k+4]
+				for l := range row {
+					w := twiddle[j*(k+l)*step]
+					if inverse {
+						w = conj(w)
+					}
+
+					a[4*j+l] = w * row[l]
+				}
+			}
+
+			kernels.ButterflyOdd4Complex64(y[:], a[:], radix, inverse)
+
+			for j := range radix {
+				copy(dst[j*span+k:j*span+k+4], y[4*j:4*j+4])
+			}
+		}
+	}
+
+	var v [mixedRadixMaxOddRadix]complex64
+
+	for ; k < span; k++ {
+		for j := range radix {
+			w := twiddle[j*k*step]
+			if inverse {
+				w = conj(w)
+			}
+
+			v[j] = w * input[j*span+k]
+		}
+
+		kernels.ButterflyOddComplex64(v[:radix], inverse)
+
+		for j := range radix {
+			dst[j*span+k] = v[j]
+		}
+	}
+}
+
+// mixedRadixOddStageComplex128 is the complex128 variant of
+// mixedRadixOddStageComplex64 (scalar butterflies only).
+func mixedRadixOddStageComplex128(dst, input, twiddle []complex128, radix, span, step int, inverse bool) {
+	var v [mixedRadixMaxOddRadix]complex128
+
+	for k := range span {
+		for j := range radix {
+			w := twiddle[j*k*step]
+			if inverse {
+				w = conj(w)
+			}
+
+			v[j] = w * input[j*span+k]
+		}
+
+		kernels.ButterflyOddComplex128(v[:radix], inverse)
+
+		for j := range radix {
+			dst[j*span+k] = v[j]
+		}
+	}
+}
+
+// mixedRadixOddStage is the generic variant of mixedRadixOddStageComplex64.
+func mixedRadixOddStage[T Complex](dst, input, twiddle []T, radix, span, step int, inverse bool) {
+	var v [mixedRadixMaxOddRadix]T
+
+	for k := range span {
+		for j := range radix {
+			w := twiddle[j*k*step]
+			if inverse {
+				w = conj(w)
+			}
+
+			v[j] = w * input[j*span+k]
+		}
+
+		kernels.ButterflyOdd(v[:radix], inverse)
+
+		for j := range radix {
+			dst[j*span+k] = v[j]
+		}
+	}
+}
@@ -90,3 +90,103 @@ func TestMixedRadixComplex128(t *testing.T) {
 		}
 	}
 }
+
+func TestMixedRadix_CodeletSizedFactors(t *testing.T) {
+	t.Parallel()
+
+	// Each size leaves a codelet-sized factor (32, 128, 384) after the
+	// first radix; the schedule must still decompose it into butterflies
+	// the recursion can run.
+	for _, n := range []int{160, 640, 1536} {
+		src := make([]complex128, n)
+		for i := range src {
+			src[i] = complex(float64(i%7), float64(i%3))
+		}
+
+		twiddle := mathpkg.ComputeTwiddleFactors[complex128](n)
+		dst := make([]complex128, n)
+
+		if !forwardMixedRadixComplex128(dst, src, twiddle, make([]complex128, n)) {
+			t.Fatalf("n=%d: forwardMixedRadixComplex128 failed", n)
+		}
+
+		ref := reference.NaiveDFT128(src)
+		for i := range dst {
+			if cmplx.Abs(dst[i]-ref[i]) > 1e-8 {
+				t.Fatalf("n=%d: mismatch at %d: got %v want %v", n, i, dst[i], ref[i])
+			}
+		}
+	}
+}
+
+func TestMixedRadixSchedule_Hints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n     int
+		hints []int
+		want  []int
+	}{
+		{1001, nil, []int{13, 11, 7}},
+		{60, []int{3, 2}, []int{3, 2, 2, 5}},
+		{60, []int{8, 6}, []int{5, 4, 3}}, // unsupported hints ignored
+		{14, []int{7}, []int{7, 2}},
+		{17, nil, nil},
+		{34, []int{2}, nil},
+	}
+
+	for _, tt := range tests {
+		got := MixedRadixSchedule[complex128](tt.n, tt.hints)
+		if len(got) != len(tt.want) {
+			t.Errorf("MixedRadixSchedule(%d, %v) = %v, want %v", tt.n, tt.hints, got, tt.want)
+			continue
+		}
+
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("MixedRadixSchedule(%d, %v) = %v, want %v", tt.n, tt.hints, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestMixedRadixForward_ExplicitSchedule(t *testing.T) {
+	t.Parallel()
+
+	const n = 2002
+
+	src := make([]complex128, n)
+	for i := range src {
+		src[i] = complex(float64(i%11), float64(i%13))
+	}
+
+	twiddle := mathpkg.ComputeTwiddleFactors[complex128](n)
+	scratch := make([]complex128, n)
+	dst := make([]complex128, n)
+
+	if MixedRadixForward(dst, src, twiddle, scratch, []int{2, 7, 11}, 1) {
+		t.Fatal("MixedRadixForward accepted a schedule that does not cover n")
+	}
+
+	if !MixedRadixForward(dst, src, twiddle, scratch, []int{7, 2, 11, 13}, 1) {
+		t.Fatal("MixedRadixForward failed")
+	}
+
+	ref := reference.NaiveDFT128(src)
+	for i := range dst {
+		if cmplx.Abs(dst[i]-ref[i]) > 1e-8*n {
+			t.Fatalf("forward mismatch at %d: got %v want %v", i, dst[i], ref[i])
+		}
+	}
+
+	if !MixedRadixInverse(dst, dst, twiddle, scratch, []int{13, 11, 7, 2}, 1) {
+		t.Fatal("MixedRadixInverse failed")
+	}
+
+	for i := range dst {
+		if cmplx.Abs(dst[i]-src[i]) > 1e-9 {
+			t.Fatalf("round trip mismatch at %d: got %v want %v", i, dst[i], src[i])
+		}
+	}
+}
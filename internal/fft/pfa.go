@@ -0,0 +1,303 @@
+package fft
+
+import (
+	"sync"
+
+	"github.com/cwbudde/algo-fft/internal/kernels"
+	m "github.com/cwbudde/algo-fft/internal/math"
+)
+
+// The Good–Thomas prime-factor algorithm turns a DFT of n = n1·n2·…·nk
+// points with pairwise coprime factors into a k-dimensional n1×n2×…×nk DFT
+// without twiddle factors. Input index Σ (n/ni)·ji mod n (Ruritanian map)
+// goes to coordinate (j1, …, jk); coordinate (k1, …, kk) lands at output
+// index Σ ei·ki mod n, where ei ≡ 1 (mod ni) and ei ≡ 0 (mod n/ni) (CRT map).
+// Each dimension is then a plain ni-point butterfly.
+
+// pfaMaxFactor is the largest factor a single butterfly handles.
+const pfaMaxFactor = 13
+
+// pfaTables holds the index maps of a prime-factor transform. Flat
+// coordinates are row-major over factors.
+type pfaTables struct {
+	factors []int
+	inPerm  []int // flat coordinate -> input index
+	outPerm []int // flat coordinate -> output index
+}
+
+//nolint:gochecknoglobals
+var pfaTableCache struct {
+	sync.RWMutex
+
+	tables map[int]*pfaTables
+}
+
+// cachedPFATables returns the memoized index maps for n, or nil if the
+// prime-factor algorithm does not apply to n.
+func cachedPFATables(n int) *pfaTables {
+	pfaTableCache.RLock()
+
+	if cached, ok := pfaTableCache.tables[n]; ok {
+		pfaTableCache.RUnlock()
+		return cached
+	}
+
+	pfaTableCache.RUnlock()
+
+	var tables *pfaTables
+	if PFAApplicable(n) {
+		tables = newPFATables(n)
+	}
+
+	pfaTableCache.Lock()
+
+	if pfaTableCache.tables == nil {
+		pfaTableCache.tables = make(map[int]*pfaTables)
+	}
+
+	pfaTableCache.tables[n] = tables
+	pfaTableCache.Unlock()
+
+	return tables
+}
+
+func newPFATables(n int) *pfaTables {
+	factors := m.CoprimeFactors(n)
+
+	inStep := make([]int, len(factors))
+	outStep := make([]int, len(factors))
+
+	for i, f := range factors {
+		rest := n / f
+		inStep[i] = rest
+		outStep[i] = rest * modInverse(rest%f, f) % n
+	}
+
+	t := &pfaTables{
+		factors: factors,
+		inPerm:  make([]int, n),
+		outPerm: make([]int, n),
+	}
+
+	// Walk the coordinates in row-major order, keeping both maps incremental.
+	digits := make([]int, len(factors))
+	in, out := 0, 0
+
+	for flat := range n {
+		t.inPerm[flat] = in
+		t.outPerm[flat] = out
+
+		for i := len(factors) - 1; i >= 0; i-- {
+			digits[i]++
+			in = (in + inStep[i]) % n
+			out = (out + outStep[i]) % n
+
+			if digits[i] < factors[i] {
+				break
+			}
+
+			// Wrapping digit i adds factors[i] steps, i.e. a multiple of n.
+			digits[i] = 0
+		}
+	}
+
+	return t
+}
+
+// modInverse returns the inverse of a modulo the small modulus mod.
+func modInverse(a, mod int) int {
+	for x := 1; x < mod; x++ {
+		if a*x%mod == 1 {
+			return x
+		}
+	}
+
+	return 1 // mod == 1
+}
+
+func forwardPFAComplex64(dst, src, twiddle, scratch []complex64) bool {
+	return pfaTransformComplex64(dst, src, scratch, false)
+}
+
+func inversePFAComplex64(dst, src, twiddle, scratch []complex64) bool {
+	return pfaTransformComplex64(dst, src, scratch, true)
+}
+
+func forwardPFAComplex128(dst, src, twiddle, scratch []complex128) bool {
+	return pfaTransformComplex128(dst, src, scratch, false)
+}
+
+func inversePFAComplex128(dst, src, twiddle, scratch []complex128) bool {
+	return pfaTransformComplex128(dst, src, scratch, true)
+}
+
+// pfaTransformComplex64 runs the prime-factor algorithm through scratch,
+// which needs len(src) elements. The inverse includes the 1/N scaling. dst
+// and src may alias. It returns false if the algorithm does not apply.
+func pfaTransformComplex64(dst, src, scratch []complex64, inverse bool) bool {
+	n := len(src)
+	if len(dst) < n || len(scratch) < n {
+		return false
+	}
+
+	tables := cachedPFATables(n)
+	if tables == nil {
+		return false
+	}
+
+	buf := scratch[:n]
+	for flat, i := range tables.inPerm {
+		buf[flat] = src[i]
+	}
+
+	var v [pfaMaxFactor]complex64
+
+	stride := n
+	for _, r := range tables.factors {
+		span := stride / r
+
+		for base := 0; base < n; base += stride {
+			for k := base; k < base+span; k++ {
+				for j := range r {
+					v[j] = buf[k+j*span]
+				}
+
+				pfaButterflyComplex64(v[:r], inverse)
+
+				for j := range r {
+					buf[k+j*span] = v[j]
+				}
+			}
+		}
+
+		stride = span
+	}
+
+	if inverse {
+		scale := complex(float32(1/float64(n)), 0)
+		for flat, k := range tables.outPerm {
+			dst[k] = buf[flat] * scale
+		}
+
+		return true
+	}
+
+	for flat, k := range tables.outPerm {
+		dst[k] = buf[flat]
+	}
+
+	return true
+}
+
+// pfaTransformComplex128 is the complex128 variant of pfaTransformComplex64.
+func pfaTransformComplex128(dst, src, scratch []complex128, inverse bool) bool {
+	n := len(src)
+	if len(dst) < n || len(scratch) < n {
+		return false
+	}
+
+	tables := cachedPFATables(n)
+	if tables == nil {
+		return false
+	}
+
+	buf := scratch[:n]
+	for flat, i := range tables.inPerm {
+		buf[flat] = src[i]
+	}
+
+	var v [pfaMaxFactor]complex128
+
+	stride := n
+	for _, r := range tables.factors {
+		span := stride / r
+
+		for base := 0; base < n; base += stride {
+			for k := base; k < base+span; k++ {
+				for j := range r {
+					v[j] = buf[k+j*span]
+				}
+
+				pfaButterflyComplex128(v[:r], inverse)
+
+				for j := range r {
+					buf[k+j*span] = v[j]
+				}
+			}
+		}
+
+		stride = span
+	}
+
+	if inverse {
+		scale := complex(1/float64(n), 0)
+		for flat, k := range tables.outPerm {
+			dst[k] = buf[flat] * scale
+		}
+
+		return true
+	}
+
+	for flat, k := range tables.outPerm {
+		dst[k] = buf[flat]
+	}
+
+	return true
+}
+
+// pfaButterflyComplex64 computes an unscaled DFT of v in place with the
+// small-radix butterflies of internal/kernels.
+func pfaButterflyComplex64(v []complex64, inverse bool) {
+	switch len(v) {
+	case 2:
+		v[0], v[1] = v[0]+v[1], v[0]-v[1]
+	case 3:
+		if inverse {
+			v[0], v[1], v[2] = kernels.Butterfly3InverseComplex64(v[0], v[1], v[2])
+		} else {
+			v[0], v[1], v[2] = kernels.Butterfly3ForwardComplex64(v[0], v[1], v[2])
+		}
+	case 4:
+		if inverse {
+			v[0], v[1], v[2], v[3] = kernels.Butterfly4InverseComplex64(v[0], v[1], v[2], v[3])
+		} else {
+			v[0], v[1], v[2], v[3] = kernels.Butterfly4ForwardComplex64(v[0], v[1], v[2], v[3])
+		}
+	case 5:
+		if inverse {
+			v[0], v[1], v[2], v[3], v[4] = kernels.Butterfly5InverseComplex64(v[0], v[1], v[2], v[3], v[4])
+		} else {
+			v[0], v[1], v[2], v[3], v[4] = kernels.Butterfly5ForwardComplex64(v[0], v[1], v[2], v[3], v[4])
+		}
+	default:
+		kernels.ButterflyOddComplex64(v, inverse)
+	}
+}
+
+// pfaButterflyComplex128 is the complex128 variant of pfaButterflyComplex64.
+func pfaButterflyComplex128(v []complex128, inverse bool) {
+	switch len(v) {
+	case 2:
+		v[0], v[1] = v[0]+v[1], v[0]-v[1]
+	case 3:
+		if inverse {
+			v[0], v[1], v[2] = kernels.Butterfly3InverseComplex128(v[0], v[1], v[2])
+		} else {
+			v[0], v[1], v[2] = kernels.Butterfly3ForwardComplex128(v[0], v[1], v[2])
+		}
+	case 4:
+		if inverse {
+			v[0], v[1], v[2], v[3] = kernels.Butterfly4InverseComplex128(v[0], v[1], v[2], v[3])
+		} else {
+			v[0], v[1], v[2], v[3] = kernels.Butterfly4ForwardComplex128(v[0], v[1], v[2], v[3])
+		}
+	case 5:
+		if inverse {
+			v[0], v[1], v[2], v[3], v[4] = kernels.Butterfly5InverseComplex128(v[0], v[1], v[2], v[3], v[4])
+		} else {
+			v[0], v[1], v[2], v[3], v[4] = kernels.Butterfly5ForwardComplex128(v[0], v[1], v[2], v[3], v[4])
+		}
+	default:
+		kernels.ButterflyOddComplex128(v, inverse)
+	}
+}
@@ -0,0 +1,95 @@
+package fft
+
+import (
+	"math/cmplx"
+	"testing"
+
+	mathpkg "github.com/cwbudde/algo-fft/internal/math"
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+func TestPFAComplex128MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{6, 10, 12, 15, 21, 35, 60, 105, 1001, 2310} {
+		src := make([]complex128, n)
+		for i := range src {
+			src[i] = complex(float64(i%7)-3, float64(i%5)-2)
+		}
+
+		twiddle := mathpkg.ComputeTwiddleFactors[complex128](n)
+		scratch := make([]complex128, n)
+		dst := make([]complex128, n)
+
+		if !forwardPFAComplex128(dst, src, twiddle, scratch) {
+			t.Fatalf("n=%d: forwardPFAComplex128 failed", n)
+		}
+
+		ref := reference.NaiveDFT128(src)
+		for i := range dst {
+			if cmplx.Abs(dst[i]-ref[i]) > 1e-9*float64(n) {
+				t.Fatalf("n=%d: forward[%d] = %v, want %v", n, i, dst[i], ref[i])
+			}
+		}
+
+		// In place round trip.
+		if !inversePFAComplex128(dst, dst, twiddle, scratch) {
+			t.Fatalf("n=%d: inversePFAComplex128 failed", n)
+		}
+
+		for i := range dst {
+			if cmplx.Abs(dst[i]-src[i]) > 1e-12*float64(n) {
+				t.Fatalf("n=%d: round trip[%d] = %v, want %v", n, i, dst[i], src[i])
+			}
+		}
+	}
+}
+
+func TestPFAComplex64MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{15, 44, 1001} {
+		src := make([]complex64, n)
+		for i := range src {
+			src[i] = complex(float32(i%7)-3, float32(i%5)-2)
+		}
+
+		twiddle := mathpkg.ComputeTwiddleFactors[complex64](n)
+		scratch := make([]complex64, n)
+		dst := make([]complex64, n)
+
+		if !forwardPFAComplex64(dst, src, twiddle, scratch) {
+			t.Fatalf("n=%d: forwardPFAComplex64 failed", n)
+		}
+
+		ref := reference.NaiveDFT(src)
+		for i := range dst {
+			if cmplx.Abs(complex128(dst[i]-ref[i])) > 1e-4*float64(n) {
+				t.Fatalf("n=%d: forward[%d] = %v, want %v", n, i, dst[i], ref[i])
+			}
+		}
+
+		if !inversePFAComplex64(dst, dst, twiddle, scratch) {
+			t.Fatalf("n=%d: inversePFAComplex64 failed", n)
+		}
+
+		for i := range dst {
+			if cmplx.Abs(complex128(dst[i]-src[i])) > 1e-4 {
+				t.Fatalf("n=%d: round trip[%d] = %v, want %v", n, i, dst[i], src[i])
+			}
+		}
+	}
+}
+
+func TestPFANotApplicable(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{8, 13, 17, 18, 34} {
+		src := make([]complex128, n)
+		dst := make([]complex128, n)
+
+		if forwardPFAComplex128(dst, src, nil, make([]complex128, n)) {
+			t.Errorf("n=%d: forwardPFAComplex128 succeeded, want false", n)
+		}
+	}
+}
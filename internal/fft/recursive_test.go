@@ -170,7 +170,7 @@ func testStockhamPacked64(t *testing.T, n int) {
 	dst := make([]complex64, n)
 
 	scratch := make([]complex64, n)
-	if !ForwardStockhamPacked(dst, src, twiddle, scratch, packed) {
+	if !ForwardStockhamPacked(dst, src, twiddle, scratch, packed, 1) {
 		t.Skip("ForwardStockhamPacked not implemented")
 		return
 	}
@@ -188,7 +188,7 @@ func testStockhamPacked64(t *testing.T, n int) {
 	inv := make([]complex64, n)
 
 	scratch = make([]complex64, n)
-	if !InverseStockhamPacked(inv, dst, twiddle, scratch, invPacked) {
+	if !InverseStockhamPacked(inv, dst, twiddle, scratch, invPacked, 1) {
 		t.Skip("InverseStockhamPacked not implemented")
 		return
 	}
@@ -224,7 +224,7 @@ func testStockhamPacked128(t *testing.T, n int) {
 	dst := make([]complex128, n)
 
 	scratch := make([]complex128, n)
-	if !ForwardStockhamPacked(dst, src, twiddle, scratch, packed) {
+	if !ForwardStockhamPacked(dst, src, twiddle, scratch, packed, 1) {
 		t.Skip("ForwardStockhamPacked not implemented")
 		return
 	}
@@ -240,7 +240,7 @@ func testStockhamPacked128(t *testing.T, n int) {
 	inv := make([]complex128, n)
 
 	scratch = make([]complex128, n)
-	if !InverseStockhamPacked(inv, dst, twiddle, scratch, invPacked) {
+	if !InverseStockhamPacked(inv, dst, twiddle, scratch, invPacked, 1) {
 		t.Skip("InverseStockhamPacked not implemented")
 		return
 	}
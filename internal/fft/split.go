@@ -0,0 +1,170 @@
+package fft
+
+import (
+	"math"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+	"github.com/cwbudde/algo-fft/internal/fftypes"
+	m "github.com/cwbudde/algo-fft/internal/math"
+)
+
+// Split-complex (planar) kernels operate on separate real and imaginary
+// arrays instead of interleaved complex values. They implement an iterative
+// radix-2 DIT transform for power-of-two sizes; each stage is a run of
+// contiguous butterfly blocks, which vectorizes without any shuffles.
+//
+// The inverse transform reuses the forward kernel via the swap identity
+//
+//	IDFT(re, im) = swap(DFT(swap(re, im))) / n
+//
+// so no separate inverse twiddle table is needed; the 1/n and any
+// normalization ride on the first butterfly stage.
+
+// ComputeSplitTwiddles returns the stage-contiguous forward twiddle table for
+// a power-of-two split-complex transform of size n.
+//
+// The stage with butterfly span 2*h stores W_{2h}^j for j = 0..h-1 starting at
+// offset h-1, so the table has n-1 entries in total.
+func ComputeSplitTwiddles[F fftypes.Float](n int) (re, im []F) {
+	if n < 2 {
+		return nil, nil
+	}
+
+	re = make([]F, n-1)
+	im = make([]F, n-1)
+
+	for half := 1; half < n; half <<= 1 {
+		for j := range half {
+			theta := -math.Pi * float64(j) / float64(half)
+			re[half-1+j] = F(math.Cos(theta))
+			im[half-1+j] = F(math.Sin(theta))
+		}
+	}
+
+	return re, im
+}
+
+// SplitKernel is a power-of-two split-complex transform: its twiddle and
+// bit-reversal tables and the butterfly resolved once for the CPU features.
+type SplitKernel[F fftypes.Float] struct {
+	twRe, twIm []F
+	bitrev     []int
+	butterfly  func(aRe, aIm, bRe, bIm, wRe, wIm []F)
+}
+
+// NewSplitKernel returns the split-complex kernel of size n for features, or
+// nil if n is not a power of two of at least 2.
+func NewSplitKernel[F fftypes.Float](n int, features cpu.Features) *SplitKernel[F] {
+	if n < 2 || !m.IsPowerOf2(n) {
+		return nil
+	}
+
+	var (
+		zero      F
+		butterfly any
+	)
+
+	switch any(zero).(type) {
+	case float32:
+		butterfly = splitButterflyFloat32For(features)
+	case float64:
+		butterfly = splitButterflyFloat64For(features)
+	}
+
+	twRe, twIm := ComputeSplitTwiddles[F](n)
+
+	return &SplitKernel[F]{
+		twRe:      twRe,
+		twIm:      twIm,
+		bitrev:    m.ComputeBitReversalIndices(n),
+		butterfly: butterfly.(func(aRe, aIm, bRe, bIm, wRe, wIm []F)), //nolint:forcetypeassert
+	}
+}
+
+// Forward computes the forward FFT of a split-complex signal, multiplied by
+// scale in the first butterfly stage. dst and src may be the same arrays.
+func (k *SplitKernel[F]) Forward(dstRe, dstIm, srcRe, srcIm []F, scale float64) {
+	splitTransform(dstRe, dstIm, srcRe, srcIm, k.twRe, k.twIm, k.bitrev, k.butterfly, F(scale))
+}
+
+// Inverse computes the inverse FFT of a split-complex signal: 1/n times
+// scale, applied in the first butterfly stage. dst and src may be the same
+// arrays.
+func (k *SplitKernel[F]) Inverse(dstRe, dstIm, srcRe, srcIm []F, scale float64) {
+	scale /= float64(len(dstRe))
+	splitTransform(dstIm, dstRe, srcIm, srcRe, k.twRe, k.twIm, k.bitrev, k.butterfly, F(scale))
+}
+
+// splitTransform runs the radix-2 stages with butterfly. The transform is
+// linear, so scaling the twiddle-free first stage scales the result without
+// a pass of its own.
+func splitTransform[F fftypes.Float](
+	dstRe, dstIm, srcRe, srcIm, twRe, twIm []F,
+	bitrev []int,
+	butterfly func(aRe, aIm, bRe, bIm, wRe, wIm []F),
+	scale F,
+) {
+	n := len(dstRe)
+	if n == 0 {
+		return
+	}
+
+	dstRe = dstRe[:n]
+	dstIm = dstIm[:n]
+	srcRe = srcRe[:n]
+	srcIm = srcIm[:n]
+	bitrev = bitrev[:n]
+
+	// Bit reversal is an involution, so the in-place case is a set of swaps.
+	if &dstRe[0] == &srcRe[0] && &dstIm[0] == &srcIm[0] {
+		for i, j := range bitrev {
+			if i < j {
+				dstRe[i], dstRe[j] = dstRe[j], dstRe[i]
+				dstIm[i], dstIm[j] = dstIm[j], dstIm[i]
+			}
+		}
+	} else {
+		for i, j := range bitrev {
+			dstRe[i] = srcRe[j]
+			dstIm[i] = srcIm[j]
+		}
+	}
+
+	// First stage: twiddle-free size-2 butterflies, carrying the scale.
+	for i := 0; i+1 < n; i += 2 {
+		ar, ai := dstRe[i], dstIm[i]
+		br, bi := dstRe[i+1], dstIm[i+1]
+		dstRe[i], dstIm[i] = (ar+br)*scale, (ai+bi)*scale
+		dstRe[i+1], dstIm[i+1] = (ar-br)*scale, (ai-bi)*scale
+	}
+
+	for half := 2; half < n; half <<= 1 {
+		wRe := twRe[half-1 : 2*half-1]
+		wIm := twIm[half-1 : 2*half-1]
+
+		for base := 0; base < n; base += 2 * half {
+			butterfly(
+				dstRe[base:base+half], dstIm[base:base+half],
+				dstRe[base+half:base+2*half], dstIm[base+half:base+2*half],
+				wRe, wIm,
+			)
+		}
+	}
+}
+
+func splitButterflyGeneric[F fftypes.Float](aRe, aIm, bRe, bIm, wRe, wIm []F) {
+	n := len(aRe)
+	aIm = aIm[:n]
+	bRe = bRe[:n]
+	bIm = bIm[:n]
+	wRe = wRe[:n]
+	wIm = wIm[:n]
+
+	for j := range n {
+		tr := bRe[j]*wRe[j] - bIm[j]*wIm[j]
+		ti := bRe[j]*wIm[j] + bIm[j]*wRe[j]
+		ar, ai := aRe[j], aIm[j]
+		aRe[j], aIm[j] = ar+tr, ai+ti
+		bRe[j], bIm[j] = ar-tr, ai-ti
+	}
+}
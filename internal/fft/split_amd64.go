@@ -0,0 +1,50 @@
+//go:build amd64 && asm && !purego
+
+package fft
+
+import (
+	amd64 "github.com/cwbudde/algo-fft/internal/asm/amd64"
+	"github.com/cwbudde/algo-fft/internal/cpu"
+)
+
+// splitButterflyFloat32For returns the float32 split-complex butterfly for
+// features: the AVX2 kernel when available, otherwise the generic loop.
+func splitButterflyFloat32For(features cpu.Features) func(aRe, aIm, bRe, bIm, wRe, wIm []float32) {
+	if features.ForceGeneric || !features.HasAVX2 {
+		return splitButterflyGeneric[float32]
+	}
+
+	return splitButterflyFloat32AVX2
+}
+
+// splitButterflyFloat64For returns the float64 split-complex butterfly for
+// features: the AVX2 kernel when available, otherwise the generic loop.
+func splitButterflyFloat64For(features cpu.Features) func(aRe, aIm, bRe, bIm, wRe, wIm []float64) {
+	if features.ForceGeneric || !features.HasAVX2 {
+		return splitButterflyGeneric[float64]
+	}
+
+	return splitButterflyFloat64AVX2
+}
+
+// splitButterflyFloat32AVX2 processes whole AVX2 vectors of split-complex
+// butterflies and finishes the tail with the generic loop.
+func splitButterflyFloat32AVX2(aRe, aIm, bRe, bIm, wRe, wIm []float32) {
+	n := len(aRe) &^ 7
+	if n > 0 {
+		amd64.SplitButterflyFloat32AVX2Asm(aRe[:n], aIm[:n], bRe[:n], bIm[:n], wRe[:n], wIm[:n])
+	}
+
+	splitButterflyGeneric(aRe[n:], aIm[n:], bRe[n:], bIm[n:], wRe[n:], wIm[n:])
+}
+
+// splitButterflyFloat64AVX2 processes whole AVX2 vectors of split-complex
+// butterflies and finishes the tail with the generic loop.
+func splitButterflyFloat64AVX2(aRe, aIm, bRe, bIm, wRe, wIm []float64) {
+	n := len(aRe) &^ 3
+	if n > 0 {
+		amd64.SplitButterflyFloat64AVX2Asm(aRe[:n], aIm[:n], bRe[:n], bIm[:n], wRe[:n], wIm[:n])
+	}
+
+	splitButterflyGeneric(aRe[n:], aIm[n:], bRe[n:], bIm[n:], wRe[n:], wIm[n:])
+}
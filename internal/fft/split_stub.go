@@ -0,0 +1,16 @@
+//go:build !amd64 || purego || !asm
+
+package fft
+
+import "github.com/cwbudde/algo-fft/internal/cpu"
+
+// Split-complex SIMD stubs for platforms without optimized implementations or
+// when assembly is disabled. They always select the generic loop.
+
+func splitButterflyFloat32For(cpu.Features) func(aRe, aIm, bRe, bIm, wRe, wIm []float32) {
+	return splitButterflyGeneric[float32]
+}
+
+func splitButterflyFloat64For(cpu.Features) func(aRe, aIm, bRe, bIm, wRe, wIm []float64) {
+	return splitButterflyGeneric[float64]
+}
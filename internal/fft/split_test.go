@@ -0,0 +1,117 @@
+package fft
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+// TestSplitFloat64MatchesReference checks the split-complex kernels, including
+// the SIMD butterfly blocks and their scalar tails, against a naive DFT.
+func TestSplitFloat64MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{2, 4, 8, 16, 32, 128} {
+		re := make([]float64, n)
+		im := make([]float64, n)
+		src := make([]complex128, n)
+
+		for i := range n {
+			re[i] = math.Sin(float64(i)*0.4) + 0.1*float64(i)
+			im[i] = math.Cos(float64(i) * 1.3)
+			src[i] = complex(re[i], im[i])
+		}
+
+		kernel := NewSplitKernel[float64](n, cpu.DetectFeatures())
+
+		dstRe := make([]float64, n)
+		dstIm := make([]float64, n)
+		kernel.Forward(dstRe, dstIm, re, im, 1)
+
+		want := reference.NaiveDFT128(src)
+		for k := range want {
+			if math.Abs(dstRe[k]-real(want[k])) > 1e-9 || math.Abs(dstIm[k]-imag(want[k])) > 1e-9 {
+				t.Fatalf("n=%d bin %d = (%v, %v), want %v", n, k, dstRe[k], dstIm[k], want[k])
+			}
+		}
+
+		kernel.Inverse(dstRe, dstIm, dstRe, dstIm, 1)
+
+		for i := range n {
+			if math.Abs(dstRe[i]-re[i]) > 1e-12 || math.Abs(dstIm[i]-im[i]) > 1e-12 {
+				t.Fatalf("n=%d round trip[%d] = (%v, %v), want (%v, %v)", n, i, dstRe[i], dstIm[i], re[i], im[i])
+			}
+		}
+	}
+}
+
+func TestSplitFloat32MatchesFloat64(t *testing.T) {
+	t.Parallel()
+
+	const n = 256
+
+	re32 := make([]float32, n)
+	im32 := make([]float32, n)
+	re64 := make([]float64, n)
+	im64 := make([]float64, n)
+
+	for i := range n {
+		re32[i] = float32(math.Sin(float64(i) * 0.21))
+		im32[i] = float32(math.Cos(float64(i) * 0.57))
+		re64[i], im64[i] = float64(re32[i]), float64(im32[i])
+	}
+
+	features := cpu.DetectFeatures()
+
+	NewSplitKernel[float32](n, features).Forward(re32, im32, re32, im32, 1)
+	NewSplitKernel[float64](n, features).Forward(re64, im64, re64, im64, 1)
+
+	for k := range n {
+		if math.Abs(float64(re32[k])-re64[k]) > 1e-3 || math.Abs(float64(im32[k])-im64[k]) > 1e-3 {
+			t.Fatalf("bin %d = (%v, %v), want (%v, %v)", k, re32[k], im32[k], re64[k], im64[k])
+		}
+	}
+}
+
+func TestSplitKernelScale(t *testing.T) {
+	t.Parallel()
+
+	const n = 64
+
+	re := make([]float64, n)
+	im := make([]float64, n)
+
+	for i := range n {
+		re[i] = math.Sin(float64(i) * 0.3)
+		im[i] = math.Cos(float64(i) * 0.8)
+	}
+
+	generic := cpu.Features{ForceGeneric: true}
+	if NewSplitKernel[float64](12, generic) != nil || NewSplitKernel[float64](1, generic) != nil {
+		t.Fatal("NewSplitKernel accepted a size that is not a power of two >= 2")
+	}
+
+	kernel := NewSplitKernel[float64](n, generic)
+	wantRe, wantIm := make([]float64, n), make([]float64, n)
+	gotRe, gotIm := make([]float64, n), make([]float64, n)
+
+	kernel.Forward(wantRe, wantIm, re, im, 1)
+	kernel.Forward(gotRe, gotIm, re, im, 0.25)
+
+	for k := range n {
+		if math.Abs(gotRe[k]-0.25*wantRe[k]) > 1e-12 || math.Abs(gotIm[k]-0.25*wantIm[k]) > 1e-12 {
+			t.Fatalf("scaled bin %d = (%v, %v), want a quarter of (%v, %v)", k, gotRe[k], gotIm[k], wantRe[k], wantIm[k])
+		}
+	}
+
+	// An unnormalized inverse undoes the 1/n of the default one.
+	kernel.Inverse(gotRe, gotIm, wantRe, wantIm, n)
+
+	for i := range n {
+		if math.Abs(gotRe[i]-n*re[i]) > 1e-9 || math.Abs(gotIm[i]-n*im[i]) > 1e-9 {
+			t.Fatalf("unnormalized inverse[%d] = (%v, %v), want n*(%v, %v)", i, gotRe[i], gotIm[i], re[i], im[i])
+		}
+	}
+}
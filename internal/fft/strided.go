@@ -2,17 +2,36 @@ package fft
 
 // ForwardStridedDIT runs a radix-2 DIT FFT over strided data.
 // dst and src must be large enough for n elements with the given stride.
-func ForwardStridedDIT[T Complex](dst, src, twiddle []T, bitrev []int, stride, n int) bool {
-	return ditForwardStrided(dst, src, twiddle, bitrev, stride, n)
+// The output is multiplied by scale, folded into the bit-reversal gather.
+func ForwardStridedDIT[T Complex](dst, src, twiddle []T, bitrev []int, stride, n int, scale float64) bool {
+	return ditForwardStrided(dst, src, twiddle, bitrev, stride, n, scale)
 }
 
 // InverseStridedDIT runs a radix-2 inverse DIT FFT over strided data.
 // dst and src must be large enough for n elements with the given stride.
-func InverseStridedDIT[T Complex](dst, src, twiddle []T, bitrev []int, stride, n int) bool {
-	return ditInverseStrided(dst, src, twiddle, bitrev, stride, n)
+// scale is applied on top of the built-in 1/N, both in the gather.
+func InverseStridedDIT[T Complex](dst, src, twiddle []T, bitrev []int, stride, n int, scale float64) bool {
+	return ditInverseStrided(dst, src, twiddle, bitrev, stride, n, scale)
 }
 
-func ditForwardStrided[T Complex](dst, src, twiddle []T, bitrev []int, stride, n int) bool {
+// stridedGather copies src into dst in bit-reversed order, multiplying by
+// scale. The transform is linear, so this scales the output.
+func stridedGather[T Complex](dst, src []T, bitrev []int, stride, n int, scale float64) {
+	if scale == 1 {
+		for i := range n {
+			dst[i*stride] = src[bitrev[i]*stride]
+		}
+
+		return
+	}
+
+	factor := complexFromFloat64[T](scale, 0)
+	for i := range n {
+		dst[i*stride] = src[bitrev[i]*stride] * factor
+	}
+}
+
+func ditForwardStrided[T Complex](dst, src, twiddle []T, bitrev []int, stride, n int, scale float64) bool {
 	if n == 0 {
 		return true
 	}
@@ -26,9 +45,7 @@ func ditForwardStrided[T Complex](dst, src, twiddle []T, bitrev []int, stride, n
 		return false
 	}
 
-	for i := range n {
-		dst[i*stride] = src[bitrev[i]*stride]
-	}
+	stridedGather(dst, src, bitrev, stride, n, scale)
 
 	for size := 2; size <= n; size <<= 1 {
 		half := size >> 1
@@ -52,7 +69,7 @@ func ditForwardStrided[T Complex](dst, src, twiddle []T, bitrev []int, stride, n
 	return true
 }
 
-func ditInverseStrided[T Complex](dst, src, twiddle []T, bitrev []int, stride, n int) bool {
+func ditInverseStrided[T Complex](dst, src, twiddle []T, bitrev []int, stride, n int, scale float64) bool {
 	if n == 0 {
 		return true
 	}
@@ -66,9 +83,7 @@ func ditInverseStrided[T Complex](dst, src, twiddle []T, bitrev []int, stride, n
 		return false
 	}
 
-	for i := range n {
-		dst[i*stride] = src[bitrev[i]*stride]
-	}
+	stridedGather(dst, src, bitrev, stride, n, scale/float64(n))
 
 	for size := 2; size <= n; size <<= 1 {
 		half := size >> 1
@@ -89,10 +104,5 @@ func ditInverseStrided[T Complex](dst, src, twiddle []T, bitrev []int, stride, n
 		}
 	}
 
-	scale := complexFromFloat64[T](1.0/float64(n), 0)
-	for i := range n {
-		dst[i*stride] *= scale
-	}
-
 	return true
 }
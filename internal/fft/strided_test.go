@@ -28,7 +28,7 @@ func TestForwardStridedDIT_Complex64(t *testing.T) {
 	output := make([]complex64, n*stride)
 
 	// Run strided FFT
-	ok := ForwardStridedDIT(output, input, twiddle, bitrev, stride, n)
+	ok := ForwardStridedDIT(output, input, twiddle, bitrev, stride, n, 1)
 	if !ok {
 		t.Fatal("ForwardStridedDIT failed")
 	}
@@ -59,7 +59,7 @@ func TestInverseStridedDIT_Complex64(t *testing.T) {
 	output := make([]complex64, n*stride)
 
 	// Run inverse strided FFT
-	ok := InverseStridedDIT(output, freq, twiddle, bitrev, stride, n)
+	ok := InverseStridedDIT(output, freq, twiddle, bitrev, stride, n, 1)
 	if !ok {
 		t.Fatal("InverseStridedDIT failed")
 	}
@@ -91,7 +91,7 @@ func TestStridedDIT_RoundTrip(t *testing.T) {
 	// Forward transform
 	freq := make([]complex64, n*stride)
 
-	ok := ForwardStridedDIT(freq, input, twiddle, bitrev, stride, n)
+	ok := ForwardStridedDIT(freq, input, twiddle, bitrev, stride, n, 1)
 	if !ok {
 		t.Fatal("ForwardStridedDIT failed")
 	}
@@ -99,7 +99,7 @@ func TestStridedDIT_RoundTrip(t *testing.T) {
 	// Inverse transform
 	output := make([]complex64, n*stride)
 
-	ok = InverseStridedDIT(output, freq, twiddle, bitrev, stride, n)
+	ok = InverseStridedDIT(output, freq, twiddle, bitrev, stride, n, 1)
 	if !ok {
 		t.Fatal("InverseStridedDIT failed")
 	}
@@ -173,7 +173,7 @@ func TestStridedDIT_ErrorHandling(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := ForwardStridedDIT(tt.dst, tt.src, twiddle, bitrev, tt.stride, tt.n)
+			got := ForwardStridedDIT(tt.dst, tt.src, twiddle, bitrev, tt.stride, tt.n, 1)
 			if got != tt.want {
 				t.Errorf("ForwardStridedDIT() = %v, want %v", got, tt.want)
 			}
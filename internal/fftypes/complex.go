@@ -9,3 +9,9 @@ type Complex interface {
 type Float interface {
 	float32 | float64
 }
+
+// Fixed is a type constraint for the fixed-point sample types of fixed-point
+// FFTs: int16 holds Q15 and int32 holds Q31 values.
+type Fixed interface {
+	int16 | int32
+}
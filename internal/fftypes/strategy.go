@@ -10,7 +10,10 @@ const (
 	KernelSixStep
 	KernelEightStep
 	KernelBluestein
-	KernelRecursive // Recursive decomposition with codelet leaves
+	KernelRecursive  // Recursive decomposition with codelet leaves
+	KernelRader      // Rader's algorithm for primes (cyclic convolution of length n-1, zero-padded if rough)
+	KernelPFA        // Good–Thomas prime-factor algorithm for coprime factorizations
+	KernelSplitRadix // Split-radix (2/4) DIT for powers of two
 )
 
 // SIMDLevel describes the minimum required CPU features for a codelet.
@@ -39,6 +39,9 @@ func GetRegistry[T Complex]() *planner.CodeletRegistry[T] {
 
 // This file registers all built-in codelets with the global registries.
 // Registration happens at init time so codelets are available when plans are created.
+// The straight-line codelets for small non-power-of-two sizes are generated:
+//
+//go:generate go run ../../cmd/genfft -out .
 
 //nolint:gochecknoinits
 func init() {
@@ -48,6 +51,14 @@ func init() {
 	// Register complex128 DIT codelets
 	registerDITCodelets128()
 
+	// Register generated straight-line codelets (codelet_init_gen.go)
+	registerGeneratedCodelets64()
+	registerGeneratedCodelets128()
+
+	// Register portable Stockham codelets (codelet_init_portable.go)
+	registerPortableCodelets64()
+	registerPortableCodelets128()
+
 	// Register NEON codelets (conditional on build tags)
 	registerNEONDITCodelets64()
 	registerNEONDITCodelets128()
@@ -0,0 +1,353 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// registerGeneratedCodelets64 registers the straight-line complex64
+// codelets generated by cmd/genfft.
+func registerGeneratedCodelets64() {
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       3,
+		Forward:    wrapCodelet64(forwardDFT3Complex64),
+		Inverse:    wrapCodelet64(inverseDFT3Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit3_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       5,
+		Forward:    wrapCodelet64(forwardDFT5Complex64),
+		Inverse:    wrapCodelet64(inverseDFT5Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit5_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       6,
+		Forward:    wrapCodelet64(forwardDFT6Complex64),
+		Inverse:    wrapCodelet64(inverseDFT6Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit6_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       7,
+		Forward:    wrapCodelet64(forwardDFT7Complex64),
+		Inverse:    wrapCodelet64(inverseDFT7Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit7_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       9,
+		Forward:    wrapCodelet64(forwardDFT9Complex64),
+		Inverse:    wrapCodelet64(inverseDFT9Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit9_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       10,
+		Forward:    wrapCodelet64(forwardDFT10Complex64),
+		Inverse:    wrapCodelet64(inverseDFT10Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit10_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       12,
+		Forward:    wrapCodelet64(forwardDFT12Complex64),
+		Inverse:    wrapCodelet64(inverseDFT12Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit12_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       15,
+		Forward:    wrapCodelet64(forwardDFT15Complex64),
+		Inverse:    wrapCodelet64(inverseDFT15Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit15_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       20,
+		Forward:    wrapCodelet64(forwardDFT20Complex64),
+		Inverse:    wrapCodelet64(inverseDFT20Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit20_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       24,
+		Forward:    wrapCodelet64(forwardDFT24Complex64),
+		Inverse:    wrapCodelet64(inverseDFT24Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit24_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       30,
+		Forward:    wrapCodelet64(forwardDFT30Complex64),
+		Inverse:    wrapCodelet64(inverseDFT30Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit30_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       36,
+		Forward:    wrapCodelet64(forwardDFT36Complex64),
+		Inverse:    wrapCodelet64(inverseDFT36Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit36_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       40,
+		Forward:    wrapCodelet64(forwardDFT40Complex64),
+		Inverse:    wrapCodelet64(inverseDFT40Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit40_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       48,
+		Forward:    wrapCodelet64(forwardDFT48Complex64),
+		Inverse:    wrapCodelet64(inverseDFT48Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit48_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       60,
+		Forward:    wrapCodelet64(forwardDFT60Complex64),
+		Inverse:    wrapCodelet64(inverseDFT60Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit60_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       80,
+		Forward:    wrapCodelet64(forwardDFT80Complex64),
+		Inverse:    wrapCodelet64(inverseDFT80Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit80_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       96,
+		Forward:    wrapCodelet64(forwardDFT96Complex64),
+		Inverse:    wrapCodelet64(inverseDFT96Complex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit96_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+}
+
+// registerGeneratedCodelets128 registers the straight-line complex128
+// codelets generated by cmd/genfft.
+func registerGeneratedCodelets128() {
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       3,
+		Forward:    wrapCodelet128(forwardDFT3Complex128),
+		Inverse:    wrapCodelet128(inverseDFT3Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit3_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       5,
+		Forward:    wrapCodelet128(forwardDFT5Complex128),
+		Inverse:    wrapCodelet128(inverseDFT5Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit5_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       6,
+		Forward:    wrapCodelet128(forwardDFT6Complex128),
+		Inverse:    wrapCodelet128(inverseDFT6Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit6_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       7,
+		Forward:    wrapCodelet128(forwardDFT7Complex128),
+		Inverse:    wrapCodelet128(inverseDFT7Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit7_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       9,
+		Forward:    wrapCodelet128(forwardDFT9Complex128),
+		Inverse:    wrapCodelet128(inverseDFT9Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit9_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       10,
+		Forward:    wrapCodelet128(forwardDFT10Complex128),
+		Inverse:    wrapCodelet128(inverseDFT10Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit10_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       12,
+		Forward:    wrapCodelet128(forwardDFT12Complex128),
+		Inverse:    wrapCodelet128(inverseDFT12Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit12_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       15,
+		Forward:    wrapCodelet128(forwardDFT15Complex128),
+		Inverse:    wrapCodelet128(inverseDFT15Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit15_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       20,
+		Forward:    wrapCodelet128(forwardDFT20Complex128),
+		Inverse:    wrapCodelet128(inverseDFT20Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit20_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       24,
+		Forward:    wrapCodelet128(forwardDFT24Complex128),
+		Inverse:    wrapCodelet128(inverseDFT24Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit24_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       30,
+		Forward:    wrapCodelet128(forwardDFT30Complex128),
+		Inverse:    wrapCodelet128(inverseDFT30Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit30_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       36,
+		Forward:    wrapCodelet128(forwardDFT36Complex128),
+		Inverse:    wrapCodelet128(inverseDFT36Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit36_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       40,
+		Forward:    wrapCodelet128(forwardDFT40Complex128),
+		Inverse:    wrapCodelet128(inverseDFT40Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit40_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       48,
+		Forward:    wrapCodelet128(forwardDFT48Complex128),
+		Inverse:    wrapCodelet128(inverseDFT48Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit48_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       60,
+		Forward:    wrapCodelet128(forwardDFT60Complex128),
+		Inverse:    wrapCodelet128(inverseDFT60Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit60_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       80,
+		Forward:    wrapCodelet128(forwardDFT80Complex128),
+		Inverse:    wrapCodelet128(inverseDFT80Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit80_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       96,
+		Forward:    wrapCodelet128(forwardDFT96Complex128),
+		Inverse:    wrapCodelet128(inverseDFT96Complex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit96_genfft_generic",
+		Priority:   10,
+		KernelType: KernelTypeCore,
+	})
+}
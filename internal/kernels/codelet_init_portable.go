@@ -0,0 +1,71 @@
+package kernels
+
+import "fmt"
+
+// Portable Stockham codelets (stockham_portable.go).
+//
+// They are registered at SIMDNone, so SIMD codelets still win wherever the
+// CPU supports them, but above the unrolled generic kernels for the sizes
+// where they measured faster: on targets without SIMD codelets (riscv64,
+// ppc64le, s390x, loong64, wasm) and with cpu.Features.ForceGeneric these are
+// the kernels that run. Elsewhere they stay available at low priority for
+// wisdom and benchmarking.
+
+const (
+	// portableCodeletPriority outranks every generic DIT codelet.
+	portableCodeletPriority = 50
+
+	// portableFallbackPriority keeps the kernel below the unrolled codelets.
+	portableFallbackPriority = 1
+)
+
+// portableCodeletMinSize64 and portableCodeletMinSize128 are the smallest
+// sizes at which the portable kernels beat the unrolled generic codelets.
+// complex128 DIT arithmetic is cheaper in Go (complex64 products are
+// widened to float64), so its break-even lies higher.
+const (
+	portableCodeletMinSize64  = 64
+	portableCodeletMinSize128 = 1024
+)
+
+// registerPortableCodelets64 registers the portable complex64 Stockham codelets.
+func registerPortableCodelets64() {
+	for size := 64; size <= 16384; size *= 2 {
+		priority := portableCodeletPriority
+		if size < portableCodeletMinSize64 {
+			priority = portableFallbackPriority
+		}
+
+		Registry64.Register(CodeletEntry[complex64]{
+			Size:       size,
+			Forward:    wrapCodelet64(forwardStockhamPortableComplex64),
+			Inverse:    wrapCodelet64(inverseStockhamPortableComplex64),
+			Algorithm:  KernelStockham,
+			SIMDLevel:  SIMDNone,
+			Signature:  fmt.Sprintf("stockham%d_radix8_portable", size),
+			Priority:   priority,
+			KernelType: KernelTypeCore,
+		})
+	}
+}
+
+// registerPortableCodelets128 registers the portable complex128 Stockham codelets.
+func registerPortableCodelets128() {
+	for size := 64; size <= 16384; size *= 2 {
+		priority := portableCodeletPriority
+		if size < portableCodeletMinSize128 {
+			priority = portableFallbackPriority
+		}
+
+		Registry128.Register(CodeletEntry[complex128]{
+			Size:       size,
+			Forward:    wrapCodelet128(forwardStockhamPortableComplex128),
+			Inverse:    wrapCodelet128(inverseStockhamPortableComplex128),
+			Algorithm:  KernelStockham,
+			SIMDLevel:  SIMDNone,
+			Signature:  fmt.Sprintf("stockham%d_radix8_portable", size),
+			Priority:   priority,
+			KernelType: KernelTypeCore,
+		})
+	}
+}
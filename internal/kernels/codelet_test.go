@@ -92,11 +92,17 @@ func TestCodeletRegistrySizes(t *testing.T) {
 	}
 
 	expected := map[int]bool{4: true, 8: true, 16: true, 32: true, 64: true, 128: true, 256: true, 512: true, 1024: true, 2048: true, 4096: true, 8192: true, 16384: true}
-	expectedCount := 13
+
+	// Straight-line codelets generated by cmd/genfft
+	for _, size := range []int{3, 5, 6, 7, 9, 10, 12, 15, 20, 24, 30, 36, 40, 48, 60, 80, 96} {
+		expected[size] = true
+	}
+
+	expectedCount := len(expected)
 
 	if has384 {
 		expected[384] = true
-		expectedCount = 14
+		expectedCount++
 	}
 
 	if len(sizes) != expectedCount {
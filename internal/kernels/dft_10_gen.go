@@ -0,0 +1,341 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT10Complex64 computes a 10-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT10Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 10
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+
+	t0 := x2 + x8
+	t1 := x2 - x8
+	t2 := x4 + x6
+	t3 := x4 - x6
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x7 + x3
+	t18 := x7 - x3
+	t19 := x9 + x1
+	t20 := x9 - x1
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := t4 + t21
+	t35 := t4 - t21
+	t36 := t10 + t27
+	t37 := t10 - t27
+	t38 := t16 + t33
+	t39 := t16 - t33
+	t40 := t15 + t32
+	t41 := t15 - t32
+	t42 := t9 + t26
+	t43 := t9 - t26
+
+	d[0] = t34
+	d[1] = t37
+	d[2] = t38
+	d[3] = t41
+	d[4] = t42
+	d[5] = t35
+	d[6] = t36
+	d[7] = t39
+	d[8] = t40
+	d[9] = t43
+
+	return true
+}
+
+// inverseDFT10Complex64 computes a 10-point inverse DFT of complex64 data,
+// including the 1/10 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT10Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 10
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+
+	t0 := x2 + x8
+	t1 := x2 - x8
+	t2 := x4 + x6
+	t3 := x4 - x6
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x7 + x3
+	t18 := x7 - x3
+	t19 := x9 + x1
+	t20 := x9 - x1
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := t4 + t21
+	t35 := t4 - t21
+	t36 := t9 + t26
+	t37 := t9 - t26
+	t38 := t15 + t32
+	t39 := t15 - t32
+	t40 := t16 + t33
+	t41 := t16 - t33
+	t42 := t10 + t27
+	t43 := t10 - t27
+
+	d[0] = complex(real(t34)*0.1, imag(t34)*0.1)
+	d[1] = complex(real(t37)*0.1, imag(t37)*0.1)
+	d[2] = complex(real(t38)*0.1, imag(t38)*0.1)
+	d[3] = complex(real(t41)*0.1, imag(t41)*0.1)
+	d[4] = complex(real(t42)*0.1, imag(t42)*0.1)
+	d[5] = complex(real(t35)*0.1, imag(t35)*0.1)
+	d[6] = complex(real(t36)*0.1, imag(t36)*0.1)
+	d[7] = complex(real(t39)*0.1, imag(t39)*0.1)
+	d[8] = complex(real(t40)*0.1, imag(t40)*0.1)
+	d[9] = complex(real(t43)*0.1, imag(t43)*0.1)
+
+	return true
+}
+
+// forwardDFT10Complex128 computes a 10-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT10Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 10
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+
+	t0 := x2 + x8
+	t1 := x2 - x8
+	t2 := x4 + x6
+	t3 := x4 - x6
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x7 + x3
+	t18 := x7 - x3
+	t19 := x9 + x1
+	t20 := x9 - x1
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := t4 + t21
+	t35 := t4 - t21
+	t36 := t10 + t27
+	t37 := t10 - t27
+	t38 := t16 + t33
+	t39 := t16 - t33
+	t40 := t15 + t32
+	t41 := t15 - t32
+	t42 := t9 + t26
+	t43 := t9 - t26
+
+	d[0] = t34
+	d[1] = t37
+	d[2] = t38
+	d[3] = t41
+	d[4] = t42
+	d[5] = t35
+	d[6] = t36
+	d[7] = t39
+	d[8] = t40
+	d[9] = t43
+
+	return true
+}
+
+// inverseDFT10Complex128 computes a 10-point inverse DFT of complex128 data,
+// including the 1/10 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT10Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 10
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+
+	t0 := x2 + x8
+	t1 := x2 - x8
+	t2 := x4 + x6
+	t3 := x4 - x6
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x7 + x3
+	t18 := x7 - x3
+	t19 := x9 + x1
+	t20 := x9 - x1
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := t4 + t21
+	t35 := t4 - t21
+	t36 := t9 + t26
+	t37 := t9 - t26
+	t38 := t15 + t32
+	t39 := t15 - t32
+	t40 := t16 + t33
+	t41 := t16 - t33
+	t42 := t10 + t27
+	t43 := t10 - t27
+
+	d[0] = complex(real(t34)*0.1, imag(t34)*0.1)
+	d[1] = complex(real(t37)*0.1, imag(t37)*0.1)
+	d[2] = complex(real(t38)*0.1, imag(t38)*0.1)
+	d[3] = complex(real(t41)*0.1, imag(t41)*0.1)
+	d[4] = complex(real(t42)*0.1, imag(t42)*0.1)
+	d[5] = complex(real(t35)*0.1, imag(t35)*0.1)
+	d[6] = complex(real(t36)*0.1, imag(t36)*0.1)
+	d[7] = complex(real(t39)*0.1, imag(t39)*0.1)
+	d[8] = complex(real(t40)*0.1, imag(t40)*0.1)
+	d[9] = complex(real(t43)*0.1, imag(t43)*0.1)
+
+	return true
+}
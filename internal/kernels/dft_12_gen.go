@@ -0,0 +1,433 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT12Complex64 computes a 12-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT12Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 12
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x7 + x11
+	t10 := x7 - x11
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x10 + x2
+	t19 := x10 - x2
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x1 + x5
+	t28 := x1 - x5
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t2 + t20
+	t37 := t2 - t20
+	t38 := t11 + t29
+	t39 := t11 - t29
+	t40 := complex(imag(t39), -real(t39))
+	t41 := t36 + t38
+	t42 := t37 + t40
+	t43 := t36 - t38
+	t44 := t37 - t40
+	t45 := t8 + t26
+	t46 := t8 - t26
+	t47 := t17 + t35
+	t48 := t17 - t35
+	t49 := complex(imag(t48), -real(t48))
+	t50 := t45 + t47
+	t51 := t46 + t49
+	t52 := t45 - t47
+	t53 := t46 - t49
+	t54 := t7 + t25
+	t55 := t7 - t25
+	t56 := t16 + t34
+	t57 := t16 - t34
+	t58 := complex(imag(t57), -real(t57))
+	t59 := t54 + t56
+	t60 := t55 + t58
+	t61 := t54 - t56
+	t62 := t55 - t58
+
+	d[0] = t41
+	d[1] = t51
+	d[2] = t61
+	d[3] = t44
+	d[4] = t50
+	d[5] = t60
+	d[6] = t43
+	d[7] = t53
+	d[8] = t59
+	d[9] = t42
+	d[10] = t52
+	d[11] = t62
+
+	return true
+}
+
+// inverseDFT12Complex64 computes a 12-point inverse DFT of complex64 data,
+// including the 1/12 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT12Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 12
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x7 + x11
+	t10 := x7 - x11
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x10 + x2
+	t19 := x10 - x2
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x1 + x5
+	t28 := x1 - x5
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t2 + t20
+	t37 := t2 - t20
+	t38 := t11 + t29
+	t39 := t11 - t29
+	t40 := complex(-imag(t39), real(t39))
+	t41 := t36 + t38
+	t42 := t37 + t40
+	t43 := t36 - t38
+	t44 := t37 - t40
+	t45 := t7 + t25
+	t46 := t7 - t25
+	t47 := t16 + t34
+	t48 := t16 - t34
+	t49 := complex(-imag(t48), real(t48))
+	t50 := t45 + t47
+	t51 := t46 + t49
+	t52 := t45 - t47
+	t53 := t46 - t49
+	t54 := t8 + t26
+	t55 := t8 - t26
+	t56 := t17 + t35
+	t57 := t17 - t35
+	t58 := complex(-imag(t57), real(t57))
+	t59 := t54 + t56
+	t60 := t55 + t58
+	t61 := t54 - t56
+	t62 := t55 - t58
+
+	d[0] = complex(real(t41)*0.08333333333333333, imag(t41)*0.08333333333333333)
+	d[1] = complex(real(t51)*0.08333333333333333, imag(t51)*0.08333333333333333)
+	d[2] = complex(real(t61)*0.08333333333333333, imag(t61)*0.08333333333333333)
+	d[3] = complex(real(t44)*0.08333333333333333, imag(t44)*0.08333333333333333)
+	d[4] = complex(real(t50)*0.08333333333333333, imag(t50)*0.08333333333333333)
+	d[5] = complex(real(t60)*0.08333333333333333, imag(t60)*0.08333333333333333)
+	d[6] = complex(real(t43)*0.08333333333333333, imag(t43)*0.08333333333333333)
+	d[7] = complex(real(t53)*0.08333333333333333, imag(t53)*0.08333333333333333)
+	d[8] = complex(real(t59)*0.08333333333333333, imag(t59)*0.08333333333333333)
+	d[9] = complex(real(t42)*0.08333333333333333, imag(t42)*0.08333333333333333)
+	d[10] = complex(real(t52)*0.08333333333333333, imag(t52)*0.08333333333333333)
+	d[11] = complex(real(t62)*0.08333333333333333, imag(t62)*0.08333333333333333)
+
+	return true
+}
+
+// forwardDFT12Complex128 computes a 12-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT12Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 12
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x7 + x11
+	t10 := x7 - x11
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x10 + x2
+	t19 := x10 - x2
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x1 + x5
+	t28 := x1 - x5
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t2 + t20
+	t37 := t2 - t20
+	t38 := t11 + t29
+	t39 := t11 - t29
+	t40 := complex(imag(t39), -real(t39))
+	t41 := t36 + t38
+	t42 := t37 + t40
+	t43 := t36 - t38
+	t44 := t37 - t40
+	t45 := t8 + t26
+	t46 := t8 - t26
+	t47 := t17 + t35
+	t48 := t17 - t35
+	t49 := complex(imag(t48), -real(t48))
+	t50 := t45 + t47
+	t51 := t46 + t49
+	t52 := t45 - t47
+	t53 := t46 - t49
+	t54 := t7 + t25
+	t55 := t7 - t25
+	t56 := t16 + t34
+	t57 := t16 - t34
+	t58 := complex(imag(t57), -real(t57))
+	t59 := t54 + t56
+	t60 := t55 + t58
+	t61 := t54 - t56
+	t62 := t55 - t58
+
+	d[0] = t41
+	d[1] = t51
+	d[2] = t61
+	d[3] = t44
+	d[4] = t50
+	d[5] = t60
+	d[6] = t43
+	d[7] = t53
+	d[8] = t59
+	d[9] = t42
+	d[10] = t52
+	d[11] = t62
+
+	return true
+}
+
+// inverseDFT12Complex128 computes a 12-point inverse DFT of complex128 data,
+// including the 1/12 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT12Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 12
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x7 + x11
+	t10 := x7 - x11
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x10 + x2
+	t19 := x10 - x2
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x1 + x5
+	t28 := x1 - x5
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t2 + t20
+	t37 := t2 - t20
+	t38 := t11 + t29
+	t39 := t11 - t29
+	t40 := complex(-imag(t39), real(t39))
+	t41 := t36 + t38
+	t42 := t37 + t40
+	t43 := t36 - t38
+	t44 := t37 - t40
+	t45 := t7 + t25
+	t46 := t7 - t25
+	t47 := t16 + t34
+	t48 := t16 - t34
+	t49 := complex(-imag(t48), real(t48))
+	t50 := t45 + t47
+	t51 := t46 + t49
+	t52 := t45 - t47
+	t53 := t46 - t49
+	t54 := t8 + t26
+	t55 := t8 - t26
+	t56 := t17 + t35
+	t57 := t17 - t35
+	t58 := complex(-imag(t57), real(t57))
+	t59 := t54 + t56
+	t60 := t55 + t58
+	t61 := t54 - t56
+	t62 := t55 - t58
+
+	d[0] = complex(real(t41)*0.08333333333333333, imag(t41)*0.08333333333333333)
+	d[1] = complex(real(t51)*0.08333333333333333, imag(t51)*0.08333333333333333)
+	d[2] = complex(real(t61)*0.08333333333333333, imag(t61)*0.08333333333333333)
+	d[3] = complex(real(t44)*0.08333333333333333, imag(t44)*0.08333333333333333)
+	d[4] = complex(real(t50)*0.08333333333333333, imag(t50)*0.08333333333333333)
+	d[5] = complex(real(t60)*0.08333333333333333, imag(t60)*0.08333333333333333)
+	d[6] = complex(real(t43)*0.08333333333333333, imag(t43)*0.08333333333333333)
+	d[7] = complex(real(t53)*0.08333333333333333, imag(t53)*0.08333333333333333)
+	d[8] = complex(real(t59)*0.08333333333333333, imag(t59)*0.08333333333333333)
+	d[9] = complex(real(t42)*0.08333333333333333, imag(t42)*0.08333333333333333)
+	d[10] = complex(real(t52)*0.08333333333333333, imag(t52)*0.08333333333333333)
+	d[11] = complex(real(t62)*0.08333333333333333, imag(t62)*0.08333333333333333)
+
+	return true
+}
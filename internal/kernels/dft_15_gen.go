@@ -0,0 +1,589 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT15Complex64 computes a 15-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT15Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 15
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+
+	t0 := x3 + x12
+	t1 := x3 - x12
+	t2 := x6 + x9
+	t3 := x6 - x9
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x8 + x2
+	t18 := x8 - x2
+	t19 := x11 + x14
+	t20 := x11 - x14
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x13 + x7
+	t35 := x13 - x7
+	t36 := x1 + x4
+	t37 := x1 - x4
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t27 + t44
+	t61 := t27 - t44
+	t62 := t10 + t60
+	t63 := real(t10) - 0.49999999999999994*real(t60)
+	t64 := imag(t10) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t33 + t50
+	t70 := t33 - t50
+	t71 := t16 + t69
+	t72 := real(t16) - 0.49999999999999994*real(t69)
+	t73 := imag(t16) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t32 + t49
+	t79 := t32 - t49
+	t80 := t15 + t78
+	t81 := real(t15) - 0.49999999999999994*real(t78)
+	t82 := imag(t15) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t26 + t43
+	t88 := t26 - t43
+	t89 := t9 + t87
+	t90 := real(t9) - 0.49999999999999994*real(t87)
+	t91 := imag(t9) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+
+	d[0] = t53
+	d[1] = t68
+	d[2] = t76
+	d[3] = t80
+	d[4] = t95
+	d[5] = t58
+	d[6] = t62
+	d[7] = t77
+	d[8] = t85
+	d[9] = t89
+	d[10] = t59
+	d[11] = t67
+	d[12] = t71
+	d[13] = t86
+	d[14] = t94
+
+	return true
+}
+
+// inverseDFT15Complex64 computes a 15-point inverse DFT of complex64 data,
+// including the 1/15 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT15Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 15
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+
+	t0 := x3 + x12
+	t1 := x3 - x12
+	t2 := x6 + x9
+	t3 := x6 - x9
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x8 + x2
+	t18 := x8 - x2
+	t19 := x11 + x14
+	t20 := x11 - x14
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x13 + x7
+	t35 := x13 - x7
+	t36 := x1 + x4
+	t37 := x1 - x4
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t26 + t43
+	t61 := t26 - t43
+	t62 := t9 + t60
+	t63 := real(t9) - 0.49999999999999994*real(t60)
+	t64 := imag(t9) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t32 + t49
+	t70 := t32 - t49
+	t71 := t15 + t69
+	t72 := real(t15) - 0.49999999999999994*real(t69)
+	t73 := imag(t15) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t33 + t50
+	t79 := t33 - t50
+	t80 := t16 + t78
+	t81 := real(t16) - 0.49999999999999994*real(t78)
+	t82 := imag(t16) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t27 + t44
+	t88 := t27 - t44
+	t89 := t10 + t87
+	t90 := real(t10) - 0.49999999999999994*real(t87)
+	t91 := imag(t10) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+
+	d[0] = complex(real(t53)*0.06666666666666667, imag(t53)*0.06666666666666667)
+	d[1] = complex(real(t67)*0.06666666666666667, imag(t67)*0.06666666666666667)
+	d[2] = complex(real(t77)*0.06666666666666667, imag(t77)*0.06666666666666667)
+	d[3] = complex(real(t80)*0.06666666666666667, imag(t80)*0.06666666666666667)
+	d[4] = complex(real(t94)*0.06666666666666667, imag(t94)*0.06666666666666667)
+	d[5] = complex(real(t59)*0.06666666666666667, imag(t59)*0.06666666666666667)
+	d[6] = complex(real(t62)*0.06666666666666667, imag(t62)*0.06666666666666667)
+	d[7] = complex(real(t76)*0.06666666666666667, imag(t76)*0.06666666666666667)
+	d[8] = complex(real(t86)*0.06666666666666667, imag(t86)*0.06666666666666667)
+	d[9] = complex(real(t89)*0.06666666666666667, imag(t89)*0.06666666666666667)
+	d[10] = complex(real(t58)*0.06666666666666667, imag(t58)*0.06666666666666667)
+	d[11] = complex(real(t68)*0.06666666666666667, imag(t68)*0.06666666666666667)
+	d[12] = complex(real(t71)*0.06666666666666667, imag(t71)*0.06666666666666667)
+	d[13] = complex(real(t85)*0.06666666666666667, imag(t85)*0.06666666666666667)
+	d[14] = complex(real(t95)*0.06666666666666667, imag(t95)*0.06666666666666667)
+
+	return true
+}
+
+// forwardDFT15Complex128 computes a 15-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT15Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 15
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+
+	t0 := x3 + x12
+	t1 := x3 - x12
+	t2 := x6 + x9
+	t3 := x6 - x9
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x8 + x2
+	t18 := x8 - x2
+	t19 := x11 + x14
+	t20 := x11 - x14
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x13 + x7
+	t35 := x13 - x7
+	t36 := x1 + x4
+	t37 := x1 - x4
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t27 + t44
+	t61 := t27 - t44
+	t62 := t10 + t60
+	t63 := real(t10) - 0.49999999999999994*real(t60)
+	t64 := imag(t10) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t33 + t50
+	t70 := t33 - t50
+	t71 := t16 + t69
+	t72 := real(t16) - 0.49999999999999994*real(t69)
+	t73 := imag(t16) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t32 + t49
+	t79 := t32 - t49
+	t80 := t15 + t78
+	t81 := real(t15) - 0.49999999999999994*real(t78)
+	t82 := imag(t15) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t26 + t43
+	t88 := t26 - t43
+	t89 := t9 + t87
+	t90 := real(t9) - 0.49999999999999994*real(t87)
+	t91 := imag(t9) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+
+	d[0] = t53
+	d[1] = t68
+	d[2] = t76
+	d[3] = t80
+	d[4] = t95
+	d[5] = t58
+	d[6] = t62
+	d[7] = t77
+	d[8] = t85
+	d[9] = t89
+	d[10] = t59
+	d[11] = t67
+	d[12] = t71
+	d[13] = t86
+	d[14] = t94
+
+	return true
+}
+
+// inverseDFT15Complex128 computes a 15-point inverse DFT of complex128 data,
+// including the 1/15 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT15Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 15
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+
+	t0 := x3 + x12
+	t1 := x3 - x12
+	t2 := x6 + x9
+	t3 := x6 - x9
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x8 + x2
+	t18 := x8 - x2
+	t19 := x11 + x14
+	t20 := x11 - x14
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x13 + x7
+	t35 := x13 - x7
+	t36 := x1 + x4
+	t37 := x1 - x4
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t26 + t43
+	t61 := t26 - t43
+	t62 := t9 + t60
+	t63 := real(t9) - 0.49999999999999994*real(t60)
+	t64 := imag(t9) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t32 + t49
+	t70 := t32 - t49
+	t71 := t15 + t69
+	t72 := real(t15) - 0.49999999999999994*real(t69)
+	t73 := imag(t15) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t33 + t50
+	t79 := t33 - t50
+	t80 := t16 + t78
+	t81 := real(t16) - 0.49999999999999994*real(t78)
+	t82 := imag(t16) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t27 + t44
+	t88 := t27 - t44
+	t89 := t10 + t87
+	t90 := real(t10) - 0.49999999999999994*real(t87)
+	t91 := imag(t10) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+
+	d[0] = complex(real(t53)*0.06666666666666667, imag(t53)*0.06666666666666667)
+	d[1] = complex(real(t67)*0.06666666666666667, imag(t67)*0.06666666666666667)
+	d[2] = complex(real(t77)*0.06666666666666667, imag(t77)*0.06666666666666667)
+	d[3] = complex(real(t80)*0.06666666666666667, imag(t80)*0.06666666666666667)
+	d[4] = complex(real(t94)*0.06666666666666667, imag(t94)*0.06666666666666667)
+	d[5] = complex(real(t59)*0.06666666666666667, imag(t59)*0.06666666666666667)
+	d[6] = complex(real(t62)*0.06666666666666667, imag(t62)*0.06666666666666667)
+	d[7] = complex(real(t76)*0.06666666666666667, imag(t76)*0.06666666666666667)
+	d[8] = complex(real(t86)*0.06666666666666667, imag(t86)*0.06666666666666667)
+	d[9] = complex(real(t89)*0.06666666666666667, imag(t89)*0.06666666666666667)
+	d[10] = complex(real(t58)*0.06666666666666667, imag(t58)*0.06666666666666667)
+	d[11] = complex(real(t68)*0.06666666666666667, imag(t68)*0.06666666666666667)
+	d[12] = complex(real(t71)*0.06666666666666667, imag(t71)*0.06666666666666667)
+	d[13] = complex(real(t85)*0.06666666666666667, imag(t85)*0.06666666666666667)
+	d[14] = complex(real(t95)*0.06666666666666667, imag(t95)*0.06666666666666667)
+
+	return true
+}
@@ -0,0 +1,697 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT20Complex64 computes a 20-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT20Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 20
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x9 + x1
+	t18 := x9 - x1
+	t19 := x13 + x17
+	t20 := x13 - x17
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x14 + x6
+	t35 := x14 - x6
+	t36 := x18 + x2
+	t37 := x18 - x2
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x19 + x11
+	t52 := x19 - x11
+	t53 := x3 + x7
+	t54 := x3 - x7
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := t4 + t38
+	t69 := t4 - t38
+	t70 := t21 + t55
+	t71 := t21 - t55
+	t72 := complex(imag(t71), -real(t71))
+	t73 := t68 + t70
+	t74 := t69 + t72
+	t75 := t68 - t70
+	t76 := t69 - t72
+	t77 := t10 + t44
+	t78 := t10 - t44
+	t79 := t27 + t61
+	t80 := t27 - t61
+	t81 := complex(imag(t80), -real(t80))
+	t82 := t77 + t79
+	t83 := t78 + t81
+	t84 := t77 - t79
+	t85 := t78 - t81
+	t86 := t16 + t50
+	t87 := t16 - t50
+	t88 := t33 + t67
+	t89 := t33 - t67
+	t90 := complex(imag(t89), -real(t89))
+	t91 := t86 + t88
+	t92 := t87 + t90
+	t93 := t86 - t88
+	t94 := t87 - t90
+	t95 := t15 + t49
+	t96 := t15 - t49
+	t97 := t32 + t66
+	t98 := t32 - t66
+	t99 := complex(imag(t98), -real(t98))
+	t100 := t95 + t97
+	t101 := t96 + t99
+	t102 := t95 - t97
+	t103 := t96 - t99
+	t104 := t9 + t43
+	t105 := t9 - t43
+	t106 := t26 + t60
+	t107 := t26 - t60
+	t108 := complex(imag(t107), -real(t107))
+	t109 := t104 + t106
+	t110 := t105 + t108
+	t111 := t104 - t106
+	t112 := t105 - t108
+
+	d[0] = t73
+	d[1] = t83
+	d[2] = t93
+	d[3] = t103
+	d[4] = t109
+	d[5] = t74
+	d[6] = t84
+	d[7] = t94
+	d[8] = t100
+	d[9] = t110
+	d[10] = t75
+	d[11] = t85
+	d[12] = t91
+	d[13] = t101
+	d[14] = t111
+	d[15] = t76
+	d[16] = t82
+	d[17] = t92
+	d[18] = t102
+	d[19] = t112
+
+	return true
+}
+
+// inverseDFT20Complex64 computes a 20-point inverse DFT of complex64 data,
+// including the 1/20 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT20Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 20
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x9 + x1
+	t18 := x9 - x1
+	t19 := x13 + x17
+	t20 := x13 - x17
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x14 + x6
+	t35 := x14 - x6
+	t36 := x18 + x2
+	t37 := x18 - x2
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x19 + x11
+	t52 := x19 - x11
+	t53 := x3 + x7
+	t54 := x3 - x7
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := t4 + t38
+	t69 := t4 - t38
+	t70 := t21 + t55
+	t71 := t21 - t55
+	t72 := complex(-imag(t71), real(t71))
+	t73 := t68 + t70
+	t74 := t69 + t72
+	t75 := t68 - t70
+	t76 := t69 - t72
+	t77 := t9 + t43
+	t78 := t9 - t43
+	t79 := t26 + t60
+	t80 := t26 - t60
+	t81 := complex(-imag(t80), real(t80))
+	t82 := t77 + t79
+	t83 := t78 + t81
+	t84 := t77 - t79
+	t85 := t78 - t81
+	t86 := t15 + t49
+	t87 := t15 - t49
+	t88 := t32 + t66
+	t89 := t32 - t66
+	t90 := complex(-imag(t89), real(t89))
+	t91 := t86 + t88
+	t92 := t87 + t90
+	t93 := t86 - t88
+	t94 := t87 - t90
+	t95 := t16 + t50
+	t96 := t16 - t50
+	t97 := t33 + t67
+	t98 := t33 - t67
+	t99 := complex(-imag(t98), real(t98))
+	t100 := t95 + t97
+	t101 := t96 + t99
+	t102 := t95 - t97
+	t103 := t96 - t99
+	t104 := t10 + t44
+	t105 := t10 - t44
+	t106 := t27 + t61
+	t107 := t27 - t61
+	t108 := complex(-imag(t107), real(t107))
+	t109 := t104 + t106
+	t110 := t105 + t108
+	t111 := t104 - t106
+	t112 := t105 - t108
+
+	d[0] = complex(real(t73)*0.05, imag(t73)*0.05)
+	d[1] = complex(real(t83)*0.05, imag(t83)*0.05)
+	d[2] = complex(real(t93)*0.05, imag(t93)*0.05)
+	d[3] = complex(real(t103)*0.05, imag(t103)*0.05)
+	d[4] = complex(real(t109)*0.05, imag(t109)*0.05)
+	d[5] = complex(real(t74)*0.05, imag(t74)*0.05)
+	d[6] = complex(real(t84)*0.05, imag(t84)*0.05)
+	d[7] = complex(real(t94)*0.05, imag(t94)*0.05)
+	d[8] = complex(real(t100)*0.05, imag(t100)*0.05)
+	d[9] = complex(real(t110)*0.05, imag(t110)*0.05)
+	d[10] = complex(real(t75)*0.05, imag(t75)*0.05)
+	d[11] = complex(real(t85)*0.05, imag(t85)*0.05)
+	d[12] = complex(real(t91)*0.05, imag(t91)*0.05)
+	d[13] = complex(real(t101)*0.05, imag(t101)*0.05)
+	d[14] = complex(real(t111)*0.05, imag(t111)*0.05)
+	d[15] = complex(real(t76)*0.05, imag(t76)*0.05)
+	d[16] = complex(real(t82)*0.05, imag(t82)*0.05)
+	d[17] = complex(real(t92)*0.05, imag(t92)*0.05)
+	d[18] = complex(real(t102)*0.05, imag(t102)*0.05)
+	d[19] = complex(real(t112)*0.05, imag(t112)*0.05)
+
+	return true
+}
+
+// forwardDFT20Complex128 computes a 20-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT20Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 20
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x9 + x1
+	t18 := x9 - x1
+	t19 := x13 + x17
+	t20 := x13 - x17
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x14 + x6
+	t35 := x14 - x6
+	t36 := x18 + x2
+	t37 := x18 - x2
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x19 + x11
+	t52 := x19 - x11
+	t53 := x3 + x7
+	t54 := x3 - x7
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := t4 + t38
+	t69 := t4 - t38
+	t70 := t21 + t55
+	t71 := t21 - t55
+	t72 := complex(imag(t71), -real(t71))
+	t73 := t68 + t70
+	t74 := t69 + t72
+	t75 := t68 - t70
+	t76 := t69 - t72
+	t77 := t10 + t44
+	t78 := t10 - t44
+	t79 := t27 + t61
+	t80 := t27 - t61
+	t81 := complex(imag(t80), -real(t80))
+	t82 := t77 + t79
+	t83 := t78 + t81
+	t84 := t77 - t79
+	t85 := t78 - t81
+	t86 := t16 + t50
+	t87 := t16 - t50
+	t88 := t33 + t67
+	t89 := t33 - t67
+	t90 := complex(imag(t89), -real(t89))
+	t91 := t86 + t88
+	t92 := t87 + t90
+	t93 := t86 - t88
+	t94 := t87 - t90
+	t95 := t15 + t49
+	t96 := t15 - t49
+	t97 := t32 + t66
+	t98 := t32 - t66
+	t99 := complex(imag(t98), -real(t98))
+	t100 := t95 + t97
+	t101 := t96 + t99
+	t102 := t95 - t97
+	t103 := t96 - t99
+	t104 := t9 + t43
+	t105 := t9 - t43
+	t106 := t26 + t60
+	t107 := t26 - t60
+	t108 := complex(imag(t107), -real(t107))
+	t109 := t104 + t106
+	t110 := t105 + t108
+	t111 := t104 - t106
+	t112 := t105 - t108
+
+	d[0] = t73
+	d[1] = t83
+	d[2] = t93
+	d[3] = t103
+	d[4] = t109
+	d[5] = t74
+	d[6] = t84
+	d[7] = t94
+	d[8] = t100
+	d[9] = t110
+	d[10] = t75
+	d[11] = t85
+	d[12] = t91
+	d[13] = t101
+	d[14] = t111
+	d[15] = t76
+	d[16] = t82
+	d[17] = t92
+	d[18] = t102
+	d[19] = t112
+
+	return true
+}
+
+// inverseDFT20Complex128 computes a 20-point inverse DFT of complex128 data,
+// including the 1/20 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT20Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 20
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x9 + x1
+	t18 := x9 - x1
+	t19 := x13 + x17
+	t20 := x13 - x17
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x14 + x6
+	t35 := x14 - x6
+	t36 := x18 + x2
+	t37 := x18 - x2
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x19 + x11
+	t52 := x19 - x11
+	t53 := x3 + x7
+	t54 := x3 - x7
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := t4 + t38
+	t69 := t4 - t38
+	t70 := t21 + t55
+	t71 := t21 - t55
+	t72 := complex(-imag(t71), real(t71))
+	t73 := t68 + t70
+	t74 := t69 + t72
+	t75 := t68 - t70
+	t76 := t69 - t72
+	t77 := t9 + t43
+	t78 := t9 - t43
+	t79 := t26 + t60
+	t80 := t26 - t60
+	t81 := complex(-imag(t80), real(t80))
+	t82 := t77 + t79
+	t83 := t78 + t81
+	t84 := t77 - t79
+	t85 := t78 - t81
+	t86 := t15 + t49
+	t87 := t15 - t49
+	t88 := t32 + t66
+	t89 := t32 - t66
+	t90 := complex(-imag(t89), real(t89))
+	t91 := t86 + t88
+	t92 := t87 + t90
+	t93 := t86 - t88
+	t94 := t87 - t90
+	t95 := t16 + t50
+	t96 := t16 - t50
+	t97 := t33 + t67
+	t98 := t33 - t67
+	t99 := complex(-imag(t98), real(t98))
+	t100 := t95 + t97
+	t101 := t96 + t99
+	t102 := t95 - t97
+	t103 := t96 - t99
+	t104 := t10 + t44
+	t105 := t10 - t44
+	t106 := t27 + t61
+	t107 := t27 - t61
+	t108 := complex(-imag(t107), real(t107))
+	t109 := t104 + t106
+	t110 := t105 + t108
+	t111 := t104 - t106
+	t112 := t105 - t108
+
+	d[0] = complex(real(t73)*0.05, imag(t73)*0.05)
+	d[1] = complex(real(t83)*0.05, imag(t83)*0.05)
+	d[2] = complex(real(t93)*0.05, imag(t93)*0.05)
+	d[3] = complex(real(t103)*0.05, imag(t103)*0.05)
+	d[4] = complex(real(t109)*0.05, imag(t109)*0.05)
+	d[5] = complex(real(t74)*0.05, imag(t74)*0.05)
+	d[6] = complex(real(t84)*0.05, imag(t84)*0.05)
+	d[7] = complex(real(t94)*0.05, imag(t94)*0.05)
+	d[8] = complex(real(t100)*0.05, imag(t100)*0.05)
+	d[9] = complex(real(t110)*0.05, imag(t110)*0.05)
+	d[10] = complex(real(t75)*0.05, imag(t75)*0.05)
+	d[11] = complex(real(t85)*0.05, imag(t85)*0.05)
+	d[12] = complex(real(t91)*0.05, imag(t91)*0.05)
+	d[13] = complex(real(t101)*0.05, imag(t101)*0.05)
+	d[14] = complex(real(t111)*0.05, imag(t111)*0.05)
+	d[15] = complex(real(t76)*0.05, imag(t76)*0.05)
+	d[16] = complex(real(t82)*0.05, imag(t82)*0.05)
+	d[17] = complex(real(t92)*0.05, imag(t92)*0.05)
+	d[18] = complex(real(t102)*0.05, imag(t102)*0.05)
+	d[19] = complex(real(t112)*0.05, imag(t112)*0.05)
+
+	return true
+}
@@ -0,0 +1,913 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT24Complex64 computes a 24-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT24Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 24
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x11 + x19
+	t10 := x11 - x19
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x14 + x22
+	t19 := x14 - x22
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x17 + x1
+	t28 := x17 - x1
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x20 + x4
+	t37 := x20 - x4
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x23 + x7
+	t46 := x23 - x7
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x2 + x10
+	t55 := x2 - x10
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x5 + x13
+	t64 := x5 - x13
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := t2 + t38
+	t73 := t2 - t38
+	t74 := t11 + t47
+	t75 := t11 - t47
+	t76 := t20 + t56
+	t77 := t20 - t56
+	t78 := t29 + t65
+	t79 := t29 - t65
+	t80 := t72 + t76
+	t81 := t72 - t76
+	t82 := t74 + t78
+	t83 := t74 - t78
+	t84 := complex(imag(t83), -real(t83))
+	t85 := t80 + t82
+	t86 := t81 + t84
+	t87 := t80 - t82
+	t88 := t81 - t84
+	t89 := complex((real(t75)+imag(t75))*0.7071067811865476, (imag(t75)-real(t75))*0.7071067811865476)
+	t90 := complex(imag(t77), -real(t77))
+	t91 := complex((-real(t79)+imag(t79))*0.7071067811865476, (-imag(t79)-real(t79))*0.7071067811865476)
+	t92 := t73 + t90
+	t93 := t73 - t90
+	t94 := t89 + t91
+	t95 := t89 - t91
+	t96 := complex(imag(t95), -real(t95))
+	t97 := t92 + t94
+	t98 := t93 + t96
+	t99 := t92 - t94
+	t100 := t93 - t96
+	t101 := t8 + t44
+	t102 := t8 - t44
+	t103 := t17 + t53
+	t104 := t17 - t53
+	t105 := t26 + t62
+	t106 := t26 - t62
+	t107 := t35 + t71
+	t108 := t35 - t71
+	t109 := t101 + t105
+	t110 := t101 - t105
+	t111 := t103 + t107
+	t112 := t103 - t107
+	t113 := complex(imag(t112), -real(t112))
+	t114 := t109 + t111
+	t115 := t110 + t113
+	t116 := t109 - t111
+	t117 := t110 - t113
+	t118 := complex((real(t104)+imag(t104))*0.7071067811865476, (imag(t104)-real(t104))*0.7071067811865476)
+	t119 := complex(imag(t106), -real(t106))
+	t120 := complex((-real(t108)+imag(t108))*0.7071067811865476, (-imag(t108)-real(t108))*0.7071067811865476)
+	t121 := t102 + t119
+	t122 := t102 - t119
+	t123 := t118 + t120
+	t124 := t118 - t120
+	t125 := complex(imag(t124), -real(t124))
+	t126 := t121 + t123
+	t127 := t122 + t125
+	t128 := t121 - t123
+	t129 := t122 - t125
+	t130 := t7 + t43
+	t131 := t7 - t43
+	t132 := t16 + t52
+	t133 := t16 - t52
+	t134 := t25 + t61
+	t135 := t25 - t61
+	t136 := t34 + t70
+	t137 := t34 - t70
+	t138 := t130 + t134
+	t139 := t130 - t134
+	t140 := t132 + t136
+	t141 := t132 - t136
+	t142 := complex(imag(t141), -real(t141))
+	t143 := t138 + t140
+	t144 := t139 + t142
+	t145 := t138 - t140
+	t146 := t139 - t142
+	t147 := complex((real(t133)+imag(t133))*0.7071067811865476, (imag(t133)-real(t133))*0.7071067811865476)
+	t148 := complex(imag(t135), -real(t135))
+	t149 := complex((-real(t137)+imag(t137))*0.7071067811865476, (-imag(t137)-real(t137))*0.7071067811865476)
+	t150 := t131 + t148
+	t151 := t131 - t148
+	t152 := t147 + t149
+	t153 := t147 - t149
+	t154 := complex(imag(t153), -real(t153))
+	t155 := t150 + t152
+	t156 := t151 + t154
+	t157 := t150 - t152
+	t158 := t151 - t154
+
+	d[0] = t85
+	d[1] = t126
+	d[2] = t144
+	d[3] = t98
+	d[4] = t116
+	d[5] = t157
+	d[6] = t88
+	d[7] = t129
+	d[8] = t143
+	d[9] = t97
+	d[10] = t115
+	d[11] = t156
+	d[12] = t87
+	d[13] = t128
+	d[14] = t146
+	d[15] = t100
+	d[16] = t114
+	d[17] = t155
+	d[18] = t86
+	d[19] = t127
+	d[20] = t145
+	d[21] = t99
+	d[22] = t117
+	d[23] = t158
+
+	return true
+}
+
+// inverseDFT24Complex64 computes a 24-point inverse DFT of complex64 data,
+// including the 1/24 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT24Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 24
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x11 + x19
+	t10 := x11 - x19
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x14 + x22
+	t19 := x14 - x22
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x17 + x1
+	t28 := x17 - x1
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x20 + x4
+	t37 := x20 - x4
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x23 + x7
+	t46 := x23 - x7
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x2 + x10
+	t55 := x2 - x10
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x5 + x13
+	t64 := x5 - x13
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := t2 + t38
+	t73 := t2 - t38
+	t74 := t11 + t47
+	t75 := t11 - t47
+	t76 := t20 + t56
+	t77 := t20 - t56
+	t78 := t29 + t65
+	t79 := t29 - t65
+	t80 := t72 + t76
+	t81 := t72 - t76
+	t82 := t74 + t78
+	t83 := t74 - t78
+	t84 := complex(-imag(t83), real(t83))
+	t85 := t80 + t82
+	t86 := t81 + t84
+	t87 := t80 - t82
+	t88 := t81 - t84
+	t89 := complex((real(t75)-imag(t75))*0.7071067811865476, (imag(t75)+real(t75))*0.7071067811865476)
+	t90 := complex(-imag(t77), real(t77))
+	t91 := complex((-real(t79)-imag(t79))*0.7071067811865476, (-imag(t79)+real(t79))*0.7071067811865476)
+	t92 := t73 + t90
+	t93 := t73 - t90
+	t94 := t89 + t91
+	t95 := t89 - t91
+	t96 := complex(-imag(t95), real(t95))
+	t97 := t92 + t94
+	t98 := t93 + t96
+	t99 := t92 - t94
+	t100 := t93 - t96
+	t101 := t7 + t43
+	t102 := t7 - t43
+	t103 := t16 + t52
+	t104 := t16 - t52
+	t105 := t25 + t61
+	t106 := t25 - t61
+	t107 := t34 + t70
+	t108 := t34 - t70
+	t109 := t101 + t105
+	t110 := t101 - t105
+	t111 := t103 + t107
+	t112 := t103 - t107
+	t113 := complex(-imag(t112), real(t112))
+	t114 := t109 + t111
+	t115 := t110 + t113
+	t116 := t109 - t111
+	t117 := t110 - t113
+	t118 := complex((real(t104)-imag(t104))*0.7071067811865476, (imag(t104)+real(t104))*0.7071067811865476)
+	t119 := complex(-imag(t106), real(t106))
+	t120 := complex((-real(t108)-imag(t108))*0.7071067811865476, (-imag(t108)+real(t108))*0.7071067811865476)
+	t121 := t102 + t119
+	t122 := t102 - t119
+	t123 := t118 + t120
+	t124 := t118 - t120
+	t125 := complex(-imag(t124), real(t124))
+	t126 := t121 + t123
+	t127 := t122 + t125
+	t128 := t121 - t123
+	t129 := t122 - t125
+	t130 := t8 + t44
+	t131 := t8 - t44
+	t132 := t17 + t53
+	t133 := t17 - t53
+	t134 := t26 + t62
+	t135 := t26 - t62
+	t136 := t35 + t71
+	t137 := t35 - t71
+	t138 := t130 + t134
+	t139 := t130 - t134
+	t140 := t132 + t136
+	t141 := t132 - t136
+	t142 := complex(-imag(t141), real(t141))
+	t143 := t138 + t140
+	t144 := t139 + t142
+	t145 := t138 - t140
+	t146 := t139 - t142
+	t147 := complex((real(t133)-imag(t133))*0.7071067811865476, (imag(t133)+real(t133))*0.7071067811865476)
+	t148 := complex(-imag(t135), real(t135))
+	t149 := complex((-real(t137)-imag(t137))*0.7071067811865476, (-imag(t137)+real(t137))*0.7071067811865476)
+	t150 := t131 + t148
+	t151 := t131 - t148
+	t152 := t147 + t149
+	t153 := t147 - t149
+	t154 := complex(-imag(t153), real(t153))
+	t155 := t150 + t152
+	t156 := t151 + t154
+	t157 := t150 - t152
+	t158 := t151 - t154
+
+	d[0] = complex(real(t85)*0.041666666666666664, imag(t85)*0.041666666666666664)
+	d[1] = complex(real(t126)*0.041666666666666664, imag(t126)*0.041666666666666664)
+	d[2] = complex(real(t144)*0.041666666666666664, imag(t144)*0.041666666666666664)
+	d[3] = complex(real(t98)*0.041666666666666664, imag(t98)*0.041666666666666664)
+	d[4] = complex(real(t116)*0.041666666666666664, imag(t116)*0.041666666666666664)
+	d[5] = complex(real(t157)*0.041666666666666664, imag(t157)*0.041666666666666664)
+	d[6] = complex(real(t88)*0.041666666666666664, imag(t88)*0.041666666666666664)
+	d[7] = complex(real(t129)*0.041666666666666664, imag(t129)*0.041666666666666664)
+	d[8] = complex(real(t143)*0.041666666666666664, imag(t143)*0.041666666666666664)
+	d[9] = complex(real(t97)*0.041666666666666664, imag(t97)*0.041666666666666664)
+	d[10] = complex(real(t115)*0.041666666666666664, imag(t115)*0.041666666666666664)
+	d[11] = complex(real(t156)*0.041666666666666664, imag(t156)*0.041666666666666664)
+	d[12] = complex(real(t87)*0.041666666666666664, imag(t87)*0.041666666666666664)
+	d[13] = complex(real(t128)*0.041666666666666664, imag(t128)*0.041666666666666664)
+	d[14] = complex(real(t146)*0.041666666666666664, imag(t146)*0.041666666666666664)
+	d[15] = complex(real(t100)*0.041666666666666664, imag(t100)*0.041666666666666664)
+	d[16] = complex(real(t114)*0.041666666666666664, imag(t114)*0.041666666666666664)
+	d[17] = complex(real(t155)*0.041666666666666664, imag(t155)*0.041666666666666664)
+	d[18] = complex(real(t86)*0.041666666666666664, imag(t86)*0.041666666666666664)
+	d[19] = complex(real(t127)*0.041666666666666664, imag(t127)*0.041666666666666664)
+	d[20] = complex(real(t145)*0.041666666666666664, imag(t145)*0.041666666666666664)
+	d[21] = complex(real(t99)*0.041666666666666664, imag(t99)*0.041666666666666664)
+	d[22] = complex(real(t117)*0.041666666666666664, imag(t117)*0.041666666666666664)
+	d[23] = complex(real(t158)*0.041666666666666664, imag(t158)*0.041666666666666664)
+
+	return true
+}
+
+// forwardDFT24Complex128 computes a 24-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT24Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 24
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x11 + x19
+	t10 := x11 - x19
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x14 + x22
+	t19 := x14 - x22
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x17 + x1
+	t28 := x17 - x1
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x20 + x4
+	t37 := x20 - x4
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x23 + x7
+	t46 := x23 - x7
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x2 + x10
+	t55 := x2 - x10
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x5 + x13
+	t64 := x5 - x13
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := t2 + t38
+	t73 := t2 - t38
+	t74 := t11 + t47
+	t75 := t11 - t47
+	t76 := t20 + t56
+	t77 := t20 - t56
+	t78 := t29 + t65
+	t79 := t29 - t65
+	t80 := t72 + t76
+	t81 := t72 - t76
+	t82 := t74 + t78
+	t83 := t74 - t78
+	t84 := complex(imag(t83), -real(t83))
+	t85 := t80 + t82
+	t86 := t81 + t84
+	t87 := t80 - t82
+	t88 := t81 - t84
+	t89 := complex((real(t75)+imag(t75))*0.7071067811865476, (imag(t75)-real(t75))*0.7071067811865476)
+	t90 := complex(imag(t77), -real(t77))
+	t91 := complex((-real(t79)+imag(t79))*0.7071067811865476, (-imag(t79)-real(t79))*0.7071067811865476)
+	t92 := t73 + t90
+	t93 := t73 - t90
+	t94 := t89 + t91
+	t95 := t89 - t91
+	t96 := complex(imag(t95), -real(t95))
+	t97 := t92 + t94
+	t98 := t93 + t96
+	t99 := t92 - t94
+	t100 := t93 - t96
+	t101 := t8 + t44
+	t102 := t8 - t44
+	t103 := t17 + t53
+	t104 := t17 - t53
+	t105 := t26 + t62
+	t106 := t26 - t62
+	t107 := t35 + t71
+	t108 := t35 - t71
+	t109 := t101 + t105
+	t110 := t101 - t105
+	t111 := t103 + t107
+	t112 := t103 - t107
+	t113 := complex(imag(t112), -real(t112))
+	t114 := t109 + t111
+	t115 := t110 + t113
+	t116 := t109 - t111
+	t117 := t110 - t113
+	t118 := complex((real(t104)+imag(t104))*0.7071067811865476, (imag(t104)-real(t104))*0.7071067811865476)
+	t119 := complex(imag(t106), -real(t106))
+	t120 := complex((-real(t108)+imag(t108))*0.7071067811865476, (-imag(t108)-real(t108))*0.7071067811865476)
+	t121 := t102 + t119
+	t122 := t102 - t119
+	t123 := t118 + t120
+	t124 := t118 - t120
+	t125 := complex(imag(t124), -real(t124))
+	t126 := t121 + t123
+	t127 := t122 + t125
+	t128 := t121 - t123
+	t129 := t122 - t125
+	t130 := t7 + t43
+	t131 := t7 - t43
+	t132 := t16 + t52
+	t133 := t16 - t52
+	t134 := t25 + t61
+	t135 := t25 - t61
+	t136 := t34 + t70
+	t137 := t34 - t70
+	t138 := t130 + t134
+	t139 := t130 - t134
+	t140 := t132 + t136
+	t141 := t132 - t136
+	t142 := complex(imag(t141), -real(t141))
+	t143 := t138 + t140
+	t144 := t139 + t142
+	t145 := t138 - t140
+	t146 := t139 - t142
+	t147 := complex((real(t133)+imag(t133))*0.7071067811865476, (imag(t133)-real(t133))*0.7071067811865476)
+	t148 := complex(imag(t135), -real(t135))
+	t149 := complex((-real(t137)+imag(t137))*0.7071067811865476, (-imag(t137)-real(t137))*0.7071067811865476)
+	t150 := t131 + t148
+	t151 := t131 - t148
+	t152 := t147 + t149
+	t153 := t147 - t149
+	t154 := complex(imag(t153), -real(t153))
+	t155 := t150 + t152
+	t156 := t151 + t154
+	t157 := t150 - t152
+	t158 := t151 - t154
+
+	d[0] = t85
+	d[1] = t126
+	d[2] = t144
+	d[3] = t98
+	d[4] = t116
+	d[5] = t157
+	d[6] = t88
+	d[7] = t129
+	d[8] = t143
+	d[9] = t97
+	d[10] = t115
+	d[11] = t156
+	d[12] = t87
+	d[13] = t128
+	d[14] = t146
+	d[15] = t100
+	d[16] = t114
+	d[17] = t155
+	d[18] = t86
+	d[19] = t127
+	d[20] = t145
+	d[21] = t99
+	d[22] = t117
+	d[23] = t158
+
+	return true
+}
+
+// inverseDFT24Complex128 computes a 24-point inverse DFT of complex128 data,
+// including the 1/24 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT24Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 24
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x11 + x19
+	t10 := x11 - x19
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x14 + x22
+	t19 := x14 - x22
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x17 + x1
+	t28 := x17 - x1
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x20 + x4
+	t37 := x20 - x4
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x23 + x7
+	t46 := x23 - x7
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x2 + x10
+	t55 := x2 - x10
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x5 + x13
+	t64 := x5 - x13
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := t2 + t38
+	t73 := t2 - t38
+	t74 := t11 + t47
+	t75 := t11 - t47
+	t76 := t20 + t56
+	t77 := t20 - t56
+	t78 := t29 + t65
+	t79 := t29 - t65
+	t80 := t72 + t76
+	t81 := t72 - t76
+	t82 := t74 + t78
+	t83 := t74 - t78
+	t84 := complex(-imag(t83), real(t83))
+	t85 := t80 + t82
+	t86 := t81 + t84
+	t87 := t80 - t82
+	t88 := t81 - t84
+	t89 := complex((real(t75)-imag(t75))*0.7071067811865476, (imag(t75)+real(t75))*0.7071067811865476)
+	t90 := complex(-imag(t77), real(t77))
+	t91 := complex((-real(t79)-imag(t79))*0.7071067811865476, (-imag(t79)+real(t79))*0.7071067811865476)
+	t92 := t73 + t90
+	t93 := t73 - t90
+	t94 := t89 + t91
+	t95 := t89 - t91
+	t96 := complex(-imag(t95), real(t95))
+	t97 := t92 + t94
+	t98 := t93 + t96
+	t99 := t92 - t94
+	t100 := t93 - t96
+	t101 := t7 + t43
+	t102 := t7 - t43
+	t103 := t16 + t52
+	t104 := t16 - t52
+	t105 := t25 + t61
+	t106 := t25 - t61
+	t107 := t34 + t70
+	t108 := t34 - t70
+	t109 := t101 + t105
+	t110 := t101 - t105
+	t111 := t103 + t107
+	t112 := t103 - t107
+	t113 := complex(-imag(t112), real(t112))
+	t114 := t109 + t111
+	t115 := t110 + t113
+	t116 := t109 - t111
+	t117 := t110 - t113
+	t118 := complex((real(t104)-imag(t104))*0.7071067811865476, (imag(t104)+real(t104))*0.7071067811865476)
+	t119 := complex(-imag(t106), real(t106))
+	t120 := complex((-real(t108)-imag(t108))*0.7071067811865476, (-imag(t108)+real(t108))*0.7071067811865476)
+	t121 := t102 + t119
+	t122 := t102 - t119
+	t123 := t118 + t120
+	t124 := t118 - t120
+	t125 := complex(-imag(t124), real(t124))
+	t126 := t121 + t123
+	t127 := t122 + t125
+	t128 := t121 - t123
+	t129 := t122 - t125
+	t130 := t8 + t44
+	t131 := t8 - t44
+	t132 := t17 + t53
+	t133 := t17 - t53
+	t134 := t26 + t62
+	t135 := t26 - t62
+	t136 := t35 + t71
+	t137 := t35 - t71
+	t138 := t130 + t134
+	t139 := t130 - t134
+	t140 := t132 + t136
+	t141 := t132 - t136
+	t142 := complex(-imag(t141), real(t141))
+	t143 := t138 + t140
+	t144 := t139 + t142
+	t145 := t138 - t140
+	t146 := t139 - t142
+	t147 := complex((real(t133)-imag(t133))*0.7071067811865476, (imag(t133)+real(t133))*0.7071067811865476)
+	t148 := complex(-imag(t135), real(t135))
+	t149 := complex((-real(t137)-imag(t137))*0.7071067811865476, (-imag(t137)+real(t137))*0.7071067811865476)
+	t150 := t131 + t148
+	t151 := t131 - t148
+	t152 := t147 + t149
+	t153 := t147 - t149
+	t154 := complex(-imag(t153), real(t153))
+	t155 := t150 + t152
+	t156 := t151 + t154
+	t157 := t150 - t152
+	t158 := t151 - t154
+
+	d[0] = complex(real(t85)*0.041666666666666664, imag(t85)*0.041666666666666664)
+	d[1] = complex(real(t126)*0.041666666666666664, imag(t126)*0.041666666666666664)
+	d[2] = complex(real(t144)*0.041666666666666664, imag(t144)*0.041666666666666664)
+	d[3] = complex(real(t98)*0.041666666666666664, imag(t98)*0.041666666666666664)
+	d[4] = complex(real(t116)*0.041666666666666664, imag(t116)*0.041666666666666664)
+	d[5] = complex(real(t157)*0.041666666666666664, imag(t157)*0.041666666666666664)
+	d[6] = complex(real(t88)*0.041666666666666664, imag(t88)*0.041666666666666664)
+	d[7] = complex(real(t129)*0.041666666666666664, imag(t129)*0.041666666666666664)
+	d[8] = complex(real(t143)*0.041666666666666664, imag(t143)*0.041666666666666664)
+	d[9] = complex(real(t97)*0.041666666666666664, imag(t97)*0.041666666666666664)
+	d[10] = complex(real(t115)*0.041666666666666664, imag(t115)*0.041666666666666664)
+	d[11] = complex(real(t156)*0.041666666666666664, imag(t156)*0.041666666666666664)
+	d[12] = complex(real(t87)*0.041666666666666664, imag(t87)*0.041666666666666664)
+	d[13] = complex(real(t128)*0.041666666666666664, imag(t128)*0.041666666666666664)
+	d[14] = complex(real(t146)*0.041666666666666664, imag(t146)*0.041666666666666664)
+	d[15] = complex(real(t100)*0.041666666666666664, imag(t100)*0.041666666666666664)
+	d[16] = complex(real(t114)*0.041666666666666664, imag(t114)*0.041666666666666664)
+	d[17] = complex(real(t155)*0.041666666666666664, imag(t155)*0.041666666666666664)
+	d[18] = complex(real(t86)*0.041666666666666664, imag(t86)*0.041666666666666664)
+	d[19] = complex(real(t127)*0.041666666666666664, imag(t127)*0.041666666666666664)
+	d[20] = complex(real(t145)*0.041666666666666664, imag(t145)*0.041666666666666664)
+	d[21] = complex(real(t99)*0.041666666666666664, imag(t99)*0.041666666666666664)
+	d[22] = complex(real(t117)*0.041666666666666664, imag(t117)*0.041666666666666664)
+	d[23] = complex(real(t158)*0.041666666666666664, imag(t158)*0.041666666666666664)
+
+	return true
+}
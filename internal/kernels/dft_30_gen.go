@@ -0,0 +1,1213 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT30Complex64 computes a 30-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT30Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 30
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+
+	t0 := x6 + x24
+	t1 := x6 - x24
+	t2 := x12 + x18
+	t3 := x12 - x18
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x16 + x4
+	t18 := x16 - x4
+	t19 := x22 + x28
+	t20 := x22 - x28
+	t21 := x10 + t17 + t19
+	t22 := real(x10) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x10) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x10) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x10) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x26 + x14
+	t35 := x26 - x14
+	t36 := x2 + x8
+	t37 := x2 - x8
+	t38 := x20 + t34 + t36
+	t39 := real(x20) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x20) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x20) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x20) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t27 + t44
+	t61 := t27 - t44
+	t62 := t10 + t60
+	t63 := real(t10) - 0.49999999999999994*real(t60)
+	t64 := imag(t10) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t33 + t50
+	t70 := t33 - t50
+	t71 := t16 + t69
+	t72 := real(t16) - 0.49999999999999994*real(t69)
+	t73 := imag(t16) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t32 + t49
+	t79 := t32 - t49
+	t80 := t15 + t78
+	t81 := real(t15) - 0.49999999999999994*real(t78)
+	t82 := imag(t15) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t26 + t43
+	t88 := t26 - t43
+	t89 := t9 + t87
+	t90 := real(t9) - 0.49999999999999994*real(t87)
+	t91 := imag(t9) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := x21 + x9
+	t97 := x21 - x9
+	t98 := x27 + x3
+	t99 := x27 - x3
+	t100 := x15 + t96 + t98
+	t101 := real(x15) + 0.3090169943749474*real(t96) - 0.8090169943749473*real(t98)
+	t102 := imag(x15) + 0.3090169943749474*imag(t96) - 0.8090169943749473*imag(t98)
+	t103 := 0.9510565162951536*real(t97) + 0.5877852522924731*real(t99)
+	t104 := 0.9510565162951536*imag(t97) + 0.5877852522924731*imag(t99)
+	t105 := complex(t101-t104, t102+t103)
+	t106 := complex(t101+t104, t102-t103)
+	t107 := real(x15) - 0.8090169943749473*real(t96) + 0.3090169943749474*real(t98)
+	t108 := imag(x15) - 0.8090169943749473*imag(t96) + 0.3090169943749474*imag(t98)
+	t109 := 0.5877852522924731*real(t97) - 0.9510565162951536*real(t99)
+	t110 := 0.5877852522924731*imag(t97) - 0.9510565162951536*imag(t99)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := x1 + x19
+	t114 := x1 - x19
+	t115 := x7 + x13
+	t116 := x7 - x13
+	t117 := x25 + t113 + t115
+	t118 := real(x25) + 0.3090169943749474*real(t113) - 0.8090169943749473*real(t115)
+	t119 := imag(x25) + 0.3090169943749474*imag(t113) - 0.8090169943749473*imag(t115)
+	t120 := 0.9510565162951536*real(t114) + 0.5877852522924731*real(t116)
+	t121 := 0.9510565162951536*imag(t114) + 0.5877852522924731*imag(t116)
+	t122 := complex(t118-t121, t119+t120)
+	t123 := complex(t118+t121, t119-t120)
+	t124 := real(x25) - 0.8090169943749473*real(t113) + 0.3090169943749474*real(t115)
+	t125 := imag(x25) - 0.8090169943749473*imag(t113) + 0.3090169943749474*imag(t115)
+	t126 := 0.5877852522924731*real(t114) - 0.9510565162951536*real(t116)
+	t127 := 0.5877852522924731*imag(t114) - 0.9510565162951536*imag(t116)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := x11 + x29
+	t131 := x11 - x29
+	t132 := x17 + x23
+	t133 := x17 - x23
+	t134 := x5 + t130 + t132
+	t135 := real(x5) + 0.3090169943749474*real(t130) - 0.8090169943749473*real(t132)
+	t136 := imag(x5) + 0.3090169943749474*imag(t130) - 0.8090169943749473*imag(t132)
+	t137 := 0.9510565162951536*real(t131) + 0.5877852522924731*real(t133)
+	t138 := 0.9510565162951536*imag(t131) + 0.5877852522924731*imag(t133)
+	t139 := complex(t135-t138, t136+t137)
+	t140 := complex(t135+t138, t136-t137)
+	t141 := real(x5) - 0.8090169943749473*real(t130) + 0.3090169943749474*real(t132)
+	t142 := imag(x5) - 0.8090169943749473*imag(t130) + 0.3090169943749474*imag(t132)
+	t143 := 0.5877852522924731*real(t131) - 0.9510565162951536*real(t133)
+	t144 := 0.5877852522924731*imag(t131) - 0.9510565162951536*imag(t133)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := t117 + t134
+	t148 := t117 - t134
+	t149 := t100 + t147
+	t150 := real(t100) - 0.49999999999999994*real(t147)
+	t151 := imag(t100) - 0.49999999999999994*imag(t147)
+	t152 := 0.8660254037844387 * real(t148)
+	t153 := 0.8660254037844387 * imag(t148)
+	t154 := complex(t150-t153, t151+t152)
+	t155 := complex(t150+t153, t151-t152)
+	t156 := t123 + t140
+	t157 := t123 - t140
+	t158 := t106 + t156
+	t159 := real(t106) - 0.49999999999999994*real(t156)
+	t160 := imag(t106) - 0.49999999999999994*imag(t156)
+	t161 := 0.8660254037844387 * real(t157)
+	t162 := 0.8660254037844387 * imag(t157)
+	t163 := complex(t159-t162, t160+t161)
+	t164 := complex(t159+t162, t160-t161)
+	t165 := t129 + t146
+	t166 := t129 - t146
+	t167 := t112 + t165
+	t168 := real(t112) - 0.49999999999999994*real(t165)
+	t169 := imag(t112) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := t128 + t145
+	t175 := t128 - t145
+	t176 := t111 + t174
+	t177 := real(t111) - 0.49999999999999994*real(t174)
+	t178 := imag(t111) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := t122 + t139
+	t184 := t122 - t139
+	t185 := t105 + t183
+	t186 := real(t105) - 0.49999999999999994*real(t183)
+	t187 := imag(t105) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := t53 + t149
+	t193 := t53 - t149
+	t194 := t68 + t164
+	t195 := t68 - t164
+	t196 := t76 + t172
+	t197 := t76 - t172
+	t198 := t80 + t176
+	t199 := t80 - t176
+	t200 := t95 + t191
+	t201 := t95 - t191
+	t202 := t58 + t154
+	t203 := t58 - t154
+	t204 := t62 + t158
+	t205 := t62 - t158
+	t206 := t77 + t173
+	t207 := t77 - t173
+	t208 := t85 + t181
+	t209 := t85 - t181
+	t210 := t89 + t185
+	t211 := t89 - t185
+	t212 := t59 + t155
+	t213 := t59 - t155
+	t214 := t67 + t163
+	t215 := t67 - t163
+	t216 := t71 + t167
+	t217 := t71 - t167
+	t218 := t86 + t182
+	t219 := t86 - t182
+	t220 := t94 + t190
+	t221 := t94 - t190
+
+	d[0] = t192
+	d[1] = t195
+	d[2] = t196
+	d[3] = t199
+	d[4] = t200
+	d[5] = t203
+	d[6] = t204
+	d[7] = t207
+	d[8] = t208
+	d[9] = t211
+	d[10] = t212
+	d[11] = t215
+	d[12] = t216
+	d[13] = t219
+	d[14] = t220
+	d[15] = t193
+	d[16] = t194
+	d[17] = t197
+	d[18] = t198
+	d[19] = t201
+	d[20] = t202
+	d[21] = t205
+	d[22] = t206
+	d[23] = t209
+	d[24] = t210
+	d[25] = t213
+	d[26] = t214
+	d[27] = t217
+	d[28] = t218
+	d[29] = t221
+
+	return true
+}
+
+// inverseDFT30Complex64 computes a 30-point inverse DFT of complex64 data,
+// including the 1/30 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT30Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 30
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+
+	t0 := x6 + x24
+	t1 := x6 - x24
+	t2 := x12 + x18
+	t3 := x12 - x18
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x16 + x4
+	t18 := x16 - x4
+	t19 := x22 + x28
+	t20 := x22 - x28
+	t21 := x10 + t17 + t19
+	t22 := real(x10) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x10) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x10) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x10) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x26 + x14
+	t35 := x26 - x14
+	t36 := x2 + x8
+	t37 := x2 - x8
+	t38 := x20 + t34 + t36
+	t39 := real(x20) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x20) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x20) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x20) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t26 + t43
+	t61 := t26 - t43
+	t62 := t9 + t60
+	t63 := real(t9) - 0.49999999999999994*real(t60)
+	t64 := imag(t9) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t32 + t49
+	t70 := t32 - t49
+	t71 := t15 + t69
+	t72 := real(t15) - 0.49999999999999994*real(t69)
+	t73 := imag(t15) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t33 + t50
+	t79 := t33 - t50
+	t80 := t16 + t78
+	t81 := real(t16) - 0.49999999999999994*real(t78)
+	t82 := imag(t16) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t27 + t44
+	t88 := t27 - t44
+	t89 := t10 + t87
+	t90 := real(t10) - 0.49999999999999994*real(t87)
+	t91 := imag(t10) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := x21 + x9
+	t97 := x21 - x9
+	t98 := x27 + x3
+	t99 := x27 - x3
+	t100 := x15 + t96 + t98
+	t101 := real(x15) + 0.3090169943749474*real(t96) - 0.8090169943749473*real(t98)
+	t102 := imag(x15) + 0.3090169943749474*imag(t96) - 0.8090169943749473*imag(t98)
+	t103 := 0.9510565162951536*real(t97) + 0.5877852522924731*real(t99)
+	t104 := 0.9510565162951536*imag(t97) + 0.5877852522924731*imag(t99)
+	t105 := complex(t101-t104, t102+t103)
+	t106 := complex(t101+t104, t102-t103)
+	t107 := real(x15) - 0.8090169943749473*real(t96) + 0.3090169943749474*real(t98)
+	t108 := imag(x15) - 0.8090169943749473*imag(t96) + 0.3090169943749474*imag(t98)
+	t109 := 0.5877852522924731*real(t97) - 0.9510565162951536*real(t99)
+	t110 := 0.5877852522924731*imag(t97) - 0.9510565162951536*imag(t99)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := x1 + x19
+	t114 := x1 - x19
+	t115 := x7 + x13
+	t116 := x7 - x13
+	t117 := x25 + t113 + t115
+	t118 := real(x25) + 0.3090169943749474*real(t113) - 0.8090169943749473*real(t115)
+	t119 := imag(x25) + 0.3090169943749474*imag(t113) - 0.8090169943749473*imag(t115)
+	t120 := 0.9510565162951536*real(t114) + 0.5877852522924731*real(t116)
+	t121 := 0.9510565162951536*imag(t114) + 0.5877852522924731*imag(t116)
+	t122 := complex(t118-t121, t119+t120)
+	t123 := complex(t118+t121, t119-t120)
+	t124 := real(x25) - 0.8090169943749473*real(t113) + 0.3090169943749474*real(t115)
+	t125 := imag(x25) - 0.8090169943749473*imag(t113) + 0.3090169943749474*imag(t115)
+	t126 := 0.5877852522924731*real(t114) - 0.9510565162951536*real(t116)
+	t127 := 0.5877852522924731*imag(t114) - 0.9510565162951536*imag(t116)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := x11 + x29
+	t131 := x11 - x29
+	t132 := x17 + x23
+	t133 := x17 - x23
+	t134 := x5 + t130 + t132
+	t135 := real(x5) + 0.3090169943749474*real(t130) - 0.8090169943749473*real(t132)
+	t136 := imag(x5) + 0.3090169943749474*imag(t130) - 0.8090169943749473*imag(t132)
+	t137 := 0.9510565162951536*real(t131) + 0.5877852522924731*real(t133)
+	t138 := 0.9510565162951536*imag(t131) + 0.5877852522924731*imag(t133)
+	t139 := complex(t135-t138, t136+t137)
+	t140 := complex(t135+t138, t136-t137)
+	t141 := real(x5) - 0.8090169943749473*real(t130) + 0.3090169943749474*real(t132)
+	t142 := imag(x5) - 0.8090169943749473*imag(t130) + 0.3090169943749474*imag(t132)
+	t143 := 0.5877852522924731*real(t131) - 0.9510565162951536*real(t133)
+	t144 := 0.5877852522924731*imag(t131) - 0.9510565162951536*imag(t133)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := t117 + t134
+	t148 := t117 - t134
+	t149 := t100 + t147
+	t150 := real(t100) - 0.49999999999999994*real(t147)
+	t151 := imag(t100) - 0.49999999999999994*imag(t147)
+	t152 := 0.8660254037844387 * real(t148)
+	t153 := 0.8660254037844387 * imag(t148)
+	t154 := complex(t150-t153, t151+t152)
+	t155 := complex(t150+t153, t151-t152)
+	t156 := t122 + t139
+	t157 := t122 - t139
+	t158 := t105 + t156
+	t159 := real(t105) - 0.49999999999999994*real(t156)
+	t160 := imag(t105) - 0.49999999999999994*imag(t156)
+	t161 := 0.8660254037844387 * real(t157)
+	t162 := 0.8660254037844387 * imag(t157)
+	t163 := complex(t159-t162, t160+t161)
+	t164 := complex(t159+t162, t160-t161)
+	t165 := t128 + t145
+	t166 := t128 - t145
+	t167 := t111 + t165
+	t168 := real(t111) - 0.49999999999999994*real(t165)
+	t169 := imag(t111) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := t129 + t146
+	t175 := t129 - t146
+	t176 := t112 + t174
+	t177 := real(t112) - 0.49999999999999994*real(t174)
+	t178 := imag(t112) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := t123 + t140
+	t184 := t123 - t140
+	t185 := t106 + t183
+	t186 := real(t106) - 0.49999999999999994*real(t183)
+	t187 := imag(t106) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := t53 + t149
+	t193 := t53 - t149
+	t194 := t67 + t163
+	t195 := t67 - t163
+	t196 := t77 + t173
+	t197 := t77 - t173
+	t198 := t80 + t176
+	t199 := t80 - t176
+	t200 := t94 + t190
+	t201 := t94 - t190
+	t202 := t59 + t155
+	t203 := t59 - t155
+	t204 := t62 + t158
+	t205 := t62 - t158
+	t206 := t76 + t172
+	t207 := t76 - t172
+	t208 := t86 + t182
+	t209 := t86 - t182
+	t210 := t89 + t185
+	t211 := t89 - t185
+	t212 := t58 + t154
+	t213 := t58 - t154
+	t214 := t68 + t164
+	t215 := t68 - t164
+	t216 := t71 + t167
+	t217 := t71 - t167
+	t218 := t85 + t181
+	t219 := t85 - t181
+	t220 := t95 + t191
+	t221 := t95 - t191
+
+	d[0] = complex(real(t192)*0.03333333333333333, imag(t192)*0.03333333333333333)
+	d[1] = complex(real(t195)*0.03333333333333333, imag(t195)*0.03333333333333333)
+	d[2] = complex(real(t196)*0.03333333333333333, imag(t196)*0.03333333333333333)
+	d[3] = complex(real(t199)*0.03333333333333333, imag(t199)*0.03333333333333333)
+	d[4] = complex(real(t200)*0.03333333333333333, imag(t200)*0.03333333333333333)
+	d[5] = complex(real(t203)*0.03333333333333333, imag(t203)*0.03333333333333333)
+	d[6] = complex(real(t204)*0.03333333333333333, imag(t204)*0.03333333333333333)
+	d[7] = complex(real(t207)*0.03333333333333333, imag(t207)*0.03333333333333333)
+	d[8] = complex(real(t208)*0.03333333333333333, imag(t208)*0.03333333333333333)
+	d[9] = complex(real(t211)*0.03333333333333333, imag(t211)*0.03333333333333333)
+	d[10] = complex(real(t212)*0.03333333333333333, imag(t212)*0.03333333333333333)
+	d[11] = complex(real(t215)*0.03333333333333333, imag(t215)*0.03333333333333333)
+	d[12] = complex(real(t216)*0.03333333333333333, imag(t216)*0.03333333333333333)
+	d[13] = complex(real(t219)*0.03333333333333333, imag(t219)*0.03333333333333333)
+	d[14] = complex(real(t220)*0.03333333333333333, imag(t220)*0.03333333333333333)
+	d[15] = complex(real(t193)*0.03333333333333333, imag(t193)*0.03333333333333333)
+	d[16] = complex(real(t194)*0.03333333333333333, imag(t194)*0.03333333333333333)
+	d[17] = complex(real(t197)*0.03333333333333333, imag(t197)*0.03333333333333333)
+	d[18] = complex(real(t198)*0.03333333333333333, imag(t198)*0.03333333333333333)
+	d[19] = complex(real(t201)*0.03333333333333333, imag(t201)*0.03333333333333333)
+	d[20] = complex(real(t202)*0.03333333333333333, imag(t202)*0.03333333333333333)
+	d[21] = complex(real(t205)*0.03333333333333333, imag(t205)*0.03333333333333333)
+	d[22] = complex(real(t206)*0.03333333333333333, imag(t206)*0.03333333333333333)
+	d[23] = complex(real(t209)*0.03333333333333333, imag(t209)*0.03333333333333333)
+	d[24] = complex(real(t210)*0.03333333333333333, imag(t210)*0.03333333333333333)
+	d[25] = complex(real(t213)*0.03333333333333333, imag(t213)*0.03333333333333333)
+	d[26] = complex(real(t214)*0.03333333333333333, imag(t214)*0.03333333333333333)
+	d[27] = complex(real(t217)*0.03333333333333333, imag(t217)*0.03333333333333333)
+	d[28] = complex(real(t218)*0.03333333333333333, imag(t218)*0.03333333333333333)
+	d[29] = complex(real(t221)*0.03333333333333333, imag(t221)*0.03333333333333333)
+
+	return true
+}
+
+// forwardDFT30Complex128 computes a 30-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT30Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 30
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+
+	t0 := x6 + x24
+	t1 := x6 - x24
+	t2 := x12 + x18
+	t3 := x12 - x18
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x16 + x4
+	t18 := x16 - x4
+	t19 := x22 + x28
+	t20 := x22 - x28
+	t21 := x10 + t17 + t19
+	t22 := real(x10) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x10) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x10) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x10) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x26 + x14
+	t35 := x26 - x14
+	t36 := x2 + x8
+	t37 := x2 - x8
+	t38 := x20 + t34 + t36
+	t39 := real(x20) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x20) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x20) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x20) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t27 + t44
+	t61 := t27 - t44
+	t62 := t10 + t60
+	t63 := real(t10) - 0.49999999999999994*real(t60)
+	t64 := imag(t10) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t33 + t50
+	t70 := t33 - t50
+	t71 := t16 + t69
+	t72 := real(t16) - 0.49999999999999994*real(t69)
+	t73 := imag(t16) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t32 + t49
+	t79 := t32 - t49
+	t80 := t15 + t78
+	t81 := real(t15) - 0.49999999999999994*real(t78)
+	t82 := imag(t15) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t26 + t43
+	t88 := t26 - t43
+	t89 := t9 + t87
+	t90 := real(t9) - 0.49999999999999994*real(t87)
+	t91 := imag(t9) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := x21 + x9
+	t97 := x21 - x9
+	t98 := x27 + x3
+	t99 := x27 - x3
+	t100 := x15 + t96 + t98
+	t101 := real(x15) + 0.3090169943749474*real(t96) - 0.8090169943749473*real(t98)
+	t102 := imag(x15) + 0.3090169943749474*imag(t96) - 0.8090169943749473*imag(t98)
+	t103 := 0.9510565162951536*real(t97) + 0.5877852522924731*real(t99)
+	t104 := 0.9510565162951536*imag(t97) + 0.5877852522924731*imag(t99)
+	t105 := complex(t101-t104, t102+t103)
+	t106 := complex(t101+t104, t102-t103)
+	t107 := real(x15) - 0.8090169943749473*real(t96) + 0.3090169943749474*real(t98)
+	t108 := imag(x15) - 0.8090169943749473*imag(t96) + 0.3090169943749474*imag(t98)
+	t109 := 0.5877852522924731*real(t97) - 0.9510565162951536*real(t99)
+	t110 := 0.5877852522924731*imag(t97) - 0.9510565162951536*imag(t99)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := x1 + x19
+	t114 := x1 - x19
+	t115 := x7 + x13
+	t116 := x7 - x13
+	t117 := x25 + t113 + t115
+	t118 := real(x25) + 0.3090169943749474*real(t113) - 0.8090169943749473*real(t115)
+	t119 := imag(x25) + 0.3090169943749474*imag(t113) - 0.8090169943749473*imag(t115)
+	t120 := 0.9510565162951536*real(t114) + 0.5877852522924731*real(t116)
+	t121 := 0.9510565162951536*imag(t114) + 0.5877852522924731*imag(t116)
+	t122 := complex(t118-t121, t119+t120)
+	t123 := complex(t118+t121, t119-t120)
+	t124 := real(x25) - 0.8090169943749473*real(t113) + 0.3090169943749474*real(t115)
+	t125 := imag(x25) - 0.8090169943749473*imag(t113) + 0.3090169943749474*imag(t115)
+	t126 := 0.5877852522924731*real(t114) - 0.9510565162951536*real(t116)
+	t127 := 0.5877852522924731*imag(t114) - 0.9510565162951536*imag(t116)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := x11 + x29
+	t131 := x11 - x29
+	t132 := x17 + x23
+	t133 := x17 - x23
+	t134 := x5 + t130 + t132
+	t135 := real(x5) + 0.3090169943749474*real(t130) - 0.8090169943749473*real(t132)
+	t136 := imag(x5) + 0.3090169943749474*imag(t130) - 0.8090169943749473*imag(t132)
+	t137 := 0.9510565162951536*real(t131) + 0.5877852522924731*real(t133)
+	t138 := 0.9510565162951536*imag(t131) + 0.5877852522924731*imag(t133)
+	t139 := complex(t135-t138, t136+t137)
+	t140 := complex(t135+t138, t136-t137)
+	t141 := real(x5) - 0.8090169943749473*real(t130) + 0.3090169943749474*real(t132)
+	t142 := imag(x5) - 0.8090169943749473*imag(t130) + 0.3090169943749474*imag(t132)
+	t143 := 0.5877852522924731*real(t131) - 0.9510565162951536*real(t133)
+	t144 := 0.5877852522924731*imag(t131) - 0.9510565162951536*imag(t133)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := t117 + t134
+	t148 := t117 - t134
+	t149 := t100 + t147
+	t150 := real(t100) - 0.49999999999999994*real(t147)
+	t151 := imag(t100) - 0.49999999999999994*imag(t147)
+	t152 := 0.8660254037844387 * real(t148)
+	t153 := 0.8660254037844387 * imag(t148)
+	t154 := complex(t150-t153, t151+t152)
+	t155 := complex(t150+t153, t151-t152)
+	t156 := t123 + t140
+	t157 := t123 - t140
+	t158 := t106 + t156
+	t159 := real(t106) - 0.49999999999999994*real(t156)
+	t160 := imag(t106) - 0.49999999999999994*imag(t156)
+	t161 := 0.8660254037844387 * real(t157)
+	t162 := 0.8660254037844387 * imag(t157)
+	t163 := complex(t159-t162, t160+t161)
+	t164 := complex(t159+t162, t160-t161)
+	t165 := t129 + t146
+	t166 := t129 - t146
+	t167 := t112 + t165
+	t168 := real(t112) - 0.49999999999999994*real(t165)
+	t169 := imag(t112) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := t128 + t145
+	t175 := t128 - t145
+	t176 := t111 + t174
+	t177 := real(t111) - 0.49999999999999994*real(t174)
+	t178 := imag(t111) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := t122 + t139
+	t184 := t122 - t139
+	t185 := t105 + t183
+	t186 := real(t105) - 0.49999999999999994*real(t183)
+	t187 := imag(t105) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := t53 + t149
+	t193 := t53 - t149
+	t194 := t68 + t164
+	t195 := t68 - t164
+	t196 := t76 + t172
+	t197 := t76 - t172
+	t198 := t80 + t176
+	t199 := t80 - t176
+	t200 := t95 + t191
+	t201 := t95 - t191
+	t202 := t58 + t154
+	t203 := t58 - t154
+	t204 := t62 + t158
+	t205 := t62 - t158
+	t206 := t77 + t173
+	t207 := t77 - t173
+	t208 := t85 + t181
+	t209 := t85 - t181
+	t210 := t89 + t185
+	t211 := t89 - t185
+	t212 := t59 + t155
+	t213 := t59 - t155
+	t214 := t67 + t163
+	t215 := t67 - t163
+	t216 := t71 + t167
+	t217 := t71 - t167
+	t218 := t86 + t182
+	t219 := t86 - t182
+	t220 := t94 + t190
+	t221 := t94 - t190
+
+	d[0] = t192
+	d[1] = t195
+	d[2] = t196
+	d[3] = t199
+	d[4] = t200
+	d[5] = t203
+	d[6] = t204
+	d[7] = t207
+	d[8] = t208
+	d[9] = t211
+	d[10] = t212
+	d[11] = t215
+	d[12] = t216
+	d[13] = t219
+	d[14] = t220
+	d[15] = t193
+	d[16] = t194
+	d[17] = t197
+	d[18] = t198
+	d[19] = t201
+	d[20] = t202
+	d[21] = t205
+	d[22] = t206
+	d[23] = t209
+	d[24] = t210
+	d[25] = t213
+	d[26] = t214
+	d[27] = t217
+	d[28] = t218
+	d[29] = t221
+
+	return true
+}
+
+// inverseDFT30Complex128 computes a 30-point inverse DFT of complex128 data,
+// including the 1/30 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT30Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 30
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+
+	t0 := x6 + x24
+	t1 := x6 - x24
+	t2 := x12 + x18
+	t3 := x12 - x18
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x16 + x4
+	t18 := x16 - x4
+	t19 := x22 + x28
+	t20 := x22 - x28
+	t21 := x10 + t17 + t19
+	t22 := real(x10) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x10) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x10) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x10) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x26 + x14
+	t35 := x26 - x14
+	t36 := x2 + x8
+	t37 := x2 - x8
+	t38 := x20 + t34 + t36
+	t39 := real(x20) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x20) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x20) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x20) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t26 + t43
+	t61 := t26 - t43
+	t62 := t9 + t60
+	t63 := real(t9) - 0.49999999999999994*real(t60)
+	t64 := imag(t9) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t32 + t49
+	t70 := t32 - t49
+	t71 := t15 + t69
+	t72 := real(t15) - 0.49999999999999994*real(t69)
+	t73 := imag(t15) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t33 + t50
+	t79 := t33 - t50
+	t80 := t16 + t78
+	t81 := real(t16) - 0.49999999999999994*real(t78)
+	t82 := imag(t16) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t27 + t44
+	t88 := t27 - t44
+	t89 := t10 + t87
+	t90 := real(t10) - 0.49999999999999994*real(t87)
+	t91 := imag(t10) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := x21 + x9
+	t97 := x21 - x9
+	t98 := x27 + x3
+	t99 := x27 - x3
+	t100 := x15 + t96 + t98
+	t101 := real(x15) + 0.3090169943749474*real(t96) - 0.8090169943749473*real(t98)
+	t102 := imag(x15) + 0.3090169943749474*imag(t96) - 0.8090169943749473*imag(t98)
+	t103 := 0.9510565162951536*real(t97) + 0.5877852522924731*real(t99)
+	t104 := 0.9510565162951536*imag(t97) + 0.5877852522924731*imag(t99)
+	t105 := complex(t101-t104, t102+t103)
+	t106 := complex(t101+t104, t102-t103)
+	t107 := real(x15) - 0.8090169943749473*real(t96) + 0.3090169943749474*real(t98)
+	t108 := imag(x15) - 0.8090169943749473*imag(t96) + 0.3090169943749474*imag(t98)
+	t109 := 0.5877852522924731*real(t97) - 0.9510565162951536*real(t99)
+	t110 := 0.5877852522924731*imag(t97) - 0.9510565162951536*imag(t99)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := x1 + x19
+	t114 := x1 - x19
+	t115 := x7 + x13
+	t116 := x7 - x13
+	t117 := x25 + t113 + t115
+	t118 := real(x25) + 0.3090169943749474*real(t113) - 0.8090169943749473*real(t115)
+	t119 := imag(x25) + 0.3090169943749474*imag(t113) - 0.8090169943749473*imag(t115)
+	t120 := 0.9510565162951536*real(t114) + 0.5877852522924731*real(t116)
+	t121 := 0.9510565162951536*imag(t114) + 0.5877852522924731*imag(t116)
+	t122 := complex(t118-t121, t119+t120)
+	t123 := complex(t118+t121, t119-t120)
+	t124 := real(x25) - 0.8090169943749473*real(t113) + 0.3090169943749474*real(t115)
+	t125 := imag(x25) - 0.8090169943749473*imag(t113) + 0.3090169943749474*imag(t115)
+	t126 := 0.5877852522924731*real(t114) - 0.9510565162951536*real(t116)
+	t127 := 0.5877852522924731*imag(t114) - 0.9510565162951536*imag(t116)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := x11 + x29
+	t131 := x11 - x29
+	t132 := x17 + x23
+	t133 := x17 - x23
+	t134 := x5 + t130 + t132
+	t135 := real(x5) + 0.3090169943749474*real(t130) - 0.8090169943749473*real(t132)
+	t136 := imag(x5) + 0.3090169943749474*imag(t130) - 0.8090169943749473*imag(t132)
+	t137 := 0.9510565162951536*real(t131) + 0.5877852522924731*real(t133)
+	t138 := 0.9510565162951536*imag(t131) + 0.5877852522924731*imag(t133)
+	t139 := complex(t135-t138, t136+t137)
+	t140 := complex(t135+t138, t136-t137)
+	t141 := real(x5) - 0.8090169943749473*real(t130) + 0.3090169943749474*real(t132)
+	t142 := imag(x5) - 0.8090169943749473*imag(t130) + 0.3090169943749474*imag(t132)
+	t143 := 0.5877852522924731*real(t131) - 0.9510565162951536*real(t133)
+	t144 := 0.5877852522924731*imag(t131) - 0.9510565162951536*imag(t133)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := t117 + t134
+	t148 := t117 - t134
+	t149 := t100 + t147
+	t150 := real(t100) - 0.49999999999999994*real(t147)
+	t151 := imag(t100) - 0.49999999999999994*imag(t147)
+	t152 := 0.8660254037844387 * real(t148)
+	t153 := 0.8660254037844387 * imag(t148)
+	t154 := complex(t150-t153, t151+t152)
+	t155 := complex(t150+t153, t151-t152)
+	t156 := t122 + t139
+	t157 := t122 - t139
+	t158 := t105 + t156
+	t159 := real(t105) - 0.49999999999999994*real(t156)
+	t160 := imag(t105) - 0.49999999999999994*imag(t156)
+	t161 := 0.8660254037844387 * real(t157)
+	t162 := 0.8660254037844387 * imag(t157)
+	t163 := complex(t159-t162, t160+t161)
+	t164 := complex(t159+t162, t160-t161)
+	t165 := t128 + t145
+	t166 := t128 - t145
+	t167 := t111 + t165
+	t168 := real(t111) - 0.49999999999999994*real(t165)
+	t169 := imag(t111) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := t129 + t146
+	t175 := t129 - t146
+	t176 := t112 + t174
+	t177 := real(t112) - 0.49999999999999994*real(t174)
+	t178 := imag(t112) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := t123 + t140
+	t184 := t123 - t140
+	t185 := t106 + t183
+	t186 := real(t106) - 0.49999999999999994*real(t183)
+	t187 := imag(t106) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := t53 + t149
+	t193 := t53 - t149
+	t194 := t67 + t163
+	t195 := t67 - t163
+	t196 := t77 + t173
+	t197 := t77 - t173
+	t198 := t80 + t176
+	t199 := t80 - t176
+	t200 := t94 + t190
+	t201 := t94 - t190
+	t202 := t59 + t155
+	t203 := t59 - t155
+	t204 := t62 + t158
+	t205 := t62 - t158
+	t206 := t76 + t172
+	t207 := t76 - t172
+	t208 := t86 + t182
+	t209 := t86 - t182
+	t210 := t89 + t185
+	t211 := t89 - t185
+	t212 := t58 + t154
+	t213 := t58 - t154
+	t214 := t68 + t164
+	t215 := t68 - t164
+	t216 := t71 + t167
+	t217 := t71 - t167
+	t218 := t85 + t181
+	t219 := t85 - t181
+	t220 := t95 + t191
+	t221 := t95 - t191
+
+	d[0] = complex(real(t192)*0.03333333333333333, imag(t192)*0.03333333333333333)
+	d[1] = complex(real(t195)*0.03333333333333333, imag(t195)*0.03333333333333333)
+	d[2] = complex(real(t196)*0.03333333333333333, imag(t196)*0.03333333333333333)
+	d[3] = complex(real(t199)*0.03333333333333333, imag(t199)*0.03333333333333333)
+	d[4] = complex(real(t200)*0.03333333333333333, imag(t200)*0.03333333333333333)
+	d[5] = complex(real(t203)*0.03333333333333333, imag(t203)*0.03333333333333333)
+	d[6] = complex(real(t204)*0.03333333333333333, imag(t204)*0.03333333333333333)
+	d[7] = complex(real(t207)*0.03333333333333333, imag(t207)*0.03333333333333333)
+	d[8] = complex(real(t208)*0.03333333333333333, imag(t208)*0.03333333333333333)
+	d[9] = complex(real(t211)*0.03333333333333333, imag(t211)*0.03333333333333333)
+	d[10] = complex(real(t212)*0.03333333333333333, imag(t212)*0.03333333333333333)
+	d[11] = complex(real(t215)*0.03333333333333333, imag(t215)*0.03333333333333333)
+	d[12] = complex(real(t216)*0.03333333333333333, imag(t216)*0.03333333333333333)
+	d[13] = complex(real(t219)*0.03333333333333333, imag(t219)*0.03333333333333333)
+	d[14] = complex(real(t220)*0.03333333333333333, imag(t220)*0.03333333333333333)
+	d[15] = complex(real(t193)*0.03333333333333333, imag(t193)*0.03333333333333333)
+	d[16] = complex(real(t194)*0.03333333333333333, imag(t194)*0.03333333333333333)
+	d[17] = complex(real(t197)*0.03333333333333333, imag(t197)*0.03333333333333333)
+	d[18] = complex(real(t198)*0.03333333333333333, imag(t198)*0.03333333333333333)
+	d[19] = complex(real(t201)*0.03333333333333333, imag(t201)*0.03333333333333333)
+	d[20] = complex(real(t202)*0.03333333333333333, imag(t202)*0.03333333333333333)
+	d[21] = complex(real(t205)*0.03333333333333333, imag(t205)*0.03333333333333333)
+	d[22] = complex(real(t206)*0.03333333333333333, imag(t206)*0.03333333333333333)
+	d[23] = complex(real(t209)*0.03333333333333333, imag(t209)*0.03333333333333333)
+	d[24] = complex(real(t210)*0.03333333333333333, imag(t210)*0.03333333333333333)
+	d[25] = complex(real(t213)*0.03333333333333333, imag(t213)*0.03333333333333333)
+	d[26] = complex(real(t214)*0.03333333333333333, imag(t214)*0.03333333333333333)
+	d[27] = complex(real(t217)*0.03333333333333333, imag(t217)*0.03333333333333333)
+	d[28] = complex(real(t218)*0.03333333333333333, imag(t218)*0.03333333333333333)
+	d[29] = complex(real(t221)*0.03333333333333333, imag(t221)*0.03333333333333333)
+
+	return true
+}
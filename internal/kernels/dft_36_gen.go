@@ -0,0 +1,1625 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT36Complex64 computes a 36-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT36Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 36
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+
+	t0 := x12 + x24
+	t1 := x12 - x24
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x16 + x28
+	t10 := x16 - x28
+	t11 := x4 + t9
+	t12 := real(x4) - 0.49999999999999994*real(t9)
+	t13 := imag(x4) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x20 + x32
+	t19 := x20 - x32
+	t20 := x8 + t18
+	t21 := real(x8) - 0.49999999999999994*real(t18)
+	t22 := imag(x8) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := t11 + t20
+	t28 := t11 - t20
+	t29 := t2 + t27
+	t30 := real(t2) - 0.49999999999999994*real(t27)
+	t31 := imag(t2) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t17 * complex(0.7660444431189781, -0.6427876096865393)
+	t37 := t26 * complex(0.17364817766693033, -0.9848077530122081)
+	t38 := t36 + t37
+	t39 := t36 - t37
+	t40 := t8 + t38
+	t41 := real(t8) - 0.49999999999999994*real(t38)
+	t42 := imag(t8) - 0.49999999999999994*imag(t38)
+	t43 := 0.8660254037844387 * real(t39)
+	t44 := 0.8660254037844387 * imag(t39)
+	t45 := complex(t41-t44, t42+t43)
+	t46 := complex(t41+t44, t42-t43)
+	t47 := t16 * complex(0.17364817766693033, -0.9848077530122081)
+	t48 := t25 * complex(-0.9396926207859084, -0.3420201433256687)
+	t49 := t47 + t48
+	t50 := t47 - t48
+	t51 := t7 + t49
+	t52 := real(t7) - 0.49999999999999994*real(t49)
+	t53 := imag(t7) - 0.49999999999999994*imag(t49)
+	t54 := 0.8660254037844387 * real(t50)
+	t55 := 0.8660254037844387 * imag(t50)
+	t56 := complex(t52-t55, t53+t54)
+	t57 := complex(t52+t55, t53-t54)
+	t58 := x21 + x33
+	t59 := x21 - x33
+	t60 := x9 + t58
+	t61 := real(x9) - 0.49999999999999994*real(t58)
+	t62 := imag(x9) - 0.49999999999999994*imag(t58)
+	t63 := 0.8660254037844387 * real(t59)
+	t64 := 0.8660254037844387 * imag(t59)
+	t65 := complex(t61-t64, t62+t63)
+	t66 := complex(t61+t64, t62-t63)
+	t67 := x25 + x1
+	t68 := x25 - x1
+	t69 := x13 + t67
+	t70 := real(x13) - 0.49999999999999994*real(t67)
+	t71 := imag(x13) - 0.49999999999999994*imag(t67)
+	t72 := 0.8660254037844387 * real(t68)
+	t73 := 0.8660254037844387 * imag(t68)
+	t74 := complex(t70-t73, t71+t72)
+	t75 := complex(t70+t73, t71-t72)
+	t76 := x29 + x5
+	t77 := x29 - x5
+	t78 := x17 + t76
+	t79 := real(x17) - 0.49999999999999994*real(t76)
+	t80 := imag(x17) - 0.49999999999999994*imag(t76)
+	t81 := 0.8660254037844387 * real(t77)
+	t82 := 0.8660254037844387 * imag(t77)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := t69 + t78
+	t86 := t69 - t78
+	t87 := t60 + t85
+	t88 := real(t60) - 0.49999999999999994*real(t85)
+	t89 := imag(t60) - 0.49999999999999994*imag(t85)
+	t90 := 0.8660254037844387 * real(t86)
+	t91 := 0.8660254037844387 * imag(t86)
+	t92 := complex(t88-t91, t89+t90)
+	t93 := complex(t88+t91, t89-t90)
+	t94 := t75 * complex(0.7660444431189781, -0.6427876096865393)
+	t95 := t84 * complex(0.17364817766693033, -0.9848077530122081)
+	t96 := t94 + t95
+	t97 := t94 - t95
+	t98 := t66 + t96
+	t99 := real(t66) - 0.49999999999999994*real(t96)
+	t100 := imag(t66) - 0.49999999999999994*imag(t96)
+	t101 := 0.8660254037844387 * real(t97)
+	t102 := 0.8660254037844387 * imag(t97)
+	t103 := complex(t99-t102, t100+t101)
+	t104 := complex(t99+t102, t100-t101)
+	t105 := t74 * complex(0.17364817766693033, -0.9848077530122081)
+	t106 := t83 * complex(-0.9396926207859084, -0.3420201433256687)
+	t107 := t105 + t106
+	t108 := t105 - t106
+	t109 := t65 + t107
+	t110 := real(t65) - 0.49999999999999994*real(t107)
+	t111 := imag(t65) - 0.49999999999999994*imag(t107)
+	t112 := 0.8660254037844387 * real(t108)
+	t113 := 0.8660254037844387 * imag(t108)
+	t114 := complex(t110-t113, t111+t112)
+	t115 := complex(t110+t113, t111-t112)
+	t116 := x30 + x6
+	t117 := x30 - x6
+	t118 := x18 + t116
+	t119 := real(x18) - 0.49999999999999994*real(t116)
+	t120 := imag(x18) - 0.49999999999999994*imag(t116)
+	t121 := 0.8660254037844387 * real(t117)
+	t122 := 0.8660254037844387 * imag(t117)
+	t123 := complex(t119-t122, t120+t121)
+	t124 := complex(t119+t122, t120-t121)
+	t125 := x34 + x10
+	t126 := x34 - x10
+	t127 := x22 + t125
+	t128 := real(x22) - 0.49999999999999994*real(t125)
+	t129 := imag(x22) - 0.49999999999999994*imag(t125)
+	t130 := 0.8660254037844387 * real(t126)
+	t131 := 0.8660254037844387 * imag(t126)
+	t132 := complex(t128-t131, t129+t130)
+	t133 := complex(t128+t131, t129-t130)
+	t134 := x2 + x14
+	t135 := x2 - x14
+	t136 := x26 + t134
+	t137 := real(x26) - 0.49999999999999994*real(t134)
+	t138 := imag(x26) - 0.49999999999999994*imag(t134)
+	t139 := 0.8660254037844387 * real(t135)
+	t140 := 0.8660254037844387 * imag(t135)
+	t141 := complex(t137-t140, t138+t139)
+	t142 := complex(t137+t140, t138-t139)
+	t143 := t127 + t136
+	t144 := t127 - t136
+	t145 := t118 + t143
+	t146 := real(t118) - 0.49999999999999994*real(t143)
+	t147 := imag(t118) - 0.49999999999999994*imag(t143)
+	t148 := 0.8660254037844387 * real(t144)
+	t149 := 0.8660254037844387 * imag(t144)
+	t150 := complex(t146-t149, t147+t148)
+	t151 := complex(t146+t149, t147-t148)
+	t152 := t133 * complex(0.7660444431189781, -0.6427876096865393)
+	t153 := t142 * complex(0.17364817766693033, -0.9848077530122081)
+	t154 := t152 + t153
+	t155 := t152 - t153
+	t156 := t124 + t154
+	t157 := real(t124) - 0.49999999999999994*real(t154)
+	t158 := imag(t124) - 0.49999999999999994*imag(t154)
+	t159 := 0.8660254037844387 * real(t155)
+	t160 := 0.8660254037844387 * imag(t155)
+	t161 := complex(t157-t160, t158+t159)
+	t162 := complex(t157+t160, t158-t159)
+	t163 := t132 * complex(0.17364817766693033, -0.9848077530122081)
+	t164 := t141 * complex(-0.9396926207859084, -0.3420201433256687)
+	t165 := t163 + t164
+	t166 := t163 - t164
+	t167 := t123 + t165
+	t168 := real(t123) - 0.49999999999999994*real(t165)
+	t169 := imag(t123) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := x3 + x15
+	t175 := x3 - x15
+	t176 := x27 + t174
+	t177 := real(x27) - 0.49999999999999994*real(t174)
+	t178 := imag(x27) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := x7 + x19
+	t184 := x7 - x19
+	t185 := x31 + t183
+	t186 := real(x31) - 0.49999999999999994*real(t183)
+	t187 := imag(x31) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := x11 + x23
+	t193 := x11 - x23
+	t194 := x35 + t192
+	t195 := real(x35) - 0.49999999999999994*real(t192)
+	t196 := imag(x35) - 0.49999999999999994*imag(t192)
+	t197 := 0.8660254037844387 * real(t193)
+	t198 := 0.8660254037844387 * imag(t193)
+	t199 := complex(t195-t198, t196+t197)
+	t200 := complex(t195+t198, t196-t197)
+	t201 := t185 + t194
+	t202 := t185 - t194
+	t203 := t176 + t201
+	t204 := real(t176) - 0.49999999999999994*real(t201)
+	t205 := imag(t176) - 0.49999999999999994*imag(t201)
+	t206 := 0.8660254037844387 * real(t202)
+	t207 := 0.8660254037844387 * imag(t202)
+	t208 := complex(t204-t207, t205+t206)
+	t209 := complex(t204+t207, t205-t206)
+	t210 := t191 * complex(0.7660444431189781, -0.6427876096865393)
+	t211 := t200 * complex(0.17364817766693033, -0.9848077530122081)
+	t212 := t210 + t211
+	t213 := t210 - t211
+	t214 := t182 + t212
+	t215 := real(t182) - 0.49999999999999994*real(t212)
+	t216 := imag(t182) - 0.49999999999999994*imag(t212)
+	t217 := 0.8660254037844387 * real(t213)
+	t218 := 0.8660254037844387 * imag(t213)
+	t219 := complex(t215-t218, t216+t217)
+	t220 := complex(t215+t218, t216-t217)
+	t221 := t190 * complex(0.17364817766693033, -0.9848077530122081)
+	t222 := t199 * complex(-0.9396926207859084, -0.3420201433256687)
+	t223 := t221 + t222
+	t224 := t221 - t222
+	t225 := t181 + t223
+	t226 := real(t181) - 0.49999999999999994*real(t223)
+	t227 := imag(t181) - 0.49999999999999994*imag(t223)
+	t228 := 0.8660254037844387 * real(t224)
+	t229 := 0.8660254037844387 * imag(t224)
+	t230 := complex(t226-t229, t227+t228)
+	t231 := complex(t226+t229, t227-t228)
+	t232 := t29 + t145
+	t233 := t29 - t145
+	t234 := t87 + t203
+	t235 := t87 - t203
+	t236 := complex(imag(t235), -real(t235))
+	t237 := t232 + t234
+	t238 := t233 + t236
+	t239 := t232 - t234
+	t240 := t233 - t236
+	t241 := t40 + t156
+	t242 := t40 - t156
+	t243 := t98 + t214
+	t244 := t98 - t214
+	t245 := complex(imag(t244), -real(t244))
+	t246 := t241 + t243
+	t247 := t242 + t245
+	t248 := t241 - t243
+	t249 := t242 - t245
+	t250 := t51 + t167
+	t251 := t51 - t167
+	t252 := t109 + t225
+	t253 := t109 - t225
+	t254 := complex(imag(t253), -real(t253))
+	t255 := t250 + t252
+	t256 := t251 + t254
+	t257 := t250 - t252
+	t258 := t251 - t254
+	t259 := t35 + t151
+	t260 := t35 - t151
+	t261 := t93 + t209
+	t262 := t93 - t209
+	t263 := complex(imag(t262), -real(t262))
+	t264 := t259 + t261
+	t265 := t260 + t263
+	t266 := t259 - t261
+	t267 := t260 - t263
+	t268 := t46 + t162
+	t269 := t46 - t162
+	t270 := t104 + t220
+	t271 := t104 - t220
+	t272 := complex(imag(t271), -real(t271))
+	t273 := t268 + t270
+	t274 := t269 + t272
+	t275 := t268 - t270
+	t276 := t269 - t272
+	t277 := t57 + t173
+	t278 := t57 - t173
+	t279 := t115 + t231
+	t280 := t115 - t231
+	t281 := complex(imag(t280), -real(t280))
+	t282 := t277 + t279
+	t283 := t278 + t281
+	t284 := t277 - t279
+	t285 := t278 - t281
+	t286 := t34 + t150
+	t287 := t34 - t150
+	t288 := t92 + t208
+	t289 := t92 - t208
+	t290 := complex(imag(t289), -real(t289))
+	t291 := t286 + t288
+	t292 := t287 + t290
+	t293 := t286 - t288
+	t294 := t287 - t290
+	t295 := t45 + t161
+	t296 := t45 - t161
+	t297 := t103 + t219
+	t298 := t103 - t219
+	t299 := complex(imag(t298), -real(t298))
+	t300 := t295 + t297
+	t301 := t296 + t299
+	t302 := t295 - t297
+	t303 := t296 - t299
+	t304 := t56 + t172
+	t305 := t56 - t172
+	t306 := t114 + t230
+	t307 := t114 - t230
+	t308 := complex(imag(t307), -real(t307))
+	t309 := t304 + t306
+	t310 := t305 + t308
+	t311 := t304 - t306
+	t312 := t305 - t308
+
+	d[0] = t237
+	d[1] = t247
+	d[2] = t257
+	d[3] = t267
+	d[4] = t273
+	d[5] = t283
+	d[6] = t293
+	d[7] = t303
+	d[8] = t309
+	d[9] = t238
+	d[10] = t248
+	d[11] = t258
+	d[12] = t264
+	d[13] = t274
+	d[14] = t284
+	d[15] = t294
+	d[16] = t300
+	d[17] = t310
+	d[18] = t239
+	d[19] = t249
+	d[20] = t255
+	d[21] = t265
+	d[22] = t275
+	d[23] = t285
+	d[24] = t291
+	d[25] = t301
+	d[26] = t311
+	d[27] = t240
+	d[28] = t246
+	d[29] = t256
+	d[30] = t266
+	d[31] = t276
+	d[32] = t282
+	d[33] = t292
+	d[34] = t302
+	d[35] = t312
+
+	return true
+}
+
+// inverseDFT36Complex64 computes a 36-point inverse DFT of complex64 data,
+// including the 1/36 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT36Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 36
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+
+	t0 := x12 + x24
+	t1 := x12 - x24
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x16 + x28
+	t10 := x16 - x28
+	t11 := x4 + t9
+	t12 := real(x4) - 0.49999999999999994*real(t9)
+	t13 := imag(x4) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x20 + x32
+	t19 := x20 - x32
+	t20 := x8 + t18
+	t21 := real(x8) - 0.49999999999999994*real(t18)
+	t22 := imag(x8) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := t11 + t20
+	t28 := t11 - t20
+	t29 := t2 + t27
+	t30 := real(t2) - 0.49999999999999994*real(t27)
+	t31 := imag(t2) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t16 * complex(0.7660444431189781, 0.6427876096865393)
+	t37 := t25 * complex(0.17364817766693033, 0.9848077530122081)
+	t38 := t36 + t37
+	t39 := t36 - t37
+	t40 := t7 + t38
+	t41 := real(t7) - 0.49999999999999994*real(t38)
+	t42 := imag(t7) - 0.49999999999999994*imag(t38)
+	t43 := 0.8660254037844387 * real(t39)
+	t44 := 0.8660254037844387 * imag(t39)
+	t45 := complex(t41-t44, t42+t43)
+	t46 := complex(t41+t44, t42-t43)
+	t47 := t17 * complex(0.17364817766693033, 0.9848077530122081)
+	t48 := t26 * complex(-0.9396926207859084, 0.3420201433256687)
+	t49 := t47 + t48
+	t50 := t47 - t48
+	t51 := t8 + t49
+	t52 := real(t8) - 0.49999999999999994*real(t49)
+	t53 := imag(t8) - 0.49999999999999994*imag(t49)
+	t54 := 0.8660254037844387 * real(t50)
+	t55 := 0.8660254037844387 * imag(t50)
+	t56 := complex(t52-t55, t53+t54)
+	t57 := complex(t52+t55, t53-t54)
+	t58 := x21 + x33
+	t59 := x21 - x33
+	t60 := x9 + t58
+	t61 := real(x9) - 0.49999999999999994*real(t58)
+	t62 := imag(x9) - 0.49999999999999994*imag(t58)
+	t63 := 0.8660254037844387 * real(t59)
+	t64 := 0.8660254037844387 * imag(t59)
+	t65 := complex(t61-t64, t62+t63)
+	t66 := complex(t61+t64, t62-t63)
+	t67 := x25 + x1
+	t68 := x25 - x1
+	t69 := x13 + t67
+	t70 := real(x13) - 0.49999999999999994*real(t67)
+	t71 := imag(x13) - 0.49999999999999994*imag(t67)
+	t72 := 0.8660254037844387 * real(t68)
+	t73 := 0.8660254037844387 * imag(t68)
+	t74 := complex(t70-t73, t71+t72)
+	t75 := complex(t70+t73, t71-t72)
+	t76 := x29 + x5
+	t77 := x29 - x5
+	t78 := x17 + t76
+	t79 := real(x17) - 0.49999999999999994*real(t76)
+	t80 := imag(x17) - 0.49999999999999994*imag(t76)
+	t81 := 0.8660254037844387 * real(t77)
+	t82 := 0.8660254037844387 * imag(t77)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := t69 + t78
+	t86 := t69 - t78
+	t87 := t60 + t85
+	t88 := real(t60) - 0.49999999999999994*real(t85)
+	t89 := imag(t60) - 0.49999999999999994*imag(t85)
+	t90 := 0.8660254037844387 * real(t86)
+	t91 := 0.8660254037844387 * imag(t86)
+	t92 := complex(t88-t91, t89+t90)
+	t93 := complex(t88+t91, t89-t90)
+	t94 := t74 * complex(0.7660444431189781, 0.6427876096865393)
+	t95 := t83 * complex(0.17364817766693033, 0.9848077530122081)
+	t96 := t94 + t95
+	t97 := t94 - t95
+	t98 := t65 + t96
+	t99 := real(t65) - 0.49999999999999994*real(t96)
+	t100 := imag(t65) - 0.49999999999999994*imag(t96)
+	t101 := 0.8660254037844387 * real(t97)
+	t102 := 0.8660254037844387 * imag(t97)
+	t103 := complex(t99-t102, t100+t101)
+	t104 := complex(t99+t102, t100-t101)
+	t105 := t75 * complex(0.17364817766693033, 0.9848077530122081)
+	t106 := t84 * complex(-0.9396926207859084, 0.3420201433256687)
+	t107 := t105 + t106
+	t108 := t105 - t106
+	t109 := t66 + t107
+	t110 := real(t66) - 0.49999999999999994*real(t107)
+	t111 := imag(t66) - 0.49999999999999994*imag(t107)
+	t112 := 0.8660254037844387 * real(t108)
+	t113 := 0.8660254037844387 * imag(t108)
+	t114 := complex(t110-t113, t111+t112)
+	t115 := complex(t110+t113, t111-t112)
+	t116 := x30 + x6
+	t117 := x30 - x6
+	t118 := x18 + t116
+	t119 := real(x18) - 0.49999999999999994*real(t116)
+	t120 := imag(x18) - 0.49999999999999994*imag(t116)
+	t121 := 0.8660254037844387 * real(t117)
+	t122 := 0.8660254037844387 * imag(t117)
+	t123 := complex(t119-t122, t120+t121)
+	t124 := complex(t119+t122, t120-t121)
+	t125 := x34 + x10
+	t126 := x34 - x10
+	t127 := x22 + t125
+	t128 := real(x22) - 0.49999999999999994*real(t125)
+	t129 := imag(x22) - 0.49999999999999994*imag(t125)
+	t130 := 0.8660254037844387 * real(t126)
+	t131 := 0.8660254037844387 * imag(t126)
+	t132 := complex(t128-t131, t129+t130)
+	t133 := complex(t128+t131, t129-t130)
+	t134 := x2 + x14
+	t135 := x2 - x14
+	t136 := x26 + t134
+	t137 := real(x26) - 0.49999999999999994*real(t134)
+	t138 := imag(x26) - 0.49999999999999994*imag(t134)
+	t139 := 0.8660254037844387 * real(t135)
+	t140 := 0.8660254037844387 * imag(t135)
+	t141 := complex(t137-t140, t138+t139)
+	t142 := complex(t137+t140, t138-t139)
+	t143 := t127 + t136
+	t144 := t127 - t136
+	t145 := t118 + t143
+	t146 := real(t118) - 0.49999999999999994*real(t143)
+	t147 := imag(t118) - 0.49999999999999994*imag(t143)
+	t148 := 0.8660254037844387 * real(t144)
+	t149 := 0.8660254037844387 * imag(t144)
+	t150 := complex(t146-t149, t147+t148)
+	t151 := complex(t146+t149, t147-t148)
+	t152 := t132 * complex(0.7660444431189781, 0.6427876096865393)
+	t153 := t141 * complex(0.17364817766693033, 0.9848077530122081)
+	t154 := t152 + t153
+	t155 := t152 - t153
+	t156 := t123 + t154
+	t157 := real(t123) - 0.49999999999999994*real(t154)
+	t158 := imag(t123) - 0.49999999999999994*imag(t154)
+	t159 := 0.8660254037844387 * real(t155)
+	t160 := 0.8660254037844387 * imag(t155)
+	t161 := complex(t157-t160, t158+t159)
+	t162 := complex(t157+t160, t158-t159)
+	t163 := t133 * complex(0.17364817766693033, 0.9848077530122081)
+	t164 := t142 * complex(-0.9396926207859084, 0.3420201433256687)
+	t165 := t163 + t164
+	t166 := t163 - t164
+	t167 := t124 + t165
+	t168 := real(t124) - 0.49999999999999994*real(t165)
+	t169 := imag(t124) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := x3 + x15
+	t175 := x3 - x15
+	t176 := x27 + t174
+	t177 := real(x27) - 0.49999999999999994*real(t174)
+	t178 := imag(x27) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := x7 + x19
+	t184 := x7 - x19
+	t185 := x31 + t183
+	t186 := real(x31) - 0.49999999999999994*real(t183)
+	t187 := imag(x31) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := x11 + x23
+	t193 := x11 - x23
+	t194 := x35 + t192
+	t195 := real(x35) - 0.49999999999999994*real(t192)
+	t196 := imag(x35) - 0.49999999999999994*imag(t192)
+	t197 := 0.8660254037844387 * real(t193)
+	t198 := 0.8660254037844387 * imag(t193)
+	t199 := complex(t195-t198, t196+t197)
+	t200 := complex(t195+t198, t196-t197)
+	t201 := t185 + t194
+	t202 := t185 - t194
+	t203 := t176 + t201
+	t204 := real(t176) - 0.49999999999999994*real(t201)
+	t205 := imag(t176) - 0.49999999999999994*imag(t201)
+	t206 := 0.8660254037844387 * real(t202)
+	t207 := 0.8660254037844387 * imag(t202)
+	t208 := complex(t204-t207, t205+t206)
+	t209 := complex(t204+t207, t205-t206)
+	t210 := t190 * complex(0.7660444431189781, 0.6427876096865393)
+	t211 := t199 * complex(0.17364817766693033, 0.9848077530122081)
+	t212 := t210 + t211
+	t213 := t210 - t211
+	t214 := t181 + t212
+	t215 := real(t181) - 0.49999999999999994*real(t212)
+	t216 := imag(t181) - 0.49999999999999994*imag(t212)
+	t217 := 0.8660254037844387 * real(t213)
+	t218 := 0.8660254037844387 * imag(t213)
+	t219 := complex(t215-t218, t216+t217)
+	t220 := complex(t215+t218, t216-t217)
+	t221 := t191 * complex(0.17364817766693033, 0.9848077530122081)
+	t222 := t200 * complex(-0.9396926207859084, 0.3420201433256687)
+	t223 := t221 + t222
+	t224 := t221 - t222
+	t225 := t182 + t223
+	t226 := real(t182) - 0.49999999999999994*real(t223)
+	t227 := imag(t182) - 0.49999999999999994*imag(t223)
+	t228 := 0.8660254037844387 * real(t224)
+	t229 := 0.8660254037844387 * imag(t224)
+	t230 := complex(t226-t229, t227+t228)
+	t231 := complex(t226+t229, t227-t228)
+	t232 := t29 + t145
+	t233 := t29 - t145
+	t234 := t87 + t203
+	t235 := t87 - t203
+	t236 := complex(-imag(t235), real(t235))
+	t237 := t232 + t234
+	t238 := t233 + t236
+	t239 := t232 - t234
+	t240 := t233 - t236
+	t241 := t40 + t156
+	t242 := t40 - t156
+	t243 := t98 + t214
+	t244 := t98 - t214
+	t245 := complex(-imag(t244), real(t244))
+	t246 := t241 + t243
+	t247 := t242 + t245
+	t248 := t241 - t243
+	t249 := t242 - t245
+	t250 := t51 + t167
+	t251 := t51 - t167
+	t252 := t109 + t225
+	t253 := t109 - t225
+	t254 := complex(-imag(t253), real(t253))
+	t255 := t250 + t252
+	t256 := t251 + t254
+	t257 := t250 - t252
+	t258 := t251 - t254
+	t259 := t34 + t150
+	t260 := t34 - t150
+	t261 := t92 + t208
+	t262 := t92 - t208
+	t263 := complex(-imag(t262), real(t262))
+	t264 := t259 + t261
+	t265 := t260 + t263
+	t266 := t259 - t261
+	t267 := t260 - t263
+	t268 := t45 + t161
+	t269 := t45 - t161
+	t270 := t103 + t219
+	t271 := t103 - t219
+	t272 := complex(-imag(t271), real(t271))
+	t273 := t268 + t270
+	t274 := t269 + t272
+	t275 := t268 - t270
+	t276 := t269 - t272
+	t277 := t56 + t172
+	t278 := t56 - t172
+	t279 := t114 + t230
+	t280 := t114 - t230
+	t281 := complex(-imag(t280), real(t280))
+	t282 := t277 + t279
+	t283 := t278 + t281
+	t284 := t277 - t279
+	t285 := t278 - t281
+	t286 := t35 + t151
+	t287 := t35 - t151
+	t288 := t93 + t209
+	t289 := t93 - t209
+	t290 := complex(-imag(t289), real(t289))
+	t291 := t286 + t288
+	t292 := t287 + t290
+	t293 := t286 - t288
+	t294 := t287 - t290
+	t295 := t46 + t162
+	t296 := t46 - t162
+	t297 := t104 + t220
+	t298 := t104 - t220
+	t299 := complex(-imag(t298), real(t298))
+	t300 := t295 + t297
+	t301 := t296 + t299
+	t302 := t295 - t297
+	t303 := t296 - t299
+	t304 := t57 + t173
+	t305 := t57 - t173
+	t306 := t115 + t231
+	t307 := t115 - t231
+	t308 := complex(-imag(t307), real(t307))
+	t309 := t304 + t306
+	t310 := t305 + t308
+	t311 := t304 - t306
+	t312 := t305 - t308
+
+	d[0] = complex(real(t237)*0.027777777777777776, imag(t237)*0.027777777777777776)
+	d[1] = complex(real(t247)*0.027777777777777776, imag(t247)*0.027777777777777776)
+	d[2] = complex(real(t257)*0.027777777777777776, imag(t257)*0.027777777777777776)
+	d[3] = complex(real(t267)*0.027777777777777776, imag(t267)*0.027777777777777776)
+	d[4] = complex(real(t273)*0.027777777777777776, imag(t273)*0.027777777777777776)
+	d[5] = complex(real(t283)*0.027777777777777776, imag(t283)*0.027777777777777776)
+	d[6] = complex(real(t293)*0.027777777777777776, imag(t293)*0.027777777777777776)
+	d[7] = complex(real(t303)*0.027777777777777776, imag(t303)*0.027777777777777776)
+	d[8] = complex(real(t309)*0.027777777777777776, imag(t309)*0.027777777777777776)
+	d[9] = complex(real(t238)*0.027777777777777776, imag(t238)*0.027777777777777776)
+	d[10] = complex(real(t248)*0.027777777777777776, imag(t248)*0.027777777777777776)
+	d[11] = complex(real(t258)*0.027777777777777776, imag(t258)*0.027777777777777776)
+	d[12] = complex(real(t264)*0.027777777777777776, imag(t264)*0.027777777777777776)
+	d[13] = complex(real(t274)*0.027777777777777776, imag(t274)*0.027777777777777776)
+	d[14] = complex(real(t284)*0.027777777777777776, imag(t284)*0.027777777777777776)
+	d[15] = complex(real(t294)*0.027777777777777776, imag(t294)*0.027777777777777776)
+	d[16] = complex(real(t300)*0.027777777777777776, imag(t300)*0.027777777777777776)
+	d[17] = complex(real(t310)*0.027777777777777776, imag(t310)*0.027777777777777776)
+	d[18] = complex(real(t239)*0.027777777777777776, imag(t239)*0.027777777777777776)
+	d[19] = complex(real(t249)*0.027777777777777776, imag(t249)*0.027777777777777776)
+	d[20] = complex(real(t255)*0.027777777777777776, imag(t255)*0.027777777777777776)
+	d[21] = complex(real(t265)*0.027777777777777776, imag(t265)*0.027777777777777776)
+	d[22] = complex(real(t275)*0.027777777777777776, imag(t275)*0.027777777777777776)
+	d[23] = complex(real(t285)*0.027777777777777776, imag(t285)*0.027777777777777776)
+	d[24] = complex(real(t291)*0.027777777777777776, imag(t291)*0.027777777777777776)
+	d[25] = complex(real(t301)*0.027777777777777776, imag(t301)*0.027777777777777776)
+	d[26] = complex(real(t311)*0.027777777777777776, imag(t311)*0.027777777777777776)
+	d[27] = complex(real(t240)*0.027777777777777776, imag(t240)*0.027777777777777776)
+	d[28] = complex(real(t246)*0.027777777777777776, imag(t246)*0.027777777777777776)
+	d[29] = complex(real(t256)*0.027777777777777776, imag(t256)*0.027777777777777776)
+	d[30] = complex(real(t266)*0.027777777777777776, imag(t266)*0.027777777777777776)
+	d[31] = complex(real(t276)*0.027777777777777776, imag(t276)*0.027777777777777776)
+	d[32] = complex(real(t282)*0.027777777777777776, imag(t282)*0.027777777777777776)
+	d[33] = complex(real(t292)*0.027777777777777776, imag(t292)*0.027777777777777776)
+	d[34] = complex(real(t302)*0.027777777777777776, imag(t302)*0.027777777777777776)
+	d[35] = complex(real(t312)*0.027777777777777776, imag(t312)*0.027777777777777776)
+
+	return true
+}
+
+// forwardDFT36Complex128 computes a 36-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT36Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 36
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+
+	t0 := x12 + x24
+	t1 := x12 - x24
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x16 + x28
+	t10 := x16 - x28
+	t11 := x4 + t9
+	t12 := real(x4) - 0.49999999999999994*real(t9)
+	t13 := imag(x4) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x20 + x32
+	t19 := x20 - x32
+	t20 := x8 + t18
+	t21 := real(x8) - 0.49999999999999994*real(t18)
+	t22 := imag(x8) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := t11 + t20
+	t28 := t11 - t20
+	t29 := t2 + t27
+	t30 := real(t2) - 0.49999999999999994*real(t27)
+	t31 := imag(t2) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t17 * complex(0.7660444431189781, -0.6427876096865393)
+	t37 := t26 * complex(0.17364817766693033, -0.9848077530122081)
+	t38 := t36 + t37
+	t39 := t36 - t37
+	t40 := t8 + t38
+	t41 := real(t8) - 0.49999999999999994*real(t38)
+	t42 := imag(t8) - 0.49999999999999994*imag(t38)
+	t43 := 0.8660254037844387 * real(t39)
+	t44 := 0.8660254037844387 * imag(t39)
+	t45 := complex(t41-t44, t42+t43)
+	t46 := complex(t41+t44, t42-t43)
+	t47 := t16 * complex(0.17364817766693033, -0.9848077530122081)
+	t48 := t25 * complex(-0.9396926207859084, -0.3420201433256687)
+	t49 := t47 + t48
+	t50 := t47 - t48
+	t51 := t7 + t49
+	t52 := real(t7) - 0.49999999999999994*real(t49)
+	t53 := imag(t7) - 0.49999999999999994*imag(t49)
+	t54 := 0.8660254037844387 * real(t50)
+	t55 := 0.8660254037844387 * imag(t50)
+	t56 := complex(t52-t55, t53+t54)
+	t57 := complex(t52+t55, t53-t54)
+	t58 := x21 + x33
+	t59 := x21 - x33
+	t60 := x9 + t58
+	t61 := real(x9) - 0.49999999999999994*real(t58)
+	t62 := imag(x9) - 0.49999999999999994*imag(t58)
+	t63 := 0.8660254037844387 * real(t59)
+	t64 := 0.8660254037844387 * imag(t59)
+	t65 := complex(t61-t64, t62+t63)
+	t66 := complex(t61+t64, t62-t63)
+	t67 := x25 + x1
+	t68 := x25 - x1
+	t69 := x13 + t67
+	t70 := real(x13) - 0.49999999999999994*real(t67)
+	t71 := imag(x13) - 0.49999999999999994*imag(t67)
+	t72 := 0.8660254037844387 * real(t68)
+	t73 := 0.8660254037844387 * imag(t68)
+	t74 := complex(t70-t73, t71+t72)
+	t75 := complex(t70+t73, t71-t72)
+	t76 := x29 + x5
+	t77 := x29 - x5
+	t78 := x17 + t76
+	t79 := real(x17) - 0.49999999999999994*real(t76)
+	t80 := imag(x17) - 0.49999999999999994*imag(t76)
+	t81 := 0.8660254037844387 * real(t77)
+	t82 := 0.8660254037844387 * imag(t77)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := t69 + t78
+	t86 := t69 - t78
+	t87 := t60 + t85
+	t88 := real(t60) - 0.49999999999999994*real(t85)
+	t89 := imag(t60) - 0.49999999999999994*imag(t85)
+	t90 := 0.8660254037844387 * real(t86)
+	t91 := 0.8660254037844387 * imag(t86)
+	t92 := complex(t88-t91, t89+t90)
+	t93 := complex(t88+t91, t89-t90)
+	t94 := t75 * complex(0.7660444431189781, -0.6427876096865393)
+	t95 := t84 * complex(0.17364817766693033, -0.9848077530122081)
+	t96 := t94 + t95
+	t97 := t94 - t95
+	t98 := t66 + t96
+	t99 := real(t66) - 0.49999999999999994*real(t96)
+	t100 := imag(t66) - 0.49999999999999994*imag(t96)
+	t101 := 0.8660254037844387 * real(t97)
+	t102 := 0.8660254037844387 * imag(t97)
+	t103 := complex(t99-t102, t100+t101)
+	t104 := complex(t99+t102, t100-t101)
+	t105 := t74 * complex(0.17364817766693033, -0.9848077530122081)
+	t106 := t83 * complex(-0.9396926207859084, -0.3420201433256687)
+	t107 := t105 + t106
+	t108 := t105 - t106
+	t109 := t65 + t107
+	t110 := real(t65) - 0.49999999999999994*real(t107)
+	t111 := imag(t65) - 0.49999999999999994*imag(t107)
+	t112 := 0.8660254037844387 * real(t108)
+	t113 := 0.8660254037844387 * imag(t108)
+	t114 := complex(t110-t113, t111+t112)
+	t115 := complex(t110+t113, t111-t112)
+	t116 := x30 + x6
+	t117 := x30 - x6
+	t118 := x18 + t116
+	t119 := real(x18) - 0.49999999999999994*real(t116)
+	t120 := imag(x18) - 0.49999999999999994*imag(t116)
+	t121 := 0.8660254037844387 * real(t117)
+	t122 := 0.8660254037844387 * imag(t117)
+	t123 := complex(t119-t122, t120+t121)
+	t124 := complex(t119+t122, t120-t121)
+	t125 := x34 + x10
+	t126 := x34 - x10
+	t127 := x22 + t125
+	t128 := real(x22) - 0.49999999999999994*real(t125)
+	t129 := imag(x22) - 0.49999999999999994*imag(t125)
+	t130 := 0.8660254037844387 * real(t126)
+	t131 := 0.8660254037844387 * imag(t126)
+	t132 := complex(t128-t131, t129+t130)
+	t133 := complex(t128+t131, t129-t130)
+	t134 := x2 + x14
+	t135 := x2 - x14
+	t136 := x26 + t134
+	t137 := real(x26) - 0.49999999999999994*real(t134)
+	t138 := imag(x26) - 0.49999999999999994*imag(t134)
+	t139 := 0.8660254037844387 * real(t135)
+	t140 := 0.8660254037844387 * imag(t135)
+	t141 := complex(t137-t140, t138+t139)
+	t142 := complex(t137+t140, t138-t139)
+	t143 := t127 + t136
+	t144 := t127 - t136
+	t145 := t118 + t143
+	t146 := real(t118) - 0.49999999999999994*real(t143)
+	t147 := imag(t118) - 0.49999999999999994*imag(t143)
+	t148 := 0.8660254037844387 * real(t144)
+	t149 := 0.8660254037844387 * imag(t144)
+	t150 := complex(t146-t149, t147+t148)
+	t151 := complex(t146+t149, t147-t148)
+	t152 := t133 * complex(0.7660444431189781, -0.6427876096865393)
+	t153 := t142 * complex(0.17364817766693033, -0.9848077530122081)
+	t154 := t152 + t153
+	t155 := t152 - t153
+	t156 := t124 + t154
+	t157 := real(t124) - 0.49999999999999994*real(t154)
+	t158 := imag(t124) - 0.49999999999999994*imag(t154)
+	t159 := 0.8660254037844387 * real(t155)
+	t160 := 0.8660254037844387 * imag(t155)
+	t161 := complex(t157-t160, t158+t159)
+	t162 := complex(t157+t160, t158-t159)
+	t163 := t132 * complex(0.17364817766693033, -0.9848077530122081)
+	t164 := t141 * complex(-0.9396926207859084, -0.3420201433256687)
+	t165 := t163 + t164
+	t166 := t163 - t164
+	t167 := t123 + t165
+	t168 := real(t123) - 0.49999999999999994*real(t165)
+	t169 := imag(t123) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := x3 + x15
+	t175 := x3 - x15
+	t176 := x27 + t174
+	t177 := real(x27) - 0.49999999999999994*real(t174)
+	t178 := imag(x27) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := x7 + x19
+	t184 := x7 - x19
+	t185 := x31 + t183
+	t186 := real(x31) - 0.49999999999999994*real(t183)
+	t187 := imag(x31) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := x11 + x23
+	t193 := x11 - x23
+	t194 := x35 + t192
+	t195 := real(x35) - 0.49999999999999994*real(t192)
+	t196 := imag(x35) - 0.49999999999999994*imag(t192)
+	t197 := 0.8660254037844387 * real(t193)
+	t198 := 0.8660254037844387 * imag(t193)
+	t199 := complex(t195-t198, t196+t197)
+	t200 := complex(t195+t198, t196-t197)
+	t201 := t185 + t194
+	t202 := t185 - t194
+	t203 := t176 + t201
+	t204 := real(t176) - 0.49999999999999994*real(t201)
+	t205 := imag(t176) - 0.49999999999999994*imag(t201)
+	t206 := 0.8660254037844387 * real(t202)
+	t207 := 0.8660254037844387 * imag(t202)
+	t208 := complex(t204-t207, t205+t206)
+	t209 := complex(t204+t207, t205-t206)
+	t210 := t191 * complex(0.7660444431189781, -0.6427876096865393)
+	t211 := t200 * complex(0.17364817766693033, -0.9848077530122081)
+	t212 := t210 + t211
+	t213 := t210 - t211
+	t214 := t182 + t212
+	t215 := real(t182) - 0.49999999999999994*real(t212)
+	t216 := imag(t182) - 0.49999999999999994*imag(t212)
+	t217 := 0.8660254037844387 * real(t213)
+	t218 := 0.8660254037844387 * imag(t213)
+	t219 := complex(t215-t218, t216+t217)
+	t220 := complex(t215+t218, t216-t217)
+	t221 := t190 * complex(0.17364817766693033, -0.9848077530122081)
+	t222 := t199 * complex(-0.9396926207859084, -0.3420201433256687)
+	t223 := t221 + t222
+	t224 := t221 - t222
+	t225 := t181 + t223
+	t226 := real(t181) - 0.49999999999999994*real(t223)
+	t227 := imag(t181) - 0.49999999999999994*imag(t223)
+	t228 := 0.8660254037844387 * real(t224)
+	t229 := 0.8660254037844387 * imag(t224)
+	t230 := complex(t226-t229, t227+t228)
+	t231 := complex(t226+t229, t227-t228)
+	t232 := t29 + t145
+	t233 := t29 - t145
+	t234 := t87 + t203
+	t235 := t87 - t203
+	t236 := complex(imag(t235), -real(t235))
+	t237 := t232 + t234
+	t238 := t233 + t236
+	t239 := t232 - t234
+	t240 := t233 - t236
+	t241 := t40 + t156
+	t242 := t40 - t156
+	t243 := t98 + t214
+	t244 := t98 - t214
+	t245 := complex(imag(t244), -real(t244))
+	t246 := t241 + t243
+	t247 := t242 + t245
+	t248 := t241 - t243
+	t249 := t242 - t245
+	t250 := t51 + t167
+	t251 := t51 - t167
+	t252 := t109 + t225
+	t253 := t109 - t225
+	t254 := complex(imag(t253), -real(t253))
+	t255 := t250 + t252
+	t256 := t251 + t254
+	t257 := t250 - t252
+	t258 := t251 - t254
+	t259 := t35 + t151
+	t260 := t35 - t151
+	t261 := t93 + t209
+	t262 := t93 - t209
+	t263 := complex(imag(t262), -real(t262))
+	t264 := t259 + t261
+	t265 := t260 + t263
+	t266 := t259 - t261
+	t267 := t260 - t263
+	t268 := t46 + t162
+	t269 := t46 - t162
+	t270 := t104 + t220
+	t271 := t104 - t220
+	t272 := complex(imag(t271), -real(t271))
+	t273 := t268 + t270
+	t274 := t269 + t272
+	t275 := t268 - t270
+	t276 := t269 - t272
+	t277 := t57 + t173
+	t278 := t57 - t173
+	t279 := t115 + t231
+	t280 := t115 - t231
+	t281 := complex(imag(t280), -real(t280))
+	t282 := t277 + t279
+	t283 := t278 + t281
+	t284 := t277 - t279
+	t285 := t278 - t281
+	t286 := t34 + t150
+	t287 := t34 - t150
+	t288 := t92 + t208
+	t289 := t92 - t208
+	t290 := complex(imag(t289), -real(t289))
+	t291 := t286 + t288
+	t292 := t287 + t290
+	t293 := t286 - t288
+	t294 := t287 - t290
+	t295 := t45 + t161
+	t296 := t45 - t161
+	t297 := t103 + t219
+	t298 := t103 - t219
+	t299 := complex(imag(t298), -real(t298))
+	t300 := t295 + t297
+	t301 := t296 + t299
+	t302 := t295 - t297
+	t303 := t296 - t299
+	t304 := t56 + t172
+	t305 := t56 - t172
+	t306 := t114 + t230
+	t307 := t114 - t230
+	t308 := complex(imag(t307), -real(t307))
+	t309 := t304 + t306
+	t310 := t305 + t308
+	t311 := t304 - t306
+	t312 := t305 - t308
+
+	d[0] = t237
+	d[1] = t247
+	d[2] = t257
+	d[3] = t267
+	d[4] = t273
+	d[5] = t283
+	d[6] = t293
+	d[7] = t303
+	d[8] = t309
+	d[9] = t238
+	d[10] = t248
+	d[11] = t258
+	d[12] = t264
+	d[13] = t274
+	d[14] = t284
+	d[15] = t294
+	d[16] = t300
+	d[17] = t310
+	d[18] = t239
+	d[19] = t249
+	d[20] = t255
+	d[21] = t265
+	d[22] = t275
+	d[23] = t285
+	d[24] = t291
+	d[25] = t301
+	d[26] = t311
+	d[27] = t240
+	d[28] = t246
+	d[29] = t256
+	d[30] = t266
+	d[31] = t276
+	d[32] = t282
+	d[33] = t292
+	d[34] = t302
+	d[35] = t312
+
+	return true
+}
+
+// inverseDFT36Complex128 computes a 36-point inverse DFT of complex128 data,
+// including the 1/36 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT36Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 36
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+
+	t0 := x12 + x24
+	t1 := x12 - x24
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x16 + x28
+	t10 := x16 - x28
+	t11 := x4 + t9
+	t12 := real(x4) - 0.49999999999999994*real(t9)
+	t13 := imag(x4) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x20 + x32
+	t19 := x20 - x32
+	t20 := x8 + t18
+	t21 := real(x8) - 0.49999999999999994*real(t18)
+	t22 := imag(x8) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := t11 + t20
+	t28 := t11 - t20
+	t29 := t2 + t27
+	t30 := real(t2) - 0.49999999999999994*real(t27)
+	t31 := imag(t2) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t16 * complex(0.7660444431189781, 0.6427876096865393)
+	t37 := t25 * complex(0.17364817766693033, 0.9848077530122081)
+	t38 := t36 + t37
+	t39 := t36 - t37
+	t40 := t7 + t38
+	t41 := real(t7) - 0.49999999999999994*real(t38)
+	t42 := imag(t7) - 0.49999999999999994*imag(t38)
+	t43 := 0.8660254037844387 * real(t39)
+	t44 := 0.8660254037844387 * imag(t39)
+	t45 := complex(t41-t44, t42+t43)
+	t46 := complex(t41+t44, t42-t43)
+	t47 := t17 * complex(0.17364817766693033, 0.9848077530122081)
+	t48 := t26 * complex(-0.9396926207859084, 0.3420201433256687)
+	t49 := t47 + t48
+	t50 := t47 - t48
+	t51 := t8 + t49
+	t52 := real(t8) - 0.49999999999999994*real(t49)
+	t53 := imag(t8) - 0.49999999999999994*imag(t49)
+	t54 := 0.8660254037844387 * real(t50)
+	t55 := 0.8660254037844387 * imag(t50)
+	t56 := complex(t52-t55, t53+t54)
+	t57 := complex(t52+t55, t53-t54)
+	t58 := x21 + x33
+	t59 := x21 - x33
+	t60 := x9 + t58
+	t61 := real(x9) - 0.49999999999999994*real(t58)
+	t62 := imag(x9) - 0.49999999999999994*imag(t58)
+	t63 := 0.8660254037844387 * real(t59)
+	t64 := 0.8660254037844387 * imag(t59)
+	t65 := complex(t61-t64, t62+t63)
+	t66 := complex(t61+t64, t62-t63)
+	t67 := x25 + x1
+	t68 := x25 - x1
+	t69 := x13 + t67
+	t70 := real(x13) - 0.49999999999999994*real(t67)
+	t71 := imag(x13) - 0.49999999999999994*imag(t67)
+	t72 := 0.8660254037844387 * real(t68)
+	t73 := 0.8660254037844387 * imag(t68)
+	t74 := complex(t70-t73, t71+t72)
+	t75 := complex(t70+t73, t71-t72)
+	t76 := x29 + x5
+	t77 := x29 - x5
+	t78 := x17 + t76
+	t79 := real(x17) - 0.49999999999999994*real(t76)
+	t80 := imag(x17) - 0.49999999999999994*imag(t76)
+	t81 := 0.8660254037844387 * real(t77)
+	t82 := 0.8660254037844387 * imag(t77)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := t69 + t78
+	t86 := t69 - t78
+	t87 := t60 + t85
+	t88 := real(t60) - 0.49999999999999994*real(t85)
+	t89 := imag(t60) - 0.49999999999999994*imag(t85)
+	t90 := 0.8660254037844387 * real(t86)
+	t91 := 0.8660254037844387 * imag(t86)
+	t92 := complex(t88-t91, t89+t90)
+	t93 := complex(t88+t91, t89-t90)
+	t94 := t74 * complex(0.7660444431189781, 0.6427876096865393)
+	t95 := t83 * complex(0.17364817766693033, 0.9848077530122081)
+	t96 := t94 + t95
+	t97 := t94 - t95
+	t98 := t65 + t96
+	t99 := real(t65) - 0.49999999999999994*real(t96)
+	t100 := imag(t65) - 0.49999999999999994*imag(t96)
+	t101 := 0.8660254037844387 * real(t97)
+	t102 := 0.8660254037844387 * imag(t97)
+	t103 := complex(t99-t102, t100+t101)
+	t104 := complex(t99+t102, t100-t101)
+	t105 := t75 * complex(0.17364817766693033, 0.9848077530122081)
+	t106 := t84 * complex(-0.9396926207859084, 0.3420201433256687)
+	t107 := t105 + t106
+	t108 := t105 - t106
+	t109 := t66 + t107
+	t110 := real(t66) - 0.49999999999999994*real(t107)
+	t111 := imag(t66) - 0.49999999999999994*imag(t107)
+	t112 := 0.8660254037844387 * real(t108)
+	t113 := 0.8660254037844387 * imag(t108)
+	t114 := complex(t110-t113, t111+t112)
+	t115 := complex(t110+t113, t111-t112)
+	t116 := x30 + x6
+	t117 := x30 - x6
+	t118 := x18 + t116
+	t119 := real(x18) - 0.49999999999999994*real(t116)
+	t120 := imag(x18) - 0.49999999999999994*imag(t116)
+	t121 := 0.8660254037844387 * real(t117)
+	t122 := 0.8660254037844387 * imag(t117)
+	t123 := complex(t119-t122, t120+t121)
+	t124 := complex(t119+t122, t120-t121)
+	t125 := x34 + x10
+	t126 := x34 - x10
+	t127 := x22 + t125
+	t128 := real(x22) - 0.49999999999999994*real(t125)
+	t129 := imag(x22) - 0.49999999999999994*imag(t125)
+	t130 := 0.8660254037844387 * real(t126)
+	t131 := 0.8660254037844387 * imag(t126)
+	t132 := complex(t128-t131, t129+t130)
+	t133 := complex(t128+t131, t129-t130)
+	t134 := x2 + x14
+	t135 := x2 - x14
+	t136 := x26 + t134
+	t137 := real(x26) - 0.49999999999999994*real(t134)
+	t138 := imag(x26) - 0.49999999999999994*imag(t134)
+	t139 := 0.8660254037844387 * real(t135)
+	t140 := 0.8660254037844387 * imag(t135)
+	t141 := complex(t137-t140, t138+t139)
+	t142 := complex(t137+t140, t138-t139)
+	t143 := t127 + t136
+	t144 := t127 - t136
+	t145 := t118 + t143
+	t146 := real(t118) - 0.49999999999999994*real(t143)
+	t147 := imag(t118) - 0.49999999999999994*imag(t143)
+	t148 := 0.8660254037844387 * real(t144)
+	t149 := 0.8660254037844387 * imag(t144)
+	t150 := complex(t146-t149, t147+t148)
+	t151 := complex(t146+t149, t147-t148)
+	t152 := t132 * complex(0.7660444431189781, 0.6427876096865393)
+	t153 := t141 * complex(0.17364817766693033, 0.9848077530122081)
+	t154 := t152 + t153
+	t155 := t152 - t153
+	t156 := t123 + t154
+	t157 := real(t123) - 0.49999999999999994*real(t154)
+	t158 := imag(t123) - 0.49999999999999994*imag(t154)
+	t159 := 0.8660254037844387 * real(t155)
+	t160 := 0.8660254037844387 * imag(t155)
+	t161 := complex(t157-t160, t158+t159)
+	t162 := complex(t157+t160, t158-t159)
+	t163 := t133 * complex(0.17364817766693033, 0.9848077530122081)
+	t164 := t142 * complex(-0.9396926207859084, 0.3420201433256687)
+	t165 := t163 + t164
+	t166 := t163 - t164
+	t167 := t124 + t165
+	t168 := real(t124) - 0.49999999999999994*real(t165)
+	t169 := imag(t124) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := x3 + x15
+	t175 := x3 - x15
+	t176 := x27 + t174
+	t177 := real(x27) - 0.49999999999999994*real(t174)
+	t178 := imag(x27) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := x7 + x19
+	t184 := x7 - x19
+	t185 := x31 + t183
+	t186 := real(x31) - 0.49999999999999994*real(t183)
+	t187 := imag(x31) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := x11 + x23
+	t193 := x11 - x23
+	t194 := x35 + t192
+	t195 := real(x35) - 0.49999999999999994*real(t192)
+	t196 := imag(x35) - 0.49999999999999994*imag(t192)
+	t197 := 0.8660254037844387 * real(t193)
+	t198 := 0.8660254037844387 * imag(t193)
+	t199 := complex(t195-t198, t196+t197)
+	t200 := complex(t195+t198, t196-t197)
+	t201 := t185 + t194
+	t202 := t185 - t194
+	t203 := t176 + t201
+	t204 := real(t176) - 0.49999999999999994*real(t201)
+	t205 := imag(t176) - 0.49999999999999994*imag(t201)
+	t206 := 0.8660254037844387 * real(t202)
+	t207 := 0.8660254037844387 * imag(t202)
+	t208 := complex(t204-t207, t205+t206)
+	t209 := complex(t204+t207, t205-t206)
+	t210 := t190 * complex(0.7660444431189781, 0.6427876096865393)
+	t211 := t199 * complex(0.17364817766693033, 0.9848077530122081)
+	t212 := t210 + t211
+	t213 := t210 - t211
+	t214 := t181 + t212
+	t215 := real(t181) - 0.49999999999999994*real(t212)
+	t216 := imag(t181) - 0.49999999999999994*imag(t212)
+	t217 := 0.8660254037844387 * real(t213)
+	t218 := 0.8660254037844387 * imag(t213)
+	t219 := complex(t215-t218, t216+t217)
+	t220 := complex(t215+t218, t216-t217)
+	t221 := t191 * complex(0.17364817766693033, 0.9848077530122081)
+	t222 := t200 * complex(-0.9396926207859084, 0.3420201433256687)
+	t223 := t221 + t222
+	t224 := t221 - t222
+	t225 := t182 + t223
+	t226 := real(t182) - 0.49999999999999994*real(t223)
+	t227 := imag(t182) - 0.49999999999999994*imag(t223)
+	t228 := 0.8660254037844387 * real(t224)
+	t229 := 0.8660254037844387 * imag(t224)
+	t230 := complex(t226-t229, t227+t228)
+	t231 := complex(t226+t229, t227-t228)
+	t232 := t29 + t145
+	t233 := t29 - t145
+	t234 := t87 + t203
+	t235 := t87 - t203
+	t236 := complex(-imag(t235), real(t235))
+	t237 := t232 + t234
+	t238 := t233 + t236
+	t239 := t232 - t234
+	t240 := t233 - t236
+	t241 := t40 + t156
+	t242 := t40 - t156
+	t243 := t98 + t214
+	t244 := t98 - t214
+	t245 := complex(-imag(t244), real(t244))
+	t246 := t241 + t243
+	t247 := t242 + t245
+	t248 := t241 - t243
+	t249 := t242 - t245
+	t250 := t51 + t167
+	t251 := t51 - t167
+	t252 := t109 + t225
+	t253 := t109 - t225
+	t254 := complex(-imag(t253), real(t253))
+	t255 := t250 + t252
+	t256 := t251 + t254
+	t257 := t250 - t252
+	t258 := t251 - t254
+	t259 := t34 + t150
+	t260 := t34 - t150
+	t261 := t92 + t208
+	t262 := t92 - t208
+	t263 := complex(-imag(t262), real(t262))
+	t264 := t259 + t261
+	t265 := t260 + t263
+	t266 := t259 - t261
+	t267 := t260 - t263
+	t268 := t45 + t161
+	t269 := t45 - t161
+	t270 := t103 + t219
+	t271 := t103 - t219
+	t272 := complex(-imag(t271), real(t271))
+	t273 := t268 + t270
+	t274 := t269 + t272
+	t275 := t268 - t270
+	t276 := t269 - t272
+	t277 := t56 + t172
+	t278 := t56 - t172
+	t279 := t114 + t230
+	t280 := t114 - t230
+	t281 := complex(-imag(t280), real(t280))
+	t282 := t277 + t279
+	t283 := t278 + t281
+	t284 := t277 - t279
+	t285 := t278 - t281
+	t286 := t35 + t151
+	t287 := t35 - t151
+	t288 := t93 + t209
+	t289 := t93 - t209
+	t290 := complex(-imag(t289), real(t289))
+	t291 := t286 + t288
+	t292 := t287 + t290
+	t293 := t286 - t288
+	t294 := t287 - t290
+	t295 := t46 + t162
+	t296 := t46 - t162
+	t297 := t104 + t220
+	t298 := t104 - t220
+	t299 := complex(-imag(t298), real(t298))
+	t300 := t295 + t297
+	t301 := t296 + t299
+	t302 := t295 - t297
+	t303 := t296 - t299
+	t304 := t57 + t173
+	t305 := t57 - t173
+	t306 := t115 + t231
+	t307 := t115 - t231
+	t308 := complex(-imag(t307), real(t307))
+	t309 := t304 + t306
+	t310 := t305 + t308
+	t311 := t304 - t306
+	t312 := t305 - t308
+
+	d[0] = complex(real(t237)*0.027777777777777776, imag(t237)*0.027777777777777776)
+	d[1] = complex(real(t247)*0.027777777777777776, imag(t247)*0.027777777777777776)
+	d[2] = complex(real(t257)*0.027777777777777776, imag(t257)*0.027777777777777776)
+	d[3] = complex(real(t267)*0.027777777777777776, imag(t267)*0.027777777777777776)
+	d[4] = complex(real(t273)*0.027777777777777776, imag(t273)*0.027777777777777776)
+	d[5] = complex(real(t283)*0.027777777777777776, imag(t283)*0.027777777777777776)
+	d[6] = complex(real(t293)*0.027777777777777776, imag(t293)*0.027777777777777776)
+	d[7] = complex(real(t303)*0.027777777777777776, imag(t303)*0.027777777777777776)
+	d[8] = complex(real(t309)*0.027777777777777776, imag(t309)*0.027777777777777776)
+	d[9] = complex(real(t238)*0.027777777777777776, imag(t238)*0.027777777777777776)
+	d[10] = complex(real(t248)*0.027777777777777776, imag(t248)*0.027777777777777776)
+	d[11] = complex(real(t258)*0.027777777777777776, imag(t258)*0.027777777777777776)
+	d[12] = complex(real(t264)*0.027777777777777776, imag(t264)*0.027777777777777776)
+	d[13] = complex(real(t274)*0.027777777777777776, imag(t274)*0.027777777777777776)
+	d[14] = complex(real(t284)*0.027777777777777776, imag(t284)*0.027777777777777776)
+	d[15] = complex(real(t294)*0.027777777777777776, imag(t294)*0.027777777777777776)
+	d[16] = complex(real(t300)*0.027777777777777776, imag(t300)*0.027777777777777776)
+	d[17] = complex(real(t310)*0.027777777777777776, imag(t310)*0.027777777777777776)
+	d[18] = complex(real(t239)*0.027777777777777776, imag(t239)*0.027777777777777776)
+	d[19] = complex(real(t249)*0.027777777777777776, imag(t249)*0.027777777777777776)
+	d[20] = complex(real(t255)*0.027777777777777776, imag(t255)*0.027777777777777776)
+	d[21] = complex(real(t265)*0.027777777777777776, imag(t265)*0.027777777777777776)
+	d[22] = complex(real(t275)*0.027777777777777776, imag(t275)*0.027777777777777776)
+	d[23] = complex(real(t285)*0.027777777777777776, imag(t285)*0.027777777777777776)
+	d[24] = complex(real(t291)*0.027777777777777776, imag(t291)*0.027777777777777776)
+	d[25] = complex(real(t301)*0.027777777777777776, imag(t301)*0.027777777777777776)
+	d[26] = complex(real(t311)*0.027777777777777776, imag(t311)*0.027777777777777776)
+	d[27] = complex(real(t240)*0.027777777777777776, imag(t240)*0.027777777777777776)
+	d[28] = complex(real(t246)*0.027777777777777776, imag(t246)*0.027777777777777776)
+	d[29] = complex(real(t256)*0.027777777777777776, imag(t256)*0.027777777777777776)
+	d[30] = complex(real(t266)*0.027777777777777776, imag(t266)*0.027777777777777776)
+	d[31] = complex(real(t276)*0.027777777777777776, imag(t276)*0.027777777777777776)
+	d[32] = complex(real(t282)*0.027777777777777776, imag(t282)*0.027777777777777776)
+	d[33] = complex(real(t292)*0.027777777777777776, imag(t292)*0.027777777777777776)
+	d[34] = complex(real(t302)*0.027777777777777776, imag(t302)*0.027777777777777776)
+	d[35] = complex(real(t312)*0.027777777777777776, imag(t312)*0.027777777777777776)
+
+	return true
+}
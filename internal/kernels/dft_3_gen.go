@@ -0,0 +1,145 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT3Complex64 computes a 3-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 3
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+
+	t0 := x1 + x2
+	t1 := x1 - x2
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+
+	d[0] = t2
+	d[1] = t8
+	d[2] = t7
+
+	return true
+}
+
+// inverseDFT3Complex64 computes a 3-point inverse DFT of complex64 data,
+// including the 1/3 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT3Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 3
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+
+	t0 := x1 + x2
+	t1 := x1 - x2
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+
+	d[0] = complex(real(t2)*0.3333333333333333, imag(t2)*0.3333333333333333)
+	d[1] = complex(real(t7)*0.3333333333333333, imag(t7)*0.3333333333333333)
+	d[2] = complex(real(t8)*0.3333333333333333, imag(t8)*0.3333333333333333)
+
+	return true
+}
+
+// forwardDFT3Complex128 computes a 3-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 3
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+
+	t0 := x1 + x2
+	t1 := x1 - x2
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+
+	d[0] = t2
+	d[1] = t8
+	d[2] = t7
+
+	return true
+}
+
+// inverseDFT3Complex128 computes a 3-point inverse DFT of complex128 data,
+// including the 1/3 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT3Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 3
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+
+	t0 := x1 + x2
+	t1 := x1 - x2
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+
+	d[0] = complex(real(t2)*0.3333333333333333, imag(t2)*0.3333333333333333)
+	d[1] = complex(real(t7)*0.3333333333333333, imag(t7)*0.3333333333333333)
+	d[2] = complex(real(t8)*0.3333333333333333, imag(t8)*0.3333333333333333)
+
+	return true
+}
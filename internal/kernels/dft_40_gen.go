@@ -0,0 +1,1529 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT40Complex64 computes a 40-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT40Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 40
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+
+	t0 := x8 + x32
+	t1 := x8 - x32
+	t2 := x16 + x24
+	t3 := x16 - x24
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x13 + x37
+	t18 := x13 - x37
+	t19 := x21 + x29
+	t20 := x21 - x29
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x18 + x2
+	t35 := x18 - x2
+	t36 := x26 + x34
+	t37 := x26 - x34
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x23 + x7
+	t52 := x23 - x7
+	t53 := x31 + x39
+	t54 := x31 - x39
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := x28 + x12
+	t69 := x28 - x12
+	t70 := x36 + x4
+	t71 := x36 - x4
+	t72 := x20 + t68 + t70
+	t73 := real(x20) + 0.3090169943749474*real(t68) - 0.8090169943749473*real(t70)
+	t74 := imag(x20) + 0.3090169943749474*imag(t68) - 0.8090169943749473*imag(t70)
+	t75 := 0.9510565162951536*real(t69) + 0.5877852522924731*real(t71)
+	t76 := 0.9510565162951536*imag(t69) + 0.5877852522924731*imag(t71)
+	t77 := complex(t73-t76, t74+t75)
+	t78 := complex(t73+t76, t74-t75)
+	t79 := real(x20) - 0.8090169943749473*real(t68) + 0.3090169943749474*real(t70)
+	t80 := imag(x20) - 0.8090169943749473*imag(t68) + 0.3090169943749474*imag(t70)
+	t81 := 0.5877852522924731*real(t69) - 0.9510565162951536*real(t71)
+	t82 := 0.5877852522924731*imag(t69) - 0.9510565162951536*imag(t71)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := x33 + x17
+	t86 := x33 - x17
+	t87 := x1 + x9
+	t88 := x1 - x9
+	t89 := x25 + t85 + t87
+	t90 := real(x25) + 0.3090169943749474*real(t85) - 0.8090169943749473*real(t87)
+	t91 := imag(x25) + 0.3090169943749474*imag(t85) - 0.8090169943749473*imag(t87)
+	t92 := 0.9510565162951536*real(t86) + 0.5877852522924731*real(t88)
+	t93 := 0.9510565162951536*imag(t86) + 0.5877852522924731*imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := real(x25) - 0.8090169943749473*real(t85) + 0.3090169943749474*real(t87)
+	t97 := imag(x25) - 0.8090169943749473*imag(t85) + 0.3090169943749474*imag(t87)
+	t98 := 0.5877852522924731*real(t86) - 0.9510565162951536*real(t88)
+	t99 := 0.5877852522924731*imag(t86) - 0.9510565162951536*imag(t88)
+	t100 := complex(t96-t99, t97+t98)
+	t101 := complex(t96+t99, t97-t98)
+	t102 := x38 + x22
+	t103 := x38 - x22
+	t104 := x6 + x14
+	t105 := x6 - x14
+	t106 := x30 + t102 + t104
+	t107 := real(x30) + 0.3090169943749474*real(t102) - 0.8090169943749473*real(t104)
+	t108 := imag(x30) + 0.3090169943749474*imag(t102) - 0.8090169943749473*imag(t104)
+	t109 := 0.9510565162951536*real(t103) + 0.5877852522924731*real(t105)
+	t110 := 0.9510565162951536*imag(t103) + 0.5877852522924731*imag(t105)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := real(x30) - 0.8090169943749473*real(t102) + 0.3090169943749474*real(t104)
+	t114 := imag(x30) - 0.8090169943749473*imag(t102) + 0.3090169943749474*imag(t104)
+	t115 := 0.5877852522924731*real(t103) - 0.9510565162951536*real(t105)
+	t116 := 0.5877852522924731*imag(t103) - 0.9510565162951536*imag(t105)
+	t117 := complex(t113-t116, t114+t115)
+	t118 := complex(t113+t116, t114-t115)
+	t119 := x3 + x27
+	t120 := x3 - x27
+	t121 := x11 + x19
+	t122 := x11 - x19
+	t123 := x35 + t119 + t121
+	t124 := real(x35) + 0.3090169943749474*real(t119) - 0.8090169943749473*real(t121)
+	t125 := imag(x35) + 0.3090169943749474*imag(t119) - 0.8090169943749473*imag(t121)
+	t126 := 0.9510565162951536*real(t120) + 0.5877852522924731*real(t122)
+	t127 := 0.9510565162951536*imag(t120) + 0.5877852522924731*imag(t122)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := real(x35) - 0.8090169943749473*real(t119) + 0.3090169943749474*real(t121)
+	t131 := imag(x35) - 0.8090169943749473*imag(t119) + 0.3090169943749474*imag(t121)
+	t132 := 0.5877852522924731*real(t120) - 0.9510565162951536*real(t122)
+	t133 := 0.5877852522924731*imag(t120) - 0.9510565162951536*imag(t122)
+	t134 := complex(t130-t133, t131+t132)
+	t135 := complex(t130+t133, t131-t132)
+	t136 := t4 + t72
+	t137 := t4 - t72
+	t138 := t21 + t89
+	t139 := t21 - t89
+	t140 := t38 + t106
+	t141 := t38 - t106
+	t142 := t55 + t123
+	t143 := t55 - t123
+	t144 := t136 + t140
+	t145 := t136 - t140
+	t146 := t138 + t142
+	t147 := t138 - t142
+	t148 := complex(imag(t147), -real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := complex((real(t139)+imag(t139))*0.7071067811865476, (imag(t139)-real(t139))*0.7071067811865476)
+	t154 := complex(imag(t141), -real(t141))
+	t155 := complex((-real(t143)+imag(t143))*0.7071067811865476, (-imag(t143)-real(t143))*0.7071067811865476)
+	t156 := t137 + t154
+	t157 := t137 - t154
+	t158 := t153 + t155
+	t159 := t153 - t155
+	t160 := complex(imag(t159), -real(t159))
+	t161 := t156 + t158
+	t162 := t157 + t160
+	t163 := t156 - t158
+	t164 := t157 - t160
+	t165 := t10 + t78
+	t166 := t10 - t78
+	t167 := t27 + t95
+	t168 := t27 - t95
+	t169 := t44 + t112
+	t170 := t44 - t112
+	t171 := t61 + t129
+	t172 := t61 - t129
+	t173 := t165 + t169
+	t174 := t165 - t169
+	t175 := t167 + t171
+	t176 := t167 - t171
+	t177 := complex(imag(t176), -real(t176))
+	t178 := t173 + t175
+	t179 := t174 + t177
+	t180 := t173 - t175
+	t181 := t174 - t177
+	t182 := complex((real(t168)+imag(t168))*0.7071067811865476, (imag(t168)-real(t168))*0.7071067811865476)
+	t183 := complex(imag(t170), -real(t170))
+	t184 := complex((-real(t172)+imag(t172))*0.7071067811865476, (-imag(t172)-real(t172))*0.7071067811865476)
+	t185 := t166 + t183
+	t186 := t166 - t183
+	t187 := t182 + t184
+	t188 := t182 - t184
+	t189 := complex(imag(t188), -real(t188))
+	t190 := t185 + t187
+	t191 := t186 + t189
+	t192 := t185 - t187
+	t193 := t186 - t189
+	t194 := t16 + t84
+	t195 := t16 - t84
+	t196 := t33 + t101
+	t197 := t33 - t101
+	t198 := t50 + t118
+	t199 := t50 - t118
+	t200 := t67 + t135
+	t201 := t67 - t135
+	t202 := t194 + t198
+	t203 := t194 - t198
+	t204 := t196 + t200
+	t205 := t196 - t200
+	t206 := complex(imag(t205), -real(t205))
+	t207 := t202 + t204
+	t208 := t203 + t206
+	t209 := t202 - t204
+	t210 := t203 - t206
+	t211 := complex((real(t197)+imag(t197))*0.7071067811865476, (imag(t197)-real(t197))*0.7071067811865476)
+	t212 := complex(imag(t199), -real(t199))
+	t213 := complex((-real(t201)+imag(t201))*0.7071067811865476, (-imag(t201)-real(t201))*0.7071067811865476)
+	t214 := t195 + t212
+	t215 := t195 - t212
+	t216 := t211 + t213
+	t217 := t211 - t213
+	t218 := complex(imag(t217), -real(t217))
+	t219 := t214 + t216
+	t220 := t215 + t218
+	t221 := t214 - t216
+	t222 := t215 - t218
+	t223 := t15 + t83
+	t224 := t15 - t83
+	t225 := t32 + t100
+	t226 := t32 - t100
+	t227 := t49 + t117
+	t228 := t49 - t117
+	t229 := t66 + t134
+	t230 := t66 - t134
+	t231 := t223 + t227
+	t232 := t223 - t227
+	t233 := t225 + t229
+	t234 := t225 - t229
+	t235 := complex(imag(t234), -real(t234))
+	t236 := t231 + t233
+	t237 := t232 + t235
+	t238 := t231 - t233
+	t239 := t232 - t235
+	t240 := complex((real(t226)+imag(t226))*0.7071067811865476, (imag(t226)-real(t226))*0.7071067811865476)
+	t241 := complex(imag(t228), -real(t228))
+	t242 := complex((-real(t230)+imag(t230))*0.7071067811865476, (-imag(t230)-real(t230))*0.7071067811865476)
+	t243 := t224 + t241
+	t244 := t224 - t241
+	t245 := t240 + t242
+	t246 := t240 - t242
+	t247 := complex(imag(t246), -real(t246))
+	t248 := t243 + t245
+	t249 := t244 + t247
+	t250 := t243 - t245
+	t251 := t244 - t247
+	t252 := t9 + t77
+	t253 := t9 - t77
+	t254 := t26 + t94
+	t255 := t26 - t94
+	t256 := t43 + t111
+	t257 := t43 - t111
+	t258 := t60 + t128
+	t259 := t60 - t128
+	t260 := t252 + t256
+	t261 := t252 - t256
+	t262 := t254 + t258
+	t263 := t254 - t258
+	t264 := complex(imag(t263), -real(t263))
+	t265 := t260 + t262
+	t266 := t261 + t264
+	t267 := t260 - t262
+	t268 := t261 - t264
+	t269 := complex((real(t255)+imag(t255))*0.7071067811865476, (imag(t255)-real(t255))*0.7071067811865476)
+	t270 := complex(imag(t257), -real(t257))
+	t271 := complex((-real(t259)+imag(t259))*0.7071067811865476, (-imag(t259)-real(t259))*0.7071067811865476)
+	t272 := t253 + t270
+	t273 := t253 - t270
+	t274 := t269 + t271
+	t275 := t269 - t271
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+
+	d[0] = t149
+	d[1] = t190
+	d[2] = t208
+	d[3] = t249
+	d[4] = t267
+	d[5] = t163
+	d[6] = t181
+	d[7] = t222
+	d[8] = t236
+	d[9] = t277
+	d[10] = t150
+	d[11] = t191
+	d[12] = t209
+	d[13] = t250
+	d[14] = t268
+	d[15] = t164
+	d[16] = t178
+	d[17] = t219
+	d[18] = t237
+	d[19] = t278
+	d[20] = t151
+	d[21] = t192
+	d[22] = t210
+	d[23] = t251
+	d[24] = t265
+	d[25] = t161
+	d[26] = t179
+	d[27] = t220
+	d[28] = t238
+	d[29] = t279
+	d[30] = t152
+	d[31] = t193
+	d[32] = t207
+	d[33] = t248
+	d[34] = t266
+	d[35] = t162
+	d[36] = t180
+	d[37] = t221
+	d[38] = t239
+	d[39] = t280
+
+	return true
+}
+
+// inverseDFT40Complex64 computes a 40-point inverse DFT of complex64 data,
+// including the 1/40 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT40Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 40
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+
+	t0 := x8 + x32
+	t1 := x8 - x32
+	t2 := x16 + x24
+	t3 := x16 - x24
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x13 + x37
+	t18 := x13 - x37
+	t19 := x21 + x29
+	t20 := x21 - x29
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x18 + x2
+	t35 := x18 - x2
+	t36 := x26 + x34
+	t37 := x26 - x34
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x23 + x7
+	t52 := x23 - x7
+	t53 := x31 + x39
+	t54 := x31 - x39
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := x28 + x12
+	t69 := x28 - x12
+	t70 := x36 + x4
+	t71 := x36 - x4
+	t72 := x20 + t68 + t70
+	t73 := real(x20) + 0.3090169943749474*real(t68) - 0.8090169943749473*real(t70)
+	t74 := imag(x20) + 0.3090169943749474*imag(t68) - 0.8090169943749473*imag(t70)
+	t75 := 0.9510565162951536*real(t69) + 0.5877852522924731*real(t71)
+	t76 := 0.9510565162951536*imag(t69) + 0.5877852522924731*imag(t71)
+	t77 := complex(t73-t76, t74+t75)
+	t78 := complex(t73+t76, t74-t75)
+	t79 := real(x20) - 0.8090169943749473*real(t68) + 0.3090169943749474*real(t70)
+	t80 := imag(x20) - 0.8090169943749473*imag(t68) + 0.3090169943749474*imag(t70)
+	t81 := 0.5877852522924731*real(t69) - 0.9510565162951536*real(t71)
+	t82 := 0.5877852522924731*imag(t69) - 0.9510565162951536*imag(t71)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := x33 + x17
+	t86 := x33 - x17
+	t87 := x1 + x9
+	t88 := x1 - x9
+	t89 := x25 + t85 + t87
+	t90 := real(x25) + 0.3090169943749474*real(t85) - 0.8090169943749473*real(t87)
+	t91 := imag(x25) + 0.3090169943749474*imag(t85) - 0.8090169943749473*imag(t87)
+	t92 := 0.9510565162951536*real(t86) + 0.5877852522924731*real(t88)
+	t93 := 0.9510565162951536*imag(t86) + 0.5877852522924731*imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := real(x25) - 0.8090169943749473*real(t85) + 0.3090169943749474*real(t87)
+	t97 := imag(x25) - 0.8090169943749473*imag(t85) + 0.3090169943749474*imag(t87)
+	t98 := 0.5877852522924731*real(t86) - 0.9510565162951536*real(t88)
+	t99 := 0.5877852522924731*imag(t86) - 0.9510565162951536*imag(t88)
+	t100 := complex(t96-t99, t97+t98)
+	t101 := complex(t96+t99, t97-t98)
+	t102 := x38 + x22
+	t103 := x38 - x22
+	t104 := x6 + x14
+	t105 := x6 - x14
+	t106 := x30 + t102 + t104
+	t107 := real(x30) + 0.3090169943749474*real(t102) - 0.8090169943749473*real(t104)
+	t108 := imag(x30) + 0.3090169943749474*imag(t102) - 0.8090169943749473*imag(t104)
+	t109 := 0.9510565162951536*real(t103) + 0.5877852522924731*real(t105)
+	t110 := 0.9510565162951536*imag(t103) + 0.5877852522924731*imag(t105)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := real(x30) - 0.8090169943749473*real(t102) + 0.3090169943749474*real(t104)
+	t114 := imag(x30) - 0.8090169943749473*imag(t102) + 0.3090169943749474*imag(t104)
+	t115 := 0.5877852522924731*real(t103) - 0.9510565162951536*real(t105)
+	t116 := 0.5877852522924731*imag(t103) - 0.9510565162951536*imag(t105)
+	t117 := complex(t113-t116, t114+t115)
+	t118 := complex(t113+t116, t114-t115)
+	t119 := x3 + x27
+	t120 := x3 - x27
+	t121 := x11 + x19
+	t122 := x11 - x19
+	t123 := x35 + t119 + t121
+	t124 := real(x35) + 0.3090169943749474*real(t119) - 0.8090169943749473*real(t121)
+	t125 := imag(x35) + 0.3090169943749474*imag(t119) - 0.8090169943749473*imag(t121)
+	t126 := 0.9510565162951536*real(t120) + 0.5877852522924731*real(t122)
+	t127 := 0.9510565162951536*imag(t120) + 0.5877852522924731*imag(t122)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := real(x35) - 0.8090169943749473*real(t119) + 0.3090169943749474*real(t121)
+	t131 := imag(x35) - 0.8090169943749473*imag(t119) + 0.3090169943749474*imag(t121)
+	t132 := 0.5877852522924731*real(t120) - 0.9510565162951536*real(t122)
+	t133 := 0.5877852522924731*imag(t120) - 0.9510565162951536*imag(t122)
+	t134 := complex(t130-t133, t131+t132)
+	t135 := complex(t130+t133, t131-t132)
+	t136 := t4 + t72
+	t137 := t4 - t72
+	t138 := t21 + t89
+	t139 := t21 - t89
+	t140 := t38 + t106
+	t141 := t38 - t106
+	t142 := t55 + t123
+	t143 := t55 - t123
+	t144 := t136 + t140
+	t145 := t136 - t140
+	t146 := t138 + t142
+	t147 := t138 - t142
+	t148 := complex(-imag(t147), real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := complex((real(t139)-imag(t139))*0.7071067811865476, (imag(t139)+real(t139))*0.7071067811865476)
+	t154 := complex(-imag(t141), real(t141))
+	t155 := complex((-real(t143)-imag(t143))*0.7071067811865476, (-imag(t143)+real(t143))*0.7071067811865476)
+	t156 := t137 + t154
+	t157 := t137 - t154
+	t158 := t153 + t155
+	t159 := t153 - t155
+	t160 := complex(-imag(t159), real(t159))
+	t161 := t156 + t158
+	t162 := t157 + t160
+	t163 := t156 - t158
+	t164 := t157 - t160
+	t165 := t9 + t77
+	t166 := t9 - t77
+	t167 := t26 + t94
+	t168 := t26 - t94
+	t169 := t43 + t111
+	t170 := t43 - t111
+	t171 := t60 + t128
+	t172 := t60 - t128
+	t173 := t165 + t169
+	t174 := t165 - t169
+	t175 := t167 + t171
+	t176 := t167 - t171
+	t177 := complex(-imag(t176), real(t176))
+	t178 := t173 + t175
+	t179 := t174 + t177
+	t180 := t173 - t175
+	t181 := t174 - t177
+	t182 := complex((real(t168)-imag(t168))*0.7071067811865476, (imag(t168)+real(t168))*0.7071067811865476)
+	t183 := complex(-imag(t170), real(t170))
+	t184 := complex((-real(t172)-imag(t172))*0.7071067811865476, (-imag(t172)+real(t172))*0.7071067811865476)
+	t185 := t166 + t183
+	t186 := t166 - t183
+	t187 := t182 + t184
+	t188 := t182 - t184
+	t189 := complex(-imag(t188), real(t188))
+	t190 := t185 + t187
+	t191 := t186 + t189
+	t192 := t185 - t187
+	t193 := t186 - t189
+	t194 := t15 + t83
+	t195 := t15 - t83
+	t196 := t32 + t100
+	t197 := t32 - t100
+	t198 := t49 + t117
+	t199 := t49 - t117
+	t200 := t66 + t134
+	t201 := t66 - t134
+	t202 := t194 + t198
+	t203 := t194 - t198
+	t204 := t196 + t200
+	t205 := t196 - t200
+	t206 := complex(-imag(t205), real(t205))
+	t207 := t202 + t204
+	t208 := t203 + t206
+	t209 := t202 - t204
+	t210 := t203 - t206
+	t211 := complex((real(t197)-imag(t197))*0.7071067811865476, (imag(t197)+real(t197))*0.7071067811865476)
+	t212 := complex(-imag(t199), real(t199))
+	t213 := complex((-real(t201)-imag(t201))*0.7071067811865476, (-imag(t201)+real(t201))*0.7071067811865476)
+	t214 := t195 + t212
+	t215 := t195 - t212
+	t216 := t211 + t213
+	t217 := t211 - t213
+	t218 := complex(-imag(t217), real(t217))
+	t219 := t214 + t216
+	t220 := t215 + t218
+	t221 := t214 - t216
+	t222 := t215 - t218
+	t223 := t16 + t84
+	t224 := t16 - t84
+	t225 := t33 + t101
+	t226 := t33 - t101
+	t227 := t50 + t118
+	t228 := t50 - t118
+	t229 := t67 + t135
+	t230 := t67 - t135
+	t231 := t223 + t227
+	t232 := t223 - t227
+	t233 := t225 + t229
+	t234 := t225 - t229
+	t235 := complex(-imag(t234), real(t234))
+	t236 := t231 + t233
+	t237 := t232 + t235
+	t238 := t231 - t233
+	t239 := t232 - t235
+	t240 := complex((real(t226)-imag(t226))*0.7071067811865476, (imag(t226)+real(t226))*0.7071067811865476)
+	t241 := complex(-imag(t228), real(t228))
+	t242 := complex((-real(t230)-imag(t230))*0.7071067811865476, (-imag(t230)+real(t230))*0.7071067811865476)
+	t243 := t224 + t241
+	t244 := t224 - t241
+	t245 := t240 + t242
+	t246 := t240 - t242
+	t247 := complex(-imag(t246), real(t246))
+	t248 := t243 + t245
+	t249 := t244 + t247
+	t250 := t243 - t245
+	t251 := t244 - t247
+	t252 := t10 + t78
+	t253 := t10 - t78
+	t254 := t27 + t95
+	t255 := t27 - t95
+	t256 := t44 + t112
+	t257 := t44 - t112
+	t258 := t61 + t129
+	t259 := t61 - t129
+	t260 := t252 + t256
+	t261 := t252 - t256
+	t262 := t254 + t258
+	t263 := t254 - t258
+	t264 := complex(-imag(t263), real(t263))
+	t265 := t260 + t262
+	t266 := t261 + t264
+	t267 := t260 - t262
+	t268 := t261 - t264
+	t269 := complex((real(t255)-imag(t255))*0.7071067811865476, (imag(t255)+real(t255))*0.7071067811865476)
+	t270 := complex(-imag(t257), real(t257))
+	t271 := complex((-real(t259)-imag(t259))*0.7071067811865476, (-imag(t259)+real(t259))*0.7071067811865476)
+	t272 := t253 + t270
+	t273 := t253 - t270
+	t274 := t269 + t271
+	t275 := t269 - t271
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+
+	d[0] = complex(real(t149)*0.025, imag(t149)*0.025)
+	d[1] = complex(real(t190)*0.025, imag(t190)*0.025)
+	d[2] = complex(real(t208)*0.025, imag(t208)*0.025)
+	d[3] = complex(real(t249)*0.025, imag(t249)*0.025)
+	d[4] = complex(real(t267)*0.025, imag(t267)*0.025)
+	d[5] = complex(real(t163)*0.025, imag(t163)*0.025)
+	d[6] = complex(real(t181)*0.025, imag(t181)*0.025)
+	d[7] = complex(real(t222)*0.025, imag(t222)*0.025)
+	d[8] = complex(real(t236)*0.025, imag(t236)*0.025)
+	d[9] = complex(real(t277)*0.025, imag(t277)*0.025)
+	d[10] = complex(real(t150)*0.025, imag(t150)*0.025)
+	d[11] = complex(real(t191)*0.025, imag(t191)*0.025)
+	d[12] = complex(real(t209)*0.025, imag(t209)*0.025)
+	d[13] = complex(real(t250)*0.025, imag(t250)*0.025)
+	d[14] = complex(real(t268)*0.025, imag(t268)*0.025)
+	d[15] = complex(real(t164)*0.025, imag(t164)*0.025)
+	d[16] = complex(real(t178)*0.025, imag(t178)*0.025)
+	d[17] = complex(real(t219)*0.025, imag(t219)*0.025)
+	d[18] = complex(real(t237)*0.025, imag(t237)*0.025)
+	d[19] = complex(real(t278)*0.025, imag(t278)*0.025)
+	d[20] = complex(real(t151)*0.025, imag(t151)*0.025)
+	d[21] = complex(real(t192)*0.025, imag(t192)*0.025)
+	d[22] = complex(real(t210)*0.025, imag(t210)*0.025)
+	d[23] = complex(real(t251)*0.025, imag(t251)*0.025)
+	d[24] = complex(real(t265)*0.025, imag(t265)*0.025)
+	d[25] = complex(real(t161)*0.025, imag(t161)*0.025)
+	d[26] = complex(real(t179)*0.025, imag(t179)*0.025)
+	d[27] = complex(real(t220)*0.025, imag(t220)*0.025)
+	d[28] = complex(real(t238)*0.025, imag(t238)*0.025)
+	d[29] = complex(real(t279)*0.025, imag(t279)*0.025)
+	d[30] = complex(real(t152)*0.025, imag(t152)*0.025)
+	d[31] = complex(real(t193)*0.025, imag(t193)*0.025)
+	d[32] = complex(real(t207)*0.025, imag(t207)*0.025)
+	d[33] = complex(real(t248)*0.025, imag(t248)*0.025)
+	d[34] = complex(real(t266)*0.025, imag(t266)*0.025)
+	d[35] = complex(real(t162)*0.025, imag(t162)*0.025)
+	d[36] = complex(real(t180)*0.025, imag(t180)*0.025)
+	d[37] = complex(real(t221)*0.025, imag(t221)*0.025)
+	d[38] = complex(real(t239)*0.025, imag(t239)*0.025)
+	d[39] = complex(real(t280)*0.025, imag(t280)*0.025)
+
+	return true
+}
+
+// forwardDFT40Complex128 computes a 40-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT40Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 40
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+
+	t0 := x8 + x32
+	t1 := x8 - x32
+	t2 := x16 + x24
+	t3 := x16 - x24
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x13 + x37
+	t18 := x13 - x37
+	t19 := x21 + x29
+	t20 := x21 - x29
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x18 + x2
+	t35 := x18 - x2
+	t36 := x26 + x34
+	t37 := x26 - x34
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x23 + x7
+	t52 := x23 - x7
+	t53 := x31 + x39
+	t54 := x31 - x39
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := x28 + x12
+	t69 := x28 - x12
+	t70 := x36 + x4
+	t71 := x36 - x4
+	t72 := x20 + t68 + t70
+	t73 := real(x20) + 0.3090169943749474*real(t68) - 0.8090169943749473*real(t70)
+	t74 := imag(x20) + 0.3090169943749474*imag(t68) - 0.8090169943749473*imag(t70)
+	t75 := 0.9510565162951536*real(t69) + 0.5877852522924731*real(t71)
+	t76 := 0.9510565162951536*imag(t69) + 0.5877852522924731*imag(t71)
+	t77 := complex(t73-t76, t74+t75)
+	t78 := complex(t73+t76, t74-t75)
+	t79 := real(x20) - 0.8090169943749473*real(t68) + 0.3090169943749474*real(t70)
+	t80 := imag(x20) - 0.8090169943749473*imag(t68) + 0.3090169943749474*imag(t70)
+	t81 := 0.5877852522924731*real(t69) - 0.9510565162951536*real(t71)
+	t82 := 0.5877852522924731*imag(t69) - 0.9510565162951536*imag(t71)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := x33 + x17
+	t86 := x33 - x17
+	t87 := x1 + x9
+	t88 := x1 - x9
+	t89 := x25 + t85 + t87
+	t90 := real(x25) + 0.3090169943749474*real(t85) - 0.8090169943749473*real(t87)
+	t91 := imag(x25) + 0.3090169943749474*imag(t85) - 0.8090169943749473*imag(t87)
+	t92 := 0.9510565162951536*real(t86) + 0.5877852522924731*real(t88)
+	t93 := 0.9510565162951536*imag(t86) + 0.5877852522924731*imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := real(x25) - 0.8090169943749473*real(t85) + 0.3090169943749474*real(t87)
+	t97 := imag(x25) - 0.8090169943749473*imag(t85) + 0.3090169943749474*imag(t87)
+	t98 := 0.5877852522924731*real(t86) - 0.9510565162951536*real(t88)
+	t99 := 0.5877852522924731*imag(t86) - 0.9510565162951536*imag(t88)
+	t100 := complex(t96-t99, t97+t98)
+	t101 := complex(t96+t99, t97-t98)
+	t102 := x38 + x22
+	t103 := x38 - x22
+	t104 := x6 + x14
+	t105 := x6 - x14
+	t106 := x30 + t102 + t104
+	t107 := real(x30) + 0.3090169943749474*real(t102) - 0.8090169943749473*real(t104)
+	t108 := imag(x30) + 0.3090169943749474*imag(t102) - 0.8090169943749473*imag(t104)
+	t109 := 0.9510565162951536*real(t103) + 0.5877852522924731*real(t105)
+	t110 := 0.9510565162951536*imag(t103) + 0.5877852522924731*imag(t105)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := real(x30) - 0.8090169943749473*real(t102) + 0.3090169943749474*real(t104)
+	t114 := imag(x30) - 0.8090169943749473*imag(t102) + 0.3090169943749474*imag(t104)
+	t115 := 0.5877852522924731*real(t103) - 0.9510565162951536*real(t105)
+	t116 := 0.5877852522924731*imag(t103) - 0.9510565162951536*imag(t105)
+	t117 := complex(t113-t116, t114+t115)
+	t118 := complex(t113+t116, t114-t115)
+	t119 := x3 + x27
+	t120 := x3 - x27
+	t121 := x11 + x19
+	t122 := x11 - x19
+	t123 := x35 + t119 + t121
+	t124 := real(x35) + 0.3090169943749474*real(t119) - 0.8090169943749473*real(t121)
+	t125 := imag(x35) + 0.3090169943749474*imag(t119) - 0.8090169943749473*imag(t121)
+	t126 := 0.9510565162951536*real(t120) + 0.5877852522924731*real(t122)
+	t127 := 0.9510565162951536*imag(t120) + 0.5877852522924731*imag(t122)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := real(x35) - 0.8090169943749473*real(t119) + 0.3090169943749474*real(t121)
+	t131 := imag(x35) - 0.8090169943749473*imag(t119) + 0.3090169943749474*imag(t121)
+	t132 := 0.5877852522924731*real(t120) - 0.9510565162951536*real(t122)
+	t133 := 0.5877852522924731*imag(t120) - 0.9510565162951536*imag(t122)
+	t134 := complex(t130-t133, t131+t132)
+	t135 := complex(t130+t133, t131-t132)
+	t136 := t4 + t72
+	t137 := t4 - t72
+	t138 := t21 + t89
+	t139 := t21 - t89
+	t140 := t38 + t106
+	t141 := t38 - t106
+	t142 := t55 + t123
+	t143 := t55 - t123
+	t144 := t136 + t140
+	t145 := t136 - t140
+	t146 := t138 + t142
+	t147 := t138 - t142
+	t148 := complex(imag(t147), -real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := complex((real(t139)+imag(t139))*0.7071067811865476, (imag(t139)-real(t139))*0.7071067811865476)
+	t154 := complex(imag(t141), -real(t141))
+	t155 := complex((-real(t143)+imag(t143))*0.7071067811865476, (-imag(t143)-real(t143))*0.7071067811865476)
+	t156 := t137 + t154
+	t157 := t137 - t154
+	t158 := t153 + t155
+	t159 := t153 - t155
+	t160 := complex(imag(t159), -real(t159))
+	t161 := t156 + t158
+	t162 := t157 + t160
+	t163 := t156 - t158
+	t164 := t157 - t160
+	t165 := t10 + t78
+	t166 := t10 - t78
+	t167 := t27 + t95
+	t168 := t27 - t95
+	t169 := t44 + t112
+	t170 := t44 - t112
+	t171 := t61 + t129
+	t172 := t61 - t129
+	t173 := t165 + t169
+	t174 := t165 - t169
+	t175 := t167 + t171
+	t176 := t167 - t171
+	t177 := complex(imag(t176), -real(t176))
+	t178 := t173 + t175
+	t179 := t174 + t177
+	t180 := t173 - t175
+	t181 := t174 - t177
+	t182 := complex((real(t168)+imag(t168))*0.7071067811865476, (imag(t168)-real(t168))*0.7071067811865476)
+	t183 := complex(imag(t170), -real(t170))
+	t184 := complex((-real(t172)+imag(t172))*0.7071067811865476, (-imag(t172)-real(t172))*0.7071067811865476)
+	t185 := t166 + t183
+	t186 := t166 - t183
+	t187 := t182 + t184
+	t188 := t182 - t184
+	t189 := complex(imag(t188), -real(t188))
+	t190 := t185 + t187
+	t191 := t186 + t189
+	t192 := t185 - t187
+	t193 := t186 - t189
+	t194 := t16 + t84
+	t195 := t16 - t84
+	t196 := t33 + t101
+	t197 := t33 - t101
+	t198 := t50 + t118
+	t199 := t50 - t118
+	t200 := t67 + t135
+	t201 := t67 - t135
+	t202 := t194 + t198
+	t203 := t194 - t198
+	t204 := t196 + t200
+	t205 := t196 - t200
+	t206 := complex(imag(t205), -real(t205))
+	t207 := t202 + t204
+	t208 := t203 + t206
+	t209 := t202 - t204
+	t210 := t203 - t206
+	t211 := complex((real(t197)+imag(t197))*0.7071067811865476, (imag(t197)-real(t197))*0.7071067811865476)
+	t212 := complex(imag(t199), -real(t199))
+	t213 := complex((-real(t201)+imag(t201))*0.7071067811865476, (-imag(t201)-real(t201))*0.7071067811865476)
+	t214 := t195 + t212
+	t215 := t195 - t212
+	t216 := t211 + t213
+	t217 := t211 - t213
+	t218 := complex(imag(t217), -real(t217))
+	t219 := t214 + t216
+	t220 := t215 + t218
+	t221 := t214 - t216
+	t222 := t215 - t218
+	t223 := t15 + t83
+	t224 := t15 - t83
+	t225 := t32 + t100
+	t226 := t32 - t100
+	t227 := t49 + t117
+	t228 := t49 - t117
+	t229 := t66 + t134
+	t230 := t66 - t134
+	t231 := t223 + t227
+	t232 := t223 - t227
+	t233 := t225 + t229
+	t234 := t225 - t229
+	t235 := complex(imag(t234), -real(t234))
+	t236 := t231 + t233
+	t237 := t232 + t235
+	t238 := t231 - t233
+	t239 := t232 - t235
+	t240 := complex((real(t226)+imag(t226))*0.7071067811865476, (imag(t226)-real(t226))*0.7071067811865476)
+	t241 := complex(imag(t228), -real(t228))
+	t242 := complex((-real(t230)+imag(t230))*0.7071067811865476, (-imag(t230)-real(t230))*0.7071067811865476)
+	t243 := t224 + t241
+	t244 := t224 - t241
+	t245 := t240 + t242
+	t246 := t240 - t242
+	t247 := complex(imag(t246), -real(t246))
+	t248 := t243 + t245
+	t249 := t244 + t247
+	t250 := t243 - t245
+	t251 := t244 - t247
+	t252 := t9 + t77
+	t253 := t9 - t77
+	t254 := t26 + t94
+	t255 := t26 - t94
+	t256 := t43 + t111
+	t257 := t43 - t111
+	t258 := t60 + t128
+	t259 := t60 - t128
+	t260 := t252 + t256
+	t261 := t252 - t256
+	t262 := t254 + t258
+	t263 := t254 - t258
+	t264 := complex(imag(t263), -real(t263))
+	t265 := t260 + t262
+	t266 := t261 + t264
+	t267 := t260 - t262
+	t268 := t261 - t264
+	t269 := complex((real(t255)+imag(t255))*0.7071067811865476, (imag(t255)-real(t255))*0.7071067811865476)
+	t270 := complex(imag(t257), -real(t257))
+	t271 := complex((-real(t259)+imag(t259))*0.7071067811865476, (-imag(t259)-real(t259))*0.7071067811865476)
+	t272 := t253 + t270
+	t273 := t253 - t270
+	t274 := t269 + t271
+	t275 := t269 - t271
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+
+	d[0] = t149
+	d[1] = t190
+	d[2] = t208
+	d[3] = t249
+	d[4] = t267
+	d[5] = t163
+	d[6] = t181
+	d[7] = t222
+	d[8] = t236
+	d[9] = t277
+	d[10] = t150
+	d[11] = t191
+	d[12] = t209
+	d[13] = t250
+	d[14] = t268
+	d[15] = t164
+	d[16] = t178
+	d[17] = t219
+	d[18] = t237
+	d[19] = t278
+	d[20] = t151
+	d[21] = t192
+	d[22] = t210
+	d[23] = t251
+	d[24] = t265
+	d[25] = t161
+	d[26] = t179
+	d[27] = t220
+	d[28] = t238
+	d[29] = t279
+	d[30] = t152
+	d[31] = t193
+	d[32] = t207
+	d[33] = t248
+	d[34] = t266
+	d[35] = t162
+	d[36] = t180
+	d[37] = t221
+	d[38] = t239
+	d[39] = t280
+
+	return true
+}
+
+// inverseDFT40Complex128 computes a 40-point inverse DFT of complex128 data,
+// including the 1/40 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT40Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 40
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+
+	t0 := x8 + x32
+	t1 := x8 - x32
+	t2 := x16 + x24
+	t3 := x16 - x24
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x13 + x37
+	t18 := x13 - x37
+	t19 := x21 + x29
+	t20 := x21 - x29
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x18 + x2
+	t35 := x18 - x2
+	t36 := x26 + x34
+	t37 := x26 - x34
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x23 + x7
+	t52 := x23 - x7
+	t53 := x31 + x39
+	t54 := x31 - x39
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := x28 + x12
+	t69 := x28 - x12
+	t70 := x36 + x4
+	t71 := x36 - x4
+	t72 := x20 + t68 + t70
+	t73 := real(x20) + 0.3090169943749474*real(t68) - 0.8090169943749473*real(t70)
+	t74 := imag(x20) + 0.3090169943749474*imag(t68) - 0.8090169943749473*imag(t70)
+	t75 := 0.9510565162951536*real(t69) + 0.5877852522924731*real(t71)
+	t76 := 0.9510565162951536*imag(t69) + 0.5877852522924731*imag(t71)
+	t77 := complex(t73-t76, t74+t75)
+	t78 := complex(t73+t76, t74-t75)
+	t79 := real(x20) - 0.8090169943749473*real(t68) + 0.3090169943749474*real(t70)
+	t80 := imag(x20) - 0.8090169943749473*imag(t68) + 0.3090169943749474*imag(t70)
+	t81 := 0.5877852522924731*real(t69) - 0.9510565162951536*real(t71)
+	t82 := 0.5877852522924731*imag(t69) - 0.9510565162951536*imag(t71)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := x33 + x17
+	t86 := x33 - x17
+	t87 := x1 + x9
+	t88 := x1 - x9
+	t89 := x25 + t85 + t87
+	t90 := real(x25) + 0.3090169943749474*real(t85) - 0.8090169943749473*real(t87)
+	t91 := imag(x25) + 0.3090169943749474*imag(t85) - 0.8090169943749473*imag(t87)
+	t92 := 0.9510565162951536*real(t86) + 0.5877852522924731*real(t88)
+	t93 := 0.9510565162951536*imag(t86) + 0.5877852522924731*imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := real(x25) - 0.8090169943749473*real(t85) + 0.3090169943749474*real(t87)
+	t97 := imag(x25) - 0.8090169943749473*imag(t85) + 0.3090169943749474*imag(t87)
+	t98 := 0.5877852522924731*real(t86) - 0.9510565162951536*real(t88)
+	t99 := 0.5877852522924731*imag(t86) - 0.9510565162951536*imag(t88)
+	t100 := complex(t96-t99, t97+t98)
+	t101 := complex(t96+t99, t97-t98)
+	t102 := x38 + x22
+	t103 := x38 - x22
+	t104 := x6 + x14
+	t105 := x6 - x14
+	t106 := x30 + t102 + t104
+	t107 := real(x30) + 0.3090169943749474*real(t102) - 0.8090169943749473*real(t104)
+	t108 := imag(x30) + 0.3090169943749474*imag(t102) - 0.8090169943749473*imag(t104)
+	t109 := 0.9510565162951536*real(t103) + 0.5877852522924731*real(t105)
+	t110 := 0.9510565162951536*imag(t103) + 0.5877852522924731*imag(t105)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := real(x30) - 0.8090169943749473*real(t102) + 0.3090169943749474*real(t104)
+	t114 := imag(x30) - 0.8090169943749473*imag(t102) + 0.3090169943749474*imag(t104)
+	t115 := 0.5877852522924731*real(t103) - 0.9510565162951536*real(t105)
+	t116 := 0.5877852522924731*imag(t103) - 0.9510565162951536*imag(t105)
+	t117 := complex(t113-t116, t114+t115)
+	t118 := complex(t113+t116, t114-t115)
+	t119 := x3 + x27
+	t120 := x3 - x27
+	t121 := x11 + x19
+	t122 := x11 - x19
+	t123 := x35 + t119 + t121
+	t124 := real(x35) + 0.3090169943749474*real(t119) - 0.8090169943749473*real(t121)
+	t125 := imag(x35) + 0.3090169943749474*imag(t119) - 0.8090169943749473*imag(t121)
+	t126 := 0.9510565162951536*real(t120) + 0.5877852522924731*real(t122)
+	t127 := 0.9510565162951536*imag(t120) + 0.5877852522924731*imag(t122)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := real(x35) - 0.8090169943749473*real(t119) + 0.3090169943749474*real(t121)
+	t131 := imag(x35) - 0.8090169943749473*imag(t119) + 0.3090169943749474*imag(t121)
+	t132 := 0.5877852522924731*real(t120) - 0.9510565162951536*real(t122)
+	t133 := 0.5877852522924731*imag(t120) - 0.9510565162951536*imag(t122)
+	t134 := complex(t130-t133, t131+t132)
+	t135 := complex(t130+t133, t131-t132)
+	t136 := t4 + t72
+	t137 := t4 - t72
+	t138 := t21 + t89
+	t139 := t21 - t89
+	t140 := t38 + t106
+	t141 := t38 - t106
+	t142 := t55 + t123
+	t143 := t55 - t123
+	t144 := t136 + t140
+	t145 := t136 - t140
+	t146 := t138 + t142
+	t147 := t138 - t142
+	t148 := complex(-imag(t147), real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := complex((real(t139)-imag(t139))*0.7071067811865476, (imag(t139)+real(t139))*0.7071067811865476)
+	t154 := complex(-imag(t141), real(t141))
+	t155 := complex((-real(t143)-imag(t143))*0.7071067811865476, (-imag(t143)+real(t143))*0.7071067811865476)
+	t156 := t137 + t154
+	t157 := t137 - t154
+	t158 := t153 + t155
+	t159 := t153 - t155
+	t160 := complex(-imag(t159), real(t159))
+	t161 := t156 + t158
+	t162 := t157 + t160
+	t163 := t156 - t158
+	t164 := t157 - t160
+	t165 := t9 + t77
+	t166 := t9 - t77
+	t167 := t26 + t94
+	t168 := t26 - t94
+	t169 := t43 + t111
+	t170 := t43 - t111
+	t171 := t60 + t128
+	t172 := t60 - t128
+	t173 := t165 + t169
+	t174 := t165 - t169
+	t175 := t167 + t171
+	t176 := t167 - t171
+	t177 := complex(-imag(t176), real(t176))
+	t178 := t173 + t175
+	t179 := t174 + t177
+	t180 := t173 - t175
+	t181 := t174 - t177
+	t182 := complex((real(t168)-imag(t168))*0.7071067811865476, (imag(t168)+real(t168))*0.7071067811865476)
+	t183 := complex(-imag(t170), real(t170))
+	t184 := complex((-real(t172)-imag(t172))*0.7071067811865476, (-imag(t172)+real(t172))*0.7071067811865476)
+	t185 := t166 + t183
+	t186 := t166 - t183
+	t187 := t182 + t184
+	t188 := t182 - t184
+	t189 := complex(-imag(t188), real(t188))
+	t190 := t185 + t187
+	t191 := t186 + t189
+	t192 := t185 - t187
+	t193 := t186 - t189
+	t194 := t15 + t83
+	t195 := t15 - t83
+	t196 := t32 + t100
+	t197 := t32 - t100
+	t198 := t49 + t117
+	t199 := t49 - t117
+	t200 := t66 + t134
+	t201 := t66 - t134
+	t202 := t194 + t198
+	t203 := t194 - t198
+	t204 := t196 + t200
+	t205 := t196 - t200
+	t206 := complex(-imag(t205), real(t205))
+	t207 := t202 + t204
+	t208 := t203 + t206
+	t209 := t202 - t204
+	t210 := t203 - t206
+	t211 := complex((real(t197)-imag(t197))*0.7071067811865476, (imag(t197)+real(t197))*0.7071067811865476)
+	t212 := complex(-imag(t199), real(t199))
+	t213 := complex((-real(t201)-imag(t201))*0.7071067811865476, (-imag(t201)+real(t201))*0.7071067811865476)
+	t214 := t195 + t212
+	t215 := t195 - t212
+	t216 := t211 + t213
+	t217 := t211 - t213
+	t218 := complex(-imag(t217), real(t217))
+	t219 := t214 + t216
+	t220 := t215 + t218
+	t221 := t214 - t216
+	t222 := t215 - t218
+	t223 := t16 + t84
+	t224 := t16 - t84
+	t225 := t33 + t101
+	t226 := t33 - t101
+	t227 := t50 + t118
+	t228 := t50 - t118
+	t229 := t67 + t135
+	t230 := t67 - t135
+	t231 := t223 + t227
+	t232 := t223 - t227
+	t233 := t225 + t229
+	t234 := t225 - t229
+	t235 := complex(-imag(t234), real(t234))
+	t236 := t231 + t233
+	t237 := t232 + t235
+	t238 := t231 - t233
+	t239 := t232 - t235
+	t240 := complex((real(t226)-imag(t226))*0.7071067811865476, (imag(t226)+real(t226))*0.7071067811865476)
+	t241 := complex(-imag(t228), real(t228))
+	t242 := complex((-real(t230)-imag(t230))*0.7071067811865476, (-imag(t230)+real(t230))*0.7071067811865476)
+	t243 := t224 + t241
+	t244 := t224 - t241
+	t245 := t240 + t242
+	t246 := t240 - t242
+	t247 := complex(-imag(t246), real(t246))
+	t248 := t243 + t245
+	t249 := t244 + t247
+	t250 := t243 - t245
+	t251 := t244 - t247
+	t252 := t10 + t78
+	t253 := t10 - t78
+	t254 := t27 + t95
+	t255 := t27 - t95
+	t256 := t44 + t112
+	t257 := t44 - t112
+	t258 := t61 + t129
+	t259 := t61 - t129
+	t260 := t252 + t256
+	t261 := t252 - t256
+	t262 := t254 + t258
+	t263 := t254 - t258
+	t264 := complex(-imag(t263), real(t263))
+	t265 := t260 + t262
+	t266 := t261 + t264
+	t267 := t260 - t262
+	t268 := t261 - t264
+	t269 := complex((real(t255)-imag(t255))*0.7071067811865476, (imag(t255)+real(t255))*0.7071067811865476)
+	t270 := complex(-imag(t257), real(t257))
+	t271 := complex((-real(t259)-imag(t259))*0.7071067811865476, (-imag(t259)+real(t259))*0.7071067811865476)
+	t272 := t253 + t270
+	t273 := t253 - t270
+	t274 := t269 + t271
+	t275 := t269 - t271
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+
+	d[0] = complex(real(t149)*0.025, imag(t149)*0.025)
+	d[1] = complex(real(t190)*0.025, imag(t190)*0.025)
+	d[2] = complex(real(t208)*0.025, imag(t208)*0.025)
+	d[3] = complex(real(t249)*0.025, imag(t249)*0.025)
+	d[4] = complex(real(t267)*0.025, imag(t267)*0.025)
+	d[5] = complex(real(t163)*0.025, imag(t163)*0.025)
+	d[6] = complex(real(t181)*0.025, imag(t181)*0.025)
+	d[7] = complex(real(t222)*0.025, imag(t222)*0.025)
+	d[8] = complex(real(t236)*0.025, imag(t236)*0.025)
+	d[9] = complex(real(t277)*0.025, imag(t277)*0.025)
+	d[10] = complex(real(t150)*0.025, imag(t150)*0.025)
+	d[11] = complex(real(t191)*0.025, imag(t191)*0.025)
+	d[12] = complex(real(t209)*0.025, imag(t209)*0.025)
+	d[13] = complex(real(t250)*0.025, imag(t250)*0.025)
+	d[14] = complex(real(t268)*0.025, imag(t268)*0.025)
+	d[15] = complex(real(t164)*0.025, imag(t164)*0.025)
+	d[16] = complex(real(t178)*0.025, imag(t178)*0.025)
+	d[17] = complex(real(t219)*0.025, imag(t219)*0.025)
+	d[18] = complex(real(t237)*0.025, imag(t237)*0.025)
+	d[19] = complex(real(t278)*0.025, imag(t278)*0.025)
+	d[20] = complex(real(t151)*0.025, imag(t151)*0.025)
+	d[21] = complex(real(t192)*0.025, imag(t192)*0.025)
+	d[22] = complex(real(t210)*0.025, imag(t210)*0.025)
+	d[23] = complex(real(t251)*0.025, imag(t251)*0.025)
+	d[24] = complex(real(t265)*0.025, imag(t265)*0.025)
+	d[25] = complex(real(t161)*0.025, imag(t161)*0.025)
+	d[26] = complex(real(t179)*0.025, imag(t179)*0.025)
+	d[27] = complex(real(t220)*0.025, imag(t220)*0.025)
+	d[28] = complex(real(t238)*0.025, imag(t238)*0.025)
+	d[29] = complex(real(t279)*0.025, imag(t279)*0.025)
+	d[30] = complex(real(t152)*0.025, imag(t152)*0.025)
+	d[31] = complex(real(t193)*0.025, imag(t193)*0.025)
+	d[32] = complex(real(t207)*0.025, imag(t207)*0.025)
+	d[33] = complex(real(t248)*0.025, imag(t248)*0.025)
+	d[34] = complex(real(t266)*0.025, imag(t266)*0.025)
+	d[35] = complex(real(t162)*0.025, imag(t162)*0.025)
+	d[36] = complex(real(t180)*0.025, imag(t180)*0.025)
+	d[37] = complex(real(t221)*0.025, imag(t221)*0.025)
+	d[38] = complex(real(t239)*0.025, imag(t239)*0.025)
+	d[39] = complex(real(t280)*0.025, imag(t280)*0.025)
+
+	return true
+}
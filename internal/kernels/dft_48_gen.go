@@ -0,0 +1,2017 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT48Complex64 computes a 48-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT48Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 48
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x19 + x35
+	t10 := x19 - x35
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x22 + x38
+	t19 := x22 - x38
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x25 + x41
+	t28 := x25 - x41
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x28 + x44
+	t37 := x28 - x44
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x31 + x47
+	t46 := x31 - x47
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x34 + x2
+	t55 := x34 - x2
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x37 + x5
+	t64 := x37 - x5
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := x40 + x8
+	t73 := x40 - x8
+	t74 := x24 + t72
+	t75 := real(x24) - 0.49999999999999994*real(t72)
+	t76 := imag(x24) - 0.49999999999999994*imag(t72)
+	t77 := 0.8660254037844387 * real(t73)
+	t78 := 0.8660254037844387 * imag(t73)
+	t79 := complex(t75-t78, t76+t77)
+	t80 := complex(t75+t78, t76-t77)
+	t81 := x43 + x11
+	t82 := x43 - x11
+	t83 := x27 + t81
+	t84 := real(x27) - 0.49999999999999994*real(t81)
+	t85 := imag(x27) - 0.49999999999999994*imag(t81)
+	t86 := 0.8660254037844387 * real(t82)
+	t87 := 0.8660254037844387 * imag(t82)
+	t88 := complex(t84-t87, t85+t86)
+	t89 := complex(t84+t87, t85-t86)
+	t90 := x46 + x14
+	t91 := x46 - x14
+	t92 := x30 + t90
+	t93 := real(x30) - 0.49999999999999994*real(t90)
+	t94 := imag(x30) - 0.49999999999999994*imag(t90)
+	t95 := 0.8660254037844387 * real(t91)
+	t96 := 0.8660254037844387 * imag(t91)
+	t97 := complex(t93-t96, t94+t95)
+	t98 := complex(t93+t96, t94-t95)
+	t99 := x1 + x17
+	t100 := x1 - x17
+	t101 := x33 + t99
+	t102 := real(x33) - 0.49999999999999994*real(t99)
+	t103 := imag(x33) - 0.49999999999999994*imag(t99)
+	t104 := 0.8660254037844387 * real(t100)
+	t105 := 0.8660254037844387 * imag(t100)
+	t106 := complex(t102-t105, t103+t104)
+	t107 := complex(t102+t105, t103-t104)
+	t108 := x4 + x20
+	t109 := x4 - x20
+	t110 := x36 + t108
+	t111 := real(x36) - 0.49999999999999994*real(t108)
+	t112 := imag(x36) - 0.49999999999999994*imag(t108)
+	t113 := 0.8660254037844387 * real(t109)
+	t114 := 0.8660254037844387 * imag(t109)
+	t115 := complex(t111-t114, t112+t113)
+	t116 := complex(t111+t114, t112-t113)
+	t117 := x7 + x23
+	t118 := x7 - x23
+	t119 := x39 + t117
+	t120 := real(x39) - 0.49999999999999994*real(t117)
+	t121 := imag(x39) - 0.49999999999999994*imag(t117)
+	t122 := 0.8660254037844387 * real(t118)
+	t123 := 0.8660254037844387 * imag(t118)
+	t124 := complex(t120-t123, t121+t122)
+	t125 := complex(t120+t123, t121-t122)
+	t126 := x10 + x26
+	t127 := x10 - x26
+	t128 := x42 + t126
+	t129 := real(x42) - 0.49999999999999994*real(t126)
+	t130 := imag(x42) - 0.49999999999999994*imag(t126)
+	t131 := 0.8660254037844387 * real(t127)
+	t132 := 0.8660254037844387 * imag(t127)
+	t133 := complex(t129-t132, t130+t131)
+	t134 := complex(t129+t132, t130-t131)
+	t135 := x13 + x29
+	t136 := x13 - x29
+	t137 := x45 + t135
+	t138 := real(x45) - 0.49999999999999994*real(t135)
+	t139 := imag(x45) - 0.49999999999999994*imag(t135)
+	t140 := 0.8660254037844387 * real(t136)
+	t141 := 0.8660254037844387 * imag(t136)
+	t142 := complex(t138-t141, t139+t140)
+	t143 := complex(t138+t141, t139-t140)
+	t144 := t2 + t74
+	t145 := t2 - t74
+	t146 := t38 + t110
+	t147 := t38 - t110
+	t148 := complex(imag(t147), -real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := t11 + t83
+	t154 := t11 - t83
+	t155 := t47 + t119
+	t156 := t47 - t119
+	t157 := complex(imag(t156), -real(t156))
+	t158 := t153 + t155
+	t159 := t154 + t157
+	t160 := t153 - t155
+	t161 := t154 - t157
+	t162 := t20 + t92
+	t163 := t20 - t92
+	t164 := t56 + t128
+	t165 := t56 - t128
+	t166 := complex(imag(t165), -real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := t29 + t101
+	t172 := t29 - t101
+	t173 := t65 + t137
+	t174 := t65 - t137
+	t175 := complex(imag(t174), -real(t174))
+	t176 := t171 + t173
+	t177 := t172 + t175
+	t178 := t171 - t173
+	t179 := t172 - t175
+	t180 := t149 + t167
+	t181 := t149 - t167
+	t182 := t158 + t176
+	t183 := t158 - t176
+	t184 := complex(imag(t183), -real(t183))
+	t185 := t180 + t182
+	t186 := t181 + t184
+	t187 := t180 - t182
+	t188 := t181 - t184
+	t189 := t159 * complex(0.9238795325112867, -0.3826834323650898)
+	t190 := complex((real(t168)+imag(t168))*0.7071067811865476, (imag(t168)-real(t168))*0.7071067811865476)
+	t191 := t177 * complex(0.3826834323650898, -0.9238795325112867)
+	t192 := t150 + t190
+	t193 := t150 - t190
+	t194 := t189 + t191
+	t195 := t189 - t191
+	t196 := complex(imag(t195), -real(t195))
+	t197 := t192 + t194
+	t198 := t193 + t196
+	t199 := t192 - t194
+	t200 := t193 - t196
+	t201 := complex((real(t160)+imag(t160))*0.7071067811865476, (imag(t160)-real(t160))*0.7071067811865476)
+	t202 := complex(imag(t169), -real(t169))
+	t203 := complex((-real(t178)+imag(t178))*0.7071067811865476, (-imag(t178)-real(t178))*0.7071067811865476)
+	t204 := t151 + t202
+	t205 := t151 - t202
+	t206 := t201 + t203
+	t207 := t201 - t203
+	t208 := complex(imag(t207), -real(t207))
+	t209 := t204 + t206
+	t210 := t205 + t208
+	t211 := t204 - t206
+	t212 := t205 - t208
+	t213 := t161 * complex(0.3826834323650898, -0.9238795325112867)
+	t214 := complex((-real(t170)+imag(t170))*0.7071067811865476, (-imag(t170)-real(t170))*0.7071067811865476)
+	t215 := t179 * complex(-0.9238795325112867, 0.3826834323650898)
+	t216 := t152 + t214
+	t217 := t152 - t214
+	t218 := t213 + t215
+	t219 := t213 - t215
+	t220 := complex(imag(t219), -real(t219))
+	t221 := t216 + t218
+	t222 := t217 + t220
+	t223 := t216 - t218
+	t224 := t217 - t220
+	t225 := t8 + t80
+	t226 := t8 - t80
+	t227 := t44 + t116
+	t228 := t44 - t116
+	t229 := complex(imag(t228), -real(t228))
+	t230 := t225 + t227
+	t231 := t226 + t229
+	t232 := t225 - t227
+	t233 := t226 - t229
+	t234 := t17 + t89
+	t235 := t17 - t89
+	t236 := t53 + t125
+	t237 := t53 - t125
+	t238 := complex(imag(t237), -real(t237))
+	t239 := t234 + t236
+	t240 := t235 + t238
+	t241 := t234 - t236
+	t242 := t235 - t238
+	t243 := t26 + t98
+	t244 := t26 - t98
+	t245 := t62 + t134
+	t246 := t62 - t134
+	t247 := complex(imag(t246), -real(t246))
+	t248 := t243 + t245
+	t249 := t244 + t247
+	t250 := t243 - t245
+	t251 := t244 - t247
+	t252 := t35 + t107
+	t253 := t35 - t107
+	t254 := t71 + t143
+	t255 := t71 - t143
+	t256 := complex(imag(t255), -real(t255))
+	t257 := t252 + t254
+	t258 := t253 + t256
+	t259 := t252 - t254
+	t260 := t253 - t256
+	t261 := t230 + t248
+	t262 := t230 - t248
+	t263 := t239 + t257
+	t264 := t239 - t257
+	t265 := complex(imag(t264), -real(t264))
+	t266 := t261 + t263
+	t267 := t262 + t265
+	t268 := t261 - t263
+	t269 := t262 - t265
+	t270 := t240 * complex(0.9238795325112867, -0.3826834323650898)
+	t271 := complex((real(t249)+imag(t249))*0.7071067811865476, (imag(t249)-real(t249))*0.7071067811865476)
+	t272 := t258 * complex(0.3826834323650898, -0.9238795325112867)
+	t273 := t231 + t271
+	t274 := t231 - t271
+	t275 := t270 + t272
+	t276 := t270 - t272
+	t277 := complex(imag(t276), -real(t276))
+	t278 := t273 + t275
+	t279 := t274 + t277
+	t280 := t273 - t275
+	t281 := t274 - t277
+	t282 := complex((real(t241)+imag(t241))*0.7071067811865476, (imag(t241)-real(t241))*0.7071067811865476)
+	t283 := complex(imag(t250), -real(t250))
+	t284 := complex((-real(t259)+imag(t259))*0.7071067811865476, (-imag(t259)-real(t259))*0.7071067811865476)
+	t285 := t232 + t283
+	t286 := t232 - t283
+	t287 := t282 + t284
+	t288 := t282 - t284
+	t289 := complex(imag(t288), -real(t288))
+	t290 := t285 + t287
+	t291 := t286 + t289
+	t292 := t285 - t287
+	t293 := t286 - t289
+	t294 := t242 * complex(0.3826834323650898, -0.9238795325112867)
+	t295 := complex((-real(t251)+imag(t251))*0.7071067811865476, (-imag(t251)-real(t251))*0.7071067811865476)
+	t296 := t260 * complex(-0.9238795325112867, 0.3826834323650898)
+	t297 := t233 + t295
+	t298 := t233 - t295
+	t299 := t294 + t296
+	t300 := t294 - t296
+	t301 := complex(imag(t300), -real(t300))
+	t302 := t297 + t299
+	t303 := t298 + t301
+	t304 := t297 - t299
+	t305 := t298 - t301
+	t306 := t7 + t79
+	t307 := t7 - t79
+	t308 := t43 + t115
+	t309 := t43 - t115
+	t310 := complex(imag(t309), -real(t309))
+	t311 := t306 + t308
+	t312 := t307 + t310
+	t313 := t306 - t308
+	t314 := t307 - t310
+	t315 := t16 + t88
+	t316 := t16 - t88
+	t317 := t52 + t124
+	t318 := t52 - t124
+	t319 := complex(imag(t318), -real(t318))
+	t320 := t315 + t317
+	t321 := t316 + t319
+	t322 := t315 - t317
+	t323 := t316 - t319
+	t324 := t25 + t97
+	t325 := t25 - t97
+	t326 := t61 + t133
+	t327 := t61 - t133
+	t328 := complex(imag(t327), -real(t327))
+	t329 := t324 + t326
+	t330 := t325 + t328
+	t331 := t324 - t326
+	t332 := t325 - t328
+	t333 := t34 + t106
+	t334 := t34 - t106
+	t335 := t70 + t142
+	t336 := t70 - t142
+	t337 := complex(imag(t336), -real(t336))
+	t338 := t333 + t335
+	t339 := t334 + t337
+	t340 := t333 - t335
+	t341 := t334 - t337
+	t342 := t311 + t329
+	t343 := t311 - t329
+	t344 := t320 + t338
+	t345 := t320 - t338
+	t346 := complex(imag(t345), -real(t345))
+	t347 := t342 + t344
+	t348 := t343 + t346
+	t349 := t342 - t344
+	t350 := t343 - t346
+	t351 := t321 * complex(0.9238795325112867, -0.3826834323650898)
+	t352 := complex((real(t330)+imag(t330))*0.7071067811865476, (imag(t330)-real(t330))*0.7071067811865476)
+	t353 := t339 * complex(0.3826834323650898, -0.9238795325112867)
+	t354 := t312 + t352
+	t355 := t312 - t352
+	t356 := t351 + t353
+	t357 := t351 - t353
+	t358 := complex(imag(t357), -real(t357))
+	t359 := t354 + t356
+	t360 := t355 + t358
+	t361 := t354 - t356
+	t362 := t355 - t358
+	t363 := complex((real(t322)+imag(t322))*0.7071067811865476, (imag(t322)-real(t322))*0.7071067811865476)
+	t364 := complex(imag(t331), -real(t331))
+	t365 := complex((-real(t340)+imag(t340))*0.7071067811865476, (-imag(t340)-real(t340))*0.7071067811865476)
+	t366 := t313 + t364
+	t367 := t313 - t364
+	t368 := t363 + t365
+	t369 := t363 - t365
+	t370 := complex(imag(t369), -real(t369))
+	t371 := t366 + t368
+	t372 := t367 + t370
+	t373 := t366 - t368
+	t374 := t367 - t370
+	t375 := t323 * complex(0.3826834323650898, -0.9238795325112867)
+	t376 := complex((-real(t332)+imag(t332))*0.7071067811865476, (-imag(t332)-real(t332))*0.7071067811865476)
+	t377 := t341 * complex(-0.9238795325112867, 0.3826834323650898)
+	t378 := t314 + t376
+	t379 := t314 - t376
+	t380 := t375 + t377
+	t381 := t375 - t377
+	t382 := complex(imag(t381), -real(t381))
+	t383 := t378 + t380
+	t384 := t379 + t382
+	t385 := t378 - t380
+	t386 := t379 - t382
+
+	d[0] = t185
+	d[1] = t278
+	d[2] = t371
+	d[3] = t221
+	d[4] = t267
+	d[5] = t360
+	d[6] = t210
+	d[7] = t303
+	d[8] = t349
+	d[9] = t199
+	d[10] = t292
+	d[11] = t385
+	d[12] = t188
+	d[13] = t281
+	d[14] = t374
+	d[15] = t224
+	d[16] = t266
+	d[17] = t359
+	d[18] = t209
+	d[19] = t302
+	d[20] = t348
+	d[21] = t198
+	d[22] = t291
+	d[23] = t384
+	d[24] = t187
+	d[25] = t280
+	d[26] = t373
+	d[27] = t223
+	d[28] = t269
+	d[29] = t362
+	d[30] = t212
+	d[31] = t305
+	d[32] = t347
+	d[33] = t197
+	d[34] = t290
+	d[35] = t383
+	d[36] = t186
+	d[37] = t279
+	d[38] = t372
+	d[39] = t222
+	d[40] = t268
+	d[41] = t361
+	d[42] = t211
+	d[43] = t304
+	d[44] = t350
+	d[45] = t200
+	d[46] = t293
+	d[47] = t386
+
+	return true
+}
+
+// inverseDFT48Complex64 computes a 48-point inverse DFT of complex64 data,
+// including the 1/48 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT48Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 48
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x19 + x35
+	t10 := x19 - x35
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x22 + x38
+	t19 := x22 - x38
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x25 + x41
+	t28 := x25 - x41
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x28 + x44
+	t37 := x28 - x44
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x31 + x47
+	t46 := x31 - x47
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x34 + x2
+	t55 := x34 - x2
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x37 + x5
+	t64 := x37 - x5
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := x40 + x8
+	t73 := x40 - x8
+	t74 := x24 + t72
+	t75 := real(x24) - 0.49999999999999994*real(t72)
+	t76 := imag(x24) - 0.49999999999999994*imag(t72)
+	t77 := 0.8660254037844387 * real(t73)
+	t78 := 0.8660254037844387 * imag(t73)
+	t79 := complex(t75-t78, t76+t77)
+	t80 := complex(t75+t78, t76-t77)
+	t81 := x43 + x11
+	t82 := x43 - x11
+	t83 := x27 + t81
+	t84 := real(x27) - 0.49999999999999994*real(t81)
+	t85 := imag(x27) - 0.49999999999999994*imag(t81)
+	t86 := 0.8660254037844387 * real(t82)
+	t87 := 0.8660254037844387 * imag(t82)
+	t88 := complex(t84-t87, t85+t86)
+	t89 := complex(t84+t87, t85-t86)
+	t90 := x46 + x14
+	t91 := x46 - x14
+	t92 := x30 + t90
+	t93 := real(x30) - 0.49999999999999994*real(t90)
+	t94 := imag(x30) - 0.49999999999999994*imag(t90)
+	t95 := 0.8660254037844387 * real(t91)
+	t96 := 0.8660254037844387 * imag(t91)
+	t97 := complex(t93-t96, t94+t95)
+	t98 := complex(t93+t96, t94-t95)
+	t99 := x1 + x17
+	t100 := x1 - x17
+	t101 := x33 + t99
+	t102 := real(x33) - 0.49999999999999994*real(t99)
+	t103 := imag(x33) - 0.49999999999999994*imag(t99)
+	t104 := 0.8660254037844387 * real(t100)
+	t105 := 0.8660254037844387 * imag(t100)
+	t106 := complex(t102-t105, t103+t104)
+	t107 := complex(t102+t105, t103-t104)
+	t108 := x4 + x20
+	t109 := x4 - x20
+	t110 := x36 + t108
+	t111 := real(x36) - 0.49999999999999994*real(t108)
+	t112 := imag(x36) - 0.49999999999999994*imag(t108)
+	t113 := 0.8660254037844387 * real(t109)
+	t114 := 0.8660254037844387 * imag(t109)
+	t115 := complex(t111-t114, t112+t113)
+	t116 := complex(t111+t114, t112-t113)
+	t117 := x7 + x23
+	t118 := x7 - x23
+	t119 := x39 + t117
+	t120 := real(x39) - 0.49999999999999994*real(t117)
+	t121 := imag(x39) - 0.49999999999999994*imag(t117)
+	t122 := 0.8660254037844387 * real(t118)
+	t123 := 0.8660254037844387 * imag(t118)
+	t124 := complex(t120-t123, t121+t122)
+	t125 := complex(t120+t123, t121-t122)
+	t126 := x10 + x26
+	t127 := x10 - x26
+	t128 := x42 + t126
+	t129 := real(x42) - 0.49999999999999994*real(t126)
+	t130 := imag(x42) - 0.49999999999999994*imag(t126)
+	t131 := 0.8660254037844387 * real(t127)
+	t132 := 0.8660254037844387 * imag(t127)
+	t133 := complex(t129-t132, t130+t131)
+	t134 := complex(t129+t132, t130-t131)
+	t135 := x13 + x29
+	t136 := x13 - x29
+	t137 := x45 + t135
+	t138 := real(x45) - 0.49999999999999994*real(t135)
+	t139 := imag(x45) - 0.49999999999999994*imag(t135)
+	t140 := 0.8660254037844387 * real(t136)
+	t141 := 0.8660254037844387 * imag(t136)
+	t142 := complex(t138-t141, t139+t140)
+	t143 := complex(t138+t141, t139-t140)
+	t144 := t2 + t74
+	t145 := t2 - t74
+	t146 := t38 + t110
+	t147 := t38 - t110
+	t148 := complex(-imag(t147), real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := t11 + t83
+	t154 := t11 - t83
+	t155 := t47 + t119
+	t156 := t47 - t119
+	t157 := complex(-imag(t156), real(t156))
+	t158 := t153 + t155
+	t159 := t154 + t157
+	t160 := t153 - t155
+	t161 := t154 - t157
+	t162 := t20 + t92
+	t163 := t20 - t92
+	t164 := t56 + t128
+	t165 := t56 - t128
+	t166 := complex(-imag(t165), real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := t29 + t101
+	t172 := t29 - t101
+	t173 := t65 + t137
+	t174 := t65 - t137
+	t175 := complex(-imag(t174), real(t174))
+	t176 := t171 + t173
+	t177 := t172 + t175
+	t178 := t171 - t173
+	t179 := t172 - t175
+	t180 := t149 + t167
+	t181 := t149 - t167
+	t182 := t158 + t176
+	t183 := t158 - t176
+	t184 := complex(-imag(t183), real(t183))
+	t185 := t180 + t182
+	t186 := t181 + t184
+	t187 := t180 - t182
+	t188 := t181 - t184
+	t189 := t159 * complex(0.9238795325112867, 0.3826834323650898)
+	t190 := complex((real(t168)-imag(t168))*0.7071067811865476, (imag(t168)+real(t168))*0.7071067811865476)
+	t191 := t177 * complex(0.3826834323650898, 0.9238795325112867)
+	t192 := t150 + t190
+	t193 := t150 - t190
+	t194 := t189 + t191
+	t195 := t189 - t191
+	t196 := complex(-imag(t195), real(t195))
+	t197 := t192 + t194
+	t198 := t193 + t196
+	t199 := t192 - t194
+	t200 := t193 - t196
+	t201 := complex((real(t160)-imag(t160))*0.7071067811865476, (imag(t160)+real(t160))*0.7071067811865476)
+	t202 := complex(-imag(t169), real(t169))
+	t203 := complex((-real(t178)-imag(t178))*0.7071067811865476, (-imag(t178)+real(t178))*0.7071067811865476)
+	t204 := t151 + t202
+	t205 := t151 - t202
+	t206 := t201 + t203
+	t207 := t201 - t203
+	t208 := complex(-imag(t207), real(t207))
+	t209 := t204 + t206
+	t210 := t205 + t208
+	t211 := t204 - t206
+	t212 := t205 - t208
+	t213 := t161 * complex(0.3826834323650898, 0.9238795325112867)
+	t214 := complex((-real(t170)-imag(t170))*0.7071067811865476, (-imag(t170)+real(t170))*0.7071067811865476)
+	t215 := t179 * complex(-0.9238795325112867, -0.3826834323650898)
+	t216 := t152 + t214
+	t217 := t152 - t214
+	t218 := t213 + t215
+	t219 := t213 - t215
+	t220 := complex(-imag(t219), real(t219))
+	t221 := t216 + t218
+	t222 := t217 + t220
+	t223 := t216 - t218
+	t224 := t217 - t220
+	t225 := t7 + t79
+	t226 := t7 - t79
+	t227 := t43 + t115
+	t228 := t43 - t115
+	t229 := complex(-imag(t228), real(t228))
+	t230 := t225 + t227
+	t231 := t226 + t229
+	t232 := t225 - t227
+	t233 := t226 - t229
+	t234 := t16 + t88
+	t235 := t16 - t88
+	t236 := t52 + t124
+	t237 := t52 - t124
+	t238 := complex(-imag(t237), real(t237))
+	t239 := t234 + t236
+	t240 := t235 + t238
+	t241 := t234 - t236
+	t242 := t235 - t238
+	t243 := t25 + t97
+	t244 := t25 - t97
+	t245 := t61 + t133
+	t246 := t61 - t133
+	t247 := complex(-imag(t246), real(t246))
+	t248 := t243 + t245
+	t249 := t244 + t247
+	t250 := t243 - t245
+	t251 := t244 - t247
+	t252 := t34 + t106
+	t253 := t34 - t106
+	t254 := t70 + t142
+	t255 := t70 - t142
+	t256 := complex(-imag(t255), real(t255))
+	t257 := t252 + t254
+	t258 := t253 + t256
+	t259 := t252 - t254
+	t260 := t253 - t256
+	t261 := t230 + t248
+	t262 := t230 - t248
+	t263 := t239 + t257
+	t264 := t239 - t257
+	t265 := complex(-imag(t264), real(t264))
+	t266 := t261 + t263
+	t267 := t262 + t265
+	t268 := t261 - t263
+	t269 := t262 - t265
+	t270 := t240 * complex(0.9238795325112867, 0.3826834323650898)
+	t271 := complex((real(t249)-imag(t249))*0.7071067811865476, (imag(t249)+real(t249))*0.7071067811865476)
+	t272 := t258 * complex(0.3826834323650898, 0.9238795325112867)
+	t273 := t231 + t271
+	t274 := t231 - t271
+	t275 := t270 + t272
+	t276 := t270 - t272
+	t277 := complex(-imag(t276), real(t276))
+	t278 := t273 + t275
+	t279 := t274 + t277
+	t280 := t273 - t275
+	t281 := t274 - t277
+	t282 := complex((real(t241)-imag(t241))*0.7071067811865476, (imag(t241)+real(t241))*0.7071067811865476)
+	t283 := complex(-imag(t250), real(t250))
+	t284 := complex((-real(t259)-imag(t259))*0.7071067811865476, (-imag(t259)+real(t259))*0.7071067811865476)
+	t285 := t232 + t283
+	t286 := t232 - t283
+	t287 := t282 + t284
+	t288 := t282 - t284
+	t289 := complex(-imag(t288), real(t288))
+	t290 := t285 + t287
+	t291 := t286 + t289
+	t292 := t285 - t287
+	t293 := t286 - t289
+	t294 := t242 * complex(0.3826834323650898, 0.9238795325112867)
+	t295 := complex((-real(t251)-imag(t251))*0.7071067811865476, (-imag(t251)+real(t251))*0.7071067811865476)
+	t296 := t260 * complex(-0.9238795325112867, -0.3826834323650898)
+	t297 := t233 + t295
+	t298 := t233 - t295
+	t299 := t294 + t296
+	t300 := t294 - t296
+	t301 := complex(-imag(t300), real(t300))
+	t302 := t297 + t299
+	t303 := t298 + t301
+	t304 := t297 - t299
+	t305 := t298 - t301
+	t306 := t8 + t80
+	t307 := t8 - t80
+	t308 := t44 + t116
+	t309 := t44 - t116
+	t310 := complex(-imag(t309), real(t309))
+	t311 := t306 + t308
+	t312 := t307 + t310
+	t313 := t306 - t308
+	t314 := t307 - t310
+	t315 := t17 + t89
+	t316 := t17 - t89
+	t317 := t53 + t125
+	t318 := t53 - t125
+	t319 := complex(-imag(t318), real(t318))
+	t320 := t315 + t317
+	t321 := t316 + t319
+	t322 := t315 - t317
+	t323 := t316 - t319
+	t324 := t26 + t98
+	t325 := t26 - t98
+	t326 := t62 + t134
+	t327 := t62 - t134
+	t328 := complex(-imag(t327), real(t327))
+	t329 := t324 + t326
+	t330 := t325 + t328
+	t331 := t324 - t326
+	t332 := t325 - t328
+	t333 := t35 + t107
+	t334 := t35 - t107
+	t335 := t71 + t143
+	t336 := t71 - t143
+	t337 := complex(-imag(t336), real(t336))
+	t338 := t333 + t335
+	t339 := t334 + t337
+	t340 := t333 - t335
+	t341 := t334 - t337
+	t342 := t311 + t329
+	t343 := t311 - t329
+	t344 := t320 + t338
+	t345 := t320 - t338
+	t346 := complex(-imag(t345), real(t345))
+	t347 := t342 + t344
+	t348 := t343 + t346
+	t349 := t342 - t344
+	t350 := t343 - t346
+	t351 := t321 * complex(0.9238795325112867, 0.3826834323650898)
+	t352 := complex((real(t330)-imag(t330))*0.7071067811865476, (imag(t330)+real(t330))*0.7071067811865476)
+	t353 := t339 * complex(0.3826834323650898, 0.9238795325112867)
+	t354 := t312 + t352
+	t355 := t312 - t352
+	t356 := t351 + t353
+	t357 := t351 - t353
+	t358 := complex(-imag(t357), real(t357))
+	t359 := t354 + t356
+	t360 := t355 + t358
+	t361 := t354 - t356
+	t362 := t355 - t358
+	t363 := complex((real(t322)-imag(t322))*0.7071067811865476, (imag(t322)+real(t322))*0.7071067811865476)
+	t364 := complex(-imag(t331), real(t331))
+	t365 := complex((-real(t340)-imag(t340))*0.7071067811865476, (-imag(t340)+real(t340))*0.7071067811865476)
+	t366 := t313 + t364
+	t367 := t313 - t364
+	t368 := t363 + t365
+	t369 := t363 - t365
+	t370 := complex(-imag(t369), real(t369))
+	t371 := t366 + t368
+	t372 := t367 + t370
+	t373 := t366 - t368
+	t374 := t367 - t370
+	t375 := t323 * complex(0.3826834323650898, 0.9238795325112867)
+	t376 := complex((-real(t332)-imag(t332))*0.7071067811865476, (-imag(t332)+real(t332))*0.7071067811865476)
+	t377 := t341 * complex(-0.9238795325112867, -0.3826834323650898)
+	t378 := t314 + t376
+	t379 := t314 - t376
+	t380 := t375 + t377
+	t381 := t375 - t377
+	t382 := complex(-imag(t381), real(t381))
+	t383 := t378 + t380
+	t384 := t379 + t382
+	t385 := t378 - t380
+	t386 := t379 - t382
+
+	d[0] = complex(real(t185)*0.020833333333333332, imag(t185)*0.020833333333333332)
+	d[1] = complex(real(t278)*0.020833333333333332, imag(t278)*0.020833333333333332)
+	d[2] = complex(real(t371)*0.020833333333333332, imag(t371)*0.020833333333333332)
+	d[3] = complex(real(t221)*0.020833333333333332, imag(t221)*0.020833333333333332)
+	d[4] = complex(real(t267)*0.020833333333333332, imag(t267)*0.020833333333333332)
+	d[5] = complex(real(t360)*0.020833333333333332, imag(t360)*0.020833333333333332)
+	d[6] = complex(real(t210)*0.020833333333333332, imag(t210)*0.020833333333333332)
+	d[7] = complex(real(t303)*0.020833333333333332, imag(t303)*0.020833333333333332)
+	d[8] = complex(real(t349)*0.020833333333333332, imag(t349)*0.020833333333333332)
+	d[9] = complex(real(t199)*0.020833333333333332, imag(t199)*0.020833333333333332)
+	d[10] = complex(real(t292)*0.020833333333333332, imag(t292)*0.020833333333333332)
+	d[11] = complex(real(t385)*0.020833333333333332, imag(t385)*0.020833333333333332)
+	d[12] = complex(real(t188)*0.020833333333333332, imag(t188)*0.020833333333333332)
+	d[13] = complex(real(t281)*0.020833333333333332, imag(t281)*0.020833333333333332)
+	d[14] = complex(real(t374)*0.020833333333333332, imag(t374)*0.020833333333333332)
+	d[15] = complex(real(t224)*0.020833333333333332, imag(t224)*0.020833333333333332)
+	d[16] = complex(real(t266)*0.020833333333333332, imag(t266)*0.020833333333333332)
+	d[17] = complex(real(t359)*0.020833333333333332, imag(t359)*0.020833333333333332)
+	d[18] = complex(real(t209)*0.020833333333333332, imag(t209)*0.020833333333333332)
+	d[19] = complex(real(t302)*0.020833333333333332, imag(t302)*0.020833333333333332)
+	d[20] = complex(real(t348)*0.020833333333333332, imag(t348)*0.020833333333333332)
+	d[21] = complex(real(t198)*0.020833333333333332, imag(t198)*0.020833333333333332)
+	d[22] = complex(real(t291)*0.020833333333333332, imag(t291)*0.020833333333333332)
+	d[23] = complex(real(t384)*0.020833333333333332, imag(t384)*0.020833333333333332)
+	d[24] = complex(real(t187)*0.020833333333333332, imag(t187)*0.020833333333333332)
+	d[25] = complex(real(t280)*0.020833333333333332, imag(t280)*0.020833333333333332)
+	d[26] = complex(real(t373)*0.020833333333333332, imag(t373)*0.020833333333333332)
+	d[27] = complex(real(t223)*0.020833333333333332, imag(t223)*0.020833333333333332)
+	d[28] = complex(real(t269)*0.020833333333333332, imag(t269)*0.020833333333333332)
+	d[29] = complex(real(t362)*0.020833333333333332, imag(t362)*0.020833333333333332)
+	d[30] = complex(real(t212)*0.020833333333333332, imag(t212)*0.020833333333333332)
+	d[31] = complex(real(t305)*0.020833333333333332, imag(t305)*0.020833333333333332)
+	d[32] = complex(real(t347)*0.020833333333333332, imag(t347)*0.020833333333333332)
+	d[33] = complex(real(t197)*0.020833333333333332, imag(t197)*0.020833333333333332)
+	d[34] = complex(real(t290)*0.020833333333333332, imag(t290)*0.020833333333333332)
+	d[35] = complex(real(t383)*0.020833333333333332, imag(t383)*0.020833333333333332)
+	d[36] = complex(real(t186)*0.020833333333333332, imag(t186)*0.020833333333333332)
+	d[37] = complex(real(t279)*0.020833333333333332, imag(t279)*0.020833333333333332)
+	d[38] = complex(real(t372)*0.020833333333333332, imag(t372)*0.020833333333333332)
+	d[39] = complex(real(t222)*0.020833333333333332, imag(t222)*0.020833333333333332)
+	d[40] = complex(real(t268)*0.020833333333333332, imag(t268)*0.020833333333333332)
+	d[41] = complex(real(t361)*0.020833333333333332, imag(t361)*0.020833333333333332)
+	d[42] = complex(real(t211)*0.020833333333333332, imag(t211)*0.020833333333333332)
+	d[43] = complex(real(t304)*0.020833333333333332, imag(t304)*0.020833333333333332)
+	d[44] = complex(real(t350)*0.020833333333333332, imag(t350)*0.020833333333333332)
+	d[45] = complex(real(t200)*0.020833333333333332, imag(t200)*0.020833333333333332)
+	d[46] = complex(real(t293)*0.020833333333333332, imag(t293)*0.020833333333333332)
+	d[47] = complex(real(t386)*0.020833333333333332, imag(t386)*0.020833333333333332)
+
+	return true
+}
+
+// forwardDFT48Complex128 computes a 48-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT48Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 48
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x19 + x35
+	t10 := x19 - x35
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x22 + x38
+	t19 := x22 - x38
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x25 + x41
+	t28 := x25 - x41
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x28 + x44
+	t37 := x28 - x44
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x31 + x47
+	t46 := x31 - x47
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x34 + x2
+	t55 := x34 - x2
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x37 + x5
+	t64 := x37 - x5
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := x40 + x8
+	t73 := x40 - x8
+	t74 := x24 + t72
+	t75 := real(x24) - 0.49999999999999994*real(t72)
+	t76 := imag(x24) - 0.49999999999999994*imag(t72)
+	t77 := 0.8660254037844387 * real(t73)
+	t78 := 0.8660254037844387 * imag(t73)
+	t79 := complex(t75-t78, t76+t77)
+	t80 := complex(t75+t78, t76-t77)
+	t81 := x43 + x11
+	t82 := x43 - x11
+	t83 := x27 + t81
+	t84 := real(x27) - 0.49999999999999994*real(t81)
+	t85 := imag(x27) - 0.49999999999999994*imag(t81)
+	t86 := 0.8660254037844387 * real(t82)
+	t87 := 0.8660254037844387 * imag(t82)
+	t88 := complex(t84-t87, t85+t86)
+	t89 := complex(t84+t87, t85-t86)
+	t90 := x46 + x14
+	t91 := x46 - x14
+	t92 := x30 + t90
+	t93 := real(x30) - 0.49999999999999994*real(t90)
+	t94 := imag(x30) - 0.49999999999999994*imag(t90)
+	t95 := 0.8660254037844387 * real(t91)
+	t96 := 0.8660254037844387 * imag(t91)
+	t97 := complex(t93-t96, t94+t95)
+	t98 := complex(t93+t96, t94-t95)
+	t99 := x1 + x17
+	t100 := x1 - x17
+	t101 := x33 + t99
+	t102 := real(x33) - 0.49999999999999994*real(t99)
+	t103 := imag(x33) - 0.49999999999999994*imag(t99)
+	t104 := 0.8660254037844387 * real(t100)
+	t105 := 0.8660254037844387 * imag(t100)
+	t106 := complex(t102-t105, t103+t104)
+	t107 := complex(t102+t105, t103-t104)
+	t108 := x4 + x20
+	t109 := x4 - x20
+	t110 := x36 + t108
+	t111 := real(x36) - 0.49999999999999994*real(t108)
+	t112 := imag(x36) - 0.49999999999999994*imag(t108)
+	t113 := 0.8660254037844387 * real(t109)
+	t114 := 0.8660254037844387 * imag(t109)
+	t115 := complex(t111-t114, t112+t113)
+	t116 := complex(t111+t114, t112-t113)
+	t117 := x7 + x23
+	t118 := x7 - x23
+	t119 := x39 + t117
+	t120 := real(x39) - 0.49999999999999994*real(t117)
+	t121 := imag(x39) - 0.49999999999999994*imag(t117)
+	t122 := 0.8660254037844387 * real(t118)
+	t123 := 0.8660254037844387 * imag(t118)
+	t124 := complex(t120-t123, t121+t122)
+	t125 := complex(t120+t123, t121-t122)
+	t126 := x10 + x26
+	t127 := x10 - x26
+	t128 := x42 + t126
+	t129 := real(x42) - 0.49999999999999994*real(t126)
+	t130 := imag(x42) - 0.49999999999999994*imag(t126)
+	t131 := 0.8660254037844387 * real(t127)
+	t132 := 0.8660254037844387 * imag(t127)
+	t133 := complex(t129-t132, t130+t131)
+	t134 := complex(t129+t132, t130-t131)
+	t135 := x13 + x29
+	t136 := x13 - x29
+	t137 := x45 + t135
+	t138 := real(x45) - 0.49999999999999994*real(t135)
+	t139 := imag(x45) - 0.49999999999999994*imag(t135)
+	t140 := 0.8660254037844387 * real(t136)
+	t141 := 0.8660254037844387 * imag(t136)
+	t142 := complex(t138-t141, t139+t140)
+	t143 := complex(t138+t141, t139-t140)
+	t144 := t2 + t74
+	t145 := t2 - t74
+	t146 := t38 + t110
+	t147 := t38 - t110
+	t148 := complex(imag(t147), -real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := t11 + t83
+	t154 := t11 - t83
+	t155 := t47 + t119
+	t156 := t47 - t119
+	t157 := complex(imag(t156), -real(t156))
+	t158 := t153 + t155
+	t159 := t154 + t157
+	t160 := t153 - t155
+	t161 := t154 - t157
+	t162 := t20 + t92
+	t163 := t20 - t92
+	t164 := t56 + t128
+	t165 := t56 - t128
+	t166 := complex(imag(t165), -real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := t29 + t101
+	t172 := t29 - t101
+	t173 := t65 + t137
+	t174 := t65 - t137
+	t175 := complex(imag(t174), -real(t174))
+	t176 := t171 + t173
+	t177 := t172 + t175
+	t178 := t171 - t173
+	t179 := t172 - t175
+	t180 := t149 + t167
+	t181 := t149 - t167
+	t182 := t158 + t176
+	t183 := t158 - t176
+	t184 := complex(imag(t183), -real(t183))
+	t185 := t180 + t182
+	t186 := t181 + t184
+	t187 := t180 - t182
+	t188 := t181 - t184
+	t189 := t159 * complex(0.9238795325112867, -0.3826834323650898)
+	t190 := complex((real(t168)+imag(t168))*0.7071067811865476, (imag(t168)-real(t168))*0.7071067811865476)
+	t191 := t177 * complex(0.3826834323650898, -0.9238795325112867)
+	t192 := t150 + t190
+	t193 := t150 - t190
+	t194 := t189 + t191
+	t195 := t189 - t191
+	t196 := complex(imag(t195), -real(t195))
+	t197 := t192 + t194
+	t198 := t193 + t196
+	t199 := t192 - t194
+	t200 := t193 - t196
+	t201 := complex((real(t160)+imag(t160))*0.7071067811865476, (imag(t160)-real(t160))*0.7071067811865476)
+	t202 := complex(imag(t169), -real(t169))
+	t203 := complex((-real(t178)+imag(t178))*0.7071067811865476, (-imag(t178)-real(t178))*0.7071067811865476)
+	t204 := t151 + t202
+	t205 := t151 - t202
+	t206 := t201 + t203
+	t207 := t201 - t203
+	t208 := complex(imag(t207), -real(t207))
+	t209 := t204 + t206
+	t210 := t205 + t208
+	t211 := t204 - t206
+	t212 := t205 - t208
+	t213 := t161 * complex(0.3826834323650898, -0.9238795325112867)
+	t214 := complex((-real(t170)+imag(t170))*0.7071067811865476, (-imag(t170)-real(t170))*0.7071067811865476)
+	t215 := t179 * complex(-0.9238795325112867, 0.3826834323650898)
+	t216 := t152 + t214
+	t217 := t152 - t214
+	t218 := t213 + t215
+	t219 := t213 - t215
+	t220 := complex(imag(t219), -real(t219))
+	t221 := t216 + t218
+	t222 := t217 + t220
+	t223 := t216 - t218
+	t224 := t217 - t220
+	t225 := t8 + t80
+	t226 := t8 - t80
+	t227 := t44 + t116
+	t228 := t44 - t116
+	t229 := complex(imag(t228), -real(t228))
+	t230 := t225 + t227
+	t231 := t226 + t229
+	t232 := t225 - t227
+	t233 := t226 - t229
+	t234 := t17 + t89
+	t235 := t17 - t89
+	t236 := t53 + t125
+	t237 := t53 - t125
+	t238 := complex(imag(t237), -real(t237))
+	t239 := t234 + t236
+	t240 := t235 + t238
+	t241 := t234 - t236
+	t242 := t235 - t238
+	t243 := t26 + t98
+	t244 := t26 - t98
+	t245 := t62 + t134
+	t246 := t62 - t134
+	t247 := complex(imag(t246), -real(t246))
+	t248 := t243 + t245
+	t249 := t244 + t247
+	t250 := t243 - t245
+	t251 := t244 - t247
+	t252 := t35 + t107
+	t253 := t35 - t107
+	t254 := t71 + t143
+	t255 := t71 - t143
+	t256 := complex(imag(t255), -real(t255))
+	t257 := t252 + t254
+	t258 := t253 + t256
+	t259 := t252 - t254
+	t260 := t253 - t256
+	t261 := t230 + t248
+	t262 := t230 - t248
+	t263 := t239 + t257
+	t264 := t239 - t257
+	t265 := complex(imag(t264), -real(t264))
+	t266 := t261 + t263
+	t267 := t262 + t265
+	t268 := t261 - t263
+	t269 := t262 - t265
+	t270 := t240 * complex(0.9238795325112867, -0.3826834323650898)
+	t271 := complex((real(t249)+imag(t249))*0.7071067811865476, (imag(t249)-real(t249))*0.7071067811865476)
+	t272 := t258 * complex(0.3826834323650898, -0.9238795325112867)
+	t273 := t231 + t271
+	t274 := t231 - t271
+	t275 := t270 + t272
+	t276 := t270 - t272
+	t277 := complex(imag(t276), -real(t276))
+	t278 := t273 + t275
+	t279 := t274 + t277
+	t280 := t273 - t275
+	t281 := t274 - t277
+	t282 := complex((real(t241)+imag(t241))*0.7071067811865476, (imag(t241)-real(t241))*0.7071067811865476)
+	t283 := complex(imag(t250), -real(t250))
+	t284 := complex((-real(t259)+imag(t259))*0.7071067811865476, (-imag(t259)-real(t259))*0.7071067811865476)
+	t285 := t232 + t283
+	t286 := t232 - t283
+	t287 := t282 + t284
+	t288 := t282 - t284
+	t289 := complex(imag(t288), -real(t288))
+	t290 := t285 + t287
+	t291 := t286 + t289
+	t292 := t285 - t287
+	t293 := t286 - t289
+	t294 := t242 * complex(0.3826834323650898, -0.9238795325112867)
+	t295 := complex((-real(t251)+imag(t251))*0.7071067811865476, (-imag(t251)-real(t251))*0.7071067811865476)
+	t296 := t260 * complex(-0.9238795325112867, 0.3826834323650898)
+	t297 := t233 + t295
+	t298 := t233 - t295
+	t299 := t294 + t296
+	t300 := t294 - t296
+	t301 := complex(imag(t300), -real(t300))
+	t302 := t297 + t299
+	t303 := t298 + t301
+	t304 := t297 - t299
+	t305 := t298 - t301
+	t306 := t7 + t79
+	t307 := t7 - t79
+	t308 := t43 + t115
+	t309 := t43 - t115
+	t310 := complex(imag(t309), -real(t309))
+	t311 := t306 + t308
+	t312 := t307 + t310
+	t313 := t306 - t308
+	t314 := t307 - t310
+	t315 := t16 + t88
+	t316 := t16 - t88
+	t317 := t52 + t124
+	t318 := t52 - t124
+	t319 := complex(imag(t318), -real(t318))
+	t320 := t315 + t317
+	t321 := t316 + t319
+	t322 := t315 - t317
+	t323 := t316 - t319
+	t324 := t25 + t97
+	t325 := t25 - t97
+	t326 := t61 + t133
+	t327 := t61 - t133
+	t328 := complex(imag(t327), -real(t327))
+	t329 := t324 + t326
+	t330 := t325 + t328
+	t331 := t324 - t326
+	t332 := t325 - t328
+	t333 := t34 + t106
+	t334 := t34 - t106
+	t335 := t70 + t142
+	t336 := t70 - t142
+	t337 := complex(imag(t336), -real(t336))
+	t338 := t333 + t335
+	t339 := t334 + t337
+	t340 := t333 - t335
+	t341 := t334 - t337
+	t342 := t311 + t329
+	t343 := t311 - t329
+	t344 := t320 + t338
+	t345 := t320 - t338
+	t346 := complex(imag(t345), -real(t345))
+	t347 := t342 + t344
+	t348 := t343 + t346
+	t349 := t342 - t344
+	t350 := t343 - t346
+	t351 := t321 * complex(0.9238795325112867, -0.3826834323650898)
+	t352 := complex((real(t330)+imag(t330))*0.7071067811865476, (imag(t330)-real(t330))*0.7071067811865476)
+	t353 := t339 * complex(0.3826834323650898, -0.9238795325112867)
+	t354 := t312 + t352
+	t355 := t312 - t352
+	t356 := t351 + t353
+	t357 := t351 - t353
+	t358 := complex(imag(t357), -real(t357))
+	t359 := t354 + t356
+	t360 := t355 + t358
+	t361 := t354 - t356
+	t362 := t355 - t358
+	t363 := complex((real(t322)+imag(t322))*0.7071067811865476, (imag(t322)-real(t322))*0.7071067811865476)
+	t364 := complex(imag(t331), -real(t331))
+	t365 := complex((-real(t340)+imag(t340))*0.7071067811865476, (-imag(t340)-real(t340))*0.7071067811865476)
+	t366 := t313 + t364
+	t367 := t313 - t364
+	t368 := t363 + t365
+	t369 := t363 - t365
+	t370 := complex(imag(t369), -real(t369))
+	t371 := t366 + t368
+	t372 := t367 + t370
+	t373 := t366 - t368
+	t374 := t367 - t370
+	t375 := t323 * complex(0.3826834323650898, -0.9238795325112867)
+	t376 := complex((-real(t332)+imag(t332))*0.7071067811865476, (-imag(t332)-real(t332))*0.7071067811865476)
+	t377 := t341 * complex(-0.9238795325112867, 0.3826834323650898)
+	t378 := t314 + t376
+	t379 := t314 - t376
+	t380 := t375 + t377
+	t381 := t375 - t377
+	t382 := complex(imag(t381), -real(t381))
+	t383 := t378 + t380
+	t384 := t379 + t382
+	t385 := t378 - t380
+	t386 := t379 - t382
+
+	d[0] = t185
+	d[1] = t278
+	d[2] = t371
+	d[3] = t221
+	d[4] = t267
+	d[5] = t360
+	d[6] = t210
+	d[7] = t303
+	d[8] = t349
+	d[9] = t199
+	d[10] = t292
+	d[11] = t385
+	d[12] = t188
+	d[13] = t281
+	d[14] = t374
+	d[15] = t224
+	d[16] = t266
+	d[17] = t359
+	d[18] = t209
+	d[19] = t302
+	d[20] = t348
+	d[21] = t198
+	d[22] = t291
+	d[23] = t384
+	d[24] = t187
+	d[25] = t280
+	d[26] = t373
+	d[27] = t223
+	d[28] = t269
+	d[29] = t362
+	d[30] = t212
+	d[31] = t305
+	d[32] = t347
+	d[33] = t197
+	d[34] = t290
+	d[35] = t383
+	d[36] = t186
+	d[37] = t279
+	d[38] = t372
+	d[39] = t222
+	d[40] = t268
+	d[41] = t361
+	d[42] = t211
+	d[43] = t304
+	d[44] = t350
+	d[45] = t200
+	d[46] = t293
+	d[47] = t386
+
+	return true
+}
+
+// inverseDFT48Complex128 computes a 48-point inverse DFT of complex128 data,
+// including the 1/48 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT48Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 48
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x19 + x35
+	t10 := x19 - x35
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x22 + x38
+	t19 := x22 - x38
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x25 + x41
+	t28 := x25 - x41
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x28 + x44
+	t37 := x28 - x44
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x31 + x47
+	t46 := x31 - x47
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x34 + x2
+	t55 := x34 - x2
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x37 + x5
+	t64 := x37 - x5
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := x40 + x8
+	t73 := x40 - x8
+	t74 := x24 + t72
+	t75 := real(x24) - 0.49999999999999994*real(t72)
+	t76 := imag(x24) - 0.49999999999999994*imag(t72)
+	t77 := 0.8660254037844387 * real(t73)
+	t78 := 0.8660254037844387 * imag(t73)
+	t79 := complex(t75-t78, t76+t77)
+	t80 := complex(t75+t78, t76-t77)
+	t81 := x43 + x11
+	t82 := x43 - x11
+	t83 := x27 + t81
+	t84 := real(x27) - 0.49999999999999994*real(t81)
+	t85 := imag(x27) - 0.49999999999999994*imag(t81)
+	t86 := 0.8660254037844387 * real(t82)
+	t87 := 0.8660254037844387 * imag(t82)
+	t88 := complex(t84-t87, t85+t86)
+	t89 := complex(t84+t87, t85-t86)
+	t90 := x46 + x14
+	t91 := x46 - x14
+	t92 := x30 + t90
+	t93 := real(x30) - 0.49999999999999994*real(t90)
+	t94 := imag(x30) - 0.49999999999999994*imag(t90)
+	t95 := 0.8660254037844387 * real(t91)
+	t96 := 0.8660254037844387 * imag(t91)
+	t97 := complex(t93-t96, t94+t95)
+	t98 := complex(t93+t96, t94-t95)
+	t99 := x1 + x17
+	t100 := x1 - x17
+	t101 := x33 + t99
+	t102 := real(x33) - 0.49999999999999994*real(t99)
+	t103 := imag(x33) - 0.49999999999999994*imag(t99)
+	t104 := 0.8660254037844387 * real(t100)
+	t105 := 0.8660254037844387 * imag(t100)
+	t106 := complex(t102-t105, t103+t104)
+	t107 := complex(t102+t105, t103-t104)
+	t108 := x4 + x20
+	t109 := x4 - x20
+	t110 := x36 + t108
+	t111 := real(x36) - 0.49999999999999994*real(t108)
+	t112 := imag(x36) - 0.49999999999999994*imag(t108)
+	t113 := 0.8660254037844387 * real(t109)
+	t114 := 0.8660254037844387 * imag(t109)
+	t115 := complex(t111-t114, t112+t113)
+	t116 := complex(t111+t114, t112-t113)
+	t117 := x7 + x23
+	t118 := x7 - x23
+	t119 := x39 + t117
+	t120 := real(x39) - 0.49999999999999994*real(t117)
+	t121 := imag(x39) - 0.49999999999999994*imag(t117)
+	t122 := 0.8660254037844387 * real(t118)
+	t123 := 0.8660254037844387 * imag(t118)
+	t124 := complex(t120-t123, t121+t122)
+	t125 := complex(t120+t123, t121-t122)
+	t126 := x10 + x26
+	t127 := x10 - x26
+	t128 := x42 + t126
+	t129 := real(x42) - 0.49999999999999994*real(t126)
+	t130 := imag(x42) - 0.49999999999999994*imag(t126)
+	t131 := 0.8660254037844387 * real(t127)
+	t132 := 0.8660254037844387 * imag(t127)
+	t133 := complex(t129-t132, t130+t131)
+	t134 := complex(t129+t132, t130-t131)
+	t135 := x13 + x29
+	t136 := x13 - x29
+	t137 := x45 + t135
+	t138 := real(x45) - 0.49999999999999994*real(t135)
+	t139 := imag(x45) - 0.49999999999999994*imag(t135)
+	t140 := 0.8660254037844387 * real(t136)
+	t141 := 0.8660254037844387 * imag(t136)
+	t142 := complex(t138-t141, t139+t140)
+	t143 := complex(t138+t141, t139-t140)
+	t144 := t2 + t74
+	t145 := t2 - t74
+	t146 := t38 + t110
+	t147 := t38 - t110
+	t148 := complex(-imag(t147), real(t147))
+	t149 := t144 + t146
+	t150 := t145 + t148
+	t151 := t144 - t146
+	t152 := t145 - t148
+	t153 := t11 + t83
+	t154 := t11 - t83
+	t155 := t47 + t119
+	t156 := t47 - t119
+	t157 := complex(-imag(t156), real(t156))
+	t158 := t153 + t155
+	t159 := t154 + t157
+	t160 := t153 - t155
+	t161 := t154 - t157
+	t162 := t20 + t92
+	t163 := t20 - t92
+	t164 := t56 + t128
+	t165 := t56 - t128
+	t166 := complex(-imag(t165), real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := t29 + t101
+	t172 := t29 - t101
+	t173 := t65 + t137
+	t174 := t65 - t137
+	t175 := complex(-imag(t174), real(t174))
+	t176 := t171 + t173
+	t177 := t172 + t175
+	t178 := t171 - t173
+	t179 := t172 - t175
+	t180 := t149 + t167
+	t181 := t149 - t167
+	t182 := t158 + t176
+	t183 := t158 - t176
+	t184 := complex(-imag(t183), real(t183))
+	t185 := t180 + t182
+	t186 := t181 + t184
+	t187 := t180 - t182
+	t188 := t181 - t184
+	t189 := t159 * complex(0.9238795325112867, 0.3826834323650898)
+	t190 := complex((real(t168)-imag(t168))*0.7071067811865476, (imag(t168)+real(t168))*0.7071067811865476)
+	t191 := t177 * complex(0.3826834323650898, 0.9238795325112867)
+	t192 := t150 + t190
+	t193 := t150 - t190
+	t194 := t189 + t191
+	t195 := t189 - t191
+	t196 := complex(-imag(t195), real(t195))
+	t197 := t192 + t194
+	t198 := t193 + t196
+	t199 := t192 - t194
+	t200 := t193 - t196
+	t201 := complex((real(t160)-imag(t160))*0.7071067811865476, (imag(t160)+real(t160))*0.7071067811865476)
+	t202 := complex(-imag(t169), real(t169))
+	t203 := complex((-real(t178)-imag(t178))*0.7071067811865476, (-imag(t178)+real(t178))*0.7071067811865476)
+	t204 := t151 + t202
+	t205 := t151 - t202
+	t206 := t201 + t203
+	t207 := t201 - t203
+	t208 := complex(-imag(t207), real(t207))
+	t209 := t204 + t206
+	t210 := t205 + t208
+	t211 := t204 - t206
+	t212 := t205 - t208
+	t213 := t161 * complex(0.3826834323650898, 0.9238795325112867)
+	t214 := complex((-real(t170)-imag(t170))*0.7071067811865476, (-imag(t170)+real(t170))*0.7071067811865476)
+	t215 := t179 * complex(-0.9238795325112867, -0.3826834323650898)
+	t216 := t152 + t214
+	t217 := t152 - t214
+	t218 := t213 + t215
+	t219 := t213 - t215
+	t220 := complex(-imag(t219), real(t219))
+	t221 := t216 + t218
+	t222 := t217 + t220
+	t223 := t216 - t218
+	t224 := t217 - t220
+	t225 := t7 + t79
+	t226 := t7 - t79
+	t227 := t43 + t115
+	t228 := t43 - t115
+	t229 := complex(-imag(t228), real(t228))
+	t230 := t225 + t227
+	t231 := t226 + t229
+	t232 := t225 - t227
+	t233 := t226 - t229
+	t234 := t16 + t88
+	t235 := t16 - t88
+	t236 := t52 + t124
+	t237 := t52 - t124
+	t238 := complex(-imag(t237), real(t237))
+	t239 := t234 + t236
+	t240 := t235 + t238
+	t241 := t234 - t236
+	t242 := t235 - t238
+	t243 := t25 + t97
+	t244 := t25 - t97
+	t245 := t61 + t133
+	t246 := t61 - t133
+	t247 := complex(-imag(t246), real(t246))
+	t248 := t243 + t245
+	t249 := t244 + t247
+	t250 := t243 - t245
+	t251 := t244 - t247
+	t252 := t34 + t106
+	t253 := t34 - t106
+	t254 := t70 + t142
+	t255 := t70 - t142
+	t256 := complex(-imag(t255), real(t255))
+	t257 := t252 + t254
+	t258 := t253 + t256
+	t259 := t252 - t254
+	t260 := t253 - t256
+	t261 := t230 + t248
+	t262 := t230 - t248
+	t263 := t239 + t257
+	t264 := t239 - t257
+	t265 := complex(-imag(t264), real(t264))
+	t266 := t261 + t263
+	t267 := t262 + t265
+	t268 := t261 - t263
+	t269 := t262 - t265
+	t270 := t240 * complex(0.9238795325112867, 0.3826834323650898)
+	t271 := complex((real(t249)-imag(t249))*0.7071067811865476, (imag(t249)+real(t249))*0.7071067811865476)
+	t272 := t258 * complex(0.3826834323650898, 0.9238795325112867)
+	t273 := t231 + t271
+	t274 := t231 - t271
+	t275 := t270 + t272
+	t276 := t270 - t272
+	t277 := complex(-imag(t276), real(t276))
+	t278 := t273 + t275
+	t279 := t274 + t277
+	t280 := t273 - t275
+	t281 := t274 - t277
+	t282 := complex((real(t241)-imag(t241))*0.7071067811865476, (imag(t241)+real(t241))*0.7071067811865476)
+	t283 := complex(-imag(t250), real(t250))
+	t284 := complex((-real(t259)-imag(t259))*0.7071067811865476, (-imag(t259)+real(t259))*0.7071067811865476)
+	t285 := t232 + t283
+	t286 := t232 - t283
+	t287 := t282 + t284
+	t288 := t282 - t284
+	t289 := complex(-imag(t288), real(t288))
+	t290 := t285 + t287
+	t291 := t286 + t289
+	t292 := t285 - t287
+	t293 := t286 - t289
+	t294 := t242 * complex(0.3826834323650898, 0.9238795325112867)
+	t295 := complex((-real(t251)-imag(t251))*0.7071067811865476, (-imag(t251)+real(t251))*0.7071067811865476)
+	t296 := t260 * complex(-0.9238795325112867, -0.3826834323650898)
+	t297 := t233 + t295
+	t298 := t233 - t295
+	t299 := t294 + t296
+	t300 := t294 - t296
+	t301 := complex(-imag(t300), real(t300))
+	t302 := t297 + t299
+	t303 := t298 + t301
+	t304 := t297 - t299
+	t305 := t298 - t301
+	t306 := t8 + t80
+	t307 := t8 - t80
+	t308 := t44 + t116
+	t309 := t44 - t116
+	t310 := complex(-imag(t309), real(t309))
+	t311 := t306 + t308
+	t312 := t307 + t310
+	t313 := t306 - t308
+	t314 := t307 - t310
+	t315 := t17 + t89
+	t316 := t17 - t89
+	t317 := t53 + t125
+	t318 := t53 - t125
+	t319 := complex(-imag(t318), real(t318))
+	t320 := t315 + t317
+	t321 := t316 + t319
+	t322 := t315 - t317
+	t323 := t316 - t319
+	t324 := t26 + t98
+	t325 := t26 - t98
+	t326 := t62 + t134
+	t327 := t62 - t134
+	t328 := complex(-imag(t327), real(t327))
+	t329 := t324 + t326
+	t330 := t325 + t328
+	t331 := t324 - t326
+	t332 := t325 - t328
+	t333 := t35 + t107
+	t334 := t35 - t107
+	t335 := t71 + t143
+	t336 := t71 - t143
+	t337 := complex(-imag(t336), real(t336))
+	t338 := t333 + t335
+	t339 := t334 + t337
+	t340 := t333 - t335
+	t341 := t334 - t337
+	t342 := t311 + t329
+	t343 := t311 - t329
+	t344 := t320 + t338
+	t345 := t320 - t338
+	t346 := complex(-imag(t345), real(t345))
+	t347 := t342 + t344
+	t348 := t343 + t346
+	t349 := t342 - t344
+	t350 := t343 - t346
+	t351 := t321 * complex(0.9238795325112867, 0.3826834323650898)
+	t352 := complex((real(t330)-imag(t330))*0.7071067811865476, (imag(t330)+real(t330))*0.7071067811865476)
+	t353 := t339 * complex(0.3826834323650898, 0.9238795325112867)
+	t354 := t312 + t352
+	t355 := t312 - t352
+	t356 := t351 + t353
+	t357 := t351 - t353
+	t358 := complex(-imag(t357), real(t357))
+	t359 := t354 + t356
+	t360 := t355 + t358
+	t361 := t354 - t356
+	t362 := t355 - t358
+	t363 := complex((real(t322)-imag(t322))*0.7071067811865476, (imag(t322)+real(t322))*0.7071067811865476)
+	t364 := complex(-imag(t331), real(t331))
+	t365 := complex((-real(t340)-imag(t340))*0.7071067811865476, (-imag(t340)+real(t340))*0.7071067811865476)
+	t366 := t313 + t364
+	t367 := t313 - t364
+	t368 := t363 + t365
+	t369 := t363 - t365
+	t370 := complex(-imag(t369), real(t369))
+	t371 := t366 + t368
+	t372 := t367 + t370
+	t373 := t366 - t368
+	t374 := t367 - t370
+	t375 := t323 * complex(0.3826834323650898, 0.9238795325112867)
+	t376 := complex((-real(t332)-imag(t332))*0.7071067811865476, (-imag(t332)+real(t332))*0.7071067811865476)
+	t377 := t341 * complex(-0.9238795325112867, -0.3826834323650898)
+	t378 := t314 + t376
+	t379 := t314 - t376
+	t380 := t375 + t377
+	t381 := t375 - t377
+	t382 := complex(-imag(t381), real(t381))
+	t383 := t378 + t380
+	t384 := t379 + t382
+	t385 := t378 - t380
+	t386 := t379 - t382
+
+	d[0] = complex(real(t185)*0.020833333333333332, imag(t185)*0.020833333333333332)
+	d[1] = complex(real(t278)*0.020833333333333332, imag(t278)*0.020833333333333332)
+	d[2] = complex(real(t371)*0.020833333333333332, imag(t371)*0.020833333333333332)
+	d[3] = complex(real(t221)*0.020833333333333332, imag(t221)*0.020833333333333332)
+	d[4] = complex(real(t267)*0.020833333333333332, imag(t267)*0.020833333333333332)
+	d[5] = complex(real(t360)*0.020833333333333332, imag(t360)*0.020833333333333332)
+	d[6] = complex(real(t210)*0.020833333333333332, imag(t210)*0.020833333333333332)
+	d[7] = complex(real(t303)*0.020833333333333332, imag(t303)*0.020833333333333332)
+	d[8] = complex(real(t349)*0.020833333333333332, imag(t349)*0.020833333333333332)
+	d[9] = complex(real(t199)*0.020833333333333332, imag(t199)*0.020833333333333332)
+	d[10] = complex(real(t292)*0.020833333333333332, imag(t292)*0.020833333333333332)
+	d[11] = complex(real(t385)*0.020833333333333332, imag(t385)*0.020833333333333332)
+	d[12] = complex(real(t188)*0.020833333333333332, imag(t188)*0.020833333333333332)
+	d[13] = complex(real(t281)*0.020833333333333332, imag(t281)*0.020833333333333332)
+	d[14] = complex(real(t374)*0.020833333333333332, imag(t374)*0.020833333333333332)
+	d[15] = complex(real(t224)*0.020833333333333332, imag(t224)*0.020833333333333332)
+	d[16] = complex(real(t266)*0.020833333333333332, imag(t266)*0.020833333333333332)
+	d[17] = complex(real(t359)*0.020833333333333332, imag(t359)*0.020833333333333332)
+	d[18] = complex(real(t209)*0.020833333333333332, imag(t209)*0.020833333333333332)
+	d[19] = complex(real(t302)*0.020833333333333332, imag(t302)*0.020833333333333332)
+	d[20] = complex(real(t348)*0.020833333333333332, imag(t348)*0.020833333333333332)
+	d[21] = complex(real(t198)*0.020833333333333332, imag(t198)*0.020833333333333332)
+	d[22] = complex(real(t291)*0.020833333333333332, imag(t291)*0.020833333333333332)
+	d[23] = complex(real(t384)*0.020833333333333332, imag(t384)*0.020833333333333332)
+	d[24] = complex(real(t187)*0.020833333333333332, imag(t187)*0.020833333333333332)
+	d[25] = complex(real(t280)*0.020833333333333332, imag(t280)*0.020833333333333332)
+	d[26] = complex(real(t373)*0.020833333333333332, imag(t373)*0.020833333333333332)
+	d[27] = complex(real(t223)*0.020833333333333332, imag(t223)*0.020833333333333332)
+	d[28] = complex(real(t269)*0.020833333333333332, imag(t269)*0.020833333333333332)
+	d[29] = complex(real(t362)*0.020833333333333332, imag(t362)*0.020833333333333332)
+	d[30] = complex(real(t212)*0.020833333333333332, imag(t212)*0.020833333333333332)
+	d[31] = complex(real(t305)*0.020833333333333332, imag(t305)*0.020833333333333332)
+	d[32] = complex(real(t347)*0.020833333333333332, imag(t347)*0.020833333333333332)
+	d[33] = complex(real(t197)*0.020833333333333332, imag(t197)*0.020833333333333332)
+	d[34] = complex(real(t290)*0.020833333333333332, imag(t290)*0.020833333333333332)
+	d[35] = complex(real(t383)*0.020833333333333332, imag(t383)*0.020833333333333332)
+	d[36] = complex(real(t186)*0.020833333333333332, imag(t186)*0.020833333333333332)
+	d[37] = complex(real(t279)*0.020833333333333332, imag(t279)*0.020833333333333332)
+	d[38] = complex(real(t372)*0.020833333333333332, imag(t372)*0.020833333333333332)
+	d[39] = complex(real(t222)*0.020833333333333332, imag(t222)*0.020833333333333332)
+	d[40] = complex(real(t268)*0.020833333333333332, imag(t268)*0.020833333333333332)
+	d[41] = complex(real(t361)*0.020833333333333332, imag(t361)*0.020833333333333332)
+	d[42] = complex(real(t211)*0.020833333333333332, imag(t211)*0.020833333333333332)
+	d[43] = complex(real(t304)*0.020833333333333332, imag(t304)*0.020833333333333332)
+	d[44] = complex(real(t350)*0.020833333333333332, imag(t350)*0.020833333333333332)
+	d[45] = complex(real(t200)*0.020833333333333332, imag(t200)*0.020833333333333332)
+	d[46] = complex(real(t293)*0.020833333333333332, imag(t293)*0.020833333333333332)
+	d[47] = complex(real(t386)*0.020833333333333332, imag(t386)*0.020833333333333332)
+
+	return true
+}
@@ -0,0 +1,2641 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT60Complex64 computes a 60-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT60Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 60
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+
+	t0 := x12 + x48
+	t1 := x12 - x48
+	t2 := x24 + x36
+	t3 := x24 - x36
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x32 + x8
+	t18 := x32 - x8
+	t19 := x44 + x56
+	t20 := x44 - x56
+	t21 := x20 + t17 + t19
+	t22 := real(x20) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x20) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x20) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x20) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x52 + x28
+	t35 := x52 - x28
+	t36 := x4 + x16
+	t37 := x4 - x16
+	t38 := x40 + t34 + t36
+	t39 := real(x40) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x40) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x40) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x40) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t27 + t44
+	t61 := t27 - t44
+	t62 := t10 + t60
+	t63 := real(t10) - 0.49999999999999994*real(t60)
+	t64 := imag(t10) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t33 + t50
+	t70 := t33 - t50
+	t71 := t16 + t69
+	t72 := real(t16) - 0.49999999999999994*real(t69)
+	t73 := imag(t16) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t32 + t49
+	t79 := t32 - t49
+	t80 := t15 + t78
+	t81 := real(t15) - 0.49999999999999994*real(t78)
+	t82 := imag(t15) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t26 + t43
+	t88 := t26 - t43
+	t89 := t9 + t87
+	t90 := real(t9) - 0.49999999999999994*real(t87)
+	t91 := imag(t9) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := x27 + x3
+	t97 := x27 - x3
+	t98 := x39 + x51
+	t99 := x39 - x51
+	t100 := x15 + t96 + t98
+	t101 := real(x15) + 0.3090169943749474*real(t96) - 0.8090169943749473*real(t98)
+	t102 := imag(x15) + 0.3090169943749474*imag(t96) - 0.8090169943749473*imag(t98)
+	t103 := 0.9510565162951536*real(t97) + 0.5877852522924731*real(t99)
+	t104 := 0.9510565162951536*imag(t97) + 0.5877852522924731*imag(t99)
+	t105 := complex(t101-t104, t102+t103)
+	t106 := complex(t101+t104, t102-t103)
+	t107 := real(x15) - 0.8090169943749473*real(t96) + 0.3090169943749474*real(t98)
+	t108 := imag(x15) - 0.8090169943749473*imag(t96) + 0.3090169943749474*imag(t98)
+	t109 := 0.5877852522924731*real(t97) - 0.9510565162951536*real(t99)
+	t110 := 0.5877852522924731*imag(t97) - 0.9510565162951536*imag(t99)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := x47 + x23
+	t114 := x47 - x23
+	t115 := x59 + x11
+	t116 := x59 - x11
+	t117 := x35 + t113 + t115
+	t118 := real(x35) + 0.3090169943749474*real(t113) - 0.8090169943749473*real(t115)
+	t119 := imag(x35) + 0.3090169943749474*imag(t113) - 0.8090169943749473*imag(t115)
+	t120 := 0.9510565162951536*real(t114) + 0.5877852522924731*real(t116)
+	t121 := 0.9510565162951536*imag(t114) + 0.5877852522924731*imag(t116)
+	t122 := complex(t118-t121, t119+t120)
+	t123 := complex(t118+t121, t119-t120)
+	t124 := real(x35) - 0.8090169943749473*real(t113) + 0.3090169943749474*real(t115)
+	t125 := imag(x35) - 0.8090169943749473*imag(t113) + 0.3090169943749474*imag(t115)
+	t126 := 0.5877852522924731*real(t114) - 0.9510565162951536*real(t116)
+	t127 := 0.5877852522924731*imag(t114) - 0.9510565162951536*imag(t116)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := x7 + x43
+	t131 := x7 - x43
+	t132 := x19 + x31
+	t133 := x19 - x31
+	t134 := x55 + t130 + t132
+	t135 := real(x55) + 0.3090169943749474*real(t130) - 0.8090169943749473*real(t132)
+	t136 := imag(x55) + 0.3090169943749474*imag(t130) - 0.8090169943749473*imag(t132)
+	t137 := 0.9510565162951536*real(t131) + 0.5877852522924731*real(t133)
+	t138 := 0.9510565162951536*imag(t131) + 0.5877852522924731*imag(t133)
+	t139 := complex(t135-t138, t136+t137)
+	t140 := complex(t135+t138, t136-t137)
+	t141 := real(x55) - 0.8090169943749473*real(t130) + 0.3090169943749474*real(t132)
+	t142 := imag(x55) - 0.8090169943749473*imag(t130) + 0.3090169943749474*imag(t132)
+	t143 := 0.5877852522924731*real(t131) - 0.9510565162951536*real(t133)
+	t144 := 0.5877852522924731*imag(t131) - 0.9510565162951536*imag(t133)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := t117 + t134
+	t148 := t117 - t134
+	t149 := t100 + t147
+	t150 := real(t100) - 0.49999999999999994*real(t147)
+	t151 := imag(t100) - 0.49999999999999994*imag(t147)
+	t152 := 0.8660254037844387 * real(t148)
+	t153 := 0.8660254037844387 * imag(t148)
+	t154 := complex(t150-t153, t151+t152)
+	t155 := complex(t150+t153, t151-t152)
+	t156 := t123 + t140
+	t157 := t123 - t140
+	t158 := t106 + t156
+	t159 := real(t106) - 0.49999999999999994*real(t156)
+	t160 := imag(t106) - 0.49999999999999994*imag(t156)
+	t161 := 0.8660254037844387 * real(t157)
+	t162 := 0.8660254037844387 * imag(t157)
+	t163 := complex(t159-t162, t160+t161)
+	t164 := complex(t159+t162, t160-t161)
+	t165 := t129 + t146
+	t166 := t129 - t146
+	t167 := t112 + t165
+	t168 := real(t112) - 0.49999999999999994*real(t165)
+	t169 := imag(t112) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := t128 + t145
+	t175 := t128 - t145
+	t176 := t111 + t174
+	t177 := real(t111) - 0.49999999999999994*real(t174)
+	t178 := imag(t111) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := t122 + t139
+	t184 := t122 - t139
+	t185 := t105 + t183
+	t186 := real(t105) - 0.49999999999999994*real(t183)
+	t187 := imag(t105) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := x42 + x18
+	t193 := x42 - x18
+	t194 := x54 + x6
+	t195 := x54 - x6
+	t196 := x30 + t192 + t194
+	t197 := real(x30) + 0.3090169943749474*real(t192) - 0.8090169943749473*real(t194)
+	t198 := imag(x30) + 0.3090169943749474*imag(t192) - 0.8090169943749473*imag(t194)
+	t199 := 0.9510565162951536*real(t193) + 0.5877852522924731*real(t195)
+	t200 := 0.9510565162951536*imag(t193) + 0.5877852522924731*imag(t195)
+	t201 := complex(t197-t200, t198+t199)
+	t202 := complex(t197+t200, t198-t199)
+	t203 := real(x30) - 0.8090169943749473*real(t192) + 0.3090169943749474*real(t194)
+	t204 := imag(x30) - 0.8090169943749473*imag(t192) + 0.3090169943749474*imag(t194)
+	t205 := 0.5877852522924731*real(t193) - 0.9510565162951536*real(t195)
+	t206 := 0.5877852522924731*imag(t193) - 0.9510565162951536*imag(t195)
+	t207 := complex(t203-t206, t204+t205)
+	t208 := complex(t203+t206, t204-t205)
+	t209 := x2 + x38
+	t210 := x2 - x38
+	t211 := x14 + x26
+	t212 := x14 - x26
+	t213 := x50 + t209 + t211
+	t214 := real(x50) + 0.3090169943749474*real(t209) - 0.8090169943749473*real(t211)
+	t215 := imag(x50) + 0.3090169943749474*imag(t209) - 0.8090169943749473*imag(t211)
+	t216 := 0.9510565162951536*real(t210) + 0.5877852522924731*real(t212)
+	t217 := 0.9510565162951536*imag(t210) + 0.5877852522924731*imag(t212)
+	t218 := complex(t214-t217, t215+t216)
+	t219 := complex(t214+t217, t215-t216)
+	t220 := real(x50) - 0.8090169943749473*real(t209) + 0.3090169943749474*real(t211)
+	t221 := imag(x50) - 0.8090169943749473*imag(t209) + 0.3090169943749474*imag(t211)
+	t222 := 0.5877852522924731*real(t210) - 0.9510565162951536*real(t212)
+	t223 := 0.5877852522924731*imag(t210) - 0.9510565162951536*imag(t212)
+	t224 := complex(t220-t223, t221+t222)
+	t225 := complex(t220+t223, t221-t222)
+	t226 := x22 + x58
+	t227 := x22 - x58
+	t228 := x34 + x46
+	t229 := x34 - x46
+	t230 := x10 + t226 + t228
+	t231 := real(x10) + 0.3090169943749474*real(t226) - 0.8090169943749473*real(t228)
+	t232 := imag(x10) + 0.3090169943749474*imag(t226) - 0.8090169943749473*imag(t228)
+	t233 := 0.9510565162951536*real(t227) + 0.5877852522924731*real(t229)
+	t234 := 0.9510565162951536*imag(t227) + 0.5877852522924731*imag(t229)
+	t235 := complex(t231-t234, t232+t233)
+	t236 := complex(t231+t234, t232-t233)
+	t237 := real(x10) - 0.8090169943749473*real(t226) + 0.3090169943749474*real(t228)
+	t238 := imag(x10) - 0.8090169943749473*imag(t226) + 0.3090169943749474*imag(t228)
+	t239 := 0.5877852522924731*real(t227) - 0.9510565162951536*real(t229)
+	t240 := 0.5877852522924731*imag(t227) - 0.9510565162951536*imag(t229)
+	t241 := complex(t237-t240, t238+t239)
+	t242 := complex(t237+t240, t238-t239)
+	t243 := t213 + t230
+	t244 := t213 - t230
+	t245 := t196 + t243
+	t246 := real(t196) - 0.49999999999999994*real(t243)
+	t247 := imag(t196) - 0.49999999999999994*imag(t243)
+	t248 := 0.8660254037844387 * real(t244)
+	t249 := 0.8660254037844387 * imag(t244)
+	t250 := complex(t246-t249, t247+t248)
+	t251 := complex(t246+t249, t247-t248)
+	t252 := t219 + t236
+	t253 := t219 - t236
+	t254 := t202 + t252
+	t255 := real(t202) - 0.49999999999999994*real(t252)
+	t256 := imag(t202) - 0.49999999999999994*imag(t252)
+	t257 := 0.8660254037844387 * real(t253)
+	t258 := 0.8660254037844387 * imag(t253)
+	t259 := complex(t255-t258, t256+t257)
+	t260 := complex(t255+t258, t256-t257)
+	t261 := t225 + t242
+	t262 := t225 - t242
+	t263 := t208 + t261
+	t264 := real(t208) - 0.49999999999999994*real(t261)
+	t265 := imag(t208) - 0.49999999999999994*imag(t261)
+	t266 := 0.8660254037844387 * real(t262)
+	t267 := 0.8660254037844387 * imag(t262)
+	t268 := complex(t264-t267, t265+t266)
+	t269 := complex(t264+t267, t265-t266)
+	t270 := t224 + t241
+	t271 := t224 - t241
+	t272 := t207 + t270
+	t273 := real(t207) - 0.49999999999999994*real(t270)
+	t274 := imag(t207) - 0.49999999999999994*imag(t270)
+	t275 := 0.8660254037844387 * real(t271)
+	t276 := 0.8660254037844387 * imag(t271)
+	t277 := complex(t273-t276, t274+t275)
+	t278 := complex(t273+t276, t274-t275)
+	t279 := t218 + t235
+	t280 := t218 - t235
+	t281 := t201 + t279
+	t282 := real(t201) - 0.49999999999999994*real(t279)
+	t283 := imag(t201) - 0.49999999999999994*imag(t279)
+	t284 := 0.8660254037844387 * real(t280)
+	t285 := 0.8660254037844387 * imag(t280)
+	t286 := complex(t282-t285, t283+t284)
+	t287 := complex(t282+t285, t283-t284)
+	t288 := x57 + x33
+	t289 := x57 - x33
+	t290 := x9 + x21
+	t291 := x9 - x21
+	t292 := x45 + t288 + t290
+	t293 := real(x45) + 0.3090169943749474*real(t288) - 0.8090169943749473*real(t290)
+	t294 := imag(x45) + 0.3090169943749474*imag(t288) - 0.8090169943749473*imag(t290)
+	t295 := 0.9510565162951536*real(t289) + 0.5877852522924731*real(t291)
+	t296 := 0.9510565162951536*imag(t289) + 0.5877852522924731*imag(t291)
+	t297 := complex(t293-t296, t294+t295)
+	t298 := complex(t293+t296, t294-t295)
+	t299 := real(x45) - 0.8090169943749473*real(t288) + 0.3090169943749474*real(t290)
+	t300 := imag(x45) - 0.8090169943749473*imag(t288) + 0.3090169943749474*imag(t290)
+	t301 := 0.5877852522924731*real(t289) - 0.9510565162951536*real(t291)
+	t302 := 0.5877852522924731*imag(t289) - 0.9510565162951536*imag(t291)
+	t303 := complex(t299-t302, t300+t301)
+	t304 := complex(t299+t302, t300-t301)
+	t305 := x17 + x53
+	t306 := x17 - x53
+	t307 := x29 + x41
+	t308 := x29 - x41
+	t309 := x5 + t305 + t307
+	t310 := real(x5) + 0.3090169943749474*real(t305) - 0.8090169943749473*real(t307)
+	t311 := imag(x5) + 0.3090169943749474*imag(t305) - 0.8090169943749473*imag(t307)
+	t312 := 0.9510565162951536*real(t306) + 0.5877852522924731*real(t308)
+	t313 := 0.9510565162951536*imag(t306) + 0.5877852522924731*imag(t308)
+	t314 := complex(t310-t313, t311+t312)
+	t315 := complex(t310+t313, t311-t312)
+	t316 := real(x5) - 0.8090169943749473*real(t305) + 0.3090169943749474*real(t307)
+	t317 := imag(x5) - 0.8090169943749473*imag(t305) + 0.3090169943749474*imag(t307)
+	t318 := 0.5877852522924731*real(t306) - 0.9510565162951536*real(t308)
+	t319 := 0.5877852522924731*imag(t306) - 0.9510565162951536*imag(t308)
+	t320 := complex(t316-t319, t317+t318)
+	t321 := complex(t316+t319, t317-t318)
+	t322 := x37 + x13
+	t323 := x37 - x13
+	t324 := x49 + x1
+	t325 := x49 - x1
+	t326 := x25 + t322 + t324
+	t327 := real(x25) + 0.3090169943749474*real(t322) - 0.8090169943749473*real(t324)
+	t328 := imag(x25) + 0.3090169943749474*imag(t322) - 0.8090169943749473*imag(t324)
+	t329 := 0.9510565162951536*real(t323) + 0.5877852522924731*real(t325)
+	t330 := 0.9510565162951536*imag(t323) + 0.5877852522924731*imag(t325)
+	t331 := complex(t327-t330, t328+t329)
+	t332 := complex(t327+t330, t328-t329)
+	t333 := real(x25) - 0.8090169943749473*real(t322) + 0.3090169943749474*real(t324)
+	t334 := imag(x25) - 0.8090169943749473*imag(t322) + 0.3090169943749474*imag(t324)
+	t335 := 0.5877852522924731*real(t323) - 0.9510565162951536*real(t325)
+	t336 := 0.5877852522924731*imag(t323) - 0.9510565162951536*imag(t325)
+	t337 := complex(t333-t336, t334+t335)
+	t338 := complex(t333+t336, t334-t335)
+	t339 := t309 + t326
+	t340 := t309 - t326
+	t341 := t292 + t339
+	t342 := real(t292) - 0.49999999999999994*real(t339)
+	t343 := imag(t292) - 0.49999999999999994*imag(t339)
+	t344 := 0.8660254037844387 * real(t340)
+	t345 := 0.8660254037844387 * imag(t340)
+	t346 := complex(t342-t345, t343+t344)
+	t347 := complex(t342+t345, t343-t344)
+	t348 := t315 + t332
+	t349 := t315 - t332
+	t350 := t298 + t348
+	t351 := real(t298) - 0.49999999999999994*real(t348)
+	t352 := imag(t298) - 0.49999999999999994*imag(t348)
+	t353 := 0.8660254037844387 * real(t349)
+	t354 := 0.8660254037844387 * imag(t349)
+	t355 := complex(t351-t354, t352+t353)
+	t356 := complex(t351+t354, t352-t353)
+	t357 := t321 + t338
+	t358 := t321 - t338
+	t359 := t304 + t357
+	t360 := real(t304) - 0.49999999999999994*real(t357)
+	t361 := imag(t304) - 0.49999999999999994*imag(t357)
+	t362 := 0.8660254037844387 * real(t358)
+	t363 := 0.8660254037844387 * imag(t358)
+	t364 := complex(t360-t363, t361+t362)
+	t365 := complex(t360+t363, t361-t362)
+	t366 := t320 + t337
+	t367 := t320 - t337
+	t368 := t303 + t366
+	t369 := real(t303) - 0.49999999999999994*real(t366)
+	t370 := imag(t303) - 0.49999999999999994*imag(t366)
+	t371 := 0.8660254037844387 * real(t367)
+	t372 := 0.8660254037844387 * imag(t367)
+	t373 := complex(t369-t372, t370+t371)
+	t374 := complex(t369+t372, t370-t371)
+	t375 := t314 + t331
+	t376 := t314 - t331
+	t377 := t297 + t375
+	t378 := real(t297) - 0.49999999999999994*real(t375)
+	t379 := imag(t297) - 0.49999999999999994*imag(t375)
+	t380 := 0.8660254037844387 * real(t376)
+	t381 := 0.8660254037844387 * imag(t376)
+	t382 := complex(t378-t381, t379+t380)
+	t383 := complex(t378+t381, t379-t380)
+	t384 := t53 + t245
+	t385 := t53 - t245
+	t386 := t149 + t341
+	t387 := t149 - t341
+	t388 := complex(imag(t387), -real(t387))
+	t389 := t384 + t386
+	t390 := t385 + t388
+	t391 := t384 - t386
+	t392 := t385 - t388
+	t393 := t68 + t260
+	t394 := t68 - t260
+	t395 := t164 + t356
+	t396 := t164 - t356
+	t397 := complex(imag(t396), -real(t396))
+	t398 := t393 + t395
+	t399 := t394 + t397
+	t400 := t393 - t395
+	t401 := t394 - t397
+	t402 := t76 + t268
+	t403 := t76 - t268
+	t404 := t172 + t364
+	t405 := t172 - t364
+	t406 := complex(imag(t405), -real(t405))
+	t407 := t402 + t404
+	t408 := t403 + t406
+	t409 := t402 - t404
+	t410 := t403 - t406
+	t411 := t80 + t272
+	t412 := t80 - t272
+	t413 := t176 + t368
+	t414 := t176 - t368
+	t415 := complex(imag(t414), -real(t414))
+	t416 := t411 + t413
+	t417 := t412 + t415
+	t418 := t411 - t413
+	t419 := t412 - t415
+	t420 := t95 + t287
+	t421 := t95 - t287
+	t422 := t191 + t383
+	t423 := t191 - t383
+	t424 := complex(imag(t423), -real(t423))
+	t425 := t420 + t422
+	t426 := t421 + t424
+	t427 := t420 - t422
+	t428 := t421 - t424
+	t429 := t58 + t250
+	t430 := t58 - t250
+	t431 := t154 + t346
+	t432 := t154 - t346
+	t433 := complex(imag(t432), -real(t432))
+	t434 := t429 + t431
+	t435 := t430 + t433
+	t436 := t429 - t431
+	t437 := t430 - t433
+	t438 := t62 + t254
+	t439 := t62 - t254
+	t440 := t158 + t350
+	t441 := t158 - t350
+	t442 := complex(imag(t441), -real(t441))
+	t443 := t438 + t440
+	t444 := t439 + t442
+	t445 := t438 - t440
+	t446 := t439 - t442
+	t447 := t77 + t269
+	t448 := t77 - t269
+	t449 := t173 + t365
+	t450 := t173 - t365
+	t451 := complex(imag(t450), -real(t450))
+	t452 := t447 + t449
+	t453 := t448 + t451
+	t454 := t447 - t449
+	t455 := t448 - t451
+	t456 := t85 + t277
+	t457 := t85 - t277
+	t458 := t181 + t373
+	t459 := t181 - t373
+	t460 := complex(imag(t459), -real(t459))
+	t461 := t456 + t458
+	t462 := t457 + t460
+	t463 := t456 - t458
+	t464 := t457 - t460
+	t465 := t89 + t281
+	t466 := t89 - t281
+	t467 := t185 + t377
+	t468 := t185 - t377
+	t469 := complex(imag(t468), -real(t468))
+	t470 := t465 + t467
+	t471 := t466 + t469
+	t472 := t465 - t467
+	t473 := t466 - t469
+	t474 := t59 + t251
+	t475 := t59 - t251
+	t476 := t155 + t347
+	t477 := t155 - t347
+	t478 := complex(imag(t477), -real(t477))
+	t479 := t474 + t476
+	t480 := t475 + t478
+	t481 := t474 - t476
+	t482 := t475 - t478
+	t483 := t67 + t259
+	t484 := t67 - t259
+	t485 := t163 + t355
+	t486 := t163 - t355
+	t487 := complex(imag(t486), -real(t486))
+	t488 := t483 + t485
+	t489 := t484 + t487
+	t490 := t483 - t485
+	t491 := t484 - t487
+	t492 := t71 + t263
+	t493 := t71 - t263
+	t494 := t167 + t359
+	t495 := t167 - t359
+	t496 := complex(imag(t495), -real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := t86 + t278
+	t502 := t86 - t278
+	t503 := t182 + t374
+	t504 := t182 - t374
+	t505 := complex(imag(t504), -real(t504))
+	t506 := t501 + t503
+	t507 := t502 + t505
+	t508 := t501 - t503
+	t509 := t502 - t505
+	t510 := t94 + t286
+	t511 := t94 - t286
+	t512 := t190 + t382
+	t513 := t190 - t382
+	t514 := complex(imag(t513), -real(t513))
+	t515 := t510 + t512
+	t516 := t511 + t514
+	t517 := t510 - t512
+	t518 := t511 - t514
+
+	d[0] = t389
+	d[1] = t399
+	d[2] = t409
+	d[3] = t419
+	d[4] = t425
+	d[5] = t435
+	d[6] = t445
+	d[7] = t455
+	d[8] = t461
+	d[9] = t471
+	d[10] = t481
+	d[11] = t491
+	d[12] = t497
+	d[13] = t507
+	d[14] = t517
+	d[15] = t392
+	d[16] = t398
+	d[17] = t408
+	d[18] = t418
+	d[19] = t428
+	d[20] = t434
+	d[21] = t444
+	d[22] = t454
+	d[23] = t464
+	d[24] = t470
+	d[25] = t480
+	d[26] = t490
+	d[27] = t500
+	d[28] = t506
+	d[29] = t516
+	d[30] = t391
+	d[31] = t401
+	d[32] = t407
+	d[33] = t417
+	d[34] = t427
+	d[35] = t437
+	d[36] = t443
+	d[37] = t453
+	d[38] = t463
+	d[39] = t473
+	d[40] = t479
+	d[41] = t489
+	d[42] = t499
+	d[43] = t509
+	d[44] = t515
+	d[45] = t390
+	d[46] = t400
+	d[47] = t410
+	d[48] = t416
+	d[49] = t426
+	d[50] = t436
+	d[51] = t446
+	d[52] = t452
+	d[53] = t462
+	d[54] = t472
+	d[55] = t482
+	d[56] = t488
+	d[57] = t498
+	d[58] = t508
+	d[59] = t518
+
+	return true
+}
+
+// inverseDFT60Complex64 computes a 60-point inverse DFT of complex64 data,
+// including the 1/60 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT60Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 60
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+
+	t0 := x12 + x48
+	t1 := x12 - x48
+	t2 := x24 + x36
+	t3 := x24 - x36
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x32 + x8
+	t18 := x32 - x8
+	t19 := x44 + x56
+	t20 := x44 - x56
+	t21 := x20 + t17 + t19
+	t22 := real(x20) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x20) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x20) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x20) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x52 + x28
+	t35 := x52 - x28
+	t36 := x4 + x16
+	t37 := x4 - x16
+	t38 := x40 + t34 + t36
+	t39 := real(x40) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x40) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x40) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x40) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t26 + t43
+	t61 := t26 - t43
+	t62 := t9 + t60
+	t63 := real(t9) - 0.49999999999999994*real(t60)
+	t64 := imag(t9) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t32 + t49
+	t70 := t32 - t49
+	t71 := t15 + t69
+	t72 := real(t15) - 0.49999999999999994*real(t69)
+	t73 := imag(t15) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t33 + t50
+	t79 := t33 - t50
+	t80 := t16 + t78
+	t81 := real(t16) - 0.49999999999999994*real(t78)
+	t82 := imag(t16) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t27 + t44
+	t88 := t27 - t44
+	t89 := t10 + t87
+	t90 := real(t10) - 0.49999999999999994*real(t87)
+	t91 := imag(t10) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := x27 + x3
+	t97 := x27 - x3
+	t98 := x39 + x51
+	t99 := x39 - x51
+	t100 := x15 + t96 + t98
+	t101 := real(x15) + 0.3090169943749474*real(t96) - 0.8090169943749473*real(t98)
+	t102 := imag(x15) + 0.3090169943749474*imag(t96) - 0.8090169943749473*imag(t98)
+	t103 := 0.9510565162951536*real(t97) + 0.5877852522924731*real(t99)
+	t104 := 0.9510565162951536*imag(t97) + 0.5877852522924731*imag(t99)
+	t105 := complex(t101-t104, t102+t103)
+	t106 := complex(t101+t104, t102-t103)
+	t107 := real(x15) - 0.8090169943749473*real(t96) + 0.3090169943749474*real(t98)
+	t108 := imag(x15) - 0.8090169943749473*imag(t96) + 0.3090169943749474*imag(t98)
+	t109 := 0.5877852522924731*real(t97) - 0.9510565162951536*real(t99)
+	t110 := 0.5877852522924731*imag(t97) - 0.9510565162951536*imag(t99)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := x47 + x23
+	t114 := x47 - x23
+	t115 := x59 + x11
+	t116 := x59 - x11
+	t117 := x35 + t113 + t115
+	t118 := real(x35) + 0.3090169943749474*real(t113) - 0.8090169943749473*real(t115)
+	t119 := imag(x35) + 0.3090169943749474*imag(t113) - 0.8090169943749473*imag(t115)
+	t120 := 0.9510565162951536*real(t114) + 0.5877852522924731*real(t116)
+	t121 := 0.9510565162951536*imag(t114) + 0.5877852522924731*imag(t116)
+	t122 := complex(t118-t121, t119+t120)
+	t123 := complex(t118+t121, t119-t120)
+	t124 := real(x35) - 0.8090169943749473*real(t113) + 0.3090169943749474*real(t115)
+	t125 := imag(x35) - 0.8090169943749473*imag(t113) + 0.3090169943749474*imag(t115)
+	t126 := 0.5877852522924731*real(t114) - 0.9510565162951536*real(t116)
+	t127 := 0.5877852522924731*imag(t114) - 0.9510565162951536*imag(t116)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := x7 + x43
+	t131 := x7 - x43
+	t132 := x19 + x31
+	t133 := x19 - x31
+	t134 := x55 + t130 + t132
+	t135 := real(x55) + 0.3090169943749474*real(t130) - 0.8090169943749473*real(t132)
+	t136 := imag(x55) + 0.3090169943749474*imag(t130) - 0.8090169943749473*imag(t132)
+	t137 := 0.9510565162951536*real(t131) + 0.5877852522924731*real(t133)
+	t138 := 0.9510565162951536*imag(t131) + 0.5877852522924731*imag(t133)
+	t139 := complex(t135-t138, t136+t137)
+	t140 := complex(t135+t138, t136-t137)
+	t141 := real(x55) - 0.8090169943749473*real(t130) + 0.3090169943749474*real(t132)
+	t142 := imag(x55) - 0.8090169943749473*imag(t130) + 0.3090169943749474*imag(t132)
+	t143 := 0.5877852522924731*real(t131) - 0.9510565162951536*real(t133)
+	t144 := 0.5877852522924731*imag(t131) - 0.9510565162951536*imag(t133)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := t117 + t134
+	t148 := t117 - t134
+	t149 := t100 + t147
+	t150 := real(t100) - 0.49999999999999994*real(t147)
+	t151 := imag(t100) - 0.49999999999999994*imag(t147)
+	t152 := 0.8660254037844387 * real(t148)
+	t153 := 0.8660254037844387 * imag(t148)
+	t154 := complex(t150-t153, t151+t152)
+	t155 := complex(t150+t153, t151-t152)
+	t156 := t122 + t139
+	t157 := t122 - t139
+	t158 := t105 + t156
+	t159 := real(t105) - 0.49999999999999994*real(t156)
+	t160 := imag(t105) - 0.49999999999999994*imag(t156)
+	t161 := 0.8660254037844387 * real(t157)
+	t162 := 0.8660254037844387 * imag(t157)
+	t163 := complex(t159-t162, t160+t161)
+	t164 := complex(t159+t162, t160-t161)
+	t165 := t128 + t145
+	t166 := t128 - t145
+	t167 := t111 + t165
+	t168 := real(t111) - 0.49999999999999994*real(t165)
+	t169 := imag(t111) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := t129 + t146
+	t175 := t129 - t146
+	t176 := t112 + t174
+	t177 := real(t112) - 0.49999999999999994*real(t174)
+	t178 := imag(t112) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := t123 + t140
+	t184 := t123 - t140
+	t185 := t106 + t183
+	t186 := real(t106) - 0.49999999999999994*real(t183)
+	t187 := imag(t106) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := x42 + x18
+	t193 := x42 - x18
+	t194 := x54 + x6
+	t195 := x54 - x6
+	t196 := x30 + t192 + t194
+	t197 := real(x30) + 0.3090169943749474*real(t192) - 0.8090169943749473*real(t194)
+	t198 := imag(x30) + 0.3090169943749474*imag(t192) - 0.8090169943749473*imag(t194)
+	t199 := 0.9510565162951536*real(t193) + 0.5877852522924731*real(t195)
+	t200 := 0.9510565162951536*imag(t193) + 0.5877852522924731*imag(t195)
+	t201 := complex(t197-t200, t198+t199)
+	t202 := complex(t197+t200, t198-t199)
+	t203 := real(x30) - 0.8090169943749473*real(t192) + 0.3090169943749474*real(t194)
+	t204 := imag(x30) - 0.8090169943749473*imag(t192) + 0.3090169943749474*imag(t194)
+	t205 := 0.5877852522924731*real(t193) - 0.9510565162951536*real(t195)
+	t206 := 0.5877852522924731*imag(t193) - 0.9510565162951536*imag(t195)
+	t207 := complex(t203-t206, t204+t205)
+	t208 := complex(t203+t206, t204-t205)
+	t209 := x2 + x38
+	t210 := x2 - x38
+	t211 := x14 + x26
+	t212 := x14 - x26
+	t213 := x50 + t209 + t211
+	t214 := real(x50) + 0.3090169943749474*real(t209) - 0.8090169943749473*real(t211)
+	t215 := imag(x50) + 0.3090169943749474*imag(t209) - 0.8090169943749473*imag(t211)
+	t216 := 0.9510565162951536*real(t210) + 0.5877852522924731*real(t212)
+	t217 := 0.9510565162951536*imag(t210) + 0.5877852522924731*imag(t212)
+	t218 := complex(t214-t217, t215+t216)
+	t219 := complex(t214+t217, t215-t216)
+	t220 := real(x50) - 0.8090169943749473*real(t209) + 0.3090169943749474*real(t211)
+	t221 := imag(x50) - 0.8090169943749473*imag(t209) + 0.3090169943749474*imag(t211)
+	t222 := 0.5877852522924731*real(t210) - 0.9510565162951536*real(t212)
+	t223 := 0.5877852522924731*imag(t210) - 0.9510565162951536*imag(t212)
+	t224 := complex(t220-t223, t221+t222)
+	t225 := complex(t220+t223, t221-t222)
+	t226 := x22 + x58
+	t227 := x22 - x58
+	t228 := x34 + x46
+	t229 := x34 - x46
+	t230 := x10 + t226 + t228
+	t231 := real(x10) + 0.3090169943749474*real(t226) - 0.8090169943749473*real(t228)
+	t232 := imag(x10) + 0.3090169943749474*imag(t226) - 0.8090169943749473*imag(t228)
+	t233 := 0.9510565162951536*real(t227) + 0.5877852522924731*real(t229)
+	t234 := 0.9510565162951536*imag(t227) + 0.5877852522924731*imag(t229)
+	t235 := complex(t231-t234, t232+t233)
+	t236 := complex(t231+t234, t232-t233)
+	t237 := real(x10) - 0.8090169943749473*real(t226) + 0.3090169943749474*real(t228)
+	t238 := imag(x10) - 0.8090169943749473*imag(t226) + 0.3090169943749474*imag(t228)
+	t239 := 0.5877852522924731*real(t227) - 0.9510565162951536*real(t229)
+	t240 := 0.5877852522924731*imag(t227) - 0.9510565162951536*imag(t229)
+	t241 := complex(t237-t240, t238+t239)
+	t242 := complex(t237+t240, t238-t239)
+	t243 := t213 + t230
+	t244 := t213 - t230
+	t245 := t196 + t243
+	t246 := real(t196) - 0.49999999999999994*real(t243)
+	t247 := imag(t196) - 0.49999999999999994*imag(t243)
+	t248 := 0.8660254037844387 * real(t244)
+	t249 := 0.8660254037844387 * imag(t244)
+	t250 := complex(t246-t249, t247+t248)
+	t251 := complex(t246+t249, t247-t248)
+	t252 := t218 + t235
+	t253 := t218 - t235
+	t254 := t201 + t252
+	t255 := real(t201) - 0.49999999999999994*real(t252)
+	t256 := imag(t201) - 0.49999999999999994*imag(t252)
+	t257 := 0.8660254037844387 * real(t253)
+	t258 := 0.8660254037844387 * imag(t253)
+	t259 := complex(t255-t258, t256+t257)
+	t260 := complex(t255+t258, t256-t257)
+	t261 := t224 + t241
+	t262 := t224 - t241
+	t263 := t207 + t261
+	t264 := real(t207) - 0.49999999999999994*real(t261)
+	t265 := imag(t207) - 0.49999999999999994*imag(t261)
+	t266 := 0.8660254037844387 * real(t262)
+	t267 := 0.8660254037844387 * imag(t262)
+	t268 := complex(t264-t267, t265+t266)
+	t269 := complex(t264+t267, t265-t266)
+	t270 := t225 + t242
+	t271 := t225 - t242
+	t272 := t208 + t270
+	t273 := real(t208) - 0.49999999999999994*real(t270)
+	t274 := imag(t208) - 0.49999999999999994*imag(t270)
+	t275 := 0.8660254037844387 * real(t271)
+	t276 := 0.8660254037844387 * imag(t271)
+	t277 := complex(t273-t276, t274+t275)
+	t278 := complex(t273+t276, t274-t275)
+	t279 := t219 + t236
+	t280 := t219 - t236
+	t281 := t202 + t279
+	t282 := real(t202) - 0.49999999999999994*real(t279)
+	t283 := imag(t202) - 0.49999999999999994*imag(t279)
+	t284 := 0.8660254037844387 * real(t280)
+	t285 := 0.8660254037844387 * imag(t280)
+	t286 := complex(t282-t285, t283+t284)
+	t287 := complex(t282+t285, t283-t284)
+	t288 := x57 + x33
+	t289 := x57 - x33
+	t290 := x9 + x21
+	t291 := x9 - x21
+	t292 := x45 + t288 + t290
+	t293 := real(x45) + 0.3090169943749474*real(t288) - 0.8090169943749473*real(t290)
+	t294 := imag(x45) + 0.3090169943749474*imag(t288) - 0.8090169943749473*imag(t290)
+	t295 := 0.9510565162951536*real(t289) + 0.5877852522924731*real(t291)
+	t296 := 0.9510565162951536*imag(t289) + 0.5877852522924731*imag(t291)
+	t297 := complex(t293-t296, t294+t295)
+	t298 := complex(t293+t296, t294-t295)
+	t299 := real(x45) - 0.8090169943749473*real(t288) + 0.3090169943749474*real(t290)
+	t300 := imag(x45) - 0.8090169943749473*imag(t288) + 0.3090169943749474*imag(t290)
+	t301 := 0.5877852522924731*real(t289) - 0.9510565162951536*real(t291)
+	t302 := 0.5877852522924731*imag(t289) - 0.9510565162951536*imag(t291)
+	t303 := complex(t299-t302, t300+t301)
+	t304 := complex(t299+t302, t300-t301)
+	t305 := x17 + x53
+	t306 := x17 - x53
+	t307 := x29 + x41
+	t308 := x29 - x41
+	t309 := x5 + t305 + t307
+	t310 := real(x5) + 0.3090169943749474*real(t305) - 0.8090169943749473*real(t307)
+	t311 := imag(x5) + 0.3090169943749474*imag(t305) - 0.8090169943749473*imag(t307)
+	t312 := 0.9510565162951536*real(t306) + 0.5877852522924731*real(t308)
+	t313 := 0.9510565162951536*imag(t306) + 0.5877852522924731*imag(t308)
+	t314 := complex(t310-t313, t311+t312)
+	t315 := complex(t310+t313, t311-t312)
+	t316 := real(x5) - 0.8090169943749473*real(t305) + 0.3090169943749474*real(t307)
+	t317 := imag(x5) - 0.8090169943749473*imag(t305) + 0.3090169943749474*imag(t307)
+	t318 := 0.5877852522924731*real(t306) - 0.9510565162951536*real(t308)
+	t319 := 0.5877852522924731*imag(t306) - 0.9510565162951536*imag(t308)
+	t320 := complex(t316-t319, t317+t318)
+	t321 := complex(t316+t319, t317-t318)
+	t322 := x37 + x13
+	t323 := x37 - x13
+	t324 := x49 + x1
+	t325 := x49 - x1
+	t326 := x25 + t322 + t324
+	t327 := real(x25) + 0.3090169943749474*real(t322) - 0.8090169943749473*real(t324)
+	t328 := imag(x25) + 0.3090169943749474*imag(t322) - 0.8090169943749473*imag(t324)
+	t329 := 0.9510565162951536*real(t323) + 0.5877852522924731*real(t325)
+	t330 := 0.9510565162951536*imag(t323) + 0.5877852522924731*imag(t325)
+	t331 := complex(t327-t330, t328+t329)
+	t332 := complex(t327+t330, t328-t329)
+	t333 := real(x25) - 0.8090169943749473*real(t322) + 0.3090169943749474*real(t324)
+	t334 := imag(x25) - 0.8090169943749473*imag(t322) + 0.3090169943749474*imag(t324)
+	t335 := 0.5877852522924731*real(t323) - 0.9510565162951536*real(t325)
+	t336 := 0.5877852522924731*imag(t323) - 0.9510565162951536*imag(t325)
+	t337 := complex(t333-t336, t334+t335)
+	t338 := complex(t333+t336, t334-t335)
+	t339 := t309 + t326
+	t340 := t309 - t326
+	t341 := t292 + t339
+	t342 := real(t292) - 0.49999999999999994*real(t339)
+	t343 := imag(t292) - 0.49999999999999994*imag(t339)
+	t344 := 0.8660254037844387 * real(t340)
+	t345 := 0.8660254037844387 * imag(t340)
+	t346 := complex(t342-t345, t343+t344)
+	t347 := complex(t342+t345, t343-t344)
+	t348 := t314 + t331
+	t349 := t314 - t331
+	t350 := t297 + t348
+	t351 := real(t297) - 0.49999999999999994*real(t348)
+	t352 := imag(t297) - 0.49999999999999994*imag(t348)
+	t353 := 0.8660254037844387 * real(t349)
+	t354 := 0.8660254037844387 * imag(t349)
+	t355 := complex(t351-t354, t352+t353)
+	t356 := complex(t351+t354, t352-t353)
+	t357 := t320 + t337
+	t358 := t320 - t337
+	t359 := t303 + t357
+	t360 := real(t303) - 0.49999999999999994*real(t357)
+	t361 := imag(t303) - 0.49999999999999994*imag(t357)
+	t362 := 0.8660254037844387 * real(t358)
+	t363 := 0.8660254037844387 * imag(t358)
+	t364 := complex(t360-t363, t361+t362)
+	t365 := complex(t360+t363, t361-t362)
+	t366 := t321 + t338
+	t367 := t321 - t338
+	t368 := t304 + t366
+	t369 := real(t304) - 0.49999999999999994*real(t366)
+	t370 := imag(t304) - 0.49999999999999994*imag(t366)
+	t371 := 0.8660254037844387 * real(t367)
+	t372 := 0.8660254037844387 * imag(t367)
+	t373 := complex(t369-t372, t370+t371)
+	t374 := complex(t369+t372, t370-t371)
+	t375 := t315 + t332
+	t376 := t315 - t332
+	t377 := t298 + t375
+	t378 := real(t298) - 0.49999999999999994*real(t375)
+	t379 := imag(t298) - 0.49999999999999994*imag(t375)
+	t380 := 0.8660254037844387 * real(t376)
+	t381 := 0.8660254037844387 * imag(t376)
+	t382 := complex(t378-t381, t379+t380)
+	t383 := complex(t378+t381, t379-t380)
+	t384 := t53 + t245
+	t385 := t53 - t245
+	t386 := t149 + t341
+	t387 := t149 - t341
+	t388 := complex(-imag(t387), real(t387))
+	t389 := t384 + t386
+	t390 := t385 + t388
+	t391 := t384 - t386
+	t392 := t385 - t388
+	t393 := t67 + t259
+	t394 := t67 - t259
+	t395 := t163 + t355
+	t396 := t163 - t355
+	t397 := complex(-imag(t396), real(t396))
+	t398 := t393 + t395
+	t399 := t394 + t397
+	t400 := t393 - t395
+	t401 := t394 - t397
+	t402 := t77 + t269
+	t403 := t77 - t269
+	t404 := t173 + t365
+	t405 := t173 - t365
+	t406 := complex(-imag(t405), real(t405))
+	t407 := t402 + t404
+	t408 := t403 + t406
+	t409 := t402 - t404
+	t410 := t403 - t406
+	t411 := t80 + t272
+	t412 := t80 - t272
+	t413 := t176 + t368
+	t414 := t176 - t368
+	t415 := complex(-imag(t414), real(t414))
+	t416 := t411 + t413
+	t417 := t412 + t415
+	t418 := t411 - t413
+	t419 := t412 - t415
+	t420 := t94 + t286
+	t421 := t94 - t286
+	t422 := t190 + t382
+	t423 := t190 - t382
+	t424 := complex(-imag(t423), real(t423))
+	t425 := t420 + t422
+	t426 := t421 + t424
+	t427 := t420 - t422
+	t428 := t421 - t424
+	t429 := t59 + t251
+	t430 := t59 - t251
+	t431 := t155 + t347
+	t432 := t155 - t347
+	t433 := complex(-imag(t432), real(t432))
+	t434 := t429 + t431
+	t435 := t430 + t433
+	t436 := t429 - t431
+	t437 := t430 - t433
+	t438 := t62 + t254
+	t439 := t62 - t254
+	t440 := t158 + t350
+	t441 := t158 - t350
+	t442 := complex(-imag(t441), real(t441))
+	t443 := t438 + t440
+	t444 := t439 + t442
+	t445 := t438 - t440
+	t446 := t439 - t442
+	t447 := t76 + t268
+	t448 := t76 - t268
+	t449 := t172 + t364
+	t450 := t172 - t364
+	t451 := complex(-imag(t450), real(t450))
+	t452 := t447 + t449
+	t453 := t448 + t451
+	t454 := t447 - t449
+	t455 := t448 - t451
+	t456 := t86 + t278
+	t457 := t86 - t278
+	t458 := t182 + t374
+	t459 := t182 - t374
+	t460 := complex(-imag(t459), real(t459))
+	t461 := t456 + t458
+	t462 := t457 + t460
+	t463 := t456 - t458
+	t464 := t457 - t460
+	t465 := t89 + t281
+	t466 := t89 - t281
+	t467 := t185 + t377
+	t468 := t185 - t377
+	t469 := complex(-imag(t468), real(t468))
+	t470 := t465 + t467
+	t471 := t466 + t469
+	t472 := t465 - t467
+	t473 := t466 - t469
+	t474 := t58 + t250
+	t475 := t58 - t250
+	t476 := t154 + t346
+	t477 := t154 - t346
+	t478 := complex(-imag(t477), real(t477))
+	t479 := t474 + t476
+	t480 := t475 + t478
+	t481 := t474 - t476
+	t482 := t475 - t478
+	t483 := t68 + t260
+	t484 := t68 - t260
+	t485 := t164 + t356
+	t486 := t164 - t356
+	t487 := complex(-imag(t486), real(t486))
+	t488 := t483 + t485
+	t489 := t484 + t487
+	t490 := t483 - t485
+	t491 := t484 - t487
+	t492 := t71 + t263
+	t493 := t71 - t263
+	t494 := t167 + t359
+	t495 := t167 - t359
+	t496 := complex(-imag(t495), real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := t85 + t277
+	t502 := t85 - t277
+	t503 := t181 + t373
+	t504 := t181 - t373
+	t505 := complex(-imag(t504), real(t504))
+	t506 := t501 + t503
+	t507 := t502 + t505
+	t508 := t501 - t503
+	t509 := t502 - t505
+	t510 := t95 + t287
+	t511 := t95 - t287
+	t512 := t191 + t383
+	t513 := t191 - t383
+	t514 := complex(-imag(t513), real(t513))
+	t515 := t510 + t512
+	t516 := t511 + t514
+	t517 := t510 - t512
+	t518 := t511 - t514
+
+	d[0] = complex(real(t389)*0.016666666666666666, imag(t389)*0.016666666666666666)
+	d[1] = complex(real(t399)*0.016666666666666666, imag(t399)*0.016666666666666666)
+	d[2] = complex(real(t409)*0.016666666666666666, imag(t409)*0.016666666666666666)
+	d[3] = complex(real(t419)*0.016666666666666666, imag(t419)*0.016666666666666666)
+	d[4] = complex(real(t425)*0.016666666666666666, imag(t425)*0.016666666666666666)
+	d[5] = complex(real(t435)*0.016666666666666666, imag(t435)*0.016666666666666666)
+	d[6] = complex(real(t445)*0.016666666666666666, imag(t445)*0.016666666666666666)
+	d[7] = complex(real(t455)*0.016666666666666666, imag(t455)*0.016666666666666666)
+	d[8] = complex(real(t461)*0.016666666666666666, imag(t461)*0.016666666666666666)
+	d[9] = complex(real(t471)*0.016666666666666666, imag(t471)*0.016666666666666666)
+	d[10] = complex(real(t481)*0.016666666666666666, imag(t481)*0.016666666666666666)
+	d[11] = complex(real(t491)*0.016666666666666666, imag(t491)*0.016666666666666666)
+	d[12] = complex(real(t497)*0.016666666666666666, imag(t497)*0.016666666666666666)
+	d[13] = complex(real(t507)*0.016666666666666666, imag(t507)*0.016666666666666666)
+	d[14] = complex(real(t517)*0.016666666666666666, imag(t517)*0.016666666666666666)
+	d[15] = complex(real(t392)*0.016666666666666666, imag(t392)*0.016666666666666666)
+	d[16] = complex(real(t398)*0.016666666666666666, imag(t398)*0.016666666666666666)
+	d[17] = complex(real(t408)*0.016666666666666666, imag(t408)*0.016666666666666666)
+	d[18] = complex(real(t418)*0.016666666666666666, imag(t418)*0.016666666666666666)
+	d[19] = complex(real(t428)*0.016666666666666666, imag(t428)*0.016666666666666666)
+	d[20] = complex(real(t434)*0.016666666666666666, imag(t434)*0.016666666666666666)
+	d[21] = complex(real(t444)*0.016666666666666666, imag(t444)*0.016666666666666666)
+	d[22] = complex(real(t454)*0.016666666666666666, imag(t454)*0.016666666666666666)
+	d[23] = complex(real(t464)*0.016666666666666666, imag(t464)*0.016666666666666666)
+	d[24] = complex(real(t470)*0.016666666666666666, imag(t470)*0.016666666666666666)
+	d[25] = complex(real(t480)*0.016666666666666666, imag(t480)*0.016666666666666666)
+	d[26] = complex(real(t490)*0.016666666666666666, imag(t490)*0.016666666666666666)
+	d[27] = complex(real(t500)*0.016666666666666666, imag(t500)*0.016666666666666666)
+	d[28] = complex(real(t506)*0.016666666666666666, imag(t506)*0.016666666666666666)
+	d[29] = complex(real(t516)*0.016666666666666666, imag(t516)*0.016666666666666666)
+	d[30] = complex(real(t391)*0.016666666666666666, imag(t391)*0.016666666666666666)
+	d[31] = complex(real(t401)*0.016666666666666666, imag(t401)*0.016666666666666666)
+	d[32] = complex(real(t407)*0.016666666666666666, imag(t407)*0.016666666666666666)
+	d[33] = complex(real(t417)*0.016666666666666666, imag(t417)*0.016666666666666666)
+	d[34] = complex(real(t427)*0.016666666666666666, imag(t427)*0.016666666666666666)
+	d[35] = complex(real(t437)*0.016666666666666666, imag(t437)*0.016666666666666666)
+	d[36] = complex(real(t443)*0.016666666666666666, imag(t443)*0.016666666666666666)
+	d[37] = complex(real(t453)*0.016666666666666666, imag(t453)*0.016666666666666666)
+	d[38] = complex(real(t463)*0.016666666666666666, imag(t463)*0.016666666666666666)
+	d[39] = complex(real(t473)*0.016666666666666666, imag(t473)*0.016666666666666666)
+	d[40] = complex(real(t479)*0.016666666666666666, imag(t479)*0.016666666666666666)
+	d[41] = complex(real(t489)*0.016666666666666666, imag(t489)*0.016666666666666666)
+	d[42] = complex(real(t499)*0.016666666666666666, imag(t499)*0.016666666666666666)
+	d[43] = complex(real(t509)*0.016666666666666666, imag(t509)*0.016666666666666666)
+	d[44] = complex(real(t515)*0.016666666666666666, imag(t515)*0.016666666666666666)
+	d[45] = complex(real(t390)*0.016666666666666666, imag(t390)*0.016666666666666666)
+	d[46] = complex(real(t400)*0.016666666666666666, imag(t400)*0.016666666666666666)
+	d[47] = complex(real(t410)*0.016666666666666666, imag(t410)*0.016666666666666666)
+	d[48] = complex(real(t416)*0.016666666666666666, imag(t416)*0.016666666666666666)
+	d[49] = complex(real(t426)*0.016666666666666666, imag(t426)*0.016666666666666666)
+	d[50] = complex(real(t436)*0.016666666666666666, imag(t436)*0.016666666666666666)
+	d[51] = complex(real(t446)*0.016666666666666666, imag(t446)*0.016666666666666666)
+	d[52] = complex(real(t452)*0.016666666666666666, imag(t452)*0.016666666666666666)
+	d[53] = complex(real(t462)*0.016666666666666666, imag(t462)*0.016666666666666666)
+	d[54] = complex(real(t472)*0.016666666666666666, imag(t472)*0.016666666666666666)
+	d[55] = complex(real(t482)*0.016666666666666666, imag(t482)*0.016666666666666666)
+	d[56] = complex(real(t488)*0.016666666666666666, imag(t488)*0.016666666666666666)
+	d[57] = complex(real(t498)*0.016666666666666666, imag(t498)*0.016666666666666666)
+	d[58] = complex(real(t508)*0.016666666666666666, imag(t508)*0.016666666666666666)
+	d[59] = complex(real(t518)*0.016666666666666666, imag(t518)*0.016666666666666666)
+
+	return true
+}
+
+// forwardDFT60Complex128 computes a 60-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT60Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 60
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+
+	t0 := x12 + x48
+	t1 := x12 - x48
+	t2 := x24 + x36
+	t3 := x24 - x36
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x32 + x8
+	t18 := x32 - x8
+	t19 := x44 + x56
+	t20 := x44 - x56
+	t21 := x20 + t17 + t19
+	t22 := real(x20) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x20) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x20) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x20) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x52 + x28
+	t35 := x52 - x28
+	t36 := x4 + x16
+	t37 := x4 - x16
+	t38 := x40 + t34 + t36
+	t39 := real(x40) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x40) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x40) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x40) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t27 + t44
+	t61 := t27 - t44
+	t62 := t10 + t60
+	t63 := real(t10) - 0.49999999999999994*real(t60)
+	t64 := imag(t10) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t33 + t50
+	t70 := t33 - t50
+	t71 := t16 + t69
+	t72 := real(t16) - 0.49999999999999994*real(t69)
+	t73 := imag(t16) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t32 + t49
+	t79 := t32 - t49
+	t80 := t15 + t78
+	t81 := real(t15) - 0.49999999999999994*real(t78)
+	t82 := imag(t15) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t26 + t43
+	t88 := t26 - t43
+	t89 := t9 + t87
+	t90 := real(t9) - 0.49999999999999994*real(t87)
+	t91 := imag(t9) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := x27 + x3
+	t97 := x27 - x3
+	t98 := x39 + x51
+	t99 := x39 - x51
+	t100 := x15 + t96 + t98
+	t101 := real(x15) + 0.3090169943749474*real(t96) - 0.8090169943749473*real(t98)
+	t102 := imag(x15) + 0.3090169943749474*imag(t96) - 0.8090169943749473*imag(t98)
+	t103 := 0.9510565162951536*real(t97) + 0.5877852522924731*real(t99)
+	t104 := 0.9510565162951536*imag(t97) + 0.5877852522924731*imag(t99)
+	t105 := complex(t101-t104, t102+t103)
+	t106 := complex(t101+t104, t102-t103)
+	t107 := real(x15) - 0.8090169943749473*real(t96) + 0.3090169943749474*real(t98)
+	t108 := imag(x15) - 0.8090169943749473*imag(t96) + 0.3090169943749474*imag(t98)
+	t109 := 0.5877852522924731*real(t97) - 0.9510565162951536*real(t99)
+	t110 := 0.5877852522924731*imag(t97) - 0.9510565162951536*imag(t99)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := x47 + x23
+	t114 := x47 - x23
+	t115 := x59 + x11
+	t116 := x59 - x11
+	t117 := x35 + t113 + t115
+	t118 := real(x35) + 0.3090169943749474*real(t113) - 0.8090169943749473*real(t115)
+	t119 := imag(x35) + 0.3090169943749474*imag(t113) - 0.8090169943749473*imag(t115)
+	t120 := 0.9510565162951536*real(t114) + 0.5877852522924731*real(t116)
+	t121 := 0.9510565162951536*imag(t114) + 0.5877852522924731*imag(t116)
+	t122 := complex(t118-t121, t119+t120)
+	t123 := complex(t118+t121, t119-t120)
+	t124 := real(x35) - 0.8090169943749473*real(t113) + 0.3090169943749474*real(t115)
+	t125 := imag(x35) - 0.8090169943749473*imag(t113) + 0.3090169943749474*imag(t115)
+	t126 := 0.5877852522924731*real(t114) - 0.9510565162951536*real(t116)
+	t127 := 0.5877852522924731*imag(t114) - 0.9510565162951536*imag(t116)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := x7 + x43
+	t131 := x7 - x43
+	t132 := x19 + x31
+	t133 := x19 - x31
+	t134 := x55 + t130 + t132
+	t135 := real(x55) + 0.3090169943749474*real(t130) - 0.8090169943749473*real(t132)
+	t136 := imag(x55) + 0.3090169943749474*imag(t130) - 0.8090169943749473*imag(t132)
+	t137 := 0.9510565162951536*real(t131) + 0.5877852522924731*real(t133)
+	t138 := 0.9510565162951536*imag(t131) + 0.5877852522924731*imag(t133)
+	t139 := complex(t135-t138, t136+t137)
+	t140 := complex(t135+t138, t136-t137)
+	t141 := real(x55) - 0.8090169943749473*real(t130) + 0.3090169943749474*real(t132)
+	t142 := imag(x55) - 0.8090169943749473*imag(t130) + 0.3090169943749474*imag(t132)
+	t143 := 0.5877852522924731*real(t131) - 0.9510565162951536*real(t133)
+	t144 := 0.5877852522924731*imag(t131) - 0.9510565162951536*imag(t133)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := t117 + t134
+	t148 := t117 - t134
+	t149 := t100 + t147
+	t150 := real(t100) - 0.49999999999999994*real(t147)
+	t151 := imag(t100) - 0.49999999999999994*imag(t147)
+	t152 := 0.8660254037844387 * real(t148)
+	t153 := 0.8660254037844387 * imag(t148)
+	t154 := complex(t150-t153, t151+t152)
+	t155 := complex(t150+t153, t151-t152)
+	t156 := t123 + t140
+	t157 := t123 - t140
+	t158 := t106 + t156
+	t159 := real(t106) - 0.49999999999999994*real(t156)
+	t160 := imag(t106) - 0.49999999999999994*imag(t156)
+	t161 := 0.8660254037844387 * real(t157)
+	t162 := 0.8660254037844387 * imag(t157)
+	t163 := complex(t159-t162, t160+t161)
+	t164 := complex(t159+t162, t160-t161)
+	t165 := t129 + t146
+	t166 := t129 - t146
+	t167 := t112 + t165
+	t168 := real(t112) - 0.49999999999999994*real(t165)
+	t169 := imag(t112) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := t128 + t145
+	t175 := t128 - t145
+	t176 := t111 + t174
+	t177 := real(t111) - 0.49999999999999994*real(t174)
+	t178 := imag(t111) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := t122 + t139
+	t184 := t122 - t139
+	t185 := t105 + t183
+	t186 := real(t105) - 0.49999999999999994*real(t183)
+	t187 := imag(t105) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := x42 + x18
+	t193 := x42 - x18
+	t194 := x54 + x6
+	t195 := x54 - x6
+	t196 := x30 + t192 + t194
+	t197 := real(x30) + 0.3090169943749474*real(t192) - 0.8090169943749473*real(t194)
+	t198 := imag(x30) + 0.3090169943749474*imag(t192) - 0.8090169943749473*imag(t194)
+	t199 := 0.9510565162951536*real(t193) + 0.5877852522924731*real(t195)
+	t200 := 0.9510565162951536*imag(t193) + 0.5877852522924731*imag(t195)
+	t201 := complex(t197-t200, t198+t199)
+	t202 := complex(t197+t200, t198-t199)
+	t203 := real(x30) - 0.8090169943749473*real(t192) + 0.3090169943749474*real(t194)
+	t204 := imag(x30) - 0.8090169943749473*imag(t192) + 0.3090169943749474*imag(t194)
+	t205 := 0.5877852522924731*real(t193) - 0.9510565162951536*real(t195)
+	t206 := 0.5877852522924731*imag(t193) - 0.9510565162951536*imag(t195)
+	t207 := complex(t203-t206, t204+t205)
+	t208 := complex(t203+t206, t204-t205)
+	t209 := x2 + x38
+	t210 := x2 - x38
+	t211 := x14 + x26
+	t212 := x14 - x26
+	t213 := x50 + t209 + t211
+	t214 := real(x50) + 0.3090169943749474*real(t209) - 0.8090169943749473*real(t211)
+	t215 := imag(x50) + 0.3090169943749474*imag(t209) - 0.8090169943749473*imag(t211)
+	t216 := 0.9510565162951536*real(t210) + 0.5877852522924731*real(t212)
+	t217 := 0.9510565162951536*imag(t210) + 0.5877852522924731*imag(t212)
+	t218 := complex(t214-t217, t215+t216)
+	t219 := complex(t214+t217, t215-t216)
+	t220 := real(x50) - 0.8090169943749473*real(t209) + 0.3090169943749474*real(t211)
+	t221 := imag(x50) - 0.8090169943749473*imag(t209) + 0.3090169943749474*imag(t211)
+	t222 := 0.5877852522924731*real(t210) - 0.9510565162951536*real(t212)
+	t223 := 0.5877852522924731*imag(t210) - 0.9510565162951536*imag(t212)
+	t224 := complex(t220-t223, t221+t222)
+	t225 := complex(t220+t223, t221-t222)
+	t226 := x22 + x58
+	t227 := x22 - x58
+	t228 := x34 + x46
+	t229 := x34 - x46
+	t230 := x10 + t226 + t228
+	t231 := real(x10) + 0.3090169943749474*real(t226) - 0.8090169943749473*real(t228)
+	t232 := imag(x10) + 0.3090169943749474*imag(t226) - 0.8090169943749473*imag(t228)
+	t233 := 0.9510565162951536*real(t227) + 0.5877852522924731*real(t229)
+	t234 := 0.9510565162951536*imag(t227) + 0.5877852522924731*imag(t229)
+	t235 := complex(t231-t234, t232+t233)
+	t236 := complex(t231+t234, t232-t233)
+	t237 := real(x10) - 0.8090169943749473*real(t226) + 0.3090169943749474*real(t228)
+	t238 := imag(x10) - 0.8090169943749473*imag(t226) + 0.3090169943749474*imag(t228)
+	t239 := 0.5877852522924731*real(t227) - 0.9510565162951536*real(t229)
+	t240 := 0.5877852522924731*imag(t227) - 0.9510565162951536*imag(t229)
+	t241 := complex(t237-t240, t238+t239)
+	t242 := complex(t237+t240, t238-t239)
+	t243 := t213 + t230
+	t244 := t213 - t230
+	t245 := t196 + t243
+	t246 := real(t196) - 0.49999999999999994*real(t243)
+	t247 := imag(t196) - 0.49999999999999994*imag(t243)
+	t248 := 0.8660254037844387 * real(t244)
+	t249 := 0.8660254037844387 * imag(t244)
+	t250 := complex(t246-t249, t247+t248)
+	t251 := complex(t246+t249, t247-t248)
+	t252 := t219 + t236
+	t253 := t219 - t236
+	t254 := t202 + t252
+	t255 := real(t202) - 0.49999999999999994*real(t252)
+	t256 := imag(t202) - 0.49999999999999994*imag(t252)
+	t257 := 0.8660254037844387 * real(t253)
+	t258 := 0.8660254037844387 * imag(t253)
+	t259 := complex(t255-t258, t256+t257)
+	t260 := complex(t255+t258, t256-t257)
+	t261 := t225 + t242
+	t262 := t225 - t242
+	t263 := t208 + t261
+	t264 := real(t208) - 0.49999999999999994*real(t261)
+	t265 := imag(t208) - 0.49999999999999994*imag(t261)
+	t266 := 0.8660254037844387 * real(t262)
+	t267 := 0.8660254037844387 * imag(t262)
+	t268 := complex(t264-t267, t265+t266)
+	t269 := complex(t264+t267, t265-t266)
+	t270 := t224 + t241
+	t271 := t224 - t241
+	t272 := t207 + t270
+	t273 := real(t207) - 0.49999999999999994*real(t270)
+	t274 := imag(t207) - 0.49999999999999994*imag(t270)
+	t275 := 0.8660254037844387 * real(t271)
+	t276 := 0.8660254037844387 * imag(t271)
+	t277 := complex(t273-t276, t274+t275)
+	t278 := complex(t273+t276, t274-t275)
+	t279 := t218 + t235
+	t280 := t218 - t235
+	t281 := t201 + t279
+	t282 := real(t201) - 0.49999999999999994*real(t279)
+	t283 := imag(t201) - 0.49999999999999994*imag(t279)
+	t284 := 0.8660254037844387 * real(t280)
+	t285 := 0.8660254037844387 * imag(t280)
+	t286 := complex(t282-t285, t283+t284)
+	t287 := complex(t282+t285, t283-t284)
+	t288 := x57 + x33
+	t289 := x57 - x33
+	t290 := x9 + x21
+	t291 := x9 - x21
+	t292 := x45 + t288 + t290
+	t293 := real(x45) + 0.3090169943749474*real(t288) - 0.8090169943749473*real(t290)
+	t294 := imag(x45) + 0.3090169943749474*imag(t288) - 0.8090169943749473*imag(t290)
+	t295 := 0.9510565162951536*real(t289) + 0.5877852522924731*real(t291)
+	t296 := 0.9510565162951536*imag(t289) + 0.5877852522924731*imag(t291)
+	t297 := complex(t293-t296, t294+t295)
+	t298 := complex(t293+t296, t294-t295)
+	t299 := real(x45) - 0.8090169943749473*real(t288) + 0.3090169943749474*real(t290)
+	t300 := imag(x45) - 0.8090169943749473*imag(t288) + 0.3090169943749474*imag(t290)
+	t301 := 0.5877852522924731*real(t289) - 0.9510565162951536*real(t291)
+	t302 := 0.5877852522924731*imag(t289) - 0.9510565162951536*imag(t291)
+	t303 := complex(t299-t302, t300+t301)
+	t304 := complex(t299+t302, t300-t301)
+	t305 := x17 + x53
+	t306 := x17 - x53
+	t307 := x29 + x41
+	t308 := x29 - x41
+	t309 := x5 + t305 + t307
+	t310 := real(x5) + 0.3090169943749474*real(t305) - 0.8090169943749473*real(t307)
+	t311 := imag(x5) + 0.3090169943749474*imag(t305) - 0.8090169943749473*imag(t307)
+	t312 := 0.9510565162951536*real(t306) + 0.5877852522924731*real(t308)
+	t313 := 0.9510565162951536*imag(t306) + 0.5877852522924731*imag(t308)
+	t314 := complex(t310-t313, t311+t312)
+	t315 := complex(t310+t313, t311-t312)
+	t316 := real(x5) - 0.8090169943749473*real(t305) + 0.3090169943749474*real(t307)
+	t317 := imag(x5) - 0.8090169943749473*imag(t305) + 0.3090169943749474*imag(t307)
+	t318 := 0.5877852522924731*real(t306) - 0.9510565162951536*real(t308)
+	t319 := 0.5877852522924731*imag(t306) - 0.9510565162951536*imag(t308)
+	t320 := complex(t316-t319, t317+t318)
+	t321 := complex(t316+t319, t317-t318)
+	t322 := x37 + x13
+	t323 := x37 - x13
+	t324 := x49 + x1
+	t325 := x49 - x1
+	t326 := x25 + t322 + t324
+	t327 := real(x25) + 0.3090169943749474*real(t322) - 0.8090169943749473*real(t324)
+	t328 := imag(x25) + 0.3090169943749474*imag(t322) - 0.8090169943749473*imag(t324)
+	t329 := 0.9510565162951536*real(t323) + 0.5877852522924731*real(t325)
+	t330 := 0.9510565162951536*imag(t323) + 0.5877852522924731*imag(t325)
+	t331 := complex(t327-t330, t328+t329)
+	t332 := complex(t327+t330, t328-t329)
+	t333 := real(x25) - 0.8090169943749473*real(t322) + 0.3090169943749474*real(t324)
+	t334 := imag(x25) - 0.8090169943749473*imag(t322) + 0.3090169943749474*imag(t324)
+	t335 := 0.5877852522924731*real(t323) - 0.9510565162951536*real(t325)
+	t336 := 0.5877852522924731*imag(t323) - 0.9510565162951536*imag(t325)
+	t337 := complex(t333-t336, t334+t335)
+	t338 := complex(t333+t336, t334-t335)
+	t339 := t309 + t326
+	t340 := t309 - t326
+	t341 := t292 + t339
+	t342 := real(t292) - 0.49999999999999994*real(t339)
+	t343 := imag(t292) - 0.49999999999999994*imag(t339)
+	t344 := 0.8660254037844387 * real(t340)
+	t345 := 0.8660254037844387 * imag(t340)
+	t346 := complex(t342-t345, t343+t344)
+	t347 := complex(t342+t345, t343-t344)
+	t348 := t315 + t332
+	t349 := t315 - t332
+	t350 := t298 + t348
+	t351 := real(t298) - 0.49999999999999994*real(t348)
+	t352 := imag(t298) - 0.49999999999999994*imag(t348)
+	t353 := 0.8660254037844387 * real(t349)
+	t354 := 0.8660254037844387 * imag(t349)
+	t355 := complex(t351-t354, t352+t353)
+	t356 := complex(t351+t354, t352-t353)
+	t357 := t321 + t338
+	t358 := t321 - t338
+	t359 := t304 + t357
+	t360 := real(t304) - 0.49999999999999994*real(t357)
+	t361 := imag(t304) - 0.49999999999999994*imag(t357)
+	t362 := 0.8660254037844387 * real(t358)
+	t363 := 0.8660254037844387 * imag(t358)
+	t364 := complex(t360-t363, t361+t362)
+	t365 := complex(t360+t363, t361-t362)
+	t366 := t320 + t337
+	t367 := t320 - t337
+	t368 := t303 + t366
+	t369 := real(t303) - 0.49999999999999994*real(t366)
+	t370 := imag(t303) - 0.49999999999999994*imag(t366)
+	t371 := 0.8660254037844387 * real(t367)
+	t372 := 0.8660254037844387 * imag(t367)
+	t373 := complex(t369-t372, t370+t371)
+	t374 := complex(t369+t372, t370-t371)
+	t375 := t314 + t331
+	t376 := t314 - t331
+	t377 := t297 + t375
+	t378 := real(t297) - 0.49999999999999994*real(t375)
+	t379 := imag(t297) - 0.49999999999999994*imag(t375)
+	t380 := 0.8660254037844387 * real(t376)
+	t381 := 0.8660254037844387 * imag(t376)
+	t382 := complex(t378-t381, t379+t380)
+	t383 := complex(t378+t381, t379-t380)
+	t384 := t53 + t245
+	t385 := t53 - t245
+	t386 := t149 + t341
+	t387 := t149 - t341
+	t388 := complex(imag(t387), -real(t387))
+	t389 := t384 + t386
+	t390 := t385 + t388
+	t391 := t384 - t386
+	t392 := t385 - t388
+	t393 := t68 + t260
+	t394 := t68 - t260
+	t395 := t164 + t356
+	t396 := t164 - t356
+	t397 := complex(imag(t396), -real(t396))
+	t398 := t393 + t395
+	t399 := t394 + t397
+	t400 := t393 - t395
+	t401 := t394 - t397
+	t402 := t76 + t268
+	t403 := t76 - t268
+	t404 := t172 + t364
+	t405 := t172 - t364
+	t406 := complex(imag(t405), -real(t405))
+	t407 := t402 + t404
+	t408 := t403 + t406
+	t409 := t402 - t404
+	t410 := t403 - t406
+	t411 := t80 + t272
+	t412 := t80 - t272
+	t413 := t176 + t368
+	t414 := t176 - t368
+	t415 := complex(imag(t414), -real(t414))
+	t416 := t411 + t413
+	t417 := t412 + t415
+	t418 := t411 - t413
+	t419 := t412 - t415
+	t420 := t95 + t287
+	t421 := t95 - t287
+	t422 := t191 + t383
+	t423 := t191 - t383
+	t424 := complex(imag(t423), -real(t423))
+	t425 := t420 + t422
+	t426 := t421 + t424
+	t427 := t420 - t422
+	t428 := t421 - t424
+	t429 := t58 + t250
+	t430 := t58 - t250
+	t431 := t154 + t346
+	t432 := t154 - t346
+	t433 := complex(imag(t432), -real(t432))
+	t434 := t429 + t431
+	t435 := t430 + t433
+	t436 := t429 - t431
+	t437 := t430 - t433
+	t438 := t62 + t254
+	t439 := t62 - t254
+	t440 := t158 + t350
+	t441 := t158 - t350
+	t442 := complex(imag(t441), -real(t441))
+	t443 := t438 + t440
+	t444 := t439 + t442
+	t445 := t438 - t440
+	t446 := t439 - t442
+	t447 := t77 + t269
+	t448 := t77 - t269
+	t449 := t173 + t365
+	t450 := t173 - t365
+	t451 := complex(imag(t450), -real(t450))
+	t452 := t447 + t449
+	t453 := t448 + t451
+	t454 := t447 - t449
+	t455 := t448 - t451
+	t456 := t85 + t277
+	t457 := t85 - t277
+	t458 := t181 + t373
+	t459 := t181 - t373
+	t460 := complex(imag(t459), -real(t459))
+	t461 := t456 + t458
+	t462 := t457 + t460
+	t463 := t456 - t458
+	t464 := t457 - t460
+	t465 := t89 + t281
+	t466 := t89 - t281
+	t467 := t185 + t377
+	t468 := t185 - t377
+	t469 := complex(imag(t468), -real(t468))
+	t470 := t465 + t467
+	t471 := t466 + t469
+	t472 := t465 - t467
+	t473 := t466 - t469
+	t474 := t59 + t251
+	t475 := t59 - t251
+	t476 := t155 + t347
+	t477 := t155 - t347
+	t478 := complex(imag(t477), -real(t477))
+	t479 := t474 + t476
+	t480 := t475 + t478
+	t481 := t474 - t476
+	t482 := t475 - t478
+	t483 := t67 + t259
+	t484 := t67 - t259
+	t485 := t163 + t355
+	t486 := t163 - t355
+	t487 := complex(imag(t486), -real(t486))
+	t488 := t483 + t485
+	t489 := t484 + t487
+	t490 := t483 - t485
+	t491 := t484 - t487
+	t492 := t71 + t263
+	t493 := t71 - t263
+	t494 := t167 + t359
+	t495 := t167 - t359
+	t496 := complex(imag(t495), -real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := t86 + t278
+	t502 := t86 - t278
+	t503 := t182 + t374
+	t504 := t182 - t374
+	t505 := complex(imag(t504), -real(t504))
+	t506 := t501 + t503
+	t507 := t502 + t505
+	t508 := t501 - t503
+	t509 := t502 - t505
+	t510 := t94 + t286
+	t511 := t94 - t286
+	t512 := t190 + t382
+	t513 := t190 - t382
+	t514 := complex(imag(t513), -real(t513))
+	t515 := t510 + t512
+	t516 := t511 + t514
+	t517 := t510 - t512
+	t518 := t511 - t514
+
+	d[0] = t389
+	d[1] = t399
+	d[2] = t409
+	d[3] = t419
+	d[4] = t425
+	d[5] = t435
+	d[6] = t445
+	d[7] = t455
+	d[8] = t461
+	d[9] = t471
+	d[10] = t481
+	d[11] = t491
+	d[12] = t497
+	d[13] = t507
+	d[14] = t517
+	d[15] = t392
+	d[16] = t398
+	d[17] = t408
+	d[18] = t418
+	d[19] = t428
+	d[20] = t434
+	d[21] = t444
+	d[22] = t454
+	d[23] = t464
+	d[24] = t470
+	d[25] = t480
+	d[26] = t490
+	d[27] = t500
+	d[28] = t506
+	d[29] = t516
+	d[30] = t391
+	d[31] = t401
+	d[32] = t407
+	d[33] = t417
+	d[34] = t427
+	d[35] = t437
+	d[36] = t443
+	d[37] = t453
+	d[38] = t463
+	d[39] = t473
+	d[40] = t479
+	d[41] = t489
+	d[42] = t499
+	d[43] = t509
+	d[44] = t515
+	d[45] = t390
+	d[46] = t400
+	d[47] = t410
+	d[48] = t416
+	d[49] = t426
+	d[50] = t436
+	d[51] = t446
+	d[52] = t452
+	d[53] = t462
+	d[54] = t472
+	d[55] = t482
+	d[56] = t488
+	d[57] = t498
+	d[58] = t508
+	d[59] = t518
+
+	return true
+}
+
+// inverseDFT60Complex128 computes a 60-point inverse DFT of complex128 data,
+// including the 1/60 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT60Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 60
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+
+	t0 := x12 + x48
+	t1 := x12 - x48
+	t2 := x24 + x36
+	t3 := x24 - x36
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x32 + x8
+	t18 := x32 - x8
+	t19 := x44 + x56
+	t20 := x44 - x56
+	t21 := x20 + t17 + t19
+	t22 := real(x20) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x20) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x20) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x20) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x52 + x28
+	t35 := x52 - x28
+	t36 := x4 + x16
+	t37 := x4 - x16
+	t38 := x40 + t34 + t36
+	t39 := real(x40) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x40) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x40) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x40) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := t21 + t38
+	t52 := t21 - t38
+	t53 := t4 + t51
+	t54 := real(t4) - 0.49999999999999994*real(t51)
+	t55 := imag(t4) - 0.49999999999999994*imag(t51)
+	t56 := 0.8660254037844387 * real(t52)
+	t57 := 0.8660254037844387 * imag(t52)
+	t58 := complex(t54-t57, t55+t56)
+	t59 := complex(t54+t57, t55-t56)
+	t60 := t26 + t43
+	t61 := t26 - t43
+	t62 := t9 + t60
+	t63 := real(t9) - 0.49999999999999994*real(t60)
+	t64 := imag(t9) - 0.49999999999999994*imag(t60)
+	t65 := 0.8660254037844387 * real(t61)
+	t66 := 0.8660254037844387 * imag(t61)
+	t67 := complex(t63-t66, t64+t65)
+	t68 := complex(t63+t66, t64-t65)
+	t69 := t32 + t49
+	t70 := t32 - t49
+	t71 := t15 + t69
+	t72 := real(t15) - 0.49999999999999994*real(t69)
+	t73 := imag(t15) - 0.49999999999999994*imag(t69)
+	t74 := 0.8660254037844387 * real(t70)
+	t75 := 0.8660254037844387 * imag(t70)
+	t76 := complex(t72-t75, t73+t74)
+	t77 := complex(t72+t75, t73-t74)
+	t78 := t33 + t50
+	t79 := t33 - t50
+	t80 := t16 + t78
+	t81 := real(t16) - 0.49999999999999994*real(t78)
+	t82 := imag(t16) - 0.49999999999999994*imag(t78)
+	t83 := 0.8660254037844387 * real(t79)
+	t84 := 0.8660254037844387 * imag(t79)
+	t85 := complex(t81-t84, t82+t83)
+	t86 := complex(t81+t84, t82-t83)
+	t87 := t27 + t44
+	t88 := t27 - t44
+	t89 := t10 + t87
+	t90 := real(t10) - 0.49999999999999994*real(t87)
+	t91 := imag(t10) - 0.49999999999999994*imag(t87)
+	t92 := 0.8660254037844387 * real(t88)
+	t93 := 0.8660254037844387 * imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := x27 + x3
+	t97 := x27 - x3
+	t98 := x39 + x51
+	t99 := x39 - x51
+	t100 := x15 + t96 + t98
+	t101 := real(x15) + 0.3090169943749474*real(t96) - 0.8090169943749473*real(t98)
+	t102 := imag(x15) + 0.3090169943749474*imag(t96) - 0.8090169943749473*imag(t98)
+	t103 := 0.9510565162951536*real(t97) + 0.5877852522924731*real(t99)
+	t104 := 0.9510565162951536*imag(t97) + 0.5877852522924731*imag(t99)
+	t105 := complex(t101-t104, t102+t103)
+	t106 := complex(t101+t104, t102-t103)
+	t107 := real(x15) - 0.8090169943749473*real(t96) + 0.3090169943749474*real(t98)
+	t108 := imag(x15) - 0.8090169943749473*imag(t96) + 0.3090169943749474*imag(t98)
+	t109 := 0.5877852522924731*real(t97) - 0.9510565162951536*real(t99)
+	t110 := 0.5877852522924731*imag(t97) - 0.9510565162951536*imag(t99)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := x47 + x23
+	t114 := x47 - x23
+	t115 := x59 + x11
+	t116 := x59 - x11
+	t117 := x35 + t113 + t115
+	t118 := real(x35) + 0.3090169943749474*real(t113) - 0.8090169943749473*real(t115)
+	t119 := imag(x35) + 0.3090169943749474*imag(t113) - 0.8090169943749473*imag(t115)
+	t120 := 0.9510565162951536*real(t114) + 0.5877852522924731*real(t116)
+	t121 := 0.9510565162951536*imag(t114) + 0.5877852522924731*imag(t116)
+	t122 := complex(t118-t121, t119+t120)
+	t123 := complex(t118+t121, t119-t120)
+	t124 := real(x35) - 0.8090169943749473*real(t113) + 0.3090169943749474*real(t115)
+	t125 := imag(x35) - 0.8090169943749473*imag(t113) + 0.3090169943749474*imag(t115)
+	t126 := 0.5877852522924731*real(t114) - 0.9510565162951536*real(t116)
+	t127 := 0.5877852522924731*imag(t114) - 0.9510565162951536*imag(t116)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := x7 + x43
+	t131 := x7 - x43
+	t132 := x19 + x31
+	t133 := x19 - x31
+	t134 := x55 + t130 + t132
+	t135 := real(x55) + 0.3090169943749474*real(t130) - 0.8090169943749473*real(t132)
+	t136 := imag(x55) + 0.3090169943749474*imag(t130) - 0.8090169943749473*imag(t132)
+	t137 := 0.9510565162951536*real(t131) + 0.5877852522924731*real(t133)
+	t138 := 0.9510565162951536*imag(t131) + 0.5877852522924731*imag(t133)
+	t139 := complex(t135-t138, t136+t137)
+	t140 := complex(t135+t138, t136-t137)
+	t141 := real(x55) - 0.8090169943749473*real(t130) + 0.3090169943749474*real(t132)
+	t142 := imag(x55) - 0.8090169943749473*imag(t130) + 0.3090169943749474*imag(t132)
+	t143 := 0.5877852522924731*real(t131) - 0.9510565162951536*real(t133)
+	t144 := 0.5877852522924731*imag(t131) - 0.9510565162951536*imag(t133)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := t117 + t134
+	t148 := t117 - t134
+	t149 := t100 + t147
+	t150 := real(t100) - 0.49999999999999994*real(t147)
+	t151 := imag(t100) - 0.49999999999999994*imag(t147)
+	t152 := 0.8660254037844387 * real(t148)
+	t153 := 0.8660254037844387 * imag(t148)
+	t154 := complex(t150-t153, t151+t152)
+	t155 := complex(t150+t153, t151-t152)
+	t156 := t122 + t139
+	t157 := t122 - t139
+	t158 := t105 + t156
+	t159 := real(t105) - 0.49999999999999994*real(t156)
+	t160 := imag(t105) - 0.49999999999999994*imag(t156)
+	t161 := 0.8660254037844387 * real(t157)
+	t162 := 0.8660254037844387 * imag(t157)
+	t163 := complex(t159-t162, t160+t161)
+	t164 := complex(t159+t162, t160-t161)
+	t165 := t128 + t145
+	t166 := t128 - t145
+	t167 := t111 + t165
+	t168 := real(t111) - 0.49999999999999994*real(t165)
+	t169 := imag(t111) - 0.49999999999999994*imag(t165)
+	t170 := 0.8660254037844387 * real(t166)
+	t171 := 0.8660254037844387 * imag(t166)
+	t172 := complex(t168-t171, t169+t170)
+	t173 := complex(t168+t171, t169-t170)
+	t174 := t129 + t146
+	t175 := t129 - t146
+	t176 := t112 + t174
+	t177 := real(t112) - 0.49999999999999994*real(t174)
+	t178 := imag(t112) - 0.49999999999999994*imag(t174)
+	t179 := 0.8660254037844387 * real(t175)
+	t180 := 0.8660254037844387 * imag(t175)
+	t181 := complex(t177-t180, t178+t179)
+	t182 := complex(t177+t180, t178-t179)
+	t183 := t123 + t140
+	t184 := t123 - t140
+	t185 := t106 + t183
+	t186 := real(t106) - 0.49999999999999994*real(t183)
+	t187 := imag(t106) - 0.49999999999999994*imag(t183)
+	t188 := 0.8660254037844387 * real(t184)
+	t189 := 0.8660254037844387 * imag(t184)
+	t190 := complex(t186-t189, t187+t188)
+	t191 := complex(t186+t189, t187-t188)
+	t192 := x42 + x18
+	t193 := x42 - x18
+	t194 := x54 + x6
+	t195 := x54 - x6
+	t196 := x30 + t192 + t194
+	t197 := real(x30) + 0.3090169943749474*real(t192) - 0.8090169943749473*real(t194)
+	t198 := imag(x30) + 0.3090169943749474*imag(t192) - 0.8090169943749473*imag(t194)
+	t199 := 0.9510565162951536*real(t193) + 0.5877852522924731*real(t195)
+	t200 := 0.9510565162951536*imag(t193) + 0.5877852522924731*imag(t195)
+	t201 := complex(t197-t200, t198+t199)
+	t202 := complex(t197+t200, t198-t199)
+	t203 := real(x30) - 0.8090169943749473*real(t192) + 0.3090169943749474*real(t194)
+	t204 := imag(x30) - 0.8090169943749473*imag(t192) + 0.3090169943749474*imag(t194)
+	t205 := 0.5877852522924731*real(t193) - 0.9510565162951536*real(t195)
+	t206 := 0.5877852522924731*imag(t193) - 0.9510565162951536*imag(t195)
+	t207 := complex(t203-t206, t204+t205)
+	t208 := complex(t203+t206, t204-t205)
+	t209 := x2 + x38
+	t210 := x2 - x38
+	t211 := x14 + x26
+	t212 := x14 - x26
+	t213 := x50 + t209 + t211
+	t214 := real(x50) + 0.3090169943749474*real(t209) - 0.8090169943749473*real(t211)
+	t215 := imag(x50) + 0.3090169943749474*imag(t209) - 0.8090169943749473*imag(t211)
+	t216 := 0.9510565162951536*real(t210) + 0.5877852522924731*real(t212)
+	t217 := 0.9510565162951536*imag(t210) + 0.5877852522924731*imag(t212)
+	t218 := complex(t214-t217, t215+t216)
+	t219 := complex(t214+t217, t215-t216)
+	t220 := real(x50) - 0.8090169943749473*real(t209) + 0.3090169943749474*real(t211)
+	t221 := imag(x50) - 0.8090169943749473*imag(t209) + 0.3090169943749474*imag(t211)
+	t222 := 0.5877852522924731*real(t210) - 0.9510565162951536*real(t212)
+	t223 := 0.5877852522924731*imag(t210) - 0.9510565162951536*imag(t212)
+	t224 := complex(t220-t223, t221+t222)
+	t225 := complex(t220+t223, t221-t222)
+	t226 := x22 + x58
+	t227 := x22 - x58
+	t228 := x34 + x46
+	t229 := x34 - x46
+	t230 := x10 + t226 + t228
+	t231 := real(x10) + 0.3090169943749474*real(t226) - 0.8090169943749473*real(t228)
+	t232 := imag(x10) + 0.3090169943749474*imag(t226) - 0.8090169943749473*imag(t228)
+	t233 := 0.9510565162951536*real(t227) + 0.5877852522924731*real(t229)
+	t234 := 0.9510565162951536*imag(t227) + 0.5877852522924731*imag(t229)
+	t235 := complex(t231-t234, t232+t233)
+	t236 := complex(t231+t234, t232-t233)
+	t237 := real(x10) - 0.8090169943749473*real(t226) + 0.3090169943749474*real(t228)
+	t238 := imag(x10) - 0.8090169943749473*imag(t226) + 0.3090169943749474*imag(t228)
+	t239 := 0.5877852522924731*real(t227) - 0.9510565162951536*real(t229)
+	t240 := 0.5877852522924731*imag(t227) - 0.9510565162951536*imag(t229)
+	t241 := complex(t237-t240, t238+t239)
+	t242 := complex(t237+t240, t238-t239)
+	t243 := t213 + t230
+	t244 := t213 - t230
+	t245 := t196 + t243
+	t246 := real(t196) - 0.49999999999999994*real(t243)
+	t247 := imag(t196) - 0.49999999999999994*imag(t243)
+	t248 := 0.8660254037844387 * real(t244)
+	t249 := 0.8660254037844387 * imag(t244)
+	t250 := complex(t246-t249, t247+t248)
+	t251 := complex(t246+t249, t247-t248)
+	t252 := t218 + t235
+	t253 := t218 - t235
+	t254 := t201 + t252
+	t255 := real(t201) - 0.49999999999999994*real(t252)
+	t256 := imag(t201) - 0.49999999999999994*imag(t252)
+	t257 := 0.8660254037844387 * real(t253)
+	t258 := 0.8660254037844387 * imag(t253)
+	t259 := complex(t255-t258, t256+t257)
+	t260 := complex(t255+t258, t256-t257)
+	t261 := t224 + t241
+	t262 := t224 - t241
+	t263 := t207 + t261
+	t264 := real(t207) - 0.49999999999999994*real(t261)
+	t265 := imag(t207) - 0.49999999999999994*imag(t261)
+	t266 := 0.8660254037844387 * real(t262)
+	t267 := 0.8660254037844387 * imag(t262)
+	t268 := complex(t264-t267, t265+t266)
+	t269 := complex(t264+t267, t265-t266)
+	t270 := t225 + t242
+	t271 := t225 - t242
+	t272 := t208 + t270
+	t273 := real(t208) - 0.49999999999999994*real(t270)
+	t274 := imag(t208) - 0.49999999999999994*imag(t270)
+	t275 := 0.8660254037844387 * real(t271)
+	t276 := 0.8660254037844387 * imag(t271)
+	t277 := complex(t273-t276, t274+t275)
+	t278 := complex(t273+t276, t274-t275)
+	t279 := t219 + t236
+	t280 := t219 - t236
+	t281 := t202 + t279
+	t282 := real(t202) - 0.49999999999999994*real(t279)
+	t283 := imag(t202) - 0.49999999999999994*imag(t279)
+	t284 := 0.8660254037844387 * real(t280)
+	t285 := 0.8660254037844387 * imag(t280)
+	t286 := complex(t282-t285, t283+t284)
+	t287 := complex(t282+t285, t283-t284)
+	t288 := x57 + x33
+	t289 := x57 - x33
+	t290 := x9 + x21
+	t291 := x9 - x21
+	t292 := x45 + t288 + t290
+	t293 := real(x45) + 0.3090169943749474*real(t288) - 0.8090169943749473*real(t290)
+	t294 := imag(x45) + 0.3090169943749474*imag(t288) - 0.8090169943749473*imag(t290)
+	t295 := 0.9510565162951536*real(t289) + 0.5877852522924731*real(t291)
+	t296 := 0.9510565162951536*imag(t289) + 0.5877852522924731*imag(t291)
+	t297 := complex(t293-t296, t294+t295)
+	t298 := complex(t293+t296, t294-t295)
+	t299 := real(x45) - 0.8090169943749473*real(t288) + 0.3090169943749474*real(t290)
+	t300 := imag(x45) - 0.8090169943749473*imag(t288) + 0.3090169943749474*imag(t290)
+	t301 := 0.5877852522924731*real(t289) - 0.9510565162951536*real(t291)
+	t302 := 0.5877852522924731*imag(t289) - 0.9510565162951536*imag(t291)
+	t303 := complex(t299-t302, t300+t301)
+	t304 := complex(t299+t302, t300-t301)
+	t305 := x17 + x53
+	t306 := x17 - x53
+	t307 := x29 + x41
+	t308 := x29 - x41
+	t309 := x5 + t305 + t307
+	t310 := real(x5) + 0.3090169943749474*real(t305) - 0.8090169943749473*real(t307)
+	t311 := imag(x5) + 0.3090169943749474*imag(t305) - 0.8090169943749473*imag(t307)
+	t312 := 0.9510565162951536*real(t306) + 0.5877852522924731*real(t308)
+	t313 := 0.9510565162951536*imag(t306) + 0.5877852522924731*imag(t308)
+	t314 := complex(t310-t313, t311+t312)
+	t315 := complex(t310+t313, t311-t312)
+	t316 := real(x5) - 0.8090169943749473*real(t305) + 0.3090169943749474*real(t307)
+	t317 := imag(x5) - 0.8090169943749473*imag(t305) + 0.3090169943749474*imag(t307)
+	t318 := 0.5877852522924731*real(t306) - 0.9510565162951536*real(t308)
+	t319 := 0.5877852522924731*imag(t306) - 0.9510565162951536*imag(t308)
+	t320 := complex(t316-t319, t317+t318)
+	t321 := complex(t316+t319, t317-t318)
+	t322 := x37 + x13
+	t323 := x37 - x13
+	t324 := x49 + x1
+	t325 := x49 - x1
+	t326 := x25 + t322 + t324
+	t327 := real(x25) + 0.3090169943749474*real(t322) - 0.8090169943749473*real(t324)
+	t328 := imag(x25) + 0.3090169943749474*imag(t322) - 0.8090169943749473*imag(t324)
+	t329 := 0.9510565162951536*real(t323) + 0.5877852522924731*real(t325)
+	t330 := 0.9510565162951536*imag(t323) + 0.5877852522924731*imag(t325)
+	t331 := complex(t327-t330, t328+t329)
+	t332 := complex(t327+t330, t328-t329)
+	t333 := real(x25) - 0.8090169943749473*real(t322) + 0.3090169943749474*real(t324)
+	t334 := imag(x25) - 0.8090169943749473*imag(t322) + 0.3090169943749474*imag(t324)
+	t335 := 0.5877852522924731*real(t323) - 0.9510565162951536*real(t325)
+	t336 := 0.5877852522924731*imag(t323) - 0.9510565162951536*imag(t325)
+	t337 := complex(t333-t336, t334+t335)
+	t338 := complex(t333+t336, t334-t335)
+	t339 := t309 + t326
+	t340 := t309 - t326
+	t341 := t292 + t339
+	t342 := real(t292) - 0.49999999999999994*real(t339)
+	t343 := imag(t292) - 0.49999999999999994*imag(t339)
+	t344 := 0.8660254037844387 * real(t340)
+	t345 := 0.8660254037844387 * imag(t340)
+	t346 := complex(t342-t345, t343+t344)
+	t347 := complex(t342+t345, t343-t344)
+	t348 := t314 + t331
+	t349 := t314 - t331
+	t350 := t297 + t348
+	t351 := real(t297) - 0.49999999999999994*real(t348)
+	t352 := imag(t297) - 0.49999999999999994*imag(t348)
+	t353 := 0.8660254037844387 * real(t349)
+	t354 := 0.8660254037844387 * imag(t349)
+	t355 := complex(t351-t354, t352+t353)
+	t356 := complex(t351+t354, t352-t353)
+	t357 := t320 + t337
+	t358 := t320 - t337
+	t359 := t303 + t357
+	t360 := real(t303) - 0.49999999999999994*real(t357)
+	t361 := imag(t303) - 0.49999999999999994*imag(t357)
+	t362 := 0.8660254037844387 * real(t358)
+	t363 := 0.8660254037844387 * imag(t358)
+	t364 := complex(t360-t363, t361+t362)
+	t365 := complex(t360+t363, t361-t362)
+	t366 := t321 + t338
+	t367 := t321 - t338
+	t368 := t304 + t366
+	t369 := real(t304) - 0.49999999999999994*real(t366)
+	t370 := imag(t304) - 0.49999999999999994*imag(t366)
+	t371 := 0.8660254037844387 * real(t367)
+	t372 := 0.8660254037844387 * imag(t367)
+	t373 := complex(t369-t372, t370+t371)
+	t374 := complex(t369+t372, t370-t371)
+	t375 := t315 + t332
+	t376 := t315 - t332
+	t377 := t298 + t375
+	t378 := real(t298) - 0.49999999999999994*real(t375)
+	t379 := imag(t298) - 0.49999999999999994*imag(t375)
+	t380 := 0.8660254037844387 * real(t376)
+	t381 := 0.8660254037844387 * imag(t376)
+	t382 := complex(t378-t381, t379+t380)
+	t383 := complex(t378+t381, t379-t380)
+	t384 := t53 + t245
+	t385 := t53 - t245
+	t386 := t149 + t341
+	t387 := t149 - t341
+	t388 := complex(-imag(t387), real(t387))
+	t389 := t384 + t386
+	t390 := t385 + t388
+	t391 := t384 - t386
+	t392 := t385 - t388
+	t393 := t67 + t259
+	t394 := t67 - t259
+	t395 := t163 + t355
+	t396 := t163 - t355
+	t397 := complex(-imag(t396), real(t396))
+	t398 := t393 + t395
+	t399 := t394 + t397
+	t400 := t393 - t395
+	t401 := t394 - t397
+	t402 := t77 + t269
+	t403 := t77 - t269
+	t404 := t173 + t365
+	t405 := t173 - t365
+	t406 := complex(-imag(t405), real(t405))
+	t407 := t402 + t404
+	t408 := t403 + t406
+	t409 := t402 - t404
+	t410 := t403 - t406
+	t411 := t80 + t272
+	t412 := t80 - t272
+	t413 := t176 + t368
+	t414 := t176 - t368
+	t415 := complex(-imag(t414), real(t414))
+	t416 := t411 + t413
+	t417 := t412 + t415
+	t418 := t411 - t413
+	t419 := t412 - t415
+	t420 := t94 + t286
+	t421 := t94 - t286
+	t422 := t190 + t382
+	t423 := t190 - t382
+	t424 := complex(-imag(t423), real(t423))
+	t425 := t420 + t422
+	t426 := t421 + t424
+	t427 := t420 - t422
+	t428 := t421 - t424
+	t429 := t59 + t251
+	t430 := t59 - t251
+	t431 := t155 + t347
+	t432 := t155 - t347
+	t433 := complex(-imag(t432), real(t432))
+	t434 := t429 + t431
+	t435 := t430 + t433
+	t436 := t429 - t431
+	t437 := t430 - t433
+	t438 := t62 + t254
+	t439 := t62 - t254
+	t440 := t158 + t350
+	t441 := t158 - t350
+	t442 := complex(-imag(t441), real(t441))
+	t443 := t438 + t440
+	t444 := t439 + t442
+	t445 := t438 - t440
+	t446 := t439 - t442
+	t447 := t76 + t268
+	t448 := t76 - t268
+	t449 := t172 + t364
+	t450 := t172 - t364
+	t451 := complex(-imag(t450), real(t450))
+	t452 := t447 + t449
+	t453 := t448 + t451
+	t454 := t447 - t449
+	t455 := t448 - t451
+	t456 := t86 + t278
+	t457 := t86 - t278
+	t458 := t182 + t374
+	t459 := t182 - t374
+	t460 := complex(-imag(t459), real(t459))
+	t461 := t456 + t458
+	t462 := t457 + t460
+	t463 := t456 - t458
+	t464 := t457 - t460
+	t465 := t89 + t281
+	t466 := t89 - t281
+	t467 := t185 + t377
+	t468 := t185 - t377
+	t469 := complex(-imag(t468), real(t468))
+	t470 := t465 + t467
+	t471 := t466 + t469
+	t472 := t465 - t467
+	t473 := t466 - t469
+	t474 := t58 + t250
+	t475 := t58 - t250
+	t476 := t154 + t346
+	t477 := t154 - t346
+	t478 := complex(-imag(t477), real(t477))
+	t479 := t474 + t476
+	t480 := t475 + t478
+	t481 := t474 - t476
+	t482 := t475 - t478
+	t483 := t68 + t260
+	t484 := t68 - t260
+	t485 := t164 + t356
+	t486 := t164 - t356
+	t487 := complex(-imag(t486), real(t486))
+	t488 := t483 + t485
+	t489 := t484 + t487
+	t490 := t483 - t485
+	t491 := t484 - t487
+	t492 := t71 + t263
+	t493 := t71 - t263
+	t494 := t167 + t359
+	t495 := t167 - t359
+	t496 := complex(-imag(t495), real(t495))
+	t497 := t492 + t494
+	t498 := t493 + t496
+	t499 := t492 - t494
+	t500 := t493 - t496
+	t501 := t85 + t277
+	t502 := t85 - t277
+	t503 := t181 + t373
+	t504 := t181 - t373
+	t505 := complex(-imag(t504), real(t504))
+	t506 := t501 + t503
+	t507 := t502 + t505
+	t508 := t501 - t503
+	t509 := t502 - t505
+	t510 := t95 + t287
+	t511 := t95 - t287
+	t512 := t191 + t383
+	t513 := t191 - t383
+	t514 := complex(-imag(t513), real(t513))
+	t515 := t510 + t512
+	t516 := t511 + t514
+	t517 := t510 - t512
+	t518 := t511 - t514
+
+	d[0] = complex(real(t389)*0.016666666666666666, imag(t389)*0.016666666666666666)
+	d[1] = complex(real(t399)*0.016666666666666666, imag(t399)*0.016666666666666666)
+	d[2] = complex(real(t409)*0.016666666666666666, imag(t409)*0.016666666666666666)
+	d[3] = complex(real(t419)*0.016666666666666666, imag(t419)*0.016666666666666666)
+	d[4] = complex(real(t425)*0.016666666666666666, imag(t425)*0.016666666666666666)
+	d[5] = complex(real(t435)*0.016666666666666666, imag(t435)*0.016666666666666666)
+	d[6] = complex(real(t445)*0.016666666666666666, imag(t445)*0.016666666666666666)
+	d[7] = complex(real(t455)*0.016666666666666666, imag(t455)*0.016666666666666666)
+	d[8] = complex(real(t461)*0.016666666666666666, imag(t461)*0.016666666666666666)
+	d[9] = complex(real(t471)*0.016666666666666666, imag(t471)*0.016666666666666666)
+	d[10] = complex(real(t481)*0.016666666666666666, imag(t481)*0.016666666666666666)
+	d[11] = complex(real(t491)*0.016666666666666666, imag(t491)*0.016666666666666666)
+	d[12] = complex(real(t497)*0.016666666666666666, imag(t497)*0.016666666666666666)
+	d[13] = complex(real(t507)*0.016666666666666666, imag(t507)*0.016666666666666666)
+	d[14] = complex(real(t517)*0.016666666666666666, imag(t517)*0.016666666666666666)
+	d[15] = complex(real(t392)*0.016666666666666666, imag(t392)*0.016666666666666666)
+	d[16] = complex(real(t398)*0.016666666666666666, imag(t398)*0.016666666666666666)
+	d[17] = complex(real(t408)*0.016666666666666666, imag(t408)*0.016666666666666666)
+	d[18] = complex(real(t418)*0.016666666666666666, imag(t418)*0.016666666666666666)
+	d[19] = complex(real(t428)*0.016666666666666666, imag(t428)*0.016666666666666666)
+	d[20] = complex(real(t434)*0.016666666666666666, imag(t434)*0.016666666666666666)
+	d[21] = complex(real(t444)*0.016666666666666666, imag(t444)*0.016666666666666666)
+	d[22] = complex(real(t454)*0.016666666666666666, imag(t454)*0.016666666666666666)
+	d[23] = complex(real(t464)*0.016666666666666666, imag(t464)*0.016666666666666666)
+	d[24] = complex(real(t470)*0.016666666666666666, imag(t470)*0.016666666666666666)
+	d[25] = complex(real(t480)*0.016666666666666666, imag(t480)*0.016666666666666666)
+	d[26] = complex(real(t490)*0.016666666666666666, imag(t490)*0.016666666666666666)
+	d[27] = complex(real(t500)*0.016666666666666666, imag(t500)*0.016666666666666666)
+	d[28] = complex(real(t506)*0.016666666666666666, imag(t506)*0.016666666666666666)
+	d[29] = complex(real(t516)*0.016666666666666666, imag(t516)*0.016666666666666666)
+	d[30] = complex(real(t391)*0.016666666666666666, imag(t391)*0.016666666666666666)
+	d[31] = complex(real(t401)*0.016666666666666666, imag(t401)*0.016666666666666666)
+	d[32] = complex(real(t407)*0.016666666666666666, imag(t407)*0.016666666666666666)
+	d[33] = complex(real(t417)*0.016666666666666666, imag(t417)*0.016666666666666666)
+	d[34] = complex(real(t427)*0.016666666666666666, imag(t427)*0.016666666666666666)
+	d[35] = complex(real(t437)*0.016666666666666666, imag(t437)*0.016666666666666666)
+	d[36] = complex(real(t443)*0.016666666666666666, imag(t443)*0.016666666666666666)
+	d[37] = complex(real(t453)*0.016666666666666666, imag(t453)*0.016666666666666666)
+	d[38] = complex(real(t463)*0.016666666666666666, imag(t463)*0.016666666666666666)
+	d[39] = complex(real(t473)*0.016666666666666666, imag(t473)*0.016666666666666666)
+	d[40] = complex(real(t479)*0.016666666666666666, imag(t479)*0.016666666666666666)
+	d[41] = complex(real(t489)*0.016666666666666666, imag(t489)*0.016666666666666666)
+	d[42] = complex(real(t499)*0.016666666666666666, imag(t499)*0.016666666666666666)
+	d[43] = complex(real(t509)*0.016666666666666666, imag(t509)*0.016666666666666666)
+	d[44] = complex(real(t515)*0.016666666666666666, imag(t515)*0.016666666666666666)
+	d[45] = complex(real(t390)*0.016666666666666666, imag(t390)*0.016666666666666666)
+	d[46] = complex(real(t400)*0.016666666666666666, imag(t400)*0.016666666666666666)
+	d[47] = complex(real(t410)*0.016666666666666666, imag(t410)*0.016666666666666666)
+	d[48] = complex(real(t416)*0.016666666666666666, imag(t416)*0.016666666666666666)
+	d[49] = complex(real(t426)*0.016666666666666666, imag(t426)*0.016666666666666666)
+	d[50] = complex(real(t436)*0.016666666666666666, imag(t436)*0.016666666666666666)
+	d[51] = complex(real(t446)*0.016666666666666666, imag(t446)*0.016666666666666666)
+	d[52] = complex(real(t452)*0.016666666666666666, imag(t452)*0.016666666666666666)
+	d[53] = complex(real(t462)*0.016666666666666666, imag(t462)*0.016666666666666666)
+	d[54] = complex(real(t472)*0.016666666666666666, imag(t472)*0.016666666666666666)
+	d[55] = complex(real(t482)*0.016666666666666666, imag(t482)*0.016666666666666666)
+	d[56] = complex(real(t488)*0.016666666666666666, imag(t488)*0.016666666666666666)
+	d[57] = complex(real(t498)*0.016666666666666666, imag(t498)*0.016666666666666666)
+	d[58] = complex(real(t508)*0.016666666666666666, imag(t508)*0.016666666666666666)
+	d[59] = complex(real(t518)*0.016666666666666666, imag(t518)*0.016666666666666666)
+
+	return true
+}
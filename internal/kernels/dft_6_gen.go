@@ -0,0 +1,229 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT6Complex64 computes a 6-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT6Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 6
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+
+	t0 := x2 + x4
+	t1 := x2 - x4
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x5 + x1
+	t10 := x5 - x1
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := t2 + t11
+	t19 := t2 - t11
+	t20 := t8 + t17
+	t21 := t8 - t17
+	t22 := t7 + t16
+	t23 := t7 - t16
+
+	d[0] = t18
+	d[1] = t21
+	d[2] = t22
+	d[3] = t19
+	d[4] = t20
+	d[5] = t23
+
+	return true
+}
+
+// inverseDFT6Complex64 computes a 6-point inverse DFT of complex64 data,
+// including the 1/6 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT6Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 6
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+
+	t0 := x2 + x4
+	t1 := x2 - x4
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x5 + x1
+	t10 := x5 - x1
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := t2 + t11
+	t19 := t2 - t11
+	t20 := t7 + t16
+	t21 := t7 - t16
+	t22 := t8 + t17
+	t23 := t8 - t17
+
+	d[0] = complex(real(t18)*0.16666666666666666, imag(t18)*0.16666666666666666)
+	d[1] = complex(real(t21)*0.16666666666666666, imag(t21)*0.16666666666666666)
+	d[2] = complex(real(t22)*0.16666666666666666, imag(t22)*0.16666666666666666)
+	d[3] = complex(real(t19)*0.16666666666666666, imag(t19)*0.16666666666666666)
+	d[4] = complex(real(t20)*0.16666666666666666, imag(t20)*0.16666666666666666)
+	d[5] = complex(real(t23)*0.16666666666666666, imag(t23)*0.16666666666666666)
+
+	return true
+}
+
+// forwardDFT6Complex128 computes a 6-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT6Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 6
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+
+	t0 := x2 + x4
+	t1 := x2 - x4
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x5 + x1
+	t10 := x5 - x1
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := t2 + t11
+	t19 := t2 - t11
+	t20 := t8 + t17
+	t21 := t8 - t17
+	t22 := t7 + t16
+	t23 := t7 - t16
+
+	d[0] = t18
+	d[1] = t21
+	d[2] = t22
+	d[3] = t19
+	d[4] = t20
+	d[5] = t23
+
+	return true
+}
+
+// inverseDFT6Complex128 computes a 6-point inverse DFT of complex128 data,
+// including the 1/6 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT6Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 6
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+
+	t0 := x2 + x4
+	t1 := x2 - x4
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x5 + x1
+	t10 := x5 - x1
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := t2 + t11
+	t19 := t2 - t11
+	t20 := t7 + t16
+	t21 := t7 - t16
+	t22 := t8 + t17
+	t23 := t8 - t17
+
+	d[0] = complex(real(t18)*0.16666666666666666, imag(t18)*0.16666666666666666)
+	d[1] = complex(real(t21)*0.16666666666666666, imag(t21)*0.16666666666666666)
+	d[2] = complex(real(t22)*0.16666666666666666, imag(t22)*0.16666666666666666)
+	d[3] = complex(real(t19)*0.16666666666666666, imag(t19)*0.16666666666666666)
+	d[4] = complex(real(t20)*0.16666666666666666, imag(t20)*0.16666666666666666)
+	d[5] = complex(real(t23)*0.16666666666666666, imag(t23)*0.16666666666666666)
+
+	return true
+}
@@ -0,0 +1,241 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT7Complex64 computes a 7-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT7Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 7
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+
+	t0 := x1 + x6
+	t1 := x1 - x6
+	t2 := x2 + x5
+	t3 := x2 - x5
+	t4 := x3 + x4
+	t5 := x3 - x4
+	t6 := x0 + t0 + t2 + t4
+	t7 := real(x0) + 0.6234898018587335*real(t0) - 0.2225209339563144*real(t2) - 0.9009688679024191*real(t4)
+	t8 := imag(x0) + 0.6234898018587335*imag(t0) - 0.2225209339563144*imag(t2) - 0.9009688679024191*imag(t4)
+	t9 := 0.7818314824680298*real(t1) + 0.9749279121818236*real(t3) + 0.4338837391175581*real(t5)
+	t10 := 0.7818314824680298*imag(t1) + 0.9749279121818236*imag(t3) + 0.4338837391175581*imag(t5)
+	t11 := complex(t7-t10, t8+t9)
+	t12 := complex(t7+t10, t8-t9)
+	t13 := real(x0) - 0.2225209339563144*real(t0) - 0.9009688679024191*real(t2) + 0.6234898018587335*real(t4)
+	t14 := imag(x0) - 0.2225209339563144*imag(t0) - 0.9009688679024191*imag(t2) + 0.6234898018587335*imag(t4)
+	t15 := 0.9749279121818236*real(t1) - 0.4338837391175581*real(t3) - 0.7818314824680298*real(t5)
+	t16 := 0.9749279121818236*imag(t1) - 0.4338837391175581*imag(t3) - 0.7818314824680298*imag(t5)
+	t17 := complex(t13-t16, t14+t15)
+	t18 := complex(t13+t16, t14-t15)
+	t19 := real(x0) - 0.9009688679024191*real(t0) + 0.6234898018587335*real(t2) - 0.2225209339563144*real(t4)
+	t20 := imag(x0) - 0.9009688679024191*imag(t0) + 0.6234898018587335*imag(t2) - 0.2225209339563144*imag(t4)
+	t21 := 0.4338837391175581*real(t1) - 0.7818314824680298*real(t3) + 0.9749279121818236*real(t5)
+	t22 := 0.4338837391175581*imag(t1) - 0.7818314824680298*imag(t3) + 0.9749279121818236*imag(t5)
+	t23 := complex(t19-t22, t20+t21)
+	t24 := complex(t19+t22, t20-t21)
+
+	d[0] = t6
+	d[1] = t12
+	d[2] = t18
+	d[3] = t24
+	d[4] = t23
+	d[5] = t17
+	d[6] = t11
+
+	return true
+}
+
+// inverseDFT7Complex64 computes a 7-point inverse DFT of complex64 data,
+// including the 1/7 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT7Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 7
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+
+	t0 := x1 + x6
+	t1 := x1 - x6
+	t2 := x2 + x5
+	t3 := x2 - x5
+	t4 := x3 + x4
+	t5 := x3 - x4
+	t6 := x0 + t0 + t2 + t4
+	t7 := real(x0) + 0.6234898018587335*real(t0) - 0.2225209339563144*real(t2) - 0.9009688679024191*real(t4)
+	t8 := imag(x0) + 0.6234898018587335*imag(t0) - 0.2225209339563144*imag(t2) - 0.9009688679024191*imag(t4)
+	t9 := 0.7818314824680298*real(t1) + 0.9749279121818236*real(t3) + 0.4338837391175581*real(t5)
+	t10 := 0.7818314824680298*imag(t1) + 0.9749279121818236*imag(t3) + 0.4338837391175581*imag(t5)
+	t11 := complex(t7-t10, t8+t9)
+	t12 := complex(t7+t10, t8-t9)
+	t13 := real(x0) - 0.2225209339563144*real(t0) - 0.9009688679024191*real(t2) + 0.6234898018587335*real(t4)
+	t14 := imag(x0) - 0.2225209339563144*imag(t0) - 0.9009688679024191*imag(t2) + 0.6234898018587335*imag(t4)
+	t15 := 0.9749279121818236*real(t1) - 0.4338837391175581*real(t3) - 0.7818314824680298*real(t5)
+	t16 := 0.9749279121818236*imag(t1) - 0.4338837391175581*imag(t3) - 0.7818314824680298*imag(t5)
+	t17 := complex(t13-t16, t14+t15)
+	t18 := complex(t13+t16, t14-t15)
+	t19 := real(x0) - 0.9009688679024191*real(t0) + 0.6234898018587335*real(t2) - 0.2225209339563144*real(t4)
+	t20 := imag(x0) - 0.9009688679024191*imag(t0) + 0.6234898018587335*imag(t2) - 0.2225209339563144*imag(t4)
+	t21 := 0.4338837391175581*real(t1) - 0.7818314824680298*real(t3) + 0.9749279121818236*real(t5)
+	t22 := 0.4338837391175581*imag(t1) - 0.7818314824680298*imag(t3) + 0.9749279121818236*imag(t5)
+	t23 := complex(t19-t22, t20+t21)
+	t24 := complex(t19+t22, t20-t21)
+
+	d[0] = complex(real(t6)*0.14285714285714285, imag(t6)*0.14285714285714285)
+	d[1] = complex(real(t11)*0.14285714285714285, imag(t11)*0.14285714285714285)
+	d[2] = complex(real(t17)*0.14285714285714285, imag(t17)*0.14285714285714285)
+	d[3] = complex(real(t23)*0.14285714285714285, imag(t23)*0.14285714285714285)
+	d[4] = complex(real(t24)*0.14285714285714285, imag(t24)*0.14285714285714285)
+	d[5] = complex(real(t18)*0.14285714285714285, imag(t18)*0.14285714285714285)
+	d[6] = complex(real(t12)*0.14285714285714285, imag(t12)*0.14285714285714285)
+
+	return true
+}
+
+// forwardDFT7Complex128 computes a 7-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT7Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 7
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+
+	t0 := x1 + x6
+	t1 := x1 - x6
+	t2 := x2 + x5
+	t3 := x2 - x5
+	t4 := x3 + x4
+	t5 := x3 - x4
+	t6 := x0 + t0 + t2 + t4
+	t7 := real(x0) + 0.6234898018587335*real(t0) - 0.2225209339563144*real(t2) - 0.9009688679024191*real(t4)
+	t8 := imag(x0) + 0.6234898018587335*imag(t0) - 0.2225209339563144*imag(t2) - 0.9009688679024191*imag(t4)
+	t9 := 0.7818314824680298*real(t1) + 0.9749279121818236*real(t3) + 0.4338837391175581*real(t5)
+	t10 := 0.7818314824680298*imag(t1) + 0.9749279121818236*imag(t3) + 0.4338837391175581*imag(t5)
+	t11 := complex(t7-t10, t8+t9)
+	t12 := complex(t7+t10, t8-t9)
+	t13 := real(x0) - 0.2225209339563144*real(t0) - 0.9009688679024191*real(t2) + 0.6234898018587335*real(t4)
+	t14 := imag(x0) - 0.2225209339563144*imag(t0) - 0.9009688679024191*imag(t2) + 0.6234898018587335*imag(t4)
+	t15 := 0.9749279121818236*real(t1) - 0.4338837391175581*real(t3) - 0.7818314824680298*real(t5)
+	t16 := 0.9749279121818236*imag(t1) - 0.4338837391175581*imag(t3) - 0.7818314824680298*imag(t5)
+	t17 := complex(t13-t16, t14+t15)
+	t18 := complex(t13+t16, t14-t15)
+	t19 := real(x0) - 0.9009688679024191*real(t0) + 0.6234898018587335*real(t2) - 0.2225209339563144*real(t4)
+	t20 := imag(x0) - 0.9009688679024191*imag(t0) + 0.6234898018587335*imag(t2) - 0.2225209339563144*imag(t4)
+	t21 := 0.4338837391175581*real(t1) - 0.7818314824680298*real(t3) + 0.9749279121818236*real(t5)
+	t22 := 0.4338837391175581*imag(t1) - 0.7818314824680298*imag(t3) + 0.9749279121818236*imag(t5)
+	t23 := complex(t19-t22, t20+t21)
+	t24 := complex(t19+t22, t20-t21)
+
+	d[0] = t6
+	d[1] = t12
+	d[2] = t18
+	d[3] = t24
+	d[4] = t23
+	d[5] = t17
+	d[6] = t11
+
+	return true
+}
+
+// inverseDFT7Complex128 computes a 7-point inverse DFT of complex128 data,
+// including the 1/7 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT7Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 7
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+
+	t0 := x1 + x6
+	t1 := x1 - x6
+	t2 := x2 + x5
+	t3 := x2 - x5
+	t4 := x3 + x4
+	t5 := x3 - x4
+	t6 := x0 + t0 + t2 + t4
+	t7 := real(x0) + 0.6234898018587335*real(t0) - 0.2225209339563144*real(t2) - 0.9009688679024191*real(t4)
+	t8 := imag(x0) + 0.6234898018587335*imag(t0) - 0.2225209339563144*imag(t2) - 0.9009688679024191*imag(t4)
+	t9 := 0.7818314824680298*real(t1) + 0.9749279121818236*real(t3) + 0.4338837391175581*real(t5)
+	t10 := 0.7818314824680298*imag(t1) + 0.9749279121818236*imag(t3) + 0.4338837391175581*imag(t5)
+	t11 := complex(t7-t10, t8+t9)
+	t12 := complex(t7+t10, t8-t9)
+	t13 := real(x0) - 0.2225209339563144*real(t0) - 0.9009688679024191*real(t2) + 0.6234898018587335*real(t4)
+	t14 := imag(x0) - 0.2225209339563144*imag(t0) - 0.9009688679024191*imag(t2) + 0.6234898018587335*imag(t4)
+	t15 := 0.9749279121818236*real(t1) - 0.4338837391175581*real(t3) - 0.7818314824680298*real(t5)
+	t16 := 0.9749279121818236*imag(t1) - 0.4338837391175581*imag(t3) - 0.7818314824680298*imag(t5)
+	t17 := complex(t13-t16, t14+t15)
+	t18 := complex(t13+t16, t14-t15)
+	t19 := real(x0) - 0.9009688679024191*real(t0) + 0.6234898018587335*real(t2) - 0.2225209339563144*real(t4)
+	t20 := imag(x0) - 0.9009688679024191*imag(t0) + 0.6234898018587335*imag(t2) - 0.2225209339563144*imag(t4)
+	t21 := 0.4338837391175581*real(t1) - 0.7818314824680298*real(t3) + 0.9749279121818236*real(t5)
+	t22 := 0.4338837391175581*imag(t1) - 0.7818314824680298*imag(t3) + 0.9749279121818236*imag(t5)
+	t23 := complex(t19-t22, t20+t21)
+	t24 := complex(t19+t22, t20-t21)
+
+	d[0] = complex(real(t6)*0.14285714285714285, imag(t6)*0.14285714285714285)
+	d[1] = complex(real(t11)*0.14285714285714285, imag(t11)*0.14285714285714285)
+	d[2] = complex(real(t17)*0.14285714285714285, imag(t17)*0.14285714285714285)
+	d[3] = complex(real(t23)*0.14285714285714285, imag(t23)*0.14285714285714285)
+	d[4] = complex(real(t24)*0.14285714285714285, imag(t24)*0.14285714285714285)
+	d[5] = complex(real(t18)*0.14285714285714285, imag(t18)*0.14285714285714285)
+	d[6] = complex(real(t12)*0.14285714285714285, imag(t12)*0.14285714285714285)
+
+	return true
+}
@@ -0,0 +1,3433 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT80Complex64 computes a 80-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT80Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 80
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+	x60 := s[60]
+	x61 := s[61]
+	x62 := s[62]
+	x63 := s[63]
+	x64 := s[64]
+	x65 := s[65]
+	x66 := s[66]
+	x67 := s[67]
+	x68 := s[68]
+	x69 := s[69]
+	x70 := s[70]
+	x71 := s[71]
+	x72 := s[72]
+	x73 := s[73]
+	x74 := s[74]
+	x75 := s[75]
+	x76 := s[76]
+	x77 := s[77]
+	x78 := s[78]
+	x79 := s[79]
+
+	t0 := x16 + x64
+	t1 := x16 - x64
+	t2 := x32 + x48
+	t3 := x32 - x48
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x21 + x69
+	t18 := x21 - x69
+	t19 := x37 + x53
+	t20 := x37 - x53
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x26 + x74
+	t35 := x26 - x74
+	t36 := x42 + x58
+	t37 := x42 - x58
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x31 + x79
+	t52 := x31 - x79
+	t53 := x47 + x63
+	t54 := x47 - x63
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := x36 + x4
+	t69 := x36 - x4
+	t70 := x52 + x68
+	t71 := x52 - x68
+	t72 := x20 + t68 + t70
+	t73 := real(x20) + 0.3090169943749474*real(t68) - 0.8090169943749473*real(t70)
+	t74 := imag(x20) + 0.3090169943749474*imag(t68) - 0.8090169943749473*imag(t70)
+	t75 := 0.9510565162951536*real(t69) + 0.5877852522924731*real(t71)
+	t76 := 0.9510565162951536*imag(t69) + 0.5877852522924731*imag(t71)
+	t77 := complex(t73-t76, t74+t75)
+	t78 := complex(t73+t76, t74-t75)
+	t79 := real(x20) - 0.8090169943749473*real(t68) + 0.3090169943749474*real(t70)
+	t80 := imag(x20) - 0.8090169943749473*imag(t68) + 0.3090169943749474*imag(t70)
+	t81 := 0.5877852522924731*real(t69) - 0.9510565162951536*real(t71)
+	t82 := 0.5877852522924731*imag(t69) - 0.9510565162951536*imag(t71)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := x41 + x9
+	t86 := x41 - x9
+	t87 := x57 + x73
+	t88 := x57 - x73
+	t89 := x25 + t85 + t87
+	t90 := real(x25) + 0.3090169943749474*real(t85) - 0.8090169943749473*real(t87)
+	t91 := imag(x25) + 0.3090169943749474*imag(t85) - 0.8090169943749473*imag(t87)
+	t92 := 0.9510565162951536*real(t86) + 0.5877852522924731*real(t88)
+	t93 := 0.9510565162951536*imag(t86) + 0.5877852522924731*imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := real(x25) - 0.8090169943749473*real(t85) + 0.3090169943749474*real(t87)
+	t97 := imag(x25) - 0.8090169943749473*imag(t85) + 0.3090169943749474*imag(t87)
+	t98 := 0.5877852522924731*real(t86) - 0.9510565162951536*real(t88)
+	t99 := 0.5877852522924731*imag(t86) - 0.9510565162951536*imag(t88)
+	t100 := complex(t96-t99, t97+t98)
+	t101 := complex(t96+t99, t97-t98)
+	t102 := x46 + x14
+	t103 := x46 - x14
+	t104 := x62 + x78
+	t105 := x62 - x78
+	t106 := x30 + t102 + t104
+	t107 := real(x30) + 0.3090169943749474*real(t102) - 0.8090169943749473*real(t104)
+	t108 := imag(x30) + 0.3090169943749474*imag(t102) - 0.8090169943749473*imag(t104)
+	t109 := 0.9510565162951536*real(t103) + 0.5877852522924731*real(t105)
+	t110 := 0.9510565162951536*imag(t103) + 0.5877852522924731*imag(t105)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := real(x30) - 0.8090169943749473*real(t102) + 0.3090169943749474*real(t104)
+	t114 := imag(x30) - 0.8090169943749473*imag(t102) + 0.3090169943749474*imag(t104)
+	t115 := 0.5877852522924731*real(t103) - 0.9510565162951536*real(t105)
+	t116 := 0.5877852522924731*imag(t103) - 0.9510565162951536*imag(t105)
+	t117 := complex(t113-t116, t114+t115)
+	t118 := complex(t113+t116, t114-t115)
+	t119 := x51 + x19
+	t120 := x51 - x19
+	t121 := x67 + x3
+	t122 := x67 - x3
+	t123 := x35 + t119 + t121
+	t124 := real(x35) + 0.3090169943749474*real(t119) - 0.8090169943749473*real(t121)
+	t125 := imag(x35) + 0.3090169943749474*imag(t119) - 0.8090169943749473*imag(t121)
+	t126 := 0.9510565162951536*real(t120) + 0.5877852522924731*real(t122)
+	t127 := 0.9510565162951536*imag(t120) + 0.5877852522924731*imag(t122)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := real(x35) - 0.8090169943749473*real(t119) + 0.3090169943749474*real(t121)
+	t131 := imag(x35) - 0.8090169943749473*imag(t119) + 0.3090169943749474*imag(t121)
+	t132 := 0.5877852522924731*real(t120) - 0.9510565162951536*real(t122)
+	t133 := 0.5877852522924731*imag(t120) - 0.9510565162951536*imag(t122)
+	t134 := complex(t130-t133, t131+t132)
+	t135 := complex(t130+t133, t131-t132)
+	t136 := x56 + x24
+	t137 := x56 - x24
+	t138 := x72 + x8
+	t139 := x72 - x8
+	t140 := x40 + t136 + t138
+	t141 := real(x40) + 0.3090169943749474*real(t136) - 0.8090169943749473*real(t138)
+	t142 := imag(x40) + 0.3090169943749474*imag(t136) - 0.8090169943749473*imag(t138)
+	t143 := 0.9510565162951536*real(t137) + 0.5877852522924731*real(t139)
+	t144 := 0.9510565162951536*imag(t137) + 0.5877852522924731*imag(t139)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := real(x40) - 0.8090169943749473*real(t136) + 0.3090169943749474*real(t138)
+	t148 := imag(x40) - 0.8090169943749473*imag(t136) + 0.3090169943749474*imag(t138)
+	t149 := 0.5877852522924731*real(t137) - 0.9510565162951536*real(t139)
+	t150 := 0.5877852522924731*imag(t137) - 0.9510565162951536*imag(t139)
+	t151 := complex(t147-t150, t148+t149)
+	t152 := complex(t147+t150, t148-t149)
+	t153 := x61 + x29
+	t154 := x61 - x29
+	t155 := x77 + x13
+	t156 := x77 - x13
+	t157 := x45 + t153 + t155
+	t158 := real(x45) + 0.3090169943749474*real(t153) - 0.8090169943749473*real(t155)
+	t159 := imag(x45) + 0.3090169943749474*imag(t153) - 0.8090169943749473*imag(t155)
+	t160 := 0.9510565162951536*real(t154) + 0.5877852522924731*real(t156)
+	t161 := 0.9510565162951536*imag(t154) + 0.5877852522924731*imag(t156)
+	t162 := complex(t158-t161, t159+t160)
+	t163 := complex(t158+t161, t159-t160)
+	t164 := real(x45) - 0.8090169943749473*real(t153) + 0.3090169943749474*real(t155)
+	t165 := imag(x45) - 0.8090169943749473*imag(t153) + 0.3090169943749474*imag(t155)
+	t166 := 0.5877852522924731*real(t154) - 0.9510565162951536*real(t156)
+	t167 := 0.5877852522924731*imag(t154) - 0.9510565162951536*imag(t156)
+	t168 := complex(t164-t167, t165+t166)
+	t169 := complex(t164+t167, t165-t166)
+	t170 := x66 + x34
+	t171 := x66 - x34
+	t172 := x2 + x18
+	t173 := x2 - x18
+	t174 := x50 + t170 + t172
+	t175 := real(x50) + 0.3090169943749474*real(t170) - 0.8090169943749473*real(t172)
+	t176 := imag(x50) + 0.3090169943749474*imag(t170) - 0.8090169943749473*imag(t172)
+	t177 := 0.9510565162951536*real(t171) + 0.5877852522924731*real(t173)
+	t178 := 0.9510565162951536*imag(t171) + 0.5877852522924731*imag(t173)
+	t179 := complex(t175-t178, t176+t177)
+	t180 := complex(t175+t178, t176-t177)
+	t181 := real(x50) - 0.8090169943749473*real(t170) + 0.3090169943749474*real(t172)
+	t182 := imag(x50) - 0.8090169943749473*imag(t170) + 0.3090169943749474*imag(t172)
+	t183 := 0.5877852522924731*real(t171) - 0.9510565162951536*real(t173)
+	t184 := 0.5877852522924731*imag(t171) - 0.9510565162951536*imag(t173)
+	t185 := complex(t181-t184, t182+t183)
+	t186 := complex(t181+t184, t182-t183)
+	t187 := x71 + x39
+	t188 := x71 - x39
+	t189 := x7 + x23
+	t190 := x7 - x23
+	t191 := x55 + t187 + t189
+	t192 := real(x55) + 0.3090169943749474*real(t187) - 0.8090169943749473*real(t189)
+	t193 := imag(x55) + 0.3090169943749474*imag(t187) - 0.8090169943749473*imag(t189)
+	t194 := 0.9510565162951536*real(t188) + 0.5877852522924731*real(t190)
+	t195 := 0.9510565162951536*imag(t188) + 0.5877852522924731*imag(t190)
+	t196 := complex(t192-t195, t193+t194)
+	t197 := complex(t192+t195, t193-t194)
+	t198 := real(x55) - 0.8090169943749473*real(t187) + 0.3090169943749474*real(t189)
+	t199 := imag(x55) - 0.8090169943749473*imag(t187) + 0.3090169943749474*imag(t189)
+	t200 := 0.5877852522924731*real(t188) - 0.9510565162951536*real(t190)
+	t201 := 0.5877852522924731*imag(t188) - 0.9510565162951536*imag(t190)
+	t202 := complex(t198-t201, t199+t200)
+	t203 := complex(t198+t201, t199-t200)
+	t204 := x76 + x44
+	t205 := x76 - x44
+	t206 := x12 + x28
+	t207 := x12 - x28
+	t208 := x60 + t204 + t206
+	t209 := real(x60) + 0.3090169943749474*real(t204) - 0.8090169943749473*real(t206)
+	t210 := imag(x60) + 0.3090169943749474*imag(t204) - 0.8090169943749473*imag(t206)
+	t211 := 0.9510565162951536*real(t205) + 0.5877852522924731*real(t207)
+	t212 := 0.9510565162951536*imag(t205) + 0.5877852522924731*imag(t207)
+	t213 := complex(t209-t212, t210+t211)
+	t214 := complex(t209+t212, t210-t211)
+	t215 := real(x60) - 0.8090169943749473*real(t204) + 0.3090169943749474*real(t206)
+	t216 := imag(x60) - 0.8090169943749473*imag(t204) + 0.3090169943749474*imag(t206)
+	t217 := 0.5877852522924731*real(t205) - 0.9510565162951536*real(t207)
+	t218 := 0.5877852522924731*imag(t205) - 0.9510565162951536*imag(t207)
+	t219 := complex(t215-t218, t216+t217)
+	t220 := complex(t215+t218, t216-t217)
+	t221 := x1 + x49
+	t222 := x1 - x49
+	t223 := x17 + x33
+	t224 := x17 - x33
+	t225 := x65 + t221 + t223
+	t226 := real(x65) + 0.3090169943749474*real(t221) - 0.8090169943749473*real(t223)
+	t227 := imag(x65) + 0.3090169943749474*imag(t221) - 0.8090169943749473*imag(t223)
+	t228 := 0.9510565162951536*real(t222) + 0.5877852522924731*real(t224)
+	t229 := 0.9510565162951536*imag(t222) + 0.5877852522924731*imag(t224)
+	t230 := complex(t226-t229, t227+t228)
+	t231 := complex(t226+t229, t227-t228)
+	t232 := real(x65) - 0.8090169943749473*real(t221) + 0.3090169943749474*real(t223)
+	t233 := imag(x65) - 0.8090169943749473*imag(t221) + 0.3090169943749474*imag(t223)
+	t234 := 0.5877852522924731*real(t222) - 0.9510565162951536*real(t224)
+	t235 := 0.5877852522924731*imag(t222) - 0.9510565162951536*imag(t224)
+	t236 := complex(t232-t235, t233+t234)
+	t237 := complex(t232+t235, t233-t234)
+	t238 := x6 + x54
+	t239 := x6 - x54
+	t240 := x22 + x38
+	t241 := x22 - x38
+	t242 := x70 + t238 + t240
+	t243 := real(x70) + 0.3090169943749474*real(t238) - 0.8090169943749473*real(t240)
+	t244 := imag(x70) + 0.3090169943749474*imag(t238) - 0.8090169943749473*imag(t240)
+	t245 := 0.9510565162951536*real(t239) + 0.5877852522924731*real(t241)
+	t246 := 0.9510565162951536*imag(t239) + 0.5877852522924731*imag(t241)
+	t247 := complex(t243-t246, t244+t245)
+	t248 := complex(t243+t246, t244-t245)
+	t249 := real(x70) - 0.8090169943749473*real(t238) + 0.3090169943749474*real(t240)
+	t250 := imag(x70) - 0.8090169943749473*imag(t238) + 0.3090169943749474*imag(t240)
+	t251 := 0.5877852522924731*real(t239) - 0.9510565162951536*real(t241)
+	t252 := 0.5877852522924731*imag(t239) - 0.9510565162951536*imag(t241)
+	t253 := complex(t249-t252, t250+t251)
+	t254 := complex(t249+t252, t250-t251)
+	t255 := x11 + x59
+	t256 := x11 - x59
+	t257 := x27 + x43
+	t258 := x27 - x43
+	t259 := x75 + t255 + t257
+	t260 := real(x75) + 0.3090169943749474*real(t255) - 0.8090169943749473*real(t257)
+	t261 := imag(x75) + 0.3090169943749474*imag(t255) - 0.8090169943749473*imag(t257)
+	t262 := 0.9510565162951536*real(t256) + 0.5877852522924731*real(t258)
+	t263 := 0.9510565162951536*imag(t256) + 0.5877852522924731*imag(t258)
+	t264 := complex(t260-t263, t261+t262)
+	t265 := complex(t260+t263, t261-t262)
+	t266 := real(x75) - 0.8090169943749473*real(t255) + 0.3090169943749474*real(t257)
+	t267 := imag(x75) - 0.8090169943749473*imag(t255) + 0.3090169943749474*imag(t257)
+	t268 := 0.5877852522924731*real(t256) - 0.9510565162951536*real(t258)
+	t269 := 0.5877852522924731*imag(t256) - 0.9510565162951536*imag(t258)
+	t270 := complex(t266-t269, t267+t268)
+	t271 := complex(t266+t269, t267-t268)
+	t272 := t4 + t140
+	t273 := t4 - t140
+	t274 := t72 + t208
+	t275 := t72 - t208
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+	t281 := t21 + t157
+	t282 := t21 - t157
+	t283 := t89 + t225
+	t284 := t89 - t225
+	t285 := complex(imag(t284), -real(t284))
+	t286 := t281 + t283
+	t287 := t282 + t285
+	t288 := t281 - t283
+	t289 := t282 - t285
+	t290 := t38 + t174
+	t291 := t38 - t174
+	t292 := t106 + t242
+	t293 := t106 - t242
+	t294 := complex(imag(t293), -real(t293))
+	t295 := t290 + t292
+	t296 := t291 + t294
+	t297 := t290 - t292
+	t298 := t291 - t294
+	t299 := t55 + t191
+	t300 := t55 - t191
+	t301 := t123 + t259
+	t302 := t123 - t259
+	t303 := complex(imag(t302), -real(t302))
+	t304 := t299 + t301
+	t305 := t300 + t303
+	t306 := t299 - t301
+	t307 := t300 - t303
+	t308 := t277 + t295
+	t309 := t277 - t295
+	t310 := t286 + t304
+	t311 := t286 - t304
+	t312 := complex(imag(t311), -real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := t287 * complex(0.9238795325112867, -0.3826834323650898)
+	t318 := complex((real(t296)+imag(t296))*0.7071067811865476, (imag(t296)-real(t296))*0.7071067811865476)
+	t319 := t305 * complex(0.3826834323650898, -0.9238795325112867)
+	t320 := t278 + t318
+	t321 := t278 - t318
+	t322 := t317 + t319
+	t323 := t317 - t319
+	t324 := complex(imag(t323), -real(t323))
+	t325 := t320 + t322
+	t326 := t321 + t324
+	t327 := t320 - t322
+	t328 := t321 - t324
+	t329 := complex((real(t288)+imag(t288))*0.7071067811865476, (imag(t288)-real(t288))*0.7071067811865476)
+	t330 := complex(imag(t297), -real(t297))
+	t331 := complex((-real(t306)+imag(t306))*0.7071067811865476, (-imag(t306)-real(t306))*0.7071067811865476)
+	t332 := t279 + t330
+	t333 := t279 - t330
+	t334 := t329 + t331
+	t335 := t329 - t331
+	t336 := complex(imag(t335), -real(t335))
+	t337 := t332 + t334
+	t338 := t333 + t336
+	t339 := t332 - t334
+	t340 := t333 - t336
+	t341 := t289 * complex(0.3826834323650898, -0.9238795325112867)
+	t342 := complex((-real(t298)+imag(t298))*0.7071067811865476, (-imag(t298)-real(t298))*0.7071067811865476)
+	t343 := t307 * complex(-0.9238795325112867, 0.3826834323650898)
+	t344 := t280 + t342
+	t345 := t280 - t342
+	t346 := t341 + t343
+	t347 := t341 - t343
+	t348 := complex(imag(t347), -real(t347))
+	t349 := t344 + t346
+	t350 := t345 + t348
+	t351 := t344 - t346
+	t352 := t345 - t348
+	t353 := t10 + t146
+	t354 := t10 - t146
+	t355 := t78 + t214
+	t356 := t78 - t214
+	t357 := complex(imag(t356), -real(t356))
+	t358 := t353 + t355
+	t359 := t354 + t357
+	t360 := t353 - t355
+	t361 := t354 - t357
+	t362 := t27 + t163
+	t363 := t27 - t163
+	t364 := t95 + t231
+	t365 := t95 - t231
+	t366 := complex(imag(t365), -real(t365))
+	t367 := t362 + t364
+	t368 := t363 + t366
+	t369 := t362 - t364
+	t370 := t363 - t366
+	t371 := t44 + t180
+	t372 := t44 - t180
+	t373 := t112 + t248
+	t374 := t112 - t248
+	t375 := complex(imag(t374), -real(t374))
+	t376 := t371 + t373
+	t377 := t372 + t375
+	t378 := t371 - t373
+	t379 := t372 - t375
+	t380 := t61 + t197
+	t381 := t61 - t197
+	t382 := t129 + t265
+	t383 := t129 - t265
+	t384 := complex(imag(t383), -real(t383))
+	t385 := t380 + t382
+	t386 := t381 + t384
+	t387 := t380 - t382
+	t388 := t381 - t384
+	t389 := t358 + t376
+	t390 := t358 - t376
+	t391 := t367 + t385
+	t392 := t367 - t385
+	t393 := complex(imag(t392), -real(t392))
+	t394 := t389 + t391
+	t395 := t390 + t393
+	t396 := t389 - t391
+	t397 := t390 - t393
+	t398 := t368 * complex(0.9238795325112867, -0.3826834323650898)
+	t399 := complex((real(t377)+imag(t377))*0.7071067811865476, (imag(t377)-real(t377))*0.7071067811865476)
+	t400 := t386 * complex(0.3826834323650898, -0.9238795325112867)
+	t401 := t359 + t399
+	t402 := t359 - t399
+	t403 := t398 + t400
+	t404 := t398 - t400
+	t405 := complex(imag(t404), -real(t404))
+	t406 := t401 + t403
+	t407 := t402 + t405
+	t408 := t401 - t403
+	t409 := t402 - t405
+	t410 := complex((real(t369)+imag(t369))*0.7071067811865476, (imag(t369)-real(t369))*0.7071067811865476)
+	t411 := complex(imag(t378), -real(t378))
+	t412 := complex((-real(t387)+imag(t387))*0.7071067811865476, (-imag(t387)-real(t387))*0.7071067811865476)
+	t413 := t360 + t411
+	t414 := t360 - t411
+	t415 := t410 + t412
+	t416 := t410 - t412
+	t417 := complex(imag(t416), -real(t416))
+	t418 := t413 + t415
+	t419 := t414 + t417
+	t420 := t413 - t415
+	t421 := t414 - t417
+	t422 := t370 * complex(0.3826834323650898, -0.9238795325112867)
+	t423 := complex((-real(t379)+imag(t379))*0.7071067811865476, (-imag(t379)-real(t379))*0.7071067811865476)
+	t424 := t388 * complex(-0.9238795325112867, 0.3826834323650898)
+	t425 := t361 + t423
+	t426 := t361 - t423
+	t427 := t422 + t424
+	t428 := t422 - t424
+	t429 := complex(imag(t428), -real(t428))
+	t430 := t425 + t427
+	t431 := t426 + t429
+	t432 := t425 - t427
+	t433 := t426 - t429
+	t434 := t16 + t152
+	t435 := t16 - t152
+	t436 := t84 + t220
+	t437 := t84 - t220
+	t438 := complex(imag(t437), -real(t437))
+	t439 := t434 + t436
+	t440 := t435 + t438
+	t441 := t434 - t436
+	t442 := t435 - t438
+	t443 := t33 + t169
+	t444 := t33 - t169
+	t445 := t101 + t237
+	t446 := t101 - t237
+	t447 := complex(imag(t446), -real(t446))
+	t448 := t443 + t445
+	t449 := t444 + t447
+	t450 := t443 - t445
+	t451 := t444 - t447
+	t452 := t50 + t186
+	t453 := t50 - t186
+	t454 := t118 + t254
+	t455 := t118 - t254
+	t456 := complex(imag(t455), -real(t455))
+	t457 := t452 + t454
+	t458 := t453 + t456
+	t459 := t452 - t454
+	t460 := t453 - t456
+	t461 := t67 + t203
+	t462 := t67 - t203
+	t463 := t135 + t271
+	t464 := t135 - t271
+	t465 := complex(imag(t464), -real(t464))
+	t466 := t461 + t463
+	t467 := t462 + t465
+	t468 := t461 - t463
+	t469 := t462 - t465
+	t470 := t439 + t457
+	t471 := t439 - t457
+	t472 := t448 + t466
+	t473 := t448 - t466
+	t474 := complex(imag(t473), -real(t473))
+	t475 := t470 + t472
+	t476 := t471 + t474
+	t477 := t470 - t472
+	t478 := t471 - t474
+	t479 := t449 * complex(0.9238795325112867, -0.3826834323650898)
+	t480 := complex((real(t458)+imag(t458))*0.7071067811865476, (imag(t458)-real(t458))*0.7071067811865476)
+	t481 := t467 * complex(0.3826834323650898, -0.9238795325112867)
+	t482 := t440 + t480
+	t483 := t440 - t480
+	t484 := t479 + t481
+	t485 := t479 - t481
+	t486 := complex(imag(t485), -real(t485))
+	t487 := t482 + t484
+	t488 := t483 + t486
+	t489 := t482 - t484
+	t490 := t483 - t486
+	t491 := complex((real(t450)+imag(t450))*0.7071067811865476, (imag(t450)-real(t450))*0.7071067811865476)
+	t492 := complex(imag(t459), -real(t459))
+	t493 := complex((-real(t468)+imag(t468))*0.7071067811865476, (-imag(t468)-real(t468))*0.7071067811865476)
+	t494 := t441 + t492
+	t495 := t441 - t492
+	t496 := t491 + t493
+	t497 := t491 - t493
+	t498 := complex(imag(t497), -real(t497))
+	t499 := t494 + t496
+	t500 := t495 + t498
+	t501 := t494 - t496
+	t502 := t495 - t498
+	t503 := t451 * complex(0.3826834323650898, -0.9238795325112867)
+	t504 := complex((-real(t460)+imag(t460))*0.7071067811865476, (-imag(t460)-real(t460))*0.7071067811865476)
+	t505 := t469 * complex(-0.9238795325112867, 0.3826834323650898)
+	t506 := t442 + t504
+	t507 := t442 - t504
+	t508 := t503 + t505
+	t509 := t503 - t505
+	t510 := complex(imag(t509), -real(t509))
+	t511 := t506 + t508
+	t512 := t507 + t510
+	t513 := t506 - t508
+	t514 := t507 - t510
+	t515 := t15 + t151
+	t516 := t15 - t151
+	t517 := t83 + t219
+	t518 := t83 - t219
+	t519 := complex(imag(t518), -real(t518))
+	t520 := t515 + t517
+	t521 := t516 + t519
+	t522 := t515 - t517
+	t523 := t516 - t519
+	t524 := t32 + t168
+	t525 := t32 - t168
+	t526 := t100 + t236
+	t527 := t100 - t236
+	t528 := complex(imag(t527), -real(t527))
+	t529 := t524 + t526
+	t530 := t525 + t528
+	t531 := t524 - t526
+	t532 := t525 - t528
+	t533 := t49 + t185
+	t534 := t49 - t185
+	t535 := t117 + t253
+	t536 := t117 - t253
+	t537 := complex(imag(t536), -real(t536))
+	t538 := t533 + t535
+	t539 := t534 + t537
+	t540 := t533 - t535
+	t541 := t534 - t537
+	t542 := t66 + t202
+	t543 := t66 - t202
+	t544 := t134 + t270
+	t545 := t134 - t270
+	t546 := complex(imag(t545), -real(t545))
+	t547 := t542 + t544
+	t548 := t543 + t546
+	t549 := t542 - t544
+	t550 := t543 - t546
+	t551 := t520 + t538
+	t552 := t520 - t538
+	t553 := t529 + t547
+	t554 := t529 - t547
+	t555 := complex(imag(t554), -real(t554))
+	t556 := t551 + t553
+	t557 := t552 + t555
+	t558 := t551 - t553
+	t559 := t552 - t555
+	t560 := t530 * complex(0.9238795325112867, -0.3826834323650898)
+	t561 := complex((real(t539)+imag(t539))*0.7071067811865476, (imag(t539)-real(t539))*0.7071067811865476)
+	t562 := t548 * complex(0.3826834323650898, -0.9238795325112867)
+	t563 := t521 + t561
+	t564 := t521 - t561
+	t565 := t560 + t562
+	t566 := t560 - t562
+	t567 := complex(imag(t566), -real(t566))
+	t568 := t563 + t565
+	t569 := t564 + t567
+	t570 := t563 - t565
+	t571 := t564 - t567
+	t572 := complex((real(t531)+imag(t531))*0.7071067811865476, (imag(t531)-real(t531))*0.7071067811865476)
+	t573 := complex(imag(t540), -real(t540))
+	t574 := complex((-real(t549)+imag(t549))*0.7071067811865476, (-imag(t549)-real(t549))*0.7071067811865476)
+	t575 := t522 + t573
+	t576 := t522 - t573
+	t577 := t572 + t574
+	t578 := t572 - t574
+	t579 := complex(imag(t578), -real(t578))
+	t580 := t575 + t577
+	t581 := t576 + t579
+	t582 := t575 - t577
+	t583 := t576 - t579
+	t584 := t532 * complex(0.3826834323650898, -0.9238795325112867)
+	t585 := complex((-real(t541)+imag(t541))*0.7071067811865476, (-imag(t541)-real(t541))*0.7071067811865476)
+	t586 := t550 * complex(-0.9238795325112867, 0.3826834323650898)
+	t587 := t523 + t585
+	t588 := t523 - t585
+	t589 := t584 + t586
+	t590 := t584 - t586
+	t591 := complex(imag(t590), -real(t590))
+	t592 := t587 + t589
+	t593 := t588 + t591
+	t594 := t587 - t589
+	t595 := t588 - t591
+	t596 := t9 + t145
+	t597 := t9 - t145
+	t598 := t77 + t213
+	t599 := t77 - t213
+	t600 := complex(imag(t599), -real(t599))
+	t601 := t596 + t598
+	t602 := t597 + t600
+	t603 := t596 - t598
+	t604 := t597 - t600
+	t605 := t26 + t162
+	t606 := t26 - t162
+	t607 := t94 + t230
+	t608 := t94 - t230
+	t609 := complex(imag(t608), -real(t608))
+	t610 := t605 + t607
+	t611 := t606 + t609
+	t612 := t605 - t607
+	t613 := t606 - t609
+	t614 := t43 + t179
+	t615 := t43 - t179
+	t616 := t111 + t247
+	t617 := t111 - t247
+	t618 := complex(imag(t617), -real(t617))
+	t619 := t614 + t616
+	t620 := t615 + t618
+	t621 := t614 - t616
+	t622 := t615 - t618
+	t623 := t60 + t196
+	t624 := t60 - t196
+	t625 := t128 + t264
+	t626 := t128 - t264
+	t627 := complex(imag(t626), -real(t626))
+	t628 := t623 + t625
+	t629 := t624 + t627
+	t630 := t623 - t625
+	t631 := t624 - t627
+	t632 := t601 + t619
+	t633 := t601 - t619
+	t634 := t610 + t628
+	t635 := t610 - t628
+	t636 := complex(imag(t635), -real(t635))
+	t637 := t632 + t634
+	t638 := t633 + t636
+	t639 := t632 - t634
+	t640 := t633 - t636
+	t641 := t611 * complex(0.9238795325112867, -0.3826834323650898)
+	t642 := complex((real(t620)+imag(t620))*0.7071067811865476, (imag(t620)-real(t620))*0.7071067811865476)
+	t643 := t629 * complex(0.3826834323650898, -0.9238795325112867)
+	t644 := t602 + t642
+	t645 := t602 - t642
+	t646 := t641 + t643
+	t647 := t641 - t643
+	t648 := complex(imag(t647), -real(t647))
+	t649 := t644 + t646
+	t650 := t645 + t648
+	t651 := t644 - t646
+	t652 := t645 - t648
+	t653 := complex((real(t612)+imag(t612))*0.7071067811865476, (imag(t612)-real(t612))*0.7071067811865476)
+	t654 := complex(imag(t621), -real(t621))
+	t655 := complex((-real(t630)+imag(t630))*0.7071067811865476, (-imag(t630)-real(t630))*0.7071067811865476)
+	t656 := t603 + t654
+	t657 := t603 - t654
+	t658 := t653 + t655
+	t659 := t653 - t655
+	t660 := complex(imag(t659), -real(t659))
+	t661 := t656 + t658
+	t662 := t657 + t660
+	t663 := t656 - t658
+	t664 := t657 - t660
+	t665 := t613 * complex(0.3826834323650898, -0.9238795325112867)
+	t666 := complex((-real(t622)+imag(t622))*0.7071067811865476, (-imag(t622)-real(t622))*0.7071067811865476)
+	t667 := t631 * complex(-0.9238795325112867, 0.3826834323650898)
+	t668 := t604 + t666
+	t669 := t604 - t666
+	t670 := t665 + t667
+	t671 := t665 - t667
+	t672 := complex(imag(t671), -real(t671))
+	t673 := t668 + t670
+	t674 := t669 + t672
+	t675 := t668 - t670
+	t676 := t669 - t672
+
+	d[0] = t313
+	d[1] = t406
+	d[2] = t499
+	d[3] = t592
+	d[4] = t638
+	d[5] = t326
+	d[6] = t419
+	d[7] = t512
+	d[8] = t558
+	d[9] = t651
+	d[10] = t339
+	d[11] = t432
+	d[12] = t478
+	d[13] = t571
+	d[14] = t664
+	d[15] = t352
+	d[16] = t394
+	d[17] = t487
+	d[18] = t580
+	d[19] = t673
+	d[20] = t314
+	d[21] = t407
+	d[22] = t500
+	d[23] = t593
+	d[24] = t639
+	d[25] = t327
+	d[26] = t420
+	d[27] = t513
+	d[28] = t559
+	d[29] = t652
+	d[30] = t340
+	d[31] = t433
+	d[32] = t475
+	d[33] = t568
+	d[34] = t661
+	d[35] = t349
+	d[36] = t395
+	d[37] = t488
+	d[38] = t581
+	d[39] = t674
+	d[40] = t315
+	d[41] = t408
+	d[42] = t501
+	d[43] = t594
+	d[44] = t640
+	d[45] = t328
+	d[46] = t421
+	d[47] = t514
+	d[48] = t556
+	d[49] = t649
+	d[50] = t337
+	d[51] = t430
+	d[52] = t476
+	d[53] = t569
+	d[54] = t662
+	d[55] = t350
+	d[56] = t396
+	d[57] = t489
+	d[58] = t582
+	d[59] = t675
+	d[60] = t316
+	d[61] = t409
+	d[62] = t502
+	d[63] = t595
+	d[64] = t637
+	d[65] = t325
+	d[66] = t418
+	d[67] = t511
+	d[68] = t557
+	d[69] = t650
+	d[70] = t338
+	d[71] = t431
+	d[72] = t477
+	d[73] = t570
+	d[74] = t663
+	d[75] = t351
+	d[76] = t397
+	d[77] = t490
+	d[78] = t583
+	d[79] = t676
+
+	return true
+}
+
+// inverseDFT80Complex64 computes a 80-point inverse DFT of complex64 data,
+// including the 1/80 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT80Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 80
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+	x60 := s[60]
+	x61 := s[61]
+	x62 := s[62]
+	x63 := s[63]
+	x64 := s[64]
+	x65 := s[65]
+	x66 := s[66]
+	x67 := s[67]
+	x68 := s[68]
+	x69 := s[69]
+	x70 := s[70]
+	x71 := s[71]
+	x72 := s[72]
+	x73 := s[73]
+	x74 := s[74]
+	x75 := s[75]
+	x76 := s[76]
+	x77 := s[77]
+	x78 := s[78]
+	x79 := s[79]
+
+	t0 := x16 + x64
+	t1 := x16 - x64
+	t2 := x32 + x48
+	t3 := x32 - x48
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x21 + x69
+	t18 := x21 - x69
+	t19 := x37 + x53
+	t20 := x37 - x53
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x26 + x74
+	t35 := x26 - x74
+	t36 := x42 + x58
+	t37 := x42 - x58
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x31 + x79
+	t52 := x31 - x79
+	t53 := x47 + x63
+	t54 := x47 - x63
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := x36 + x4
+	t69 := x36 - x4
+	t70 := x52 + x68
+	t71 := x52 - x68
+	t72 := x20 + t68 + t70
+	t73 := real(x20) + 0.3090169943749474*real(t68) - 0.8090169943749473*real(t70)
+	t74 := imag(x20) + 0.3090169943749474*imag(t68) - 0.8090169943749473*imag(t70)
+	t75 := 0.9510565162951536*real(t69) + 0.5877852522924731*real(t71)
+	t76 := 0.9510565162951536*imag(t69) + 0.5877852522924731*imag(t71)
+	t77 := complex(t73-t76, t74+t75)
+	t78 := complex(t73+t76, t74-t75)
+	t79 := real(x20) - 0.8090169943749473*real(t68) + 0.3090169943749474*real(t70)
+	t80 := imag(x20) - 0.8090169943749473*imag(t68) + 0.3090169943749474*imag(t70)
+	t81 := 0.5877852522924731*real(t69) - 0.9510565162951536*real(t71)
+	t82 := 0.5877852522924731*imag(t69) - 0.9510565162951536*imag(t71)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := x41 + x9
+	t86 := x41 - x9
+	t87 := x57 + x73
+	t88 := x57 - x73
+	t89 := x25 + t85 + t87
+	t90 := real(x25) + 0.3090169943749474*real(t85) - 0.8090169943749473*real(t87)
+	t91 := imag(x25) + 0.3090169943749474*imag(t85) - 0.8090169943749473*imag(t87)
+	t92 := 0.9510565162951536*real(t86) + 0.5877852522924731*real(t88)
+	t93 := 0.9510565162951536*imag(t86) + 0.5877852522924731*imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := real(x25) - 0.8090169943749473*real(t85) + 0.3090169943749474*real(t87)
+	t97 := imag(x25) - 0.8090169943749473*imag(t85) + 0.3090169943749474*imag(t87)
+	t98 := 0.5877852522924731*real(t86) - 0.9510565162951536*real(t88)
+	t99 := 0.5877852522924731*imag(t86) - 0.9510565162951536*imag(t88)
+	t100 := complex(t96-t99, t97+t98)
+	t101 := complex(t96+t99, t97-t98)
+	t102 := x46 + x14
+	t103 := x46 - x14
+	t104 := x62 + x78
+	t105 := x62 - x78
+	t106 := x30 + t102 + t104
+	t107 := real(x30) + 0.3090169943749474*real(t102) - 0.8090169943749473*real(t104)
+	t108 := imag(x30) + 0.3090169943749474*imag(t102) - 0.8090169943749473*imag(t104)
+	t109 := 0.9510565162951536*real(t103) + 0.5877852522924731*real(t105)
+	t110 := 0.9510565162951536*imag(t103) + 0.5877852522924731*imag(t105)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := real(x30) - 0.8090169943749473*real(t102) + 0.3090169943749474*real(t104)
+	t114 := imag(x30) - 0.8090169943749473*imag(t102) + 0.3090169943749474*imag(t104)
+	t115 := 0.5877852522924731*real(t103) - 0.9510565162951536*real(t105)
+	t116 := 0.5877852522924731*imag(t103) - 0.9510565162951536*imag(t105)
+	t117 := complex(t113-t116, t114+t115)
+	t118 := complex(t113+t116, t114-t115)
+	t119 := x51 + x19
+	t120 := x51 - x19
+	t121 := x67 + x3
+	t122 := x67 - x3
+	t123 := x35 + t119 + t121
+	t124 := real(x35) + 0.3090169943749474*real(t119) - 0.8090169943749473*real(t121)
+	t125 := imag(x35) + 0.3090169943749474*imag(t119) - 0.8090169943749473*imag(t121)
+	t126 := 0.9510565162951536*real(t120) + 0.5877852522924731*real(t122)
+	t127 := 0.9510565162951536*imag(t120) + 0.5877852522924731*imag(t122)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := real(x35) - 0.8090169943749473*real(t119) + 0.3090169943749474*real(t121)
+	t131 := imag(x35) - 0.8090169943749473*imag(t119) + 0.3090169943749474*imag(t121)
+	t132 := 0.5877852522924731*real(t120) - 0.9510565162951536*real(t122)
+	t133 := 0.5877852522924731*imag(t120) - 0.9510565162951536*imag(t122)
+	t134 := complex(t130-t133, t131+t132)
+	t135 := complex(t130+t133, t131-t132)
+	t136 := x56 + x24
+	t137 := x56 - x24
+	t138 := x72 + x8
+	t139 := x72 - x8
+	t140 := x40 + t136 + t138
+	t141 := real(x40) + 0.3090169943749474*real(t136) - 0.8090169943749473*real(t138)
+	t142 := imag(x40) + 0.3090169943749474*imag(t136) - 0.8090169943749473*imag(t138)
+	t143 := 0.9510565162951536*real(t137) + 0.5877852522924731*real(t139)
+	t144 := 0.9510565162951536*imag(t137) + 0.5877852522924731*imag(t139)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := real(x40) - 0.8090169943749473*real(t136) + 0.3090169943749474*real(t138)
+	t148 := imag(x40) - 0.8090169943749473*imag(t136) + 0.3090169943749474*imag(t138)
+	t149 := 0.5877852522924731*real(t137) - 0.9510565162951536*real(t139)
+	t150 := 0.5877852522924731*imag(t137) - 0.9510565162951536*imag(t139)
+	t151 := complex(t147-t150, t148+t149)
+	t152 := complex(t147+t150, t148-t149)
+	t153 := x61 + x29
+	t154 := x61 - x29
+	t155 := x77 + x13
+	t156 := x77 - x13
+	t157 := x45 + t153 + t155
+	t158 := real(x45) + 0.3090169943749474*real(t153) - 0.8090169943749473*real(t155)
+	t159 := imag(x45) + 0.3090169943749474*imag(t153) - 0.8090169943749473*imag(t155)
+	t160 := 0.9510565162951536*real(t154) + 0.5877852522924731*real(t156)
+	t161 := 0.9510565162951536*imag(t154) + 0.5877852522924731*imag(t156)
+	t162 := complex(t158-t161, t159+t160)
+	t163 := complex(t158+t161, t159-t160)
+	t164 := real(x45) - 0.8090169943749473*real(t153) + 0.3090169943749474*real(t155)
+	t165 := imag(x45) - 0.8090169943749473*imag(t153) + 0.3090169943749474*imag(t155)
+	t166 := 0.5877852522924731*real(t154) - 0.9510565162951536*real(t156)
+	t167 := 0.5877852522924731*imag(t154) - 0.9510565162951536*imag(t156)
+	t168 := complex(t164-t167, t165+t166)
+	t169 := complex(t164+t167, t165-t166)
+	t170 := x66 + x34
+	t171 := x66 - x34
+	t172 := x2 + x18
+	t173 := x2 - x18
+	t174 := x50 + t170 + t172
+	t175 := real(x50) + 0.3090169943749474*real(t170) - 0.8090169943749473*real(t172)
+	t176 := imag(x50) + 0.3090169943749474*imag(t170) - 0.8090169943749473*imag(t172)
+	t177 := 0.9510565162951536*real(t171) + 0.5877852522924731*real(t173)
+	t178 := 0.9510565162951536*imag(t171) + 0.5877852522924731*imag(t173)
+	t179 := complex(t175-t178, t176+t177)
+	t180 := complex(t175+t178, t176-t177)
+	t181 := real(x50) - 0.8090169943749473*real(t170) + 0.3090169943749474*real(t172)
+	t182 := imag(x50) - 0.8090169943749473*imag(t170) + 0.3090169943749474*imag(t172)
+	t183 := 0.5877852522924731*real(t171) - 0.9510565162951536*real(t173)
+	t184 := 0.5877852522924731*imag(t171) - 0.9510565162951536*imag(t173)
+	t185 := complex(t181-t184, t182+t183)
+	t186 := complex(t181+t184, t182-t183)
+	t187 := x71 + x39
+	t188 := x71 - x39
+	t189 := x7 + x23
+	t190 := x7 - x23
+	t191 := x55 + t187 + t189
+	t192 := real(x55) + 0.3090169943749474*real(t187) - 0.8090169943749473*real(t189)
+	t193 := imag(x55) + 0.3090169943749474*imag(t187) - 0.8090169943749473*imag(t189)
+	t194 := 0.9510565162951536*real(t188) + 0.5877852522924731*real(t190)
+	t195 := 0.9510565162951536*imag(t188) + 0.5877852522924731*imag(t190)
+	t196 := complex(t192-t195, t193+t194)
+	t197 := complex(t192+t195, t193-t194)
+	t198 := real(x55) - 0.8090169943749473*real(t187) + 0.3090169943749474*real(t189)
+	t199 := imag(x55) - 0.8090169943749473*imag(t187) + 0.3090169943749474*imag(t189)
+	t200 := 0.5877852522924731*real(t188) - 0.9510565162951536*real(t190)
+	t201 := 0.5877852522924731*imag(t188) - 0.9510565162951536*imag(t190)
+	t202 := complex(t198-t201, t199+t200)
+	t203 := complex(t198+t201, t199-t200)
+	t204 := x76 + x44
+	t205 := x76 - x44
+	t206 := x12 + x28
+	t207 := x12 - x28
+	t208 := x60 + t204 + t206
+	t209 := real(x60) + 0.3090169943749474*real(t204) - 0.8090169943749473*real(t206)
+	t210 := imag(x60) + 0.3090169943749474*imag(t204) - 0.8090169943749473*imag(t206)
+	t211 := 0.9510565162951536*real(t205) + 0.5877852522924731*real(t207)
+	t212 := 0.9510565162951536*imag(t205) + 0.5877852522924731*imag(t207)
+	t213 := complex(t209-t212, t210+t211)
+	t214 := complex(t209+t212, t210-t211)
+	t215 := real(x60) - 0.8090169943749473*real(t204) + 0.3090169943749474*real(t206)
+	t216 := imag(x60) - 0.8090169943749473*imag(t204) + 0.3090169943749474*imag(t206)
+	t217 := 0.5877852522924731*real(t205) - 0.9510565162951536*real(t207)
+	t218 := 0.5877852522924731*imag(t205) - 0.9510565162951536*imag(t207)
+	t219 := complex(t215-t218, t216+t217)
+	t220 := complex(t215+t218, t216-t217)
+	t221 := x1 + x49
+	t222 := x1 - x49
+	t223 := x17 + x33
+	t224 := x17 - x33
+	t225 := x65 + t221 + t223
+	t226 := real(x65) + 0.3090169943749474*real(t221) - 0.8090169943749473*real(t223)
+	t227 := imag(x65) + 0.3090169943749474*imag(t221) - 0.8090169943749473*imag(t223)
+	t228 := 0.9510565162951536*real(t222) + 0.5877852522924731*real(t224)
+	t229 := 0.9510565162951536*imag(t222) + 0.5877852522924731*imag(t224)
+	t230 := complex(t226-t229, t227+t228)
+	t231 := complex(t226+t229, t227-t228)
+	t232 := real(x65) - 0.8090169943749473*real(t221) + 0.3090169943749474*real(t223)
+	t233 := imag(x65) - 0.8090169943749473*imag(t221) + 0.3090169943749474*imag(t223)
+	t234 := 0.5877852522924731*real(t222) - 0.9510565162951536*real(t224)
+	t235 := 0.5877852522924731*imag(t222) - 0.9510565162951536*imag(t224)
+	t236 := complex(t232-t235, t233+t234)
+	t237 := complex(t232+t235, t233-t234)
+	t238 := x6 + x54
+	t239 := x6 - x54
+	t240 := x22 + x38
+	t241 := x22 - x38
+	t242 := x70 + t238 + t240
+	t243 := real(x70) + 0.3090169943749474*real(t238) - 0.8090169943749473*real(t240)
+	t244 := imag(x70) + 0.3090169943749474*imag(t238) - 0.8090169943749473*imag(t240)
+	t245 := 0.9510565162951536*real(t239) + 0.5877852522924731*real(t241)
+	t246 := 0.9510565162951536*imag(t239) + 0.5877852522924731*imag(t241)
+	t247 := complex(t243-t246, t244+t245)
+	t248 := complex(t243+t246, t244-t245)
+	t249 := real(x70) - 0.8090169943749473*real(t238) + 0.3090169943749474*real(t240)
+	t250 := imag(x70) - 0.8090169943749473*imag(t238) + 0.3090169943749474*imag(t240)
+	t251 := 0.5877852522924731*real(t239) - 0.9510565162951536*real(t241)
+	t252 := 0.5877852522924731*imag(t239) - 0.9510565162951536*imag(t241)
+	t253 := complex(t249-t252, t250+t251)
+	t254 := complex(t249+t252, t250-t251)
+	t255 := x11 + x59
+	t256 := x11 - x59
+	t257 := x27 + x43
+	t258 := x27 - x43
+	t259 := x75 + t255 + t257
+	t260 := real(x75) + 0.3090169943749474*real(t255) - 0.8090169943749473*real(t257)
+	t261 := imag(x75) + 0.3090169943749474*imag(t255) - 0.8090169943749473*imag(t257)
+	t262 := 0.9510565162951536*real(t256) + 0.5877852522924731*real(t258)
+	t263 := 0.9510565162951536*imag(t256) + 0.5877852522924731*imag(t258)
+	t264 := complex(t260-t263, t261+t262)
+	t265 := complex(t260+t263, t261-t262)
+	t266 := real(x75) - 0.8090169943749473*real(t255) + 0.3090169943749474*real(t257)
+	t267 := imag(x75) - 0.8090169943749473*imag(t255) + 0.3090169943749474*imag(t257)
+	t268 := 0.5877852522924731*real(t256) - 0.9510565162951536*real(t258)
+	t269 := 0.5877852522924731*imag(t256) - 0.9510565162951536*imag(t258)
+	t270 := complex(t266-t269, t267+t268)
+	t271 := complex(t266+t269, t267-t268)
+	t272 := t4 + t140
+	t273 := t4 - t140
+	t274 := t72 + t208
+	t275 := t72 - t208
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+	t281 := t21 + t157
+	t282 := t21 - t157
+	t283 := t89 + t225
+	t284 := t89 - t225
+	t285 := complex(-imag(t284), real(t284))
+	t286 := t281 + t283
+	t287 := t282 + t285
+	t288 := t281 - t283
+	t289 := t282 - t285
+	t290 := t38 + t174
+	t291 := t38 - t174
+	t292 := t106 + t242
+	t293 := t106 - t242
+	t294 := complex(-imag(t293), real(t293))
+	t295 := t290 + t292
+	t296 := t291 + t294
+	t297 := t290 - t292
+	t298 := t291 - t294
+	t299 := t55 + t191
+	t300 := t55 - t191
+	t301 := t123 + t259
+	t302 := t123 - t259
+	t303 := complex(-imag(t302), real(t302))
+	t304 := t299 + t301
+	t305 := t300 + t303
+	t306 := t299 - t301
+	t307 := t300 - t303
+	t308 := t277 + t295
+	t309 := t277 - t295
+	t310 := t286 + t304
+	t311 := t286 - t304
+	t312 := complex(-imag(t311), real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := t287 * complex(0.9238795325112867, 0.3826834323650898)
+	t318 := complex((real(t296)-imag(t296))*0.7071067811865476, (imag(t296)+real(t296))*0.7071067811865476)
+	t319 := t305 * complex(0.3826834323650898, 0.9238795325112867)
+	t320 := t278 + t318
+	t321 := t278 - t318
+	t322 := t317 + t319
+	t323 := t317 - t319
+	t324 := complex(-imag(t323), real(t323))
+	t325 := t320 + t322
+	t326 := t321 + t324
+	t327 := t320 - t322
+	t328 := t321 - t324
+	t329 := complex((real(t288)-imag(t288))*0.7071067811865476, (imag(t288)+real(t288))*0.7071067811865476)
+	t330 := complex(-imag(t297), real(t297))
+	t331 := complex((-real(t306)-imag(t306))*0.7071067811865476, (-imag(t306)+real(t306))*0.7071067811865476)
+	t332 := t279 + t330
+	t333 := t279 - t330
+	t334 := t329 + t331
+	t335 := t329 - t331
+	t336 := complex(-imag(t335), real(t335))
+	t337 := t332 + t334
+	t338 := t333 + t336
+	t339 := t332 - t334
+	t340 := t333 - t336
+	t341 := t289 * complex(0.3826834323650898, 0.9238795325112867)
+	t342 := complex((-real(t298)-imag(t298))*0.7071067811865476, (-imag(t298)+real(t298))*0.7071067811865476)
+	t343 := t307 * complex(-0.9238795325112867, -0.3826834323650898)
+	t344 := t280 + t342
+	t345 := t280 - t342
+	t346 := t341 + t343
+	t347 := t341 - t343
+	t348 := complex(-imag(t347), real(t347))
+	t349 := t344 + t346
+	t350 := t345 + t348
+	t351 := t344 - t346
+	t352 := t345 - t348
+	t353 := t9 + t145
+	t354 := t9 - t145
+	t355 := t77 + t213
+	t356 := t77 - t213
+	t357 := complex(-imag(t356), real(t356))
+	t358 := t353 + t355
+	t359 := t354 + t357
+	t360 := t353 - t355
+	t361 := t354 - t357
+	t362 := t26 + t162
+	t363 := t26 - t162
+	t364 := t94 + t230
+	t365 := t94 - t230
+	t366 := complex(-imag(t365), real(t365))
+	t367 := t362 + t364
+	t368 := t363 + t366
+	t369 := t362 - t364
+	t370 := t363 - t366
+	t371 := t43 + t179
+	t372 := t43 - t179
+	t373 := t111 + t247
+	t374 := t111 - t247
+	t375 := complex(-imag(t374), real(t374))
+	t376 := t371 + t373
+	t377 := t372 + t375
+	t378 := t371 - t373
+	t379 := t372 - t375
+	t380 := t60 + t196
+	t381 := t60 - t196
+	t382 := t128 + t264
+	t383 := t128 - t264
+	t384 := complex(-imag(t383), real(t383))
+	t385 := t380 + t382
+	t386 := t381 + t384
+	t387 := t380 - t382
+	t388 := t381 - t384
+	t389 := t358 + t376
+	t390 := t358 - t376
+	t391 := t367 + t385
+	t392 := t367 - t385
+	t393 := complex(-imag(t392), real(t392))
+	t394 := t389 + t391
+	t395 := t390 + t393
+	t396 := t389 - t391
+	t397 := t390 - t393
+	t398 := t368 * complex(0.9238795325112867, 0.3826834323650898)
+	t399 := complex((real(t377)-imag(t377))*0.7071067811865476, (imag(t377)+real(t377))*0.7071067811865476)
+	t400 := t386 * complex(0.3826834323650898, 0.9238795325112867)
+	t401 := t359 + t399
+	t402 := t359 - t399
+	t403 := t398 + t400
+	t404 := t398 - t400
+	t405 := complex(-imag(t404), real(t404))
+	t406 := t401 + t403
+	t407 := t402 + t405
+	t408 := t401 - t403
+	t409 := t402 - t405
+	t410 := complex((real(t369)-imag(t369))*0.7071067811865476, (imag(t369)+real(t369))*0.7071067811865476)
+	t411 := complex(-imag(t378), real(t378))
+	t412 := complex((-real(t387)-imag(t387))*0.7071067811865476, (-imag(t387)+real(t387))*0.7071067811865476)
+	t413 := t360 + t411
+	t414 := t360 - t411
+	t415 := t410 + t412
+	t416 := t410 - t412
+	t417 := complex(-imag(t416), real(t416))
+	t418 := t413 + t415
+	t419 := t414 + t417
+	t420 := t413 - t415
+	t421 := t414 - t417
+	t422 := t370 * complex(0.3826834323650898, 0.9238795325112867)
+	t423 := complex((-real(t379)-imag(t379))*0.7071067811865476, (-imag(t379)+real(t379))*0.7071067811865476)
+	t424 := t388 * complex(-0.9238795325112867, -0.3826834323650898)
+	t425 := t361 + t423
+	t426 := t361 - t423
+	t427 := t422 + t424
+	t428 := t422 - t424
+	t429 := complex(-imag(t428), real(t428))
+	t430 := t425 + t427
+	t431 := t426 + t429
+	t432 := t425 - t427
+	t433 := t426 - t429
+	t434 := t15 + t151
+	t435 := t15 - t151
+	t436 := t83 + t219
+	t437 := t83 - t219
+	t438 := complex(-imag(t437), real(t437))
+	t439 := t434 + t436
+	t440 := t435 + t438
+	t441 := t434 - t436
+	t442 := t435 - t438
+	t443 := t32 + t168
+	t444 := t32 - t168
+	t445 := t100 + t236
+	t446 := t100 - t236
+	t447 := complex(-imag(t446), real(t446))
+	t448 := t443 + t445
+	t449 := t444 + t447
+	t450 := t443 - t445
+	t451 := t444 - t447
+	t452 := t49 + t185
+	t453 := t49 - t185
+	t454 := t117 + t253
+	t455 := t117 - t253
+	t456 := complex(-imag(t455), real(t455))
+	t457 := t452 + t454
+	t458 := t453 + t456
+	t459 := t452 - t454
+	t460 := t453 - t456
+	t461 := t66 + t202
+	t462 := t66 - t202
+	t463 := t134 + t270
+	t464 := t134 - t270
+	t465 := complex(-imag(t464), real(t464))
+	t466 := t461 + t463
+	t467 := t462 + t465
+	t468 := t461 - t463
+	t469 := t462 - t465
+	t470 := t439 + t457
+	t471 := t439 - t457
+	t472 := t448 + t466
+	t473 := t448 - t466
+	t474 := complex(-imag(t473), real(t473))
+	t475 := t470 + t472
+	t476 := t471 + t474
+	t477 := t470 - t472
+	t478 := t471 - t474
+	t479 := t449 * complex(0.9238795325112867, 0.3826834323650898)
+	t480 := complex((real(t458)-imag(t458))*0.7071067811865476, (imag(t458)+real(t458))*0.7071067811865476)
+	t481 := t467 * complex(0.3826834323650898, 0.9238795325112867)
+	t482 := t440 + t480
+	t483 := t440 - t480
+	t484 := t479 + t481
+	t485 := t479 - t481
+	t486 := complex(-imag(t485), real(t485))
+	t487 := t482 + t484
+	t488 := t483 + t486
+	t489 := t482 - t484
+	t490 := t483 - t486
+	t491 := complex((real(t450)-imag(t450))*0.7071067811865476, (imag(t450)+real(t450))*0.7071067811865476)
+	t492 := complex(-imag(t459), real(t459))
+	t493 := complex((-real(t468)-imag(t468))*0.7071067811865476, (-imag(t468)+real(t468))*0.7071067811865476)
+	t494 := t441 + t492
+	t495 := t441 - t492
+	t496 := t491 + t493
+	t497 := t491 - t493
+	t498 := complex(-imag(t497), real(t497))
+	t499 := t494 + t496
+	t500 := t495 + t498
+	t501 := t494 - t496
+	t502 := t495 - t498
+	t503 := t451 * complex(0.3826834323650898, 0.9238795325112867)
+	t504 := complex((-real(t460)-imag(t460))*0.7071067811865476, (-imag(t460)+real(t460))*0.7071067811865476)
+	t505 := t469 * complex(-0.9238795325112867, -0.3826834323650898)
+	t506 := t442 + t504
+	t507 := t442 - t504
+	t508 := t503 + t505
+	t509 := t503 - t505
+	t510 := complex(-imag(t509), real(t509))
+	t511 := t506 + t508
+	t512 := t507 + t510
+	t513 := t506 - t508
+	t514 := t507 - t510
+	t515 := t16 + t152
+	t516 := t16 - t152
+	t517 := t84 + t220
+	t518 := t84 - t220
+	t519 := complex(-imag(t518), real(t518))
+	t520 := t515 + t517
+	t521 := t516 + t519
+	t522 := t515 - t517
+	t523 := t516 - t519
+	t524 := t33 + t169
+	t525 := t33 - t169
+	t526 := t101 + t237
+	t527 := t101 - t237
+	t528 := complex(-imag(t527), real(t527))
+	t529 := t524 + t526
+	t530 := t525 + t528
+	t531 := t524 - t526
+	t532 := t525 - t528
+	t533 := t50 + t186
+	t534 := t50 - t186
+	t535 := t118 + t254
+	t536 := t118 - t254
+	t537 := complex(-imag(t536), real(t536))
+	t538 := t533 + t535
+	t539 := t534 + t537
+	t540 := t533 - t535
+	t541 := t534 - t537
+	t542 := t67 + t203
+	t543 := t67 - t203
+	t544 := t135 + t271
+	t545 := t135 - t271
+	t546 := complex(-imag(t545), real(t545))
+	t547 := t542 + t544
+	t548 := t543 + t546
+	t549 := t542 - t544
+	t550 := t543 - t546
+	t551 := t520 + t538
+	t552 := t520 - t538
+	t553 := t529 + t547
+	t554 := t529 - t547
+	t555 := complex(-imag(t554), real(t554))
+	t556 := t551 + t553
+	t557 := t552 + t555
+	t558 := t551 - t553
+	t559 := t552 - t555
+	t560 := t530 * complex(0.9238795325112867, 0.3826834323650898)
+	t561 := complex((real(t539)-imag(t539))*0.7071067811865476, (imag(t539)+real(t539))*0.7071067811865476)
+	t562 := t548 * complex(0.3826834323650898, 0.9238795325112867)
+	t563 := t521 + t561
+	t564 := t521 - t561
+	t565 := t560 + t562
+	t566 := t560 - t562
+	t567 := complex(-imag(t566), real(t566))
+	t568 := t563 + t565
+	t569 := t564 + t567
+	t570 := t563 - t565
+	t571 := t564 - t567
+	t572 := complex((real(t531)-imag(t531))*0.7071067811865476, (imag(t531)+real(t531))*0.7071067811865476)
+	t573 := complex(-imag(t540), real(t540))
+	t574 := complex((-real(t549)-imag(t549))*0.7071067811865476, (-imag(t549)+real(t549))*0.7071067811865476)
+	t575 := t522 + t573
+	t576 := t522 - t573
+	t577 := t572 + t574
+	t578 := t572 - t574
+	t579 := complex(-imag(t578), real(t578))
+	t580 := t575 + t577
+	t581 := t576 + t579
+	t582 := t575 - t577
+	t583 := t576 - t579
+	t584 := t532 * complex(0.3826834323650898, 0.9238795325112867)
+	t585 := complex((-real(t541)-imag(t541))*0.7071067811865476, (-imag(t541)+real(t541))*0.7071067811865476)
+	t586 := t550 * complex(-0.9238795325112867, -0.3826834323650898)
+	t587 := t523 + t585
+	t588 := t523 - t585
+	t589 := t584 + t586
+	t590 := t584 - t586
+	t591 := complex(-imag(t590), real(t590))
+	t592 := t587 + t589
+	t593 := t588 + t591
+	t594 := t587 - t589
+	t595 := t588 - t591
+	t596 := t10 + t146
+	t597 := t10 - t146
+	t598 := t78 + t214
+	t599 := t78 - t214
+	t600 := complex(-imag(t599), real(t599))
+	t601 := t596 + t598
+	t602 := t597 + t600
+	t603 := t596 - t598
+	t604 := t597 - t600
+	t605 := t27 + t163
+	t606 := t27 - t163
+	t607 := t95 + t231
+	t608 := t95 - t231
+	t609 := complex(-imag(t608), real(t608))
+	t610 := t605 + t607
+	t611 := t606 + t609
+	t612 := t605 - t607
+	t613 := t606 - t609
+	t614 := t44 + t180
+	t615 := t44 - t180
+	t616 := t112 + t248
+	t617 := t112 - t248
+	t618 := complex(-imag(t617), real(t617))
+	t619 := t614 + t616
+	t620 := t615 + t618
+	t621 := t614 - t616
+	t622 := t615 - t618
+	t623 := t61 + t197
+	t624 := t61 - t197
+	t625 := t129 + t265
+	t626 := t129 - t265
+	t627 := complex(-imag(t626), real(t626))
+	t628 := t623 + t625
+	t629 := t624 + t627
+	t630 := t623 - t625
+	t631 := t624 - t627
+	t632 := t601 + t619
+	t633 := t601 - t619
+	t634 := t610 + t628
+	t635 := t610 - t628
+	t636 := complex(-imag(t635), real(t635))
+	t637 := t632 + t634
+	t638 := t633 + t636
+	t639 := t632 - t634
+	t640 := t633 - t636
+	t641 := t611 * complex(0.9238795325112867, 0.3826834323650898)
+	t642 := complex((real(t620)-imag(t620))*0.7071067811865476, (imag(t620)+real(t620))*0.7071067811865476)
+	t643 := t629 * complex(0.3826834323650898, 0.9238795325112867)
+	t644 := t602 + t642
+	t645 := t602 - t642
+	t646 := t641 + t643
+	t647 := t641 - t643
+	t648 := complex(-imag(t647), real(t647))
+	t649 := t644 + t646
+	t650 := t645 + t648
+	t651 := t644 - t646
+	t652 := t645 - t648
+	t653 := complex((real(t612)-imag(t612))*0.7071067811865476, (imag(t612)+real(t612))*0.7071067811865476)
+	t654 := complex(-imag(t621), real(t621))
+	t655 := complex((-real(t630)-imag(t630))*0.7071067811865476, (-imag(t630)+real(t630))*0.7071067811865476)
+	t656 := t603 + t654
+	t657 := t603 - t654
+	t658 := t653 + t655
+	t659 := t653 - t655
+	t660 := complex(-imag(t659), real(t659))
+	t661 := t656 + t658
+	t662 := t657 + t660
+	t663 := t656 - t658
+	t664 := t657 - t660
+	t665 := t613 * complex(0.3826834323650898, 0.9238795325112867)
+	t666 := complex((-real(t622)-imag(t622))*0.7071067811865476, (-imag(t622)+real(t622))*0.7071067811865476)
+	t667 := t631 * complex(-0.9238795325112867, -0.3826834323650898)
+	t668 := t604 + t666
+	t669 := t604 - t666
+	t670 := t665 + t667
+	t671 := t665 - t667
+	t672 := complex(-imag(t671), real(t671))
+	t673 := t668 + t670
+	t674 := t669 + t672
+	t675 := t668 - t670
+	t676 := t669 - t672
+
+	d[0] = complex(real(t313)*0.0125, imag(t313)*0.0125)
+	d[1] = complex(real(t406)*0.0125, imag(t406)*0.0125)
+	d[2] = complex(real(t499)*0.0125, imag(t499)*0.0125)
+	d[3] = complex(real(t592)*0.0125, imag(t592)*0.0125)
+	d[4] = complex(real(t638)*0.0125, imag(t638)*0.0125)
+	d[5] = complex(real(t326)*0.0125, imag(t326)*0.0125)
+	d[6] = complex(real(t419)*0.0125, imag(t419)*0.0125)
+	d[7] = complex(real(t512)*0.0125, imag(t512)*0.0125)
+	d[8] = complex(real(t558)*0.0125, imag(t558)*0.0125)
+	d[9] = complex(real(t651)*0.0125, imag(t651)*0.0125)
+	d[10] = complex(real(t339)*0.0125, imag(t339)*0.0125)
+	d[11] = complex(real(t432)*0.0125, imag(t432)*0.0125)
+	d[12] = complex(real(t478)*0.0125, imag(t478)*0.0125)
+	d[13] = complex(real(t571)*0.0125, imag(t571)*0.0125)
+	d[14] = complex(real(t664)*0.0125, imag(t664)*0.0125)
+	d[15] = complex(real(t352)*0.0125, imag(t352)*0.0125)
+	d[16] = complex(real(t394)*0.0125, imag(t394)*0.0125)
+	d[17] = complex(real(t487)*0.0125, imag(t487)*0.0125)
+	d[18] = complex(real(t580)*0.0125, imag(t580)*0.0125)
+	d[19] = complex(real(t673)*0.0125, imag(t673)*0.0125)
+	d[20] = complex(real(t314)*0.0125, imag(t314)*0.0125)
+	d[21] = complex(real(t407)*0.0125, imag(t407)*0.0125)
+	d[22] = complex(real(t500)*0.0125, imag(t500)*0.0125)
+	d[23] = complex(real(t593)*0.0125, imag(t593)*0.0125)
+	d[24] = complex(real(t639)*0.0125, imag(t639)*0.0125)
+	d[25] = complex(real(t327)*0.0125, imag(t327)*0.0125)
+	d[26] = complex(real(t420)*0.0125, imag(t420)*0.0125)
+	d[27] = complex(real(t513)*0.0125, imag(t513)*0.0125)
+	d[28] = complex(real(t559)*0.0125, imag(t559)*0.0125)
+	d[29] = complex(real(t652)*0.0125, imag(t652)*0.0125)
+	d[30] = complex(real(t340)*0.0125, imag(t340)*0.0125)
+	d[31] = complex(real(t433)*0.0125, imag(t433)*0.0125)
+	d[32] = complex(real(t475)*0.0125, imag(t475)*0.0125)
+	d[33] = complex(real(t568)*0.0125, imag(t568)*0.0125)
+	d[34] = complex(real(t661)*0.0125, imag(t661)*0.0125)
+	d[35] = complex(real(t349)*0.0125, imag(t349)*0.0125)
+	d[36] = complex(real(t395)*0.0125, imag(t395)*0.0125)
+	d[37] = complex(real(t488)*0.0125, imag(t488)*0.0125)
+	d[38] = complex(real(t581)*0.0125, imag(t581)*0.0125)
+	d[39] = complex(real(t674)*0.0125, imag(t674)*0.0125)
+	d[40] = complex(real(t315)*0.0125, imag(t315)*0.0125)
+	d[41] = complex(real(t408)*0.0125, imag(t408)*0.0125)
+	d[42] = complex(real(t501)*0.0125, imag(t501)*0.0125)
+	d[43] = complex(real(t594)*0.0125, imag(t594)*0.0125)
+	d[44] = complex(real(t640)*0.0125, imag(t640)*0.0125)
+	d[45] = complex(real(t328)*0.0125, imag(t328)*0.0125)
+	d[46] = complex(real(t421)*0.0125, imag(t421)*0.0125)
+	d[47] = complex(real(t514)*0.0125, imag(t514)*0.0125)
+	d[48] = complex(real(t556)*0.0125, imag(t556)*0.0125)
+	d[49] = complex(real(t649)*0.0125, imag(t649)*0.0125)
+	d[50] = complex(real(t337)*0.0125, imag(t337)*0.0125)
+	d[51] = complex(real(t430)*0.0125, imag(t430)*0.0125)
+	d[52] = complex(real(t476)*0.0125, imag(t476)*0.0125)
+	d[53] = complex(real(t569)*0.0125, imag(t569)*0.0125)
+	d[54] = complex(real(t662)*0.0125, imag(t662)*0.0125)
+	d[55] = complex(real(t350)*0.0125, imag(t350)*0.0125)
+	d[56] = complex(real(t396)*0.0125, imag(t396)*0.0125)
+	d[57] = complex(real(t489)*0.0125, imag(t489)*0.0125)
+	d[58] = complex(real(t582)*0.0125, imag(t582)*0.0125)
+	d[59] = complex(real(t675)*0.0125, imag(t675)*0.0125)
+	d[60] = complex(real(t316)*0.0125, imag(t316)*0.0125)
+	d[61] = complex(real(t409)*0.0125, imag(t409)*0.0125)
+	d[62] = complex(real(t502)*0.0125, imag(t502)*0.0125)
+	d[63] = complex(real(t595)*0.0125, imag(t595)*0.0125)
+	d[64] = complex(real(t637)*0.0125, imag(t637)*0.0125)
+	d[65] = complex(real(t325)*0.0125, imag(t325)*0.0125)
+	d[66] = complex(real(t418)*0.0125, imag(t418)*0.0125)
+	d[67] = complex(real(t511)*0.0125, imag(t511)*0.0125)
+	d[68] = complex(real(t557)*0.0125, imag(t557)*0.0125)
+	d[69] = complex(real(t650)*0.0125, imag(t650)*0.0125)
+	d[70] = complex(real(t338)*0.0125, imag(t338)*0.0125)
+	d[71] = complex(real(t431)*0.0125, imag(t431)*0.0125)
+	d[72] = complex(real(t477)*0.0125, imag(t477)*0.0125)
+	d[73] = complex(real(t570)*0.0125, imag(t570)*0.0125)
+	d[74] = complex(real(t663)*0.0125, imag(t663)*0.0125)
+	d[75] = complex(real(t351)*0.0125, imag(t351)*0.0125)
+	d[76] = complex(real(t397)*0.0125, imag(t397)*0.0125)
+	d[77] = complex(real(t490)*0.0125, imag(t490)*0.0125)
+	d[78] = complex(real(t583)*0.0125, imag(t583)*0.0125)
+	d[79] = complex(real(t676)*0.0125, imag(t676)*0.0125)
+
+	return true
+}
+
+// forwardDFT80Complex128 computes a 80-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT80Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 80
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+	x60 := s[60]
+	x61 := s[61]
+	x62 := s[62]
+	x63 := s[63]
+	x64 := s[64]
+	x65 := s[65]
+	x66 := s[66]
+	x67 := s[67]
+	x68 := s[68]
+	x69 := s[69]
+	x70 := s[70]
+	x71 := s[71]
+	x72 := s[72]
+	x73 := s[73]
+	x74 := s[74]
+	x75 := s[75]
+	x76 := s[76]
+	x77 := s[77]
+	x78 := s[78]
+	x79 := s[79]
+
+	t0 := x16 + x64
+	t1 := x16 - x64
+	t2 := x32 + x48
+	t3 := x32 - x48
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x21 + x69
+	t18 := x21 - x69
+	t19 := x37 + x53
+	t20 := x37 - x53
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x26 + x74
+	t35 := x26 - x74
+	t36 := x42 + x58
+	t37 := x42 - x58
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x31 + x79
+	t52 := x31 - x79
+	t53 := x47 + x63
+	t54 := x47 - x63
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := x36 + x4
+	t69 := x36 - x4
+	t70 := x52 + x68
+	t71 := x52 - x68
+	t72 := x20 + t68 + t70
+	t73 := real(x20) + 0.3090169943749474*real(t68) - 0.8090169943749473*real(t70)
+	t74 := imag(x20) + 0.3090169943749474*imag(t68) - 0.8090169943749473*imag(t70)
+	t75 := 0.9510565162951536*real(t69) + 0.5877852522924731*real(t71)
+	t76 := 0.9510565162951536*imag(t69) + 0.5877852522924731*imag(t71)
+	t77 := complex(t73-t76, t74+t75)
+	t78 := complex(t73+t76, t74-t75)
+	t79 := real(x20) - 0.8090169943749473*real(t68) + 0.3090169943749474*real(t70)
+	t80 := imag(x20) - 0.8090169943749473*imag(t68) + 0.3090169943749474*imag(t70)
+	t81 := 0.5877852522924731*real(t69) - 0.9510565162951536*real(t71)
+	t82 := 0.5877852522924731*imag(t69) - 0.9510565162951536*imag(t71)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := x41 + x9
+	t86 := x41 - x9
+	t87 := x57 + x73
+	t88 := x57 - x73
+	t89 := x25 + t85 + t87
+	t90 := real(x25) + 0.3090169943749474*real(t85) - 0.8090169943749473*real(t87)
+	t91 := imag(x25) + 0.3090169943749474*imag(t85) - 0.8090169943749473*imag(t87)
+	t92 := 0.9510565162951536*real(t86) + 0.5877852522924731*real(t88)
+	t93 := 0.9510565162951536*imag(t86) + 0.5877852522924731*imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := real(x25) - 0.8090169943749473*real(t85) + 0.3090169943749474*real(t87)
+	t97 := imag(x25) - 0.8090169943749473*imag(t85) + 0.3090169943749474*imag(t87)
+	t98 := 0.5877852522924731*real(t86) - 0.9510565162951536*real(t88)
+	t99 := 0.5877852522924731*imag(t86) - 0.9510565162951536*imag(t88)
+	t100 := complex(t96-t99, t97+t98)
+	t101 := complex(t96+t99, t97-t98)
+	t102 := x46 + x14
+	t103 := x46 - x14
+	t104 := x62 + x78
+	t105 := x62 - x78
+	t106 := x30 + t102 + t104
+	t107 := real(x30) + 0.3090169943749474*real(t102) - 0.8090169943749473*real(t104)
+	t108 := imag(x30) + 0.3090169943749474*imag(t102) - 0.8090169943749473*imag(t104)
+	t109 := 0.9510565162951536*real(t103) + 0.5877852522924731*real(t105)
+	t110 := 0.9510565162951536*imag(t103) + 0.5877852522924731*imag(t105)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := real(x30) - 0.8090169943749473*real(t102) + 0.3090169943749474*real(t104)
+	t114 := imag(x30) - 0.8090169943749473*imag(t102) + 0.3090169943749474*imag(t104)
+	t115 := 0.5877852522924731*real(t103) - 0.9510565162951536*real(t105)
+	t116 := 0.5877852522924731*imag(t103) - 0.9510565162951536*imag(t105)
+	t117 := complex(t113-t116, t114+t115)
+	t118 := complex(t113+t116, t114-t115)
+	t119 := x51 + x19
+	t120 := x51 - x19
+	t121 := x67 + x3
+	t122 := x67 - x3
+	t123 := x35 + t119 + t121
+	t124 := real(x35) + 0.3090169943749474*real(t119) - 0.8090169943749473*real(t121)
+	t125 := imag(x35) + 0.3090169943749474*imag(t119) - 0.8090169943749473*imag(t121)
+	t126 := 0.9510565162951536*real(t120) + 0.5877852522924731*real(t122)
+	t127 := 0.9510565162951536*imag(t120) + 0.5877852522924731*imag(t122)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := real(x35) - 0.8090169943749473*real(t119) + 0.3090169943749474*real(t121)
+	t131 := imag(x35) - 0.8090169943749473*imag(t119) + 0.3090169943749474*imag(t121)
+	t132 := 0.5877852522924731*real(t120) - 0.9510565162951536*real(t122)
+	t133 := 0.5877852522924731*imag(t120) - 0.9510565162951536*imag(t122)
+	t134 := complex(t130-t133, t131+t132)
+	t135 := complex(t130+t133, t131-t132)
+	t136 := x56 + x24
+	t137 := x56 - x24
+	t138 := x72 + x8
+	t139 := x72 - x8
+	t140 := x40 + t136 + t138
+	t141 := real(x40) + 0.3090169943749474*real(t136) - 0.8090169943749473*real(t138)
+	t142 := imag(x40) + 0.3090169943749474*imag(t136) - 0.8090169943749473*imag(t138)
+	t143 := 0.9510565162951536*real(t137) + 0.5877852522924731*real(t139)
+	t144 := 0.9510565162951536*imag(t137) + 0.5877852522924731*imag(t139)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := real(x40) - 0.8090169943749473*real(t136) + 0.3090169943749474*real(t138)
+	t148 := imag(x40) - 0.8090169943749473*imag(t136) + 0.3090169943749474*imag(t138)
+	t149 := 0.5877852522924731*real(t137) - 0.9510565162951536*real(t139)
+	t150 := 0.5877852522924731*imag(t137) - 0.9510565162951536*imag(t139)
+	t151 := complex(t147-t150, t148+t149)
+	t152 := complex(t147+t150, t148-t149)
+	t153 := x61 + x29
+	t154 := x61 - x29
+	t155 := x77 + x13
+	t156 := x77 - x13
+	t157 := x45 + t153 + t155
+	t158 := real(x45) + 0.3090169943749474*real(t153) - 0.8090169943749473*real(t155)
+	t159 := imag(x45) + 0.3090169943749474*imag(t153) - 0.8090169943749473*imag(t155)
+	t160 := 0.9510565162951536*real(t154) + 0.5877852522924731*real(t156)
+	t161 := 0.9510565162951536*imag(t154) + 0.5877852522924731*imag(t156)
+	t162 := complex(t158-t161, t159+t160)
+	t163 := complex(t158+t161, t159-t160)
+	t164 := real(x45) - 0.8090169943749473*real(t153) + 0.3090169943749474*real(t155)
+	t165 := imag(x45) - 0.8090169943749473*imag(t153) + 0.3090169943749474*imag(t155)
+	t166 := 0.5877852522924731*real(t154) - 0.9510565162951536*real(t156)
+	t167 := 0.5877852522924731*imag(t154) - 0.9510565162951536*imag(t156)
+	t168 := complex(t164-t167, t165+t166)
+	t169 := complex(t164+t167, t165-t166)
+	t170 := x66 + x34
+	t171 := x66 - x34
+	t172 := x2 + x18
+	t173 := x2 - x18
+	t174 := x50 + t170 + t172
+	t175 := real(x50) + 0.3090169943749474*real(t170) - 0.8090169943749473*real(t172)
+	t176 := imag(x50) + 0.3090169943749474*imag(t170) - 0.8090169943749473*imag(t172)
+	t177 := 0.9510565162951536*real(t171) + 0.5877852522924731*real(t173)
+	t178 := 0.9510565162951536*imag(t171) + 0.5877852522924731*imag(t173)
+	t179 := complex(t175-t178, t176+t177)
+	t180 := complex(t175+t178, t176-t177)
+	t181 := real(x50) - 0.8090169943749473*real(t170) + 0.3090169943749474*real(t172)
+	t182 := imag(x50) - 0.8090169943749473*imag(t170) + 0.3090169943749474*imag(t172)
+	t183 := 0.5877852522924731*real(t171) - 0.9510565162951536*real(t173)
+	t184 := 0.5877852522924731*imag(t171) - 0.9510565162951536*imag(t173)
+	t185 := complex(t181-t184, t182+t183)
+	t186 := complex(t181+t184, t182-t183)
+	t187 := x71 + x39
+	t188 := x71 - x39
+	t189 := x7 + x23
+	t190 := x7 - x23
+	t191 := x55 + t187 + t189
+	t192 := real(x55) + 0.3090169943749474*real(t187) - 0.8090169943749473*real(t189)
+	t193 := imag(x55) + 0.3090169943749474*imag(t187) - 0.8090169943749473*imag(t189)
+	t194 := 0.9510565162951536*real(t188) + 0.5877852522924731*real(t190)
+	t195 := 0.9510565162951536*imag(t188) + 0.5877852522924731*imag(t190)
+	t196 := complex(t192-t195, t193+t194)
+	t197 := complex(t192+t195, t193-t194)
+	t198 := real(x55) - 0.8090169943749473*real(t187) + 0.3090169943749474*real(t189)
+	t199 := imag(x55) - 0.8090169943749473*imag(t187) + 0.3090169943749474*imag(t189)
+	t200 := 0.5877852522924731*real(t188) - 0.9510565162951536*real(t190)
+	t201 := 0.5877852522924731*imag(t188) - 0.9510565162951536*imag(t190)
+	t202 := complex(t198-t201, t199+t200)
+	t203 := complex(t198+t201, t199-t200)
+	t204 := x76 + x44
+	t205 := x76 - x44
+	t206 := x12 + x28
+	t207 := x12 - x28
+	t208 := x60 + t204 + t206
+	t209 := real(x60) + 0.3090169943749474*real(t204) - 0.8090169943749473*real(t206)
+	t210 := imag(x60) + 0.3090169943749474*imag(t204) - 0.8090169943749473*imag(t206)
+	t211 := 0.9510565162951536*real(t205) + 0.5877852522924731*real(t207)
+	t212 := 0.9510565162951536*imag(t205) + 0.5877852522924731*imag(t207)
+	t213 := complex(t209-t212, t210+t211)
+	t214 := complex(t209+t212, t210-t211)
+	t215 := real(x60) - 0.8090169943749473*real(t204) + 0.3090169943749474*real(t206)
+	t216 := imag(x60) - 0.8090169943749473*imag(t204) + 0.3090169943749474*imag(t206)
+	t217 := 0.5877852522924731*real(t205) - 0.9510565162951536*real(t207)
+	t218 := 0.5877852522924731*imag(t205) - 0.9510565162951536*imag(t207)
+	t219 := complex(t215-t218, t216+t217)
+	t220 := complex(t215+t218, t216-t217)
+	t221 := x1 + x49
+	t222 := x1 - x49
+	t223 := x17 + x33
+	t224 := x17 - x33
+	t225 := x65 + t221 + t223
+	t226 := real(x65) + 0.3090169943749474*real(t221) - 0.8090169943749473*real(t223)
+	t227 := imag(x65) + 0.3090169943749474*imag(t221) - 0.8090169943749473*imag(t223)
+	t228 := 0.9510565162951536*real(t222) + 0.5877852522924731*real(t224)
+	t229 := 0.9510565162951536*imag(t222) + 0.5877852522924731*imag(t224)
+	t230 := complex(t226-t229, t227+t228)
+	t231 := complex(t226+t229, t227-t228)
+	t232 := real(x65) - 0.8090169943749473*real(t221) + 0.3090169943749474*real(t223)
+	t233 := imag(x65) - 0.8090169943749473*imag(t221) + 0.3090169943749474*imag(t223)
+	t234 := 0.5877852522924731*real(t222) - 0.9510565162951536*real(t224)
+	t235 := 0.5877852522924731*imag(t222) - 0.9510565162951536*imag(t224)
+	t236 := complex(t232-t235, t233+t234)
+	t237 := complex(t232+t235, t233-t234)
+	t238 := x6 + x54
+	t239 := x6 - x54
+	t240 := x22 + x38
+	t241 := x22 - x38
+	t242 := x70 + t238 + t240
+	t243 := real(x70) + 0.3090169943749474*real(t238) - 0.8090169943749473*real(t240)
+	t244 := imag(x70) + 0.3090169943749474*imag(t238) - 0.8090169943749473*imag(t240)
+	t245 := 0.9510565162951536*real(t239) + 0.5877852522924731*real(t241)
+	t246 := 0.9510565162951536*imag(t239) + 0.5877852522924731*imag(t241)
+	t247 := complex(t243-t246, t244+t245)
+	t248 := complex(t243+t246, t244-t245)
+	t249 := real(x70) - 0.8090169943749473*real(t238) + 0.3090169943749474*real(t240)
+	t250 := imag(x70) - 0.8090169943749473*imag(t238) + 0.3090169943749474*imag(t240)
+	t251 := 0.5877852522924731*real(t239) - 0.9510565162951536*real(t241)
+	t252 := 0.5877852522924731*imag(t239) - 0.9510565162951536*imag(t241)
+	t253 := complex(t249-t252, t250+t251)
+	t254 := complex(t249+t252, t250-t251)
+	t255 := x11 + x59
+	t256 := x11 - x59
+	t257 := x27 + x43
+	t258 := x27 - x43
+	t259 := x75 + t255 + t257
+	t260 := real(x75) + 0.3090169943749474*real(t255) - 0.8090169943749473*real(t257)
+	t261 := imag(x75) + 0.3090169943749474*imag(t255) - 0.8090169943749473*imag(t257)
+	t262 := 0.9510565162951536*real(t256) + 0.5877852522924731*real(t258)
+	t263 := 0.9510565162951536*imag(t256) + 0.5877852522924731*imag(t258)
+	t264 := complex(t260-t263, t261+t262)
+	t265 := complex(t260+t263, t261-t262)
+	t266 := real(x75) - 0.8090169943749473*real(t255) + 0.3090169943749474*real(t257)
+	t267 := imag(x75) - 0.8090169943749473*imag(t255) + 0.3090169943749474*imag(t257)
+	t268 := 0.5877852522924731*real(t256) - 0.9510565162951536*real(t258)
+	t269 := 0.5877852522924731*imag(t256) - 0.9510565162951536*imag(t258)
+	t270 := complex(t266-t269, t267+t268)
+	t271 := complex(t266+t269, t267-t268)
+	t272 := t4 + t140
+	t273 := t4 - t140
+	t274 := t72 + t208
+	t275 := t72 - t208
+	t276 := complex(imag(t275), -real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+	t281 := t21 + t157
+	t282 := t21 - t157
+	t283 := t89 + t225
+	t284 := t89 - t225
+	t285 := complex(imag(t284), -real(t284))
+	t286 := t281 + t283
+	t287 := t282 + t285
+	t288 := t281 - t283
+	t289 := t282 - t285
+	t290 := t38 + t174
+	t291 := t38 - t174
+	t292 := t106 + t242
+	t293 := t106 - t242
+	t294 := complex(imag(t293), -real(t293))
+	t295 := t290 + t292
+	t296 := t291 + t294
+	t297 := t290 - t292
+	t298 := t291 - t294
+	t299 := t55 + t191
+	t300 := t55 - t191
+	t301 := t123 + t259
+	t302 := t123 - t259
+	t303 := complex(imag(t302), -real(t302))
+	t304 := t299 + t301
+	t305 := t300 + t303
+	t306 := t299 - t301
+	t307 := t300 - t303
+	t308 := t277 + t295
+	t309 := t277 - t295
+	t310 := t286 + t304
+	t311 := t286 - t304
+	t312 := complex(imag(t311), -real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := t287 * complex(0.9238795325112867, -0.3826834323650898)
+	t318 := complex((real(t296)+imag(t296))*0.7071067811865476, (imag(t296)-real(t296))*0.7071067811865476)
+	t319 := t305 * complex(0.3826834323650898, -0.9238795325112867)
+	t320 := t278 + t318
+	t321 := t278 - t318
+	t322 := t317 + t319
+	t323 := t317 - t319
+	t324 := complex(imag(t323), -real(t323))
+	t325 := t320 + t322
+	t326 := t321 + t324
+	t327 := t320 - t322
+	t328 := t321 - t324
+	t329 := complex((real(t288)+imag(t288))*0.7071067811865476, (imag(t288)-real(t288))*0.7071067811865476)
+	t330 := complex(imag(t297), -real(t297))
+	t331 := complex((-real(t306)+imag(t306))*0.7071067811865476, (-imag(t306)-real(t306))*0.7071067811865476)
+	t332 := t279 + t330
+	t333 := t279 - t330
+	t334 := t329 + t331
+	t335 := t329 - t331
+	t336 := complex(imag(t335), -real(t335))
+	t337 := t332 + t334
+	t338 := t333 + t336
+	t339 := t332 - t334
+	t340 := t333 - t336
+	t341 := t289 * complex(0.3826834323650898, -0.9238795325112867)
+	t342 := complex((-real(t298)+imag(t298))*0.7071067811865476, (-imag(t298)-real(t298))*0.7071067811865476)
+	t343 := t307 * complex(-0.9238795325112867, 0.3826834323650898)
+	t344 := t280 + t342
+	t345 := t280 - t342
+	t346 := t341 + t343
+	t347 := t341 - t343
+	t348 := complex(imag(t347), -real(t347))
+	t349 := t344 + t346
+	t350 := t345 + t348
+	t351 := t344 - t346
+	t352 := t345 - t348
+	t353 := t10 + t146
+	t354 := t10 - t146
+	t355 := t78 + t214
+	t356 := t78 - t214
+	t357 := complex(imag(t356), -real(t356))
+	t358 := t353 + t355
+	t359 := t354 + t357
+	t360 := t353 - t355
+	t361 := t354 - t357
+	t362 := t27 + t163
+	t363 := t27 - t163
+	t364 := t95 + t231
+	t365 := t95 - t231
+	t366 := complex(imag(t365), -real(t365))
+	t367 := t362 + t364
+	t368 := t363 + t366
+	t369 := t362 - t364
+	t370 := t363 - t366
+	t371 := t44 + t180
+	t372 := t44 - t180
+	t373 := t112 + t248
+	t374 := t112 - t248
+	t375 := complex(imag(t374), -real(t374))
+	t376 := t371 + t373
+	t377 := t372 + t375
+	t378 := t371 - t373
+	t379 := t372 - t375
+	t380 := t61 + t197
+	t381 := t61 - t197
+	t382 := t129 + t265
+	t383 := t129 - t265
+	t384 := complex(imag(t383), -real(t383))
+	t385 := t380 + t382
+	t386 := t381 + t384
+	t387 := t380 - t382
+	t388 := t381 - t384
+	t389 := t358 + t376
+	t390 := t358 - t376
+	t391 := t367 + t385
+	t392 := t367 - t385
+	t393 := complex(imag(t392), -real(t392))
+	t394 := t389 + t391
+	t395 := t390 + t393
+	t396 := t389 - t391
+	t397 := t390 - t393
+	t398 := t368 * complex(0.9238795325112867, -0.3826834323650898)
+	t399 := complex((real(t377)+imag(t377))*0.7071067811865476, (imag(t377)-real(t377))*0.7071067811865476)
+	t400 := t386 * complex(0.3826834323650898, -0.9238795325112867)
+	t401 := t359 + t399
+	t402 := t359 - t399
+	t403 := t398 + t400
+	t404 := t398 - t400
+	t405 := complex(imag(t404), -real(t404))
+	t406 := t401 + t403
+	t407 := t402 + t405
+	t408 := t401 - t403
+	t409 := t402 - t405
+	t410 := complex((real(t369)+imag(t369))*0.7071067811865476, (imag(t369)-real(t369))*0.7071067811865476)
+	t411 := complex(imag(t378), -real(t378))
+	t412 := complex((-real(t387)+imag(t387))*0.7071067811865476, (-imag(t387)-real(t387))*0.7071067811865476)
+	t413 := t360 + t411
+	t414 := t360 - t411
+	t415 := t410 + t412
+	t416 := t410 - t412
+	t417 := complex(imag(t416), -real(t416))
+	t418 := t413 + t415
+	t419 := t414 + t417
+	t420 := t413 - t415
+	t421 := t414 - t417
+	t422 := t370 * complex(0.3826834323650898, -0.9238795325112867)
+	t423 := complex((-real(t379)+imag(t379))*0.7071067811865476, (-imag(t379)-real(t379))*0.7071067811865476)
+	t424 := t388 * complex(-0.9238795325112867, 0.3826834323650898)
+	t425 := t361 + t423
+	t426 := t361 - t423
+	t427 := t422 + t424
+	t428 := t422 - t424
+	t429 := complex(imag(t428), -real(t428))
+	t430 := t425 + t427
+	t431 := t426 + t429
+	t432 := t425 - t427
+	t433 := t426 - t429
+	t434 := t16 + t152
+	t435 := t16 - t152
+	t436 := t84 + t220
+	t437 := t84 - t220
+	t438 := complex(imag(t437), -real(t437))
+	t439 := t434 + t436
+	t440 := t435 + t438
+	t441 := t434 - t436
+	t442 := t435 - t438
+	t443 := t33 + t169
+	t444 := t33 - t169
+	t445 := t101 + t237
+	t446 := t101 - t237
+	t447 := complex(imag(t446), -real(t446))
+	t448 := t443 + t445
+	t449 := t444 + t447
+	t450 := t443 - t445
+	t451 := t444 - t447
+	t452 := t50 + t186
+	t453 := t50 - t186
+	t454 := t118 + t254
+	t455 := t118 - t254
+	t456 := complex(imag(t455), -real(t455))
+	t457 := t452 + t454
+	t458 := t453 + t456
+	t459 := t452 - t454
+	t460 := t453 - t456
+	t461 := t67 + t203
+	t462 := t67 - t203
+	t463 := t135 + t271
+	t464 := t135 - t271
+	t465 := complex(imag(t464), -real(t464))
+	t466 := t461 + t463
+	t467 := t462 + t465
+	t468 := t461 - t463
+	t469 := t462 - t465
+	t470 := t439 + t457
+	t471 := t439 - t457
+	t472 := t448 + t466
+	t473 := t448 - t466
+	t474 := complex(imag(t473), -real(t473))
+	t475 := t470 + t472
+	t476 := t471 + t474
+	t477 := t470 - t472
+	t478 := t471 - t474
+	t479 := t449 * complex(0.9238795325112867, -0.3826834323650898)
+	t480 := complex((real(t458)+imag(t458))*0.7071067811865476, (imag(t458)-real(t458))*0.7071067811865476)
+	t481 := t467 * complex(0.3826834323650898, -0.9238795325112867)
+	t482 := t440 + t480
+	t483 := t440 - t480
+	t484 := t479 + t481
+	t485 := t479 - t481
+	t486 := complex(imag(t485), -real(t485))
+	t487 := t482 + t484
+	t488 := t483 + t486
+	t489 := t482 - t484
+	t490 := t483 - t486
+	t491 := complex((real(t450)+imag(t450))*0.7071067811865476, (imag(t450)-real(t450))*0.7071067811865476)
+	t492 := complex(imag(t459), -real(t459))
+	t493 := complex((-real(t468)+imag(t468))*0.7071067811865476, (-imag(t468)-real(t468))*0.7071067811865476)
+	t494 := t441 + t492
+	t495 := t441 - t492
+	t496 := t491 + t493
+	t497 := t491 - t493
+	t498 := complex(imag(t497), -real(t497))
+	t499 := t494 + t496
+	t500 := t495 + t498
+	t501 := t494 - t496
+	t502 := t495 - t498
+	t503 := t451 * complex(0.3826834323650898, -0.9238795325112867)
+	t504 := complex((-real(t460)+imag(t460))*0.7071067811865476, (-imag(t460)-real(t460))*0.7071067811865476)
+	t505 := t469 * complex(-0.9238795325112867, 0.3826834323650898)
+	t506 := t442 + t504
+	t507 := t442 - t504
+	t508 := t503 + t505
+	t509 := t503 - t505
+	t510 := complex(imag(t509), -real(t509))
+	t511 := t506 + t508
+	t512 := t507 + t510
+	t513 := t506 - t508
+	t514 := t507 - t510
+	t515 := t15 + t151
+	t516 := t15 - t151
+	t517 := t83 + t219
+	t518 := t83 - t219
+	t519 := complex(imag(t518), -real(t518))
+	t520 := t515 + t517
+	t521 := t516 + t519
+	t522 := t515 - t517
+	t523 := t516 - t519
+	t524 := t32 + t168
+	t525 := t32 - t168
+	t526 := t100 + t236
+	t527 := t100 - t236
+	t528 := complex(imag(t527), -real(t527))
+	t529 := t524 + t526
+	t530 := t525 + t528
+	t531 := t524 - t526
+	t532 := t525 - t528
+	t533 := t49 + t185
+	t534 := t49 - t185
+	t535 := t117 + t253
+	t536 := t117 - t253
+	t537 := complex(imag(t536), -real(t536))
+	t538 := t533 + t535
+	t539 := t534 + t537
+	t540 := t533 - t535
+	t541 := t534 - t537
+	t542 := t66 + t202
+	t543 := t66 - t202
+	t544 := t134 + t270
+	t545 := t134 - t270
+	t546 := complex(imag(t545), -real(t545))
+	t547 := t542 + t544
+	t548 := t543 + t546
+	t549 := t542 - t544
+	t550 := t543 - t546
+	t551 := t520 + t538
+	t552 := t520 - t538
+	t553 := t529 + t547
+	t554 := t529 - t547
+	t555 := complex(imag(t554), -real(t554))
+	t556 := t551 + t553
+	t557 := t552 + t555
+	t558 := t551 - t553
+	t559 := t552 - t555
+	t560 := t530 * complex(0.9238795325112867, -0.3826834323650898)
+	t561 := complex((real(t539)+imag(t539))*0.7071067811865476, (imag(t539)-real(t539))*0.7071067811865476)
+	t562 := t548 * complex(0.3826834323650898, -0.9238795325112867)
+	t563 := t521 + t561
+	t564 := t521 - t561
+	t565 := t560 + t562
+	t566 := t560 - t562
+	t567 := complex(imag(t566), -real(t566))
+	t568 := t563 + t565
+	t569 := t564 + t567
+	t570 := t563 - t565
+	t571 := t564 - t567
+	t572 := complex((real(t531)+imag(t531))*0.7071067811865476, (imag(t531)-real(t531))*0.7071067811865476)
+	t573 := complex(imag(t540), -real(t540))
+	t574 := complex((-real(t549)+imag(t549))*0.7071067811865476, (-imag(t549)-real(t549))*0.7071067811865476)
+	t575 := t522 + t573
+	t576 := t522 - t573
+	t577 := t572 + t574
+	t578 := t572 - t574
+	t579 := complex(imag(t578), -real(t578))
+	t580 := t575 + t577
+	t581 := t576 + t579
+	t582 := t575 - t577
+	t583 := t576 - t579
+	t584 := t532 * complex(0.3826834323650898, -0.9238795325112867)
+	t585 := complex((-real(t541)+imag(t541))*0.7071067811865476, (-imag(t541)-real(t541))*0.7071067811865476)
+	t586 := t550 * complex(-0.9238795325112867, 0.3826834323650898)
+	t587 := t523 + t585
+	t588 := t523 - t585
+	t589 := t584 + t586
+	t590 := t584 - t586
+	t591 := complex(imag(t590), -real(t590))
+	t592 := t587 + t589
+	t593 := t588 + t591
+	t594 := t587 - t589
+	t595 := t588 - t591
+	t596 := t9 + t145
+	t597 := t9 - t145
+	t598 := t77 + t213
+	t599 := t77 - t213
+	t600 := complex(imag(t599), -real(t599))
+	t601 := t596 + t598
+	t602 := t597 + t600
+	t603 := t596 - t598
+	t604 := t597 - t600
+	t605 := t26 + t162
+	t606 := t26 - t162
+	t607 := t94 + t230
+	t608 := t94 - t230
+	t609 := complex(imag(t608), -real(t608))
+	t610 := t605 + t607
+	t611 := t606 + t609
+	t612 := t605 - t607
+	t613 := t606 - t609
+	t614 := t43 + t179
+	t615 := t43 - t179
+	t616 := t111 + t247
+	t617 := t111 - t247
+	t618 := complex(imag(t617), -real(t617))
+	t619 := t614 + t616
+	t620 := t615 + t618
+	t621 := t614 - t616
+	t622 := t615 - t618
+	t623 := t60 + t196
+	t624 := t60 - t196
+	t625 := t128 + t264
+	t626 := t128 - t264
+	t627 := complex(imag(t626), -real(t626))
+	t628 := t623 + t625
+	t629 := t624 + t627
+	t630 := t623 - t625
+	t631 := t624 - t627
+	t632 := t601 + t619
+	t633 := t601 - t619
+	t634 := t610 + t628
+	t635 := t610 - t628
+	t636 := complex(imag(t635), -real(t635))
+	t637 := t632 + t634
+	t638 := t633 + t636
+	t639 := t632 - t634
+	t640 := t633 - t636
+	t641 := t611 * complex(0.9238795325112867, -0.3826834323650898)
+	t642 := complex((real(t620)+imag(t620))*0.7071067811865476, (imag(t620)-real(t620))*0.7071067811865476)
+	t643 := t629 * complex(0.3826834323650898, -0.9238795325112867)
+	t644 := t602 + t642
+	t645 := t602 - t642
+	t646 := t641 + t643
+	t647 := t641 - t643
+	t648 := complex(imag(t647), -real(t647))
+	t649 := t644 + t646
+	t650 := t645 + t648
+	t651 := t644 - t646
+	t652 := t645 - t648
+	t653 := complex((real(t612)+imag(t612))*0.7071067811865476, (imag(t612)-real(t612))*0.7071067811865476)
+	t654 := complex(imag(t621), -real(t621))
+	t655 := complex((-real(t630)+imag(t630))*0.7071067811865476, (-imag(t630)-real(t630))*0.7071067811865476)
+	t656 := t603 + t654
+	t657 := t603 - t654
+	t658 := t653 + t655
+	t659 := t653 - t655
+	t660 := complex(imag(t659), -real(t659))
+	t661 := t656 + t658
+	t662 := t657 + t660
+	t663 := t656 - t658
+	t664 := t657 - t660
+	t665 := t613 * complex(0.3826834323650898, -0.9238795325112867)
+	t666 := complex((-real(t622)+imag(t622))*0.7071067811865476, (-imag(t622)-real(t622))*0.7071067811865476)
+	t667 := t631 * complex(-0.9238795325112867, 0.3826834323650898)
+	t668 := t604 + t666
+	t669 := t604 - t666
+	t670 := t665 + t667
+	t671 := t665 - t667
+	t672 := complex(imag(t671), -real(t671))
+	t673 := t668 + t670
+	t674 := t669 + t672
+	t675 := t668 - t670
+	t676 := t669 - t672
+
+	d[0] = t313
+	d[1] = t406
+	d[2] = t499
+	d[3] = t592
+	d[4] = t638
+	d[5] = t326
+	d[6] = t419
+	d[7] = t512
+	d[8] = t558
+	d[9] = t651
+	d[10] = t339
+	d[11] = t432
+	d[12] = t478
+	d[13] = t571
+	d[14] = t664
+	d[15] = t352
+	d[16] = t394
+	d[17] = t487
+	d[18] = t580
+	d[19] = t673
+	d[20] = t314
+	d[21] = t407
+	d[22] = t500
+	d[23] = t593
+	d[24] = t639
+	d[25] = t327
+	d[26] = t420
+	d[27] = t513
+	d[28] = t559
+	d[29] = t652
+	d[30] = t340
+	d[31] = t433
+	d[32] = t475
+	d[33] = t568
+	d[34] = t661
+	d[35] = t349
+	d[36] = t395
+	d[37] = t488
+	d[38] = t581
+	d[39] = t674
+	d[40] = t315
+	d[41] = t408
+	d[42] = t501
+	d[43] = t594
+	d[44] = t640
+	d[45] = t328
+	d[46] = t421
+	d[47] = t514
+	d[48] = t556
+	d[49] = t649
+	d[50] = t337
+	d[51] = t430
+	d[52] = t476
+	d[53] = t569
+	d[54] = t662
+	d[55] = t350
+	d[56] = t396
+	d[57] = t489
+	d[58] = t582
+	d[59] = t675
+	d[60] = t316
+	d[61] = t409
+	d[62] = t502
+	d[63] = t595
+	d[64] = t637
+	d[65] = t325
+	d[66] = t418
+	d[67] = t511
+	d[68] = t557
+	d[69] = t650
+	d[70] = t338
+	d[71] = t431
+	d[72] = t477
+	d[73] = t570
+	d[74] = t663
+	d[75] = t351
+	d[76] = t397
+	d[77] = t490
+	d[78] = t583
+	d[79] = t676
+
+	return true
+}
+
+// inverseDFT80Complex128 computes a 80-point inverse DFT of complex128 data,
+// including the 1/80 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT80Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 80
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+	x60 := s[60]
+	x61 := s[61]
+	x62 := s[62]
+	x63 := s[63]
+	x64 := s[64]
+	x65 := s[65]
+	x66 := s[66]
+	x67 := s[67]
+	x68 := s[68]
+	x69 := s[69]
+	x70 := s[70]
+	x71 := s[71]
+	x72 := s[72]
+	x73 := s[73]
+	x74 := s[74]
+	x75 := s[75]
+	x76 := s[76]
+	x77 := s[77]
+	x78 := s[78]
+	x79 := s[79]
+
+	t0 := x16 + x64
+	t1 := x16 - x64
+	t2 := x32 + x48
+	t3 := x32 - x48
+	t4 := x0 + t0 + t2
+	t5 := real(x0) + 0.3090169943749474*real(t0) - 0.8090169943749473*real(t2)
+	t6 := imag(x0) + 0.3090169943749474*imag(t0) - 0.8090169943749473*imag(t2)
+	t7 := 0.9510565162951536*real(t1) + 0.5877852522924731*real(t3)
+	t8 := 0.9510565162951536*imag(t1) + 0.5877852522924731*imag(t3)
+	t9 := complex(t5-t8, t6+t7)
+	t10 := complex(t5+t8, t6-t7)
+	t11 := real(x0) - 0.8090169943749473*real(t0) + 0.3090169943749474*real(t2)
+	t12 := imag(x0) - 0.8090169943749473*imag(t0) + 0.3090169943749474*imag(t2)
+	t13 := 0.5877852522924731*real(t1) - 0.9510565162951536*real(t3)
+	t14 := 0.5877852522924731*imag(t1) - 0.9510565162951536*imag(t3)
+	t15 := complex(t11-t14, t12+t13)
+	t16 := complex(t11+t14, t12-t13)
+	t17 := x21 + x69
+	t18 := x21 - x69
+	t19 := x37 + x53
+	t20 := x37 - x53
+	t21 := x5 + t17 + t19
+	t22 := real(x5) + 0.3090169943749474*real(t17) - 0.8090169943749473*real(t19)
+	t23 := imag(x5) + 0.3090169943749474*imag(t17) - 0.8090169943749473*imag(t19)
+	t24 := 0.9510565162951536*real(t18) + 0.5877852522924731*real(t20)
+	t25 := 0.9510565162951536*imag(t18) + 0.5877852522924731*imag(t20)
+	t26 := complex(t22-t25, t23+t24)
+	t27 := complex(t22+t25, t23-t24)
+	t28 := real(x5) - 0.8090169943749473*real(t17) + 0.3090169943749474*real(t19)
+	t29 := imag(x5) - 0.8090169943749473*imag(t17) + 0.3090169943749474*imag(t19)
+	t30 := 0.5877852522924731*real(t18) - 0.9510565162951536*real(t20)
+	t31 := 0.5877852522924731*imag(t18) - 0.9510565162951536*imag(t20)
+	t32 := complex(t28-t31, t29+t30)
+	t33 := complex(t28+t31, t29-t30)
+	t34 := x26 + x74
+	t35 := x26 - x74
+	t36 := x42 + x58
+	t37 := x42 - x58
+	t38 := x10 + t34 + t36
+	t39 := real(x10) + 0.3090169943749474*real(t34) - 0.8090169943749473*real(t36)
+	t40 := imag(x10) + 0.3090169943749474*imag(t34) - 0.8090169943749473*imag(t36)
+	t41 := 0.9510565162951536*real(t35) + 0.5877852522924731*real(t37)
+	t42 := 0.9510565162951536*imag(t35) + 0.5877852522924731*imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := real(x10) - 0.8090169943749473*real(t34) + 0.3090169943749474*real(t36)
+	t46 := imag(x10) - 0.8090169943749473*imag(t34) + 0.3090169943749474*imag(t36)
+	t47 := 0.5877852522924731*real(t35) - 0.9510565162951536*real(t37)
+	t48 := 0.5877852522924731*imag(t35) - 0.9510565162951536*imag(t37)
+	t49 := complex(t45-t48, t46+t47)
+	t50 := complex(t45+t48, t46-t47)
+	t51 := x31 + x79
+	t52 := x31 - x79
+	t53 := x47 + x63
+	t54 := x47 - x63
+	t55 := x15 + t51 + t53
+	t56 := real(x15) + 0.3090169943749474*real(t51) - 0.8090169943749473*real(t53)
+	t57 := imag(x15) + 0.3090169943749474*imag(t51) - 0.8090169943749473*imag(t53)
+	t58 := 0.9510565162951536*real(t52) + 0.5877852522924731*real(t54)
+	t59 := 0.9510565162951536*imag(t52) + 0.5877852522924731*imag(t54)
+	t60 := complex(t56-t59, t57+t58)
+	t61 := complex(t56+t59, t57-t58)
+	t62 := real(x15) - 0.8090169943749473*real(t51) + 0.3090169943749474*real(t53)
+	t63 := imag(x15) - 0.8090169943749473*imag(t51) + 0.3090169943749474*imag(t53)
+	t64 := 0.5877852522924731*real(t52) - 0.9510565162951536*real(t54)
+	t65 := 0.5877852522924731*imag(t52) - 0.9510565162951536*imag(t54)
+	t66 := complex(t62-t65, t63+t64)
+	t67 := complex(t62+t65, t63-t64)
+	t68 := x36 + x4
+	t69 := x36 - x4
+	t70 := x52 + x68
+	t71 := x52 - x68
+	t72 := x20 + t68 + t70
+	t73 := real(x20) + 0.3090169943749474*real(t68) - 0.8090169943749473*real(t70)
+	t74 := imag(x20) + 0.3090169943749474*imag(t68) - 0.8090169943749473*imag(t70)
+	t75 := 0.9510565162951536*real(t69) + 0.5877852522924731*real(t71)
+	t76 := 0.9510565162951536*imag(t69) + 0.5877852522924731*imag(t71)
+	t77 := complex(t73-t76, t74+t75)
+	t78 := complex(t73+t76, t74-t75)
+	t79 := real(x20) - 0.8090169943749473*real(t68) + 0.3090169943749474*real(t70)
+	t80 := imag(x20) - 0.8090169943749473*imag(t68) + 0.3090169943749474*imag(t70)
+	t81 := 0.5877852522924731*real(t69) - 0.9510565162951536*real(t71)
+	t82 := 0.5877852522924731*imag(t69) - 0.9510565162951536*imag(t71)
+	t83 := complex(t79-t82, t80+t81)
+	t84 := complex(t79+t82, t80-t81)
+	t85 := x41 + x9
+	t86 := x41 - x9
+	t87 := x57 + x73
+	t88 := x57 - x73
+	t89 := x25 + t85 + t87
+	t90 := real(x25) + 0.3090169943749474*real(t85) - 0.8090169943749473*real(t87)
+	t91 := imag(x25) + 0.3090169943749474*imag(t85) - 0.8090169943749473*imag(t87)
+	t92 := 0.9510565162951536*real(t86) + 0.5877852522924731*real(t88)
+	t93 := 0.9510565162951536*imag(t86) + 0.5877852522924731*imag(t88)
+	t94 := complex(t90-t93, t91+t92)
+	t95 := complex(t90+t93, t91-t92)
+	t96 := real(x25) - 0.8090169943749473*real(t85) + 0.3090169943749474*real(t87)
+	t97 := imag(x25) - 0.8090169943749473*imag(t85) + 0.3090169943749474*imag(t87)
+	t98 := 0.5877852522924731*real(t86) - 0.9510565162951536*real(t88)
+	t99 := 0.5877852522924731*imag(t86) - 0.9510565162951536*imag(t88)
+	t100 := complex(t96-t99, t97+t98)
+	t101 := complex(t96+t99, t97-t98)
+	t102 := x46 + x14
+	t103 := x46 - x14
+	t104 := x62 + x78
+	t105 := x62 - x78
+	t106 := x30 + t102 + t104
+	t107 := real(x30) + 0.3090169943749474*real(t102) - 0.8090169943749473*real(t104)
+	t108 := imag(x30) + 0.3090169943749474*imag(t102) - 0.8090169943749473*imag(t104)
+	t109 := 0.9510565162951536*real(t103) + 0.5877852522924731*real(t105)
+	t110 := 0.9510565162951536*imag(t103) + 0.5877852522924731*imag(t105)
+	t111 := complex(t107-t110, t108+t109)
+	t112 := complex(t107+t110, t108-t109)
+	t113 := real(x30) - 0.8090169943749473*real(t102) + 0.3090169943749474*real(t104)
+	t114 := imag(x30) - 0.8090169943749473*imag(t102) + 0.3090169943749474*imag(t104)
+	t115 := 0.5877852522924731*real(t103) - 0.9510565162951536*real(t105)
+	t116 := 0.5877852522924731*imag(t103) - 0.9510565162951536*imag(t105)
+	t117 := complex(t113-t116, t114+t115)
+	t118 := complex(t113+t116, t114-t115)
+	t119 := x51 + x19
+	t120 := x51 - x19
+	t121 := x67 + x3
+	t122 := x67 - x3
+	t123 := x35 + t119 + t121
+	t124 := real(x35) + 0.3090169943749474*real(t119) - 0.8090169943749473*real(t121)
+	t125 := imag(x35) + 0.3090169943749474*imag(t119) - 0.8090169943749473*imag(t121)
+	t126 := 0.9510565162951536*real(t120) + 0.5877852522924731*real(t122)
+	t127 := 0.9510565162951536*imag(t120) + 0.5877852522924731*imag(t122)
+	t128 := complex(t124-t127, t125+t126)
+	t129 := complex(t124+t127, t125-t126)
+	t130 := real(x35) - 0.8090169943749473*real(t119) + 0.3090169943749474*real(t121)
+	t131 := imag(x35) - 0.8090169943749473*imag(t119) + 0.3090169943749474*imag(t121)
+	t132 := 0.5877852522924731*real(t120) - 0.9510565162951536*real(t122)
+	t133 := 0.5877852522924731*imag(t120) - 0.9510565162951536*imag(t122)
+	t134 := complex(t130-t133, t131+t132)
+	t135 := complex(t130+t133, t131-t132)
+	t136 := x56 + x24
+	t137 := x56 - x24
+	t138 := x72 + x8
+	t139 := x72 - x8
+	t140 := x40 + t136 + t138
+	t141 := real(x40) + 0.3090169943749474*real(t136) - 0.8090169943749473*real(t138)
+	t142 := imag(x40) + 0.3090169943749474*imag(t136) - 0.8090169943749473*imag(t138)
+	t143 := 0.9510565162951536*real(t137) + 0.5877852522924731*real(t139)
+	t144 := 0.9510565162951536*imag(t137) + 0.5877852522924731*imag(t139)
+	t145 := complex(t141-t144, t142+t143)
+	t146 := complex(t141+t144, t142-t143)
+	t147 := real(x40) - 0.8090169943749473*real(t136) + 0.3090169943749474*real(t138)
+	t148 := imag(x40) - 0.8090169943749473*imag(t136) + 0.3090169943749474*imag(t138)
+	t149 := 0.5877852522924731*real(t137) - 0.9510565162951536*real(t139)
+	t150 := 0.5877852522924731*imag(t137) - 0.9510565162951536*imag(t139)
+	t151 := complex(t147-t150, t148+t149)
+	t152 := complex(t147+t150, t148-t149)
+	t153 := x61 + x29
+	t154 := x61 - x29
+	t155 := x77 + x13
+	t156 := x77 - x13
+	t157 := x45 + t153 + t155
+	t158 := real(x45) + 0.3090169943749474*real(t153) - 0.8090169943749473*real(t155)
+	t159 := imag(x45) + 0.3090169943749474*imag(t153) - 0.8090169943749473*imag(t155)
+	t160 := 0.9510565162951536*real(t154) + 0.5877852522924731*real(t156)
+	t161 := 0.9510565162951536*imag(t154) + 0.5877852522924731*imag(t156)
+	t162 := complex(t158-t161, t159+t160)
+	t163 := complex(t158+t161, t159-t160)
+	t164 := real(x45) - 0.8090169943749473*real(t153) + 0.3090169943749474*real(t155)
+	t165 := imag(x45) - 0.8090169943749473*imag(t153) + 0.3090169943749474*imag(t155)
+	t166 := 0.5877852522924731*real(t154) - 0.9510565162951536*real(t156)
+	t167 := 0.5877852522924731*imag(t154) - 0.9510565162951536*imag(t156)
+	t168 := complex(t164-t167, t165+t166)
+	t169 := complex(t164+t167, t165-t166)
+	t170 := x66 + x34
+	t171 := x66 - x34
+	t172 := x2 + x18
+	t173 := x2 - x18
+	t174 := x50 + t170 + t172
+	t175 := real(x50) + 0.3090169943749474*real(t170) - 0.8090169943749473*real(t172)
+	t176 := imag(x50) + 0.3090169943749474*imag(t170) - 0.8090169943749473*imag(t172)
+	t177 := 0.9510565162951536*real(t171) + 0.5877852522924731*real(t173)
+	t178 := 0.9510565162951536*imag(t171) + 0.5877852522924731*imag(t173)
+	t179 := complex(t175-t178, t176+t177)
+	t180 := complex(t175+t178, t176-t177)
+	t181 := real(x50) - 0.8090169943749473*real(t170) + 0.3090169943749474*real(t172)
+	t182 := imag(x50) - 0.8090169943749473*imag(t170) + 0.3090169943749474*imag(t172)
+	t183 := 0.5877852522924731*real(t171) - 0.9510565162951536*real(t173)
+	t184 := 0.5877852522924731*imag(t171) - 0.9510565162951536*imag(t173)
+	t185 := complex(t181-t184, t182+t183)
+	t186 := complex(t181+t184, t182-t183)
+	t187 := x71 + x39
+	t188 := x71 - x39
+	t189 := x7 + x23
+	t190 := x7 - x23
+	t191 := x55 + t187 + t189
+	t192 := real(x55) + 0.3090169943749474*real(t187) - 0.8090169943749473*real(t189)
+	t193 := imag(x55) + 0.3090169943749474*imag(t187) - 0.8090169943749473*imag(t189)
+	t194 := 0.9510565162951536*real(t188) + 0.5877852522924731*real(t190)
+	t195 := 0.9510565162951536*imag(t188) + 0.5877852522924731*imag(t190)
+	t196 := complex(t192-t195, t193+t194)
+	t197 := complex(t192+t195, t193-t194)
+	t198 := real(x55) - 0.8090169943749473*real(t187) + 0.3090169943749474*real(t189)
+	t199 := imag(x55) - 0.8090169943749473*imag(t187) + 0.3090169943749474*imag(t189)
+	t200 := 0.5877852522924731*real(t188) - 0.9510565162951536*real(t190)
+	t201 := 0.5877852522924731*imag(t188) - 0.9510565162951536*imag(t190)
+	t202 := complex(t198-t201, t199+t200)
+	t203 := complex(t198+t201, t199-t200)
+	t204 := x76 + x44
+	t205 := x76 - x44
+	t206 := x12 + x28
+	t207 := x12 - x28
+	t208 := x60 + t204 + t206
+	t209 := real(x60) + 0.3090169943749474*real(t204) - 0.8090169943749473*real(t206)
+	t210 := imag(x60) + 0.3090169943749474*imag(t204) - 0.8090169943749473*imag(t206)
+	t211 := 0.9510565162951536*real(t205) + 0.5877852522924731*real(t207)
+	t212 := 0.9510565162951536*imag(t205) + 0.5877852522924731*imag(t207)
+	t213 := complex(t209-t212, t210+t211)
+	t214 := complex(t209+t212, t210-t211)
+	t215 := real(x60) - 0.8090169943749473*real(t204) + 0.3090169943749474*real(t206)
+	t216 := imag(x60) - 0.8090169943749473*imag(t204) + 0.3090169943749474*imag(t206)
+	t217 := 0.5877852522924731*real(t205) - 0.9510565162951536*real(t207)
+	t218 := 0.5877852522924731*imag(t205) - 0.9510565162951536*imag(t207)
+	t219 := complex(t215-t218, t216+t217)
+	t220 := complex(t215+t218, t216-t217)
+	t221 := x1 + x49
+	t222 := x1 - x49
+	t223 := x17 + x33
+	t224 := x17 - x33
+	t225 := x65 + t221 + t223
+	t226 := real(x65) + 0.3090169943749474*real(t221) - 0.8090169943749473*real(t223)
+	t227 := imag(x65) + 0.3090169943749474*imag(t221) - 0.8090169943749473*imag(t223)
+	t228 := 0.9510565162951536*real(t222) + 0.5877852522924731*real(t224)
+	t229 := 0.9510565162951536*imag(t222) + 0.5877852522924731*imag(t224)
+	t230 := complex(t226-t229, t227+t228)
+	t231 := complex(t226+t229, t227-t228)
+	t232 := real(x65) - 0.8090169943749473*real(t221) + 0.3090169943749474*real(t223)
+	t233 := imag(x65) - 0.8090169943749473*imag(t221) + 0.3090169943749474*imag(t223)
+	t234 := 0.5877852522924731*real(t222) - 0.9510565162951536*real(t224)
+	t235 := 0.5877852522924731*imag(t222) - 0.9510565162951536*imag(t224)
+	t236 := complex(t232-t235, t233+t234)
+	t237 := complex(t232+t235, t233-t234)
+	t238 := x6 + x54
+	t239 := x6 - x54
+	t240 := x22 + x38
+	t241 := x22 - x38
+	t242 := x70 + t238 + t240
+	t243 := real(x70) + 0.3090169943749474*real(t238) - 0.8090169943749473*real(t240)
+	t244 := imag(x70) + 0.3090169943749474*imag(t238) - 0.8090169943749473*imag(t240)
+	t245 := 0.9510565162951536*real(t239) + 0.5877852522924731*real(t241)
+	t246 := 0.9510565162951536*imag(t239) + 0.5877852522924731*imag(t241)
+	t247 := complex(t243-t246, t244+t245)
+	t248 := complex(t243+t246, t244-t245)
+	t249 := real(x70) - 0.8090169943749473*real(t238) + 0.3090169943749474*real(t240)
+	t250 := imag(x70) - 0.8090169943749473*imag(t238) + 0.3090169943749474*imag(t240)
+	t251 := 0.5877852522924731*real(t239) - 0.9510565162951536*real(t241)
+	t252 := 0.5877852522924731*imag(t239) - 0.9510565162951536*imag(t241)
+	t253 := complex(t249-t252, t250+t251)
+	t254 := complex(t249+t252, t250-t251)
+	t255 := x11 + x59
+	t256 := x11 - x59
+	t257 := x27 + x43
+	t258 := x27 - x43
+	t259 := x75 + t255 + t257
+	t260 := real(x75) + 0.3090169943749474*real(t255) - 0.8090169943749473*real(t257)
+	t261 := imag(x75) + 0.3090169943749474*imag(t255) - 0.8090169943749473*imag(t257)
+	t262 := 0.9510565162951536*real(t256) + 0.5877852522924731*real(t258)
+	t263 := 0.9510565162951536*imag(t256) + 0.5877852522924731*imag(t258)
+	t264 := complex(t260-t263, t261+t262)
+	t265 := complex(t260+t263, t261-t262)
+	t266 := real(x75) - 0.8090169943749473*real(t255) + 0.3090169943749474*real(t257)
+	t267 := imag(x75) - 0.8090169943749473*imag(t255) + 0.3090169943749474*imag(t257)
+	t268 := 0.5877852522924731*real(t256) - 0.9510565162951536*real(t258)
+	t269 := 0.5877852522924731*imag(t256) - 0.9510565162951536*imag(t258)
+	t270 := complex(t266-t269, t267+t268)
+	t271 := complex(t266+t269, t267-t268)
+	t272 := t4 + t140
+	t273 := t4 - t140
+	t274 := t72 + t208
+	t275 := t72 - t208
+	t276 := complex(-imag(t275), real(t275))
+	t277 := t272 + t274
+	t278 := t273 + t276
+	t279 := t272 - t274
+	t280 := t273 - t276
+	t281 := t21 + t157
+	t282 := t21 - t157
+	t283 := t89 + t225
+	t284 := t89 - t225
+	t285 := complex(-imag(t284), real(t284))
+	t286 := t281 + t283
+	t287 := t282 + t285
+	t288 := t281 - t283
+	t289 := t282 - t285
+	t290 := t38 + t174
+	t291 := t38 - t174
+	t292 := t106 + t242
+	t293 := t106 - t242
+	t294 := complex(-imag(t293), real(t293))
+	t295 := t290 + t292
+	t296 := t291 + t294
+	t297 := t290 - t292
+	t298 := t291 - t294
+	t299 := t55 + t191
+	t300 := t55 - t191
+	t301 := t123 + t259
+	t302 := t123 - t259
+	t303 := complex(-imag(t302), real(t302))
+	t304 := t299 + t301
+	t305 := t300 + t303
+	t306 := t299 - t301
+	t307 := t300 - t303
+	t308 := t277 + t295
+	t309 := t277 - t295
+	t310 := t286 + t304
+	t311 := t286 - t304
+	t312 := complex(-imag(t311), real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := t287 * complex(0.9238795325112867, 0.3826834323650898)
+	t318 := complex((real(t296)-imag(t296))*0.7071067811865476, (imag(t296)+real(t296))*0.7071067811865476)
+	t319 := t305 * complex(0.3826834323650898, 0.9238795325112867)
+	t320 := t278 + t318
+	t321 := t278 - t318
+	t322 := t317 + t319
+	t323 := t317 - t319
+	t324 := complex(-imag(t323), real(t323))
+	t325 := t320 + t322
+	t326 := t321 + t324
+	t327 := t320 - t322
+	t328 := t321 - t324
+	t329 := complex((real(t288)-imag(t288))*0.7071067811865476, (imag(t288)+real(t288))*0.7071067811865476)
+	t330 := complex(-imag(t297), real(t297))
+	t331 := complex((-real(t306)-imag(t306))*0.7071067811865476, (-imag(t306)+real(t306))*0.7071067811865476)
+	t332 := t279 + t330
+	t333 := t279 - t330
+	t334 := t329 + t331
+	t335 := t329 - t331
+	t336 := complex(-imag(t335), real(t335))
+	t337 := t332 + t334
+	t338 := t333 + t336
+	t339 := t332 - t334
+	t340 := t333 - t336
+	t341 := t289 * complex(0.3826834323650898, 0.9238795325112867)
+	t342 := complex((-real(t298)-imag(t298))*0.7071067811865476, (-imag(t298)+real(t298))*0.7071067811865476)
+	t343 := t307 * complex(-0.9238795325112867, -0.3826834323650898)
+	t344 := t280 + t342
+	t345 := t280 - t342
+	t346 := t341 + t343
+	t347 := t341 - t343
+	t348 := complex(-imag(t347), real(t347))
+	t349 := t344 + t346
+	t350 := t345 + t348
+	t351 := t344 - t346
+	t352 := t345 - t348
+	t353 := t9 + t145
+	t354 := t9 - t145
+	t355 := t77 + t213
+	t356 := t77 - t213
+	t357 := complex(-imag(t356), real(t356))
+	t358 := t353 + t355
+	t359 := t354 + t357
+	t360 := t353 - t355
+	t361 := t354 - t357
+	t362 := t26 + t162
+	t363 := t26 - t162
+	t364 := t94 + t230
+	t365 := t94 - t230
+	t366 := complex(-imag(t365), real(t365))
+	t367 := t362 + t364
+	t368 := t363 + t366
+	t369 := t362 - t364
+	t370 := t363 - t366
+	t371 := t43 + t179
+	t372 := t43 - t179
+	t373 := t111 + t247
+	t374 := t111 - t247
+	t375 := complex(-imag(t374), real(t374))
+	t376 := t371 + t373
+	t377 := t372 + t375
+	t378 := t371 - t373
+	t379 := t372 - t375
+	t380 := t60 + t196
+	t381 := t60 - t196
+	t382 := t128 + t264
+	t383 := t128 - t264
+	t384 := complex(-imag(t383), real(t383))
+	t385 := t380 + t382
+	t386 := t381 + t384
+	t387 := t380 - t382
+	t388 := t381 - t384
+	t389 := t358 + t376
+	t390 := t358 - t376
+	t391 := t367 + t385
+	t392 := t367 - t385
+	t393 := complex(-imag(t392), real(t392))
+	t394 := t389 + t391
+	t395 := t390 + t393
+	t396 := t389 - t391
+	t397 := t390 - t393
+	t398 := t368 * complex(0.9238795325112867, 0.3826834323650898)
+	t399 := complex((real(t377)-imag(t377))*0.7071067811865476, (imag(t377)+real(t377))*0.7071067811865476)
+	t400 := t386 * complex(0.3826834323650898, 0.9238795325112867)
+	t401 := t359 + t399
+	t402 := t359 - t399
+	t403 := t398 + t400
+	t404 := t398 - t400
+	t405 := complex(-imag(t404), real(t404))
+	t406 := t401 + t403
+	t407 := t402 + t405
+	t408 := t401 - t403
+	t409 := t402 - t405
+	t410 := complex((real(t369)-imag(t369))*0.7071067811865476, (imag(t369)+real(t369))*0.7071067811865476)
+	t411 := complex(-imag(t378), real(t378))
+	t412 := complex((-real(t387)-imag(t387))*0.7071067811865476, (-imag(t387)+real(t387))*0.7071067811865476)
+	t413 := t360 + t411
+	t414 := t360 - t411
+	t415 := t410 + t412
+	t416 := t410 - t412
+	t417 := complex(-imag(t416), real(t416))
+	t418 := t413 + t415
+	t419 := t414 + t417
+	t420 := t413 - t415
+	t421 := t414 - t417
+	t422 := t370 * complex(0.3826834323650898, 0.9238795325112867)
+	t423 := complex((-real(t379)-imag(t379))*0.7071067811865476, (-imag(t379)+real(t379))*0.7071067811865476)
+	t424 := t388 * complex(-0.9238795325112867, -0.3826834323650898)
+	t425 := t361 + t423
+	t426 := t361 - t423
+	t427 := t422 + t424
+	t428 := t422 - t424
+	t429 := complex(-imag(t428), real(t428))
+	t430 := t425 + t427
+	t431 := t426 + t429
+	t432 := t425 - t427
+	t433 := t426 - t429
+	t434 := t15 + t151
+	t435 := t15 - t151
+	t436 := t83 + t219
+	t437 := t83 - t219
+	t438 := complex(-imag(t437), real(t437))
+	t439 := t434 + t436
+	t440 := t435 + t438
+	t441 := t434 - t436
+	t442 := t435 - t438
+	t443 := t32 + t168
+	t444 := t32 - t168
+	t445 := t100 + t236
+	t446 := t100 - t236
+	t447 := complex(-imag(t446), real(t446))
+	t448 := t443 + t445
+	t449 := t444 + t447
+	t450 := t443 - t445
+	t451 := t444 - t447
+	t452 := t49 + t185
+	t453 := t49 - t185
+	t454 := t117 + t253
+	t455 := t117 - t253
+	t456 := complex(-imag(t455), real(t455))
+	t457 := t452 + t454
+	t458 := t453 + t456
+	t459 := t452 - t454
+	t460 := t453 - t456
+	t461 := t66 + t202
+	t462 := t66 - t202
+	t463 := t134 + t270
+	t464 := t134 - t270
+	t465 := complex(-imag(t464), real(t464))
+	t466 := t461 + t463
+	t467 := t462 + t465
+	t468 := t461 - t463
+	t469 := t462 - t465
+	t470 := t439 + t457
+	t471 := t439 - t457
+	t472 := t448 + t466
+	t473 := t448 - t466
+	t474 := complex(-imag(t473), real(t473))
+	t475 := t470 + t472
+	t476 := t471 + t474
+	t477 := t470 - t472
+	t478 := t471 - t474
+	t479 := t449 * complex(0.9238795325112867, 0.3826834323650898)
+	t480 := complex((real(t458)-imag(t458))*0.7071067811865476, (imag(t458)+real(t458))*0.7071067811865476)
+	t481 := t467 * complex(0.3826834323650898, 0.9238795325112867)
+	t482 := t440 + t480
+	t483 := t440 - t480
+	t484 := t479 + t481
+	t485 := t479 - t481
+	t486 := complex(-imag(t485), real(t485))
+	t487 := t482 + t484
+	t488 := t483 + t486
+	t489 := t482 - t484
+	t490 := t483 - t486
+	t491 := complex((real(t450)-imag(t450))*0.7071067811865476, (imag(t450)+real(t450))*0.7071067811865476)
+	t492 := complex(-imag(t459), real(t459))
+	t493 := complex((-real(t468)-imag(t468))*0.7071067811865476, (-imag(t468)+real(t468))*0.7071067811865476)
+	t494 := t441 + t492
+	t495 := t441 - t492
+	t496 := t491 + t493
+	t497 := t491 - t493
+	t498 := complex(-imag(t497), real(t497))
+	t499 := t494 + t496
+	t500 := t495 + t498
+	t501 := t494 - t496
+	t502 := t495 - t498
+	t503 := t451 * complex(0.3826834323650898, 0.9238795325112867)
+	t504 := complex((-real(t460)-imag(t460))*0.7071067811865476, (-imag(t460)+real(t460))*0.7071067811865476)
+	t505 := t469 * complex(-0.9238795325112867, -0.3826834323650898)
+	t506 := t442 + t504
+	t507 := t442 - t504
+	t508 := t503 + t505
+	t509 := t503 - t505
+	t510 := complex(-imag(t509), real(t509))
+	t511 := t506 + t508
+	t512 := t507 + t510
+	t513 := t506 - t508
+	t514 := t507 - t510
+	t515 := t16 + t152
+	t516 := t16 - t152
+	t517 := t84 + t220
+	t518 := t84 - t220
+	t519 := complex(-imag(t518), real(t518))
+	t520 := t515 + t517
+	t521 := t516 + t519
+	t522 := t515 - t517
+	t523 := t516 - t519
+	t524 := t33 + t169
+	t525 := t33 - t169
+	t526 := t101 + t237
+	t527 := t101 - t237
+	t528 := complex(-imag(t527), real(t527))
+	t529 := t524 + t526
+	t530 := t525 + t528
+	t531 := t524 - t526
+	t532 := t525 - t528
+	t533 := t50 + t186
+	t534 := t50 - t186
+	t535 := t118 + t254
+	t536 := t118 - t254
+	t537 := complex(-imag(t536), real(t536))
+	t538 := t533 + t535
+	t539 := t534 + t537
+	t540 := t533 - t535
+	t541 := t534 - t537
+	t542 := t67 + t203
+	t543 := t67 - t203
+	t544 := t135 + t271
+	t545 := t135 - t271
+	t546 := complex(-imag(t545), real(t545))
+	t547 := t542 + t544
+	t548 := t543 + t546
+	t549 := t542 - t544
+	t550 := t543 - t546
+	t551 := t520 + t538
+	t552 := t520 - t538
+	t553 := t529 + t547
+	t554 := t529 - t547
+	t555 := complex(-imag(t554), real(t554))
+	t556 := t551 + t553
+	t557 := t552 + t555
+	t558 := t551 - t553
+	t559 := t552 - t555
+	t560 := t530 * complex(0.9238795325112867, 0.3826834323650898)
+	t561 := complex((real(t539)-imag(t539))*0.7071067811865476, (imag(t539)+real(t539))*0.7071067811865476)
+	t562 := t548 * complex(0.3826834323650898, 0.9238795325112867)
+	t563 := t521 + t561
+	t564 := t521 - t561
+	t565 := t560 + t562
+	t566 := t560 - t562
+	t567 := complex(-imag(t566), real(t566))
+	t568 := t563 + t565
+	t569 := t564 + t567
+	t570 := t563 - t565
+	t571 := t564 - t567
+	t572 := complex((real(t531)-imag(t531))*0.7071067811865476, (imag(t531)+real(t531))*0.7071067811865476)
+	t573 := complex(-imag(t540), real(t540))
+	t574 := complex((-real(t549)-imag(t549))*0.7071067811865476, (-imag(t549)+real(t549))*0.7071067811865476)
+	t575 := t522 + t573
+	t576 := t522 - t573
+	t577 := t572 + t574
+	t578 := t572 - t574
+	t579 := complex(-imag(t578), real(t578))
+	t580 := t575 + t577
+	t581 := t576 + t579
+	t582 := t575 - t577
+	t583 := t576 - t579
+	t584 := t532 * complex(0.3826834323650898, 0.9238795325112867)
+	t585 := complex((-real(t541)-imag(t541))*0.7071067811865476, (-imag(t541)+real(t541))*0.7071067811865476)
+	t586 := t550 * complex(-0.9238795325112867, -0.3826834323650898)
+	t587 := t523 + t585
+	t588 := t523 - t585
+	t589 := t584 + t586
+	t590 := t584 - t586
+	t591 := complex(-imag(t590), real(t590))
+	t592 := t587 + t589
+	t593 := t588 + t591
+	t594 := t587 - t589
+	t595 := t588 - t591
+	t596 := t10 + t146
+	t597 := t10 - t146
+	t598 := t78 + t214
+	t599 := t78 - t214
+	t600 := complex(-imag(t599), real(t599))
+	t601 := t596 + t598
+	t602 := t597 + t600
+	t603 := t596 - t598
+	t604 := t597 - t600
+	t605 := t27 + t163
+	t606 := t27 - t163
+	t607 := t95 + t231
+	t608 := t95 - t231
+	t609 := complex(-imag(t608), real(t608))
+	t610 := t605 + t607
+	t611 := t606 + t609
+	t612 := t605 - t607
+	t613 := t606 - t609
+	t614 := t44 + t180
+	t615 := t44 - t180
+	t616 := t112 + t248
+	t617 := t112 - t248
+	t618 := complex(-imag(t617), real(t617))
+	t619 := t614 + t616
+	t620 := t615 + t618
+	t621 := t614 - t616
+	t622 := t615 - t618
+	t623 := t61 + t197
+	t624 := t61 - t197
+	t625 := t129 + t265
+	t626 := t129 - t265
+	t627 := complex(-imag(t626), real(t626))
+	t628 := t623 + t625
+	t629 := t624 + t627
+	t630 := t623 - t625
+	t631 := t624 - t627
+	t632 := t601 + t619
+	t633 := t601 - t619
+	t634 := t610 + t628
+	t635 := t610 - t628
+	t636 := complex(-imag(t635), real(t635))
+	t637 := t632 + t634
+	t638 := t633 + t636
+	t639 := t632 - t634
+	t640 := t633 - t636
+	t641 := t611 * complex(0.9238795325112867, 0.3826834323650898)
+	t642 := complex((real(t620)-imag(t620))*0.7071067811865476, (imag(t620)+real(t620))*0.7071067811865476)
+	t643 := t629 * complex(0.3826834323650898, 0.9238795325112867)
+	t644 := t602 + t642
+	t645 := t602 - t642
+	t646 := t641 + t643
+	t647 := t641 - t643
+	t648 := complex(-imag(t647), real(t647))
+	t649 := t644 + t646
+	t650 := t645 + t648
+	t651 := t644 - t646
+	t652 := t645 - t648
+	t653 := complex((real(t612)-imag(t612))*0.7071067811865476, (imag(t612)+real(t612))*0.7071067811865476)
+	t654 := complex(-imag(t621), real(t621))
+	t655 := complex((-real(t630)-imag(t630))*0.7071067811865476, (-imag(t630)+real(t630))*0.7071067811865476)
+	t656 := t603 + t654
+	t657 := t603 - t654
+	t658 := t653 + t655
+	t659 := t653 - t655
+	t660 := complex(-imag(t659), real(t659))
+	t661 := t656 + t658
+	t662 := t657 + t660
+	t663 := t656 - t658
+	t664 := t657 - t660
+	t665 := t613 * complex(0.3826834323650898, 0.9238795325112867)
+	t666 := complex((-real(t622)-imag(t622))*0.7071067811865476, (-imag(t622)+real(t622))*0.7071067811865476)
+	t667 := t631 * complex(-0.9238795325112867, -0.3826834323650898)
+	t668 := t604 + t666
+	t669 := t604 - t666
+	t670 := t665 + t667
+	t671 := t665 - t667
+	t672 := complex(-imag(t671), real(t671))
+	t673 := t668 + t670
+	t674 := t669 + t672
+	t675 := t668 - t670
+	t676 := t669 - t672
+
+	d[0] = complex(real(t313)*0.0125, imag(t313)*0.0125)
+	d[1] = complex(real(t406)*0.0125, imag(t406)*0.0125)
+	d[2] = complex(real(t499)*0.0125, imag(t499)*0.0125)
+	d[3] = complex(real(t592)*0.0125, imag(t592)*0.0125)
+	d[4] = complex(real(t638)*0.0125, imag(t638)*0.0125)
+	d[5] = complex(real(t326)*0.0125, imag(t326)*0.0125)
+	d[6] = complex(real(t419)*0.0125, imag(t419)*0.0125)
+	d[7] = complex(real(t512)*0.0125, imag(t512)*0.0125)
+	d[8] = complex(real(t558)*0.0125, imag(t558)*0.0125)
+	d[9] = complex(real(t651)*0.0125, imag(t651)*0.0125)
+	d[10] = complex(real(t339)*0.0125, imag(t339)*0.0125)
+	d[11] = complex(real(t432)*0.0125, imag(t432)*0.0125)
+	d[12] = complex(real(t478)*0.0125, imag(t478)*0.0125)
+	d[13] = complex(real(t571)*0.0125, imag(t571)*0.0125)
+	d[14] = complex(real(t664)*0.0125, imag(t664)*0.0125)
+	d[15] = complex(real(t352)*0.0125, imag(t352)*0.0125)
+	d[16] = complex(real(t394)*0.0125, imag(t394)*0.0125)
+	d[17] = complex(real(t487)*0.0125, imag(t487)*0.0125)
+	d[18] = complex(real(t580)*0.0125, imag(t580)*0.0125)
+	d[19] = complex(real(t673)*0.0125, imag(t673)*0.0125)
+	d[20] = complex(real(t314)*0.0125, imag(t314)*0.0125)
+	d[21] = complex(real(t407)*0.0125, imag(t407)*0.0125)
+	d[22] = complex(real(t500)*0.0125, imag(t500)*0.0125)
+	d[23] = complex(real(t593)*0.0125, imag(t593)*0.0125)
+	d[24] = complex(real(t639)*0.0125, imag(t639)*0.0125)
+	d[25] = complex(real(t327)*0.0125, imag(t327)*0.0125)
+	d[26] = complex(real(t420)*0.0125, imag(t420)*0.0125)
+	d[27] = complex(real(t513)*0.0125, imag(t513)*0.0125)
+	d[28] = complex(real(t559)*0.0125, imag(t559)*0.0125)
+	d[29] = complex(real(t652)*0.0125, imag(t652)*0.0125)
+	d[30] = complex(real(t340)*0.0125, imag(t340)*0.0125)
+	d[31] = complex(real(t433)*0.0125, imag(t433)*0.0125)
+	d[32] = complex(real(t475)*0.0125, imag(t475)*0.0125)
+	d[33] = complex(real(t568)*0.0125, imag(t568)*0.0125)
+	d[34] = complex(real(t661)*0.0125, imag(t661)*0.0125)
+	d[35] = complex(real(t349)*0.0125, imag(t349)*0.0125)
+	d[36] = complex(real(t395)*0.0125, imag(t395)*0.0125)
+	d[37] = complex(real(t488)*0.0125, imag(t488)*0.0125)
+	d[38] = complex(real(t581)*0.0125, imag(t581)*0.0125)
+	d[39] = complex(real(t674)*0.0125, imag(t674)*0.0125)
+	d[40] = complex(real(t315)*0.0125, imag(t315)*0.0125)
+	d[41] = complex(real(t408)*0.0125, imag(t408)*0.0125)
+	d[42] = complex(real(t501)*0.0125, imag(t501)*0.0125)
+	d[43] = complex(real(t594)*0.0125, imag(t594)*0.0125)
+	d[44] = complex(real(t640)*0.0125, imag(t640)*0.0125)
+	d[45] = complex(real(t328)*0.0125, imag(t328)*0.0125)
+	d[46] = complex(real(t421)*0.0125, imag(t421)*0.0125)
+	d[47] = complex(real(t514)*0.0125, imag(t514)*0.0125)
+	d[48] = complex(real(t556)*0.0125, imag(t556)*0.0125)
+	d[49] = complex(real(t649)*0.0125, imag(t649)*0.0125)
+	d[50] = complex(real(t337)*0.0125, imag(t337)*0.0125)
+	d[51] = complex(real(t430)*0.0125, imag(t430)*0.0125)
+	d[52] = complex(real(t476)*0.0125, imag(t476)*0.0125)
+	d[53] = complex(real(t569)*0.0125, imag(t569)*0.0125)
+	d[54] = complex(real(t662)*0.0125, imag(t662)*0.0125)
+	d[55] = complex(real(t350)*0.0125, imag(t350)*0.0125)
+	d[56] = complex(real(t396)*0.0125, imag(t396)*0.0125)
+	d[57] = complex(real(t489)*0.0125, imag(t489)*0.0125)
+	d[58] = complex(real(t582)*0.0125, imag(t582)*0.0125)
+	d[59] = complex(real(t675)*0.0125, imag(t675)*0.0125)
+	d[60] = complex(real(t316)*0.0125, imag(t316)*0.0125)
+	d[61] = complex(real(t409)*0.0125, imag(t409)*0.0125)
+	d[62] = complex(real(t502)*0.0125, imag(t502)*0.0125)
+	d[63] = complex(real(t595)*0.0125, imag(t595)*0.0125)
+	d[64] = complex(real(t637)*0.0125, imag(t637)*0.0125)
+	d[65] = complex(real(t325)*0.0125, imag(t325)*0.0125)
+	d[66] = complex(real(t418)*0.0125, imag(t418)*0.0125)
+	d[67] = complex(real(t511)*0.0125, imag(t511)*0.0125)
+	d[68] = complex(real(t557)*0.0125, imag(t557)*0.0125)
+	d[69] = complex(real(t650)*0.0125, imag(t650)*0.0125)
+	d[70] = complex(real(t338)*0.0125, imag(t338)*0.0125)
+	d[71] = complex(real(t431)*0.0125, imag(t431)*0.0125)
+	d[72] = complex(real(t477)*0.0125, imag(t477)*0.0125)
+	d[73] = complex(real(t570)*0.0125, imag(t570)*0.0125)
+	d[74] = complex(real(t663)*0.0125, imag(t663)*0.0125)
+	d[75] = complex(real(t351)*0.0125, imag(t351)*0.0125)
+	d[76] = complex(real(t397)*0.0125, imag(t397)*0.0125)
+	d[77] = complex(real(t490)*0.0125, imag(t490)*0.0125)
+	d[78] = complex(real(t583)*0.0125, imag(t583)*0.0125)
+	d[79] = complex(real(t676)*0.0125, imag(t676)*0.0125)
+
+	return true
+}
@@ -0,0 +1,4513 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT96Complex64 computes a 96-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT96Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 96
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+	x60 := s[60]
+	x61 := s[61]
+	x62 := s[62]
+	x63 := s[63]
+	x64 := s[64]
+	x65 := s[65]
+	x66 := s[66]
+	x67 := s[67]
+	x68 := s[68]
+	x69 := s[69]
+	x70 := s[70]
+	x71 := s[71]
+	x72 := s[72]
+	x73 := s[73]
+	x74 := s[74]
+	x75 := s[75]
+	x76 := s[76]
+	x77 := s[77]
+	x78 := s[78]
+	x79 := s[79]
+	x80 := s[80]
+	x81 := s[81]
+	x82 := s[82]
+	x83 := s[83]
+	x84 := s[84]
+	x85 := s[85]
+	x86 := s[86]
+	x87 := s[87]
+	x88 := s[88]
+	x89 := s[89]
+	x90 := s[90]
+	x91 := s[91]
+	x92 := s[92]
+	x93 := s[93]
+	x94 := s[94]
+	x95 := s[95]
+
+	t0 := x32 + x64
+	t1 := x32 - x64
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x35 + x67
+	t10 := x35 - x67
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x38 + x70
+	t19 := x38 - x70
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x41 + x73
+	t28 := x41 - x73
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x44 + x76
+	t37 := x44 - x76
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x47 + x79
+	t46 := x47 - x79
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x50 + x82
+	t55 := x50 - x82
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x53 + x85
+	t64 := x53 - x85
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := x56 + x88
+	t73 := x56 - x88
+	t74 := x24 + t72
+	t75 := real(x24) - 0.49999999999999994*real(t72)
+	t76 := imag(x24) - 0.49999999999999994*imag(t72)
+	t77 := 0.8660254037844387 * real(t73)
+	t78 := 0.8660254037844387 * imag(t73)
+	t79 := complex(t75-t78, t76+t77)
+	t80 := complex(t75+t78, t76-t77)
+	t81 := x59 + x91
+	t82 := x59 - x91
+	t83 := x27 + t81
+	t84 := real(x27) - 0.49999999999999994*real(t81)
+	t85 := imag(x27) - 0.49999999999999994*imag(t81)
+	t86 := 0.8660254037844387 * real(t82)
+	t87 := 0.8660254037844387 * imag(t82)
+	t88 := complex(t84-t87, t85+t86)
+	t89 := complex(t84+t87, t85-t86)
+	t90 := x62 + x94
+	t91 := x62 - x94
+	t92 := x30 + t90
+	t93 := real(x30) - 0.49999999999999994*real(t90)
+	t94 := imag(x30) - 0.49999999999999994*imag(t90)
+	t95 := 0.8660254037844387 * real(t91)
+	t96 := 0.8660254037844387 * imag(t91)
+	t97 := complex(t93-t96, t94+t95)
+	t98 := complex(t93+t96, t94-t95)
+	t99 := x65 + x1
+	t100 := x65 - x1
+	t101 := x33 + t99
+	t102 := real(x33) - 0.49999999999999994*real(t99)
+	t103 := imag(x33) - 0.49999999999999994*imag(t99)
+	t104 := 0.8660254037844387 * real(t100)
+	t105 := 0.8660254037844387 * imag(t100)
+	t106 := complex(t102-t105, t103+t104)
+	t107 := complex(t102+t105, t103-t104)
+	t108 := x68 + x4
+	t109 := x68 - x4
+	t110 := x36 + t108
+	t111 := real(x36) - 0.49999999999999994*real(t108)
+	t112 := imag(x36) - 0.49999999999999994*imag(t108)
+	t113 := 0.8660254037844387 * real(t109)
+	t114 := 0.8660254037844387 * imag(t109)
+	t115 := complex(t111-t114, t112+t113)
+	t116 := complex(t111+t114, t112-t113)
+	t117 := x71 + x7
+	t118 := x71 - x7
+	t119 := x39 + t117
+	t120 := real(x39) - 0.49999999999999994*real(t117)
+	t121 := imag(x39) - 0.49999999999999994*imag(t117)
+	t122 := 0.8660254037844387 * real(t118)
+	t123 := 0.8660254037844387 * imag(t118)
+	t124 := complex(t120-t123, t121+t122)
+	t125 := complex(t120+t123, t121-t122)
+	t126 := x74 + x10
+	t127 := x74 - x10
+	t128 := x42 + t126
+	t129 := real(x42) - 0.49999999999999994*real(t126)
+	t130 := imag(x42) - 0.49999999999999994*imag(t126)
+	t131 := 0.8660254037844387 * real(t127)
+	t132 := 0.8660254037844387 * imag(t127)
+	t133 := complex(t129-t132, t130+t131)
+	t134 := complex(t129+t132, t130-t131)
+	t135 := x77 + x13
+	t136 := x77 - x13
+	t137 := x45 + t135
+	t138 := real(x45) - 0.49999999999999994*real(t135)
+	t139 := imag(x45) - 0.49999999999999994*imag(t135)
+	t140 := 0.8660254037844387 * real(t136)
+	t141 := 0.8660254037844387 * imag(t136)
+	t142 := complex(t138-t141, t139+t140)
+	t143 := complex(t138+t141, t139-t140)
+	t144 := x80 + x16
+	t145 := x80 - x16
+	t146 := x48 + t144
+	t147 := real(x48) - 0.49999999999999994*real(t144)
+	t148 := imag(x48) - 0.49999999999999994*imag(t144)
+	t149 := 0.8660254037844387 * real(t145)
+	t150 := 0.8660254037844387 * imag(t145)
+	t151 := complex(t147-t150, t148+t149)
+	t152 := complex(t147+t150, t148-t149)
+	t153 := x83 + x19
+	t154 := x83 - x19
+	t155 := x51 + t153
+	t156 := real(x51) - 0.49999999999999994*real(t153)
+	t157 := imag(x51) - 0.49999999999999994*imag(t153)
+	t158 := 0.8660254037844387 * real(t154)
+	t159 := 0.8660254037844387 * imag(t154)
+	t160 := complex(t156-t159, t157+t158)
+	t161 := complex(t156+t159, t157-t158)
+	t162 := x86 + x22
+	t163 := x86 - x22
+	t164 := x54 + t162
+	t165 := real(x54) - 0.49999999999999994*real(t162)
+	t166 := imag(x54) - 0.49999999999999994*imag(t162)
+	t167 := 0.8660254037844387 * real(t163)
+	t168 := 0.8660254037844387 * imag(t163)
+	t169 := complex(t165-t168, t166+t167)
+	t170 := complex(t165+t168, t166-t167)
+	t171 := x89 + x25
+	t172 := x89 - x25
+	t173 := x57 + t171
+	t174 := real(x57) - 0.49999999999999994*real(t171)
+	t175 := imag(x57) - 0.49999999999999994*imag(t171)
+	t176 := 0.8660254037844387 * real(t172)
+	t177 := 0.8660254037844387 * imag(t172)
+	t178 := complex(t174-t177, t175+t176)
+	t179 := complex(t174+t177, t175-t176)
+	t180 := x92 + x28
+	t181 := x92 - x28
+	t182 := x60 + t180
+	t183 := real(x60) - 0.49999999999999994*real(t180)
+	t184 := imag(x60) - 0.49999999999999994*imag(t180)
+	t185 := 0.8660254037844387 * real(t181)
+	t186 := 0.8660254037844387 * imag(t181)
+	t187 := complex(t183-t186, t184+t185)
+	t188 := complex(t183+t186, t184-t185)
+	t189 := x95 + x31
+	t190 := x95 - x31
+	t191 := x63 + t189
+	t192 := real(x63) - 0.49999999999999994*real(t189)
+	t193 := imag(x63) - 0.49999999999999994*imag(t189)
+	t194 := 0.8660254037844387 * real(t190)
+	t195 := 0.8660254037844387 * imag(t190)
+	t196 := complex(t192-t195, t193+t194)
+	t197 := complex(t192+t195, t193-t194)
+	t198 := x2 + x34
+	t199 := x2 - x34
+	t200 := x66 + t198
+	t201 := real(x66) - 0.49999999999999994*real(t198)
+	t202 := imag(x66) - 0.49999999999999994*imag(t198)
+	t203 := 0.8660254037844387 * real(t199)
+	t204 := 0.8660254037844387 * imag(t199)
+	t205 := complex(t201-t204, t202+t203)
+	t206 := complex(t201+t204, t202-t203)
+	t207 := x5 + x37
+	t208 := x5 - x37
+	t209 := x69 + t207
+	t210 := real(x69) - 0.49999999999999994*real(t207)
+	t211 := imag(x69) - 0.49999999999999994*imag(t207)
+	t212 := 0.8660254037844387 * real(t208)
+	t213 := 0.8660254037844387 * imag(t208)
+	t214 := complex(t210-t213, t211+t212)
+	t215 := complex(t210+t213, t211-t212)
+	t216 := x8 + x40
+	t217 := x8 - x40
+	t218 := x72 + t216
+	t219 := real(x72) - 0.49999999999999994*real(t216)
+	t220 := imag(x72) - 0.49999999999999994*imag(t216)
+	t221 := 0.8660254037844387 * real(t217)
+	t222 := 0.8660254037844387 * imag(t217)
+	t223 := complex(t219-t222, t220+t221)
+	t224 := complex(t219+t222, t220-t221)
+	t225 := x11 + x43
+	t226 := x11 - x43
+	t227 := x75 + t225
+	t228 := real(x75) - 0.49999999999999994*real(t225)
+	t229 := imag(x75) - 0.49999999999999994*imag(t225)
+	t230 := 0.8660254037844387 * real(t226)
+	t231 := 0.8660254037844387 * imag(t226)
+	t232 := complex(t228-t231, t229+t230)
+	t233 := complex(t228+t231, t229-t230)
+	t234 := x14 + x46
+	t235 := x14 - x46
+	t236 := x78 + t234
+	t237 := real(x78) - 0.49999999999999994*real(t234)
+	t238 := imag(x78) - 0.49999999999999994*imag(t234)
+	t239 := 0.8660254037844387 * real(t235)
+	t240 := 0.8660254037844387 * imag(t235)
+	t241 := complex(t237-t240, t238+t239)
+	t242 := complex(t237+t240, t238-t239)
+	t243 := x17 + x49
+	t244 := x17 - x49
+	t245 := x81 + t243
+	t246 := real(x81) - 0.49999999999999994*real(t243)
+	t247 := imag(x81) - 0.49999999999999994*imag(t243)
+	t248 := 0.8660254037844387 * real(t244)
+	t249 := 0.8660254037844387 * imag(t244)
+	t250 := complex(t246-t249, t247+t248)
+	t251 := complex(t246+t249, t247-t248)
+	t252 := x20 + x52
+	t253 := x20 - x52
+	t254 := x84 + t252
+	t255 := real(x84) - 0.49999999999999994*real(t252)
+	t256 := imag(x84) - 0.49999999999999994*imag(t252)
+	t257 := 0.8660254037844387 * real(t253)
+	t258 := 0.8660254037844387 * imag(t253)
+	t259 := complex(t255-t258, t256+t257)
+	t260 := complex(t255+t258, t256-t257)
+	t261 := x23 + x55
+	t262 := x23 - x55
+	t263 := x87 + t261
+	t264 := real(x87) - 0.49999999999999994*real(t261)
+	t265 := imag(x87) - 0.49999999999999994*imag(t261)
+	t266 := 0.8660254037844387 * real(t262)
+	t267 := 0.8660254037844387 * imag(t262)
+	t268 := complex(t264-t267, t265+t266)
+	t269 := complex(t264+t267, t265-t266)
+	t270 := x26 + x58
+	t271 := x26 - x58
+	t272 := x90 + t270
+	t273 := real(x90) - 0.49999999999999994*real(t270)
+	t274 := imag(x90) - 0.49999999999999994*imag(t270)
+	t275 := 0.8660254037844387 * real(t271)
+	t276 := 0.8660254037844387 * imag(t271)
+	t277 := complex(t273-t276, t274+t275)
+	t278 := complex(t273+t276, t274-t275)
+	t279 := x29 + x61
+	t280 := x29 - x61
+	t281 := x93 + t279
+	t282 := real(x93) - 0.49999999999999994*real(t279)
+	t283 := imag(x93) - 0.49999999999999994*imag(t279)
+	t284 := 0.8660254037844387 * real(t280)
+	t285 := 0.8660254037844387 * imag(t280)
+	t286 := complex(t282-t285, t283+t284)
+	t287 := complex(t282+t285, t283-t284)
+	t288 := t2 + t146
+	t289 := t2 - t146
+	t290 := t38 + t182
+	t291 := t38 - t182
+	t292 := t74 + t218
+	t293 := t74 - t218
+	t294 := t110 + t254
+	t295 := t110 - t254
+	t296 := t288 + t292
+	t297 := t288 - t292
+	t298 := t290 + t294
+	t299 := t290 - t294
+	t300 := complex(imag(t299), -real(t299))
+	t301 := t296 + t298
+	t302 := t297 + t300
+	t303 := t296 - t298
+	t304 := t297 - t300
+	t305 := complex((real(t291)+imag(t291))*0.7071067811865476, (imag(t291)-real(t291))*0.7071067811865476)
+	t306 := complex(imag(t293), -real(t293))
+	t307 := complex((-real(t295)+imag(t295))*0.7071067811865476, (-imag(t295)-real(t295))*0.7071067811865476)
+	t308 := t289 + t306
+	t309 := t289 - t306
+	t310 := t305 + t307
+	t311 := t305 - t307
+	t312 := complex(imag(t311), -real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := t11 + t155
+	t318 := t11 - t155
+	t319 := t47 + t191
+	t320 := t47 - t191
+	t321 := t83 + t227
+	t322 := t83 - t227
+	t323 := t119 + t263
+	t324 := t119 - t263
+	t325 := t317 + t321
+	t326 := t317 - t321
+	t327 := t319 + t323
+	t328 := t319 - t323
+	t329 := complex(imag(t328), -real(t328))
+	t330 := t325 + t327
+	t331 := t326 + t329
+	t332 := t325 - t327
+	t333 := t326 - t329
+	t334 := complex((real(t320)+imag(t320))*0.7071067811865476, (imag(t320)-real(t320))*0.7071067811865476)
+	t335 := complex(imag(t322), -real(t322))
+	t336 := complex((-real(t324)+imag(t324))*0.7071067811865476, (-imag(t324)-real(t324))*0.7071067811865476)
+	t337 := t318 + t335
+	t338 := t318 - t335
+	t339 := t334 + t336
+	t340 := t334 - t336
+	t341 := complex(imag(t340), -real(t340))
+	t342 := t337 + t339
+	t343 := t338 + t341
+	t344 := t337 - t339
+	t345 := t338 - t341
+	t346 := t20 + t164
+	t347 := t20 - t164
+	t348 := t56 + t200
+	t349 := t56 - t200
+	t350 := t92 + t236
+	t351 := t92 - t236
+	t352 := t128 + t272
+	t353 := t128 - t272
+	t354 := t346 + t350
+	t355 := t346 - t350
+	t356 := t348 + t352
+	t357 := t348 - t352
+	t358 := complex(imag(t357), -real(t357))
+	t359 := t354 + t356
+	t360 := t355 + t358
+	t361 := t354 - t356
+	t362 := t355 - t358
+	t363 := complex((real(t349)+imag(t349))*0.7071067811865476, (imag(t349)-real(t349))*0.7071067811865476)
+	t364 := complex(imag(t351), -real(t351))
+	t365 := complex((-real(t353)+imag(t353))*0.7071067811865476, (-imag(t353)-real(t353))*0.7071067811865476)
+	t366 := t347 + t364
+	t367 := t347 - t364
+	t368 := t363 + t365
+	t369 := t363 - t365
+	t370 := complex(imag(t369), -real(t369))
+	t371 := t366 + t368
+	t372 := t367 + t370
+	t373 := t366 - t368
+	t374 := t367 - t370
+	t375 := t29 + t173
+	t376 := t29 - t173
+	t377 := t65 + t209
+	t378 := t65 - t209
+	t379 := t101 + t245
+	t380 := t101 - t245
+	t381 := t137 + t281
+	t382 := t137 - t281
+	t383 := t375 + t379
+	t384 := t375 - t379
+	t385 := t377 + t381
+	t386 := t377 - t381
+	t387 := complex(imag(t386), -real(t386))
+	t388 := t383 + t385
+	t389 := t384 + t387
+	t390 := t383 - t385
+	t391 := t384 - t387
+	t392 := complex((real(t378)+imag(t378))*0.7071067811865476, (imag(t378)-real(t378))*0.7071067811865476)
+	t393 := complex(imag(t380), -real(t380))
+	t394 := complex((-real(t382)+imag(t382))*0.7071067811865476, (-imag(t382)-real(t382))*0.7071067811865476)
+	t395 := t376 + t393
+	t396 := t376 - t393
+	t397 := t392 + t394
+	t398 := t392 - t394
+	t399 := complex(imag(t398), -real(t398))
+	t400 := t395 + t397
+	t401 := t396 + t399
+	t402 := t395 - t397
+	t403 := t396 - t399
+	t404 := t301 + t359
+	t405 := t301 - t359
+	t406 := t330 + t388
+	t407 := t330 - t388
+	t408 := complex(imag(t407), -real(t407))
+	t409 := t404 + t406
+	t410 := t405 + t408
+	t411 := t404 - t406
+	t412 := t405 - t408
+	t413 := t342 * complex(0.9807852804032304, -0.19509032201612825)
+	t414 := t371 * complex(0.9238795325112867, -0.3826834323650898)
+	t415 := t400 * complex(0.8314696123025453, -0.5555702330196022)
+	t416 := t313 + t414
+	t417 := t313 - t414
+	t418 := t413 + t415
+	t419 := t413 - t415
+	t420 := complex(imag(t419), -real(t419))
+	t421 := t416 + t418
+	t422 := t417 + t420
+	t423 := t416 - t418
+	t424 := t417 - t420
+	t425 := t331 * complex(0.9238795325112867, -0.3826834323650898)
+	t426 := complex((real(t360)+imag(t360))*0.7071067811865476, (imag(t360)-real(t360))*0.7071067811865476)
+	t427 := t389 * complex(0.3826834323650898, -0.9238795325112867)
+	t428 := t302 + t426
+	t429 := t302 - t426
+	t430 := t425 + t427
+	t431 := t425 - t427
+	t432 := complex(imag(t431), -real(t431))
+	t433 := t428 + t430
+	t434 := t429 + t432
+	t435 := t428 - t430
+	t436 := t429 - t432
+	t437 := t343 * complex(0.8314696123025453, -0.5555702330196022)
+	t438 := t372 * complex(0.3826834323650898, -0.9238795325112867)
+	t439 := t401 * complex(-0.19509032201612825, -0.9807852804032304)
+	t440 := t314 + t438
+	t441 := t314 - t438
+	t442 := t437 + t439
+	t443 := t437 - t439
+	t444 := complex(imag(t443), -real(t443))
+	t445 := t440 + t442
+	t446 := t441 + t444
+	t447 := t440 - t442
+	t448 := t441 - t444
+	t449 := complex((real(t332)+imag(t332))*0.7071067811865476, (imag(t332)-real(t332))*0.7071067811865476)
+	t450 := complex(imag(t361), -real(t361))
+	t451 := complex((-real(t390)+imag(t390))*0.7071067811865476, (-imag(t390)-real(t390))*0.7071067811865476)
+	t452 := t303 + t450
+	t453 := t303 - t450
+	t454 := t449 + t451
+	t455 := t449 - t451
+	t456 := complex(imag(t455), -real(t455))
+	t457 := t452 + t454
+	t458 := t453 + t456
+	t459 := t452 - t454
+	t460 := t453 - t456
+	t461 := t344 * complex(0.5555702330196022, -0.8314696123025453)
+	t462 := t373 * complex(-0.3826834323650898, -0.9238795325112867)
+	t463 := t402 * complex(-0.9807852804032304, -0.19509032201612825)
+	t464 := t315 + t462
+	t465 := t315 - t462
+	t466 := t461 + t463
+	t467 := t461 - t463
+	t468 := complex(imag(t467), -real(t467))
+	t469 := t464 + t466
+	t470 := t465 + t468
+	t471 := t464 - t466
+	t472 := t465 - t468
+	t473 := t333 * complex(0.3826834323650898, -0.9238795325112867)
+	t474 := complex((-real(t362)+imag(t362))*0.7071067811865476, (-imag(t362)-real(t362))*0.7071067811865476)
+	t475 := t391 * complex(-0.9238795325112867, 0.3826834323650898)
+	t476 := t304 + t474
+	t477 := t304 - t474
+	t478 := t473 + t475
+	t479 := t473 - t475
+	t480 := complex(imag(t479), -real(t479))
+	t481 := t476 + t478
+	t482 := t477 + t480
+	t483 := t476 - t478
+	t484 := t477 - t480
+	t485 := t345 * complex(0.19509032201612825, -0.9807852804032304)
+	t486 := t374 * complex(-0.9238795325112867, -0.3826834323650898)
+	t487 := t403 * complex(-0.5555702330196022, 0.8314696123025453)
+	t488 := t316 + t486
+	t489 := t316 - t486
+	t490 := t485 + t487
+	t491 := t485 - t487
+	t492 := complex(imag(t491), -real(t491))
+	t493 := t488 + t490
+	t494 := t489 + t492
+	t495 := t488 - t490
+	t496 := t489 - t492
+	t497 := t8 + t152
+	t498 := t8 - t152
+	t499 := t44 + t188
+	t500 := t44 - t188
+	t501 := t80 + t224
+	t502 := t80 - t224
+	t503 := t116 + t260
+	t504 := t116 - t260
+	t505 := t497 + t501
+	t506 := t497 - t501
+	t507 := t499 + t503
+	t508 := t499 - t503
+	t509 := complex(imag(t508), -real(t508))
+	t510 := t505 + t507
+	t511 := t506 + t509
+	t512 := t505 - t507
+	t513 := t506 - t509
+	t514 := complex((real(t500)+imag(t500))*0.7071067811865476, (imag(t500)-real(t500))*0.7071067811865476)
+	t515 := complex(imag(t502), -real(t502))
+	t516 := complex((-real(t504)+imag(t504))*0.7071067811865476, (-imag(t504)-real(t504))*0.7071067811865476)
+	t517 := t498 + t515
+	t518 := t498 - t515
+	t519 := t514 + t516
+	t520 := t514 - t516
+	t521 := complex(imag(t520), -real(t520))
+	t522 := t517 + t519
+	t523 := t518 + t521
+	t524 := t517 - t519
+	t525 := t518 - t521
+	t526 := t17 + t161
+	t527 := t17 - t161
+	t528 := t53 + t197
+	t529 := t53 - t197
+	t530 := t89 + t233
+	t531 := t89 - t233
+	t532 := t125 + t269
+	t533 := t125 - t269
+	t534 := t526 + t530
+	t535 := t526 - t530
+	t536 := t528 + t532
+	t537 := t528 - t532
+	t538 := complex(imag(t537), -real(t537))
+	t539 := t534 + t536
+	t540 := t535 + t538
+	t541 := t534 - t536
+	t542 := t535 - t538
+	t543 := complex((real(t529)+imag(t529))*0.7071067811865476, (imag(t529)-real(t529))*0.7071067811865476)
+	t544 := complex(imag(t531), -real(t531))
+	t545 := complex((-real(t533)+imag(t533))*0.7071067811865476, (-imag(t533)-real(t533))*0.7071067811865476)
+	t546 := t527 + t544
+	t547 := t527 - t544
+	t548 := t543 + t545
+	t549 := t543 - t545
+	t550 := complex(imag(t549), -real(t549))
+	t551 := t546 + t548
+	t552 := t547 + t550
+	t553 := t546 - t548
+	t554 := t547 - t550
+	t555 := t26 + t170
+	t556 := t26 - t170
+	t557 := t62 + t206
+	t558 := t62 - t206
+	t559 := t98 + t242
+	t560 := t98 - t242
+	t561 := t134 + t278
+	t562 := t134 - t278
+	t563 := t555 + t559
+	t564 := t555 - t559
+	t565 := t557 + t561
+	t566 := t557 - t561
+	t567 := complex(imag(t566), -real(t566))
+	t568 := t563 + t565
+	t569 := t564 + t567
+	t570 := t563 - t565
+	t571 := t564 - t567
+	t572 := complex((real(t558)+imag(t558))*0.7071067811865476, (imag(t558)-real(t558))*0.7071067811865476)
+	t573 := complex(imag(t560), -real(t560))
+	t574 := complex((-real(t562)+imag(t562))*0.7071067811865476, (-imag(t562)-real(t562))*0.7071067811865476)
+	t575 := t556 + t573
+	t576 := t556 - t573
+	t577 := t572 + t574
+	t578 := t572 - t574
+	t579 := complex(imag(t578), -real(t578))
+	t580 := t575 + t577
+	t581 := t576 + t579
+	t582 := t575 - t577
+	t583 := t576 - t579
+	t584 := t35 + t179
+	t585 := t35 - t179
+	t586 := t71 + t215
+	t587 := t71 - t215
+	t588 := t107 + t251
+	t589 := t107 - t251
+	t590 := t143 + t287
+	t591 := t143 - t287
+	t592 := t584 + t588
+	t593 := t584 - t588
+	t594 := t586 + t590
+	t595 := t586 - t590
+	t596 := complex(imag(t595), -real(t595))
+	t597 := t592 + t594
+	t598 := t593 + t596
+	t599 := t592 - t594
+	t600 := t593 - t596
+	t601 := complex((real(t587)+imag(t587))*0.7071067811865476, (imag(t587)-real(t587))*0.7071067811865476)
+	t602 := complex(imag(t589), -real(t589))
+	t603 := complex((-real(t591)+imag(t591))*0.7071067811865476, (-imag(t591)-real(t591))*0.7071067811865476)
+	t604 := t585 + t602
+	t605 := t585 - t602
+	t606 := t601 + t603
+	t607 := t601 - t603
+	t608 := complex(imag(t607), -real(t607))
+	t609 := t604 + t606
+	t610 := t605 + t608
+	t611 := t604 - t606
+	t612 := t605 - t608
+	t613 := t510 + t568
+	t614 := t510 - t568
+	t615 := t539 + t597
+	t616 := t539 - t597
+	t617 := complex(imag(t616), -real(t616))
+	t618 := t613 + t615
+	t619 := t614 + t617
+	t620 := t613 - t615
+	t621 := t614 - t617
+	t622 := t551 * complex(0.9807852804032304, -0.19509032201612825)
+	t623 := t580 * complex(0.9238795325112867, -0.3826834323650898)
+	t624 := t609 * complex(0.8314696123025453, -0.5555702330196022)
+	t625 := t522 + t623
+	t626 := t522 - t623
+	t627 := t622 + t624
+	t628 := t622 - t624
+	t629 := complex(imag(t628), -real(t628))
+	t630 := t625 + t627
+	t631 := t626 + t629
+	t632 := t625 - t627
+	t633 := t626 - t629
+	t634 := t540 * complex(0.9238795325112867, -0.3826834323650898)
+	t635 := complex((real(t569)+imag(t569))*0.7071067811865476, (imag(t569)-real(t569))*0.7071067811865476)
+	t636 := t598 * complex(0.3826834323650898, -0.9238795325112867)
+	t637 := t511 + t635
+	t638 := t511 - t635
+	t639 := t634 + t636
+	t640 := t634 - t636
+	t641 := complex(imag(t640), -real(t640))
+	t642 := t637 + t639
+	t643 := t638 + t641
+	t644 := t637 - t639
+	t645 := t638 - t641
+	t646 := t552 * complex(0.8314696123025453, -0.5555702330196022)
+	t647 := t581 * complex(0.3826834323650898, -0.9238795325112867)
+	t648 := t610 * complex(-0.19509032201612825, -0.9807852804032304)
+	t649 := t523 + t647
+	t650 := t523 - t647
+	t651 := t646 + t648
+	t652 := t646 - t648
+	t653 := complex(imag(t652), -real(t652))
+	t654 := t649 + t651
+	t655 := t650 + t653
+	t656 := t649 - t651
+	t657 := t650 - t653
+	t658 := complex((real(t541)+imag(t541))*0.7071067811865476, (imag(t541)-real(t541))*0.7071067811865476)
+	t659 := complex(imag(t570), -real(t570))
+	t660 := complex((-real(t599)+imag(t599))*0.7071067811865476, (-imag(t599)-real(t599))*0.7071067811865476)
+	t661 := t512 + t659
+	t662 := t512 - t659
+	t663 := t658 + t660
+	t664 := t658 - t660
+	t665 := complex(imag(t664), -real(t664))
+	t666 := t661 + t663
+	t667 := t662 + t665
+	t668 := t661 - t663
+	t669 := t662 - t665
+	t670 := t553 * complex(0.5555702330196022, -0.8314696123025453)
+	t671 := t582 * complex(-0.3826834323650898, -0.9238795325112867)
+	t672 := t611 * complex(-0.9807852804032304, -0.19509032201612825)
+	t673 := t524 + t671
+	t674 := t524 - t671
+	t675 := t670 + t672
+	t676 := t670 - t672
+	t677 := complex(imag(t676), -real(t676))
+	t678 := t673 + t675
+	t679 := t674 + t677
+	t680 := t673 - t675
+	t681 := t674 - t677
+	t682 := t542 * complex(0.3826834323650898, -0.9238795325112867)
+	t683 := complex((-real(t571)+imag(t571))*0.7071067811865476, (-imag(t571)-real(t571))*0.7071067811865476)
+	t684 := t600 * complex(-0.9238795325112867, 0.3826834323650898)
+	t685 := t513 + t683
+	t686 := t513 - t683
+	t687 := t682 + t684
+	t688 := t682 - t684
+	t689 := complex(imag(t688), -real(t688))
+	t690 := t685 + t687
+	t691 := t686 + t689
+	t692 := t685 - t687
+	t693 := t686 - t689
+	t694 := t554 * complex(0.19509032201612825, -0.9807852804032304)
+	t695 := t583 * complex(-0.9238795325112867, -0.3826834323650898)
+	t696 := t612 * complex(-0.5555702330196022, 0.8314696123025453)
+	t697 := t525 + t695
+	t698 := t525 - t695
+	t699 := t694 + t696
+	t700 := t694 - t696
+	t701 := complex(imag(t700), -real(t700))
+	t702 := t697 + t699
+	t703 := t698 + t701
+	t704 := t697 - t699
+	t705 := t698 - t701
+	t706 := t7 + t151
+	t707 := t7 - t151
+	t708 := t43 + t187
+	t709 := t43 - t187
+	t710 := t79 + t223
+	t711 := t79 - t223
+	t712 := t115 + t259
+	t713 := t115 - t259
+	t714 := t706 + t710
+	t715 := t706 - t710
+	t716 := t708 + t712
+	t717 := t708 - t712
+	t718 := complex(imag(t717), -real(t717))
+	t719 := t714 + t716
+	t720 := t715 + t718
+	t721 := t714 - t716
+	t722 := t715 - t718
+	t723 := complex((real(t709)+imag(t709))*0.7071067811865476, (imag(t709)-real(t709))*0.7071067811865476)
+	t724 := complex(imag(t711), -real(t711))
+	t725 := complex((-real(t713)+imag(t713))*0.7071067811865476, (-imag(t713)-real(t713))*0.7071067811865476)
+	t726 := t707 + t724
+	t727 := t707 - t724
+	t728 := t723 + t725
+	t729 := t723 - t725
+	t730 := complex(imag(t729), -real(t729))
+	t731 := t726 + t728
+	t732 := t727 + t730
+	t733 := t726 - t728
+	t734 := t727 - t730
+	t735 := t16 + t160
+	t736 := t16 - t160
+	t737 := t52 + t196
+	t738 := t52 - t196
+	t739 := t88 + t232
+	t740 := t88 - t232
+	t741 := t124 + t268
+	t742 := t124 - t268
+	t743 := t735 + t739
+	t744 := t735 - t739
+	t745 := t737 + t741
+	t746 := t737 - t741
+	t747 := complex(imag(t746), -real(t746))
+	t748 := t743 + t745
+	t749 := t744 + t747
+	t750 := t743 - t745
+	t751 := t744 - t747
+	t752 := complex((real(t738)+imag(t738))*0.7071067811865476, (imag(t738)-real(t738))*0.7071067811865476)
+	t753 := complex(imag(t740), -real(t740))
+	t754 := complex((-real(t742)+imag(t742))*0.7071067811865476, (-imag(t742)-real(t742))*0.7071067811865476)
+	t755 := t736 + t753
+	t756 := t736 - t753
+	t757 := t752 + t754
+	t758 := t752 - t754
+	t759 := complex(imag(t758), -real(t758))
+	t760 := t755 + t757
+	t761 := t756 + t759
+	t762 := t755 - t757
+	t763 := t756 - t759
+	t764 := t25 + t169
+	t765 := t25 - t169
+	t766 := t61 + t205
+	t767 := t61 - t205
+	t768 := t97 + t241
+	t769 := t97 - t241
+	t770 := t133 + t277
+	t771 := t133 - t277
+	t772 := t764 + t768
+	t773 := t764 - t768
+	t774 := t766 + t770
+	t775 := t766 - t770
+	t776 := complex(imag(t775), -real(t775))
+	t777 := t772 + t774
+	t778 := t773 + t776
+	t779 := t772 - t774
+	t780 := t773 - t776
+	t781 := complex((real(t767)+imag(t767))*0.7071067811865476, (imag(t767)-real(t767))*0.7071067811865476)
+	t782 := complex(imag(t769), -real(t769))
+	t783 := complex((-real(t771)+imag(t771))*0.7071067811865476, (-imag(t771)-real(t771))*0.7071067811865476)
+	t784 := t765 + t782
+	t785 := t765 - t782
+	t786 := t781 + t783
+	t787 := t781 - t783
+	t788 := complex(imag(t787), -real(t787))
+	t789 := t784 + t786
+	t790 := t785 + t788
+	t791 := t784 - t786
+	t792 := t785 - t788
+	t793 := t34 + t178
+	t794 := t34 - t178
+	t795 := t70 + t214
+	t796 := t70 - t214
+	t797 := t106 + t250
+	t798 := t106 - t250
+	t799 := t142 + t286
+	t800 := t142 - t286
+	t801 := t793 + t797
+	t802 := t793 - t797
+	t803 := t795 + t799
+	t804 := t795 - t799
+	t805 := complex(imag(t804), -real(t804))
+	t806 := t801 + t803
+	t807 := t802 + t805
+	t808 := t801 - t803
+	t809 := t802 - t805
+	t810 := complex((real(t796)+imag(t796))*0.7071067811865476, (imag(t796)-real(t796))*0.7071067811865476)
+	t811 := complex(imag(t798), -real(t798))
+	t812 := complex((-real(t800)+imag(t800))*0.7071067811865476, (-imag(t800)-real(t800))*0.7071067811865476)
+	t813 := t794 + t811
+	t814 := t794 - t811
+	t815 := t810 + t812
+	t816 := t810 - t812
+	t817 := complex(imag(t816), -real(t816))
+	t818 := t813 + t815
+	t819 := t814 + t817
+	t820 := t813 - t815
+	t821 := t814 - t817
+	t822 := t719 + t777
+	t823 := t719 - t777
+	t824 := t748 + t806
+	t825 := t748 - t806
+	t826 := complex(imag(t825), -real(t825))
+	t827 := t822 + t824
+	t828 := t823 + t826
+	t829 := t822 - t824
+	t830 := t823 - t826
+	t831 := t760 * complex(0.9807852804032304, -0.19509032201612825)
+	t832 := t789 * complex(0.9238795325112867, -0.3826834323650898)
+	t833 := t818 * complex(0.8314696123025453, -0.5555702330196022)
+	t834 := t731 + t832
+	t835 := t731 - t832
+	t836 := t831 + t833
+	t837 := t831 - t833
+	t838 := complex(imag(t837), -real(t837))
+	t839 := t834 + t836
+	t840 := t835 + t838
+	t841 := t834 - t836
+	t842 := t835 - t838
+	t843 := t749 * complex(0.9238795325112867, -0.3826834323650898)
+	t844 := complex((real(t778)+imag(t778))*0.7071067811865476, (imag(t778)-real(t778))*0.7071067811865476)
+	t845 := t807 * complex(0.3826834323650898, -0.9238795325112867)
+	t846 := t720 + t844
+	t847 := t720 - t844
+	t848 := t843 + t845
+	t849 := t843 - t845
+	t850 := complex(imag(t849), -real(t849))
+	t851 := t846 + t848
+	t852 := t847 + t850
+	t853 := t846 - t848
+	t854 := t847 - t850
+	t855 := t761 * complex(0.8314696123025453, -0.5555702330196022)
+	t856 := t790 * complex(0.3826834323650898, -0.9238795325112867)
+	t857 := t819 * complex(-0.19509032201612825, -0.9807852804032304)
+	t858 := t732 + t856
+	t859 := t732 - t856
+	t860 := t855 + t857
+	t861 := t855 - t857
+	t862 := complex(imag(t861), -real(t861))
+	t863 := t858 + t860
+	t864 := t859 + t862
+	t865 := t858 - t860
+	t866 := t859 - t862
+	t867 := complex((real(t750)+imag(t750))*0.7071067811865476, (imag(t750)-real(t750))*0.7071067811865476)
+	t868 := complex(imag(t779), -real(t779))
+	t869 := complex((-real(t808)+imag(t808))*0.7071067811865476, (-imag(t808)-real(t808))*0.7071067811865476)
+	t870 := t721 + t868
+	t871 := t721 - t868
+	t872 := t867 + t869
+	t873 := t867 - t869
+	t874 := complex(imag(t873), -real(t873))
+	t875 := t870 + t872
+	t876 := t871 + t874
+	t877 := t870 - t872
+	t878 := t871 - t874
+	t879 := t762 * complex(0.5555702330196022, -0.8314696123025453)
+	t880 := t791 * complex(-0.3826834323650898, -0.9238795325112867)
+	t881 := t820 * complex(-0.9807852804032304, -0.19509032201612825)
+	t882 := t733 + t880
+	t883 := t733 - t880
+	t884 := t879 + t881
+	t885 := t879 - t881
+	t886 := complex(imag(t885), -real(t885))
+	t887 := t882 + t884
+	t888 := t883 + t886
+	t889 := t882 - t884
+	t890 := t883 - t886
+	t891 := t751 * complex(0.3826834323650898, -0.9238795325112867)
+	t892 := complex((-real(t780)+imag(t780))*0.7071067811865476, (-imag(t780)-real(t780))*0.7071067811865476)
+	t893 := t809 * complex(-0.9238795325112867, 0.3826834323650898)
+	t894 := t722 + t892
+	t895 := t722 - t892
+	t896 := t891 + t893
+	t897 := t891 - t893
+	t898 := complex(imag(t897), -real(t897))
+	t899 := t894 + t896
+	t900 := t895 + t898
+	t901 := t894 - t896
+	t902 := t895 - t898
+	t903 := t763 * complex(0.19509032201612825, -0.9807852804032304)
+	t904 := t792 * complex(-0.9238795325112867, -0.3826834323650898)
+	t905 := t821 * complex(-0.5555702330196022, 0.8314696123025453)
+	t906 := t734 + t904
+	t907 := t734 - t904
+	t908 := t903 + t905
+	t909 := t903 - t905
+	t910 := complex(imag(t909), -real(t909))
+	t911 := t906 + t908
+	t912 := t907 + t910
+	t913 := t906 - t908
+	t914 := t907 - t910
+
+	d[0] = t409
+	d[1] = t630
+	d[2] = t851
+	d[3] = t445
+	d[4] = t666
+	d[5] = t887
+	d[6] = t481
+	d[7] = t702
+	d[8] = t828
+	d[9] = t422
+	d[10] = t643
+	d[11] = t864
+	d[12] = t458
+	d[13] = t679
+	d[14] = t900
+	d[15] = t494
+	d[16] = t620
+	d[17] = t841
+	d[18] = t435
+	d[19] = t656
+	d[20] = t877
+	d[21] = t471
+	d[22] = t692
+	d[23] = t913
+	d[24] = t412
+	d[25] = t633
+	d[26] = t854
+	d[27] = t448
+	d[28] = t669
+	d[29] = t890
+	d[30] = t484
+	d[31] = t705
+	d[32] = t827
+	d[33] = t421
+	d[34] = t642
+	d[35] = t863
+	d[36] = t457
+	d[37] = t678
+	d[38] = t899
+	d[39] = t493
+	d[40] = t619
+	d[41] = t840
+	d[42] = t434
+	d[43] = t655
+	d[44] = t876
+	d[45] = t470
+	d[46] = t691
+	d[47] = t912
+	d[48] = t411
+	d[49] = t632
+	d[50] = t853
+	d[51] = t447
+	d[52] = t668
+	d[53] = t889
+	d[54] = t483
+	d[55] = t704
+	d[56] = t830
+	d[57] = t424
+	d[58] = t645
+	d[59] = t866
+	d[60] = t460
+	d[61] = t681
+	d[62] = t902
+	d[63] = t496
+	d[64] = t618
+	d[65] = t839
+	d[66] = t433
+	d[67] = t654
+	d[68] = t875
+	d[69] = t469
+	d[70] = t690
+	d[71] = t911
+	d[72] = t410
+	d[73] = t631
+	d[74] = t852
+	d[75] = t446
+	d[76] = t667
+	d[77] = t888
+	d[78] = t482
+	d[79] = t703
+	d[80] = t829
+	d[81] = t423
+	d[82] = t644
+	d[83] = t865
+	d[84] = t459
+	d[85] = t680
+	d[86] = t901
+	d[87] = t495
+	d[88] = t621
+	d[89] = t842
+	d[90] = t436
+	d[91] = t657
+	d[92] = t878
+	d[93] = t472
+	d[94] = t693
+	d[95] = t914
+
+	return true
+}
+
+// inverseDFT96Complex64 computes a 96-point inverse DFT of complex64 data,
+// including the 1/96 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT96Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 96
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+	x60 := s[60]
+	x61 := s[61]
+	x62 := s[62]
+	x63 := s[63]
+	x64 := s[64]
+	x65 := s[65]
+	x66 := s[66]
+	x67 := s[67]
+	x68 := s[68]
+	x69 := s[69]
+	x70 := s[70]
+	x71 := s[71]
+	x72 := s[72]
+	x73 := s[73]
+	x74 := s[74]
+	x75 := s[75]
+	x76 := s[76]
+	x77 := s[77]
+	x78 := s[78]
+	x79 := s[79]
+	x80 := s[80]
+	x81 := s[81]
+	x82 := s[82]
+	x83 := s[83]
+	x84 := s[84]
+	x85 := s[85]
+	x86 := s[86]
+	x87 := s[87]
+	x88 := s[88]
+	x89 := s[89]
+	x90 := s[90]
+	x91 := s[91]
+	x92 := s[92]
+	x93 := s[93]
+	x94 := s[94]
+	x95 := s[95]
+
+	t0 := x32 + x64
+	t1 := x32 - x64
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x35 + x67
+	t10 := x35 - x67
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x38 + x70
+	t19 := x38 - x70
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x41 + x73
+	t28 := x41 - x73
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x44 + x76
+	t37 := x44 - x76
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x47 + x79
+	t46 := x47 - x79
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x50 + x82
+	t55 := x50 - x82
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x53 + x85
+	t64 := x53 - x85
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := x56 + x88
+	t73 := x56 - x88
+	t74 := x24 + t72
+	t75 := real(x24) - 0.49999999999999994*real(t72)
+	t76 := imag(x24) - 0.49999999999999994*imag(t72)
+	t77 := 0.8660254037844387 * real(t73)
+	t78 := 0.8660254037844387 * imag(t73)
+	t79 := complex(t75-t78, t76+t77)
+	t80 := complex(t75+t78, t76-t77)
+	t81 := x59 + x91
+	t82 := x59 - x91
+	t83 := x27 + t81
+	t84 := real(x27) - 0.49999999999999994*real(t81)
+	t85 := imag(x27) - 0.49999999999999994*imag(t81)
+	t86 := 0.8660254037844387 * real(t82)
+	t87 := 0.8660254037844387 * imag(t82)
+	t88 := complex(t84-t87, t85+t86)
+	t89 := complex(t84+t87, t85-t86)
+	t90 := x62 + x94
+	t91 := x62 - x94
+	t92 := x30 + t90
+	t93 := real(x30) - 0.49999999999999994*real(t90)
+	t94 := imag(x30) - 0.49999999999999994*imag(t90)
+	t95 := 0.8660254037844387 * real(t91)
+	t96 := 0.8660254037844387 * imag(t91)
+	t97 := complex(t93-t96, t94+t95)
+	t98 := complex(t93+t96, t94-t95)
+	t99 := x65 + x1
+	t100 := x65 - x1
+	t101 := x33 + t99
+	t102 := real(x33) - 0.49999999999999994*real(t99)
+	t103 := imag(x33) - 0.49999999999999994*imag(t99)
+	t104 := 0.8660254037844387 * real(t100)
+	t105 := 0.8660254037844387 * imag(t100)
+	t106 := complex(t102-t105, t103+t104)
+	t107 := complex(t102+t105, t103-t104)
+	t108 := x68 + x4
+	t109 := x68 - x4
+	t110 := x36 + t108
+	t111 := real(x36) - 0.49999999999999994*real(t108)
+	t112 := imag(x36) - 0.49999999999999994*imag(t108)
+	t113 := 0.8660254037844387 * real(t109)
+	t114 := 0.8660254037844387 * imag(t109)
+	t115 := complex(t111-t114, t112+t113)
+	t116 := complex(t111+t114, t112-t113)
+	t117 := x71 + x7
+	t118 := x71 - x7
+	t119 := x39 + t117
+	t120 := real(x39) - 0.49999999999999994*real(t117)
+	t121 := imag(x39) - 0.49999999999999994*imag(t117)
+	t122 := 0.8660254037844387 * real(t118)
+	t123 := 0.8660254037844387 * imag(t118)
+	t124 := complex(t120-t123, t121+t122)
+	t125 := complex(t120+t123, t121-t122)
+	t126 := x74 + x10
+	t127 := x74 - x10
+	t128 := x42 + t126
+	t129 := real(x42) - 0.49999999999999994*real(t126)
+	t130 := imag(x42) - 0.49999999999999994*imag(t126)
+	t131 := 0.8660254037844387 * real(t127)
+	t132 := 0.8660254037844387 * imag(t127)
+	t133 := complex(t129-t132, t130+t131)
+	t134 := complex(t129+t132, t130-t131)
+	t135 := x77 + x13
+	t136 := x77 - x13
+	t137 := x45 + t135
+	t138 := real(x45) - 0.49999999999999994*real(t135)
+	t139 := imag(x45) - 0.49999999999999994*imag(t135)
+	t140 := 0.8660254037844387 * real(t136)
+	t141 := 0.8660254037844387 * imag(t136)
+	t142 := complex(t138-t141, t139+t140)
+	t143 := complex(t138+t141, t139-t140)
+	t144 := x80 + x16
+	t145 := x80 - x16
+	t146 := x48 + t144
+	t147 := real(x48) - 0.49999999999999994*real(t144)
+	t148 := imag(x48) - 0.49999999999999994*imag(t144)
+	t149 := 0.8660254037844387 * real(t145)
+	t150 := 0.8660254037844387 * imag(t145)
+	t151 := complex(t147-t150, t148+t149)
+	t152 := complex(t147+t150, t148-t149)
+	t153 := x83 + x19
+	t154 := x83 - x19
+	t155 := x51 + t153
+	t156 := real(x51) - 0.49999999999999994*real(t153)
+	t157 := imag(x51) - 0.49999999999999994*imag(t153)
+	t158 := 0.8660254037844387 * real(t154)
+	t159 := 0.8660254037844387 * imag(t154)
+	t160 := complex(t156-t159, t157+t158)
+	t161 := complex(t156+t159, t157-t158)
+	t162 := x86 + x22
+	t163 := x86 - x22
+	t164 := x54 + t162
+	t165 := real(x54) - 0.49999999999999994*real(t162)
+	t166 := imag(x54) - 0.49999999999999994*imag(t162)
+	t167 := 0.8660254037844387 * real(t163)
+	t168 := 0.8660254037844387 * imag(t163)
+	t169 := complex(t165-t168, t166+t167)
+	t170 := complex(t165+t168, t166-t167)
+	t171 := x89 + x25
+	t172 := x89 - x25
+	t173 := x57 + t171
+	t174 := real(x57) - 0.49999999999999994*real(t171)
+	t175 := imag(x57) - 0.49999999999999994*imag(t171)
+	t176 := 0.8660254037844387 * real(t172)
+	t177 := 0.8660254037844387 * imag(t172)
+	t178 := complex(t174-t177, t175+t176)
+	t179 := complex(t174+t177, t175-t176)
+	t180 := x92 + x28
+	t181 := x92 - x28
+	t182 := x60 + t180
+	t183 := real(x60) - 0.49999999999999994*real(t180)
+	t184 := imag(x60) - 0.49999999999999994*imag(t180)
+	t185 := 0.8660254037844387 * real(t181)
+	t186 := 0.8660254037844387 * imag(t181)
+	t187 := complex(t183-t186, t184+t185)
+	t188 := complex(t183+t186, t184-t185)
+	t189 := x95 + x31
+	t190 := x95 - x31
+	t191 := x63 + t189
+	t192 := real(x63) - 0.49999999999999994*real(t189)
+	t193 := imag(x63) - 0.49999999999999994*imag(t189)
+	t194 := 0.8660254037844387 * real(t190)
+	t195 := 0.8660254037844387 * imag(t190)
+	t196 := complex(t192-t195, t193+t194)
+	t197 := complex(t192+t195, t193-t194)
+	t198 := x2 + x34
+	t199 := x2 - x34
+	t200 := x66 + t198
+	t201 := real(x66) - 0.49999999999999994*real(t198)
+	t202 := imag(x66) - 0.49999999999999994*imag(t198)
+	t203 := 0.8660254037844387 * real(t199)
+	t204 := 0.8660254037844387 * imag(t199)
+	t205 := complex(t201-t204, t202+t203)
+	t206 := complex(t201+t204, t202-t203)
+	t207 := x5 + x37
+	t208 := x5 - x37
+	t209 := x69 + t207
+	t210 := real(x69) - 0.49999999999999994*real(t207)
+	t211 := imag(x69) - 0.49999999999999994*imag(t207)
+	t212 := 0.8660254037844387 * real(t208)
+	t213 := 0.8660254037844387 * imag(t208)
+	t214 := complex(t210-t213, t211+t212)
+	t215 := complex(t210+t213, t211-t212)
+	t216 := x8 + x40
+	t217 := x8 - x40
+	t218 := x72 + t216
+	t219 := real(x72) - 0.49999999999999994*real(t216)
+	t220 := imag(x72) - 0.49999999999999994*imag(t216)
+	t221 := 0.8660254037844387 * real(t217)
+	t222 := 0.8660254037844387 * imag(t217)
+	t223 := complex(t219-t222, t220+t221)
+	t224 := complex(t219+t222, t220-t221)
+	t225 := x11 + x43
+	t226 := x11 - x43
+	t227 := x75 + t225
+	t228 := real(x75) - 0.49999999999999994*real(t225)
+	t229 := imag(x75) - 0.49999999999999994*imag(t225)
+	t230 := 0.8660254037844387 * real(t226)
+	t231 := 0.8660254037844387 * imag(t226)
+	t232 := complex(t228-t231, t229+t230)
+	t233 := complex(t228+t231, t229-t230)
+	t234 := x14 + x46
+	t235 := x14 - x46
+	t236 := x78 + t234
+	t237 := real(x78) - 0.49999999999999994*real(t234)
+	t238 := imag(x78) - 0.49999999999999994*imag(t234)
+	t239 := 0.8660254037844387 * real(t235)
+	t240 := 0.8660254037844387 * imag(t235)
+	t241 := complex(t237-t240, t238+t239)
+	t242 := complex(t237+t240, t238-t239)
+	t243 := x17 + x49
+	t244 := x17 - x49
+	t245 := x81 + t243
+	t246 := real(x81) - 0.49999999999999994*real(t243)
+	t247 := imag(x81) - 0.49999999999999994*imag(t243)
+	t248 := 0.8660254037844387 * real(t244)
+	t249 := 0.8660254037844387 * imag(t244)
+	t250 := complex(t246-t249, t247+t248)
+	t251 := complex(t246+t249, t247-t248)
+	t252 := x20 + x52
+	t253 := x20 - x52
+	t254 := x84 + t252
+	t255 := real(x84) - 0.49999999999999994*real(t252)
+	t256 := imag(x84) - 0.49999999999999994*imag(t252)
+	t257 := 0.8660254037844387 * real(t253)
+	t258 := 0.8660254037844387 * imag(t253)
+	t259 := complex(t255-t258, t256+t257)
+	t260 := complex(t255+t258, t256-t257)
+	t261 := x23 + x55
+	t262 := x23 - x55
+	t263 := x87 + t261
+	t264 := real(x87) - 0.49999999999999994*real(t261)
+	t265 := imag(x87) - 0.49999999999999994*imag(t261)
+	t266 := 0.8660254037844387 * real(t262)
+	t267 := 0.8660254037844387 * imag(t262)
+	t268 := complex(t264-t267, t265+t266)
+	t269 := complex(t264+t267, t265-t266)
+	t270 := x26 + x58
+	t271 := x26 - x58
+	t272 := x90 + t270
+	t273 := real(x90) - 0.49999999999999994*real(t270)
+	t274 := imag(x90) - 0.49999999999999994*imag(t270)
+	t275 := 0.8660254037844387 * real(t271)
+	t276 := 0.8660254037844387 * imag(t271)
+	t277 := complex(t273-t276, t274+t275)
+	t278 := complex(t273+t276, t274-t275)
+	t279 := x29 + x61
+	t280 := x29 - x61
+	t281 := x93 + t279
+	t282 := real(x93) - 0.49999999999999994*real(t279)
+	t283 := imag(x93) - 0.49999999999999994*imag(t279)
+	t284 := 0.8660254037844387 * real(t280)
+	t285 := 0.8660254037844387 * imag(t280)
+	t286 := complex(t282-t285, t283+t284)
+	t287 := complex(t282+t285, t283-t284)
+	t288 := t2 + t146
+	t289 := t2 - t146
+	t290 := t38 + t182
+	t291 := t38 - t182
+	t292 := t74 + t218
+	t293 := t74 - t218
+	t294 := t110 + t254
+	t295 := t110 - t254
+	t296 := t288 + t292
+	t297 := t288 - t292
+	t298 := t290 + t294
+	t299 := t290 - t294
+	t300 := complex(-imag(t299), real(t299))
+	t301 := t296 + t298
+	t302 := t297 + t300
+	t303 := t296 - t298
+	t304 := t297 - t300
+	t305 := complex((real(t291)-imag(t291))*0.7071067811865476, (imag(t291)+real(t291))*0.7071067811865476)
+	t306 := complex(-imag(t293), real(t293))
+	t307 := complex((-real(t295)-imag(t295))*0.7071067811865476, (-imag(t295)+real(t295))*0.7071067811865476)
+	t308 := t289 + t306
+	t309 := t289 - t306
+	t310 := t305 + t307
+	t311 := t305 - t307
+	t312 := complex(-imag(t311), real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := t11 + t155
+	t318 := t11 - t155
+	t319 := t47 + t191
+	t320 := t47 - t191
+	t321 := t83 + t227
+	t322 := t83 - t227
+	t323 := t119 + t263
+	t324 := t119 - t263
+	t325 := t317 + t321
+	t326 := t317 - t321
+	t327 := t319 + t323
+	t328 := t319 - t323
+	t329 := complex(-imag(t328), real(t328))
+	t330 := t325 + t327
+	t331 := t326 + t329
+	t332 := t325 - t327
+	t333 := t326 - t329
+	t334 := complex((real(t320)-imag(t320))*0.7071067811865476, (imag(t320)+real(t320))*0.7071067811865476)
+	t335 := complex(-imag(t322), real(t322))
+	t336 := complex((-real(t324)-imag(t324))*0.7071067811865476, (-imag(t324)+real(t324))*0.7071067811865476)
+	t337 := t318 + t335
+	t338 := t318 - t335
+	t339 := t334 + t336
+	t340 := t334 - t336
+	t341 := complex(-imag(t340), real(t340))
+	t342 := t337 + t339
+	t343 := t338 + t341
+	t344 := t337 - t339
+	t345 := t338 - t341
+	t346 := t20 + t164
+	t347 := t20 - t164
+	t348 := t56 + t200
+	t349 := t56 - t200
+	t350 := t92 + t236
+	t351 := t92 - t236
+	t352 := t128 + t272
+	t353 := t128 - t272
+	t354 := t346 + t350
+	t355 := t346 - t350
+	t356 := t348 + t352
+	t357 := t348 - t352
+	t358 := complex(-imag(t357), real(t357))
+	t359 := t354 + t356
+	t360 := t355 + t358
+	t361 := t354 - t356
+	t362 := t355 - t358
+	t363 := complex((real(t349)-imag(t349))*0.7071067811865476, (imag(t349)+real(t349))*0.7071067811865476)
+	t364 := complex(-imag(t351), real(t351))
+	t365 := complex((-real(t353)-imag(t353))*0.7071067811865476, (-imag(t353)+real(t353))*0.7071067811865476)
+	t366 := t347 + t364
+	t367 := t347 - t364
+	t368 := t363 + t365
+	t369 := t363 - t365
+	t370 := complex(-imag(t369), real(t369))
+	t371 := t366 + t368
+	t372 := t367 + t370
+	t373 := t366 - t368
+	t374 := t367 - t370
+	t375 := t29 + t173
+	t376 := t29 - t173
+	t377 := t65 + t209
+	t378 := t65 - t209
+	t379 := t101 + t245
+	t380 := t101 - t245
+	t381 := t137 + t281
+	t382 := t137 - t281
+	t383 := t375 + t379
+	t384 := t375 - t379
+	t385 := t377 + t381
+	t386 := t377 - t381
+	t387 := complex(-imag(t386), real(t386))
+	t388 := t383 + t385
+	t389 := t384 + t387
+	t390 := t383 - t385
+	t391 := t384 - t387
+	t392 := complex((real(t378)-imag(t378))*0.7071067811865476, (imag(t378)+real(t378))*0.7071067811865476)
+	t393 := complex(-imag(t380), real(t380))
+	t394 := complex((-real(t382)-imag(t382))*0.7071067811865476, (-imag(t382)+real(t382))*0.7071067811865476)
+	t395 := t376 + t393
+	t396 := t376 - t393
+	t397 := t392 + t394
+	t398 := t392 - t394
+	t399 := complex(-imag(t398), real(t398))
+	t400 := t395 + t397
+	t401 := t396 + t399
+	t402 := t395 - t397
+	t403 := t396 - t399
+	t404 := t301 + t359
+	t405 := t301 - t359
+	t406 := t330 + t388
+	t407 := t330 - t388
+	t408 := complex(-imag(t407), real(t407))
+	t409 := t404 + t406
+	t410 := t405 + t408
+	t411 := t404 - t406
+	t412 := t405 - t408
+	t413 := t342 * complex(0.9807852804032304, 0.19509032201612825)
+	t414 := t371 * complex(0.9238795325112867, 0.3826834323650898)
+	t415 := t400 * complex(0.8314696123025453, 0.5555702330196022)
+	t416 := t313 + t414
+	t417 := t313 - t414
+	t418 := t413 + t415
+	t419 := t413 - t415
+	t420 := complex(-imag(t419), real(t419))
+	t421 := t416 + t418
+	t422 := t417 + t420
+	t423 := t416 - t418
+	t424 := t417 - t420
+	t425 := t331 * complex(0.9238795325112867, 0.3826834323650898)
+	t426 := complex((real(t360)-imag(t360))*0.7071067811865476, (imag(t360)+real(t360))*0.7071067811865476)
+	t427 := t389 * complex(0.3826834323650898, 0.9238795325112867)
+	t428 := t302 + t426
+	t429 := t302 - t426
+	t430 := t425 + t427
+	t431 := t425 - t427
+	t432 := complex(-imag(t431), real(t431))
+	t433 := t428 + t430
+	t434 := t429 + t432
+	t435 := t428 - t430
+	t436 := t429 - t432
+	t437 := t343 * complex(0.8314696123025453, 0.5555702330196022)
+	t438 := t372 * complex(0.3826834323650898, 0.9238795325112867)
+	t439 := t401 * complex(-0.19509032201612825, 0.9807852804032304)
+	t440 := t314 + t438
+	t441 := t314 - t438
+	t442 := t437 + t439
+	t443 := t437 - t439
+	t444 := complex(-imag(t443), real(t443))
+	t445 := t440 + t442
+	t446 := t441 + t444
+	t447 := t440 - t442
+	t448 := t441 - t444
+	t449 := complex((real(t332)-imag(t332))*0.7071067811865476, (imag(t332)+real(t332))*0.7071067811865476)
+	t450 := complex(-imag(t361), real(t361))
+	t451 := complex((-real(t390)-imag(t390))*0.7071067811865476, (-imag(t390)+real(t390))*0.7071067811865476)
+	t452 := t303 + t450
+	t453 := t303 - t450
+	t454 := t449 + t451
+	t455 := t449 - t451
+	t456 := complex(-imag(t455), real(t455))
+	t457 := t452 + t454
+	t458 := t453 + t456
+	t459 := t452 - t454
+	t460 := t453 - t456
+	t461 := t344 * complex(0.5555702330196022, 0.8314696123025453)
+	t462 := t373 * complex(-0.3826834323650898, 0.9238795325112867)
+	t463 := t402 * complex(-0.9807852804032304, 0.19509032201612825)
+	t464 := t315 + t462
+	t465 := t315 - t462
+	t466 := t461 + t463
+	t467 := t461 - t463
+	t468 := complex(-imag(t467), real(t467))
+	t469 := t464 + t466
+	t470 := t465 + t468
+	t471 := t464 - t466
+	t472 := t465 - t468
+	t473 := t333 * complex(0.3826834323650898, 0.9238795325112867)
+	t474 := complex((-real(t362)-imag(t362))*0.7071067811865476, (-imag(t362)+real(t362))*0.7071067811865476)
+	t475 := t391 * complex(-0.9238795325112867, -0.3826834323650898)
+	t476 := t304 + t474
+	t477 := t304 - t474
+	t478 := t473 + t475
+	t479 := t473 - t475
+	t480 := complex(-imag(t479), real(t479))
+	t481 := t476 + t478
+	t482 := t477 + t480
+	t483 := t476 - t478
+	t484 := t477 - t480
+	t485 := t345 * complex(0.19509032201612825, 0.9807852804032304)
+	t486 := t374 * complex(-0.9238795325112867, 0.3826834323650898)
+	t487 := t403 * complex(-0.5555702330196022, -0.8314696123025453)
+	t488 := t316 + t486
+	t489 := t316 - t486
+	t490 := t485 + t487
+	t491 := t485 - t487
+	t492 := complex(-imag(t491), real(t491))
+	t493 := t488 + t490
+	t494 := t489 + t492
+	t495 := t488 - t490
+	t496 := t489 - t492
+	t497 := t7 + t151
+	t498 := t7 - t151
+	t499 := t43 + t187
+	t500 := t43 - t187
+	t501 := t79 + t223
+	t502 := t79 - t223
+	t503 := t115 + t259
+	t504 := t115 - t259
+	t505 := t497 + t501
+	t506 := t497 - t501
+	t507 := t499 + t503
+	t508 := t499 - t503
+	t509 := complex(-imag(t508), real(t508))
+	t510 := t505 + t507
+	t511 := t506 + t509
+	t512 := t505 - t507
+	t513 := t506 - t509
+	t514 := complex((real(t500)-imag(t500))*0.7071067811865476, (imag(t500)+real(t500))*0.7071067811865476)
+	t515 := complex(-imag(t502), real(t502))
+	t516 := complex((-real(t504)-imag(t504))*0.7071067811865476, (-imag(t504)+real(t504))*0.7071067811865476)
+	t517 := t498 + t515
+	t518 := t498 - t515
+	t519 := t514 + t516
+	t520 := t514 - t516
+	t521 := complex(-imag(t520), real(t520))
+	t522 := t517 + t519
+	t523 := t518 + t521
+	t524 := t517 - t519
+	t525 := t518 - t521
+	t526 := t16 + t160
+	t527 := t16 - t160
+	t528 := t52 + t196
+	t529 := t52 - t196
+	t530 := t88 + t232
+	t531 := t88 - t232
+	t532 := t124 + t268
+	t533 := t124 - t268
+	t534 := t526 + t530
+	t535 := t526 - t530
+	t536 := t528 + t532
+	t537 := t528 - t532
+	t538 := complex(-imag(t537), real(t537))
+	t539 := t534 + t536
+	t540 := t535 + t538
+	t541 := t534 - t536
+	t542 := t535 - t538
+	t543 := complex((real(t529)-imag(t529))*0.7071067811865476, (imag(t529)+real(t529))*0.7071067811865476)
+	t544 := complex(-imag(t531), real(t531))
+	t545 := complex((-real(t533)-imag(t533))*0.7071067811865476, (-imag(t533)+real(t533))*0.7071067811865476)
+	t546 := t527 + t544
+	t547 := t527 - t544
+	t548 := t543 + t545
+	t549 := t543 - t545
+	t550 := complex(-imag(t549), real(t549))
+	t551 := t546 + t548
+	t552 := t547 + t550
+	t553 := t546 - t548
+	t554 := t547 - t550
+	t555 := t25 + t169
+	t556 := t25 - t169
+	t557 := t61 + t205
+	t558 := t61 - t205
+	t559 := t97 + t241
+	t560 := t97 - t241
+	t561 := t133 + t277
+	t562 := t133 - t277
+	t563 := t555 + t559
+	t564 := t555 - t559
+	t565 := t557 + t561
+	t566 := t557 - t561
+	t567 := complex(-imag(t566), real(t566))
+	t568 := t563 + t565
+	t569 := t564 + t567
+	t570 := t563 - t565
+	t571 := t564 - t567
+	t572 := complex((real(t558)-imag(t558))*0.7071067811865476, (imag(t558)+real(t558))*0.7071067811865476)
+	t573 := complex(-imag(t560), real(t560))
+	t574 := complex((-real(t562)-imag(t562))*0.7071067811865476, (-imag(t562)+real(t562))*0.7071067811865476)
+	t575 := t556 + t573
+	t576 := t556 - t573
+	t577 := t572 + t574
+	t578 := t572 - t574
+	t579 := complex(-imag(t578), real(t578))
+	t580 := t575 + t577
+	t581 := t576 + t579
+	t582 := t575 - t577
+	t583 := t576 - t579
+	t584 := t34 + t178
+	t585 := t34 - t178
+	t586 := t70 + t214
+	t587 := t70 - t214
+	t588 := t106 + t250
+	t589 := t106 - t250
+	t590 := t142 + t286
+	t591 := t142 - t286
+	t592 := t584 + t588
+	t593 := t584 - t588
+	t594 := t586 + t590
+	t595 := t586 - t590
+	t596 := complex(-imag(t595), real(t595))
+	t597 := t592 + t594
+	t598 := t593 + t596
+	t599 := t592 - t594
+	t600 := t593 - t596
+	t601 := complex((real(t587)-imag(t587))*0.7071067811865476, (imag(t587)+real(t587))*0.7071067811865476)
+	t602 := complex(-imag(t589), real(t589))
+	t603 := complex((-real(t591)-imag(t591))*0.7071067811865476, (-imag(t591)+real(t591))*0.7071067811865476)
+	t604 := t585 + t602
+	t605 := t585 - t602
+	t606 := t601 + t603
+	t607 := t601 - t603
+	t608 := complex(-imag(t607), real(t607))
+	t609 := t604 + t606
+	t610 := t605 + t608
+	t611 := t604 - t606
+	t612 := t605 - t608
+	t613 := t510 + t568
+	t614 := t510 - t568
+	t615 := t539 + t597
+	t616 := t539 - t597
+	t617 := complex(-imag(t616), real(t616))
+	t618 := t613 + t615
+	t619 := t614 + t617
+	t620 := t613 - t615
+	t621 := t614 - t617
+	t622 := t551 * complex(0.9807852804032304, 0.19509032201612825)
+	t623 := t580 * complex(0.9238795325112867, 0.3826834323650898)
+	t624 := t609 * complex(0.8314696123025453, 0.5555702330196022)
+	t625 := t522 + t623
+	t626 := t522 - t623
+	t627 := t622 + t624
+	t628 := t622 - t624
+	t629 := complex(-imag(t628), real(t628))
+	t630 := t625 + t627
+	t631 := t626 + t629
+	t632 := t625 - t627
+	t633 := t626 - t629
+	t634 := t540 * complex(0.9238795325112867, 0.3826834323650898)
+	t635 := complex((real(t569)-imag(t569))*0.7071067811865476, (imag(t569)+real(t569))*0.7071067811865476)
+	t636 := t598 * complex(0.3826834323650898, 0.9238795325112867)
+	t637 := t511 + t635
+	t638 := t511 - t635
+	t639 := t634 + t636
+	t640 := t634 - t636
+	t641 := complex(-imag(t640), real(t640))
+	t642 := t637 + t639
+	t643 := t638 + t641
+	t644 := t637 - t639
+	t645 := t638 - t641
+	t646 := t552 * complex(0.8314696123025453, 0.5555702330196022)
+	t647 := t581 * complex(0.3826834323650898, 0.9238795325112867)
+	t648 := t610 * complex(-0.19509032201612825, 0.9807852804032304)
+	t649 := t523 + t647
+	t650 := t523 - t647
+	t651 := t646 + t648
+	t652 := t646 - t648
+	t653 := complex(-imag(t652), real(t652))
+	t654 := t649 + t651
+	t655 := t650 + t653
+	t656 := t649 - t651
+	t657 := t650 - t653
+	t658 := complex((real(t541)-imag(t541))*0.7071067811865476, (imag(t541)+real(t541))*0.7071067811865476)
+	t659 := complex(-imag(t570), real(t570))
+	t660 := complex((-real(t599)-imag(t599))*0.7071067811865476, (-imag(t599)+real(t599))*0.7071067811865476)
+	t661 := t512 + t659
+	t662 := t512 - t659
+	t663 := t658 + t660
+	t664 := t658 - t660
+	t665 := complex(-imag(t664), real(t664))
+	t666 := t661 + t663
+	t667 := t662 + t665
+	t668 := t661 - t663
+	t669 := t662 - t665
+	t670 := t553 * complex(0.5555702330196022, 0.8314696123025453)
+	t671 := t582 * complex(-0.3826834323650898, 0.9238795325112867)
+	t672 := t611 * complex(-0.9807852804032304, 0.19509032201612825)
+	t673 := t524 + t671
+	t674 := t524 - t671
+	t675 := t670 + t672
+	t676 := t670 - t672
+	t677 := complex(-imag(t676), real(t676))
+	t678 := t673 + t675
+	t679 := t674 + t677
+	t680 := t673 - t675
+	t681 := t674 - t677
+	t682 := t542 * complex(0.3826834323650898, 0.9238795325112867)
+	t683 := complex((-real(t571)-imag(t571))*0.7071067811865476, (-imag(t571)+real(t571))*0.7071067811865476)
+	t684 := t600 * complex(-0.9238795325112867, -0.3826834323650898)
+	t685 := t513 + t683
+	t686 := t513 - t683
+	t687 := t682 + t684
+	t688 := t682 - t684
+	t689 := complex(-imag(t688), real(t688))
+	t690 := t685 + t687
+	t691 := t686 + t689
+	t692 := t685 - t687
+	t693 := t686 - t689
+	t694 := t554 * complex(0.19509032201612825, 0.9807852804032304)
+	t695 := t583 * complex(-0.9238795325112867, 0.3826834323650898)
+	t696 := t612 * complex(-0.5555702330196022, -0.8314696123025453)
+	t697 := t525 + t695
+	t698 := t525 - t695
+	t699 := t694 + t696
+	t700 := t694 - t696
+	t701 := complex(-imag(t700), real(t700))
+	t702 := t697 + t699
+	t703 := t698 + t701
+	t704 := t697 - t699
+	t705 := t698 - t701
+	t706 := t8 + t152
+	t707 := t8 - t152
+	t708 := t44 + t188
+	t709 := t44 - t188
+	t710 := t80 + t224
+	t711 := t80 - t224
+	t712 := t116 + t260
+	t713 := t116 - t260
+	t714 := t706 + t710
+	t715 := t706 - t710
+	t716 := t708 + t712
+	t717 := t708 - t712
+	t718 := complex(-imag(t717), real(t717))
+	t719 := t714 + t716
+	t720 := t715 + t718
+	t721 := t714 - t716
+	t722 := t715 - t718
+	t723 := complex((real(t709)-imag(t709))*0.7071067811865476, (imag(t709)+real(t709))*0.7071067811865476)
+	t724 := complex(-imag(t711), real(t711))
+	t725 := complex((-real(t713)-imag(t713))*0.7071067811865476, (-imag(t713)+real(t713))*0.7071067811865476)
+	t726 := t707 + t724
+	t727 := t707 - t724
+	t728 := t723 + t725
+	t729 := t723 - t725
+	t730 := complex(-imag(t729), real(t729))
+	t731 := t726 + t728
+	t732 := t727 + t730
+	t733 := t726 - t728
+	t734 := t727 - t730
+	t735 := t17 + t161
+	t736 := t17 - t161
+	t737 := t53 + t197
+	t738 := t53 - t197
+	t739 := t89 + t233
+	t740 := t89 - t233
+	t741 := t125 + t269
+	t742 := t125 - t269
+	t743 := t735 + t739
+	t744 := t735 - t739
+	t745 := t737 + t741
+	t746 := t737 - t741
+	t747 := complex(-imag(t746), real(t746))
+	t748 := t743 + t745
+	t749 := t744 + t747
+	t750 := t743 - t745
+	t751 := t744 - t747
+	t752 := complex((real(t738)-imag(t738))*0.7071067811865476, (imag(t738)+real(t738))*0.7071067811865476)
+	t753 := complex(-imag(t740), real(t740))
+	t754 := complex((-real(t742)-imag(t742))*0.7071067811865476, (-imag(t742)+real(t742))*0.7071067811865476)
+	t755 := t736 + t753
+	t756 := t736 - t753
+	t757 := t752 + t754
+	t758 := t752 - t754
+	t759 := complex(-imag(t758), real(t758))
+	t760 := t755 + t757
+	t761 := t756 + t759
+	t762 := t755 - t757
+	t763 := t756 - t759
+	t764 := t26 + t170
+	t765 := t26 - t170
+	t766 := t62 + t206
+	t767 := t62 - t206
+	t768 := t98 + t242
+	t769 := t98 - t242
+	t770 := t134 + t278
+	t771 := t134 - t278
+	t772 := t764 + t768
+	t773 := t764 - t768
+	t774 := t766 + t770
+	t775 := t766 - t770
+	t776 := complex(-imag(t775), real(t775))
+	t777 := t772 + t774
+	t778 := t773 + t776
+	t779 := t772 - t774
+	t780 := t773 - t776
+	t781 := complex((real(t767)-imag(t767))*0.7071067811865476, (imag(t767)+real(t767))*0.7071067811865476)
+	t782 := complex(-imag(t769), real(t769))
+	t783 := complex((-real(t771)-imag(t771))*0.7071067811865476, (-imag(t771)+real(t771))*0.7071067811865476)
+	t784 := t765 + t782
+	t785 := t765 - t782
+	t786 := t781 + t783
+	t787 := t781 - t783
+	t788 := complex(-imag(t787), real(t787))
+	t789 := t784 + t786
+	t790 := t785 + t788
+	t791 := t784 - t786
+	t792 := t785 - t788
+	t793 := t35 + t179
+	t794 := t35 - t179
+	t795 := t71 + t215
+	t796 := t71 - t215
+	t797 := t107 + t251
+	t798 := t107 - t251
+	t799 := t143 + t287
+	t800 := t143 - t287
+	t801 := t793 + t797
+	t802 := t793 - t797
+	t803 := t795 + t799
+	t804 := t795 - t799
+	t805 := complex(-imag(t804), real(t804))
+	t806 := t801 + t803
+	t807 := t802 + t805
+	t808 := t801 - t803
+	t809 := t802 - t805
+	t810 := complex((real(t796)-imag(t796))*0.7071067811865476, (imag(t796)+real(t796))*0.7071067811865476)
+	t811 := complex(-imag(t798), real(t798))
+	t812 := complex((-real(t800)-imag(t800))*0.7071067811865476, (-imag(t800)+real(t800))*0.7071067811865476)
+	t813 := t794 + t811
+	t814 := t794 - t811
+	t815 := t810 + t812
+	t816 := t810 - t812
+	t817 := complex(-imag(t816), real(t816))
+	t818 := t813 + t815
+	t819 := t814 + t817
+	t820 := t813 - t815
+	t821 := t814 - t817
+	t822 := t719 + t777
+	t823 := t719 - t777
+	t824 := t748 + t806
+	t825 := t748 - t806
+	t826 := complex(-imag(t825), real(t825))
+	t827 := t822 + t824
+	t828 := t823 + t826
+	t829 := t822 - t824
+	t830 := t823 - t826
+	t831 := t760 * complex(0.9807852804032304, 0.19509032201612825)
+	t832 := t789 * complex(0.9238795325112867, 0.3826834323650898)
+	t833 := t818 * complex(0.8314696123025453, 0.5555702330196022)
+	t834 := t731 + t832
+	t835 := t731 - t832
+	t836 := t831 + t833
+	t837 := t831 - t833
+	t838 := complex(-imag(t837), real(t837))
+	t839 := t834 + t836
+	t840 := t835 + t838
+	t841 := t834 - t836
+	t842 := t835 - t838
+	t843 := t749 * complex(0.9238795325112867, 0.3826834323650898)
+	t844 := complex((real(t778)-imag(t778))*0.7071067811865476, (imag(t778)+real(t778))*0.7071067811865476)
+	t845 := t807 * complex(0.3826834323650898, 0.9238795325112867)
+	t846 := t720 + t844
+	t847 := t720 - t844
+	t848 := t843 + t845
+	t849 := t843 - t845
+	t850 := complex(-imag(t849), real(t849))
+	t851 := t846 + t848
+	t852 := t847 + t850
+	t853 := t846 - t848
+	t854 := t847 - t850
+	t855 := t761 * complex(0.8314696123025453, 0.5555702330196022)
+	t856 := t790 * complex(0.3826834323650898, 0.9238795325112867)
+	t857 := t819 * complex(-0.19509032201612825, 0.9807852804032304)
+	t858 := t732 + t856
+	t859 := t732 - t856
+	t860 := t855 + t857
+	t861 := t855 - t857
+	t862 := complex(-imag(t861), real(t861))
+	t863 := t858 + t860
+	t864 := t859 + t862
+	t865 := t858 - t860
+	t866 := t859 - t862
+	t867 := complex((real(t750)-imag(t750))*0.7071067811865476, (imag(t750)+real(t750))*0.7071067811865476)
+	t868 := complex(-imag(t779), real(t779))
+	t869 := complex((-real(t808)-imag(t808))*0.7071067811865476, (-imag(t808)+real(t808))*0.7071067811865476)
+	t870 := t721 + t868
+	t871 := t721 - t868
+	t872 := t867 + t869
+	t873 := t867 - t869
+	t874 := complex(-imag(t873), real(t873))
+	t875 := t870 + t872
+	t876 := t871 + t874
+	t877 := t870 - t872
+	t878 := t871 - t874
+	t879 := t762 * complex(0.5555702330196022, 0.8314696123025453)
+	t880 := t791 * complex(-0.3826834323650898, 0.9238795325112867)
+	t881 := t820 * complex(-0.9807852804032304, 0.19509032201612825)
+	t882 := t733 + t880
+	t883 := t733 - t880
+	t884 := t879 + t881
+	t885 := t879 - t881
+	t886 := complex(-imag(t885), real(t885))
+	t887 := t882 + t884
+	t888 := t883 + t886
+	t889 := t882 - t884
+	t890 := t883 - t886
+	t891 := t751 * complex(0.3826834323650898, 0.9238795325112867)
+	t892 := complex((-real(t780)-imag(t780))*0.7071067811865476, (-imag(t780)+real(t780))*0.7071067811865476)
+	t893 := t809 * complex(-0.9238795325112867, -0.3826834323650898)
+	t894 := t722 + t892
+	t895 := t722 - t892
+	t896 := t891 + t893
+	t897 := t891 - t893
+	t898 := complex(-imag(t897), real(t897))
+	t899 := t894 + t896
+	t900 := t895 + t898
+	t901 := t894 - t896
+	t902 := t895 - t898
+	t903 := t763 * complex(0.19509032201612825, 0.9807852804032304)
+	t904 := t792 * complex(-0.9238795325112867, 0.3826834323650898)
+	t905 := t821 * complex(-0.5555702330196022, -0.8314696123025453)
+	t906 := t734 + t904
+	t907 := t734 - t904
+	t908 := t903 + t905
+	t909 := t903 - t905
+	t910 := complex(-imag(t909), real(t909))
+	t911 := t906 + t908
+	t912 := t907 + t910
+	t913 := t906 - t908
+	t914 := t907 - t910
+
+	d[0] = complex(real(t409)*0.010416666666666666, imag(t409)*0.010416666666666666)
+	d[1] = complex(real(t630)*0.010416666666666666, imag(t630)*0.010416666666666666)
+	d[2] = complex(real(t851)*0.010416666666666666, imag(t851)*0.010416666666666666)
+	d[3] = complex(real(t445)*0.010416666666666666, imag(t445)*0.010416666666666666)
+	d[4] = complex(real(t666)*0.010416666666666666, imag(t666)*0.010416666666666666)
+	d[5] = complex(real(t887)*0.010416666666666666, imag(t887)*0.010416666666666666)
+	d[6] = complex(real(t481)*0.010416666666666666, imag(t481)*0.010416666666666666)
+	d[7] = complex(real(t702)*0.010416666666666666, imag(t702)*0.010416666666666666)
+	d[8] = complex(real(t828)*0.010416666666666666, imag(t828)*0.010416666666666666)
+	d[9] = complex(real(t422)*0.010416666666666666, imag(t422)*0.010416666666666666)
+	d[10] = complex(real(t643)*0.010416666666666666, imag(t643)*0.010416666666666666)
+	d[11] = complex(real(t864)*0.010416666666666666, imag(t864)*0.010416666666666666)
+	d[12] = complex(real(t458)*0.010416666666666666, imag(t458)*0.010416666666666666)
+	d[13] = complex(real(t679)*0.010416666666666666, imag(t679)*0.010416666666666666)
+	d[14] = complex(real(t900)*0.010416666666666666, imag(t900)*0.010416666666666666)
+	d[15] = complex(real(t494)*0.010416666666666666, imag(t494)*0.010416666666666666)
+	d[16] = complex(real(t620)*0.010416666666666666, imag(t620)*0.010416666666666666)
+	d[17] = complex(real(t841)*0.010416666666666666, imag(t841)*0.010416666666666666)
+	d[18] = complex(real(t435)*0.010416666666666666, imag(t435)*0.010416666666666666)
+	d[19] = complex(real(t656)*0.010416666666666666, imag(t656)*0.010416666666666666)
+	d[20] = complex(real(t877)*0.010416666666666666, imag(t877)*0.010416666666666666)
+	d[21] = complex(real(t471)*0.010416666666666666, imag(t471)*0.010416666666666666)
+	d[22] = complex(real(t692)*0.010416666666666666, imag(t692)*0.010416666666666666)
+	d[23] = complex(real(t913)*0.010416666666666666, imag(t913)*0.010416666666666666)
+	d[24] = complex(real(t412)*0.010416666666666666, imag(t412)*0.010416666666666666)
+	d[25] = complex(real(t633)*0.010416666666666666, imag(t633)*0.010416666666666666)
+	d[26] = complex(real(t854)*0.010416666666666666, imag(t854)*0.010416666666666666)
+	d[27] = complex(real(t448)*0.010416666666666666, imag(t448)*0.010416666666666666)
+	d[28] = complex(real(t669)*0.010416666666666666, imag(t669)*0.010416666666666666)
+	d[29] = complex(real(t890)*0.010416666666666666, imag(t890)*0.010416666666666666)
+	d[30] = complex(real(t484)*0.010416666666666666, imag(t484)*0.010416666666666666)
+	d[31] = complex(real(t705)*0.010416666666666666, imag(t705)*0.010416666666666666)
+	d[32] = complex(real(t827)*0.010416666666666666, imag(t827)*0.010416666666666666)
+	d[33] = complex(real(t421)*0.010416666666666666, imag(t421)*0.010416666666666666)
+	d[34] = complex(real(t642)*0.010416666666666666, imag(t642)*0.010416666666666666)
+	d[35] = complex(real(t863)*0.010416666666666666, imag(t863)*0.010416666666666666)
+	d[36] = complex(real(t457)*0.010416666666666666, imag(t457)*0.010416666666666666)
+	d[37] = complex(real(t678)*0.010416666666666666, imag(t678)*0.010416666666666666)
+	d[38] = complex(real(t899)*0.010416666666666666, imag(t899)*0.010416666666666666)
+	d[39] = complex(real(t493)*0.010416666666666666, imag(t493)*0.010416666666666666)
+	d[40] = complex(real(t619)*0.010416666666666666, imag(t619)*0.010416666666666666)
+	d[41] = complex(real(t840)*0.010416666666666666, imag(t840)*0.010416666666666666)
+	d[42] = complex(real(t434)*0.010416666666666666, imag(t434)*0.010416666666666666)
+	d[43] = complex(real(t655)*0.010416666666666666, imag(t655)*0.010416666666666666)
+	d[44] = complex(real(t876)*0.010416666666666666, imag(t876)*0.010416666666666666)
+	d[45] = complex(real(t470)*0.010416666666666666, imag(t470)*0.010416666666666666)
+	d[46] = complex(real(t691)*0.010416666666666666, imag(t691)*0.010416666666666666)
+	d[47] = complex(real(t912)*0.010416666666666666, imag(t912)*0.010416666666666666)
+	d[48] = complex(real(t411)*0.010416666666666666, imag(t411)*0.010416666666666666)
+	d[49] = complex(real(t632)*0.010416666666666666, imag(t632)*0.010416666666666666)
+	d[50] = complex(real(t853)*0.010416666666666666, imag(t853)*0.010416666666666666)
+	d[51] = complex(real(t447)*0.010416666666666666, imag(t447)*0.010416666666666666)
+	d[52] = complex(real(t668)*0.010416666666666666, imag(t668)*0.010416666666666666)
+	d[53] = complex(real(t889)*0.010416666666666666, imag(t889)*0.010416666666666666)
+	d[54] = complex(real(t483)*0.010416666666666666, imag(t483)*0.010416666666666666)
+	d[55] = complex(real(t704)*0.010416666666666666, imag(t704)*0.010416666666666666)
+	d[56] = complex(real(t830)*0.010416666666666666, imag(t830)*0.010416666666666666)
+	d[57] = complex(real(t424)*0.010416666666666666, imag(t424)*0.010416666666666666)
+	d[58] = complex(real(t645)*0.010416666666666666, imag(t645)*0.010416666666666666)
+	d[59] = complex(real(t866)*0.010416666666666666, imag(t866)*0.010416666666666666)
+	d[60] = complex(real(t460)*0.010416666666666666, imag(t460)*0.010416666666666666)
+	d[61] = complex(real(t681)*0.010416666666666666, imag(t681)*0.010416666666666666)
+	d[62] = complex(real(t902)*0.010416666666666666, imag(t902)*0.010416666666666666)
+	d[63] = complex(real(t496)*0.010416666666666666, imag(t496)*0.010416666666666666)
+	d[64] = complex(real(t618)*0.010416666666666666, imag(t618)*0.010416666666666666)
+	d[65] = complex(real(t839)*0.010416666666666666, imag(t839)*0.010416666666666666)
+	d[66] = complex(real(t433)*0.010416666666666666, imag(t433)*0.010416666666666666)
+	d[67] = complex(real(t654)*0.010416666666666666, imag(t654)*0.010416666666666666)
+	d[68] = complex(real(t875)*0.010416666666666666, imag(t875)*0.010416666666666666)
+	d[69] = complex(real(t469)*0.010416666666666666, imag(t469)*0.010416666666666666)
+	d[70] = complex(real(t690)*0.010416666666666666, imag(t690)*0.010416666666666666)
+	d[71] = complex(real(t911)*0.010416666666666666, imag(t911)*0.010416666666666666)
+	d[72] = complex(real(t410)*0.010416666666666666, imag(t410)*0.010416666666666666)
+	d[73] = complex(real(t631)*0.010416666666666666, imag(t631)*0.010416666666666666)
+	d[74] = complex(real(t852)*0.010416666666666666, imag(t852)*0.010416666666666666)
+	d[75] = complex(real(t446)*0.010416666666666666, imag(t446)*0.010416666666666666)
+	d[76] = complex(real(t667)*0.010416666666666666, imag(t667)*0.010416666666666666)
+	d[77] = complex(real(t888)*0.010416666666666666, imag(t888)*0.010416666666666666)
+	d[78] = complex(real(t482)*0.010416666666666666, imag(t482)*0.010416666666666666)
+	d[79] = complex(real(t703)*0.010416666666666666, imag(t703)*0.010416666666666666)
+	d[80] = complex(real(t829)*0.010416666666666666, imag(t829)*0.010416666666666666)
+	d[81] = complex(real(t423)*0.010416666666666666, imag(t423)*0.010416666666666666)
+	d[82] = complex(real(t644)*0.010416666666666666, imag(t644)*0.010416666666666666)
+	d[83] = complex(real(t865)*0.010416666666666666, imag(t865)*0.010416666666666666)
+	d[84] = complex(real(t459)*0.010416666666666666, imag(t459)*0.010416666666666666)
+	d[85] = complex(real(t680)*0.010416666666666666, imag(t680)*0.010416666666666666)
+	d[86] = complex(real(t901)*0.010416666666666666, imag(t901)*0.010416666666666666)
+	d[87] = complex(real(t495)*0.010416666666666666, imag(t495)*0.010416666666666666)
+	d[88] = complex(real(t621)*0.010416666666666666, imag(t621)*0.010416666666666666)
+	d[89] = complex(real(t842)*0.010416666666666666, imag(t842)*0.010416666666666666)
+	d[90] = complex(real(t436)*0.010416666666666666, imag(t436)*0.010416666666666666)
+	d[91] = complex(real(t657)*0.010416666666666666, imag(t657)*0.010416666666666666)
+	d[92] = complex(real(t878)*0.010416666666666666, imag(t878)*0.010416666666666666)
+	d[93] = complex(real(t472)*0.010416666666666666, imag(t472)*0.010416666666666666)
+	d[94] = complex(real(t693)*0.010416666666666666, imag(t693)*0.010416666666666666)
+	d[95] = complex(real(t914)*0.010416666666666666, imag(t914)*0.010416666666666666)
+
+	return true
+}
+
+// forwardDFT96Complex128 computes a 96-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT96Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 96
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+	x60 := s[60]
+	x61 := s[61]
+	x62 := s[62]
+	x63 := s[63]
+	x64 := s[64]
+	x65 := s[65]
+	x66 := s[66]
+	x67 := s[67]
+	x68 := s[68]
+	x69 := s[69]
+	x70 := s[70]
+	x71 := s[71]
+	x72 := s[72]
+	x73 := s[73]
+	x74 := s[74]
+	x75 := s[75]
+	x76 := s[76]
+	x77 := s[77]
+	x78 := s[78]
+	x79 := s[79]
+	x80 := s[80]
+	x81 := s[81]
+	x82 := s[82]
+	x83 := s[83]
+	x84 := s[84]
+	x85 := s[85]
+	x86 := s[86]
+	x87 := s[87]
+	x88 := s[88]
+	x89 := s[89]
+	x90 := s[90]
+	x91 := s[91]
+	x92 := s[92]
+	x93 := s[93]
+	x94 := s[94]
+	x95 := s[95]
+
+	t0 := x32 + x64
+	t1 := x32 - x64
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x35 + x67
+	t10 := x35 - x67
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x38 + x70
+	t19 := x38 - x70
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x41 + x73
+	t28 := x41 - x73
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x44 + x76
+	t37 := x44 - x76
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x47 + x79
+	t46 := x47 - x79
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x50 + x82
+	t55 := x50 - x82
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x53 + x85
+	t64 := x53 - x85
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := x56 + x88
+	t73 := x56 - x88
+	t74 := x24 + t72
+	t75 := real(x24) - 0.49999999999999994*real(t72)
+	t76 := imag(x24) - 0.49999999999999994*imag(t72)
+	t77 := 0.8660254037844387 * real(t73)
+	t78 := 0.8660254037844387 * imag(t73)
+	t79 := complex(t75-t78, t76+t77)
+	t80 := complex(t75+t78, t76-t77)
+	t81 := x59 + x91
+	t82 := x59 - x91
+	t83 := x27 + t81
+	t84 := real(x27) - 0.49999999999999994*real(t81)
+	t85 := imag(x27) - 0.49999999999999994*imag(t81)
+	t86 := 0.8660254037844387 * real(t82)
+	t87 := 0.8660254037844387 * imag(t82)
+	t88 := complex(t84-t87, t85+t86)
+	t89 := complex(t84+t87, t85-t86)
+	t90 := x62 + x94
+	t91 := x62 - x94
+	t92 := x30 + t90
+	t93 := real(x30) - 0.49999999999999994*real(t90)
+	t94 := imag(x30) - 0.49999999999999994*imag(t90)
+	t95 := 0.8660254037844387 * real(t91)
+	t96 := 0.8660254037844387 * imag(t91)
+	t97 := complex(t93-t96, t94+t95)
+	t98 := complex(t93+t96, t94-t95)
+	t99 := x65 + x1
+	t100 := x65 - x1
+	t101 := x33 + t99
+	t102 := real(x33) - 0.49999999999999994*real(t99)
+	t103 := imag(x33) - 0.49999999999999994*imag(t99)
+	t104 := 0.8660254037844387 * real(t100)
+	t105 := 0.8660254037844387 * imag(t100)
+	t106 := complex(t102-t105, t103+t104)
+	t107 := complex(t102+t105, t103-t104)
+	t108 := x68 + x4
+	t109 := x68 - x4
+	t110 := x36 + t108
+	t111 := real(x36) - 0.49999999999999994*real(t108)
+	t112 := imag(x36) - 0.49999999999999994*imag(t108)
+	t113 := 0.8660254037844387 * real(t109)
+	t114 := 0.8660254037844387 * imag(t109)
+	t115 := complex(t111-t114, t112+t113)
+	t116 := complex(t111+t114, t112-t113)
+	t117 := x71 + x7
+	t118 := x71 - x7
+	t119 := x39 + t117
+	t120 := real(x39) - 0.49999999999999994*real(t117)
+	t121 := imag(x39) - 0.49999999999999994*imag(t117)
+	t122 := 0.8660254037844387 * real(t118)
+	t123 := 0.8660254037844387 * imag(t118)
+	t124 := complex(t120-t123, t121+t122)
+	t125 := complex(t120+t123, t121-t122)
+	t126 := x74 + x10
+	t127 := x74 - x10
+	t128 := x42 + t126
+	t129 := real(x42) - 0.49999999999999994*real(t126)
+	t130 := imag(x42) - 0.49999999999999994*imag(t126)
+	t131 := 0.8660254037844387 * real(t127)
+	t132 := 0.8660254037844387 * imag(t127)
+	t133 := complex(t129-t132, t130+t131)
+	t134 := complex(t129+t132, t130-t131)
+	t135 := x77 + x13
+	t136 := x77 - x13
+	t137 := x45 + t135
+	t138 := real(x45) - 0.49999999999999994*real(t135)
+	t139 := imag(x45) - 0.49999999999999994*imag(t135)
+	t140 := 0.8660254037844387 * real(t136)
+	t141 := 0.8660254037844387 * imag(t136)
+	t142 := complex(t138-t141, t139+t140)
+	t143 := complex(t138+t141, t139-t140)
+	t144 := x80 + x16
+	t145 := x80 - x16
+	t146 := x48 + t144
+	t147 := real(x48) - 0.49999999999999994*real(t144)
+	t148 := imag(x48) - 0.49999999999999994*imag(t144)
+	t149 := 0.8660254037844387 * real(t145)
+	t150 := 0.8660254037844387 * imag(t145)
+	t151 := complex(t147-t150, t148+t149)
+	t152 := complex(t147+t150, t148-t149)
+	t153 := x83 + x19
+	t154 := x83 - x19
+	t155 := x51 + t153
+	t156 := real(x51) - 0.49999999999999994*real(t153)
+	t157 := imag(x51) - 0.49999999999999994*imag(t153)
+	t158 := 0.8660254037844387 * real(t154)
+	t159 := 0.8660254037844387 * imag(t154)
+	t160 := complex(t156-t159, t157+t158)
+	t161 := complex(t156+t159, t157-t158)
+	t162 := x86 + x22
+	t163 := x86 - x22
+	t164 := x54 + t162
+	t165 := real(x54) - 0.49999999999999994*real(t162)
+	t166 := imag(x54) - 0.49999999999999994*imag(t162)
+	t167 := 0.8660254037844387 * real(t163)
+	t168 := 0.8660254037844387 * imag(t163)
+	t169 := complex(t165-t168, t166+t167)
+	t170 := complex(t165+t168, t166-t167)
+	t171 := x89 + x25
+	t172 := x89 - x25
+	t173 := x57 + t171
+	t174 := real(x57) - 0.49999999999999994*real(t171)
+	t175 := imag(x57) - 0.49999999999999994*imag(t171)
+	t176 := 0.8660254037844387 * real(t172)
+	t177 := 0.8660254037844387 * imag(t172)
+	t178 := complex(t174-t177, t175+t176)
+	t179 := complex(t174+t177, t175-t176)
+	t180 := x92 + x28
+	t181 := x92 - x28
+	t182 := x60 + t180
+	t183 := real(x60) - 0.49999999999999994*real(t180)
+	t184 := imag(x60) - 0.49999999999999994*imag(t180)
+	t185 := 0.8660254037844387 * real(t181)
+	t186 := 0.8660254037844387 * imag(t181)
+	t187 := complex(t183-t186, t184+t185)
+	t188 := complex(t183+t186, t184-t185)
+	t189 := x95 + x31
+	t190 := x95 - x31
+	t191 := x63 + t189
+	t192 := real(x63) - 0.49999999999999994*real(t189)
+	t193 := imag(x63) - 0.49999999999999994*imag(t189)
+	t194 := 0.8660254037844387 * real(t190)
+	t195 := 0.8660254037844387 * imag(t190)
+	t196 := complex(t192-t195, t193+t194)
+	t197 := complex(t192+t195, t193-t194)
+	t198 := x2 + x34
+	t199 := x2 - x34
+	t200 := x66 + t198
+	t201 := real(x66) - 0.49999999999999994*real(t198)
+	t202 := imag(x66) - 0.49999999999999994*imag(t198)
+	t203 := 0.8660254037844387 * real(t199)
+	t204 := 0.8660254037844387 * imag(t199)
+	t205 := complex(t201-t204, t202+t203)
+	t206 := complex(t201+t204, t202-t203)
+	t207 := x5 + x37
+	t208 := x5 - x37
+	t209 := x69 + t207
+	t210 := real(x69) - 0.49999999999999994*real(t207)
+	t211 := imag(x69) - 0.49999999999999994*imag(t207)
+	t212 := 0.8660254037844387 * real(t208)
+	t213 := 0.8660254037844387 * imag(t208)
+	t214 := complex(t210-t213, t211+t212)
+	t215 := complex(t210+t213, t211-t212)
+	t216 := x8 + x40
+	t217 := x8 - x40
+	t218 := x72 + t216
+	t219 := real(x72) - 0.49999999999999994*real(t216)
+	t220 := imag(x72) - 0.49999999999999994*imag(t216)
+	t221 := 0.8660254037844387 * real(t217)
+	t222 := 0.8660254037844387 * imag(t217)
+	t223 := complex(t219-t222, t220+t221)
+	t224 := complex(t219+t222, t220-t221)
+	t225 := x11 + x43
+	t226 := x11 - x43
+	t227 := x75 + t225
+	t228 := real(x75) - 0.49999999999999994*real(t225)
+	t229 := imag(x75) - 0.49999999999999994*imag(t225)
+	t230 := 0.8660254037844387 * real(t226)
+	t231 := 0.8660254037844387 * imag(t226)
+	t232 := complex(t228-t231, t229+t230)
+	t233 := complex(t228+t231, t229-t230)
+	t234 := x14 + x46
+	t235 := x14 - x46
+	t236 := x78 + t234
+	t237 := real(x78) - 0.49999999999999994*real(t234)
+	t238 := imag(x78) - 0.49999999999999994*imag(t234)
+	t239 := 0.8660254037844387 * real(t235)
+	t240 := 0.8660254037844387 * imag(t235)
+	t241 := complex(t237-t240, t238+t239)
+	t242 := complex(t237+t240, t238-t239)
+	t243 := x17 + x49
+	t244 := x17 - x49
+	t245 := x81 + t243
+	t246 := real(x81) - 0.49999999999999994*real(t243)
+	t247 := imag(x81) - 0.49999999999999994*imag(t243)
+	t248 := 0.8660254037844387 * real(t244)
+	t249 := 0.8660254037844387 * imag(t244)
+	t250 := complex(t246-t249, t247+t248)
+	t251 := complex(t246+t249, t247-t248)
+	t252 := x20 + x52
+	t253 := x20 - x52
+	t254 := x84 + t252
+	t255 := real(x84) - 0.49999999999999994*real(t252)
+	t256 := imag(x84) - 0.49999999999999994*imag(t252)
+	t257 := 0.8660254037844387 * real(t253)
+	t258 := 0.8660254037844387 * imag(t253)
+	t259 := complex(t255-t258, t256+t257)
+	t260 := complex(t255+t258, t256-t257)
+	t261 := x23 + x55
+	t262 := x23 - x55
+	t263 := x87 + t261
+	t264 := real(x87) - 0.49999999999999994*real(t261)
+	t265 := imag(x87) - 0.49999999999999994*imag(t261)
+	t266 := 0.8660254037844387 * real(t262)
+	t267 := 0.8660254037844387 * imag(t262)
+	t268 := complex(t264-t267, t265+t266)
+	t269 := complex(t264+t267, t265-t266)
+	t270 := x26 + x58
+	t271 := x26 - x58
+	t272 := x90 + t270
+	t273 := real(x90) - 0.49999999999999994*real(t270)
+	t274 := imag(x90) - 0.49999999999999994*imag(t270)
+	t275 := 0.8660254037844387 * real(t271)
+	t276 := 0.8660254037844387 * imag(t271)
+	t277 := complex(t273-t276, t274+t275)
+	t278 := complex(t273+t276, t274-t275)
+	t279 := x29 + x61
+	t280 := x29 - x61
+	t281 := x93 + t279
+	t282 := real(x93) - 0.49999999999999994*real(t279)
+	t283 := imag(x93) - 0.49999999999999994*imag(t279)
+	t284 := 0.8660254037844387 * real(t280)
+	t285 := 0.8660254037844387 * imag(t280)
+	t286 := complex(t282-t285, t283+t284)
+	t287 := complex(t282+t285, t283-t284)
+	t288 := t2 + t146
+	t289 := t2 - t146
+	t290 := t38 + t182
+	t291 := t38 - t182
+	t292 := t74 + t218
+	t293 := t74 - t218
+	t294 := t110 + t254
+	t295 := t110 - t254
+	t296 := t288 + t292
+	t297 := t288 - t292
+	t298 := t290 + t294
+	t299 := t290 - t294
+	t300 := complex(imag(t299), -real(t299))
+	t301 := t296 + t298
+	t302 := t297 + t300
+	t303 := t296 - t298
+	t304 := t297 - t300
+	t305 := complex((real(t291)+imag(t291))*0.7071067811865476, (imag(t291)-real(t291))*0.7071067811865476)
+	t306 := complex(imag(t293), -real(t293))
+	t307 := complex((-real(t295)+imag(t295))*0.7071067811865476, (-imag(t295)-real(t295))*0.7071067811865476)
+	t308 := t289 + t306
+	t309 := t289 - t306
+	t310 := t305 + t307
+	t311 := t305 - t307
+	t312 := complex(imag(t311), -real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := t11 + t155
+	t318 := t11 - t155
+	t319 := t47 + t191
+	t320 := t47 - t191
+	t321 := t83 + t227
+	t322 := t83 - t227
+	t323 := t119 + t263
+	t324 := t119 - t263
+	t325 := t317 + t321
+	t326 := t317 - t321
+	t327 := t319 + t323
+	t328 := t319 - t323
+	t329 := complex(imag(t328), -real(t328))
+	t330 := t325 + t327
+	t331 := t326 + t329
+	t332 := t325 - t327
+	t333 := t326 - t329
+	t334 := complex((real(t320)+imag(t320))*0.7071067811865476, (imag(t320)-real(t320))*0.7071067811865476)
+	t335 := complex(imag(t322), -real(t322))
+	t336 := complex((-real(t324)+imag(t324))*0.7071067811865476, (-imag(t324)-real(t324))*0.7071067811865476)
+	t337 := t318 + t335
+	t338 := t318 - t335
+	t339 := t334 + t336
+	t340 := t334 - t336
+	t341 := complex(imag(t340), -real(t340))
+	t342 := t337 + t339
+	t343 := t338 + t341
+	t344 := t337 - t339
+	t345 := t338 - t341
+	t346 := t20 + t164
+	t347 := t20 - t164
+	t348 := t56 + t200
+	t349 := t56 - t200
+	t350 := t92 + t236
+	t351 := t92 - t236
+	t352 := t128 + t272
+	t353 := t128 - t272
+	t354 := t346 + t350
+	t355 := t346 - t350
+	t356 := t348 + t352
+	t357 := t348 - t352
+	t358 := complex(imag(t357), -real(t357))
+	t359 := t354 + t356
+	t360 := t355 + t358
+	t361 := t354 - t356
+	t362 := t355 - t358
+	t363 := complex((real(t349)+imag(t349))*0.7071067811865476, (imag(t349)-real(t349))*0.7071067811865476)
+	t364 := complex(imag(t351), -real(t351))
+	t365 := complex((-real(t353)+imag(t353))*0.7071067811865476, (-imag(t353)-real(t353))*0.7071067811865476)
+	t366 := t347 + t364
+	t367 := t347 - t364
+	t368 := t363 + t365
+	t369 := t363 - t365
+	t370 := complex(imag(t369), -real(t369))
+	t371 := t366 + t368
+	t372 := t367 + t370
+	t373 := t366 - t368
+	t374 := t367 - t370
+	t375 := t29 + t173
+	t376 := t29 - t173
+	t377 := t65 + t209
+	t378 := t65 - t209
+	t379 := t101 + t245
+	t380 := t101 - t245
+	t381 := t137 + t281
+	t382 := t137 - t281
+	t383 := t375 + t379
+	t384 := t375 - t379
+	t385 := t377 + t381
+	t386 := t377 - t381
+	t387 := complex(imag(t386), -real(t386))
+	t388 := t383 + t385
+	t389 := t384 + t387
+	t390 := t383 - t385
+	t391 := t384 - t387
+	t392 := complex((real(t378)+imag(t378))*0.7071067811865476, (imag(t378)-real(t378))*0.7071067811865476)
+	t393 := complex(imag(t380), -real(t380))
+	t394 := complex((-real(t382)+imag(t382))*0.7071067811865476, (-imag(t382)-real(t382))*0.7071067811865476)
+	t395 := t376 + t393
+	t396 := t376 - t393
+	t397 := t392 + t394
+	t398 := t392 - t394
+	t399 := complex(imag(t398), -real(t398))
+	t400 := t395 + t397
+	t401 := t396 + t399
+	t402 := t395 - t397
+	t403 := t396 - t399
+	t404 := t301 + t359
+	t405 := t301 - t359
+	t406 := t330 + t388
+	t407 := t330 - t388
+	t408 := complex(imag(t407), -real(t407))
+	t409 := t404 + t406
+	t410 := t405 + t408
+	t411 := t404 - t406
+	t412 := t405 - t408
+	t413 := t342 * complex(0.9807852804032304, -0.19509032201612825)
+	t414 := t371 * complex(0.9238795325112867, -0.3826834323650898)
+	t415 := t400 * complex(0.8314696123025453, -0.5555702330196022)
+	t416 := t313 + t414
+	t417 := t313 - t414
+	t418 := t413 + t415
+	t419 := t413 - t415
+	t420 := complex(imag(t419), -real(t419))
+	t421 := t416 + t418
+	t422 := t417 + t420
+	t423 := t416 - t418
+	t424 := t417 - t420
+	t425 := t331 * complex(0.9238795325112867, -0.3826834323650898)
+	t426 := complex((real(t360)+imag(t360))*0.7071067811865476, (imag(t360)-real(t360))*0.7071067811865476)
+	t427 := t389 * complex(0.3826834323650898, -0.9238795325112867)
+	t428 := t302 + t426
+	t429 := t302 - t426
+	t430 := t425 + t427
+	t431 := t425 - t427
+	t432 := complex(imag(t431), -real(t431))
+	t433 := t428 + t430
+	t434 := t429 + t432
+	t435 := t428 - t430
+	t436 := t429 - t432
+	t437 := t343 * complex(0.8314696123025453, -0.5555702330196022)
+	t438 := t372 * complex(0.3826834323650898, -0.9238795325112867)
+	t439 := t401 * complex(-0.19509032201612825, -0.9807852804032304)
+	t440 := t314 + t438
+	t441 := t314 - t438
+	t442 := t437 + t439
+	t443 := t437 - t439
+	t444 := complex(imag(t443), -real(t443))
+	t445 := t440 + t442
+	t446 := t441 + t444
+	t447 := t440 - t442
+	t448 := t441 - t444
+	t449 := complex((real(t332)+imag(t332))*0.7071067811865476, (imag(t332)-real(t332))*0.7071067811865476)
+	t450 := complex(imag(t361), -real(t361))
+	t451 := complex((-real(t390)+imag(t390))*0.7071067811865476, (-imag(t390)-real(t390))*0.7071067811865476)
+	t452 := t303 + t450
+	t453 := t303 - t450
+	t454 := t449 + t451
+	t455 := t449 - t451
+	t456 := complex(imag(t455), -real(t455))
+	t457 := t452 + t454
+	t458 := t453 + t456
+	t459 := t452 - t454
+	t460 := t453 - t456
+	t461 := t344 * complex(0.5555702330196022, -0.8314696123025453)
+	t462 := t373 * complex(-0.3826834323650898, -0.9238795325112867)
+	t463 := t402 * complex(-0.9807852804032304, -0.19509032201612825)
+	t464 := t315 + t462
+	t465 := t315 - t462
+	t466 := t461 + t463
+	t467 := t461 - t463
+	t468 := complex(imag(t467), -real(t467))
+	t469 := t464 + t466
+	t470 := t465 + t468
+	t471 := t464 - t466
+	t472 := t465 - t468
+	t473 := t333 * complex(0.3826834323650898, -0.9238795325112867)
+	t474 := complex((-real(t362)+imag(t362))*0.7071067811865476, (-imag(t362)-real(t362))*0.7071067811865476)
+	t475 := t391 * complex(-0.9238795325112867, 0.3826834323650898)
+	t476 := t304 + t474
+	t477 := t304 - t474
+	t478 := t473 + t475
+	t479 := t473 - t475
+	t480 := complex(imag(t479), -real(t479))
+	t481 := t476 + t478
+	t482 := t477 + t480
+	t483 := t476 - t478
+	t484 := t477 - t480
+	t485 := t345 * complex(0.19509032201612825, -0.9807852804032304)
+	t486 := t374 * complex(-0.9238795325112867, -0.3826834323650898)
+	t487 := t403 * complex(-0.5555702330196022, 0.8314696123025453)
+	t488 := t316 + t486
+	t489 := t316 - t486
+	t490 := t485 + t487
+	t491 := t485 - t487
+	t492 := complex(imag(t491), -real(t491))
+	t493 := t488 + t490
+	t494 := t489 + t492
+	t495 := t488 - t490
+	t496 := t489 - t492
+	t497 := t8 + t152
+	t498 := t8 - t152
+	t499 := t44 + t188
+	t500 := t44 - t188
+	t501 := t80 + t224
+	t502 := t80 - t224
+	t503 := t116 + t260
+	t504 := t116 - t260
+	t505 := t497 + t501
+	t506 := t497 - t501
+	t507 := t499 + t503
+	t508 := t499 - t503
+	t509 := complex(imag(t508), -real(t508))
+	t510 := t505 + t507
+	t511 := t506 + t509
+	t512 := t505 - t507
+	t513 := t506 - t509
+	t514 := complex((real(t500)+imag(t500))*0.7071067811865476, (imag(t500)-real(t500))*0.7071067811865476)
+	t515 := complex(imag(t502), -real(t502))
+	t516 := complex((-real(t504)+imag(t504))*0.7071067811865476, (-imag(t504)-real(t504))*0.7071067811865476)
+	t517 := t498 + t515
+	t518 := t498 - t515
+	t519 := t514 + t516
+	t520 := t514 - t516
+	t521 := complex(imag(t520), -real(t520))
+	t522 := t517 + t519
+	t523 := t518 + t521
+	t524 := t517 - t519
+	t525 := t518 - t521
+	t526 := t17 + t161
+	t527 := t17 - t161
+	t528 := t53 + t197
+	t529 := t53 - t197
+	t530 := t89 + t233
+	t531 := t89 - t233
+	t532 := t125 + t269
+	t533 := t125 - t269
+	t534 := t526 + t530
+	t535 := t526 - t530
+	t536 := t528 + t532
+	t537 := t528 - t532
+	t538 := complex(imag(t537), -real(t537))
+	t539 := t534 + t536
+	t540 := t535 + t538
+	t541 := t534 - t536
+	t542 := t535 - t538
+	t543 := complex((real(t529)+imag(t529))*0.7071067811865476, (imag(t529)-real(t529))*0.7071067811865476)
+	t544 := complex(imag(t531), -real(t531))
+	t545 := complex((-real(t533)+imag(t533))*0.7071067811865476, (-imag(t533)-real(t533))*0.7071067811865476)
+	t546 := t527 + t544
+	t547 := t527 - t544
+	t548 := t543 + t545
+	t549 := t543 - t545
+	t550 := complex(imag(t549), -real(t549))
+	t551 := t546 + t548
+	t552 := t547 + t550
+	t553 := t546 - t548
+	t554 := t547 - t550
+	t555 := t26 + t170
+	t556 := t26 - t170
+	t557 := t62 + t206
+	t558 := t62 - t206
+	t559 := t98 + t242
+	t560 := t98 - t242
+	t561 := t134 + t278
+	t562 := t134 - t278
+	t563 := t555 + t559
+	t564 := t555 - t559
+	t565 := t557 + t561
+	t566 := t557 - t561
+	t567 := complex(imag(t566), -real(t566))
+	t568 := t563 + t565
+	t569 := t564 + t567
+	t570 := t563 - t565
+	t571 := t564 - t567
+	t572 := complex((real(t558)+imag(t558))*0.7071067811865476, (imag(t558)-real(t558))*0.7071067811865476)
+	t573 := complex(imag(t560), -real(t560))
+	t574 := complex((-real(t562)+imag(t562))*0.7071067811865476, (-imag(t562)-real(t562))*0.7071067811865476)
+	t575 := t556 + t573
+	t576 := t556 - t573
+	t577 := t572 + t574
+	t578 := t572 - t574
+	t579 := complex(imag(t578), -real(t578))
+	t580 := t575 + t577
+	t581 := t576 + t579
+	t582 := t575 - t577
+	t583 := t576 - t579
+	t584 := t35 + t179
+	t585 := t35 - t179
+	t586 := t71 + t215
+	t587 := t71 - t215
+	t588 := t107 + t251
+	t589 := t107 - t251
+	t590 := t143 + t287
+	t591 := t143 - t287
+	t592 := t584 + t588
+	t593 := t584 - t588
+	t594 := t586 + t590
+	t595 := t586 - t590
+	t596 := complex(imag(t595), -real(t595))
+	t597 := t592 + t594
+	t598 := t593 + t596
+	t599 := t592 - t594
+	t600 := t593 - t596
+	t601 := complex((real(t587)+imag(t587))*0.7071067811865476, (imag(t587)-real(t587))*0.7071067811865476)
+	t602 := complex(imag(t589), -real(t589))
+	t603 := complex((-real(t591)+imag(t591))*0.7071067811865476, (-imag(t591)-real(t591))*0.7071067811865476)
+	t604 := t585 + t602
+	t605 := t585 - t602
+	t606 := t601 + t603
+	t607 := t601 - t603
+	t608 := complex(imag(t607), -real(t607))
+	t609 := t604 + t606
+	t610 := t605 + t608
+	t611 := t604 - t606
+	t612 := t605 - t608
+	t613 := t510 + t568
+	t614 := t510 - t568
+	t615 := t539 + t597
+	t616 := t539 - t597
+	t617 := complex(imag(t616), -real(t616))
+	t618 := t613 + t615
+	t619 := t614 + t617
+	t620 := t613 - t615
+	t621 := t614 - t617
+	t622 := t551 * complex(0.9807852804032304, -0.19509032201612825)
+	t623 := t580 * complex(0.9238795325112867, -0.3826834323650898)
+	t624 := t609 * complex(0.8314696123025453, -0.5555702330196022)
+	t625 := t522 + t623
+	t626 := t522 - t623
+	t627 := t622 + t624
+	t628 := t622 - t624
+	t629 := complex(imag(t628), -real(t628))
+	t630 := t625 + t627
+	t631 := t626 + t629
+	t632 := t625 - t627
+	t633 := t626 - t629
+	t634 := t540 * complex(0.9238795325112867, -0.3826834323650898)
+	t635 := complex((real(t569)+imag(t569))*0.7071067811865476, (imag(t569)-real(t569))*0.7071067811865476)
+	t636 := t598 * complex(0.3826834323650898, -0.9238795325112867)
+	t637 := t511 + t635
+	t638 := t511 - t635
+	t639 := t634 + t636
+	t640 := t634 - t636
+	t641 := complex(imag(t640), -real(t640))
+	t642 := t637 + t639
+	t643 := t638 + t641
+	t644 := t637 - t639
+	t645 := t638 - t641
+	t646 := t552 * complex(0.8314696123025453, -0.5555702330196022)
+	t647 := t581 * complex(0.3826834323650898, -0.9238795325112867)
+	t648 := t610 * complex(-0.19509032201612825, -0.9807852804032304)
+	t649 := t523 + t647
+	t650 := t523 - t647
+	t651 := t646 + t648
+	t652 := t646 - t648
+	t653 := complex(imag(t652), -real(t652))
+	t654 := t649 + t651
+	t655 := t650 + t653
+	t656 := t649 - t651
+	t657 := t650 - t653
+	t658 := complex((real(t541)+imag(t541))*0.7071067811865476, (imag(t541)-real(t541))*0.7071067811865476)
+	t659 := complex(imag(t570), -real(t570))
+	t660 := complex((-real(t599)+imag(t599))*0.7071067811865476, (-imag(t599)-real(t599))*0.7071067811865476)
+	t661 := t512 + t659
+	t662 := t512 - t659
+	t663 := t658 + t660
+	t664 := t658 - t660
+	t665 := complex(imag(t664), -real(t664))
+	t666 := t661 + t663
+	t667 := t662 + t665
+	t668 := t661 - t663
+	t669 := t662 - t665
+	t670 := t553 * complex(0.5555702330196022, -0.8314696123025453)
+	t671 := t582 * complex(-0.3826834323650898, -0.9238795325112867)
+	t672 := t611 * complex(-0.9807852804032304, -0.19509032201612825)
+	t673 := t524 + t671
+	t674 := t524 - t671
+	t675 := t670 + t672
+	t676 := t670 - t672
+	t677 := complex(imag(t676), -real(t676))
+	t678 := t673 + t675
+	t679 := t674 + t677
+	t680 := t673 - t675
+	t681 := t674 - t677
+	t682 := t542 * complex(0.3826834323650898, -0.9238795325112867)
+	t683 := complex((-real(t571)+imag(t571))*0.7071067811865476, (-imag(t571)-real(t571))*0.7071067811865476)
+	t684 := t600 * complex(-0.9238795325112867, 0.3826834323650898)
+	t685 := t513 + t683
+	t686 := t513 - t683
+	t687 := t682 + t684
+	t688 := t682 - t684
+	t689 := complex(imag(t688), -real(t688))
+	t690 := t685 + t687
+	t691 := t686 + t689
+	t692 := t685 - t687
+	t693 := t686 - t689
+	t694 := t554 * complex(0.19509032201612825, -0.9807852804032304)
+	t695 := t583 * complex(-0.9238795325112867, -0.3826834323650898)
+	t696 := t612 * complex(-0.5555702330196022, 0.8314696123025453)
+	t697 := t525 + t695
+	t698 := t525 - t695
+	t699 := t694 + t696
+	t700 := t694 - t696
+	t701 := complex(imag(t700), -real(t700))
+	t702 := t697 + t699
+	t703 := t698 + t701
+	t704 := t697 - t699
+	t705 := t698 - t701
+	t706 := t7 + t151
+	t707 := t7 - t151
+	t708 := t43 + t187
+	t709 := t43 - t187
+	t710 := t79 + t223
+	t711 := t79 - t223
+	t712 := t115 + t259
+	t713 := t115 - t259
+	t714 := t706 + t710
+	t715 := t706 - t710
+	t716 := t708 + t712
+	t717 := t708 - t712
+	t718 := complex(imag(t717), -real(t717))
+	t719 := t714 + t716
+	t720 := t715 + t718
+	t721 := t714 - t716
+	t722 := t715 - t718
+	t723 := complex((real(t709)+imag(t709))*0.7071067811865476, (imag(t709)-real(t709))*0.7071067811865476)
+	t724 := complex(imag(t711), -real(t711))
+	t725 := complex((-real(t713)+imag(t713))*0.7071067811865476, (-imag(t713)-real(t713))*0.7071067811865476)
+	t726 := t707 + t724
+	t727 := t707 - t724
+	t728 := t723 + t725
+	t729 := t723 - t725
+	t730 := complex(imag(t729), -real(t729))
+	t731 := t726 + t728
+	t732 := t727 + t730
+	t733 := t726 - t728
+	t734 := t727 - t730
+	t735 := t16 + t160
+	t736 := t16 - t160
+	t737 := t52 + t196
+	t738 := t52 - t196
+	t739 := t88 + t232
+	t740 := t88 - t232
+	t741 := t124 + t268
+	t742 := t124 - t268
+	t743 := t735 + t739
+	t744 := t735 - t739
+	t745 := t737 + t741
+	t746 := t737 - t741
+	t747 := complex(imag(t746), -real(t746))
+	t748 := t743 + t745
+	t749 := t744 + t747
+	t750 := t743 - t745
+	t751 := t744 - t747
+	t752 := complex((real(t738)+imag(t738))*0.7071067811865476, (imag(t738)-real(t738))*0.7071067811865476)
+	t753 := complex(imag(t740), -real(t740))
+	t754 := complex((-real(t742)+imag(t742))*0.7071067811865476, (-imag(t742)-real(t742))*0.7071067811865476)
+	t755 := t736 + t753
+	t756 := t736 - t753
+	t757 := t752 + t754
+	t758 := t752 - t754
+	t759 := complex(imag(t758), -real(t758))
+	t760 := t755 + t757
+	t761 := t756 + t759
+	t762 := t755 - t757
+	t763 := t756 - t759
+	t764 := t25 + t169
+	t765 := t25 - t169
+	t766 := t61 + t205
+	t767 := t61 - t205
+	t768 := t97 + t241
+	t769 := t97 - t241
+	t770 := t133 + t277
+	t771 := t133 - t277
+	t772 := t764 + t768
+	t773 := t764 - t768
+	t774 := t766 + t770
+	t775 := t766 - t770
+	t776 := complex(imag(t775), -real(t775))
+	t777 := t772 + t774
+	t778 := t773 + t776
+	t779 := t772 - t774
+	t780 := t773 - t776
+	t781 := complex((real(t767)+imag(t767))*0.7071067811865476, (imag(t767)-real(t767))*0.7071067811865476)
+	t782 := complex(imag(t769), -real(t769))
+	t783 := complex((-real(t771)+imag(t771))*0.7071067811865476, (-imag(t771)-real(t771))*0.7071067811865476)
+	t784 := t765 + t782
+	t785 := t765 - t782
+	t786 := t781 + t783
+	t787 := t781 - t783
+	t788 := complex(imag(t787), -real(t787))
+	t789 := t784 + t786
+	t790 := t785 + t788
+	t791 := t784 - t786
+	t792 := t785 - t788
+	t793 := t34 + t178
+	t794 := t34 - t178
+	t795 := t70 + t214
+	t796 := t70 - t214
+	t797 := t106 + t250
+	t798 := t106 - t250
+	t799 := t142 + t286
+	t800 := t142 - t286
+	t801 := t793 + t797
+	t802 := t793 - t797
+	t803 := t795 + t799
+	t804 := t795 - t799
+	t805 := complex(imag(t804), -real(t804))
+	t806 := t801 + t803
+	t807 := t802 + t805
+	t808 := t801 - t803
+	t809 := t802 - t805
+	t810 := complex((real(t796)+imag(t796))*0.7071067811865476, (imag(t796)-real(t796))*0.7071067811865476)
+	t811 := complex(imag(t798), -real(t798))
+	t812 := complex((-real(t800)+imag(t800))*0.7071067811865476, (-imag(t800)-real(t800))*0.7071067811865476)
+	t813 := t794 + t811
+	t814 := t794 - t811
+	t815 := t810 + t812
+	t816 := t810 - t812
+	t817 := complex(imag(t816), -real(t816))
+	t818 := t813 + t815
+	t819 := t814 + t817
+	t820 := t813 - t815
+	t821 := t814 - t817
+	t822 := t719 + t777
+	t823 := t719 - t777
+	t824 := t748 + t806
+	t825 := t748 - t806
+	t826 := complex(imag(t825), -real(t825))
+	t827 := t822 + t824
+	t828 := t823 + t826
+	t829 := t822 - t824
+	t830 := t823 - t826
+	t831 := t760 * complex(0.9807852804032304, -0.19509032201612825)
+	t832 := t789 * complex(0.9238795325112867, -0.3826834323650898)
+	t833 := t818 * complex(0.8314696123025453, -0.5555702330196022)
+	t834 := t731 + t832
+	t835 := t731 - t832
+	t836 := t831 + t833
+	t837 := t831 - t833
+	t838 := complex(imag(t837), -real(t837))
+	t839 := t834 + t836
+	t840 := t835 + t838
+	t841 := t834 - t836
+	t842 := t835 - t838
+	t843 := t749 * complex(0.9238795325112867, -0.3826834323650898)
+	t844 := complex((real(t778)+imag(t778))*0.7071067811865476, (imag(t778)-real(t778))*0.7071067811865476)
+	t845 := t807 * complex(0.3826834323650898, -0.9238795325112867)
+	t846 := t720 + t844
+	t847 := t720 - t844
+	t848 := t843 + t845
+	t849 := t843 - t845
+	t850 := complex(imag(t849), -real(t849))
+	t851 := t846 + t848
+	t852 := t847 + t850
+	t853 := t846 - t848
+	t854 := t847 - t850
+	t855 := t761 * complex(0.8314696123025453, -0.5555702330196022)
+	t856 := t790 * complex(0.3826834323650898, -0.9238795325112867)
+	t857 := t819 * complex(-0.19509032201612825, -0.9807852804032304)
+	t858 := t732 + t856
+	t859 := t732 - t856
+	t860 := t855 + t857
+	t861 := t855 - t857
+	t862 := complex(imag(t861), -real(t861))
+	t863 := t858 + t860
+	t864 := t859 + t862
+	t865 := t858 - t860
+	t866 := t859 - t862
+	t867 := complex((real(t750)+imag(t750))*0.7071067811865476, (imag(t750)-real(t750))*0.7071067811865476)
+	t868 := complex(imag(t779), -real(t779))
+	t869 := complex((-real(t808)+imag(t808))*0.7071067811865476, (-imag(t808)-real(t808))*0.7071067811865476)
+	t870 := t721 + t868
+	t871 := t721 - t868
+	t872 := t867 + t869
+	t873 := t867 - t869
+	t874 := complex(imag(t873), -real(t873))
+	t875 := t870 + t872
+	t876 := t871 + t874
+	t877 := t870 - t872
+	t878 := t871 - t874
+	t879 := t762 * complex(0.5555702330196022, -0.8314696123025453)
+	t880 := t791 * complex(-0.3826834323650898, -0.9238795325112867)
+	t881 := t820 * complex(-0.9807852804032304, -0.19509032201612825)
+	t882 := t733 + t880
+	t883 := t733 - t880
+	t884 := t879 + t881
+	t885 := t879 - t881
+	t886 := complex(imag(t885), -real(t885))
+	t887 := t882 + t884
+	t888 := t883 + t886
+	t889 := t882 - t884
+	t890 := t883 - t886
+	t891 := t751 * complex(0.3826834323650898, -0.9238795325112867)
+	t892 := complex((-real(t780)+imag(t780))*0.7071067811865476, (-imag(t780)-real(t780))*0.7071067811865476)
+	t893 := t809 * complex(-0.9238795325112867, 0.3826834323650898)
+	t894 := t722 + t892
+	t895 := t722 - t892
+	t896 := t891 + t893
+	t897 := t891 - t893
+	t898 := complex(imag(t897), -real(t897))
+	t899 := t894 + t896
+	t900 := t895 + t898
+	t901 := t894 - t896
+	t902 := t895 - t898
+	t903 := t763 * complex(0.19509032201612825, -0.9807852804032304)
+	t904 := t792 * complex(-0.9238795325112867, -0.3826834323650898)
+	t905 := t821 * complex(-0.5555702330196022, 0.8314696123025453)
+	t906 := t734 + t904
+	t907 := t734 - t904
+	t908 := t903 + t905
+	t909 := t903 - t905
+	t910 := complex(imag(t909), -real(t909))
+	t911 := t906 + t908
+	t912 := t907 + t910
+	t913 := t906 - t908
+	t914 := t907 - t910
+
+	d[0] = t409
+	d[1] = t630
+	d[2] = t851
+	d[3] = t445
+	d[4] = t666
+	d[5] = t887
+	d[6] = t481
+	d[7] = t702
+	d[8] = t828
+	d[9] = t422
+	d[10] = t643
+	d[11] = t864
+	d[12] = t458
+	d[13] = t679
+	d[14] = t900
+	d[15] = t494
+	d[16] = t620
+	d[17] = t841
+	d[18] = t435
+	d[19] = t656
+	d[20] = t877
+	d[21] = t471
+	d[22] = t692
+	d[23] = t913
+	d[24] = t412
+	d[25] = t633
+	d[26] = t854
+	d[27] = t448
+	d[28] = t669
+	d[29] = t890
+	d[30] = t484
+	d[31] = t705
+	d[32] = t827
+	d[33] = t421
+	d[34] = t642
+	d[35] = t863
+	d[36] = t457
+	d[37] = t678
+	d[38] = t899
+	d[39] = t493
+	d[40] = t619
+	d[41] = t840
+	d[42] = t434
+	d[43] = t655
+	d[44] = t876
+	d[45] = t470
+	d[46] = t691
+	d[47] = t912
+	d[48] = t411
+	d[49] = t632
+	d[50] = t853
+	d[51] = t447
+	d[52] = t668
+	d[53] = t889
+	d[54] = t483
+	d[55] = t704
+	d[56] = t830
+	d[57] = t424
+	d[58] = t645
+	d[59] = t866
+	d[60] = t460
+	d[61] = t681
+	d[62] = t902
+	d[63] = t496
+	d[64] = t618
+	d[65] = t839
+	d[66] = t433
+	d[67] = t654
+	d[68] = t875
+	d[69] = t469
+	d[70] = t690
+	d[71] = t911
+	d[72] = t410
+	d[73] = t631
+	d[74] = t852
+	d[75] = t446
+	d[76] = t667
+	d[77] = t888
+	d[78] = t482
+	d[79] = t703
+	d[80] = t829
+	d[81] = t423
+	d[82] = t644
+	d[83] = t865
+	d[84] = t459
+	d[85] = t680
+	d[86] = t901
+	d[87] = t495
+	d[88] = t621
+	d[89] = t842
+	d[90] = t436
+	d[91] = t657
+	d[92] = t878
+	d[93] = t472
+	d[94] = t693
+	d[95] = t914
+
+	return true
+}
+
+// inverseDFT96Complex128 computes a 96-point inverse DFT of complex128 data,
+// including the 1/96 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT96Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 96
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+	x60 := s[60]
+	x61 := s[61]
+	x62 := s[62]
+	x63 := s[63]
+	x64 := s[64]
+	x65 := s[65]
+	x66 := s[66]
+	x67 := s[67]
+	x68 := s[68]
+	x69 := s[69]
+	x70 := s[70]
+	x71 := s[71]
+	x72 := s[72]
+	x73 := s[73]
+	x74 := s[74]
+	x75 := s[75]
+	x76 := s[76]
+	x77 := s[77]
+	x78 := s[78]
+	x79 := s[79]
+	x80 := s[80]
+	x81 := s[81]
+	x82 := s[82]
+	x83 := s[83]
+	x84 := s[84]
+	x85 := s[85]
+	x86 := s[86]
+	x87 := s[87]
+	x88 := s[88]
+	x89 := s[89]
+	x90 := s[90]
+	x91 := s[91]
+	x92 := s[92]
+	x93 := s[93]
+	x94 := s[94]
+	x95 := s[95]
+
+	t0 := x32 + x64
+	t1 := x32 - x64
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x35 + x67
+	t10 := x35 - x67
+	t11 := x3 + t9
+	t12 := real(x3) - 0.49999999999999994*real(t9)
+	t13 := imag(x3) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x38 + x70
+	t19 := x38 - x70
+	t20 := x6 + t18
+	t21 := real(x6) - 0.49999999999999994*real(t18)
+	t22 := imag(x6) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := x41 + x73
+	t28 := x41 - x73
+	t29 := x9 + t27
+	t30 := real(x9) - 0.49999999999999994*real(t27)
+	t31 := imag(x9) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := x44 + x76
+	t37 := x44 - x76
+	t38 := x12 + t36
+	t39 := real(x12) - 0.49999999999999994*real(t36)
+	t40 := imag(x12) - 0.49999999999999994*imag(t36)
+	t41 := 0.8660254037844387 * real(t37)
+	t42 := 0.8660254037844387 * imag(t37)
+	t43 := complex(t39-t42, t40+t41)
+	t44 := complex(t39+t42, t40-t41)
+	t45 := x47 + x79
+	t46 := x47 - x79
+	t47 := x15 + t45
+	t48 := real(x15) - 0.49999999999999994*real(t45)
+	t49 := imag(x15) - 0.49999999999999994*imag(t45)
+	t50 := 0.8660254037844387 * real(t46)
+	t51 := 0.8660254037844387 * imag(t46)
+	t52 := complex(t48-t51, t49+t50)
+	t53 := complex(t48+t51, t49-t50)
+	t54 := x50 + x82
+	t55 := x50 - x82
+	t56 := x18 + t54
+	t57 := real(x18) - 0.49999999999999994*real(t54)
+	t58 := imag(x18) - 0.49999999999999994*imag(t54)
+	t59 := 0.8660254037844387 * real(t55)
+	t60 := 0.8660254037844387 * imag(t55)
+	t61 := complex(t57-t60, t58+t59)
+	t62 := complex(t57+t60, t58-t59)
+	t63 := x53 + x85
+	t64 := x53 - x85
+	t65 := x21 + t63
+	t66 := real(x21) - 0.49999999999999994*real(t63)
+	t67 := imag(x21) - 0.49999999999999994*imag(t63)
+	t68 := 0.8660254037844387 * real(t64)
+	t69 := 0.8660254037844387 * imag(t64)
+	t70 := complex(t66-t69, t67+t68)
+	t71 := complex(t66+t69, t67-t68)
+	t72 := x56 + x88
+	t73 := x56 - x88
+	t74 := x24 + t72
+	t75 := real(x24) - 0.49999999999999994*real(t72)
+	t76 := imag(x24) - 0.49999999999999994*imag(t72)
+	t77 := 0.8660254037844387 * real(t73)
+	t78 := 0.8660254037844387 * imag(t73)
+	t79 := complex(t75-t78, t76+t77)
+	t80 := complex(t75+t78, t76-t77)
+	t81 := x59 + x91
+	t82 := x59 - x91
+	t83 := x27 + t81
+	t84 := real(x27) - 0.49999999999999994*real(t81)
+	t85 := imag(x27) - 0.49999999999999994*imag(t81)
+	t86 := 0.8660254037844387 * real(t82)
+	t87 := 0.8660254037844387 * imag(t82)
+	t88 := complex(t84-t87, t85+t86)
+	t89 := complex(t84+t87, t85-t86)
+	t90 := x62 + x94
+	t91 := x62 - x94
+	t92 := x30 + t90
+	t93 := real(x30) - 0.49999999999999994*real(t90)
+	t94 := imag(x30) - 0.49999999999999994*imag(t90)
+	t95 := 0.8660254037844387 * real(t91)
+	t96 := 0.8660254037844387 * imag(t91)
+	t97 := complex(t93-t96, t94+t95)
+	t98 := complex(t93+t96, t94-t95)
+	t99 := x65 + x1
+	t100 := x65 - x1
+	t101 := x33 + t99
+	t102 := real(x33) - 0.49999999999999994*real(t99)
+	t103 := imag(x33) - 0.49999999999999994*imag(t99)
+	t104 := 0.8660254037844387 * real(t100)
+	t105 := 0.8660254037844387 * imag(t100)
+	t106 := complex(t102-t105, t103+t104)
+	t107 := complex(t102+t105, t103-t104)
+	t108 := x68 + x4
+	t109 := x68 - x4
+	t110 := x36 + t108
+	t111 := real(x36) - 0.49999999999999994*real(t108)
+	t112 := imag(x36) - 0.49999999999999994*imag(t108)
+	t113 := 0.8660254037844387 * real(t109)
+	t114 := 0.8660254037844387 * imag(t109)
+	t115 := complex(t111-t114, t112+t113)
+	t116 := complex(t111+t114, t112-t113)
+	t117 := x71 + x7
+	t118 := x71 - x7
+	t119 := x39 + t117
+	t120 := real(x39) - 0.49999999999999994*real(t117)
+	t121 := imag(x39) - 0.49999999999999994*imag(t117)
+	t122 := 0.8660254037844387 * real(t118)
+	t123 := 0.8660254037844387 * imag(t118)
+	t124 := complex(t120-t123, t121+t122)
+	t125 := complex(t120+t123, t121-t122)
+	t126 := x74 + x10
+	t127 := x74 - x10
+	t128 := x42 + t126
+	t129 := real(x42) - 0.49999999999999994*real(t126)
+	t130 := imag(x42) - 0.49999999999999994*imag(t126)
+	t131 := 0.8660254037844387 * real(t127)
+	t132 := 0.8660254037844387 * imag(t127)
+	t133 := complex(t129-t132, t130+t131)
+	t134 := complex(t129+t132, t130-t131)
+	t135 := x77 + x13
+	t136 := x77 - x13
+	t137 := x45 + t135
+	t138 := real(x45) - 0.49999999999999994*real(t135)
+	t139 := imag(x45) - 0.49999999999999994*imag(t135)
+	t140 := 0.8660254037844387 * real(t136)
+	t141 := 0.8660254037844387 * imag(t136)
+	t142 := complex(t138-t141, t139+t140)
+	t143 := complex(t138+t141, t139-t140)
+	t144 := x80 + x16
+	t145 := x80 - x16
+	t146 := x48 + t144
+	t147 := real(x48) - 0.49999999999999994*real(t144)
+	t148 := imag(x48) - 0.49999999999999994*imag(t144)
+	t149 := 0.8660254037844387 * real(t145)
+	t150 := 0.8660254037844387 * imag(t145)
+	t151 := complex(t147-t150, t148+t149)
+	t152 := complex(t147+t150, t148-t149)
+	t153 := x83 + x19
+	t154 := x83 - x19
+	t155 := x51 + t153
+	t156 := real(x51) - 0.49999999999999994*real(t153)
+	t157 := imag(x51) - 0.49999999999999994*imag(t153)
+	t158 := 0.8660254037844387 * real(t154)
+	t159 := 0.8660254037844387 * imag(t154)
+	t160 := complex(t156-t159, t157+t158)
+	t161 := complex(t156+t159, t157-t158)
+	t162 := x86 + x22
+	t163 := x86 - x22
+	t164 := x54 + t162
+	t165 := real(x54) - 0.49999999999999994*real(t162)
+	t166 := imag(x54) - 0.49999999999999994*imag(t162)
+	t167 := 0.8660254037844387 * real(t163)
+	t168 := 0.8660254037844387 * imag(t163)
+	t169 := complex(t165-t168, t166+t167)
+	t170 := complex(t165+t168, t166-t167)
+	t171 := x89 + x25
+	t172 := x89 - x25
+	t173 := x57 + t171
+	t174 := real(x57) - 0.49999999999999994*real(t171)
+	t175 := imag(x57) - 0.49999999999999994*imag(t171)
+	t176 := 0.8660254037844387 * real(t172)
+	t177 := 0.8660254037844387 * imag(t172)
+	t178 := complex(t174-t177, t175+t176)
+	t179 := complex(t174+t177, t175-t176)
+	t180 := x92 + x28
+	t181 := x92 - x28
+	t182 := x60 + t180
+	t183 := real(x60) - 0.49999999999999994*real(t180)
+	t184 := imag(x60) - 0.49999999999999994*imag(t180)
+	t185 := 0.8660254037844387 * real(t181)
+	t186 := 0.8660254037844387 * imag(t181)
+	t187 := complex(t183-t186, t184+t185)
+	t188 := complex(t183+t186, t184-t185)
+	t189 := x95 + x31
+	t190 := x95 - x31
+	t191 := x63 + t189
+	t192 := real(x63) - 0.49999999999999994*real(t189)
+	t193 := imag(x63) - 0.49999999999999994*imag(t189)
+	t194 := 0.8660254037844387 * real(t190)
+	t195 := 0.8660254037844387 * imag(t190)
+	t196 := complex(t192-t195, t193+t194)
+	t197 := complex(t192+t195, t193-t194)
+	t198 := x2 + x34
+	t199 := x2 - x34
+	t200 := x66 + t198
+	t201 := real(x66) - 0.49999999999999994*real(t198)
+	t202 := imag(x66) - 0.49999999999999994*imag(t198)
+	t203 := 0.8660254037844387 * real(t199)
+	t204 := 0.8660254037844387 * imag(t199)
+	t205 := complex(t201-t204, t202+t203)
+	t206 := complex(t201+t204, t202-t203)
+	t207 := x5 + x37
+	t208 := x5 - x37
+	t209 := x69 + t207
+	t210 := real(x69) - 0.49999999999999994*real(t207)
+	t211 := imag(x69) - 0.49999999999999994*imag(t207)
+	t212 := 0.8660254037844387 * real(t208)
+	t213 := 0.8660254037844387 * imag(t208)
+	t214 := complex(t210-t213, t211+t212)
+	t215 := complex(t210+t213, t211-t212)
+	t216 := x8 + x40
+	t217 := x8 - x40
+	t218 := x72 + t216
+	t219 := real(x72) - 0.49999999999999994*real(t216)
+	t220 := imag(x72) - 0.49999999999999994*imag(t216)
+	t221 := 0.8660254037844387 * real(t217)
+	t222 := 0.8660254037844387 * imag(t217)
+	t223 := complex(t219-t222, t220+t221)
+	t224 := complex(t219+t222, t220-t221)
+	t225 := x11 + x43
+	t226 := x11 - x43
+	t227 := x75 + t225
+	t228 := real(x75) - 0.49999999999999994*real(t225)
+	t229 := imag(x75) - 0.49999999999999994*imag(t225)
+	t230 := 0.8660254037844387 * real(t226)
+	t231 := 0.8660254037844387 * imag(t226)
+	t232 := complex(t228-t231, t229+t230)
+	t233 := complex(t228+t231, t229-t230)
+	t234 := x14 + x46
+	t235 := x14 - x46
+	t236 := x78 + t234
+	t237 := real(x78) - 0.49999999999999994*real(t234)
+	t238 := imag(x78) - 0.49999999999999994*imag(t234)
+	t239 := 0.8660254037844387 * real(t235)
+	t240 := 0.8660254037844387 * imag(t235)
+	t241 := complex(t237-t240, t238+t239)
+	t242 := complex(t237+t240, t238-t239)
+	t243 := x17 + x49
+	t244 := x17 - x49
+	t245 := x81 + t243
+	t246 := real(x81) - 0.49999999999999994*real(t243)
+	t247 := imag(x81) - 0.49999999999999994*imag(t243)
+	t248 := 0.8660254037844387 * real(t244)
+	t249 := 0.8660254037844387 * imag(t244)
+	t250 := complex(t246-t249, t247+t248)
+	t251 := complex(t246+t249, t247-t248)
+	t252 := x20 + x52
+	t253 := x20 - x52
+	t254 := x84 + t252
+	t255 := real(x84) - 0.49999999999999994*real(t252)
+	t256 := imag(x84) - 0.49999999999999994*imag(t252)
+	t257 := 0.8660254037844387 * real(t253)
+	t258 := 0.8660254037844387 * imag(t253)
+	t259 := complex(t255-t258, t256+t257)
+	t260 := complex(t255+t258, t256-t257)
+	t261 := x23 + x55
+	t262 := x23 - x55
+	t263 := x87 + t261
+	t264 := real(x87) - 0.49999999999999994*real(t261)
+	t265 := imag(x87) - 0.49999999999999994*imag(t261)
+	t266 := 0.8660254037844387 * real(t262)
+	t267 := 0.8660254037844387 * imag(t262)
+	t268 := complex(t264-t267, t265+t266)
+	t269 := complex(t264+t267, t265-t266)
+	t270 := x26 + x58
+	t271 := x26 - x58
+	t272 := x90 + t270
+	t273 := real(x90) - 0.49999999999999994*real(t270)
+	t274 := imag(x90) - 0.49999999999999994*imag(t270)
+	t275 := 0.8660254037844387 * real(t271)
+	t276 := 0.8660254037844387 * imag(t271)
+	t277 := complex(t273-t276, t274+t275)
+	t278 := complex(t273+t276, t274-t275)
+	t279 := x29 + x61
+	t280 := x29 - x61
+	t281 := x93 + t279
+	t282 := real(x93) - 0.49999999999999994*real(t279)
+	t283 := imag(x93) - 0.49999999999999994*imag(t279)
+	t284 := 0.8660254037844387 * real(t280)
+	t285 := 0.8660254037844387 * imag(t280)
+	t286 := complex(t282-t285, t283+t284)
+	t287 := complex(t282+t285, t283-t284)
+	t288 := t2 + t146
+	t289 := t2 - t146
+	t290 := t38 + t182
+	t291 := t38 - t182
+	t292 := t74 + t218
+	t293 := t74 - t218
+	t294 := t110 + t254
+	t295 := t110 - t254
+	t296 := t288 + t292
+	t297 := t288 - t292
+	t298 := t290 + t294
+	t299 := t290 - t294
+	t300 := complex(-imag(t299), real(t299))
+	t301 := t296 + t298
+	t302 := t297 + t300
+	t303 := t296 - t298
+	t304 := t297 - t300
+	t305 := complex((real(t291)-imag(t291))*0.7071067811865476, (imag(t291)+real(t291))*0.7071067811865476)
+	t306 := complex(-imag(t293), real(t293))
+	t307 := complex((-real(t295)-imag(t295))*0.7071067811865476, (-imag(t295)+real(t295))*0.7071067811865476)
+	t308 := t289 + t306
+	t309 := t289 - t306
+	t310 := t305 + t307
+	t311 := t305 - t307
+	t312 := complex(-imag(t311), real(t311))
+	t313 := t308 + t310
+	t314 := t309 + t312
+	t315 := t308 - t310
+	t316 := t309 - t312
+	t317 := t11 + t155
+	t318 := t11 - t155
+	t319 := t47 + t191
+	t320 := t47 - t191
+	t321 := t83 + t227
+	t322 := t83 - t227
+	t323 := t119 + t263
+	t324 := t119 - t263
+	t325 := t317 + t321
+	t326 := t317 - t321
+	t327 := t319 + t323
+	t328 := t319 - t323
+	t329 := complex(-imag(t328), real(t328))
+	t330 := t325 + t327
+	t331 := t326 + t329
+	t332 := t325 - t327
+	t333 := t326 - t329
+	t334 := complex((real(t320)-imag(t320))*0.7071067811865476, (imag(t320)+real(t320))*0.7071067811865476)
+	t335 := complex(-imag(t322), real(t322))
+	t336 := complex((-real(t324)-imag(t324))*0.7071067811865476, (-imag(t324)+real(t324))*0.7071067811865476)
+	t337 := t318 + t335
+	t338 := t318 - t335
+	t339 := t334 + t336
+	t340 := t334 - t336
+	t341 := complex(-imag(t340), real(t340))
+	t342 := t337 + t339
+	t343 := t338 + t341
+	t344 := t337 - t339
+	t345 := t338 - t341
+	t346 := t20 + t164
+	t347 := t20 - t164
+	t348 := t56 + t200
+	t349 := t56 - t200
+	t350 := t92 + t236
+	t351 := t92 - t236
+	t352 := t128 + t272
+	t353 := t128 - t272
+	t354 := t346 + t350
+	t355 := t346 - t350
+	t356 := t348 + t352
+	t357 := t348 - t352
+	t358 := complex(-imag(t357), real(t357))
+	t359 := t354 + t356
+	t360 := t355 + t358
+	t361 := t354 - t356
+	t362 := t355 - t358
+	t363 := complex((real(t349)-imag(t349))*0.7071067811865476, (imag(t349)+real(t349))*0.7071067811865476)
+	t364 := complex(-imag(t351), real(t351))
+	t365 := complex((-real(t353)-imag(t353))*0.7071067811865476, (-imag(t353)+real(t353))*0.7071067811865476)
+	t366 := t347 + t364
+	t367 := t347 - t364
+	t368 := t363 + t365
+	t369 := t363 - t365
+	t370 := complex(-imag(t369), real(t369))
+	t371 := t366 + t368
+	t372 := t367 + t370
+	t373 := t366 - t368
+	t374 := t367 - t370
+	t375 := t29 + t173
+	t376 := t29 - t173
+	t377 := t65 + t209
+	t378 := t65 - t209
+	t379 := t101 + t245
+	t380 := t101 - t245
+	t381 := t137 + t281
+	t382 := t137 - t281
+	t383 := t375 + t379
+	t384 := t375 - t379
+	t385 := t377 + t381
+	t386 := t377 - t381
+	t387 := complex(-imag(t386), real(t386))
+	t388 := t383 + t385
+	t389 := t384 + t387
+	t390 := t383 - t385
+	t391 := t384 - t387
+	t392 := complex((real(t378)-imag(t378))*0.7071067811865476, (imag(t378)+real(t378))*0.7071067811865476)
+	t393 := complex(-imag(t380), real(t380))
+	t394 := complex((-real(t382)-imag(t382))*0.7071067811865476, (-imag(t382)+real(t382))*0.7071067811865476)
+	t395 := t376 + t393
+	t396 := t376 - t393
+	t397 := t392 + t394
+	t398 := t392 - t394
+	t399 := complex(-imag(t398), real(t398))
+	t400 := t395 + t397
+	t401 := t396 + t399
+	t402 := t395 - t397
+	t403 := t396 - t399
+	t404 := t301 + t359
+	t405 := t301 - t359
+	t406 := t330 + t388
+	t407 := t330 - t388
+	t408 := complex(-imag(t407), real(t407))
+	t409 := t404 + t406
+	t410 := t405 + t408
+	t411 := t404 - t406
+	t412 := t405 - t408
+	t413 := t342 * complex(0.9807852804032304, 0.19509032201612825)
+	t414 := t371 * complex(0.9238795325112867, 0.3826834323650898)
+	t415 := t400 * complex(0.8314696123025453, 0.5555702330196022)
+	t416 := t313 + t414
+	t417 := t313 - t414
+	t418 := t413 + t415
+	t419 := t413 - t415
+	t420 := complex(-imag(t419), real(t419))
+	t421 := t416 + t418
+	t422 := t417 + t420
+	t423 := t416 - t418
+	t424 := t417 - t420
+	t425 := t331 * complex(0.9238795325112867, 0.3826834323650898)
+	t426 := complex((real(t360)-imag(t360))*0.7071067811865476, (imag(t360)+real(t360))*0.7071067811865476)
+	t427 := t389 * complex(0.3826834323650898, 0.9238795325112867)
+	t428 := t302 + t426
+	t429 := t302 - t426
+	t430 := t425 + t427
+	t431 := t425 - t427
+	t432 := complex(-imag(t431), real(t431))
+	t433 := t428 + t430
+	t434 := t429 + t432
+	t435 := t428 - t430
+	t436 := t429 - t432
+	t437 := t343 * complex(0.8314696123025453, 0.5555702330196022)
+	t438 := t372 * complex(0.3826834323650898, 0.9238795325112867)
+	t439 := t401 * complex(-0.19509032201612825, 0.9807852804032304)
+	t440 := t314 + t438
+	t441 := t314 - t438
+	t442 := t437 + t439
+	t443 := t437 - t439
+	t444 := complex(-imag(t443), real(t443))
+	t445 := t440 + t442
+	t446 := t441 + t444
+	t447 := t440 - t442
+	t448 := t441 - t444
+	t449 := complex((real(t332)-imag(t332))*0.7071067811865476, (imag(t332)+real(t332))*0.7071067811865476)
+	t450 := complex(-imag(t361), real(t361))
+	t451 := complex((-real(t390)-imag(t390))*0.7071067811865476, (-imag(t390)+real(t390))*0.7071067811865476)
+	t452 := t303 + t450
+	t453 := t303 - t450
+	t454 := t449 + t451
+	t455 := t449 - t451
+	t456 := complex(-imag(t455), real(t455))
+	t457 := t452 + t454
+	t458 := t453 + t456
+	t459 := t452 - t454
+	t460 := t453 - t456
+	t461 := t344 * complex(0.5555702330196022, 0.8314696123025453)
+	t462 := t373 * complex(-0.3826834323650898, 0.9238795325112867)
+	t463 := t402 * complex(-0.9807852804032304, 0.19509032201612825)
+	t464 := t315 + t462
+	t465 := t315 - t462
+	t466 := t461 + t463
+	t467 := t461 - t463
+	t468 := complex(-imag(t467), real(t467))
+	t469 := t464 + t466
+	t470 := t465 + t468
+	t471 := t464 - t466
+	t472 := t465 - t468
+	t473 := t333 * complex(0.3826834323650898, 0.9238795325112867)
+	t474 := complex((-real(t362)-imag(t362))*0.7071067811865476, (-imag(t362)+real(t362))*0.7071067811865476)
+	t475 := t391 * complex(-0.9238795325112867, -0.3826834323650898)
+	t476 := t304 + t474
+	t477 := t304 - t474
+	t478 := t473 + t475
+	t479 := t473 - t475
+	t480 := complex(-imag(t479), real(t479))
+	t481 := t476 + t478
+	t482 := t477 + t480
+	t483 := t476 - t478
+	t484 := t477 - t480
+	t485 := t345 * complex(0.19509032201612825, 0.9807852804032304)
+	t486 := t374 * complex(-0.9238795325112867, 0.3826834323650898)
+	t487 := t403 * complex(-0.5555702330196022, -0.8314696123025453)
+	t488 := t316 + t486
+	t489 := t316 - t486
+	t490 := t485 + t487
+	t491 := t485 - t487
+	t492 := complex(-imag(t491), real(t491))
+	t493 := t488 + t490
+	t494 := t489 + t492
+	t495 := t488 - t490
+	t496 := t489 - t492
+	t497 := t7 + t151
+	t498 := t7 - t151
+	t499 := t43 + t187
+	t500 := t43 - t187
+	t501 := t79 + t223
+	t502 := t79 - t223
+	t503 := t115 + t259
+	t504 := t115 - t259
+	t505 := t497 + t501
+	t506 := t497 - t501
+	t507 := t499 + t503
+	t508 := t499 - t503
+	t509 := complex(-imag(t508), real(t508))
+	t510 := t505 + t507
+	t511 := t506 + t509
+	t512 := t505 - t507
+	t513 := t506 - t509
+	t514 := complex((real(t500)-imag(t500))*0.7071067811865476, (imag(t500)+real(t500))*0.7071067811865476)
+	t515 := complex(-imag(t502), real(t502))
+	t516 := complex((-real(t504)-imag(t504))*0.7071067811865476, (-imag(t504)+real(t504))*0.7071067811865476)
+	t517 := t498 + t515
+	t518 := t498 - t515
+	t519 := t514 + t516
+	t520 := t514 - t516
+	t521 := complex(-imag(t520), real(t520))
+	t522 := t517 + t519
+	t523 := t518 + t521
+	t524 := t517 - t519
+	t525 := t518 - t521
+	t526 := t16 + t160
+	t527 := t16 - t160
+	t528 := t52 + t196
+	t529 := t52 - t196
+	t530 := t88 + t232
+	t531 := t88 - t232
+	t532 := t124 + t268
+	t533 := t124 - t268
+	t534 := t526 + t530
+	t535 := t526 - t530
+	t536 := t528 + t532
+	t537 := t528 - t532
+	t538 := complex(-imag(t537), real(t537))
+	t539 := t534 + t536
+	t540 := t535 + t538
+	t541 := t534 - t536
+	t542 := t535 - t538
+	t543 := complex((real(t529)-imag(t529))*0.7071067811865476, (imag(t529)+real(t529))*0.7071067811865476)
+	t544 := complex(-imag(t531), real(t531))
+	t545 := complex((-real(t533)-imag(t533))*0.7071067811865476, (-imag(t533)+real(t533))*0.7071067811865476)
+	t546 := t527 + t544
+	t547 := t527 - t544
+	t548 := t543 + t545
+	t549 := t543 - t545
+	t550 := complex(-imag(t549), real(t549))
+	t551 := t546 + t548
+	t552 := t547 + t550
+	t553 := t546 - t548
+	t554 := t547 - t550
+	t555 := t25 + t169
+	t556 := t25 - t169
+	t557 := t61 + t205
+	t558 := t61 - t205
+	t559 := t97 + t241
+	t560 := t97 - t241
+	t561 := t133 + t277
+	t562 := t133 - t277
+	t563 := t555 + t559
+	t564 := t555 - t559
+	t565 := t557 + t561
+	t566 := t557 - t561
+	t567 := complex(-imag(t566), real(t566))
+	t568 := t563 + t565
+	t569 := t564 + t567
+	t570 := t563 - t565
+	t571 := t564 - t567
+	t572 := complex((real(t558)-imag(t558))*0.7071067811865476, (imag(t558)+real(t558))*0.7071067811865476)
+	t573 := complex(-imag(t560), real(t560))
+	t574 := complex((-real(t562)-imag(t562))*0.7071067811865476, (-imag(t562)+real(t562))*0.7071067811865476)
+	t575 := t556 + t573
+	t576 := t556 - t573
+	t577 := t572 + t574
+	t578 := t572 - t574
+	t579 := complex(-imag(t578), real(t578))
+	t580 := t575 + t577
+	t581 := t576 + t579
+	t582 := t575 - t577
+	t583 := t576 - t579
+	t584 := t34 + t178
+	t585 := t34 - t178
+	t586 := t70 + t214
+	t587 := t70 - t214
+	t588 := t106 + t250
+	t589 := t106 - t250
+	t590 := t142 + t286
+	t591 := t142 - t286
+	t592 := t584 + t588
+	t593 := t584 - t588
+	t594 := t586 + t590
+	t595 := t586 - t590
+	t596 := complex(-imag(t595), real(t595))
+	t597 := t592 + t594
+	t598 := t593 + t596
+	t599 := t592 - t594
+	t600 := t593 - t596
+	t601 := complex((real(t587)-imag(t587))*0.7071067811865476, (imag(t587)+real(t587))*0.7071067811865476)
+	t602 := complex(-imag(t589), real(t589))
+	t603 := complex((-real(t591)-imag(t591))*0.7071067811865476, (-imag(t591)+real(t591))*0.7071067811865476)
+	t604 := t585 + t602
+	t605 := t585 - t602
+	t606 := t601 + t603
+	t607 := t601 - t603
+	t608 := complex(-imag(t607), real(t607))
+	t609 := t604 + t606
+	t610 := t605 + t608
+	t611 := t604 - t606
+	t612 := t605 - t608
+	t613 := t510 + t568
+	t614 := t510 - t568
+	t615 := t539 + t597
+	t616 := t539 - t597
+	t617 := complex(-imag(t616), real(t616))
+	t618 := t613 + t615
+	t619 := t614 + t617
+	t620 := t613 - t615
+	t621 := t614 - t617
+	t622 := t551 * complex(0.9807852804032304, 0.19509032201612825)
+	t623 := t580 * complex(0.9238795325112867, 0.3826834323650898)
+	t624 := t609 * complex(0.8314696123025453, 0.5555702330196022)
+	t625 := t522 + t623
+	t626 := t522 - t623
+	t627 := t622 + t624
+	t628 := t622 - t624
+	t629 := complex(-imag(t628), real(t628))
+	t630 := t625 + t627
+	t631 := t626 + t629
+	t632 := t625 - t627
+	t633 := t626 - t629
+	t634 := t540 * complex(0.9238795325112867, 0.3826834323650898)
+	t635 := complex((real(t569)-imag(t569))*0.7071067811865476, (imag(t569)+real(t569))*0.7071067811865476)
+	t636 := t598 * complex(0.3826834323650898, 0.9238795325112867)
+	t637 := t511 + t635
+	t638 := t511 - t635
+	t639 := t634 + t636
+	t640 := t634 - t636
+	t641 := complex(-imag(t640), real(t640))
+	t642 := t637 + t639
+	t643 := t638 + t641
+	t644 := t637 - t639
+	t645 := t638 - t641
+	t646 := t552 * complex(0.8314696123025453, 0.5555702330196022)
+	t647 := t581 * complex(0.3826834323650898, 0.9238795325112867)
+	t648 := t610 * complex(-0.19509032201612825, 0.9807852804032304)
+	t649 := t523 + t647
+	t650 := t523 - t647
+	t651 := t646 + t648
+	t652 := t646 - t648
+	t653 := complex(-imag(t652), real(t652))
+	t654 := t649 + t651
+	t655 := t650 + t653
+	t656 := t649 - t651
+	t657 := t650 - t653
+	t658 := complex((real(t541)-imag(t541))*0.7071067811865476, (imag(t541)+real(t541))*0.7071067811865476)
+	t659 := complex(-imag(t570), real(t570))
+	t660 := complex((-real(t599)-imag(t599))*0.7071067811865476, (-imag(t599)+real(t599))*0.7071067811865476)
+	t661 := t512 + t659
+	t662 := t512 - t659
+	t663 := t658 + t660
+	t664 := t658 - t660
+	t665 := complex(-imag(t664), real(t664))
+	t666 := t661 + t663
+	t667 := t662 + t665
+	t668 := t661 - t663
+	t669 := t662 - t665
+	t670 := t553 * complex(0.5555702330196022, 0.8314696123025453)
+	t671 := t582 * complex(-0.3826834323650898, 0.9238795325112867)
+	t672 := t611 * complex(-0.9807852804032304, 0.19509032201612825)
+	t673 := t524 + t671
+	t674 := t524 - t671
+	t675 := t670 + t672
+	t676 := t670 - t672
+	t677 := complex(-imag(t676), real(t676))
+	t678 := t673 + t675
+	t679 := t674 + t677
+	t680 := t673 - t675
+	t681 := t674 - t677
+	t682 := t542 * complex(0.3826834323650898, 0.9238795325112867)
+	t683 := complex((-real(t571)-imag(t571))*0.7071067811865476, (-imag(t571)+real(t571))*0.7071067811865476)
+	t684 := t600 * complex(-0.9238795325112867, -0.3826834323650898)
+	t685 := t513 + t683
+	t686 := t513 - t683
+	t687 := t682 + t684
+	t688 := t682 - t684
+	t689 := complex(-imag(t688), real(t688))
+	t690 := t685 + t687
+	t691 := t686 + t689
+	t692 := t685 - t687
+	t693 := t686 - t689
+	t694 := t554 * complex(0.19509032201612825, 0.9807852804032304)
+	t695 := t583 * complex(-0.9238795325112867, 0.3826834323650898)
+	t696 := t612 * complex(-0.5555702330196022, -0.8314696123025453)
+	t697 := t525 + t695
+	t698 := t525 - t695
+	t699 := t694 + t696
+	t700 := t694 - t696
+	t701 := complex(-imag(t700), real(t700))
+	t702 := t697 + t699
+	t703 := t698 + t701
+	t704 := t697 - t699
+	t705 := t698 - t701
+	t706 := t8 + t152
+	t707 := t8 - t152
+	t708 := t44 + t188
+	t709 := t44 - t188
+	t710 := t80 + t224
+	t711 := t80 - t224
+	t712 := t116 + t260
+	t713 := t116 - t260
+	t714 := t706 + t710
+	t715 := t706 - t710
+	t716 := t708 + t712
+	t717 := t708 - t712
+	t718 := complex(-imag(t717), real(t717))
+	t719 := t714 + t716
+	t720 := t715 + t718
+	t721 := t714 - t716
+	t722 := t715 - t718
+	t723 := complex((real(t709)-imag(t709))*0.7071067811865476, (imag(t709)+real(t709))*0.7071067811865476)
+	t724 := complex(-imag(t711), real(t711))
+	t725 := complex((-real(t713)-imag(t713))*0.7071067811865476, (-imag(t713)+real(t713))*0.7071067811865476)
+	t726 := t707 + t724
+	t727 := t707 - t724
+	t728 := t723 + t725
+	t729 := t723 - t725
+	t730 := complex(-imag(t729), real(t729))
+	t731 := t726 + t728
+	t732 := t727 + t730
+	t733 := t726 - t728
+	t734 := t727 - t730
+	t735 := t17 + t161
+	t736 := t17 - t161
+	t737 := t53 + t197
+	t738 := t53 - t197
+	t739 := t89 + t233
+	t740 := t89 - t233
+	t741 := t125 + t269
+	t742 := t125 - t269
+	t743 := t735 + t739
+	t744 := t735 - t739
+	t745 := t737 + t741
+	t746 := t737 - t741
+	t747 := complex(-imag(t746), real(t746))
+	t748 := t743 + t745
+	t749 := t744 + t747
+	t750 := t743 - t745
+	t751 := t744 - t747
+	t752 := complex((real(t738)-imag(t738))*0.7071067811865476, (imag(t738)+real(t738))*0.7071067811865476)
+	t753 := complex(-imag(t740), real(t740))
+	t754 := complex((-real(t742)-imag(t742))*0.7071067811865476, (-imag(t742)+real(t742))*0.7071067811865476)
+	t755 := t736 + t753
+	t756 := t736 - t753
+	t757 := t752 + t754
+	t758 := t752 - t754
+	t759 := complex(-imag(t758), real(t758))
+	t760 := t755 + t757
+	t761 := t756 + t759
+	t762 := t755 - t757
+	t763 := t756 - t759
+	t764 := t26 + t170
+	t765 := t26 - t170
+	t766 := t62 + t206
+	t767 := t62 - t206
+	t768 := t98 + t242
+	t769 := t98 - t242
+	t770 := t134 + t278
+	t771 := t134 - t278
+	t772 := t764 + t768
+	t773 := t764 - t768
+	t774 := t766 + t770
+	t775 := t766 - t770
+	t776 := complex(-imag(t775), real(t775))
+	t777 := t772 + t774
+	t778 := t773 + t776
+	t779 := t772 - t774
+	t780 := t773 - t776
+	t781 := complex((real(t767)-imag(t767))*0.7071067811865476, (imag(t767)+real(t767))*0.7071067811865476)
+	t782 := complex(-imag(t769), real(t769))
+	t783 := complex((-real(t771)-imag(t771))*0.7071067811865476, (-imag(t771)+real(t771))*0.7071067811865476)
+	t784 := t765 + t782
+	t785 := t765 - t782
+	t786 := t781 + t783
+	t787 := t781 - t783
+	t788 := complex(-imag(t787), real(t787))
+	t789 := t784 + t786
+	t790 := t785 + t788
+	t791 := t784 - t786
+	t792 := t785 - t788
+	t793 := t35 + t179
+	t794 := t35 - t179
+	t795 := t71 + t215
+	t796 := t71 - t215
+	t797 := t107 + t251
+	t798 := t107 - t251
+	t799 := t143 + t287
+	t800 := t143 - t287
+	t801 := t793 + t797
+	t802 := t793 - t797
+	t803 := t795 + t799
+	t804 := t795 - t799
+	t805 := complex(-imag(t804), real(t804))
+	t806 := t801 + t803
+	t807 := t802 + t805
+	t808 := t801 - t803
+	t809 := t802 - t805
+	t810 := complex((real(t796)-imag(t796))*0.7071067811865476, (imag(t796)+real(t796))*0.7071067811865476)
+	t811 := complex(-imag(t798), real(t798))
+	t812 := complex((-real(t800)-imag(t800))*0.7071067811865476, (-imag(t800)+real(t800))*0.7071067811865476)
+	t813 := t794 + t811
+	t814 := t794 - t811
+	t815 := t810 + t812
+	t816 := t810 - t812
+	t817 := complex(-imag(t816), real(t816))
+	t818 := t813 + t815
+	t819 := t814 + t817
+	t820 := t813 - t815
+	t821 := t814 - t817
+	t822 := t719 + t777
+	t823 := t719 - t777
+	t824 := t748 + t806
+	t825 := t748 - t806
+	t826 := complex(-imag(t825), real(t825))
+	t827 := t822 + t824
+	t828 := t823 + t826
+	t829 := t822 - t824
+	t830 := t823 - t826
+	t831 := t760 * complex(0.9807852804032304, 0.19509032201612825)
+	t832 := t789 * complex(0.9238795325112867, 0.3826834323650898)
+	t833 := t818 * complex(0.8314696123025453, 0.5555702330196022)
+	t834 := t731 + t832
+	t835 := t731 - t832
+	t836 := t831 + t833
+	t837 := t831 - t833
+	t838 := complex(-imag(t837), real(t837))
+	t839 := t834 + t836
+	t840 := t835 + t838
+	t841 := t834 - t836
+	t842 := t835 - t838
+	t843 := t749 * complex(0.9238795325112867, 0.3826834323650898)
+	t844 := complex((real(t778)-imag(t778))*0.7071067811865476, (imag(t778)+real(t778))*0.7071067811865476)
+	t845 := t807 * complex(0.3826834323650898, 0.9238795325112867)
+	t846 := t720 + t844
+	t847 := t720 - t844
+	t848 := t843 + t845
+	t849 := t843 - t845
+	t850 := complex(-imag(t849), real(t849))
+	t851 := t846 + t848
+	t852 := t847 + t850
+	t853 := t846 - t848
+	t854 := t847 - t850
+	t855 := t761 * complex(0.8314696123025453, 0.5555702330196022)
+	t856 := t790 * complex(0.3826834323650898, 0.9238795325112867)
+	t857 := t819 * complex(-0.19509032201612825, 0.9807852804032304)
+	t858 := t732 + t856
+	t859 := t732 - t856
+	t860 := t855 + t857
+	t861 := t855 - t857
+	t862 := complex(-imag(t861), real(t861))
+	t863 := t858 + t860
+	t864 := t859 + t862
+	t865 := t858 - t860
+	t866 := t859 - t862
+	t867 := complex((real(t750)-imag(t750))*0.7071067811865476, (imag(t750)+real(t750))*0.7071067811865476)
+	t868 := complex(-imag(t779), real(t779))
+	t869 := complex((-real(t808)-imag(t808))*0.7071067811865476, (-imag(t808)+real(t808))*0.7071067811865476)
+	t870 := t721 + t868
+	t871 := t721 - t868
+	t872 := t867 + t869
+	t873 := t867 - t869
+	t874 := complex(-imag(t873), real(t873))
+	t875 := t870 + t872
+	t876 := t871 + t874
+	t877 := t870 - t872
+	t878 := t871 - t874
+	t879 := t762 * complex(0.5555702330196022, 0.8314696123025453)
+	t880 := t791 * complex(-0.3826834323650898, 0.9238795325112867)
+	t881 := t820 * complex(-0.9807852804032304, 0.19509032201612825)
+	t882 := t733 + t880
+	t883 := t733 - t880
+	t884 := t879 + t881
+	t885 := t879 - t881
+	t886 := complex(-imag(t885), real(t885))
+	t887 := t882 + t884
+	t888 := t883 + t886
+	t889 := t882 - t884
+	t890 := t883 - t886
+	t891 := t751 * complex(0.3826834323650898, 0.9238795325112867)
+	t892 := complex((-real(t780)-imag(t780))*0.7071067811865476, (-imag(t780)+real(t780))*0.7071067811865476)
+	t893 := t809 * complex(-0.9238795325112867, -0.3826834323650898)
+	t894 := t722 + t892
+	t895 := t722 - t892
+	t896 := t891 + t893
+	t897 := t891 - t893
+	t898 := complex(-imag(t897), real(t897))
+	t899 := t894 + t896
+	t900 := t895 + t898
+	t901 := t894 - t896
+	t902 := t895 - t898
+	t903 := t763 * complex(0.19509032201612825, 0.9807852804032304)
+	t904 := t792 * complex(-0.9238795325112867, 0.3826834323650898)
+	t905 := t821 * complex(-0.5555702330196022, -0.8314696123025453)
+	t906 := t734 + t904
+	t907 := t734 - t904
+	t908 := t903 + t905
+	t909 := t903 - t905
+	t910 := complex(-imag(t909), real(t909))
+	t911 := t906 + t908
+	t912 := t907 + t910
+	t913 := t906 - t908
+	t914 := t907 - t910
+
+	d[0] = complex(real(t409)*0.010416666666666666, imag(t409)*0.010416666666666666)
+	d[1] = complex(real(t630)*0.010416666666666666, imag(t630)*0.010416666666666666)
+	d[2] = complex(real(t851)*0.010416666666666666, imag(t851)*0.010416666666666666)
+	d[3] = complex(real(t445)*0.010416666666666666, imag(t445)*0.010416666666666666)
+	d[4] = complex(real(t666)*0.010416666666666666, imag(t666)*0.010416666666666666)
+	d[5] = complex(real(t887)*0.010416666666666666, imag(t887)*0.010416666666666666)
+	d[6] = complex(real(t481)*0.010416666666666666, imag(t481)*0.010416666666666666)
+	d[7] = complex(real(t702)*0.010416666666666666, imag(t702)*0.010416666666666666)
+	d[8] = complex(real(t828)*0.010416666666666666, imag(t828)*0.010416666666666666)
+	d[9] = complex(real(t422)*0.010416666666666666, imag(t422)*0.010416666666666666)
+	d[10] = complex(real(t643)*0.010416666666666666, imag(t643)*0.010416666666666666)
+	d[11] = complex(real(t864)*0.010416666666666666, imag(t864)*0.010416666666666666)
+	d[12] = complex(real(t458)*0.010416666666666666, imag(t458)*0.010416666666666666)
+	d[13] = complex(real(t679)*0.010416666666666666, imag(t679)*0.010416666666666666)
+	d[14] = complex(real(t900)*0.010416666666666666, imag(t900)*0.010416666666666666)
+	d[15] = complex(real(t494)*0.010416666666666666, imag(t494)*0.010416666666666666)
+	d[16] = complex(real(t620)*0.010416666666666666, imag(t620)*0.010416666666666666)
+	d[17] = complex(real(t841)*0.010416666666666666, imag(t841)*0.010416666666666666)
+	d[18] = complex(real(t435)*0.010416666666666666, imag(t435)*0.010416666666666666)
+	d[19] = complex(real(t656)*0.010416666666666666, imag(t656)*0.010416666666666666)
+	d[20] = complex(real(t877)*0.010416666666666666, imag(t877)*0.010416666666666666)
+	d[21] = complex(real(t471)*0.010416666666666666, imag(t471)*0.010416666666666666)
+	d[22] = complex(real(t692)*0.010416666666666666, imag(t692)*0.010416666666666666)
+	d[23] = complex(real(t913)*0.010416666666666666, imag(t913)*0.010416666666666666)
+	d[24] = complex(real(t412)*0.010416666666666666, imag(t412)*0.010416666666666666)
+	d[25] = complex(real(t633)*0.010416666666666666, imag(t633)*0.010416666666666666)
+	d[26] = complex(real(t854)*0.010416666666666666, imag(t854)*0.010416666666666666)
+	d[27] = complex(real(t448)*0.010416666666666666, imag(t448)*0.010416666666666666)
+	d[28] = complex(real(t669)*0.010416666666666666, imag(t669)*0.010416666666666666)
+	d[29] = complex(real(t890)*0.010416666666666666, imag(t890)*0.010416666666666666)
+	d[30] = complex(real(t484)*0.010416666666666666, imag(t484)*0.010416666666666666)
+	d[31] = complex(real(t705)*0.010416666666666666, imag(t705)*0.010416666666666666)
+	d[32] = complex(real(t827)*0.010416666666666666, imag(t827)*0.010416666666666666)
+	d[33] = complex(real(t421)*0.010416666666666666, imag(t421)*0.010416666666666666)
+	d[34] = complex(real(t642)*0.010416666666666666, imag(t642)*0.010416666666666666)
+	d[35] = complex(real(t863)*0.010416666666666666, imag(t863)*0.010416666666666666)
+	d[36] = complex(real(t457)*0.010416666666666666, imag(t457)*0.010416666666666666)
+	d[37] = complex(real(t678)*0.010416666666666666, imag(t678)*0.010416666666666666)
+	d[38] = complex(real(t899)*0.010416666666666666, imag(t899)*0.010416666666666666)
+	d[39] = complex(real(t493)*0.010416666666666666, imag(t493)*0.010416666666666666)
+	d[40] = complex(real(t619)*0.010416666666666666, imag(t619)*0.010416666666666666)
+	d[41] = complex(real(t840)*0.010416666666666666, imag(t840)*0.010416666666666666)
+	d[42] = complex(real(t434)*0.010416666666666666, imag(t434)*0.010416666666666666)
+	d[43] = complex(real(t655)*0.010416666666666666, imag(t655)*0.010416666666666666)
+	d[44] = complex(real(t876)*0.010416666666666666, imag(t876)*0.010416666666666666)
+	d[45] = complex(real(t470)*0.010416666666666666, imag(t470)*0.010416666666666666)
+	d[46] = complex(real(t691)*0.010416666666666666, imag(t691)*0.010416666666666666)
+	d[47] = complex(real(t912)*0.010416666666666666, imag(t912)*0.010416666666666666)
+	d[48] = complex(real(t411)*0.010416666666666666, imag(t411)*0.010416666666666666)
+	d[49] = complex(real(t632)*0.010416666666666666, imag(t632)*0.010416666666666666)
+	d[50] = complex(real(t853)*0.010416666666666666, imag(t853)*0.010416666666666666)
+	d[51] = complex(real(t447)*0.010416666666666666, imag(t447)*0.010416666666666666)
+	d[52] = complex(real(t668)*0.010416666666666666, imag(t668)*0.010416666666666666)
+	d[53] = complex(real(t889)*0.010416666666666666, imag(t889)*0.010416666666666666)
+	d[54] = complex(real(t483)*0.010416666666666666, imag(t483)*0.010416666666666666)
+	d[55] = complex(real(t704)*0.010416666666666666, imag(t704)*0.010416666666666666)
+	d[56] = complex(real(t830)*0.010416666666666666, imag(t830)*0.010416666666666666)
+	d[57] = complex(real(t424)*0.010416666666666666, imag(t424)*0.010416666666666666)
+	d[58] = complex(real(t645)*0.010416666666666666, imag(t645)*0.010416666666666666)
+	d[59] = complex(real(t866)*0.010416666666666666, imag(t866)*0.010416666666666666)
+	d[60] = complex(real(t460)*0.010416666666666666, imag(t460)*0.010416666666666666)
+	d[61] = complex(real(t681)*0.010416666666666666, imag(t681)*0.010416666666666666)
+	d[62] = complex(real(t902)*0.010416666666666666, imag(t902)*0.010416666666666666)
+	d[63] = complex(real(t496)*0.010416666666666666, imag(t496)*0.010416666666666666)
+	d[64] = complex(real(t618)*0.010416666666666666, imag(t618)*0.010416666666666666)
+	d[65] = complex(real(t839)*0.010416666666666666, imag(t839)*0.010416666666666666)
+	d[66] = complex(real(t433)*0.010416666666666666, imag(t433)*0.010416666666666666)
+	d[67] = complex(real(t654)*0.010416666666666666, imag(t654)*0.010416666666666666)
+	d[68] = complex(real(t875)*0.010416666666666666, imag(t875)*0.010416666666666666)
+	d[69] = complex(real(t469)*0.010416666666666666, imag(t469)*0.010416666666666666)
+	d[70] = complex(real(t690)*0.010416666666666666, imag(t690)*0.010416666666666666)
+	d[71] = complex(real(t911)*0.010416666666666666, imag(t911)*0.010416666666666666)
+	d[72] = complex(real(t410)*0.010416666666666666, imag(t410)*0.010416666666666666)
+	d[73] = complex(real(t631)*0.010416666666666666, imag(t631)*0.010416666666666666)
+	d[74] = complex(real(t852)*0.010416666666666666, imag(t852)*0.010416666666666666)
+	d[75] = complex(real(t446)*0.010416666666666666, imag(t446)*0.010416666666666666)
+	d[76] = complex(real(t667)*0.010416666666666666, imag(t667)*0.010416666666666666)
+	d[77] = complex(real(t888)*0.010416666666666666, imag(t888)*0.010416666666666666)
+	d[78] = complex(real(t482)*0.010416666666666666, imag(t482)*0.010416666666666666)
+	d[79] = complex(real(t703)*0.010416666666666666, imag(t703)*0.010416666666666666)
+	d[80] = complex(real(t829)*0.010416666666666666, imag(t829)*0.010416666666666666)
+	d[81] = complex(real(t423)*0.010416666666666666, imag(t423)*0.010416666666666666)
+	d[82] = complex(real(t644)*0.010416666666666666, imag(t644)*0.010416666666666666)
+	d[83] = complex(real(t865)*0.010416666666666666, imag(t865)*0.010416666666666666)
+	d[84] = complex(real(t459)*0.010416666666666666, imag(t459)*0.010416666666666666)
+	d[85] = complex(real(t680)*0.010416666666666666, imag(t680)*0.010416666666666666)
+	d[86] = complex(real(t901)*0.010416666666666666, imag(t901)*0.010416666666666666)
+	d[87] = complex(real(t495)*0.010416666666666666, imag(t495)*0.010416666666666666)
+	d[88] = complex(real(t621)*0.010416666666666666, imag(t621)*0.010416666666666666)
+	d[89] = complex(real(t842)*0.010416666666666666, imag(t842)*0.010416666666666666)
+	d[90] = complex(real(t436)*0.010416666666666666, imag(t436)*0.010416666666666666)
+	d[91] = complex(real(t657)*0.010416666666666666, imag(t657)*0.010416666666666666)
+	d[92] = complex(real(t878)*0.010416666666666666, imag(t878)*0.010416666666666666)
+	d[93] = complex(real(t472)*0.010416666666666666, imag(t472)*0.010416666666666666)
+	d[94] = complex(real(t693)*0.010416666666666666, imag(t693)*0.010416666666666666)
+	d[95] = complex(real(t914)*0.010416666666666666, imag(t914)*0.010416666666666666)
+
+	return true
+}
@@ -0,0 +1,389 @@
+// Code generated by genfft. DO NOT EDIT.
+
+package kernels
+
+// forwardDFT9Complex64 computes a 9-point forward DFT of complex64 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT9Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 9
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+
+	t0 := x3 + x6
+	t1 := x3 - x6
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x4 + x7
+	t10 := x4 - x7
+	t11 := x1 + t9
+	t12 := real(x1) - 0.49999999999999994*real(t9)
+	t13 := imag(x1) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x5 + x8
+	t19 := x5 - x8
+	t20 := x2 + t18
+	t21 := real(x2) - 0.49999999999999994*real(t18)
+	t22 := imag(x2) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := t11 + t20
+	t28 := t11 - t20
+	t29 := t2 + t27
+	t30 := real(t2) - 0.49999999999999994*real(t27)
+	t31 := imag(t2) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t17 * complex(0.7660444431189781, -0.6427876096865393)
+	t37 := t26 * complex(0.17364817766693033, -0.9848077530122081)
+	t38 := t36 + t37
+	t39 := t36 - t37
+	t40 := t8 + t38
+	t41 := real(t8) - 0.49999999999999994*real(t38)
+	t42 := imag(t8) - 0.49999999999999994*imag(t38)
+	t43 := 0.8660254037844387 * real(t39)
+	t44 := 0.8660254037844387 * imag(t39)
+	t45 := complex(t41-t44, t42+t43)
+	t46 := complex(t41+t44, t42-t43)
+	t47 := t16 * complex(0.17364817766693033, -0.9848077530122081)
+	t48 := t25 * complex(-0.9396926207859084, -0.3420201433256687)
+	t49 := t47 + t48
+	t50 := t47 - t48
+	t51 := t7 + t49
+	t52 := real(t7) - 0.49999999999999994*real(t49)
+	t53 := imag(t7) - 0.49999999999999994*imag(t49)
+	t54 := 0.8660254037844387 * real(t50)
+	t55 := 0.8660254037844387 * imag(t50)
+	t56 := complex(t52-t55, t53+t54)
+	t57 := complex(t52+t55, t53-t54)
+
+	d[0] = t29
+	d[1] = t40
+	d[2] = t51
+	d[3] = t35
+	d[4] = t46
+	d[5] = t57
+	d[6] = t34
+	d[7] = t45
+	d[8] = t56
+
+	return true
+}
+
+// inverseDFT9Complex64 computes a 9-point inverse DFT of complex64 data,
+// including the 1/9 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT9Complex64(dst, src, twiddle, scratch []complex64) bool {
+	const n = 9
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+
+	t0 := x3 + x6
+	t1 := x3 - x6
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x4 + x7
+	t10 := x4 - x7
+	t11 := x1 + t9
+	t12 := real(x1) - 0.49999999999999994*real(t9)
+	t13 := imag(x1) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x5 + x8
+	t19 := x5 - x8
+	t20 := x2 + t18
+	t21 := real(x2) - 0.49999999999999994*real(t18)
+	t22 := imag(x2) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := t11 + t20
+	t28 := t11 - t20
+	t29 := t2 + t27
+	t30 := real(t2) - 0.49999999999999994*real(t27)
+	t31 := imag(t2) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t16 * complex(0.7660444431189781, 0.6427876096865393)
+	t37 := t25 * complex(0.17364817766693033, 0.9848077530122081)
+	t38 := t36 + t37
+	t39 := t36 - t37
+	t40 := t7 + t38
+	t41 := real(t7) - 0.49999999999999994*real(t38)
+	t42 := imag(t7) - 0.49999999999999994*imag(t38)
+	t43 := 0.8660254037844387 * real(t39)
+	t44 := 0.8660254037844387 * imag(t39)
+	t45 := complex(t41-t44, t42+t43)
+	t46 := complex(t41+t44, t42-t43)
+	t47 := t17 * complex(0.17364817766693033, 0.9848077530122081)
+	t48 := t26 * complex(-0.9396926207859084, 0.3420201433256687)
+	t49 := t47 + t48
+	t50 := t47 - t48
+	t51 := t8 + t49
+	t52 := real(t8) - 0.49999999999999994*real(t49)
+	t53 := imag(t8) - 0.49999999999999994*imag(t49)
+	t54 := 0.8660254037844387 * real(t50)
+	t55 := 0.8660254037844387 * imag(t50)
+	t56 := complex(t52-t55, t53+t54)
+	t57 := complex(t52+t55, t53-t54)
+
+	d[0] = complex(real(t29)*0.1111111111111111, imag(t29)*0.1111111111111111)
+	d[1] = complex(real(t40)*0.1111111111111111, imag(t40)*0.1111111111111111)
+	d[2] = complex(real(t51)*0.1111111111111111, imag(t51)*0.1111111111111111)
+	d[3] = complex(real(t34)*0.1111111111111111, imag(t34)*0.1111111111111111)
+	d[4] = complex(real(t45)*0.1111111111111111, imag(t45)*0.1111111111111111)
+	d[5] = complex(real(t56)*0.1111111111111111, imag(t56)*0.1111111111111111)
+	d[6] = complex(real(t35)*0.1111111111111111, imag(t35)*0.1111111111111111)
+	d[7] = complex(real(t46)*0.1111111111111111, imag(t46)*0.1111111111111111)
+	d[8] = complex(real(t57)*0.1111111111111111, imag(t57)*0.1111111111111111)
+
+	return true
+}
+
+// forwardDFT9Complex128 computes a 9-point forward DFT of complex128 data.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func forwardDFT9Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 9
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+
+	t0 := x3 + x6
+	t1 := x3 - x6
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x4 + x7
+	t10 := x4 - x7
+	t11 := x1 + t9
+	t12 := real(x1) - 0.49999999999999994*real(t9)
+	t13 := imag(x1) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x5 + x8
+	t19 := x5 - x8
+	t20 := x2 + t18
+	t21 := real(x2) - 0.49999999999999994*real(t18)
+	t22 := imag(x2) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := t11 + t20
+	t28 := t11 - t20
+	t29 := t2 + t27
+	t30 := real(t2) - 0.49999999999999994*real(t27)
+	t31 := imag(t2) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t17 * complex(0.7660444431189781, -0.6427876096865393)
+	t37 := t26 * complex(0.17364817766693033, -0.9848077530122081)
+	t38 := t36 + t37
+	t39 := t36 - t37
+	t40 := t8 + t38
+	t41 := real(t8) - 0.49999999999999994*real(t38)
+	t42 := imag(t8) - 0.49999999999999994*imag(t38)
+	t43 := 0.8660254037844387 * real(t39)
+	t44 := 0.8660254037844387 * imag(t39)
+	t45 := complex(t41-t44, t42+t43)
+	t46 := complex(t41+t44, t42-t43)
+	t47 := t16 * complex(0.17364817766693033, -0.9848077530122081)
+	t48 := t25 * complex(-0.9396926207859084, -0.3420201433256687)
+	t49 := t47 + t48
+	t50 := t47 - t48
+	t51 := t7 + t49
+	t52 := real(t7) - 0.49999999999999994*real(t49)
+	t53 := imag(t7) - 0.49999999999999994*imag(t49)
+	t54 := 0.8660254037844387 * real(t50)
+	t55 := 0.8660254037844387 * imag(t50)
+	t56 := complex(t52-t55, t53+t54)
+	t57 := complex(t52+t55, t53-t54)
+
+	d[0] = t29
+	d[1] = t40
+	d[2] = t51
+	d[3] = t35
+	d[4] = t46
+	d[5] = t57
+	d[6] = t34
+	d[7] = t45
+	d[8] = t56
+
+	return true
+}
+
+// inverseDFT9Complex128 computes a 9-point inverse DFT of complex128 data,
+// including the 1/9 scaling.
+// The twiddle and scratch arguments are unused; dst and src may alias.
+//
+//nolint:funlen
+func inverseDFT9Complex128(dst, src, twiddle, scratch []complex128) bool {
+	const n = 9
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+
+	t0 := x3 + x6
+	t1 := x3 - x6
+	t2 := x0 + t0
+	t3 := real(x0) - 0.49999999999999994*real(t0)
+	t4 := imag(x0) - 0.49999999999999994*imag(t0)
+	t5 := 0.8660254037844387 * real(t1)
+	t6 := 0.8660254037844387 * imag(t1)
+	t7 := complex(t3-t6, t4+t5)
+	t8 := complex(t3+t6, t4-t5)
+	t9 := x4 + x7
+	t10 := x4 - x7
+	t11 := x1 + t9
+	t12 := real(x1) - 0.49999999999999994*real(t9)
+	t13 := imag(x1) - 0.49999999999999994*imag(t9)
+	t14 := 0.8660254037844387 * real(t10)
+	t15 := 0.8660254037844387 * imag(t10)
+	t16 := complex(t12-t15, t13+t14)
+	t17 := complex(t12+t15, t13-t14)
+	t18 := x5 + x8
+	t19 := x5 - x8
+	t20 := x2 + t18
+	t21 := real(x2) - 0.49999999999999994*real(t18)
+	t22 := imag(x2) - 0.49999999999999994*imag(t18)
+	t23 := 0.8660254037844387 * real(t19)
+	t24 := 0.8660254037844387 * imag(t19)
+	t25 := complex(t21-t24, t22+t23)
+	t26 := complex(t21+t24, t22-t23)
+	t27 := t11 + t20
+	t28 := t11 - t20
+	t29 := t2 + t27
+	t30 := real(t2) - 0.49999999999999994*real(t27)
+	t31 := imag(t2) - 0.49999999999999994*imag(t27)
+	t32 := 0.8660254037844387 * real(t28)
+	t33 := 0.8660254037844387 * imag(t28)
+	t34 := complex(t30-t33, t31+t32)
+	t35 := complex(t30+t33, t31-t32)
+	t36 := t16 * complex(0.7660444431189781, 0.6427876096865393)
+	t37 := t25 * complex(0.17364817766693033, 0.9848077530122081)
+	t38 := t36 + t37
+	t39 := t36 - t37
+	t40 := t7 + t38
+	t41 := real(t7) - 0.49999999999999994*real(t38)
+	t42 := imag(t7) - 0.49999999999999994*imag(t38)
+	t43 := 0.8660254037844387 * real(t39)
+	t44 := 0.8660254037844387 * imag(t39)
+	t45 := complex(t41-t44, t42+t43)
+	t46 := complex(t41+t44, t42-t43)
+	t47 := t17 * complex(0.17364817766693033, 0.9848077530122081)
+	t48 := t26 * complex(-0.9396926207859084, 0.3420201433256687)
+	t49 := t47 + t48
+	t50 := t47 - t48
+	t51 := t8 + t49
+	t52 := real(t8) - 0.49999999999999994*real(t49)
+	t53 := imag(t8) - 0.49999999999999994*imag(t49)
+	t54 := 0.8660254037844387 * real(t50)
+	t55 := 0.8660254037844387 * imag(t50)
+	t56 := complex(t52-t55, t53+t54)
+	t57 := complex(t52+t55, t53-t54)
+
+	d[0] = complex(real(t29)*0.1111111111111111, imag(t29)*0.1111111111111111)
+	d[1] = complex(real(t40)*0.1111111111111111, imag(t40)*0.1111111111111111)
+	d[2] = complex(real(t51)*0.1111111111111111, imag(t51)*0.1111111111111111)
+	d[3] = complex(real(t34)*0.1111111111111111, imag(t34)*0.1111111111111111)
+	d[4] = complex(real(t45)*0.1111111111111111, imag(t45)*0.1111111111111111)
+	d[5] = complex(real(t56)*0.1111111111111111, imag(t56)*0.1111111111111111)
+	d[6] = complex(real(t35)*0.1111111111111111, imag(t35)*0.1111111111111111)
+	d[7] = complex(real(t46)*0.1111111111111111, imag(t46)*0.1111111111111111)
+	d[8] = complex(real(t57)*0.1111111111111111, imag(t57)*0.1111111111111111)
+
+	return true
+}
@@ -0,0 +1,109 @@
+package kernels
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+// genfftSignature is the signature suffix of the codelets generated by cmd/genfft.
+const genfftSignature = "_genfft_generic"
+
+// TestGeneratedCodelets64 compares every generated complex64 codelet with the
+// naive DFT, running the inverse in place.
+func TestGeneratedCodelets64(t *testing.T) {
+	t.Parallel()
+
+	found := 0
+
+	for _, size := range Registry64.Sizes() {
+		for _, entry := range Registry64.GetAllForSize(size) {
+			if !strings.HasSuffix(entry.Signature, genfftSignature) {
+				continue
+			}
+
+			found++
+
+			t.Run(fmt.Sprintf("size%d", size), func(t *testing.T) {
+				t.Parallel()
+
+				src := randomComplex64(size, uint64(size))
+				dst := make([]complex64, size)
+				tol := 1e-5 * float64(size)
+
+				entry.Forward(dst, src, nil, nil)
+
+				assertComplex64Close(t, dst, reference.NaiveDFT(src), tol)
+
+				want := reference.NaiveIDFT(src)
+				copy(dst, src)
+
+				entry.Inverse(dst, dst, nil, nil)
+
+				assertComplex64Close(t, dst, want, tol/float64(size))
+			})
+		}
+	}
+
+	if found == 0 {
+		t.Fatal("no generated codelets registered")
+	}
+}
+
+// TestGeneratedCodelets128 is the complex128 version of TestGeneratedCodelets64.
+func TestGeneratedCodelets128(t *testing.T) {
+	t.Parallel()
+
+	found := 0
+
+	for _, size := range Registry128.Sizes() {
+		for _, entry := range Registry128.GetAllForSize(size) {
+			if !strings.HasSuffix(entry.Signature, genfftSignature) {
+				continue
+			}
+
+			found++
+
+			t.Run(fmt.Sprintf("size%d", size), func(t *testing.T) {
+				t.Parallel()
+
+				src := randomComplex128(size, uint64(size))
+				dst := make([]complex128, size)
+				tol := 1e-12 * float64(size)
+
+				entry.Forward(dst, src, nil, nil)
+
+				assertComplex128Close(t, dst, reference.NaiveDFT128(src), tol)
+
+				want := reference.NaiveIDFT128(src)
+				copy(dst, src)
+
+				entry.Inverse(dst, dst, nil, nil)
+
+				assertComplex128Close(t, dst, want, tol/float64(size))
+			})
+		}
+	}
+
+	if found == 0 {
+		t.Fatal("no generated codelets registered")
+	}
+}
+
+// TestGeneratedCodelets_RejectShortBuffers checks the length guard of the
+// generated kernels.
+func TestGeneratedCodelets_RejectShortBuffers(t *testing.T) {
+	t.Parallel()
+
+	src := randomComplex64(6, 1)
+
+	if forwardDFT6Complex64(make([]complex64, 5), src, nil, nil) {
+		t.Error("forwardDFT6Complex64 accepted a short dst")
+	}
+
+	if inverseDFT6Complex64(make([]complex64, 6), src[:5], nil, nil) {
+		t.Error("inverseDFT6Complex64 accepted a short src")
+	}
+}
@@ -1,6 +1,10 @@
 package kernels
 
-import mathpkg "github.com/cwbudde/algo-fft/internal/math"
+import (
+	"math/bits"
+
+	mathpkg "github.com/cwbudde/algo-fft/internal/math"
+)
 
 // Pre-computed bit-reversal indices for multiple sizes/algorithms.
 //
@@ -232,11 +236,12 @@ func ditForward[T Complex](dst, src, twiddle, scratch []T) bool {
 	src = src[:n]
 	twiddle = twiddle[:n]
 
-	// Compute bit-reversal indices locally for fallback
-	bitrev := mathpkg.ComputeBitReversalIndices(n)
+	// The permutation is derived from the index, so the fallback needs no
+	// shared table.
+	shift := bits.UintSize - bits.TrailingZeros(uint(n))
 
 	for i := range n {
-		work[i] = src[bitrev[i]]
+		work[i] = src[bits.Reverse(uint(i))>>shift]
 	}
 
 	for size := 2; size <= n; size <<= 1 {
@@ -294,10 +299,10 @@ func ditInverse[T Complex](dst, src, twiddle, scratch []T) bool {
 	src = src[:n]
 	twiddle = twiddle[:n]
 
-	bitrev := mathpkg.ComputeBitReversalIndices(n)
+	shift := bits.UintSize - bits.TrailingZeros(uint(n))
 
 	for i := range n {
-		work[i] = src[bitrev[i]]
+		work[i] = src[bits.Reverse(uint(i))>>shift]
 	}
 
 	for size := 2; size <= n; size <<= 1 {
@@ -360,10 +365,10 @@ func ditInverseComplex64(dst, src, twiddle, scratch []complex64) bool {
 	src = src[:n]
 	twiddle = twiddle[:n]
 
-	bitrev := mathpkg.ComputeBitReversalIndices(n)
+	shift := bits.UintSize - bits.TrailingZeros(uint(n))
 
 	for i := range n {
-		work[i] = src[bitrev[i]]
+		work[i] = src[bits.Reverse(uint(i))>>shift]
 	}
 
 	for size := 2; size <= n; size <<= 1 {
@@ -427,10 +432,10 @@ func ditInverseComplex128(dst, src, twiddle, scratch []complex128) bool {
 	src = src[:n]
 	twiddle = twiddle[:n]
 
-	bitrev := mathpkg.ComputeBitReversalIndices(n)
+	shift := bits.UintSize - bits.TrailingZeros(uint(n))
 
 	for i := range n {
-		work[i] = src[bitrev[i]]
+		work[i] = src[bits.Reverse(uint(i))>>shift]
 	}
 
 	for size := 2; size <= n; size <<= 1 {
@@ -0,0 +1,196 @@
+package kernels
+
+// Radix-11 DFT constants: cos and sin of 2πq/11 for q = 1..5.
+const (
+	radix11C1 = 0.84125353283118120551  // cos(2π/11)
+	radix11C2 = 0.41541501300188643508  // cos(2·2π/11)
+	radix11C3 = -0.14231483827328500480 // cos(3·2π/11)
+	radix11C4 = -0.65486073394528498959 // cos(4·2π/11)
+	radix11C5 = -0.95949297361449736865 // cos(5·2π/11)
+	radix11S1 = 0.54064081745559755543  // sin(2π/11)
+	radix11S2 = 0.90963199535451833011  // sin(2·2π/11)
+	radix11S3 = 0.98982144188093279524  // sin(3·2π/11)
+	radix11S4 = 0.75574957435425826890  // sin(4·2π/11)
+	radix11S5 = 0.28173255684142967104  // sin(5·2π/11)
+)
+
+// butterfly11Complex64 computes a radix-11 DFT of v in place (inverse selects the
+// conjugate kernel, without 1/11 scaling). It folds the inputs into 5
+// conjugate pairs t = v[j]+v[11-j], u = v[j]-v[11-j], so that each output
+// pair y[k], y[11-k] shares one cosine sum over t and one sine sum over u.
+func butterfly11Complex64(v *[11]complex64, inverse bool) {
+	x0r, x0i := real(v[0]), imag(v[0])
+
+	t1r, t1i := real(v[1])+real(v[10]), imag(v[1])+imag(v[10])
+	u1r, u1i := real(v[1])-real(v[10]), imag(v[1])-imag(v[10])
+	t2r, t2i := real(v[2])+real(v[9]), imag(v[2])+imag(v[9])
+	u2r, u2i := real(v[2])-real(v[9]), imag(v[2])-imag(v[9])
+	t3r, t3i := real(v[3])+real(v[8]), imag(v[3])+imag(v[8])
+	u3r, u3i := real(v[3])-real(v[8]), imag(v[3])-imag(v[8])
+	t4r, t4i := real(v[4])+real(v[7]), imag(v[4])+imag(v[7])
+	u4r, u4i := real(v[4])-real(v[7]), imag(v[4])-imag(v[7])
+	t5r, t5i := real(v[5])+real(v[6]), imag(v[5])+imag(v[6])
+	u5r, u5i := real(v[5])-real(v[6]), imag(v[5])-imag(v[6])
+
+	v[0] = complex(x0r+t1r+t2r+t3r+t4r+t5r, x0i+t1i+t2i+t3i+t4i+t5i)
+
+	a1r := x0r + radix11C1*t1r + radix11C2*t2r + radix11C3*t3r + radix11C4*t4r + radix11C5*t5r
+	a1i := x0i + radix11C1*t1i + radix11C2*t2i + radix11C3*t3i + radix11C4*t4i + radix11C5*t5i
+	b1r := radix11S1*u1r + radix11S2*u2r + radix11S3*u3r + radix11S4*u4r + radix11S5*u5r
+	b1i := radix11S1*u1i + radix11S2*u2i + radix11S3*u3i + radix11S4*u4i + radix11S5*u5i
+
+	if inverse {
+		b1r, b1i = -b1r, -b1i
+	}
+
+	v[1] = complex(a1r+b1i, a1i-b1r)
+	v[10] = complex(a1r-b1i, a1i+b1r)
+
+	a2r := x0r + radix11C2*t1r + radix11C4*t2r + radix11C5*t3r + radix11C3*t4r + radix11C1*t5r
+	a2i := x0i + radix11C2*t1i + radix11C4*t2i + radix11C5*t3i + radix11C3*t4i + radix11C1*t5i
+	b2r := radix11S2*u1r + radix11S4*u2r - radix11S5*u3r - radix11S3*u4r - radix11S1*u5r
+	b2i := radix11S2*u1i + radix11S4*u2i - radix11S5*u3i - radix11S3*u4i - radix11S1*u5i
+
+	if inverse {
+		b2r, b2i = -b2r, -b2i
+	}
+
+	v[2] = complex(a2r+b2i, a2i-b2r)
+	v[9] = complex(a2r-b2i, a2i+b2r)
+
+	a3r := x0r + radix11C3*t1r + radix11C5*t2r + radix11C2*t3r + radix11C1*t4r + radix11C4*t5r
+	a3i := x0i + radix11C3*t1i + radix11C5*t2i + radix11C2*t3i + radix11C1*t4i + radix11C4*t5i
+	b3r := radix11S3*u1r - radix11S5*u2r - radix11S2*u3r + radix11S1*u4r + radix11S4*u5r
+	b3i := radix11S3*u1i - radix11S5*u2i - radix11S2*u3i + radix11S1*u4i + radix11S4*u5i
+
+	if inverse {
+		b3r, b3i = -b3r, -b3i
+	}
+
+	v[3] = complex(a3r+b3i, a3i-b3r)
+	v[8] = complex(a3r-b3i, a3i+b3r)
+
+	a4r := x0r + radix11C4*t1r + radix11C3*t2r + radix11C1*t3r + radix11C5*t4r + radix11C2*t5r
+	a4i := x0i + radix11C4*t1i + radix11C3*t2i + radix11C1*t3i + radix11C5*t4i + radix11C2*t5i
+	b4r := radix11S4*u1r - radix11S3*u2r + radix11S1*u3r + radix11S5*u4r - radix11S2*u5r
+	b4i := radix11S4*u1i - radix11S3*u2i + radix11S1*u3i + radix11S5*u4i - radix11S2*u5i
+
+	if inverse {
+		b4r, b4i = -b4r, -b4i
+	}
+
+	v[4] = complex(a4r+b4i, a4i-b4r)
+	v[7] = complex(a4r-b4i, a4i+b4r)
+
+	a5r := x0r + radix11C5*t1r + radix11C1*t2r + radix11C4*t3r + radix11C2*t4r + radix11C3*t5r
+	a5i := x0i + radix11C5*t1i + radix11C1*t2i + radix11C4*t3i + radix11C2*t4i + radix11C3*t5i
+	b5r := radix11S5*u1r - radix11S1*u2r + radix11S4*u3r - radix11S2*u4r + radix11S3*u5r
+	b5i := radix11S5*u1i - radix11S1*u2i + radix11S4*u3i - radix11S2*u4i + radix11S3*u5i
+
+	if inverse {
+		b5r, b5i = -b5r, -b5i
+	}
+
+	v[5] = complex(a5r+b5i, a5i-b5r)
+	v[6] = complex(a5r-b5i, a5i+b5r)
+}
+
+// butterfly11Complex128 is the complex128 variant of butterfly11Complex64.
+func butterfly11Complex128(v *[11]complex128, inverse bool) {
+	x0r, x0i := real(v[0]), imag(v[0])
+
+	t1r, t1i := real(v[1])+real(v[10]), imag(v[1])+imag(v[10])
+	u1r, u1i := real(v[1])-real(v[10]), imag(v[1])-imag(v[10])
+	t2r, t2i := real(v[2])+real(v[9]), imag(v[2])+imag(v[9])
+	u2r, u2i := real(v[2])-real(v[9]), imag(v[2])-imag(v[9])
+	t3r, t3i := real(v[3])+real(v[8]), imag(v[3])+imag(v[8])
+	u3r, u3i := real(v[3])-real(v[8]), imag(v[3])-imag(v[8])
+	t4r, t4i := real(v[4])+real(v[7]), imag(v[4])+imag(v[7])
+	u4r, u4i := real(v[4])-real(v[7]), imag(v[4])-imag(v[7])
+	t5r, t5i := real(v[5])+real(v[6]), imag(v[5])+imag(v[6])
+	u5r, u5i := real(v[5])-real(v[6]), imag(v[5])-imag(v[6])
+
+	v[0] = complex(x0r+t1r+t2r+t3r+t4r+t5r, x0i+t1i+t2i+t3i+t4i+t5i)
+
+	a1r := x0r + radix11C1*t1r + radix11C2*t2r + radix11C3*t3r + radix11C4*t4r + radix11C5*t5r
+	a1i := x0i + radix11C1*t1i + radix11C2*t2i + radix11C3*t3i + radix11C4*t4i + radix11C5*t5i
+	b1r := radix11S1*u1r + radix11S2*u2r + radix11S3*u3r + radix11S4*u4r + radix11S5*u5r
+	b1i := radix11S1*u1i + radix11S2*u2i + radix11S3*u3i + radix11S4*u4i + radix11S5*u5i
+
+	if inverse {
+		b1r, b1i = -b1r, -b1i
+	}
+
+	v[1] = complex(a1r+b1i, a1i-b1r)
+	v[10] = complex(a1r-b1i, a1i+b1r)
+
+	a2r := x0r + radix11C2*t1r + radix11C4*t2r + radix11C5*t3r + radix11C3*t4r + radix11C1*t5r
+	a2i := x0i + radix11C2*t1i + radix11C4*t2i + radix11C5*t3i + radix11C3*t4i + radix11C1*t5i
+	b2r := radix11S2*u1r + radix11S4*u2r - radix11S5*u3r - radix11S3*u4r - radix11S1*u5r
+	b2i := radix11S2*u1i + radix11S4*u2i - radix11S5*u3i - radix11S3*u4i - radix11S1*u5i
+
+	if inverse {
+		b2r, b2i = -b2r, -b2i
+	}
+
+	v[2] = complex(a2r+b2i, a2i-b2r)
+	v[9] = complex(a2r-b2i, a2i+b2r)
+
+	a3r := x0r + radix11C3*t1r + radix11C5*t2r + radix11C2*t3r + radix11C1*t4r + radix11C4*t5r
+	a3i := x0i + radix11C3*t1i + radix11C5*t2i + radix11C2*t3i + radix11C1*t4i + radix11C4*t5i
+	b3r := radix11S3*u1r - radix11S5*u2r - radix11S2*u3r + radix11S1*u4r + radix11S4*u5r
+	b3i := radix11S3*u1i - radix11S5*u2i - radix11S2*u3i + radix11S1*u4i + radix11S4*u5i
+
+	if inverse {
+		b3r, b3i = -b3r, -b3i
+	}
+
+	v[3] = complex(a3r+b3i, a3i-b3r)
+	v[8] = complex(a3r-b3i, a3i+b3r)
+
+	a4r := x0r + radix11C4*t1r + radix11C3*t2r + radix11C1*t3r + radix11C5*t4r + radix11C2*t5r
+	a4i := x0i + radix11C4*t1i + radix11C3*t2i + radix11C1*t3i + radix11C5*t4i + radix11C2*t5i
+	b4r := radix11S4*u1r - radix11S3*u2r + radix11S1*u3r + radix11S5*u4r - radix11S2*u5r
+	b4i := radix11S4*u1i - radix11S3*u2i + radix11S1*u3i + radix11S5*u4i - radix11S2*u5i
+
+	if inverse {
+		b4r, b4i = -b4r, -b4i
+	}
+
+	v[4] = complex(a4r+b4i, a4i-b4r)
+	v[7] = complex(a4r-b4i, a4i+b4r)
+
+	a5r := x0r + radix11C5*t1r + radix11C1*t2r + radix11C4*t3r + radix11C2*t4r + radix11C3*t5r
+	a5i := x0i + radix11C5*t1i + radix11C1*t2i + radix11C4*t3i + radix11C2*t4i + radix11C3*t5i
+	b5r := radix11S5*u1r - radix11S1*u2r + radix11S4*u3r - radix11S2*u4r + radix11S3*u5r
+	b5i := radix11S5*u1i - radix11S1*u2i + radix11S4*u3i - radix11S2*u4i + radix11S3*u5i
+
+	if inverse {
+		b5r, b5i = -b5r, -b5i
+	}
+
+	v[5] = complex(a5r+b5i, a5i-b5r)
+	v[6] = complex(a5r-b5i, a5i+b5r)
+}
+
+// Butterfly11 computes a radix-11 DFT of v in place; see butterfly11Complex64.
+func Butterfly11[T Complex](v *[11]T, inverse bool) {
+	switch vv := any(v).(type) {
+	case *[11]complex64:
+		butterfly11Complex64(vv, inverse)
+	case *[11]complex128:
+		butterfly11Complex128(vv, inverse)
+	default:
+		panic("unsupported complex type")
+	}
+}
+
+// Butterfly11Complex64 computes a radix-11 DFT of v in place.
+func Butterfly11Complex64(v *[11]complex64, inverse bool) {
+	butterfly11Complex64(v, inverse)
+}
+
+// Butterfly11Complex128 computes a radix-11 DFT of v in place.
+func Butterfly11Complex128(v *[11]complex128, inverse bool) {
+	butterfly11Complex128(v, inverse)
+}
@@ -0,0 +1,226 @@
+package kernels
+
+// Radix-13 DFT constants: cos and sin of 2πq/13 for q = 1..6.
+const (
+	radix13C1 = 0.88545602565320991051  // cos(2π/13)
+	radix13C2 = 0.56806474673115592289  // cos(2·2π/13)
+	radix13C3 = 0.12053668025532300601  // cos(3·2π/13)
+	radix13C4 = -0.35460488704253545489 // cos(4·2π/13)
+	radix13C5 = -0.74851074817110119231 // cos(5·2π/13)
+	radix13C6 = -0.97094181742605201180 // cos(6·2π/13)
+	radix13S1 = 0.46472317204376850652  // sin(2π/13)
+	radix13S2 = 0.82298386589365635224  // sin(2·2π/13)
+	radix13S3 = 0.99270887409805397272  // sin(3·2π/13)
+	radix13S4 = 0.93501624268541483342  // sin(4·2π/13)
+	radix13S5 = 0.66312265824079519305  // sin(5·2π/13)
+	radix13S6 = 0.23931566428755768339  // sin(6·2π/13)
+)
+
+// butterfly13Complex64 computes a radix-13 DFT of v in place (inverse selects the
+// conjugate kernel, without 1/13 scaling). It folds the inputs into 6
+// conjugate pairs t = v[j]+v[13-j], u = v[j]-v[13-j], so that each output
+// pair y[k], y[13-k] shares one cosine sum over t and one sine sum over u.
+func butterfly13Complex64(v *[13]complex64, inverse bool) {
+	x0r, x0i := real(v[0]), imag(v[0])
+
+	t1r, t1i := real(v[1])+real(v[12]), imag(v[1])+imag(v[12])
+	u1r, u1i := real(v[1])-real(v[12]), imag(v[1])-imag(v[12])
+	t2r, t2i := real(v[2])+real(v[11]), imag(v[2])+imag(v[11])
+	u2r, u2i := real(v[2])-real(v[11]), imag(v[2])-imag(v[11])
+	t3r, t3i := real(v[3])+real(v[10]), imag(v[3])+imag(v[10])
+	u3r, u3i := real(v[3])-real(v[10]), imag(v[3])-imag(v[10])
+	t4r, t4i := real(v[4])+real(v[9]), imag(v[4])+imag(v[9])
+	u4r, u4i := real(v[4])-real(v[9]), imag(v[4])-imag(v[9])
+	t5r, t5i := real(v[5])+real(v[8]), imag(v[5])+imag(v[8])
+	u5r, u5i := real(v[5])-real(v[8]), imag(v[5])-imag(v[8])
+	t6r, t6i := real(v[6])+real(v[7]), imag(v[6])+imag(v[7])
+	u6r, u6i := real(v[6])-real(v[7]), imag(v[6])-imag(v[7])
+
+	v[0] = complex(x0r+t1r+t2r+t3r+t4r+t5r+t6r, x0i+t1i+t2i+t3i+t4i+t5i+t6i)
+
+	a1r := x0r + radix13C1*t1r + radix13C2*t2r + radix13C3*t3r + radix13C4*t4r + radix13C5*t5r + radix13C6*t6r
+	a1i := x0i + radix13C1*t1i + radix13C2*t2i + radix13C3*t3i + radix13C4*t4i + radix13C5*t5i + radix13C6*t6i
+	b1r := radix13S1*u1r + radix13S2*u2r + radix13S3*u3r + radix13S4*u4r + radix13S5*u5r + radix13S6*u6r
+	b1i := radix13S1*u1i + radix13S2*u2i + radix13S3*u3i + radix13S4*u4i + radix13S5*u5i + radix13S6*u6i
+
+	if inverse {
+		b1r, b1i = -b1r, -b1i
+	}
+
+	v[1] = complex(a1r+b1i, a1i-b1r)
+	v[12] = complex(a1r-b1i, a1i+b1r)
+
+	a2r := x0r + radix13C2*t1r + radix13C4*t2r + radix13C6*t3r + radix13C5*t4r + radix13C3*t5r + radix13C1*t6r
+	a2i := x0i + radix13C2*t1i + radix13C4*t2i + radix13C6*t3i + radix13C5*t4i + radix13C3*t5i + radix13C1*t6i
+	b2r := radix13S2*u1r + radix13S4*u2r + radix13S6*u3r - radix13S5*u4r - radix13S3*u5r - radix13S1*u6r
+	b2i := radix13S2*u1i + radix13S4*u2i + radix13S6*u3i - radix13S5*u4i - radix13S3*u5i - radix13S1*u6i
+
+	if inverse {
+		b2r, b2i = -b2r, -b2i
+	}
+
+	v[2] = complex(a2r+b2i, a2i-b2r)
+	v[11] = complex(a2r-b2i, a2i+b2r)
+
+	a3r := x0r + radix13C3*t1r + radix13C6*t2r + radix13C4*t3r + radix13C1*t4r + radix13C2*t5r + radix13C5*t6r
+	a3i := x0i + radix13C3*t1i + radix13C6*t2i + radix13C4*t3i + radix13C1*t4i + radix13C2*t5i + radix13C5*t6i
+	b3r := radix13S3*u1r + radix13S6*u2r - radix13S4*u3r - radix13S1*u4r + radix13S2*u5r + radix13S5*u6r
+	b3i := radix13S3*u1i + radix13S6*u2i - radix13S4*u3i - radix13S1*u4i + radix13S2*u5i + radix13S5*u6i
+
+	if inverse {
+		b3r, b3i = -b3r, -b3i
+	}
+
+	v[3] = complex(a3r+b3i, a3i-b3r)
+	v[10] = complex(a3r-b3i, a3i+b3r)
+
+	a4r := x0r + radix13C4*t1r + radix13C5*t2r + radix13C1*t3r + radix13C3*t4r + radix13C6*t5r + radix13C2*t6r
+	a4i := x0i + radix13C4*t1i + radix13C5*t2i + radix13C1*t3i + radix13C3*t4i + radix13C6*t5i + radix13C2*t6i
+	b4r := radix13S4*u1r - radix13S5*u2r - radix13S1*u3r + radix13S3*u4r - radix13S6*u5r - radix13S2*u6r
+	b4i := radix13S4*u1i - radix13S5*u2i - radix13S1*u3i + radix13S3*u4i - radix13S6*u5i - radix13S2*u6i
+
+	if inverse {
+		b4r, b4i = -b4r, -b4i
+	}
+
+	v[4] = complex(a4r+b4i, a4i-b4r)
+	v[9] = complex(a4r-b4i, a4i+b4r)
+
+	a5r := x0r + radix13C5*t1r + radix13C3*t2r + radix13C2*t3r + radix13C6*t4r + radix13C1*t5r + radix13C4*t6r
+	a5i := x0i + radix13C5*t1i + radix13C3*t2i + radix13C2*t3i + radix13C6*t4i + radix13C1*t5i + radix13C4*t6i
+	b5r := radix13S5*u1r - radix13S3*u2r + radix13S2*u3r - radix13S6*u4r - radix13S1*u5r + radix13S4*u6r
+	b5i := radix13S5*u1i - radix13S3*u2i + radix13S2*u3i - radix13S6*u4i - radix13S1*u5i + radix13S4*u6i
+
+	if inverse {
+		b5r, b5i = -b5r, -b5i
+	}
+
+	v[5] = complex(a5r+b5i, a5i-b5r)
+	v[8] = complex(a5r-b5i, a5i+b5r)
+
+	a6r := x0r + radix13C6*t1r + radix13C1*t2r + radix13C5*t3r + radix13C2*t4r + radix13C4*t5r + radix13C3*t6r
+	a6i := x0i + radix13C6*t1i + radix13C1*t2i + radix13C5*t3i + radix13C2*t4i + radix13C4*t5i + radix13C3*t6i
+	b6r := radix13S6*u1r - radix13S1*u2r + radix13S5*u3r - radix13S2*u4r + radix13S4*u5r - radix13S3*u6r
+	b6i := radix13S6*u1i - radix13S1*u2i + radix13S5*u3i - radix13S2*u4i + radix13S4*u5i - radix13S3*u6i
+
+	if inverse {
+		b6r, b6i = -b6r, -b6i
+	}
+
+	v[6] = complex(a6r+b6i, a6i-b6r)
+	v[7] = complex(a6r-b6i, a6i+b6r)
+}
+
+// butterfly13Complex128 is the complex128 variant of butterfly13Complex64.
+func butterfly13Complex128(v *[13]complex128, inverse bool) {
+	x0r, x0i := real(v[0]), imag(v[0])
+
+	t1r, t1i := real(v[1])+real(v[12]), imag(v[1])+imag(v[12])
+	u1r, u1i := real(v[1])-real(v[12]), imag(v[1])-imag(v[12])
+	t2r, t2i := real(v[2])+real(v[11]), imag(v[2])+imag(v[11])
+	u2r, u2i := real(v[2])-real(v[11]), imag(v[2])-imag(v[11])
+	t3r, t3i := real(v[3])+real(v[10]), imag(v[3])+imag(v[10])
+	u3r, u3i := real(v[3])-real(v[10]), imag(v[3])-imag(v[10])
+	t4r, t4i := real(v[4])+real(v[9]), imag(v[4])+imag(v[9])
+	u4r, u4i := real(v[4])-real(v[9]), imag(v[4])-imag(v[9])
+	t5r, t5i := real(v[5])+real(v[8]), imag(v[5])+imag(v[8])
+	u5r, u5i := real(v[5])-real(v[8]), imag(v[5])-imag(v[8])
+	t6r, t6i := real(v[6])+real(v[7]), imag(v[6])+imag(v[7])
+	u6r, u6i := real(v[6])-real(v[7]), imag(v[6])-imag(v[7])
+
+	v[0] = complex(x0r+t1r+t2r+t3r+t4r+t5r+t6r, x0i+t1i+t2i+t3i+t4i+t5i+t6i)
+
+	a1r := x0r + radix13C1*t1r + radix13C2*t2r + radix13C3*t3r + radix13C4*t4r + radix13C5*t5r + radix13C6*t6r
+	a1i := x0i + radix13C1*t1i + radix13C2*t2i + radix13C3*t3i + radix13C4*t4i + radix13C5*t5i + radix13C6*t6i
+	b1r := radix13S1*u1r + radix13S2*u2r + radix13S3*u3r + radix13S4*u4r + radix13S5*u5r + radix13S6*u6r
+	b1i := radix13S1*u1i + radix13S2*u2i + radix13S3*u3i + radix13S4*u4i + radix13S5*u5i + radix13S6*u6i
+
+	if inverse {
+		b1r, b1i = -b1r, -b1i
+	}
+
+	v[1] = complex(a1r+b1i, a1i-b1r)
+	v[12] = complex(a1r-b1i, a1i+b1r)
+
+	a2r := x0r + radix13C2*t1r + radix13C4*t2r + radix13C6*t3r + radix13C5*t4r + radix13C3*t5r + radix13C1*t6r
+	a2i := x0i + radix13C2*t1i + radix13C4*t2i + radix13C6*t3i + radix13C5*t4i + radix13C3*t5i + radix13C1*t6i
+	b2r := radix13S2*u1r + radix13S4*u2r + radix13S6*u3r - radix13S5*u4r - radix13S3*u5r - radix13S1*u6r
+	b2i := radix13S2*u1i + radix13S4*u2i + radix13S6*u3i - radix13S5*u4i - radix13S3*u5i - radix13S1*u6i
+
+	if inverse {
+		b2r, b2i = -b2r, -b2i
+	}
+
+	v[2] = complex(a2r+b2i, a2i-b2r)
+	v[11] = complex(a2r-b2i, a2i+b2r)
+
+	a3r := x0r + radix13C3*t1r + radix13C6*t2r + radix13C4*t3r + radix13C1*t4r + radix13C2*t5r + radix13C5*t6r
+	a3i := x0i + radix13C3*t1i + radix13C6*t2i + radix13C4*t3i + radix13C1*t4i + radix13C2*t5i + radix13C5*t6i
+	b3r := radix13S3*u1r + radix13S6*u2r - radix13S4*u3r - radix13S1*u4r + radix13S2*u5r + radix13S5*u6r
+	b3i := radix13S3*u1i + radix13S6*u2i - radix13S4*u3i - radix13S1*u4i + radix13S2*u5i + radix13S5*u6i
+
+	if inverse {
+		b3r, b3i = -b3r, -b3i
+	}
+
+	v[3] = complex(a3r+b3i, a3i-b3r)
+	v[10] = complex(a3r-b3i, a3i+b3r)
+
+	a4r := x0r + radix13C4*t1r + radix13C5*t2r + radix13C1*t3r + radix13C3*t4r + radix13C6*t5r + radix13C2*t6r
+	a4i := x0i + radix13C4*t1i + radix13C5*t2i + radix13C1*t3i + radix13C3*t4i + radix13C6*t5i + radix13C2*t6i
+	b4r := radix13S4*u1r - radix13S5*u2r - radix13S1*u3r + radix13S3*u4r - radix13S6*u5r - radix13S2*u6r
+	b4i := radix13S4*u1i - radix13S5*u2i - radix13S1*u3i + radix13S3*u4i - radix13S6*u5i - radix13S2*u6i
+
+	if inverse {
+		b4r, b4i = -b4r, -b4i
+	}
+
+	v[4] = complex(a4r+b4i, a4i-b4r)
+	v[9] = complex(a4r-b4i, a4i+b4r)
+
+	a5r := x0r + radix13C5*t1r + radix13C3*t2r + radix13C2*t3r + radix13C6*t4r + radix13C1*t5r + radix13C4*t6r
+	a5i := x0i + radix13C5*t1i + radix13C3*t2i + radix13C2*t3i + radix13C6*t4i + radix13C1*t5i + radix13C4*t6i
+	b5r := radix13S5*u1r - radix13S3*u2r + radix13S2*u3r - radix13S6*u4r - radix13S1*u5r + radix13S4*u6r
+	b5i := radix13S5*u1i - radix13S3*u2i + radix13S2*u3i - radix13S6*u4i - radix13S1*u5i + radix13S4*u6i
+
+	if inverse {
+		b5r, b5i = -b5r, -b5i
+	}
+
+	v[5] = complex(a5r+b5i, a5i-b5r)
+	v[8] = complex(a5r-b5i, a5i+b5r)
+
+	a6r := x0r + radix13C6*t1r + radix13C1*t2r + radix13C5*t3r + radix13C2*t4r + radix13C4*t5r + radix13C3*t6r
+	a6i := x0i + radix13C6*t1i + radix13C1*t2i + radix13C5*t3i + radix13C2*t4i + radix13C4*t5i + radix13C3*t6i
+	b6r := radix13S6*u1r - radix13S1*u2r + radix13S5*u3r - radix13S2*u4r + radix13S4*u5r - radix13S3*u6r
+	b6i := radix13S6*u1i - radix13S1*u2i + radix13S5*u3i - radix13S2*u4i + radix13S4*u5i - radix13S3*u6i
+
+	if inverse {
+		b6r, b6i = -b6r, -b6i
+	}
+
+	v[6] = complex(a6r+b6i, a6i-b6r)
+	v[7] = complex(a6r-b6i, a6i+b6r)
+}
+
+// Butterfly13 computes a radix-13 DFT of v in place; see butterfly13Complex64.
+func Butterfly13[T Complex](v *[13]T, inverse bool) {
+	switch vv := any(v).(type) {
+	case *[13]complex64:
+		butterfly13Complex64(vv, inverse)
+	case *[13]complex128:
+		butterfly13Complex128(vv, inverse)
+	default:
+		panic("unsupported complex type")
+	}
+}
+
+// Butterfly13Complex64 computes a radix-13 DFT of v in place.
+func Butterfly13Complex64(v *[13]complex64, inverse bool) {
+	butterfly13Complex64(v, inverse)
+}
+
+// Butterfly13Complex128 computes a radix-13 DFT of v in place.
+func Butterfly13Complex128(v *[13]complex128, inverse bool) {
+	butterfly13Complex128(v, inverse)
+}
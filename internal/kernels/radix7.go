@@ -0,0 +1,136 @@
+package kernels
+
+// Radix-7 DFT constants: cos and sin of 2πq/7 for q = 1..3.
+const (
+	radix7C1 = 0.62348980185873359439  // cos(2π/7)
+	radix7C2 = -0.22252093395631433737 // cos(2·2π/7)
+	radix7C3 = -0.90096886790241903498 // cos(3·2π/7)
+	radix7S1 = 0.78183148246802980363  // sin(2π/7)
+	radix7S2 = 0.97492791218182361934  // sin(2·2π/7)
+	radix7S3 = 0.43388373911755823142  // sin(3·2π/7)
+)
+
+// butterfly7Complex64 computes a radix-7 DFT of v in place (inverse selects the
+// conjugate kernel, without 1/7 scaling). It folds the inputs into 3
+// conjugate pairs t = v[j]+v[7-j], u = v[j]-v[7-j], so that each output
+// pair y[k], y[7-k] shares one cosine sum over t and one sine sum over u.
+func butterfly7Complex64(v *[7]complex64, inverse bool) {
+	x0r, x0i := real(v[0]), imag(v[0])
+
+	t1r, t1i := real(v[1])+real(v[6]), imag(v[1])+imag(v[6])
+	u1r, u1i := real(v[1])-real(v[6]), imag(v[1])-imag(v[6])
+	t2r, t2i := real(v[2])+real(v[5]), imag(v[2])+imag(v[5])
+	u2r, u2i := real(v[2])-real(v[5]), imag(v[2])-imag(v[5])
+	t3r, t3i := real(v[3])+real(v[4]), imag(v[3])+imag(v[4])
+	u3r, u3i := real(v[3])-real(v[4]), imag(v[3])-imag(v[4])
+
+	v[0] = complex(x0r+t1r+t2r+t3r, x0i+t1i+t2i+t3i)
+
+	a1r := x0r + radix7C1*t1r + radix7C2*t2r + radix7C3*t3r
+	a1i := x0i + radix7C1*t1i + radix7C2*t2i + radix7C3*t3i
+	b1r := radix7S1*u1r + radix7S2*u2r + radix7S3*u3r
+	b1i := radix7S1*u1i + radix7S2*u2i + radix7S3*u3i
+
+	if inverse {
+		b1r, b1i = -b1r, -b1i
+	}
+
+	v[1] = complex(a1r+b1i, a1i-b1r)
+	v[6] = complex(a1r-b1i, a1i+b1r)
+
+	a2r := x0r + radix7C2*t1r + radix7C3*t2r + radix7C1*t3r
+	a2i := x0i + radix7C2*t1i + radix7C3*t2i + radix7C1*t3i
+	b2r := radix7S2*u1r - radix7S3*u2r - radix7S1*u3r
+	b2i := radix7S2*u1i - radix7S3*u2i - radix7S1*u3i
+
+	if inverse {
+		b2r, b2i = -b2r, -b2i
+	}
+
+	v[2] = complex(a2r+b2i, a2i-b2r)
+	v[5] = complex(a2r-b2i, a2i+b2r)
+
+	a3r := x0r + radix7C3*t1r + radix7C1*t2r + radix7C2*t3r
+	a3i := x0i + radix7C3*t1i + radix7C1*t2i + radix7C2*t3i
+	b3r := radix7S3*u1r - radix7S1*u2r + radix7S2*u3r
+	b3i := radix7S3*u1i - radix7S1*u2i + radix7S2*u3i
+
+	if inverse {
+		b3r, b3i = -b3r, -b3i
+	}
+
+	v[3] = complex(a3r+b3i, a3i-b3r)
+	v[4] = complex(a3r-b3i, a3i+b3r)
+}
+
+// butterfly7Complex128 is the complex128 variant of butterfly7Complex64.
+func butterfly7Complex128(v *[7]complex128, inverse bool) {
+	x0r, x0i := real(v[0]), imag(v[0])
+
+	t1r, t1i := real(v[1])+real(v[6]), imag(v[1])+imag(v[6])
+	u1r, u1i := real(v[1])-real(v[6]), imag(v[1])-imag(v[6])
+	t2r, t2i := real(v[2])+real(v[5]), imag(v[2])+imag(v[5])
+	u2r, u2i := real(v[2])-real(v[5]), imag(v[2])-imag(v[5])
+	t3r, t3i := real(v[3])+real(v[4]), imag(v[3])+imag(v[4])
+	u3r, u3i := real(v[3])-real(v[4]), imag(v[3])-imag(v[4])
+
+	v[0] = complex(x0r+t1r+t2r+t3r, x0i+t1i+t2i+t3i)
+
+	a1r := x0r + radix7C1*t1r + radix7C2*t2r + radix7C3*t3r
+	a1i := x0i + radix7C1*t1i + radix7C2*t2i + radix7C3*t3i
+	b1r := radix7S1*u1r + radix7S2*u2r + radix7S3*u3r
+	b1i := radix7S1*u1i + radix7S2*u2i + radix7S3*u3i
+
+	if inverse {
+		b1r, b1i = -b1r, -b1i
+	}
+
+	v[1] = complex(a1r+b1i, a1i-b1r)
+	v[6] = complex(a1r-b1i, a1i+b1r)
+
+	a2r := x0r + radix7C2*t1r + radix7C3*t2r + radix7C1*t3r
+	a2i := x0i + radix7C2*t1i + radix7C3*t2i + radix7C1*t3i
+	b2r := radix7S2*u1r - radix7S3*u2r - radix7S1*u3r
+	b2i := radix7S2*u1i - radix7S3*u2i - radix7S1*u3i
+
+	if inverse {
+		b2r, b2i = -b2r, -b2i
+	}
+
+	v[2] = complex(a2r+b2i, a2i-b2r)
+	v[5] = complex(a2r-b2i, a2i+b2r)
+
+	a3r := x0r + radix7C3*t1r + radix7C1*t2r + radix7C2*t3r
+	a3i := x0i + radix7C3*t1i + radix7C1*t2i + radix7C2*t3i
+	b3r := radix7S3*u1r - radix7S1*u2r + radix7S2*u3r
+	b3i := radix7S3*u1i - radix7S1*u2i + radix7S2*u3i
+
+	if inverse {
+		b3r, b3i = -b3r, -b3i
+	}
+
+	v[3] = complex(a3r+b3i, a3i-b3r)
+	v[4] = complex(a3r-b3i, a3i+b3r)
+}
+
+// Butterfly7 computes a radix-7 DFT of v in place; see butterfly7Complex64.
+func Butterfly7[T Complex](v *[7]T, inverse bool) {
+	switch vv := any(v).(type) {
+	case *[7]complex64:
+		butterfly7Complex64(vv, inverse)
+	case *[7]complex128:
+		butterfly7Complex128(vv, inverse)
+	default:
+		panic("unsupported complex type")
+	}
+}
+
+// Butterfly7Complex64 computes a radix-7 DFT of v in place.
+func Butterfly7Complex64(v *[7]complex64, inverse bool) {
+	butterfly7Complex64(v, inverse)
+}
+
+// Butterfly7Complex128 computes a radix-7 DFT of v in place.
+func Butterfly7Complex128(v *[7]complex128, inverse bool) {
+	butterfly7Complex128(v, inverse)
+}
@@ -0,0 +1,114 @@
+package kernels
+
+import "math"
+
+// radixOddTable holds the m×m cosine and sine tables of the AVX2 odd-radix
+// butterfly for radix r = 2m+1, indexed [(k-1)*m + (j-1)] for j, k = 1..m.
+type radixOddTable struct {
+	cos    []float32
+	sin    []float32
+	sinInv []float32
+}
+
+//nolint:gochecknoglobals
+var radixOddTables [14]radixOddTable
+
+//nolint:gochecknoinits
+func init() {
+	for _, r := range []int{7, 11, 13} {
+		m := (r - 1) / 2
+		table := radixOddTable{
+			cos:    make([]float32, m*m),
+			sin:    make([]float32, m*m),
+			sinInv: make([]float32, m*m),
+		}
+
+		for k := 1; k <= m; k++ {
+			for j := 1; j <= m; j++ {
+				angle := 2 * math.Pi * float64(j*k%r) / float64(r)
+				idx := (k-1)*m + (j - 1)
+				table.cos[idx] = float32(math.Cos(angle))
+				table.sin[idx] = float32(math.Sin(angle))
+				table.sinInv[idx] = -table.sin[idx]
+			}
+		}
+
+		radixOddTables[r] = table
+	}
+}
+
+// ButterflyOddComplex64 computes a radix-7, 11 or 13 DFT of v in place and
+// reports whether len(v) is one of those radices.
+func ButterflyOddComplex64(v []complex64, inverse bool) bool {
+	switch len(v) {
+	case 7:
+		butterfly7Complex64((*[7]complex64)(v), inverse)
+	case 11:
+		butterfly11Complex64((*[11]complex64)(v), inverse)
+	case 13:
+		butterfly13Complex64((*[13]complex64)(v), inverse)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// ButterflyOddComplex128 computes a radix-7, 11 or 13 DFT of v in place and
+// reports whether len(v) is one of those radices.
+func ButterflyOddComplex128(v []complex128, inverse bool) bool {
+	switch len(v) {
+	case 7:
+		butterfly7Complex128((*[7]complex128)(v), inverse)
+	case 11:
+		butterfly11Complex128((*[11]complex128)(v), inverse)
+	case 13:
+		butterfly13Complex128((*[13]complex128)(v), inverse)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// ButterflyOdd is the generic form of ButterflyOddComplex64/128.
+func ButterflyOdd[T Complex](v []T, inverse bool) bool {
+	switch vv := any(v).(type) {
+	case []complex64:
+		return ButterflyOddComplex64(vv, inverse)
+	case []complex128:
+		return ButterflyOddComplex128(vv, inverse)
+	default:
+		return false
+	}
+}
+
+// ButterflyOddAVX2Available reports whether ButterflyOdd4Complex64 can run.
+func ButterflyOddAVX2Available() bool {
+	return radixOddAVX2Available()
+}
+
+// ButterflyOdd4Complex64 computes four radix-r DFTs (r = 7, 11 or 13) in
+// parallel with AVX2. a and y hold r rows of four lanes, a[4*j+l]; a is
+// overwritten. It returns false without touching y if AVX2 is unavailable
+// or the arguments do not fit.
+func ButterflyOdd4Complex64(y, a []complex64, radix int, inverse bool) bool {
+	if radix < 0 || radix >= len(radixOddTables) || radixOddTables[radix].cos == nil {
+		return false
+	}
+
+	if len(y) < 4*radix || len(a) < 4*radix || !radixOddAVX2Available() {
+		return false
+	}
+
+	table := &radixOddTables[radix]
+
+	sin := table.sin
+	if inverse {
+		sin = table.sinInv
+	}
+
+	butterflyOddAVX2Complex64(y, a, table.cos, sin, radix)
+
+	return true
+}
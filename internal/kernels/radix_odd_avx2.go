@@ -0,0 +1,17 @@
+//go:build amd64 && asm && !purego
+
+package kernels
+
+import (
+	amd64 "github.com/cwbudde/algo-fft/internal/asm/amd64"
+	"github.com/cwbudde/algo-fft/internal/cpu"
+)
+
+func radixOddAVX2Available() bool {
+	features := cpu.DetectFeatures()
+	return features.HasAVX2 && !features.ForceGeneric
+}
+
+func butterflyOddAVX2Complex64(y, a []complex64, cos, sin []float32, radix int) {
+	amd64.ButterflyOddAVX2Complex64(y, a, cos, sin, radix)
+}
@@ -0,0 +1,10 @@
+//go:build !amd64 || !asm || purego
+
+package kernels
+
+func radixOddAVX2Available() bool {
+	return false
+}
+
+func butterflyOddAVX2Complex64(y, a []complex64, cos, sin []float32, radix int) {
+}
@@ -0,0 +1,38 @@
+//go:build amd64 && asm && !purego
+
+package kernels
+
+import "testing"
+
+func TestButterflyOdd4AVX2MatchesScalar(t *testing.T) {
+	t.Parallel()
+
+	if !ButterflyOddAVX2Available() {
+		t.Skip("AVX2 not available")
+	}
+
+	for _, radix := range []int{7, 11, 13} {
+		for _, inverse := range []bool{false, true} {
+			src := randomComplex64(4*radix, 0xA0D+uint64(radix))
+			a := append([]complex64(nil), src...)
+			y := make([]complex64, 4*radix)
+
+			if !ButterflyOdd4Complex64(y, a, radix, inverse) {
+				t.Fatalf("ButterflyOdd4Complex64 rejected radix %d", radix)
+			}
+
+			for lane := range 4 {
+				v := make([]complex64, radix)
+				got := make([]complex64, radix)
+
+				for j := range radix {
+					v[j] = src[4*j+lane]
+					got[j] = y[4*j+lane]
+				}
+
+				ButterflyOddComplex64(v, inverse)
+				assertComplex64Close(t, got, v, 1e-4)
+			}
+		}
+	}
+}
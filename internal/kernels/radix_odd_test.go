@@ -0,0 +1,44 @@
+package kernels
+
+import (
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+func TestButterflyOddMatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, radix := range []int{7, 11, 13} {
+		src64 := randomComplex64(radix, 0x0DD+uint64(radix))
+		src128 := randomComplex128(radix, 0x0DD+uint64(radix))
+
+		got64 := append([]complex64(nil), src64...)
+		if !ButterflyOddComplex64(got64, false) {
+			t.Fatalf("ButterflyOddComplex64 rejected radix %d", radix)
+		}
+
+		assertComplex64Close(t, got64, reference.NaiveDFT(src64), 1e-4)
+
+		got128 := append([]complex128(nil), src128...)
+		if !ButterflyOdd(got128, false) {
+			t.Fatalf("ButterflyOdd rejected radix %d", radix)
+		}
+
+		assertComplex128Close(t, got128, reference.NaiveDFT128(src128), 1e-12)
+
+		// The inverse butterfly is unscaled.
+		want := reference.NaiveIDFT128(src128)
+		for i := range want {
+			want[i] *= complex(float64(radix), 0)
+		}
+
+		copy(got128, src128)
+		ButterflyOddComplex128(got128, true)
+		assertComplex128Close(t, got128, want, 1e-12)
+	}
+
+	if ButterflyOddComplex64(make([]complex64, 9), false) {
+		t.Error("ButterflyOddComplex64 accepted radix 9")
+	}
+}
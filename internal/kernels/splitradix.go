@@ -0,0 +1,267 @@
+package kernels
+
+// Split-radix (2/4) decimation-in-time FFT for power-of-two sizes.
+//
+// Each step splits x into the even samples and the two odd quarters
+// x[4m+1] and x[4m+3]:
+//
+//	U  = DFT_{n/2}(x[2m])
+//	Z  = DFT_{n/4}(x[4m+1]),  Z' = DFT_{n/4}(x[4m+3])
+//	X[k]       = U[k]     + (W^k Z[k] + W^3k Z'[k])
+//	X[k+n/2]   = U[k]     - (W^k Z[k] + W^3k Z'[k])
+//	X[k+n/4]   = U[k+n/4] - i(W^k Z[k] - W^3k Z'[k])
+//	X[k+3n/4]  = U[k+n/4] + i(W^k Z[k] - W^3k Z'[k])
+//
+// which needs the fewest real operations of the power-of-two algorithms
+// (about 4n·log2(n) - 6n + 8). The recursion reads its input with a growing
+// stride and writes the output in natural order, so no bit-reversal pass is
+// needed. It is written out per precision to keep complex arithmetic
+// inlined; the kernels are used on platforms without SIMD codelets.
+
+// ForwardSplitRadixComplex64 performs a forward split-radix FFT on complex64 data.
+func ForwardSplitRadixComplex64(dst, src, twiddle, scratch []complex64) bool {
+	in, ok := splitRadixInput(dst, src, twiddle, scratch)
+	if !ok {
+		return false
+	}
+
+	splitRadixComplex64(dst[:len(in)], in, 1, twiddle, 1, false)
+
+	return true
+}
+
+// InverseSplitRadixComplex64 performs an inverse split-radix FFT on complex64
+// data, including the 1/N scaling.
+func InverseSplitRadixComplex64(dst, src, twiddle, scratch []complex64) bool {
+	in, ok := splitRadixInput(dst, src, twiddle, scratch)
+	if !ok {
+		return false
+	}
+
+	n := len(in)
+	splitRadixComplex64(dst[:n], in, 1, twiddle, 1, true)
+
+	scale := float32(1) / float32(n)
+	for i := range dst[:n] {
+		dst[i] = complex(real(dst[i])*scale, imag(dst[i])*scale)
+	}
+
+	return true
+}
+
+// ForwardSplitRadixComplex128 performs a forward split-radix FFT on complex128 data.
+func ForwardSplitRadixComplex128(dst, src, twiddle, scratch []complex128) bool {
+	in, ok := splitRadixInput(dst, src, twiddle, scratch)
+	if !ok {
+		return false
+	}
+
+	splitRadixComplex128(dst[:len(in)], in, 1, twiddle, 1, false)
+
+	return true
+}
+
+// InverseSplitRadixComplex128 performs an inverse split-radix FFT on
+// complex128 data, including the 1/N scaling.
+func InverseSplitRadixComplex128(dst, src, twiddle, scratch []complex128) bool {
+	in, ok := splitRadixInput(dst, src, twiddle, scratch)
+	if !ok {
+		return false
+	}
+
+	n := len(in)
+	splitRadixComplex128(dst[:n], in, 1, twiddle, 1, true)
+
+	scale := 1 / float64(n)
+	for i := range dst[:n] {
+		dst[i] = complex(real(dst[i])*scale, imag(dst[i])*scale)
+	}
+
+	return true
+}
+
+// splitRadixInput validates the kernel arguments and returns the input to
+// read from: src itself, or a copy in scratch when the transform runs in
+// place.
+func splitRadixInput[T Complex](dst, src, twiddle, scratch []T) ([]T, bool) {
+	n := len(src)
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n {
+		return nil, false
+	}
+
+	if n > 0 && !IsPowerOf2(n) {
+		return nil, false
+	}
+
+	if sameSlice(dst[:n], src) {
+		copy(scratch, src)
+		return scratch[:n], true
+	}
+
+	return src, true
+}
+
+// splitRadixComplex64 writes the DFT of in[0], in[stride], ... to out, whose
+// length gives the transform size. twiddle[k*tstride] is W_len(out)^k.
+//
+//nolint:dupl
+func splitRadixComplex64(out, in []complex64, stride int, twiddle []complex64, tstride int, inverse bool) {
+	n := len(out)
+
+	switch n {
+	case 0:
+		return
+	case 1:
+		out[0] = in[0]
+		return
+	case 2:
+		a, b := in[0], in[stride]
+		out[0] = a + b
+		out[1] = a - b
+
+		return
+	case 4:
+		a0, a1, a2, a3 := in[0], in[stride], in[2*stride], in[3*stride]
+		t0, t1 := a0+a2, a0-a2
+		t2, t3 := a1+a3, a1-a3
+
+		// r = -i·t3 forward, +i·t3 inverse
+		r := complex(imag(t3), -real(t3))
+		if inverse {
+			r = -r
+		}
+
+		out[0] = t0 + t2
+		out[1] = t1 + r
+		out[2] = t0 - t2
+		out[3] = t1 - r
+
+		return
+	}
+
+	half := n >> 1
+	quarter := n >> 2
+
+	splitRadixComplex64(out[:half], in, 2*stride, twiddle, 2*tstride, inverse)
+	splitRadixComplex64(out[half:half+quarter], in[stride:], 4*stride, twiddle, 4*tstride, inverse)
+	splitRadixComplex64(out[half+quarter:n], in[3*stride:], 4*stride, twiddle, 4*tstride, inverse)
+
+	u0 := out[:quarter]
+	u1 := out[quarter:half]
+	z1 := out[half : half+quarter]
+	z3 := out[half+quarter : n]
+
+	if inverse {
+		for k := range quarter {
+			w1 := twiddle[k*tstride]
+			w3 := twiddle[3*k*tstride]
+			a := z1[k] * complex(real(w1), -imag(w1))
+			b := z3[k] * complex(real(w3), -imag(w3))
+			s, d := a+b, a-b
+			id := complex(-imag(d), real(d))
+			x0, x1 := u0[k], u1[k]
+			u0[k] = x0 + s
+			z1[k] = x0 - s
+			u1[k] = x1 + id
+			z3[k] = x1 - id
+		}
+
+		return
+	}
+
+	for k := range quarter {
+		w1 := twiddle[k*tstride]
+		w3 := twiddle[3*k*tstride]
+		a := z1[k] * w1
+		b := z3[k] * w3
+		s, d := a+b, a-b
+		id := complex(-imag(d), real(d))
+		x0, x1 := u0[k], u1[k]
+		u0[k] = x0 + s
+		z1[k] = x0 - s
+		u1[k] = x1 - id
+		z3[k] = x1 + id
+	}
+}
+
+// splitRadixComplex128 is the complex128 version of splitRadixComplex64.
+//
+//nolint:dupl
+func splitRadixComplex128(out, in []complex128, stride int, twiddle []complex128, tstride int, inverse bool) {
+	n := len(out)
+
+	switch n {
+	case 0:
+		return
+	case 1:
+		out[0] = in[0]
+		return
+	case 2:
+		a, b := in[0], in[stride]
+		out[0] = a + b
+		out[1] = a - b
+
+		return
+	case 4:
+		a0, a1, a2, a3 := in[0], in[stride], in[2*stride], in[3*stride]
+		t0, t1 := a0+a2, a0-a2
+		t2, t3 := a1+a3, a1-a3
+
+		// r = -i·t3 forward, +i·t3 inverse
+		r := complex(imag(t3), -real(t3))
+		if inverse {
+			r = -r
+		}
+
+		out[0] = t0 + t2
+		out[1] = t1 + r
+		out[2] = t0 - t2
+		out[3] = t1 - r
+
+		return
+	}
+
+	half := n >> 1
+	quarter := n >> 2
+
+	splitRadixComplex128(out[:half], in, 2*stride, twiddle, 2*tstride, inverse)
+	splitRadixComplex128(out[half:half+quarter], in[stride:], 4*stride, twiddle, 4*tstride, inverse)
+	splitRadixComplex128(out[half+quarter:n], in[3*stride:], 4*stride, twiddle, 4*tstride, inverse)
+
+	u0 := out[:quarter]
+	u1 := out[quarter:half]
+	z1 := out[half : half+quarter]
+	z3 := out[half+quarter : n]
+
+	if inverse {
+		for k := range quarter {
+			w1 := twiddle[k*tstride]
+			w3 := twiddle[3*k*tstride]
+			a := z1[k] * complex(real(w1), -imag(w1))
+			b := z3[k] * complex(real(w3), -imag(w3))
+			s, d := a+b, a-b
+			id := complex(-imag(d), real(d))
+			x0, x1 := u0[k], u1[k]
+			u0[k] = x0 + s
+			z1[k] = x0 - s
+			u1[k] = x1 + id
+			z3[k] = x1 - id
+		}
+
+		return
+	}
+
+	for k := range quarter {
+		w1 := twiddle[k*tstride]
+		w3 := twiddle[3*k*tstride]
+		a := z1[k] * w1
+		b := z3[k] * w3
+		s, d := a+b, a-b
+		id := complex(-imag(d), real(d))
+		x0, x1 := u0[k], u1[k]
+		u0[k] = x0 + s
+		z1[k] = x0 - s
+		u1[k] = x1 - id
+		z3[k] = x1 + id
+	}
+}
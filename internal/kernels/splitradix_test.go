@@ -0,0 +1,93 @@
+package kernels
+
+import (
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+func TestSplitRadixComplex64(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 2048} {
+		t.Run(testName("forward", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex64(n, 0x12345678+uint64(n))
+			dst := make([]complex64, n)
+			scratch := make([]complex64, n)
+			twiddle := ComputeTwiddleFactors[complex64](n)
+
+			if !ForwardSplitRadixComplex64(dst, src, twiddle, scratch) {
+				t.Fatalf("ForwardSplitRadixComplex64 failed for n=%d", n)
+			}
+
+			assertComplex64Close(t, dst, reference.NaiveDFT(src), 1e-4)
+		})
+
+		t.Run(testName("inverse_inplace", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex64(n, 0x87654321+uint64(n))
+			data := append([]complex64(nil), src...)
+			scratch := make([]complex64, n)
+			twiddle := ComputeTwiddleFactors[complex64](n)
+
+			if !InverseSplitRadixComplex64(data, data, twiddle, scratch) {
+				t.Fatalf("InverseSplitRadixComplex64 failed for n=%d", n)
+			}
+
+			assertComplex64Close(t, data, reference.NaiveIDFT(src), 1e-4)
+		})
+	}
+}
+
+func TestSplitRadixComplex128(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 2048} {
+		t.Run(testName("forward_inplace", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex128(n, 0x12345678+uint64(n))
+			data := append([]complex128(nil), src...)
+			scratch := make([]complex128, n)
+			twiddle := ComputeTwiddleFactors[complex128](n)
+
+			if !ForwardSplitRadixComplex128(data, data, twiddle, scratch) {
+				t.Fatalf("ForwardSplitRadixComplex128 failed for n=%d", n)
+			}
+
+			assertComplex128Close(t, data, reference.NaiveDFT128(src), 1e-10)
+		})
+
+		t.Run(testName("inverse", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex128(n, 0x87654321+uint64(n))
+			dst := make([]complex128, n)
+			scratch := make([]complex128, n)
+			twiddle := ComputeTwiddleFactors[complex128](n)
+
+			if !InverseSplitRadixComplex128(dst, src, twiddle, scratch) {
+				t.Fatalf("InverseSplitRadixComplex128 failed for n=%d", n)
+			}
+
+			assertComplex128Close(t, dst, reference.NaiveIDFT128(src), 1e-10)
+		})
+	}
+}
+
+func TestSplitRadixRejectsInvalid(t *testing.T) {
+	t.Parallel()
+
+	twiddle := ComputeTwiddleFactors[complex64](12)
+
+	if ForwardSplitRadixComplex64(make([]complex64, 12), make([]complex64, 12), twiddle, make([]complex64, 12)) {
+		t.Error("ForwardSplitRadixComplex64 accepted n=12")
+	}
+
+	if InverseSplitRadixComplex64(make([]complex64, 8), make([]complex64, 8), twiddle, make([]complex64, 4)) {
+		t.Error("InverseSplitRadixComplex64 accepted a short scratch")
+	}
+}
@@ -0,0 +1,755 @@
+package kernels
+
+import mathpkg "github.com/cwbudde/algo-fft/internal/math"
+
+// Portable radix-8/4 Stockham kernels for power-of-two sizes.
+//
+// These are the tuned pure-Go kernels for targets without SIMD codelets
+// (riscv64, ppc64le, s390x, loong64, wasm, and any build with
+// cpu.Features.ForceGeneric). Each decimation-in-frequency stage of length l
+// and stride s = n/l reads x[q + s·(p + k·l/r)] and writes
+// y[q + s·(r·p + k)], so:
+//
+//   - the output is in natural order without a bit-reversal pass,
+//   - the twiddles W_n^(k·p·s) are loaded once per block p and reused across
+//     the contiguous inner loop over q,
+//   - radix-8 stages need a third of the passes over memory of radix-2, with
+//     radix-4 stages absorbing the remaining factors.
+//
+// The inverse runs the forward butterflies: output k of the inverse butterfly
+// is output (r-k) mod r of the forward one, so a block only permutes its
+// output slots and conjugates its twiddles, keeping the inner loops free of
+// direction branches. Like the split-radix kernels the stages are written out
+// per precision to keep the complex arithmetic inlined.
+
+// 1/√2, the magnitude of the components of W_8.
+const (
+	sqrtHalf32 = float32(0.707106781186547524400844362104849039284)
+	sqrtHalf   = 0.707106781186547524400844362104849039284
+)
+
+// stockhamPortableRadix returns the radix of the next stage when bits
+// factors of two remain: radix-8 stages, preceded by one radix-4 stage (or
+// two, instead of a radix-2 one) covering the rest.
+func stockhamPortableRadix(bits int) int {
+	switch {
+	case bits%3 == 0:
+		return 8
+	case bits == 1:
+		return 2
+	default:
+		return 4
+	}
+}
+
+// stockhamPortableStages returns the number of stages of a 2^bits-point
+// transform.
+func stockhamPortableStages(bits int) int {
+	stages := 0
+
+	for bits > 0 {
+		bits -= log2(stockhamPortableRadix(bits))
+		stages++
+	}
+
+	return stages
+}
+
+// stockhamPortableBuffers validates the kernel arguments and returns the
+// first stage input. Stages alternate between dst and scratch so that the
+// last one writes dst; when the first stage would overwrite its own input,
+// the input is copied to scratch first.
+func stockhamPortableBuffers[T Complex](dst, src, twiddle, scratch []T) ([]T, bool) {
+	n := len(src)
+	if len(dst) < n || len(twiddle) < n || len(scratch) < n {
+		return nil, false
+	}
+
+	if n < 2 {
+		copy(dst, src)
+		return src, true
+	}
+
+	if !mathpkg.IsPowerOf2(n) {
+		return nil, false
+	}
+
+	if stockhamPortableStages(log2(n))%2 == 1 && sameSlice(dst[:n], src) {
+		copy(scratch, src)
+		return scratch[:n], true
+	}
+
+	return src, true
+}
+
+// stockhamPortableOutput returns the buffer written by stage i of count.
+func stockhamPortableOutput[T Complex](dst, scratch []T, i, count int) []T {
+	if (count-1-i)%2 == 0 {
+		return dst
+	}
+
+	return scratch
+}
+
+// mulStockhamComplex64 returns a·w. Go evaluates complex64 products in
+// double precision; spelling the product out keeps it in float32.
+func mulStockhamComplex64(a, w complex64) complex64 {
+	return complex(real(a)*real(w)-imag(a)*imag(w), real(a)*imag(w)+imag(a)*real(w))
+}
+
+// mulStockhamComplex128 returns a·w.
+func mulStockhamComplex128(a, w complex128) complex128 {
+	return complex(real(a)*real(w)-imag(a)*imag(w), real(a)*imag(w)+imag(a)*real(w))
+}
+
+// forwardStockhamPortableComplex64 performs a forward portable Stockham FFT
+// on complex64 data.
+func forwardStockhamPortableComplex64(dst, src, twiddle, scratch []complex64) bool {
+	return stockhamPortableComplex64(dst, src, twiddle, scratch, false)
+}
+
+// inverseStockhamPortableComplex64 performs an inverse portable Stockham FFT
+// on complex64 data, including the 1/N scaling.
+func inverseStockhamPortableComplex64(dst, src, twiddle, scratch []complex64) bool {
+	if !stockhamPortableComplex64(dst, src, twiddle, scratch, true) {
+		return false
+	}
+
+	n := len(src)
+	scale := float32(1) / float32(n)
+
+	for i := range dst[:n] {
+		dst[i] = complex(real(dst[i])*scale, imag(dst[i])*scale)
+	}
+
+	return true
+}
+
+func stockhamPortableComplex64(dst, src, twiddle, scratch []complex64, inverse bool) bool {
+	in, ok := stockhamPortableBuffers(dst, src, twiddle, scratch)
+	if !ok {
+		return false
+	}
+
+	n := len(in)
+	twiddle = twiddle[:n]
+	bits := log2(n)
+	stages := stockhamPortableStages(bits)
+	stride := 1
+
+	for i := range stages {
+		out := stockhamPortableOutput(dst, scratch, i, stages)[:n]
+		radix := stockhamPortableRadix(bits)
+		l := n / stride
+
+		switch {
+		case radix == 8 && stride == 1:
+			stockhamFirstStage8Complex64(out, in, twiddle, inverse)
+		case radix == 8 && l == 8:
+			stockhamLastStage8Complex64(out, in, stride, inverse)
+		case radix == 8:
+			stockhamStage8Complex64(out, in, twiddle, l, stride, inverse)
+		case radix == 4 && stride == 1:
+			stockhamFirstStage4Complex64(out, in, twiddle, inverse)
+		case radix == 4:
+			stockhamStage4Complex64(out, in, twiddle, l, stride, inverse)
+		default:
+			stockhamStage2Complex64(out, in, stride)
+		}
+
+		in = out
+		stride *= radix
+		bits -= log2(radix)
+	}
+
+	return true
+}
+
+// stockhamStage2Complex64 is a final radix-2 stage (l = 2, no twiddles).
+func stockhamStage2Complex64(y, x []complex64, s int) {
+	x0, x1 := x[:s], x[s:2*s]
+	y0, y1 := y[:s], y[s:2*s]
+
+	for q := range s {
+		a, b := x0[q], x1[q]
+		y0[q] = a + b
+		y1[q] = a - b
+	}
+}
+
+// stockhamStage4Complex64 runs a radix-4 stage of length l and stride s.
+//
+//nolint:dupl
+func stockhamStage4Complex64(y, x, twiddle []complex64, l, s int, inverse bool) {
+	m := l / 4
+	xs := m * s
+
+	for p := range m {
+		ps := p * s
+		w1, w2, w3 := twiddle[ps], twiddle[2*ps], twiddle[3*ps]
+
+		// Forward outputs 1 and 3 trade places in the inverse.
+		o1, o3 := 1, 3
+		if inverse {
+			w1, w2, w3 = complex(real(w3), -imag(w3)), complex(real(w2), -imag(w2)), complex(real(w1), -imag(w1))
+			o1, o3 = 3, 1
+		}
+
+		in := x[ps:]
+		x0, x1, x2, x3 := in[:s], in[xs:xs+s], in[2*xs:2*xs+s], in[3*xs:3*xs+s]
+
+		out := y[4*ps : 4*ps+4*s]
+		y0, y1, y2, y3 := out[:s], out[o1*s:o1*s+s], out[2*s:3*s], out[o3*s:o3*s+s]
+
+		if p == 0 {
+			for q := range s {
+				a, b, c, d := x0[q], x1[q], x2[q], x3[q]
+				apc, amc := a+c, a-c
+				bpd, bmd := b+d, b-d
+				ib := complex(-imag(bmd), real(bmd))
+				y0[q] = apc + bpd
+				y1[q] = amc - ib
+				y2[q] = apc - bpd
+				y3[q] = amc + ib
+			}
+
+			continue
+		}
+
+		for q := range s {
+			a, b, c, d := x0[q], x1[q], x2[q], x3[q]
+			apc, amc := a+c, a-c
+			bpd, bmd := b+d, b-d
+			ib := complex(-imag(bmd), real(bmd))
+			y0[q] = apc + bpd
+			y1[q] = mulStockhamComplex64(amc-ib, w1)
+			y2[q] = mulStockhamComplex64(apc-bpd, w2)
+			y3[q] = mulStockhamComplex64(amc+ib, w3)
+		}
+	}
+}
+
+// stockhamStage8Complex64 runs a radix-8 stage of length l and stride s.
+//
+//nolint:dupl,funlen
+func stockhamStage8Complex64(y, x, twiddle []complex64, l, s int, inverse bool) {
+	m := l / 8
+	xs := m * s
+
+	for p := range m {
+		ps := p * s
+		w1, w2, w3, w4 := twiddle[ps], twiddle[2*ps], twiddle[3*ps], twiddle[4*ps]
+		w5, w6, w7 := twiddle[5*ps], twiddle[6*ps], twiddle[7*ps]
+
+		// Forward output k lands in slot (8-k) mod 8 in the inverse.
+		o1, o2, o3, o5, o6, o7 := 1, 2, 3, 5, 6, 7
+		if inverse {
+			w1, w7 = complex(real(w7), -imag(w7)), complex(real(w1), -imag(w1))
+			w2, w6 = complex(real(w6), -imag(w6)), complex(real(w2), -imag(w2))
+			w3, w5 = complex(real(w5), -imag(w5)), complex(real(w3), -imag(w3))
+			w4 = complex(real(w4), -imag(w4))
+			o1, o2, o3, o5, o6, o7 = 7, 6, 5, 3, 2, 1
+		}
+
+		in := x[ps:]
+		x0, x1, x2, x3 := in[:s], in[xs:xs+s], in[2*xs:2*xs+s], in[3*xs:3*xs+s]
+		x4, x5, x6, x7 := in[4*xs:4*xs+s], in[5*xs:5*xs+s], in[6*xs:6*xs+s], in[7*xs:7*xs+s]
+
+		out := y[8*ps : 8*ps+8*s]
+		y0, y1, y2, y3 := out[:s], out[o1*s:o1*s+s], out[o2*s:o2*s+s], out[o3*s:o3*s+s]
+		y4, y5, y6, y7 := out[4*s:5*s], out[o5*s:o5*s+s], out[o6*s:o6*s+s], out[o7*s:o7*s+s]
+
+		for q := range s {
+			// Radix-2 split: b = a_j + a_j+4, d = a_j - a_j+4, then the odd
+			// half is rotated by W_8^j: c1 = d1·W_8, c2 = -i·d2, c3 = -i·W_8·d3.
+			a0, a1, a2, a3 := x0[q], x1[q], x2[q], x3[q]
+			a4, a5, a6, a7 := x4[q], x5[q], x6[q], x7[q]
+			b0, b1, b2, b3 := a0+a4, a1+a5, a2+a6, a3+a7
+			d0, d1, d2, d3 := a0-a4, a1-a5, a2-a6, a3-a7
+
+			c1 := complex((real(d1)+imag(d1))*sqrtHalf32, (imag(d1)-real(d1))*sqrtHalf32)
+			c2 := complex(imag(d2), -real(d2))
+			c3 := complex((imag(d3)-real(d3))*sqrtHalf32, -(real(d3)+imag(d3))*sqrtHalf32)
+
+			// Two 4-point DFTs give the even and the odd outputs.
+			bp02, bm02 := b0+b2, b0-b2
+			bp13, bm13 := b1+b3, b1-b3
+			ib := complex(-imag(bm13), real(bm13))
+			cp02, cm02 := d0+c2, d0-c2
+			cp13, cm13 := c1+c3, c1-c3
+			ic := complex(-imag(cm13), real(cm13))
+
+			y0[q] = bp02 + bp13
+			y1[q] = mulStockhamComplex64(cp02+cp13, w1)
+			y2[q] = mulStockhamComplex64(bm02-ib, w2)
+			y3[q] = mulStockhamComplex64(cm02-ic, w3)
+			y4[q] = mulStockhamComplex64(bp02-bp13, w4)
+			y5[q] = mulStockhamComplex64(cp02-cp13, w5)
+			y6[q] = mulStockhamComplex64(bm02+ib, w6)
+			y7[q] = mulStockhamComplex64(cm02+ic, w7)
+		}
+	}
+}
+
+// stockhamFirstStage4Complex64 is stockhamStage4Complex64 for the first
+// stage (s = 1), where every block holds a single butterfly: it walks the
+// four input quarters contiguously and loads the twiddles per butterfly.
+//
+//nolint:dupl
+func stockhamFirstStage4Complex64(y, x, twiddle []complex64, inverse bool) {
+	m := len(x) / 4
+	x0, x1, x2, x3 := x[:m], x[m:2*m], x[2*m:3*m], x[3*m:4*m]
+	y = y[:4*m]
+
+	// Forward outputs 1 and 3 trade places in the inverse.
+	o1, o3 := 1, 3
+	if inverse {
+		o1, o3 = 3, 1
+	}
+
+	for p := range m {
+		w1, w2, w3 := twiddle[p], twiddle[2*p], twiddle[3*p]
+		if inverse {
+			w1, w2, w3 = complex(real(w3), -imag(w3)), complex(real(w2), -imag(w2)), complex(real(w1), -imag(w1))
+		}
+
+		a, b, c, d := x0[p], x1[p], x2[p], x3[p]
+		apc, amc := a+c, a-c
+		bpd, bmd := b+d, b-d
+		ib := complex(-imag(bmd), real(bmd))
+
+		out := y[4*p : 4*p+4]
+		out[0] = apc + bpd
+		out[o1] = mulStockhamComplex64(amc-ib, w1)
+		out[2] = mulStockhamComplex64(apc-bpd, w2)
+		out[o3] = mulStockhamComplex64(amc+ib, w3)
+	}
+}
+
+// stockhamFirstStage8Complex64 is stockhamStage8Complex64 for the first
+// stage (s = 1).
+//
+//nolint:dupl,funlen
+func stockhamFirstStage8Complex64(y, x, twiddle []complex64, inverse bool) {
+	m := len(x) / 8
+	x0, x1, x2, x3 := x[:m], x[m:2*m], x[2*m:3*m], x[3*m:4*m]
+	x4, x5, x6, x7 := x[4*m:5*m], x[5*m:6*m], x[6*m:7*m], x[7*m:8*m]
+	y = y[:8*m]
+
+	// Forward output k lands in slot (8-k) mod 8 in the inverse.
+	o1, o2, o3, o5, o6, o7 := 1, 2, 3, 5, 6, 7
+	if inverse {
+		o1, o2, o3, o5, o6, o7 = 7, 6, 5, 3, 2, 1
+	}
+
+	for p := range m {
+		w1, w2, w3, w4 := twiddle[p], twiddle[2*p], twiddle[3*p], twiddle[4*p]
+		w5, w6, w7 := twiddle[5*p], twiddle[6*p], twiddle[7*p]
+
+		if inverse {
+			w1, w7 = complex(real(w7), -imag(w7)), complex(real(w1), -imag(w1))
+			w2, w6 = complex(real(w6), -imag(w6)), complex(real(w2), -imag(w2))
+			w3, w5 = complex(real(w5), -imag(w5)), complex(real(w3), -imag(w3))
+			w4 = complex(real(w4), -imag(w4))
+		}
+
+		a0, a1, a2, a3 := x0[p], x1[p], x2[p], x3[p]
+		a4, a5, a6, a7 := x4[p], x5[p], x6[p], x7[p]
+		b0, b1, b2, b3 := a0+a4, a1+a5, a2+a6, a3+a7
+		d0, d1, d2, d3 := a0-a4, a1-a5, a2-a6, a3-a7
+
+		c1 := complex((real(d1)+imag(d1))*sqrtHalf32, (imag(d1)-real(d1))*sqrtHalf32)
+		c2 := complex(imag(d2), -real(d2))
+		c3 := complex((imag(d3)-real(d3))*sqrtHalf32, -(real(d3)+imag(d3))*sqrtHalf32)
+
+		bp02, bm02 := b0+b2, b0-b2
+		bp13, bm13 := b1+b3, b1-b3
+		ib := complex(-imag(bm13), real(bm13))
+		cp02, cm02 := d0+c2, d0-c2
+		cp13, cm13 := c1+c3, c1-c3
+		ic := complex(-imag(cm13), real(cm13))
+
+		out := y[8*p : 8*p+8]
+		out[0] = bp02 + bp13
+		out[o1] = mulStockhamComplex64(cp02+cp13, w1)
+		out[o2] = mulStockhamComplex64(bm02-ib, w2)
+		out[o3] = mulStockhamComplex64(cm02-ic, w3)
+		out[4] = mulStockhamComplex64(bp02-bp13, w4)
+		out[o5] = mulStockhamComplex64(cp02-cp13, w5)
+		out[o6] = mulStockhamComplex64(bm02+ib, w6)
+		out[o7] = mulStockhamComplex64(cm02+ic, w7)
+	}
+}
+
+// stockhamLastStage8Complex64 is stockhamStage8Complex64 for the last stage
+// (l = 8), whose single block needs no twiddles.
+//
+//nolint:dupl
+func stockhamLastStage8Complex64(y, x []complex64, s int, inverse bool) {
+	o1, o2, o3, o5, o6, o7 := 1, 2, 3, 5, 6, 7
+	if inverse {
+		o1, o2, o3, o5, o6, o7 = 7, 6, 5, 3, 2, 1
+	}
+
+	x0, x1, x2, x3 := x[:s], x[s:2*s], x[2*s:3*s], x[3*s:4*s]
+	x4, x5, x6, x7 := x[4*s:5*s], x[5*s:6*s], x[6*s:7*s], x[7*s:8*s]
+	y0, y1, y2, y3 := y[:s], y[o1*s:o1*s+s], y[o2*s:o2*s+s], y[o3*s:o3*s+s]
+	y4, y5, y6, y7 := y[4*s:5*s], y[o5*s:o5*s+s], y[o6*s:o6*s+s], y[o7*s:o7*s+s]
+
+	for q := range s {
+		a0, a1, a2, a3 := x0[q], x1[q], x2[q], x3[q]
+		a4, a5, a6, a7 := x4[q], x5[q], x6[q], x7[q]
+		b0, b1, b2, b3 := a0+a4, a1+a5, a2+a6, a3+a7
+		d0, d1, d2, d3 := a0-a4, a1-a5, a2-a6, a3-a7
+
+		c1 := complex((real(d1)+imag(d1))*sqrtHalf32, (imag(d1)-real(d1))*sqrtHalf32)
+		c2 := complex(imag(d2), -real(d2))
+		c3 := complex((imag(d3)-real(d3))*sqrtHalf32, -(real(d3)+imag(d3))*sqrtHalf32)
+
+		bp02, bm02 := b0+b2, b0-b2
+		bp13, bm13 := b1+b3, b1-b3
+		ib := complex(-imag(bm13), real(bm13))
+		cp02, cm02 := d0+c2, d0-c2
+		cp13, cm13 := c1+c3, c1-c3
+		ic := complex(-imag(cm13), real(cm13))
+
+		y0[q] = bp02 + bp13
+		y1[q] = cp02 + cp13
+		y2[q] = bm02 - ib
+		y3[q] = cm02 - ic
+		y4[q] = bp02 - bp13
+		y5[q] = cp02 - cp13
+		y6[q] = bm02 + ib
+		y7[q] = cm02 + ic
+	}
+}
+
+// forwardStockhamPortableComplex128 performs a forward portable Stockham FFT
+// on complex128 data.
+func forwardStockhamPortableComplex128(dst, src, twiddle, scratch []complex128) bool {
+	return stockhamPortableComplex128(dst, src, twiddle, scratch, false)
+}
+
+// inverseStockhamPortableComplex128 performs an inverse portable Stockham FFT
+// on complex128 data, including the 1/N scaling.
+func inverseStockhamPortableComplex128(dst, src, twiddle, scratch []complex128) bool {
+	if !stockhamPortableComplex128(dst, src, twiddle, scratch, true) {
+		return false
+	}
+
+	n := len(src)
+	scale := 1 / float64(n)
+
+	for i := range dst[:n] {
+		dst[i] = complex(real(dst[i])*scale, imag(dst[i])*scale)
+	}
+
+	return true
+}
+
+func stockhamPortableComplex128(dst, src, twiddle, scratch []complex128, inverse bool) bool {
+	in, ok := stockhamPortableBuffers(dst, src, twiddle, scratch)
+	if !ok {
+		return false
+	}
+
+	n := len(in)
+	twiddle = twiddle[:n]
+	bits := log2(n)
+	stages := stockhamPortableStages(bits)
+	stride := 1
+
+	for i := range stages {
+		out := stockhamPortableOutput(dst, scratch, i, stages)[:n]
+		radix := stockhamPortableRadix(bits)
+		l := n / stride
+
+		switch {
+		case radix == 8 && stride == 1:
+			stockhamFirstStage8Complex128(out, in, twiddle, inverse)
+		case radix == 8 && l == 8:
+			stockhamLastStage8Complex128(out, in, stride, inverse)
+		case radix == 8:
+			stockhamStage8Complex128(out, in, twiddle, l, stride, inverse)
+		case radix == 4 && stride == 1:
+			stockhamFirstStage4Complex128(out, in, twiddle, inverse)
+		case radix == 4:
+			stockhamStage4Complex128(out, in, twiddle, l, stride, inverse)
+		default:
+			stockhamStage2Complex128(out, in, stride)
+		}
+
+		in = out
+		stride *= radix
+		bits -= log2(radix)
+	}
+
+	return true
+}
+
+// stockhamStage2Complex128 is a final radix-2 stage (l = 2, no twiddles).
+func stockhamStage2Complex128(y, x []complex128, s int) {
+	x0, x1 := x[:s], x[s:2*s]
+	y0, y1 := y[:s], y[s:2*s]
+
+	for q := range s {
+		a, b := x0[q], x1[q]
+		y0[q] = a + b
+		y1[q] = a - b
+	}
+}
+
+// stockhamStage4Complex128 runs a radix-4 stage of length l and stride s.
+//
+//nolint:dupl
+func stockhamStage4Complex128(y, x, twiddle []complex128, l, s int, inverse bool) {
+	m := l / 4
+	xs := m * s
+
+	for p := range m {
+		ps := p * s
+		w1, w2, w3 := twiddle[ps], twiddle[2*ps], twiddle[3*ps]
+
+		// Forward outputs 1 and 3 trade places in the inverse.
+		o1, o3 := 1, 3
+		if inverse {
+			w1, w2, w3 = complex(real(w3), -imag(w3)), complex(real(w2), -imag(w2)), complex(real(w1), -imag(w1))
+			o1, o3 = 3, 1
+		}
+
+		in := x[ps:]
+		x0, x1, x2, x3 := in[:s], in[xs:xs+s], in[2*xs:2*xs+s], in[3*xs:3*xs+s]
+
+		out := y[4*ps : 4*ps+4*s]
+		y0, y1, y2, y3 := out[:s], out[o1*s:o1*s+s], out[2*s:3*s], out[o3*s:o3*s+s]
+
+		if p == 0 {
+			for q := range s {
+				a, b, c, d := x0[q], x1[q], x2[q], x3[q]
+				apc, amc := a+c, a-c
+				bpd, bmd := b+d, b-d
+				ib := complex(-imag(bmd), real(bmd))
+				y0[q] = apc + bpd
+				y1[q] = amc - ib
+				y2[q] = apc - bpd
+				y3[q] = amc + ib
+			}
+
+			continue
+		}
+
+		for q := range s {
+			a, b, c, d := x0[q], x1[q], x2[q], x3[q]
+			apc, amc := a+c, a-c
+			bpd, bmd := b+d, b-d
+			ib := complex(-imag(bmd), real(bmd))
+			y0[q] = apc + bpd
+			y1[q] = mulStockhamComplex128(amc-ib, w1)
+			y2[q] = mulStockhamComplex128(apc-bpd, w2)
+			y3[q] = mulStockhamComplex128(amc+ib, w3)
+		}
+	}
+}
+
+// stockhamStage8Complex128 runs a radix-8 stage of length l and stride s.
+//
+//nolint:dupl,funlen
+func stockhamStage8Complex128(y, x, twiddle []complex128, l, s int, inverse bool) {
+	m := l / 8
+	xs := m * s
+
+	for p := range m {
+		ps := p * s
+		w1, w2, w3, w4 := twiddle[ps], twiddle[2*ps], twiddle[3*ps], twiddle[4*ps]
+		w5, w6, w7 := twiddle[5*ps], twiddle[6*ps], twiddle[7*ps]
+
+		// Forward output k lands in slot (8-k) mod 8 in the inverse.
+		o1, o2, o3, o5, o6, o7 := 1, 2, 3, 5, 6, 7
+		if inverse {
+			w1, w7 = complex(real(w7), -imag(w7)), complex(real(w1), -imag(w1))
+			w2, w6 = complex(real(w6), -imag(w6)), complex(real(w2), -imag(w2))
+			w3, w5 = complex(real(w5), -imag(w5)), complex(real(w3), -imag(w3))
+			w4 = complex(real(w4), -imag(w4))
+			o1, o2, o3, o5, o6, o7 = 7, 6, 5, 3, 2, 1
+		}
+
+		in := x[ps:]
+		x0, x1, x2, x3 := in[:s], in[xs:xs+s], in[2*xs:2*xs+s], in[3*xs:3*xs+s]
+		x4, x5, x6, x7 := in[4*xs:4*xs+s], in[5*xs:5*xs+s], in[6*xs:6*xs+s], in[7*xs:7*xs+s]
+
+		out := y[8*ps : 8*ps+8*s]
+		y0, y1, y2, y3 := out[:s], out[o1*s:o1*s+s], out[o2*s:o2*s+s], out[o3*s:o3*s+s]
+		y4, y5, y6, y7 := out[4*s:5*s], out[o5*s:o5*s+s], out[o6*s:o6*s+s], out[o7*s:o7*s+s]
+
+		for q := range s {
+			// Radix-2 split: b = a_j + a_j+4, d = a_j - a_j+4, then the odd
+			// half is rotated by W_8^j: c1 = d1·W_8, c2 = -i·d2, c3 = -i·W_8·d3.
+			a0, a1, a2, a3 := x0[q], x1[q], x2[q], x3[q]
+			a4, a5, a6, a7 := x4[q], x5[q], x6[q], x7[q]
+			b0, b1, b2, b3 := a0+a4, a1+a5, a2+a6, a3+a7
+			d0, d1, d2, d3 := a0-a4, a1-a5, a2-a6, a3-a7
+
+			c1 := complex((real(d1)+imag(d1))*sqrtHalf, (imag(d1)-real(d1))*sqrtHalf)
+			c2 := complex(imag(d2), -real(d2))
+			c3 := complex((imag(d3)-real(d3))*sqrtHalf, -(real(d3)+imag(d3))*sqrtHalf)
+
+			// Two 4-point DFTs give the even and the odd outputs.
+			bp02, bm02 := b0+b2, b0-b2
+			bp13, bm13 := b1+b3, b1-b3
+			ib := complex(-imag(bm13), real(bm13))
+			cp02, cm02 := d0+c2, d0-c2
+			cp13, cm13 := c1+c3, c1-c3
+			ic := complex(-imag(cm13), real(cm13))
+
+			y0[q] = bp02 + bp13
+			y1[q] = mulStockhamComplex128(cp02+cp13, w1)
+			y2[q] = mulStockhamComplex128(bm02-ib, w2)
+			y3[q] = mulStockhamComplex128(cm02-ic, w3)
+			y4[q] = mulStockhamComplex128(bp02-bp13, w4)
+			y5[q] = mulStockhamComplex128(cp02-cp13, w5)
+			y6[q] = mulStockhamComplex128(bm02+ib, w6)
+			y7[q] = mulStockhamComplex128(cm02+ic, w7)
+		}
+	}
+}
+
+// stockhamFirstStage4Complex128 is stockhamStage4Complex128 for the first
+// stage (s = 1), where every block holds a single butterfly: it walks the
+// four input quarters contiguously and loads the twiddles per butterfly.
+//
+//nolint:dupl
+func stockhamFirstStage4Complex128(y, x, twiddle []complex128, inverse bool) {
+	m := len(x) / 4
+	x0, x1, x2, x3 := x[:m], x[m:2*m], x[2*m:3*m], x[3*m:4*m]
+	y = y[:4*m]
+
+	// Forward outputs 1 and 3 trade places in the inverse.
+	o1, o3 := 1, 3
+	if inverse {
+		o1, o3 = 3, 1
+	}
+
+	for p := range m {
+		w1, w2, w3 := twiddle[p], twiddle[2*p], twiddle[3*p]
+		if inverse {
+			w1, w2, w3 = complex(real(w3), -imag(w3)), complex(real(w2), -imag(w2)), complex(real(w1), -imag(w1))
+		}
+
+		a, b, c, d := x0[p], x1[p], x2[p], x3[p]
+		apc, amc := a+c, a-c
+		bpd, bmd := b+d, b-d
+		ib := complex(-imag(bmd), real(bmd))
+
+		out := y[4*p : 4*p+4]
+		out[0] = apc + bpd
+		out[o1] = mulStockhamComplex128(amc-ib, w1)
+		out[2] = mulStockhamComplex128(apc-bpd, w2)
+		out[o3] = mulStockhamComplex128(amc+ib, w3)
+	}
+}
+
+// stockhamFirstStage8Complex128 is stockhamStage8Complex128 for the first
+// stage (s = 1).
+//
+//nolint:dupl,funlen
+func stockhamFirstStage8Complex128(y, x, twiddle []complex128, inverse bool) {
+	m := len(x) / 8
+	x0, x1, x2, x3 := x[:m], x[m:2*m], x[2*m:3*m], x[3*m:4*m]
+	x4, x5, x6, x7 := x[4*m:5*m], x[5*m:6*m], x[6*m:7*m], x[7*m:8*m]
+	y = y[:8*m]
+
+	// Forward output k lands in slot (8-k) mod 8 in the inverse.
+	o1, o2, o3, o5, o6, o7 := 1, 2, 3, 5, 6, 7
+	if inverse {
+		o1, o2, o3, o5, o6, o7 = 7, 6, 5, 3, 2, 1
+	}
+
+	for p := range m {
+		w1, w2, w3, w4 := twiddle[p], twiddle[2*p], twiddle[3*p], twiddle[4*p]
+		w5, w6, w7 := twiddle[5*p], twiddle[6*p], twiddle[7*p]
+
+		if inverse {
+			w1, w7 = complex(real(w7), -imag(w7)), complex(real(w1), -imag(w1))
+			w2, w6 = complex(real(w6), -imag(w6)), complex(real(w2), -imag(w2))
+			w3, w5 = complex(real(w5), -imag(w5)), complex(real(w3), -imag(w3))
+			w4 = complex(real(w4), -imag(w4))
+		}
+
+		a0, a1, a2, a3 := x0[p], x1[p], x2[p], x3[p]
+		a4, a5, a6, a7 := x4[p], x5[p], x6[p], x7[p]
+		b0, b1, b2, b3 := a0+a4, a1+a5, a2+a6, a3+a7
+		d0, d1, d2, d3 := a0-a4, a1-a5, a2-a6, a3-a7
+
+		c1 := complex((real(d1)+imag(d1))*sqrtHalf, (imag(d1)-real(d1))*sqrtHalf)
+		c2 := complex(imag(d2), -real(d2))
+		c3 := complex((imag(d3)-real(d3))*sqrtHalf, -(real(d3)+imag(d3))*sqrtHalf)
+
+		bp02, bm02 := b0+b2, b0-b2
+		bp13, bm13 := b1+b3, b1-b3
+		ib := complex(-imag(bm13), real(bm13))
+		cp02, cm02 := d0+c2, d0-c2
+		cp13, cm13 := c1+c3, c1-c3
+		ic := complex(-imag(cm13), real(cm13))
+
+		out := y[8*p : 8*p+8]
+		out[0] = bp02 + bp13
+		out[o1] = mulStockhamComplex128(cp02+cp13, w1)
+		out[o2] = mulStockhamComplex128(bm02-ib, w2)
+		out[o3] = mulStockhamComplex128(cm02-ic, w3)
+		out[4] = mulStockhamComplex128(bp02-bp13, w4)
+		out[o5] = mulStockhamComplex128(cp02-cp13, w5)
+		out[o6] = mulStockhamComplex128(bm02+ib, w6)
+		out[o7] = mulStockhamComplex128(cm02+ic, w7)
+	}
+}
+
+// stockhamLastStage8Complex128 is stockhamStage8Complex128 for the last stage
+// (l = 8), whose single block needs no twiddles.
+//
+//nolint:dupl
+func stockhamLastStage8Complex128(y, x []complex128, s int, inverse bool) {
+	o1, o2, o3, o5, o6, o7 := 1, 2, 3, 5, 6, 7
+	if inverse {
+		o1, o2, o3, o5, o6, o7 = 7, 6, 5, 3, 2, 1
+	}
+
+	x0, x1, x2, x3 := x[:s], x[s:2*s], x[2*s:3*s], x[3*s:4*s]
+	x4, x5, x6, x7 := x[4*s:5*s], x[5*s:6*s], x[6*s:7*s], x[7*s:8*s]
+	y0, y1, y2, y3 := y[:s], y[o1*s:o1*s+s], y[o2*s:o2*s+s], y[o3*s:o3*s+s]
+	y4, y5, y6, y7 := y[4*s:5*s], y[o5*s:o5*s+s], y[o6*s:o6*s+s], y[o7*s:o7*s+s]
+
+	for q := range s {
+		a0, a1, a2, a3 := x0[q], x1[q], x2[q], x3[q]
+		a4, a5, a6, a7 := x4[q], x5[q], x6[q], x7[q]
+		b0, b1, b2, b3 := a0+a4, a1+a5, a2+a6, a3+a7
+		d0, d1, d2, d3 := a0-a4, a1-a5, a2-a6, a3-a7
+
+		c1 := complex((real(d1)+imag(d1))*sqrtHalf, (imag(d1)-real(d1))*sqrtHalf)
+		c2 := complex(imag(d2), -real(d2))
+		c3 := complex((imag(d3)-real(d3))*sqrtHalf, -(real(d3)+imag(d3))*sqrtHalf)
+
+		bp02, bm02 := b0+b2, b0-b2
+		bp13, bm13 := b1+b3, b1-b3
+		ib := complex(-imag(bm13), real(bm13))
+		cp02, cm02 := d0+c2, d0-c2
+		cp13, cm13 := c1+c3, c1-c3
+		ic := complex(-imag(cm13), real(cm13))
+
+		y0[q] = bp02 + bp13
+		y1[q] = cp02 + cp13
+		y2[q] = bm02 - ib
+		y3[q] = cm02 - ic
+		y4[q] = bp02 - bp13
+		y5[q] = cp02 - cp13
+		y6[q] = bm02 + ib
+		y7[q] = cm02 + ic
+	}
+}
+
+// Precision-specific exports.
+var (
+	ForwardStockhamPortableComplex64  = forwardStockhamPortableComplex64
+	InverseStockhamPortableComplex64  = inverseStockhamPortableComplex64
+	ForwardStockhamPortableComplex128 = forwardStockhamPortableComplex128
+	InverseStockhamPortableComplex128 = inverseStockhamPortableComplex128
+)
@@ -0,0 +1,129 @@
+package kernels
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+func TestStockhamPortableComplex64(t *testing.T) {
+	t.Parallel()
+
+	for n := 2; n <= 4096; n *= 2 {
+		t.Run(fmt.Sprintf("n%d", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex64(n, uint64(n))
+			twiddle := ComputeTwiddleFactors[complex64](n)
+			scratch := make([]complex64, n)
+			dst := make([]complex64, n)
+			tol := 1e-5 * float64(log2(n)+1)
+
+			if !forwardStockhamPortableComplex64(dst, src, twiddle, scratch) {
+				t.Fatal("forwardStockhamPortableComplex64 failed")
+			}
+
+			assertComplex64Close(t, dst, reference.NaiveDFT(src), tol*float64(n))
+
+			// The inverse runs in place.
+			copy(dst, src)
+
+			if !inverseStockhamPortableComplex64(dst, dst, twiddle, scratch) {
+				t.Fatal("inverseStockhamPortableComplex64 failed")
+			}
+
+			assertComplex64Close(t, dst, reference.NaiveIDFT(src), tol)
+		})
+	}
+}
+
+func TestStockhamPortableComplex128(t *testing.T) {
+	t.Parallel()
+
+	for n := 2; n <= 4096; n *= 2 {
+		t.Run(fmt.Sprintf("n%d", n), func(t *testing.T) {
+			t.Parallel()
+
+			src := randomComplex128(n, uint64(n))
+			twiddle := ComputeTwiddleFactors[complex128](n)
+			scratch := make([]complex128, n)
+			dst := make([]complex128, n)
+			tol := 1e-12 * float64(log2(n)+1)
+
+			// The forward transform runs in place.
+			copy(dst, src)
+
+			if !forwardStockhamPortableComplex128(dst, dst, twiddle, scratch) {
+				t.Fatal("forwardStockhamPortableComplex128 failed")
+			}
+
+			assertComplex128Close(t, dst, reference.NaiveDFT128(src), tol*float64(n))
+
+			if !inverseStockhamPortableComplex128(dst, src, twiddle, scratch) {
+				t.Fatal("inverseStockhamPortableComplex128 failed")
+			}
+
+			assertComplex128Close(t, dst, reference.NaiveIDFT128(src), tol)
+		})
+	}
+}
+
+func TestStockhamPortable_Radices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		bits    int
+		radices []int
+	}{
+		{1, []int{2}},
+		{2, []int{4}},
+		{3, []int{8}},
+		{4, []int{4, 4}},
+		{5, []int{4, 8}},
+		{7, []int{4, 4, 8}},
+		{12, []int{8, 8, 8, 8}},
+	}
+
+	for _, tt := range tests {
+		var got []int
+
+		for bits := tt.bits; bits > 0; {
+			radix := stockhamPortableRadix(bits)
+			got = append(got, radix)
+			bits -= log2(radix)
+		}
+
+		if fmt.Sprint(got) != fmt.Sprint(tt.radices) {
+			t.Errorf("bits=%d: radices = %v, want %v", tt.bits, got, tt.radices)
+		}
+
+		if stages := stockhamPortableStages(tt.bits); stages != len(tt.radices) {
+			t.Errorf("bits=%d: stages = %d, want %d", tt.bits, stages, len(tt.radices))
+		}
+	}
+}
+
+func TestStockhamPortable_EdgeCases(t *testing.T) {
+	t.Parallel()
+
+	one := []complex64{3 + 4i}
+	dst := make([]complex64, 1)
+
+	if !forwardStockhamPortableComplex64(dst, one, make([]complex64, 1), make([]complex64, 1)) || dst[0] != one[0] {
+		t.Errorf("size 1: got %v, want %v", dst, one)
+	}
+
+	if !inverseStockhamPortableComplex64(nil, nil, nil, nil) {
+		t.Error("size 0 rejected")
+	}
+
+	buf := make([]complex64, 12)
+	if forwardStockhamPortableComplex64(buf, buf, buf, buf) {
+		t.Error("non-power-of-two size accepted")
+	}
+
+	if forwardStockhamPortableComplex64(buf[:4], buf[:8], buf, buf) {
+		t.Error("short dst accepted")
+	}
+}
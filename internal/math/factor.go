@@ -27,17 +27,102 @@ func Factorize(n int) []int {
 	return factors
 }
 
-// IsHighlyComposite reports whether n only contains 2, 3, or 5 factors.
+// CoprimeFactors splits n into pairwise coprime factors, one prime power per
+// distinct prime factor, in ascending order of the primes.
+func CoprimeFactors(n int) []int {
+	var factors []int
+
+	for _, p := range Factorize(n) {
+		if last := len(factors) - 1; last >= 0 && factors[last]%p == 0 {
+			factors[last] *= p
+			continue
+		}
+
+		factors = append(factors, p)
+	}
+
+	return factors
+}
+
+// IsHighlyComposite reports whether n only contains the factors 2, 3, 5, 7,
+// 11 and 13, i.e. whether the mixed-radix kernels can transform it.
 func IsHighlyComposite(n int) bool {
 	if n <= 0 {
 		return false
 	}
 
 	for _, factor := range Factorize(n) {
-		if factor != 2 && factor != 3 && factor != 5 {
-			return false
+		switch factor {
+		case 2, 3, 5, 7, 11, 13:
+			continue
 		}
+
+		return false
 	}
 
 	return true
 }
+
+// IsPrime reports whether n is a prime number.
+func IsPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+
+	factors := Factorize(n)
+
+	return len(factors) == 1
+}
+
+// PrimitiveRoot returns the smallest generator of the multiplicative group
+// modulo the prime p, or 0 if p is not prime.
+func PrimitiveRoot(p int) int {
+	if !IsPrime(p) {
+		return 0
+	}
+
+	if p == 2 {
+		return 1
+	}
+
+	// g is a generator iff g^((p-1)/q) != 1 for every prime factor q of p-1.
+	factors := Factorize(p - 1)
+
+	for g := 2; g < p; g++ {
+		generator := true
+
+		for i, q := range factors {
+			if i > 0 && q == factors[i-1] {
+				continue
+			}
+
+			if PowMod(g, (p-1)/q, p) == 1 {
+				generator = false
+				break
+			}
+		}
+
+		if generator {
+			return g
+		}
+	}
+
+	return 0
+}
+
+// PowMod returns base^exp mod m for exp >= 0 and m > 0.
+func PowMod(base, exp, m int) int {
+	result := 1 % m
+	base %= m
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = int(uint64(result) * uint64(base) % uint64(m))
+		}
+
+		base = int(uint64(base) * uint64(base) % uint64(m))
+		exp >>= 1
+	}
+
+	return result
+}
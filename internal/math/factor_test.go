@@ -34,6 +34,29 @@ func TestFactorize(t *testing.T) {
 	}
 }
 
+func TestCoprimeFactors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n    int
+		want []int
+	}{
+		{n: 1, want: nil},
+		{n: 7, want: []int{7}},
+		{n: 15, want: []int{3, 5}},
+		{n: 60, want: []int{4, 3, 5}},
+		{n: 1000, want: []int{8, 125}},
+		{n: 1001, want: []int{7, 11, 13}},
+	}
+
+	for _, tt := range tests {
+		got := CoprimeFactors(tt.n)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("CoprimeFactors(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
 func TestIsHighlyComposite(t *testing.T) {
 	t.Parallel()
 
@@ -58,9 +81,13 @@ func TestIsHighlyComposite(t *testing.T) {
 		{n: 25, want: true},
 		{n: 30, want: true},
 		{n: 16, want: true},
-		{n: 14, want: false},
-		{n: 49, want: false},
-		{n: 11, want: false},
+		{n: 14, want: true},
+		{n: 49, want: true},
+		{n: 11, want: true},
+		{n: 3003, want: true},
+		{n: 17, want: false},
+		{n: 34, want: false},
+		{n: 221, want: false},
 	}
 
 	for _, tt := range tests {
@@ -70,3 +97,43 @@ func TestIsHighlyComposite(t *testing.T) {
 		}
 	}
 }
+
+func TestPrimitiveRoot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		p    int
+		want int
+	}{
+		{p: 1, want: 0},
+		{p: 2, want: 1},
+		{p: 3, want: 2},
+		{p: 7, want: 3},
+		{p: 15, want: 0},
+		{p: 17, want: 3},
+		{p: 41, want: 6},
+		{p: 1009, want: 11},
+		{p: 65537, want: 3},
+	}
+
+	for _, tt := range tests {
+		got := PrimitiveRoot(tt.p)
+		if got != tt.want {
+			t.Errorf("PrimitiveRoot(%d) = %d, want %d", tt.p, got, tt.want)
+		}
+
+		if got == 0 || tt.p < 3 {
+			continue
+		}
+
+		// The powers of a generator visit every non-zero residue once.
+		seen := make(map[int]bool, tt.p-1)
+		for q := range tt.p - 1 {
+			seen[PowMod(got, q, tt.p)] = true
+		}
+
+		if len(seen) != tt.p-1 {
+			t.Errorf("PrimitiveRoot(%d) = %d generates %d residues, want %d", tt.p, got, len(seen), tt.p-1)
+		}
+	}
+}
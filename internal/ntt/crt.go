@@ -0,0 +1,64 @@
+package ntt
+
+// Three NTT-friendly primes c*2^k+1 below 2^62. Their product exceeds 2^185,
+// so a convolution of int64 sequences is exact modulo it for any length the
+// primes support (2^51).
+const (
+	Prime1 uint64 = 2019<<51 + 1 // 4546383823830515713
+	Prime2 uint64 = 501<<53 + 1  // 4512606826625236993
+	Prime3 uint64 = 993<<52 + 1  // 4472074429978902529
+
+	// CRTMaxLog2 is log2 of the largest power-of-two size all three primes
+	// support.
+	CRTMaxLog2 = 51
+)
+
+// CRT combines residues modulo Prime1, Prime2 and Prime3 with Garner's
+// algorithm.
+type CRT struct {
+	m2, m3 Modulus
+
+	inv1Mod2   uint64 // Prime1^-1 mod Prime2
+	p1Mod3     uint64 // Prime1 mod Prime3
+	inv12Mod3  uint64 // (Prime1*Prime2)^-1 mod Prime3
+	p1p2       uint64 // Prime1*Prime2 mod 2^64
+	p1p2p3     uint64 // Prime1*Prime2*Prime3 mod 2^64
+	halfPrime3 uint64 // (Prime3-1)/2
+}
+
+// NewCRT precomputes the Garner constants.
+func NewCRT() CRT {
+	p1, p2, p3 := Prime1, Prime2, Prime3
+	p1Mod3 := p1 % p3
+
+	return CRT{
+		m2:         NewModulus(Prime2),
+		m3:         NewModulus(Prime3),
+		inv1Mod2:   PowMod(Prime1%Prime2, Prime2-2, Prime2),
+		p1Mod3:     p1Mod3,
+		inv12Mod3:  PowMod(MulMod(p1Mod3, Prime2%Prime3, Prime3), Prime3-2, Prime3),
+		p1p2:       p1 * p2, // wraps: only needed modulo 2^64
+		p1p2p3:     p1 * p2 * p3,
+		halfPrime3: (Prime3 - 1) / 2,
+	}
+}
+
+// Int64 returns the value v with |v| < Prime1*Prime2*Prime3/2 and the given
+// residues, reduced modulo 2^64 the way int64 arithmetic wraps.
+func (c CRT) Int64(r1, r2, r3 uint64) int64 {
+	// Mixed-radix digits: v ≡ d1 + d2*Prime1 + d3*Prime1*Prime2.
+	d1 := r1
+	d2 := c.m2.Mul(c.m2.Sub(r2, d1%Prime2), c.inv1Mod2)
+	t := c.m3.Add(d1%Prime3, c.m3.Mul(d2%Prime3, c.p1Mod3))
+	d3 := c.m3.Mul(c.m3.Sub(r3, t), c.inv12Mod3)
+
+	x := d1 + d2*Prime1 + d3*c.p1p2
+
+	// Digits above half the product encode negative values. The tie
+	// d3 == halfPrime3 is only reached by values beyond 2^183.
+	if d3 > c.halfPrime3 {
+		x -= c.p1p2p3
+	}
+
+	return int64(x)
+}
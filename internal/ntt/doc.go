@@ -0,0 +1,7 @@
+// Package ntt implements number-theoretic transforms: FFTs over the integers
+// modulo a prime p with 2^k | p-1, where the 2^k-th roots of unity exist
+// exactly. Convolutions computed this way are exact modulo p.
+//
+// Arithmetic uses Montgomery multiplication with R = 2^64, so moduli must be
+// odd primes below 2^63.
+package ntt
@@ -0,0 +1,146 @@
+package ntt
+
+import "math/bits"
+
+// Modulus holds the Montgomery constants of an odd prime modulus p < 2^63.
+type Modulus struct {
+	P uint64
+
+	negInv uint64 // -p^-1 mod 2^64
+	r2     uint64 // R^2 mod p
+}
+
+// NewModulus returns the Montgomery constants for p. The caller ensures p is
+// an odd prime below 2^63; see Supports.
+func NewModulus(p uint64) Modulus {
+	// Newton iteration for p^-1 mod 2^64: each step doubles the correct bits,
+	// starting from the 3 bits p*p ≡ 1 (mod 8) gives for odd p.
+	inv := p
+	for range 5 {
+		inv *= 2 - p*inv
+	}
+
+	r := -p % p // 2^64 mod p
+
+	return Modulus{P: p, negInv: -inv, r2: MulMod(r, r, p)}
+}
+
+// Reduce returns x mod p for any x.
+func (m Modulus) Reduce(x uint64) uint64 {
+	return x % m.P
+}
+
+// Add returns a+b mod p for a, b < p.
+func (m Modulus) Add(a, b uint64) uint64 {
+	s := a + b
+	if s >= m.P {
+		s -= m.P
+	}
+
+	return s
+}
+
+// Sub returns a-b mod p for a, b < p.
+func (m Modulus) Sub(a, b uint64) uint64 {
+	if a >= b {
+		return a - b
+	}
+
+	return a - b + m.P
+}
+
+// MulMont returns a*b/R mod p for b < p (Montgomery reduction).
+func (m Modulus) MulMont(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	q := lo * m.negInv
+	qhi, qlo := bits.Mul64(q, m.P)
+	_, carry := bits.Add64(lo, qlo, 0)
+
+	// hi + qhi + carry < 2p < 2^64 because p < 2^63
+	res := hi + qhi + carry
+	if res >= m.P {
+		res -= m.P
+	}
+
+	return res
+}
+
+// ToMont returns x*R mod p, the Montgomery form of x, for any x.
+func (m Modulus) ToMont(x uint64) uint64 {
+	return m.MulMont(x, m.r2)
+}
+
+// Mul returns a*b mod p for a, b < p.
+func (m Modulus) Mul(a, b uint64) uint64 {
+	return m.MulMont(m.MulMont(a, b), m.r2)
+}
+
+// Pow returns x^e mod p for x < p.
+func (m Modulus) Pow(x, e uint64) uint64 {
+	return PowMod(x, e, m.P)
+}
+
+// MulMod returns a*b mod p for any a, b and p > 0.
+func MulMod(a, b, p uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, p)
+}
+
+// PowMod returns x^e mod p.
+func PowMod(x, e, p uint64) uint64 {
+	result := 1 % p
+	x %= p
+
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result = MulMod(result, x, p)
+		}
+
+		x = MulMod(x, x, p)
+	}
+
+	return result
+}
+
+// IsPrime reports whether p is prime, using the Miller-Rabin bases that are
+// deterministic for all 64-bit integers.
+func IsPrime(p uint64) bool {
+	bases := [...]uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+	if p < 2 {
+		return false
+	}
+
+	for _, b := range bases {
+		if p%b == 0 {
+			return p == b
+		}
+	}
+
+	d := p - 1
+	s := bits.TrailingZeros64(d)
+	d >>= s
+
+	for _, b := range bases {
+		x := PowMod(b, d, p)
+		if x == 1 || x == p-1 {
+			continue
+		}
+
+		composite := true
+
+		for range s - 1 {
+			x = MulMod(x, x, p)
+			if x == p-1 {
+				composite = false
+				break
+			}
+		}
+
+		if composite {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,88 @@
+package ntt
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestModulusArithmetic(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(1, 2)) //nolint:gosec
+
+	for _, p := range []uint64{998244353, Prime1, Prime2, Prime3, 1<<63 - 25} {
+		mod := NewModulus(p)
+		bp := new(big.Int).SetUint64(p)
+
+		for range 1000 {
+			a, b := rng.Uint64N(p), rng.Uint64N(p)
+
+			want := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+			want.Mod(want, bp)
+
+			if got := mod.Mul(a, b); got != want.Uint64() {
+				t.Fatalf("p=%d: Mul(%d, %d) = %d, want %d", p, a, b, got, want)
+			}
+
+			if got := MulMod(a, b, p); got != want.Uint64() {
+				t.Fatalf("p=%d: MulMod(%d, %d) = %d, want %d", p, a, b, got, want)
+			}
+
+			if got := mod.Sub(mod.Add(a, b), b); got != a {
+				t.Fatalf("p=%d: (a+b)-b = %d, want %d", p, got, a)
+			}
+		}
+	}
+}
+
+func TestIsPrime(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range []uint64{2, 3, 998244353, Prime1, Prime2, Prime3, 1<<63 - 25, 1<<64 - 59} {
+		if !IsPrime(p) {
+			t.Errorf("IsPrime(%d) = false", p)
+		}
+	}
+
+	// 3215031751 is a strong pseudoprime to bases 2, 3, 5 and 7.
+	for _, n := range []uint64{0, 1, 4, 561, 3215031751, 998244353 * 3, 1<<63 - 1} {
+		if IsPrime(n) {
+			t.Errorf("IsPrime(%d) = true", n)
+		}
+	}
+}
+
+func TestCRTInt64(t *testing.T) {
+	t.Parallel()
+
+	crt := NewCRT()
+	rng := rand.New(rand.NewPCG(3, 4)) //nolint:gosec
+
+	residue := func(v *big.Int, p uint64) uint64 {
+		return new(big.Int).Mod(v, new(big.Int).SetUint64(p)).Uint64()
+	}
+
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 64), big.NewInt(1))
+
+	for i := range 2000 {
+		// Values up to about 2^150 in magnitude, both signs.
+		v := new(big.Int).Mul(big.NewInt(rng.Int64()), big.NewInt(rng.Int64()))
+		v.Mul(v, big.NewInt(int64(rng.Uint32N(1<<24))))
+
+		if i%2 == 0 {
+			v.Neg(v)
+		}
+
+		if i < 10 {
+			v.SetInt64(int64(i) - 5)
+		}
+
+		want := int64(new(big.Int).And(v, mask).Uint64())
+
+		got := crt.Int64(residue(v, Prime1), residue(v, Prime2), residue(v, Prime3))
+		if got != want {
+			t.Fatalf("Int64(%v) = %d, want %d", v, got, want)
+		}
+	}
+}
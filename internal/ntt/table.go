@@ -0,0 +1,193 @@
+package ntt
+
+import (
+	"sync"
+
+	mathpkg "github.com/cwbudde/algo-fft/internal/math"
+)
+
+// Table holds the precomputed roots of unity for a power-of-two NTT of size
+// N modulo P. A Table is immutable and safe for concurrent use.
+//
+// The transform mirrors the split-complex float kernels: an iterative radix-2
+// DIT over bit-reversed input, with the twiddles of the stage with butterfly
+// span 2*h stored contiguously from offset h-1. The inverse reuses the forward
+// pass through the index-reversal identity
+//
+//	INTT(x)[k] = NTT(x)[(N-k) mod N] / N
+//
+// so no inverse twiddle table is needed.
+type Table struct {
+	N   int
+	Mod Modulus
+
+	twiddle []uint64 // Montgomery form, N-1 entries
+	bitrev  []int
+	invN    uint64 // Montgomery form of 1/N
+}
+
+// Supports reports whether a size-n NTT exists modulo p: n must be a power of
+// two and p an odd prime below 2^63 with n | p-1.
+func Supports(n int, p uint64) bool {
+	if n < 1 || !mathpkg.IsPowerOf2(n) || p < 3 || p >= 1<<63 {
+		return false
+	}
+
+	return (p-1)%uint64(n) == 0 && IsPrime(p)
+}
+
+// NewTable precomputes a size-n NTT modulo p. The caller ensures
+// Supports(n, p).
+func NewTable(n int, p uint64) *Table {
+	mod := NewModulus(p)
+	root := rootOfUnity(n, p)
+
+	// powers[i] = root^i for i < n/2
+	powers := make([]uint64, max(n/2, 1))
+	powers[0] = 1
+
+	for i := 1; i < len(powers); i++ {
+		powers[i] = MulMod(powers[i-1], root, p)
+	}
+
+	twiddle := make([]uint64, max(n-1, 0))
+	for half := 1; half < n; half <<= 1 {
+		step := n / (2 * half)
+		for j := range half {
+			twiddle[half-1+j] = mod.ToMont(powers[j*step])
+		}
+	}
+
+	return &Table{
+		N:       n,
+		Mod:     mod,
+		twiddle: twiddle,
+		bitrev:  mathpkg.ComputeBitReversalIndices(n),
+		invN:    mod.ToMont(PowMod(uint64(n), p-2, p)),
+	}
+}
+
+// rootOfUnity returns a primitive n-th root of unity modulo the prime p. For
+// a non-residue g, g^((p-1)/n) has order exactly n; half of all g qualify.
+func rootOfUnity(n int, p uint64) uint64 {
+	if n == 1 {
+		return 1
+	}
+
+	exp := (p - 1) / uint64(n)
+
+	for g := uint64(2); ; g++ {
+		root := PowMod(g, exp, p)
+		if PowMod(root, uint64(n/2), p) == p-1 {
+			return root
+		}
+	}
+}
+
+// Forward computes the NTT of src into dst. Inputs must be reduced modulo P;
+// outputs are. dst and src may be the same slice.
+func (t *Table) Forward(dst, src []uint64) {
+	t.transform(dst, src)
+}
+
+// Inverse computes the inverse NTT, including the 1/N factor, of src into
+// dst. dst and src may be the same slice.
+func (t *Table) Inverse(dst, src []uint64) {
+	t.transform(dst, src)
+
+	if n := t.N; n > 1 {
+		for i, j := 1, n-1; i < j; i, j = i+1, j-1 {
+			dst[i], dst[j] = dst[j], dst[i]
+		}
+	}
+
+	for i, v := range dst {
+		dst[i] = t.Mod.MulMont(v, t.invN)
+	}
+}
+
+// MulPointwise sets dst[i] = a[i]*b[i] mod P.
+func (t *Table) MulPointwise(dst, a, b []uint64) {
+	b = b[:len(a)]
+	dst = dst[:len(a)]
+
+	for i := range a {
+		dst[i] = t.Mod.Mul(a[i], b[i])
+	}
+}
+
+func (t *Table) transform(dst, src []uint64) {
+	n := t.N
+	dst = dst[:n]
+	src = src[:n]
+	mod := t.Mod
+
+	// Bit reversal is an involution, so the in-place case is a set of swaps.
+	if &dst[0] == &src[0] {
+		for i, j := range t.bitrev {
+			if i < j {
+				dst[i], dst[j] = dst[j], dst[i]
+			}
+		}
+	} else {
+		for i, j := range t.bitrev {
+			dst[i] = src[j]
+		}
+	}
+
+	for half := 1; half < n; half <<= 1 {
+		w := t.twiddle[half-1 : 2*half-1]
+
+		for base := 0; base < n; base += 2 * half {
+			a := dst[base : base+half]
+			b := dst[base+half : base+2*half]
+
+			for j := range a {
+				// MulMont by a Montgomery-form twiddle multiplies by the
+				// twiddle itself.
+				tw := mod.MulMont(b[j], w[j])
+				a[j], b[j] = mod.Add(a[j], tw), mod.Sub(a[j], tw)
+			}
+		}
+	}
+}
+
+type tableKey struct {
+	n int
+	p uint64
+}
+
+//nolint:gochecknoglobals
+var tableCache struct {
+	sync.RWMutex
+
+	tables map[tableKey]*Table
+}
+
+// CachedTable returns the same table as NewTable but memoizes it per size
+// and modulus, so repeated transforms do not recompute roots.
+func CachedTable(n int, p uint64) *Table {
+	key := tableKey{n: n, p: p}
+
+	tableCache.RLock()
+
+	if cached, ok := tableCache.tables[key]; ok {
+		tableCache.RUnlock()
+		return cached
+	}
+
+	tableCache.RUnlock()
+
+	table := NewTable(n, p)
+
+	tableCache.Lock()
+
+	if tableCache.tables == nil {
+		tableCache.tables = make(map[tableKey]*Table)
+	}
+
+	tableCache.tables[key] = table
+	tableCache.Unlock()
+
+	return table
+}
@@ -0,0 +1,116 @@
+package ntt
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+)
+
+// naiveNTT evaluates the transform definition with the table's root.
+func naiveNTT(x []uint64, p uint64) []uint64 {
+	n := len(x)
+	root := rootOfUnity(n, p)
+	out := make([]uint64, n)
+
+	for k := range n {
+		var sum uint64
+
+		w := PowMod(root, uint64(k), p)
+		wj := uint64(1)
+
+		for _, v := range x {
+			sum = (sum + MulMod(v, wj, p)) % p
+			wj = MulMod(wj, w, p)
+		}
+
+		out[k] = sum
+	}
+
+	return out
+}
+
+func TestTableMatchesDefinition(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(5, 6)) //nolint:gosec
+
+	for _, p := range []uint64{998244353, Prime1} {
+		for _, n := range []int{1, 2, 4, 8, 64, 256} {
+			if !Supports(n, p) {
+				t.Fatalf("Supports(%d, %d) = false", n, p)
+			}
+
+			table := NewTable(n, p)
+			src := make([]uint64, n)
+
+			for i := range src {
+				src[i] = rng.Uint64N(p)
+			}
+
+			dst := make([]uint64, n)
+			table.Forward(dst, src)
+
+			want := naiveNTT(src, p)
+			for k := range want {
+				if dst[k] != want[k] {
+					t.Fatalf("p=%d n=%d: bin %d = %d, want %d", p, n, k, dst[k], want[k])
+				}
+			}
+
+			table.Inverse(dst, dst)
+
+			for i := range src {
+				if dst[i] != src[i] {
+					t.Fatalf("p=%d n=%d: round trip[%d] = %d, want %d", p, n, i, dst[i], src[i])
+				}
+			}
+		}
+	}
+}
+
+func TestSupports(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		n    int
+		p    uint64
+		want bool
+	}{
+		{1 << 23, 998244353, true},
+		{1 << 24, 998244353, false}, // 2^24 does not divide p-1
+		{12, 998244353, false},      // not a power of two
+		{8, 998244353 * 3, false},   // not prime
+		{2, 2, false},               // even
+	}
+
+	if bits.UintSize == 64 {
+		// Sizes past 2^31 only fit int on 64-bit targets.
+		shift := CRTMaxLog2
+		cases = append(cases, []struct {
+			n    int
+			p    uint64
+			want bool
+		}{
+			{1 << shift, Prime1, true},
+			{2 << shift, Prime1, false},
+		}...)
+	}
+
+	for _, c := range cases {
+		if got := Supports(c.n, c.p); got != c.want {
+			t.Errorf("Supports(%d, %d) = %v, want %v", c.n, c.p, got, c.want)
+		}
+	}
+}
+
+func TestCachedTable(t *testing.T) {
+	t.Parallel()
+
+	if CachedTable(64, Prime2) != CachedTable(64, Prime2) {
+		t.Error("CachedTable returned different tables for the same key")
+	}
+
+	if CachedTable(64, Prime2) == CachedTable(64, Prime3) {
+		t.Error("CachedTable shared a table across moduli")
+	}
+}
@@ -126,6 +126,43 @@ func (r *CodeletRegistry[T]) Lookup(size int, features cpu.Features) *CodeletEnt
 	return nil
 }
 
+// LookupAlgorithm is Lookup restricted to codelets implementing the given
+// algorithm, for plans that force a kernel strategy.
+func (r *CodeletRegistry[T]) LookupAlgorithm(size int, features cpu.Features, algorithm KernelStrategy) *CodeletEntry[T] {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := r.codelets[size]
+	for i := range entries {
+		if entries[i].Priority < 0 || entries[i].Algorithm != algorithm {
+			continue
+		}
+
+		if cpuSupports(features, entries[i].SIMDLevel) {
+			return &entries[i]
+		}
+	}
+
+	return nil
+}
+
+// Candidates returns every enabled codelet for the given size that the CPU
+// supports, in the order Lookup prefers them.
+func (r *CodeletRegistry[T]) Candidates(size int, features cpu.Features) []CodeletEntry[T] {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var candidates []CodeletEntry[T]
+
+	for _, entry := range r.codelets[size] {
+		if entry.Priority >= 0 && cpuSupports(features, entry.SIMDLevel) {
+			candidates = append(candidates, entry)
+		}
+	}
+
+	return candidates
+}
+
 // LookupBySignature finds a codelet by its signature.
 // Used primarily for wisdom system lookups.
 func (r *CodeletRegistry[T]) LookupBySignature(size int, signature string) *CodeletEntry[T] {
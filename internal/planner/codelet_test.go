@@ -1,6 +1,7 @@
 package planner
 
 import (
+	"slices"
 	"sync"
 	"testing"
 
@@ -238,6 +239,83 @@ func TestCodeletRegistryPriority(t *testing.T) {
 	}
 }
 
+// TestCodeletRegistryLookupAlgorithm tests lookup restricted to one algorithm.
+func TestCodeletRegistryLookupAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	registry := NewCodeletRegistry[complex64]()
+
+	registry.Register(CodeletEntry[complex64]{
+		Size:      64,
+		Forward:   dummyCodelet[complex64],
+		Inverse:   dummyCodelet[complex64],
+		Algorithm: KernelDIT,
+		SIMDLevel: SIMDNone,
+		Signature: "dit64_generic",
+		Priority:  10,
+	})
+
+	registry.Register(CodeletEntry[complex64]{
+		Size:      64,
+		Forward:   dummyCodelet[complex64],
+		Inverse:   dummyCodelet[complex64],
+		Algorithm: KernelStockham,
+		SIMDLevel: SIMDNone,
+		Signature: "stockham64_generic",
+		Priority:  20,
+	})
+
+	features := cpu.Features{Architecture: "amd64"}
+
+	if found := registry.Lookup(64, features); found == nil || found.Signature != "stockham64_generic" {
+		t.Errorf("Lookup = %v, want stockham64_generic", found)
+	}
+
+	if found := registry.LookupAlgorithm(64, features, KernelDIT); found == nil || found.Signature != "dit64_generic" {
+		t.Errorf("LookupAlgorithm(DIT) = %v, want dit64_generic", found)
+	}
+
+	if found := registry.LookupAlgorithm(64, features, KernelSixStep); found != nil {
+		t.Errorf("LookupAlgorithm(SixStep) = %q, want nil", found.Signature)
+	}
+}
+
+// TestCodeletRegistryCandidates tests the preference-ordered candidate list.
+func TestCodeletRegistryCandidates(t *testing.T) {
+	t.Parallel()
+
+	registry := NewCodeletRegistry[complex64]()
+
+	for _, entry := range []CodeletEntry[complex64]{
+		{Size: 32, Algorithm: KernelDIT, SIMDLevel: SIMDNone, Signature: "dit32_generic", Priority: 10},
+		{Size: 32, Algorithm: KernelDIT, SIMDLevel: SIMDAVX2, Signature: "dit32_avx2", Priority: 10},
+		{Size: 32, Algorithm: KernelStockham, SIMDLevel: SIMDNone, Signature: "stockham32_generic", Priority: 20},
+		{Size: 32, Algorithm: KernelDIT, SIMDLevel: SIMDNone, Signature: "dit32_disabled", Priority: -1},
+	} {
+		entry.Forward = dummyCodelet[complex64]
+		entry.Inverse = dummyCodelet[complex64]
+		registry.Register(entry)
+	}
+
+	var got []string
+	for _, entry := range registry.Candidates(32, cpu.Features{HasAVX2: true}) {
+		got = append(got, entry.Signature)
+	}
+
+	want := []string{"dit32_avx2", "stockham32_generic", "dit32_generic"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Candidates = %v, want %v", got, want)
+	}
+
+	if found := registry.Lookup(32, cpu.Features{HasAVX2: true}); found == nil || found.Signature != want[0] {
+		t.Errorf("Lookup = %v, want first candidate %q", found, want[0])
+	}
+
+	if candidates := registry.Candidates(64, cpu.Features{}); candidates != nil {
+		t.Errorf("Candidates(64) = %v, want nil", candidates)
+	}
+}
+
 // TestCodeletRegistryLookupBySignature tests lookup by signature string.
 func TestCodeletRegistryLookupBySignature(t *testing.T) {
 	t.Parallel()
@@ -1,7 +1,12 @@
 package planner
 
 import (
+	"math"
+	"slices"
+	"sort"
+
 	"github.com/cwbudde/algo-fft/internal/cpu"
+	m "github.com/cwbudde/algo-fft/internal/math"
 )
 
 // WisdomStore interface for dependency injection from root package.
@@ -35,23 +40,24 @@ type PlanEstimate[T Complex] struct {
 // It checks in order:
 //  1. Codelet registry (highest priority - zero dispatch)
 //  2. Wisdom cache (if provided)
-//  3. Heuristic strategy selection (fallback)
+//  3. Heuristic strategy selection (fallback); sizes whose prime factors
+//     are all distinct use the prime-factor algorithm when it applies
 //
 // The returned PlanEstimate contains either:
 //   - Direct codelet bindings (zero dispatch) if a codelet is registered for the size
 //   - Empty codelet fields and just Strategy if no codelet (caller uses fallback kernels)
 func EstimatePlan[T Complex](n int, features cpu.Features, wisdom WisdomStore, forcedStrategy KernelStrategy) PlanEstimate[T] {
+	forcedStrategy = applicableStrategy(n, forcedStrategy)
+
 	strategy := ResolveKernelStrategy(n)
 	if forcedStrategy != KernelAuto {
 		strategy = forcedStrategy
 	}
 
-	// For Bluestein, there are no codelets
+	// Sizes the mixed-radix kernels cannot factor have no codelets; they run
+	// Rader's or Bluestein's algorithm.
 	if !IsPowerOf2(n) && !IsHighlyComposite(n) {
-		return PlanEstimate[T]{
-			Strategy:  KernelBluestein,
-			Algorithm: "bluestein",
-		}
+		return estimatePrime[T](n, features, wisdom, forcedStrategy)
 	}
 
 	// 1. Try codelet registry first (highest priority - zero dispatch)
@@ -66,6 +72,8 @@ func EstimatePlan[T Complex](n int, features cpu.Features, wisdom WisdomStore, f
 		}
 
 		strategy = wisStrat
+	} else if forcedStrategy == KernelAuto && pfaPreferred(n) {
+		strategy = KernelPFA
 	}
 
 	// 3. Fall back to heuristic kernel selection
@@ -77,6 +85,222 @@ func EstimatePlan[T Complex](n int, features cpu.Features, wisdom WisdomStore, f
 	}
 }
 
+// FallbackPlan returns the estimate that replaces a plan whose codelets named
+// in rejected failed verification: the next registry entry for n (of the
+// forced strategy, if any) or, once none remains, the heuristic kernel
+// strategy EstimatePlan falls back to.
+func FallbackPlan[T Complex](n int, features cpu.Features, forcedStrategy KernelStrategy, rejected []string) PlanEstimate[T] {
+	forcedStrategy = applicableStrategy(n, forcedStrategy)
+
+	if registry := GetRegistry[T](); registry != nil {
+		for _, entry := range registry.Candidates(n, features) {
+			if forcedStrategy != KernelAuto && entry.Algorithm != forcedStrategy {
+				continue
+			}
+
+			if slices.Contains(rejected, entry.Signature) {
+				continue
+			}
+
+			return PlanEstimate[T]{
+				ForwardCodelet: entry.Forward,
+				InverseCodelet: entry.Inverse,
+				Algorithm:      entry.Signature,
+				Strategy:       entry.Algorithm,
+				TwiddleSize:    entry.TwiddleSize,
+				PrepareTwiddle: entry.PrepareTwiddle,
+			}
+		}
+	}
+
+	strategy := forcedStrategy
+	if strategy == KernelAuto {
+		strategy = ResolveKernelStrategy(n)
+		if pfaPreferred(n) {
+			strategy = KernelPFA
+		}
+	}
+
+	return PlanEstimate[T]{
+		Strategy:  strategy,
+		Algorithm: StrategyToAlgorithmName(strategy),
+	}
+}
+
+// applicableStrategy drops forced strategies that do not exist for n.
+func applicableStrategy(n int, forcedStrategy KernelStrategy) KernelStrategy {
+	// The prime-factor algorithm only exists for coprime factorizations
+	if forcedStrategy == KernelPFA && !PFAApplicable(n) {
+		return KernelAuto
+	}
+
+	// Split radix and the recursive kernel only exist for powers of two
+	if (forcedStrategy == KernelSplitRadix || forcedStrategy == KernelRecursive) && !IsPowerOf2(n) {
+		return KernelAuto
+	}
+
+	return forcedStrategy
+}
+
+// Per-point, per-log2 cost weights of the kernels a Bluestein convolution of
+// size M can run on, relative to each other. Registered codelets are the
+// fastest; mixed-radix sizes pay extra for every radix-3 and radix-5 stage.
+const (
+	bluesteinWeightCodelet = 1.5
+	bluesteinWeightPow2    = 2.5
+	bluesteinWeightMixed   = 3.8
+	bluesteinWeightRadix3  = 0.4
+	bluesteinWeightRadix5  = 0.8
+)
+
+// BluesteinSizes returns the candidate convolution lengths for a Bluestein
+// transform of n points, cheapest estimate first: every 5-smooth size from
+// 2n-1 up to the next power of two, scored by BluesteinCost.
+func BluesteinSizes[T Complex](n int, features cpu.Features) []int {
+	lo := max(2*n-1, 1)
+	hi := m.NextPowerOfTwo(lo)
+
+	var sizes []int
+
+	for p2 := 1; p2 <= hi; p2 *= 2 {
+		for p3 := p2; p3 <= hi; p3 *= 3 {
+			for p5 := p3; p5 <= hi; p5 *= 5 {
+				if p5 >= lo {
+					sizes = append(sizes, p5)
+				}
+			}
+		}
+	}
+
+	costs := make(map[int]float64, len(sizes))
+	for _, size := range sizes {
+		costs[size] = BluesteinCost[T](size, features)
+	}
+
+	sort.Slice(sizes, func(i, j int) bool {
+		if costs[sizes[i]] != costs[sizes[j]] {
+			return costs[sizes[i]] < costs[sizes[j]]
+		}
+
+		return sizes[i] < sizes[j]
+	})
+
+	return sizes
+}
+
+// BluesteinCost estimates the cost of one m-point transform as m·log2(m)
+// times the weight of the kernel it runs on.
+func BluesteinCost[T Complex](m int, features cpu.Features) float64 {
+	weight := bluesteinWeightMixed
+
+	switch {
+	case HasCodelet[T](m, features):
+		weight = bluesteinWeightCodelet
+	case IsPowerOf2(m):
+		weight = bluesteinWeightPow2
+	default:
+		for r := m; r%3 == 0; r /= 3 {
+			weight += bluesteinWeightRadix3
+		}
+
+		for r := m; r%5 == 0; r /= 5 {
+			weight += bluesteinWeightRadix5
+		}
+	}
+
+	return float64(m) * math.Log2(float64(max(m, 2))) * weight
+}
+
+// PFAApplicable reports whether the Good–Thomas prime-factor algorithm can
+// transform n points: n must split into at least two coprime prime powers,
+// each of which is 2, 3, 4, 5, 7, 11 or 13.
+func PFAApplicable(n int) bool {
+	factors := m.CoprimeFactors(n)
+	if len(factors) < 2 {
+		return false
+	}
+
+	for _, f := range factors {
+		switch f {
+		case 2, 3, 4, 5, 7, 11, 13:
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// pfaPreferred reports whether EstimatePlan picks the prime-factor algorithm
+// for n: its prime factors are pairwise coprime (all distinct), so no stage
+// needs the twiddle multiplications of the mixed-radix kernels.
+func pfaPreferred(n int) bool {
+	return PFAApplicable(n) && len(m.Factorize(n)) == len(m.CoprimeFactors(n))
+}
+
+// RaderMinSize is the smallest prime EstimatePlan runs with Rader's algorithm
+// on its own. Below it, Bluestein's padded power-of-two transforms hit
+// codelets and the extra permutation passes of Rader do not pay off.
+const RaderMinSize = 64
+
+// RaderApplicable reports whether Rader's algorithm can transform n points:
+// n must be an odd prime. When the mixed-radix kernels cannot factor n-1,
+// the cyclic convolution is zero-padded (see RaderConvolutionLength).
+func RaderApplicable(n int) bool {
+	return n > 2 && IsPrime(n)
+}
+
+// RaderConvolutionLength returns the length of the cyclic convolution a
+// Rader transform of the prime n runs on: n-1 when the mixed-radix kernels
+// can factor it, otherwise the cheapest BluesteinSizes length of at least
+// 2n-3, which holds the length-(n-1) convolution without wrap-around.
+func RaderConvolutionLength[T Complex](n int, features cpu.Features) int {
+	if IsHighlyComposite(n - 1) {
+		return n - 1
+	}
+
+	return BluesteinSizes[T](n-1, features)[0]
+}
+
+// estimatePrime chooses between Rader's and Bluestein's algorithm for sizes
+// the mixed-radix kernels cannot handle. A forced strategy or a wisdom entry
+// selects either one explicitly; otherwise Rader is used for primes of at
+// least RaderMinSize whose n-1 factors well. A padded Rader convolution is
+// about as long as Bluestein's, so only the measuring planners, which time
+// both, pick it on their own.
+func estimatePrime[T Complex](n int, features cpu.Features, wisdom WisdomStore, forcedStrategy KernelStrategy) PlanEstimate[T] {
+	rader := PlanEstimate[T]{Strategy: KernelRader, Algorithm: "rader"}
+	bluestein := PlanEstimate[T]{Strategy: KernelBluestein, Algorithm: "bluestein"}
+
+	if !RaderApplicable(n) {
+		return bluestein
+	}
+
+	switch forcedStrategy {
+	case KernelRader:
+		return rader
+	case KernelBluestein:
+		return bluestein
+	}
+
+	if _, strategy, found := resolveWisdom[T](n, features, wisdom, KernelAuto); found {
+		if strategy == KernelBluestein {
+			return bluestein
+		}
+
+		if strategy == KernelRader {
+			return rader
+		}
+	}
+
+	if n >= RaderMinSize && IsHighlyComposite(n-1) {
+		return rader
+	}
+
+	return bluestein
+}
+
 func tryRegistry[T Complex](n int, features cpu.Features, forcedStrategy KernelStrategy) *PlanEstimate[T] {
 	registry := GetRegistry[T]()
 	if registry == nil {
@@ -84,11 +308,11 @@ func tryRegistry[T Complex](n int, features cpu.Features, forcedStrategy KernelS
 	}
 
 	entry := registry.Lookup(n, features)
-	if entry == nil {
-		return nil
+	if forcedStrategy != KernelAuto {
+		entry = registry.LookupAlgorithm(n, features, forcedStrategy)
 	}
 
-	if forcedStrategy != KernelAuto && entry.Algorithm != forcedStrategy {
+	if entry == nil {
 		return nil
 	}
 
@@ -159,6 +383,12 @@ func resolveWisdom[T Complex](n int, features cpu.Features, wisdom WisdomStore,
 		strategy = KernelEightStep
 	case "bluestein":
 		strategy = KernelBluestein
+	case "rader":
+		strategy = KernelRader
+	case "pfa":
+		strategy = KernelPFA
+	case "splitradix":
+		strategy = KernelSplitRadix
 	default:
 		return nil, KernelAuto, false
 	}
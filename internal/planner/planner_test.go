@@ -1,6 +1,7 @@
 package planner
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/cwbudde/algo-fft/internal/cpu"
@@ -99,7 +100,8 @@ func TestEstimatePlanNonPowerOf2(t *testing.T) {
 		{"Size 1000 (highly composite)", 1000, false}, // 2³ × 5³ - not bluestein
 		{"Size 1500 (highly composite)", 1500, false}, // 2² × 3 × 5³ - not bluestein
 		{"Size 3072 (highly composite)", 3072, false}, // 2¹⁰ × 3 - not bluestein
-		{"Size 1001 (not composite)", 1001, true},     // 7 × 11 × 13 - bluestein required
+		{"Size 1001 (highly composite)", 1001, false}, // 7 × 11 × 13 - not bluestein
+		{"Size 1003 (not composite)", 1003, true},     // 17 × 59 - bluestein required
 	}
 
 	features := cpu.Features{
@@ -115,13 +117,13 @@ func TestEstimatePlanNonPowerOf2(t *testing.T) {
 
 			if tt.expectBluestein {
 				if estimate.Algorithm != "bluestein" {
-					t.Errorf("EstimatePlan(%d) algorithm = %q, want \"bluestein\" for non-5-smooth number",
+					t.Errorf("EstimatePlan(%d) algorithm = %q, want \"bluestein\" for a size with factors above 13",
 						tt.size, estimate.Algorithm)
 				}
 			} else {
 				// Highly composite numbers use fallback strategies, not bluestein
 				if estimate.Algorithm == "bluestein" {
-					t.Errorf("EstimatePlan(%d) algorithm = \"bluestein\", but %d is 5-smooth",
+					t.Errorf("EstimatePlan(%d) algorithm = \"bluestein\", but %d is 13-smooth",
 						tt.size, tt.size)
 				}
 			}
@@ -129,6 +131,70 @@ func TestEstimatePlanNonPowerOf2(t *testing.T) {
 	}
 }
 
+// TestEstimatePlanPrime tests the choice between Rader and Bluestein.
+func TestEstimatePlanPrime(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{
+		Architecture: "amd64",
+		HasSSE2:      true,
+	}
+
+	wisdom := NewWisdom()
+	wisdom.Store(WisdomEntry{
+		Key: WisdomKey{
+			Size:        641,
+			Precision:   0,
+			CPUFeatures: CPUFeatureMask(true, false, false, false),
+		},
+		Algorithm: "bluestein",
+	})
+
+	tests := []struct {
+		name   string
+		size   int
+		wisdom WisdomStore
+		forced KernelStrategy
+		want   KernelStrategy
+	}{
+		{"small prime", 17, nil, KernelAuto, KernelBluestein},
+		{"forced small prime", 17, nil, KernelRader, KernelRader},
+		{"smooth n-1", 257, nil, KernelAuto, KernelRader},
+		{"rough n-1", 1019, nil, KernelAuto, KernelBluestein},
+		{"forced rough n-1", 1019, nil, KernelRader, KernelRader},
+		{"composite", 1003, nil, KernelRader, KernelBluestein},
+		{"wisdom", 641, wisdom, KernelAuto, KernelBluestein},
+		{"wisdom overridden", 641, wisdom, KernelRader, KernelRader},
+	}
+
+	for _, tt := range tests {
+		estimate := EstimatePlan[complex64](tt.size, features, tt.wisdom, tt.forced)
+		if estimate.Strategy != tt.want {
+			t.Errorf("%s: EstimatePlan(%d) strategy = %v, want %v", tt.name, tt.size, estimate.Strategy, tt.want)
+		}
+	}
+}
+
+func TestRaderConvolutionLength(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{Architecture: "amd64", HasSSE2: true}
+
+	for _, n := range []int{5, 257, 1009} {
+		if got := RaderConvolutionLength[complex64](n, features); got != n-1 {
+			t.Errorf("RaderConvolutionLength(%d) = %d, want %d", n, got, n-1)
+		}
+	}
+
+	// 1018 = 2·509 and 4098 = 2·3·683 are padded to a 5-smooth length.
+	for _, n := range []int{1019, 4099} {
+		got := RaderConvolutionLength[complex64](n, features)
+		if got < 2*n-3 || !IsHighlyComposite(got) {
+			t.Errorf("RaderConvolutionLength(%d) = %d, want a smooth length >= %d", n, got, 2*n-3)
+		}
+	}
+}
+
 // TestEstimatePlanComplex128 tests EstimatePlan with complex128 precision.
 func TestEstimatePlanComplex128(t *testing.T) {
 	t.Parallel()
@@ -201,6 +267,40 @@ func TestEstimatePlanWisdomOverriddenByForce(t *testing.T) {
 	}
 }
 
+// TestEstimatePlanSplitRadix tests forcing and replaying the split-radix strategy.
+func TestEstimatePlanSplitRadix(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{Architecture: "riscv64"}
+
+	estimate := EstimatePlan[complex128](4096, features, nil, KernelSplitRadix)
+	if estimate.Strategy != KernelSplitRadix || estimate.Algorithm != "splitradix" {
+		t.Errorf("forced split radix: got %v/%q, want KernelSplitRadix/\"splitradix\"", estimate.Strategy, estimate.Algorithm)
+	}
+
+	// Split radix only handles powers of two; other sizes ignore the request.
+	if estimate := EstimatePlan[complex128](60, features, nil, KernelSplitRadix); estimate.Strategy == KernelSplitRadix {
+		t.Errorf("EstimatePlan(60) strategy = %v, want a mixed-radix strategy", estimate.Strategy)
+	}
+
+	// So does the recursive kernel, including 7/11/13-smooth sizes.
+	for _, n := range []int{7, 60, 1001, 2002} {
+		if estimate := EstimatePlan[complex128](n, features, nil, KernelRecursive); estimate.Strategy == KernelRecursive {
+			t.Errorf("EstimatePlan(%d, Recursive) strategy = %v, want a fallback strategy", n, estimate.Strategy)
+		}
+	}
+
+	wisdom := NewWisdom()
+	wisdom.Store(WisdomEntry{
+		Key:       WisdomKey{Size: 4096, Precision: 1, CPUFeatures: CPUFeatureMask(false, false, false, false)},
+		Algorithm: "splitradix",
+	})
+
+	if estimate := EstimatePlan[complex128](4096, features, wisdom, KernelAuto); estimate.Strategy != KernelSplitRadix {
+		t.Errorf("EstimatePlan with wisdom: strategy = %v, want KernelSplitRadix", estimate.Strategy)
+	}
+}
+
 // TestHasCodelet tests the HasCodelet function.
 func TestHasCodelet(t *testing.T) {
 	t.Parallel()
@@ -216,3 +316,119 @@ func TestHasCodelet(t *testing.T) {
 		t.Error("HasCodelet should return false when no codelets registered")
 	}
 }
+
+// TestEstimatePlanPFA tests when the prime-factor algorithm is chosen.
+func TestEstimatePlanPFA(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{
+		Architecture: "amd64",
+		HasSSE2:      true,
+	}
+
+	tests := []struct {
+		name   string
+		size   int
+		forced KernelStrategy
+		pfa    bool
+	}{
+		{"distinct primes", 15, KernelAuto, true},
+		{"three primes", 105, KernelAuto, true},
+		{"odd radices", 1001, KernelAuto, true},
+		{"repeated prime", 60, KernelAuto, false},
+		{"forced coprime powers", 60, KernelPFA, true},
+		{"forced unsupported power", 18, KernelPFA, false},
+		{"forced power of two", 64, KernelPFA, false},
+		{"forced DIT", 15, KernelDIT, false},
+	}
+
+	for _, tt := range tests {
+		estimate := EstimatePlan[complex64](tt.size, features, nil, tt.forced)
+		if got := estimate.Strategy == KernelPFA; got != tt.pfa {
+			t.Errorf("%s: EstimatePlan(%d) strategy = %v, want PFA = %v", tt.name, tt.size, estimate.Strategy, tt.pfa)
+		}
+
+		if tt.pfa && estimate.Algorithm != "pfa" {
+			t.Errorf("%s: EstimatePlan(%d) algorithm = %q, want \"pfa\"", tt.name, tt.size, estimate.Algorithm)
+		}
+	}
+}
+
+// TestBluesteinSizes tests the Bluestein padding candidates.
+func TestBluesteinSizes(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{
+		Architecture: "amd64",
+		HasSSE2:      true,
+	}
+
+	for _, n := range []int{2, 17, 97, 1019, 4099} {
+		sizes := BluesteinSizes[complex64](n, features)
+		if len(sizes) == 0 {
+			t.Fatalf("BluesteinSizes(%d) returned no candidates", n)
+		}
+
+		for i, size := range sizes {
+			if size < 2*n-1 {
+				t.Errorf("n=%d: size %d below 2n-1", n, size)
+			}
+
+			rest := size
+			for _, p := range []int{2, 3, 5} {
+				for rest%p == 0 {
+					rest /= p
+				}
+			}
+
+			if rest != 1 {
+				t.Errorf("n=%d: size %d is not 5-smooth", n, size)
+			}
+
+			if i > 0 && BluesteinCost[complex64](size, features) < BluesteinCost[complex64](sizes[i-1], features) {
+				t.Errorf("n=%d: sizes not ordered by cost at %d", n, i)
+			}
+		}
+
+		// The next power of two is always a candidate.
+		if !slices.Contains(sizes, nextPowerOfTwo(2*n-1)) {
+			t.Errorf("n=%d: candidates %v miss the next power of two", n, sizes)
+		}
+	}
+
+	// 2*4099-1 lies just above 8192, where a smooth size such as 8640 is
+	// cheaper than padding to 16384.
+	if sizes := BluesteinSizes[complex64](4099, features); sizes[0] == 16384 {
+		t.Errorf("BluesteinSizes(4099)[0] = 16384, want a smaller smooth size")
+	}
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+
+	return p
+}
+
+// TestFallbackPlan tests the estimate that replaces rejected codelets once
+// the registry has no candidates left.
+func TestFallbackPlan(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{Architecture: "riscv64"}
+
+	if est := FallbackPlan[complex64](64, features, KernelAuto, []string{"dit64_generic"}); est.ForwardCodelet != nil || est.Strategy != KernelDIT {
+		t.Errorf("FallbackPlan(64) = %v/%q, want DIT kernel", est.Strategy, est.Algorithm)
+	}
+
+	if est := FallbackPlan[complex64](4096, features, KernelSplitRadix, nil); est.Strategy != KernelSplitRadix || est.Algorithm != "splitradix" {
+		t.Errorf("FallbackPlan(4096, SplitRadix) = %v/%q, want splitradix", est.Strategy, est.Algorithm)
+	}
+
+	// Forced strategies that do not exist for the size are dropped.
+	if est := FallbackPlan[complex128](30, features, KernelSplitRadix, nil); est.Strategy != KernelPFA {
+		t.Errorf("FallbackPlan(30, SplitRadix) strategy = %v, want KernelPFA", est.Strategy)
+	}
+}
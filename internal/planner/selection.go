@@ -36,7 +36,7 @@ func RecordBenchmarkDecision(n int, strategy KernelStrategy) {
 	}
 
 	switch strategy {
-	case KernelDIT, KernelStockham, KernelSixStep, KernelEightStep:
+	case KernelDIT, KernelStockham, KernelSixStep, KernelEightStep, KernelSplitRadix:
 	default:
 		return
 	}
@@ -22,6 +22,10 @@ var IsPowerOf2 = m.IsPowerOf2
 // Re-exported from internal/math.
 var IsHighlyComposite = m.IsHighlyComposite
 
+// IsPrime checks if n is prime.
+// Re-exported from internal/math.
+var IsPrime = m.IsPrime
+
 // complexFromFloat64 creates a complex number of type T from float64 components.
 func complexFromFloat64[T Complex](re, im float64) T {
 	return m.ComplexFromFloat64[T](re, im)
@@ -64,6 +68,12 @@ func StrategyToAlgorithmName(strategy KernelStrategy) string {
 		return "eightstep"
 	case KernelBluestein:
 		return "bluestein"
+	case KernelRader:
+		return "rader"
+	case KernelPFA:
+		return "pfa"
+	case KernelSplitRadix:
+		return "splitradix"
 	default:
 		return "unknown"
 	}
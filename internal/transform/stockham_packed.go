@@ -1,5 +1,7 @@
 package transform
 
+import m "github.com/cwbudde/algo-fft/internal/math"
+
 // StockhamPackedAvailable reports whether packed Stockham is enabled in this build.
 // The stockhamPackedEnabled constant is defined in stockham_packed_toggle_*.go files.
 func StockhamPackedAvailable() bool {
@@ -7,18 +9,20 @@ func StockhamPackedAvailable() bool {
 }
 
 // ForwardStockhamPacked executes a mixed-radix (radix-4 + optional radix-2) Stockham FFT
-// using packed radix-4 twiddles when available.
-func ForwardStockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T]) bool {
-	return stockhamPacked(dst, src, twiddle, scratch, packed, false)
+// using packed radix-4 twiddles when available. The output is multiplied by
+// scale in the final pass.
+func ForwardStockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T], scale float64) bool {
+	return stockhamPacked(dst, src, twiddle, scratch, packed, false, scale)
 }
 
 // InverseStockhamPacked executes a mixed-radix (radix-4 + optional radix-2) Stockham inverse FFT
-// using packed radix-4 twiddles when available.
-func InverseStockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T]) bool {
-	return stockhamPacked(dst, src, twiddle, scratch, packed, true)
+// using packed radix-4 twiddles when available. scale is applied on top of
+// the built-in 1/N, in the same pass.
+func InverseStockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T], scale float64) bool {
+	return stockhamPacked(dst, src, twiddle, scratch, packed, true, scale)
 }
 
-func stockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T], inverse bool) bool {
+func stockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwiddles[T], inverse bool, scale float64) bool {
 	if !stockhamPackedEnabled {
 		return false
 	}
@@ -50,7 +54,7 @@ func stockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwi
 			return false
 		}
 
-		return stockhamPackedComplex64(dst64, src64, tw64, scratch64, packed64, inverse)
+		return stockhamPackedComplex64(dst64, src64, tw64, scratch64, packed64, inverse, scale)
 	case complex128:
 		dst128, ok := any(dst).([]complex128)
 		if !ok {
@@ -77,13 +81,13 @@ func stockhamPacked[T Complex](dst, src, twiddle, scratch []T, packed *PackedTwi
 			return false
 		}
 
-		return stockhamPackedComplex128(dst128, src128, tw128, scratch128, packed128, inverse)
+		return stockhamPackedComplex128(dst128, src128, tw128, scratch128, packed128, inverse, scale)
 	default:
 		return false
 	}
 }
 
-func stockhamPackedComplex64(dst, src, twiddle, scratch []complex64, packed *PackedTwiddles[complex64], inverse bool) bool {
+func stockhamPackedComplex64(dst, src, twiddle, scratch []complex64, packed *PackedTwiddles[complex64], inverse bool, scale float64) bool {
 	n := len(src)
 	if n == 0 {
 		return true
@@ -179,21 +183,16 @@ func stockhamPackedComplex64(dst, src, twiddle, scratch []complex64, packed *Pac
 		m /= 4
 	}
 
-	if !inIsDst {
-		copy(dst, in)
-	}
-
 	if inverse {
-		scale := float32(1.0 / float64(n))
-		for i := range dst {
-			dst[i] *= complex(scale, 0)
-		}
+		scale /= float64(n)
 	}
 
+	finishScaled(dst[:n], in, inIsDst, scale)
+
 	return true
 }
 
-func stockhamPackedComplex128(dst, src, twiddle, scratch []complex128, packed *PackedTwiddles[complex128], inverse bool) bool {
+func stockhamPackedComplex128(dst, src, twiddle, scratch []complex128, packed *PackedTwiddles[complex128], inverse bool, scale float64) bool {
 	n := len(src)
 	if n == 0 {
 		return true
@@ -289,17 +288,12 @@ func stockhamPackedComplex128(dst, src, twiddle, scratch []complex128, packed *P
 		m /= 4
 	}
 
-	if !inIsDst {
-		copy(dst, in)
-	}
-
 	if inverse {
-		scale := 1.0 / float64(n)
-		for i := range dst {
-			dst[i] *= complex(scale, 0)
-		}
+		scale /= float64(n)
 	}
 
+	finishScaled(dst[:n], in, inIsDst, scale)
+
 	return true
 }
 
@@ -499,3 +493,24 @@ func packedStageIndex(size int) (int, bool) {
 
 	return idx, true
 }
+
+// finishScaled moves the last stage's output from in into dst (unless it is
+// already there) and multiplies it by scale, touching each element once.
+func finishScaled[T Complex](dst, in []T, inIsDst bool, scale float64) {
+	switch {
+	case scale == 1:
+		if !inIsDst {
+			copy(dst, in)
+		}
+	case inIsDst:
+		factor := m.ComplexFromFloat64[T](scale, 0)
+		for i := range dst {
+			dst[i] *= factor
+		}
+	default:
+		factor := m.ComplexFromFloat64[T](scale, 0)
+		for i, v := range in[:len(dst)] {
+			dst[i] = v * factor
+		}
+	}
+}
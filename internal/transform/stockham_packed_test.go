@@ -26,7 +26,7 @@ func TestStockhamPackedForwardMatchesReferenceComplex64(t *testing.T) {
 		dst := make([]complex64, n)
 
 		scratch := make([]complex64, n)
-		if !ForwardStockhamPacked(dst, src, twiddle, scratch, packed) {
+		if !ForwardStockhamPacked(dst, src, twiddle, scratch, packed, 1) {
 			t.Fatalf("ForwardStockhamPacked(%d) returned false", n)
 		}
 
@@ -55,7 +55,7 @@ func TestStockhamPackedInverseMatchesReferenceComplex64(t *testing.T) {
 		dst := make([]complex64, n)
 
 		scratch := make([]complex64, n)
-		if !InverseStockhamPacked(dst, src, twiddle, scratch, packed) {
+		if !InverseStockhamPacked(dst, src, twiddle, scratch, packed, 1) {
 			t.Fatalf("InverseStockhamPacked(%d) returned false", n)
 		}
 
@@ -84,7 +84,7 @@ func TestStockhamPackedForwardMatchesReferenceComplex128(t *testing.T) {
 		dst := make([]complex128, n)
 
 		scratch := make([]complex128, n)
-		if !ForwardStockhamPacked(dst, src, twiddle, scratch, packed) {
+		if !ForwardStockhamPacked(dst, src, twiddle, scratch, packed, 1) {
 			t.Fatalf("ForwardStockhamPacked(%d) returned false", n)
 		}
 
@@ -113,7 +113,7 @@ func TestStockhamPackedInverseMatchesReferenceComplex128(t *testing.T) {
 		dst := make([]complex128, n)
 
 		scratch := make([]complex128, n)
-		if !InverseStockhamPacked(dst, src, twiddle, scratch, packed) {
+		if !InverseStockhamPacked(dst, src, twiddle, scratch, packed, 1) {
 			t.Fatalf("InverseStockhamPacked(%d) returned false", n)
 		}
 
@@ -144,7 +144,7 @@ func TestStockhamPackedMatchesStockhamComplex64(t *testing.T) {
 		scratch := make([]complex64, n)
 		scratchGo := make([]complex64, n)
 
-		if !ForwardStockhamPacked(dstPacked, src, twiddle, scratch, packed) {
+		if !ForwardStockhamPacked(dstPacked, src, twiddle, scratch, packed, 1) {
 			t.Fatalf("ForwardStockhamPacked(%d) returned false", n)
 		}
 
@@ -178,7 +178,7 @@ func TestStockhamPackedMatchesStockhamComplex128(t *testing.T) {
 		scratch := make([]complex128, n)
 		scratchGo := make([]complex128, n)
 
-		if !ForwardStockhamPacked(dstPacked, src, twiddle, scratch, packed) {
+		if !ForwardStockhamPacked(dstPacked, src, twiddle, scratch, packed, 1) {
 			t.Fatalf("ForwardStockhamPacked(%d) returned false", n)
 		}
 
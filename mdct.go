@@ -0,0 +1,295 @@
+package algofft
+
+import (
+	"math"
+)
+
+// MDCTWindow selects the analysis/synthesis window of an MDCT. Both windows
+// satisfy the Princen-Bradley condition w[n]² + w[n+N/2]² = 1, so
+// overlap-adding consecutive inverse frames cancels the time-domain aliasing.
+type MDCTWindow uint8
+
+const (
+	// WindowSine is w[n] = sin(π(n+½)/N), used by MP3, Vorbis and AAC.
+	WindowSine MDCTWindow = iota
+
+	// WindowKBD is the Kaiser-Bessel-derived window of AAC and AC-3, shaped
+	// by MDCTOptions.KBDAlpha.
+	WindowKBD
+)
+
+// defaultKBDAlpha is the KBD alpha used when MDCTOptions.KBDAlpha is zero,
+// that of AAC long blocks.
+const defaultKBDAlpha = 4
+
+// MDCTOptions configures an MDCT.
+type MDCTOptions struct {
+	// Window is the analysis and synthesis window.
+	Window MDCTWindow
+
+	// KBDAlpha shapes WindowKBD: larger values trade main-lobe width for
+	// stopband attenuation. 0 means 4.
+	KBDAlpha float64
+}
+
+// MDCT is a pre-computed modified discrete cosine transform for frames of N
+// samples and N/2 coefficients, with a window applied on both sides:
+//
+//	X[k] = Σ_{n=0}^{N-1} w[n] x[n] cos(2π/N (n + ½ + N/4)(k + ½))
+//
+// Inverse returns w[n]·(4/N) Σ_k X[k] cos(2π/N (n + ½ + N/4)(k + ½)), which
+// is aliased in time; adding the second half of one inverse frame to the
+// first half of the next reconstructs the input exactly when frames advance
+// by N/2 (time-domain aliasing cancellation). MDCTOverlapAdd does this for
+// streams.
+//
+// The input is folded into N/2 values whose DCT-IV gives X; the DCT-IV runs
+// on an N/4-point complex FFT with pre- and post-twiddles. Transforms do not
+// allocate.
+//
+// An MDCT reuses internal buffers and is not safe for concurrent use.
+type MDCT[F Float] struct {
+	n      int
+	window []F
+	dct    *PlanDCT[F] // unnormalized DCT-IV of length N/2
+	fold   []F
+}
+
+// NewMDCT creates an MDCT for frames of frameSize samples with the given
+// window.
+func NewMDCT[F Float](frameSize int, window MDCTWindow) (*MDCT[F], error) {
+	return NewMDCTWithOptions[F](frameSize, MDCTOptions{Window: window})
+}
+
+// NewMDCTWithOptions creates an MDCT with explicit options.
+//
+// Returns ErrInvalidLength if frameSize is not a positive multiple of 4.
+// Returns ErrInvalidWindow for an unknown window or a negative KBDAlpha.
+func NewMDCTWithOptions[F Float](frameSize int, opts MDCTOptions) (*MDCT[F], error) {
+	if frameSize < 4 || frameSize%4 != 0 {
+		return nil, ErrInvalidLength
+	}
+
+	window, err := mdctWindow[F](frameSize, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dct, err := NewPlanDCTWithOptions[F](frameSize/2, TypeIV, PlanOptions{Normalization: NormNone})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MDCT[F]{
+		n:      frameSize,
+		window: window,
+		dct:    dct,
+		fold:   make([]F, frameSize/2),
+	}, nil
+}
+
+// mdctWindow returns the N-point window selected by opts.
+func mdctWindow[F Float](n int, opts MDCTOptions) ([]F, error) {
+	window := make([]F, n)
+	half := n / 2
+
+	// Both windows are symmetric; the second half mirrors the first.
+	switch opts.Window {
+	case WindowSine:
+		for i := range half {
+			w := F(math.Sin(math.Pi * (float64(i) + 0.5) / float64(n)))
+			window[i] = w
+			window[n-1-i] = w
+		}
+	case WindowKBD:
+		alpha := opts.KBDAlpha
+		if alpha < 0 {
+			return nil, ErrInvalidWindow
+		}
+
+		if alpha == 0 {
+			alpha = defaultKBDAlpha
+		}
+
+		// Cumulative sums of the (N/2+1)-point Kaiser window with β = πα.
+		cumulative := make([]float64, half+1)
+
+		var sum float64
+
+		for j := range cumulative {
+			r := 2*float64(j)/float64(half) - 1
+			sum += besselI0(math.Pi * alpha * math.Sqrt(1-r*r))
+			cumulative[j] = sum
+		}
+
+		for i := range half {
+			w := F(math.Sqrt(cumulative[i] / sum))
+			window[i] = w
+			window[n-1-i] = w
+		}
+	default:
+		return nil, ErrInvalidWindow
+	}
+
+	return window, nil
+}
+
+// besselI0 evaluates the modified Bessel function of the first kind of order
+// zero by its power series, which converges for all x.
+func besselI0(x float64) float64 {
+	sum, term := 1.0, 1.0
+	q := x * x / 4
+
+	for k := 1.0; term > sum*1e-17; k++ {
+		term *= q / (k * k)
+		sum += term
+	}
+
+	return sum
+}
+
+// FrameSize returns the number of time samples per frame, N.
+func (m *MDCT[F]) FrameSize() int {
+	return m.n
+}
+
+// SpectrumLen returns the number of coefficients per frame, N/2. It is also
+// the hop size between overlapping frames.
+func (m *MDCT[F]) SpectrumLen() int {
+	return m.n / 2
+}
+
+// Window returns a copy of the window.
+func (m *MDCT[F]) Window() []F {
+	return append([]F(nil), m.window...)
+}
+
+// Forward windows the N samples of src and computes their N/2 MDCT
+// coefficients into dst.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if len(src) != FrameSize() or
+// len(dst) != SpectrumLen().
+func (m *MDCT[F]) Forward(dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != m.n || len(dst) != m.n/2 {
+		return ErrLengthMismatch
+	}
+
+	// Fold the windowed frame (a, b, c, d) of quarters into (-c_r - d, a - b_r)
+	// of length N/2, with _r denoting reversal. The factor ½ cancels the 2 of
+	// the unnormalized DCT-IV.
+	half := m.n / 2
+	quarter := m.n / 4
+	w := m.window
+	at := func(i int) float64 { return float64(w[i]) * float64(src[i]) }
+
+	for i := range quarter {
+		m.fold[i] = F(-(at(3*quarter-1-i) + at(3*quarter+i)) / 2)
+		m.fold[quarter+i] = F((at(i) - at(half-1-i)) / 2)
+	}
+
+	return m.dct.Forward(dst, m.fold)
+}
+
+// Inverse computes the N windowed, time-aliased samples of the N/2
+// coefficients in src into dst. Overlap-adding consecutive frames at a hop
+// of N/2 reconstructs the signal; see MDCTOverlapAdd.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if len(src) != SpectrumLen() or
+// len(dst) != FrameSize().
+func (m *MDCT[F]) Inverse(dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != m.n/2 || len(dst) != m.n {
+		return ErrLengthMismatch
+	}
+
+	err := m.dct.Forward(m.fold, src)
+	if err != nil {
+		return err
+	}
+
+	// Unfold with the transpose of Forward's folding. The DCT-IV carries a
+	// factor 2, so 2/N leaves the 4/N of the definition.
+	half := m.n / 2
+	quarter := m.n / 4
+	scale := 2 / float64(m.n)
+	w := m.window
+	put := func(i int, v float64) { dst[i] = F(float64(w[i]) * v * scale) }
+
+	for i := range quarter {
+		u := float64(m.fold[i])
+		put(3*quarter-1-i, -u)
+		put(3*quarter+i, -u)
+
+		u = float64(m.fold[quarter+i])
+		put(i, u)
+		put(half-1-i, -u)
+	}
+
+	return nil
+}
+
+// MDCTOverlapAdd reconstructs a stream from consecutive MDCT frames. It
+// keeps the second half of the previous inverse frame and adds it to the
+// first half of the next, so each call emits N/2 finished samples, delayed
+// by N/2 against the analysis input.
+//
+// Like its MDCT, it is not safe for concurrent use.
+type MDCTOverlapAdd[F Float] struct {
+	mdct    *MDCT[F]
+	frame   []F
+	overlap []F
+}
+
+// NewOverlapAdd returns a streaming synthesizer for m with an empty (zero)
+// overlap. It shares m's buffers, so m must not be used concurrently.
+func (m *MDCT[F]) NewOverlapAdd() *MDCTOverlapAdd[F] {
+	return &MDCTOverlapAdd[F]{
+		mdct:    m,
+		frame:   make([]F, m.n),
+		overlap: make([]F, m.n/2),
+	}
+}
+
+// Synthesize inverse-transforms the N/2 coefficients in coeffs and writes
+// the next N/2 reconstructed samples into dst. The first call after
+// creation or Reset returns the fade-in of the first frame only.
+//
+// Returns ErrNilSlice if dst or coeffs is nil.
+// Returns ErrLengthMismatch if either length differs from SpectrumLen().
+func (o *MDCTOverlapAdd[F]) Synthesize(dst, coeffs []F) error {
+	if dst == nil || coeffs == nil {
+		return ErrNilSlice
+	}
+
+	half := o.mdct.n / 2
+	if len(dst) != half {
+		return ErrLengthMismatch
+	}
+
+	err := o.mdct.Inverse(o.frame, coeffs)
+	if err != nil {
+		return err
+	}
+
+	for i := range dst {
+		dst[i] = o.overlap[i] + o.frame[i]
+	}
+
+	copy(o.overlap, o.frame[half:])
+
+	return nil
+}
+
+// Reset clears the overlap, starting a new stream.
+func (o *MDCTOverlapAdd[F]) Reset() {
+	clear(o.overlap)
+}
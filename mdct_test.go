@@ -0,0 +1,195 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// naiveMDCT evaluates the windowed MDCT definition given on MDCT.
+func naiveMDCT(x, w []float64) []float64 {
+	n := len(x)
+	y := make([]float64, n/2)
+
+	for k := range y {
+		for j, v := range x {
+			y[k] += w[j] * v * math.Cos(2*math.Pi/float64(n)*(float64(j)+0.5+float64(n)/4)*(float64(k)+0.5))
+		}
+	}
+
+	return y
+}
+
+// naiveIMDCT evaluates the windowed inverse given on MDCT.
+func naiveIMDCT(coeffs, w []float64) []float64 {
+	n := 2 * len(coeffs)
+	y := make([]float64, n)
+
+	for j := range y {
+		for k, v := range coeffs {
+			y[j] += v * math.Cos(2*math.Pi/float64(n)*(float64(j)+0.5+float64(n)/4)*(float64(k)+0.5))
+		}
+
+		y[j] *= w[j] * 4 / float64(n)
+	}
+
+	return y
+}
+
+func TestMDCT_MatchesDefinition(t *testing.T) {
+	t.Parallel()
+
+	for _, window := range []MDCTWindow{WindowSine, WindowKBD} {
+		for _, n := range []int{4, 8, 12, 32, 40, 256} {
+			name := fmt.Sprintf("window %d/%d", window, n)
+
+			mdct, err := NewMDCT[float64](n, window)
+			if err != nil {
+				t.Fatalf("%s: NewMDCT failed: %v", name, err)
+			}
+
+			if mdct.FrameSize() != n || mdct.SpectrumLen() != n/2 {
+				t.Fatalf("%s: FrameSize/SpectrumLen = %d/%d", name, mdct.FrameSize(), mdct.SpectrumLen())
+			}
+
+			src := make([]float64, n)
+			for i, v := range randomComplex128Slice(n, uint64(n)) {
+				src[i] = real(v)
+			}
+
+			w := mdct.Window()
+			coeffs := make([]float64, n/2)
+
+			if err := mdct.Forward(coeffs, src); err != nil {
+				t.Fatalf("%s: Forward failed: %v", name, err)
+			}
+
+			for k, want := range naiveMDCT(src, w) {
+				if math.Abs(coeffs[k]-want) > 1e-12*float64(n) {
+					t.Fatalf("%s: X[%d] = %v, want %v", name, k, coeffs[k], want)
+				}
+			}
+
+			frame := make([]float64, n)
+
+			if err := mdct.Inverse(frame, coeffs); err != nil {
+				t.Fatalf("%s: Inverse failed: %v", name, err)
+			}
+
+			for i, want := range naiveIMDCT(coeffs, w) {
+				if math.Abs(frame[i]-want) > 1e-12*float64(n) {
+					t.Fatalf("%s: y[%d] = %v, want %v", name, i, frame[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestMDCT_PrincenBradley(t *testing.T) {
+	t.Parallel()
+
+	for _, opts := range []MDCTOptions{
+		{Window: WindowSine},
+		{Window: WindowKBD},
+		{Window: WindowKBD, KBDAlpha: 6},
+	} {
+		mdct, err := NewMDCTWithOptions[float64](64, opts)
+		if err != nil {
+			t.Fatalf("%+v: NewMDCTWithOptions failed: %v", opts, err)
+		}
+
+		w := mdct.Window()
+		for i := range 32 {
+			if sum := w[i]*w[i] + w[i+32]*w[i+32]; math.Abs(sum-1) > 1e-14 {
+				t.Fatalf("%+v: w[%d]² + w[%d]² = %v, want 1", opts, i, i+32, sum)
+			}
+
+			if w[i] != w[63-i] {
+				t.Fatalf("%+v: window not symmetric at %d", opts, i)
+			}
+		}
+	}
+}
+
+func TestMDCT_OverlapAddReconstructs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n      = 64
+		hop    = n / 2
+		frames = 10
+	)
+
+	for _, window := range []MDCTWindow{WindowSine, WindowKBD} {
+		mdct, err := NewMDCT[float32](n, window)
+		if err != nil {
+			t.Fatalf("NewMDCT failed: %v", err)
+		}
+
+		// One hop of leading silence primes the overlap.
+		signal := append(make([]float32, hop), randomFloat32Slice(frames*hop, 7)...)
+		ola := mdct.NewOverlapAdd()
+		coeffs := make([]float32, hop)
+		out := make([]float32, hop)
+
+		for run := range 2 {
+			for f := range frames {
+				if err := mdct.Forward(coeffs, signal[f*hop:f*hop+n]); err != nil {
+					t.Fatalf("Forward failed: %v", err)
+				}
+
+				if err := ola.Synthesize(out, coeffs); err != nil {
+					t.Fatalf("Synthesize failed: %v", err)
+				}
+
+				// Output lags the frame start by one hop.
+				if f > 0 {
+					name := fmt.Sprintf("window %d run %d frame %d", window, run, f)
+					assertScaledFloat32(t, out, signal[f*hop:(f+1)*hop], 1, 1e-5, name)
+				}
+			}
+
+			ola.Reset()
+		}
+	}
+}
+
+func TestMDCT_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 2, 6, 30} {
+		if _, err := NewMDCT[float64](n, WindowSine); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("frame size %d: %v, want ErrInvalidLength", n, err)
+		}
+	}
+
+	if _, err := NewMDCT[float32](16, MDCTWindow(9)); !errors.Is(err, ErrInvalidWindow) {
+		t.Errorf("window 9: %v, want ErrInvalidWindow", err)
+	}
+
+	if _, err := NewMDCTWithOptions[float32](16, MDCTOptions{Window: WindowKBD, KBDAlpha: -1}); !errors.Is(err, ErrInvalidWindow) {
+		t.Errorf("negative alpha: %v, want ErrInvalidWindow", err)
+	}
+
+	mdct, err := NewMDCT[float64](16, WindowSine)
+	if err != nil {
+		t.Fatalf("NewMDCT failed: %v", err)
+	}
+
+	if err := mdct.Forward(nil, make([]float64, 16)); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: %v, want ErrNilSlice", err)
+	}
+
+	if err := mdct.Forward(make([]float64, 8), make([]float64, 8)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short frame: %v, want ErrLengthMismatch", err)
+	}
+
+	if err := mdct.Inverse(make([]float64, 8), make([]float64, 8)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short output: %v, want ErrLengthMismatch", err)
+	}
+
+	if err := mdct.NewOverlapAdd().Synthesize(make([]float64, 16), make([]float64, 8)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("long hop: %v, want ErrLengthMismatch", err)
+	}
+}
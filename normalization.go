@@ -0,0 +1,131 @@
+package algofft
+
+import "math"
+
+// Normalization selects how forward and inverse transforms are scaled.
+//
+// The conventions mirror numpy.fft's "norm" argument:
+//   - NormBackward: forward unscaled, inverse scaled by 1/N (default)
+//   - NormForward:  forward scaled by 1/N, inverse unscaled
+//   - NormOrtho:    both directions scaled by 1/sqrt(N) (unitary)
+//   - NormNone:     both directions unscaled
+//
+// N is the total number of complex (or real) samples of one transform,
+// e.g. rows*cols for Plan2D.
+//
+// Inverse kernels already apply 1/N in their final pass, so NormBackward
+// costs nothing extra. Other conventions fold the remaining correction
+// factor into an existing final pass where the plan owns one: the
+// mixed-radix and packed Stockham kernels, the Bluestein chirp multiply and
+// Rader's output permutation, the real-FFT pack/unpack and the
+// multi-dimensional copy-out. Codelets and the remaining fallback kernels
+// have their scaling compiled in, so for them the correction is one extra
+// pass over the output; for NormForward and NormNone inverses that pass
+// undoes the codelet's own 1/N.
+type Normalization uint8
+
+const (
+	// NormBackward leaves the forward transform unscaled and scales the
+	// inverse by 1/N. This is the default and matches FFTW/numpy defaults.
+	NormBackward Normalization = iota
+
+	// NormForward scales the forward transform by 1/N and leaves the
+	// inverse unscaled.
+	NormForward
+
+	// NormOrtho scales both directions by 1/sqrt(N), making the transform unitary.
+	NormOrtho
+
+	// NormNone leaves both directions unscaled; a round trip multiplies by N.
+	NormNone
+)
+
+// String returns the numpy-style name of the normalization convention.
+func (n Normalization) String() string {
+	switch n {
+	case NormBackward:
+		return "backward"
+	case NormForward:
+		return "forward"
+	case NormOrtho:
+		return "ortho"
+	case NormNone:
+		return "none"
+	default:
+		return "unknown"
+	}
+}
+
+// normalizationScales returns the extra factors that must be applied on top
+// of the kernels' built-in scaling (forward: 1, inverse: 1/N) to obtain the
+// requested convention for a transform of n total samples.
+func normalizationScales(norm Normalization, n int) (forward, inverse float64) {
+	if n <= 0 {
+		return 1, 1
+	}
+
+	size := float64(n)
+
+	switch norm {
+	case NormForward:
+		return 1 / size, size
+	case NormOrtho:
+		root := math.Sqrt(size)
+		return 1 / root, root
+	case NormNone:
+		return 1, size
+	default:
+		return 1, 1
+	}
+}
+
+// complexScale converts a real scale factor into the complex type T.
+func complexScale[T Complex](scale float64) T {
+	var result T
+
+	switch any(result).(type) {
+	case complex64:
+		result = any(complex(float32(scale), 0)).(T)
+	case complex128:
+		result = any(complex(scale, 0)).(T)
+	}
+
+	return result
+}
+
+// scaleComplexInPlace multiplies every element of data by scale.
+// It is a no-op for scale == 1.
+func scaleComplexInPlace[T Complex](data []T, scale float64) {
+	if scale == 1 {
+		return
+	}
+
+	scaleSpectrumGeneric(data, scale)
+}
+
+// scaleCopyComplex copies src into dst while multiplying by scale.
+// It degrades to a plain copy for scale == 1. dst and src may alias.
+func scaleCopyComplex[T Complex](dst, src []T, scale float64) {
+	if scale == 1 {
+		copy(dst, src)
+		return
+	}
+
+	switch d := any(dst).(type) {
+	case []complex64:
+		s := any(src).([]complex64)
+		f := float32(scale)
+
+		for i := range d {
+			v := s[i]
+			d[i] = complex(real(v)*f, imag(v)*f)
+		}
+	case []complex128:
+		s := any(src).([]complex128)
+
+		for i := range d {
+			v := s[i]
+			d[i] = complex(real(v)*scale, imag(v)*scale)
+		}
+	}
+}
@@ -0,0 +1,467 @@
+package algofft
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+)
+
+var allNormalizations = []Normalization{NormBackward, NormForward, NormOrtho, NormNone}
+
+// expectedNormFactors returns the factor applied to the unnormalized forward
+// transform and the factor a forward+inverse round trip applies to the input.
+func expectedNormFactors(norm Normalization, n int) (forward, roundTrip float64) {
+	switch norm {
+	case NormForward:
+		return 1 / float64(n), 1
+	case NormOrtho:
+		return 1 / math.Sqrt(float64(n)), 1
+	case NormNone:
+		return 1, float64(n)
+	default:
+		return 1, 1
+	}
+}
+
+func randomComplex128Slice(n int, seed uint64) []complex128 {
+	rng := rand.New(rand.NewPCG(seed, seed^0x5EED)) //nolint:gosec
+
+	data := make([]complex128, n)
+	for i := range data {
+		data[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	return data
+}
+
+func randomFloat32Slice(n int, seed uint64) []float32 {
+	rng := rand.New(rand.NewPCG(seed, seed^0x5EED)) //nolint:gosec
+
+	data := make([]float32, n)
+	for i := range data {
+		data[i] = float32(rng.Float64()*2 - 1)
+	}
+
+	return data
+}
+
+func assertScaledComplex128(t *testing.T, got, ref []complex128, factor, tol float64, what string) {
+	t.Helper()
+
+	for i := range ref {
+		want := ref[i] * complex(factor, 0)
+		if cmplx.Abs(got[i]-want) > tol*math.Max(1, cmplx.Abs(want)) {
+			t.Fatalf("%s[%d] = %v, want %v", what, i, got[i], want)
+		}
+	}
+}
+
+func assertScaledComplex64(t *testing.T, got, ref []complex64, factor, tol float64, what string) {
+	t.Helper()
+
+	for i := range ref {
+		want := complex128(ref[i]) * complex(factor, 0)
+		if cmplx.Abs(complex128(got[i])-want) > tol*math.Max(1, cmplx.Abs(want)) {
+			t.Fatalf("%s[%d] = %v, want %v", what, i, got[i], want)
+		}
+	}
+}
+
+func assertScaledFloat32(t *testing.T, got, ref []float32, factor, tol float64, what string) {
+	t.Helper()
+
+	for i := range ref {
+		want := float64(ref[i]) * factor
+		if math.Abs(float64(got[i])-want) > tol*math.Max(1, math.Abs(want)) {
+			t.Fatalf("%s[%d] = %v, want %v", what, i, got[i], want)
+		}
+	}
+}
+
+func TestNormalization_String(t *testing.T) {
+	t.Parallel()
+
+	want := map[Normalization]string{
+		NormBackward: "backward",
+		NormForward:  "forward",
+		NormOrtho:    "ortho",
+		NormNone:     "none",
+		99:           "unknown",
+	}
+
+	for norm, name := range want {
+		if got := norm.String(); got != name {
+			t.Errorf("Normalization(%d).String() = %q, want %q", norm, got, name)
+		}
+	}
+}
+
+func TestNormalization_InvalidFallsBackToBackward(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanWithOptions[complex64](16, PlanOptions{Normalization: 42})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if got := plan.Meta().Normalization; got != NormBackward {
+		t.Fatalf("Meta().Normalization = %v, want %v", got, NormBackward)
+	}
+}
+
+func TestNormalization_Plan(t *testing.T) {
+	t.Parallel()
+
+	// 64 uses a codelet, 96 mixed-radix and 17 Bluestein.
+	for _, n := range []int{64, 96, 17} {
+		for _, norm := range allNormalizations {
+			t.Run(itoa(n)+"_"+norm.String(), func(t *testing.T) {
+				t.Parallel()
+
+				ref, err := NewPlan64(n)
+				if err != nil {
+					t.Fatalf("NewPlan64(%d) failed: %v", n, err)
+				}
+
+				plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Normalization: norm})
+				if err != nil {
+					t.Fatalf("NewPlanWithOptions(%d) failed: %v", n, err)
+				}
+
+				if got := plan.Meta().Normalization; got != norm {
+					t.Fatalf("Meta().Normalization = %v, want %v", got, norm)
+				}
+
+				src := randomComplex128Slice(n, uint64(n))
+				want := make([]complex128, n)
+				got := make([]complex128, n)
+				back := make([]complex128, n)
+
+				if err := ref.Forward(want, src); err != nil {
+					t.Fatalf("reference Forward failed: %v", err)
+				}
+
+				if err := plan.Forward(got, src); err != nil {
+					t.Fatalf("Forward failed: %v", err)
+				}
+
+				fwd, rt := expectedNormFactors(norm, n)
+				assertScaledComplex128(t, got, want, fwd, 1e-9, "forward")
+
+				if err := plan.Inverse(back, got); err != nil {
+					t.Fatalf("Inverse failed: %v", err)
+				}
+
+				assertScaledComplex128(t, back, src, rt, 1e-9, "round trip")
+			})
+		}
+	}
+}
+
+func TestNormalization_FusedExecutors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		n    int
+		opts PlanOptions
+	}{
+		{"rader", 97, PlanOptions{Strategy: KernelRader}},
+		{"stockham", 1 << 15, PlanOptions{Strategy: KernelStockham}},
+		{"fourstep", 1024, PlanOptions{Strategy: KernelSixStep, Workers: 2}},
+	}
+
+	for _, tc := range cases {
+		ref, err := NewPlan64(tc.n)
+		if err != nil {
+			t.Fatalf("%s: NewPlan64 failed: %v", tc.name, err)
+		}
+
+		src := randomComplex128Slice(tc.n, uint64(tc.n))
+		want := make([]complex128, tc.n)
+
+		if err := ref.Forward(want, src); err != nil {
+			t.Fatalf("%s: reference Forward failed: %v", tc.name, err)
+		}
+
+		for _, norm := range allNormalizations {
+			opts := tc.opts
+			opts.Normalization = norm
+
+			plan, err := NewPlanWithOptions[complex128](tc.n, opts)
+			if err != nil {
+				t.Fatalf("%s: NewPlanWithOptions failed: %v", tc.name, err)
+			}
+
+			got := make([]complex128, tc.n)
+			back := make([]complex128, tc.n)
+
+			if err := plan.Forward(got, src); err != nil {
+				t.Fatalf("%s: Forward failed: %v", tc.name, err)
+			}
+
+			if err := plan.Inverse(back, got); err != nil {
+				t.Fatalf("%s: Inverse failed: %v", tc.name, err)
+			}
+
+			fwd, rt := expectedNormFactors(norm, tc.n)
+			assertScaledComplex128(t, got, want, fwd, 1e-9, tc.name+" "+norm.String()+" forward")
+			assertScaledComplex128(t, back, src, rt, 1e-9, tc.name+" "+norm.String()+" round trip")
+		}
+	}
+}
+
+func TestNormalization_PlanStridedAndUnsafe(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n      = 32
+		stride = 3
+	)
+
+	plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	src := randomComplex128Slice(n, 7)
+	want := make([]complex128, n)
+
+	if err := plan.Forward(want, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	strided := make([]complex128, n*stride)
+	for i, v := range src {
+		strided[i*stride] = v
+	}
+
+	out := make([]complex128, n*stride)
+	if err := plan.ForwardStrided(out, strided, stride); err != nil {
+		t.Fatalf("ForwardStrided failed: %v", err)
+	}
+
+	for i := range want {
+		if cmplx.Abs(out[i*stride]-want[i]) > 1e-9 {
+			t.Fatalf("strided[%d] = %v, want %v", i, out[i*stride], want[i])
+		}
+	}
+
+	clone := plan.Clone()
+	got := make([]complex128, n)
+	clone.ForwardUnsafe(got, src)
+	assertScaledComplex128(t, got, want, 1, 1e-12, "unsafe forward")
+
+	back := make([]complex128, n)
+	clone.InverseUnsafe(back, got)
+	assertScaledComplex128(t, back, src, 1, 1e-9, "unsafe round trip")
+}
+
+func TestNormalization_FastPlan(t *testing.T) {
+	t.Parallel()
+
+	const n = 64
+
+	for _, norm := range allNormalizations {
+		plan, err := NewFastPlanWithOptions[complex64](n, PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Skipf("no codelet for size %d: %v", n, err)
+		}
+
+		ref, err := NewFastPlan[complex64](n)
+		if err != nil {
+			t.Fatalf("NewFastPlan failed: %v", err)
+		}
+
+		src := make([]complex64, n)
+		for i := range src {
+			src[i] = complex(float32(i%7)-3, float32(i%5)-2)
+		}
+
+		want := make([]complex64, n)
+		got := make([]complex64, n)
+		back := make([]complex64, n)
+
+		ref.Forward(want, src)
+		plan.Forward(got, src)
+		plan.Inverse(back, got)
+
+		fwd, rt := expectedNormFactors(norm, n)
+		assertScaledComplex64(t, got, want, fwd, 1e-5, norm.String()+" forward")
+		assertScaledComplex64(t, back, src, rt, 1e-4, norm.String()+" round trip")
+	}
+}
+
+func TestNormalization_MultiDimensional(t *testing.T) {
+	t.Parallel()
+
+	for _, norm := range allNormalizations {
+		t.Run(norm.String(), func(t *testing.T) {
+			t.Parallel()
+
+			opts := PlanOptions{Normalization: norm}
+
+			p2, err := NewPlan2DWithOptions[complex128](8, 12, opts)
+			if err != nil {
+				t.Fatalf("NewPlan2DWithOptions failed: %v", err)
+			}
+
+			r2, _ := NewPlan2D64(8, 12)
+
+			p3, err := NewPlan3DWithOptions[complex128](4, 6, 8, opts)
+			if err != nil {
+				t.Fatalf("NewPlan3DWithOptions failed: %v", err)
+			}
+
+			r3, _ := NewPlan3D64(4, 6, 8)
+
+			pn, err := NewPlanNDWithOptions[complex128]([]int{3, 4, 5}, opts)
+			if err != nil {
+				t.Fatalf("NewPlanNDWithOptions failed: %v", err)
+			}
+
+			rn, _ := NewPlanND64([]int{3, 4, 5})
+
+			cases := []struct {
+				name string
+				n    int
+				fwd  func(dst, src []complex128) error
+				inv  func(dst, src []complex128) error
+				ref  func(dst, src []complex128) error
+			}{
+				{"2D", p2.Len(), p2.Forward, p2.Inverse, r2.Forward},
+				{"3D", p3.Len(), p3.Forward, p3.Inverse, r3.Forward},
+				{"ND", pn.Len(), pn.Forward, pn.Inverse, rn.Forward},
+				{"2DClone", p2.Len(), p2.Clone().Forward, p2.Clone().Inverse, r2.Forward},
+			}
+
+			for _, tc := range cases {
+				src := randomComplex128Slice(tc.n, uint64(tc.n))
+				want := make([]complex128, tc.n)
+				got := make([]complex128, tc.n)
+				back := make([]complex128, tc.n)
+
+				if err := tc.ref(want, src); err != nil {
+					t.Fatalf("%s reference Forward failed: %v", tc.name, err)
+				}
+
+				if err := tc.fwd(got, src); err != nil {
+					t.Fatalf("%s Forward failed: %v", tc.name, err)
+				}
+
+				if err := tc.inv(back, got); err != nil {
+					t.Fatalf("%s Inverse failed: %v", tc.name, err)
+				}
+
+				fwd, rt := expectedNormFactors(norm, tc.n)
+				assertScaledComplex128(t, got, want, fwd, 1e-9, tc.name+" forward")
+				assertScaledComplex128(t, back, src, rt, 1e-9, tc.name+" round trip")
+			}
+		})
+	}
+}
+
+func TestNormalization_PlanRealT(t *testing.T) {
+	t.Parallel()
+
+	const n = 64
+
+	for _, norm := range allNormalizations {
+		plan, err := NewPlanReal32WithOptions(n, PlanOptions{Normalization: norm})
+		if err != nil {
+			t.Fatalf("NewPlanReal32WithOptions failed: %v", err)
+		}
+
+		ref, _ := NewPlanReal32(n)
+
+		src := randomFloat32Slice(n, 3)
+		want := make([]complex64, plan.SpectrumLen())
+		got := make([]complex64, plan.SpectrumLen())
+		back := make([]float32, n)
+
+		if err := ref.Forward(want, src); err != nil {
+			t.Fatalf("reference Forward failed: %v", err)
+		}
+
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		if err := plan.Inverse(back, got); err != nil {
+			t.Fatalf("Inverse failed: %v", err)
+		}
+
+		fwd, rt := expectedNormFactors(norm, n)
+		assertScaledComplex64(t, got, want, fwd, 1e-5, norm.String()+" forward")
+		assertScaledFloat32(t, back, src, rt, 1e-4, norm.String()+" round trip")
+
+		// The explicit variants replace the plan's normalization.
+		if err := plan.ForwardNormalized(got, src); err != nil {
+			t.Fatalf("ForwardNormalized failed: %v", err)
+		}
+
+		assertScaledComplex64(t, got, want, 1/float64(n), 1e-5, norm.String()+" ForwardNormalized")
+
+		if err := plan.ForwardUnitary(got, src); err != nil {
+			t.Fatalf("ForwardUnitary failed: %v", err)
+		}
+
+		assertScaledComplex64(t, got, want, 1/math.Sqrt(n), 1e-5, norm.String()+" ForwardUnitary")
+	}
+}
+
+func TestNormalization_PlanReal2DAnd3D(t *testing.T) {
+	t.Parallel()
+
+	for _, norm := range allNormalizations {
+		opts := PlanOptions{Normalization: norm}
+
+		p2, err := NewPlanReal2DWithOptions(8, 16, opts)
+		if err != nil {
+			t.Fatalf("NewPlanReal2DWithOptions failed: %v", err)
+		}
+
+		r2, _ := NewPlanReal2D(8, 16)
+
+		p3, err := NewPlanReal3DWithOptions(4, 4, 8, opts)
+		if err != nil {
+			t.Fatalf("NewPlanReal3DWithOptions failed: %v", err)
+		}
+
+		r3, _ := NewPlanReal3D(4, 4, 8)
+
+		cases := []struct {
+			name        string
+			n, specLen  int
+			fwd, refFwd func([]complex64, []float32) error
+			inv         func([]float32, []complex64) error
+		}{
+			{"2D", p2.Len(), p2.SpectrumLen(), p2.Forward, r2.Forward, p2.Inverse},
+			{"3D", p3.Len(), p3.SpectrumLen(), p3.Forward, r3.Forward, p3.Inverse},
+		}
+
+		for _, tc := range cases {
+			src := randomFloat32Slice(tc.n, uint64(tc.n))
+			want := make([]complex64, tc.specLen)
+			got := make([]complex64, tc.specLen)
+			back := make([]float32, tc.n)
+
+			if err := tc.refFwd(want, src); err != nil {
+				t.Fatalf("%s reference Forward failed: %v", tc.name, err)
+			}
+
+			if err := tc.fwd(got, src); err != nil {
+				t.Fatalf("%s Forward failed: %v", tc.name, err)
+			}
+
+			if err := tc.inv(back, got); err != nil {
+				t.Fatalf("%s Inverse failed: %v", tc.name, err)
+			}
+
+			fwd, rt := expectedNormFactors(norm, tc.n)
+			assertScaledComplex64(t, got, want, fwd, 1e-4, norm.String()+" "+tc.name+" forward")
+			assertScaledFloat32(t, back, src, rt, 1e-4, norm.String()+" "+tc.name+" round trip")
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package algofft
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Parallel execution uses a small set of long-lived helper goroutines shared
+// by all plans. Spawning goroutines per call would allocate, so callers hand
+// out work through a buffered queue and always drain their own job as well:
+// helpers only speed things up, and a saturated or nested call can never
+// deadlock waiting for them.
+
+// parallelTask is a unit of work split into independent, indexed chunks.
+// Each chunk is run exactly once, on an arbitrary goroutine.
+type parallelTask interface {
+	runChunk(chunk int)
+}
+
+// parallelJob tracks one runParallel call. Jobs are pooled; gen distinguishes
+// reuses so that stale queue entries from an earlier call are ignored.
+type parallelJob struct {
+	task   parallelTask
+	chunks int64
+	next   atomic.Int64
+
+	mu     sync.Mutex
+	done   sync.Cond
+	gen    uint64
+	closed bool
+	active int
+}
+
+type parallelWork struct {
+	job *parallelJob
+	gen uint64
+}
+
+//nolint:gochecknoglobals
+var (
+	parallelOnce  sync.Once
+	parallelQueue chan parallelWork
+	parallelJobs  = sync.Pool{New: func() any {
+		job := &parallelJob{}
+		job.done.L = &job.mu
+
+		return job
+	}}
+)
+
+// resolveWorkers maps PlanOptions.Workers to a goroutine count.
+func resolveWorkers(workers int) int {
+	if workers < 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+
+	return workers
+}
+
+func startParallelWorkers() {
+	helpers := runtime.GOMAXPROCS(0)
+	parallelQueue = make(chan parallelWork, 4*helpers)
+
+	for range helpers {
+		go func() {
+			for work := range parallelQueue {
+				if work.job.enter(work.gen) {
+					work.job.drain()
+					work.job.leave()
+				}
+			}
+		}()
+	}
+}
+
+// runParallel runs task.runChunk for chunks 0..chunks-1 using up to workers
+// goroutines (including the caller) and returns once every chunk is done.
+func runParallel(workers, chunks int, task parallelTask) {
+	if workers <= 1 || chunks <= 1 {
+		for c := range chunks {
+			task.runChunk(c)
+		}
+
+		return
+	}
+
+	parallelOnce.Do(startParallelWorkers)
+
+	job, _ := parallelJobs.Get().(*parallelJob)
+
+	job.mu.Lock()
+	job.gen++
+	job.closed = false
+	job.task = task
+	job.chunks = int64(chunks)
+	job.next.Store(0)
+	gen := job.gen
+	job.mu.Unlock()
+
+	for range min(workers, chunks) - 1 {
+		select {
+		case parallelQueue <- parallelWork{job: job, gen: gen}:
+		default:
+			// Helpers are saturated; the caller picks up the slack.
+		}
+	}
+
+	job.drain()
+
+	job.mu.Lock()
+	job.closed = true
+
+	for job.active > 0 {
+		job.done.Wait()
+	}
+
+	job.task = nil
+	job.mu.Unlock()
+
+	parallelJobs.Put(job)
+}
+
+// enter registers a helper on the job unless the job has finished or has been
+// reused for a later call.
+func (j *parallelJob) enter(gen uint64) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.gen != gen || j.closed {
+		return false
+	}
+
+	j.active++
+
+	return true
+}
+
+func (j *parallelJob) leave() {
+	j.mu.Lock()
+	j.active--
+
+	if j.active == 0 {
+		j.done.Broadcast()
+	}
+
+	j.mu.Unlock()
+}
+
+// drain claims and runs chunks until none are left.
+func (j *parallelJob) drain() {
+	for {
+		c := j.next.Add(1) - 1
+		if c >= j.chunks {
+			return
+		}
+
+		j.task.runChunk(int(c))
+	}
+}
+
+// chunkRange splits count items into chunks near-equal contiguous ranges and
+// returns the half-open range for chunk c.
+func chunkRange(count, chunks, c int) (int, int) {
+	return c * count / chunks, (c + 1) * count / chunks
+}
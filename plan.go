@@ -34,6 +34,9 @@ type Plan[T Complex] struct {
 	// stridedScratch is an optional pre-allocated buffer for strided transforms.
 	stridedScratch []T
 
+	// scratchLen is the length of the main scratch buffer the kernels need.
+	scratchLen int
+
 	// bitrev contains precomputed bit-reversal permutation indices.
 	// bitrev[i] contains the bit-reversed index for position i.
 	bitrev []int
@@ -45,16 +48,26 @@ type Plan[T Complex] struct {
 	packedTwiddle16   *fft.PackedTwiddles[T]
 
 	// Bluestein specific fields (used only if kernelStrategy == KernelBluestein)
-	bluesteinM              int   // Padded size M >= 2N-1
-	bluesteinChirp          []T   // Size N
-	bluesteinChirpInv       []T   // Size N
-	bluesteinFilter         []T   // Size M
-	bluesteinFilterInv      []T   // Size M
-	bluesteinTwiddle        []T   // Size M
-	bluesteinBitrev         []int // Size M
-	bluesteinScratch        []T   // Size M (extra scratch for Bluestein)
+	bluesteinM              int      // Padded size M >= 2N-1
+	bluesteinChirp          []T      // Size N
+	bluesteinChirpInv       []T      // Size N
+	bluesteinFilter         []T      // Size M, scaled by 1/M
+	bluesteinFilterInv      []T      // Size M, scaled by 1/M
+	bluesteinPlan           *Plan[T] // Size M unnormalized convolution plan
+	bluesteinScratch        []T      // bluesteinPlan workspace
 	bluesteinScratchBacking []byte
 
+	// Rader specific fields (used only if kernelStrategy == KernelRader)
+	raderPlan      *Plan[T] // Size L cyclic convolution plan (L = N-1, or padded to L >= 2N-3)
+	raderFilter    []T      // Size L, FFT of W_N^(g^-q)
+	raderFilterInv []T      // Size L, FFT of W_N^-(g^-q)
+	raderPerm      []int    // Size N-1, g^q mod N (input gather)
+	raderPermInv   []int    // Size N-1, g^-q mod N (output scatter)
+
+	// high computes a PlanOptions.AccuracyHigh complex64 plan in float64
+	// (nil otherwise); see highTransform.
+	high *highAccuracy
+
 	// Zero-dispatch codelet bindings (nil = use fallback kernel)
 	forwardCodelet fft.CodeletFunc[T]
 	inverseCodelet fft.CodeletFunc[T]
@@ -67,9 +80,26 @@ type Plan[T Complex] struct {
 	kernelStrategy fft.KernelStrategy
 	meta           PlanMeta
 
+	// radices is the mixed-radix stage schedule for non-power-of-two sizes
+	// without a codelet (nil = power of two, codelet or other algorithm).
+	radices []int
+
+	// forwardScale/inverseScale are applied on top of the kernels' built-in
+	// scaling to honour PlanOptions.Normalization (1 = no extra work).
+	forwardScale float64
+	inverseScale float64
+
+	// split holds the split-complex kernel (*fft.SplitKernel[float32] or
+	// *fft.SplitKernel[float64]) for power-of-two sizes, nil otherwise.
+	split any
+
 	// Recursive decomposition strategy (nil if using existing kernel path)
 	decompStrategy *fft.DecomposeStrategy
 
+	// fourStep is the parallel four-step executor for large sizes
+	// (nil = run the bound codelet or kernel serially).
+	fourStep *fourStep[T]
+
 	// backing buffers keep aligned slices alive for GC.
 	twiddleBacking               []byte
 	codeletTwiddleForwardBacking []byte
@@ -98,13 +128,16 @@ type scratchSet[T any] struct {
 type KernelStrategy = fft.KernelStrategy
 
 const (
-	KernelAuto      = fft.KernelAuto
-	KernelDIT       = fft.KernelDIT
-	KernelStockham  = fft.KernelStockham
-	KernelSixStep   = fft.KernelSixStep
-	KernelEightStep = fft.KernelEightStep
-	KernelBluestein = fft.KernelBluestein
-	KernelRecursive = fft.KernelRecursive // Recursive decomposition with codelet leaves
+	KernelAuto       = fft.KernelAuto
+	KernelDIT        = fft.KernelDIT
+	KernelStockham   = fft.KernelStockham
+	KernelSixStep    = fft.KernelSixStep
+	KernelEightStep  = fft.KernelEightStep
+	KernelBluestein  = fft.KernelBluestein
+	KernelRecursive  = fft.KernelRecursive  // Recursive decomposition with codelet leaves
+	KernelRader      = fft.KernelRader      // Rader's algorithm for odd primes
+	KernelPFA        = fft.KernelPFA        // Good–Thomas prime-factor algorithm for coprime factors
+	KernelSplitRadix = fft.KernelSplitRadix // Split-radix (2/4) for powers of two
 )
 
 // SetKernelStrategy overrides the global kernel selection strategy.
@@ -151,6 +184,10 @@ func (p *Plan[T]) Len() int {
 
 // KernelStrategy reports the strategy chosen when the plan was created.
 func (p *Plan[T]) KernelStrategy() KernelStrategy {
+	if p.high != nil {
+		return p.high.strategy()
+	}
+
 	return p.kernelStrategy
 }
 
@@ -173,7 +210,7 @@ func (p *Plan[T]) String() string {
 
 	strategyName := "auto"
 
-	switch p.kernelStrategy {
+	switch p.KernelStrategy() {
 	case fft.KernelDIT:
 		strategyName = "DIT"
 	case fft.KernelStockham:
@@ -184,6 +221,12 @@ func (p *Plan[T]) String() string {
 		strategyName = "EightStep"
 	case fft.KernelBluestein:
 		strategyName = "Bluestein"
+	case fft.KernelRader:
+		strategyName = "Rader"
+	case fft.KernelPFA:
+		strategyName = "PFA"
+	case fft.KernelSplitRadix:
+		strategyName = "SplitRadix"
 	}
 
 	pooled := ""
@@ -324,6 +367,8 @@ func (p *Plan[T]) getScratch() ([]T, []T, []T, *scratchSet[T]) {
 //
 //	X[k] = Σ x[n] * exp(-2πink/N) for k = 0..N-1
 //
+// and then scaled according to PlanOptions.Normalization (unscaled by default).
+//
 // dst and src must have length equal to Plan.Len().
 // dst and src may point to the same slice for in-place operation.
 //
@@ -335,37 +380,71 @@ func (p *Plan[T]) Forward(dst, src []T) error {
 		return err
 	}
 
+	if p.meta.Workspace == WorkspaceExternal {
+		return ErrWorkspaceRequired
+	}
+
 	scratch, _, bsScratch, set := p.getScratch()
 	if set != nil {
 		defer p.scratchPool.Put(set)
 	}
 
-	if p.kernelStrategy == fft.KernelBluestein {
-		return p.bluesteinForward(dst, src, scratch, bsScratch)
-	}
+	return p.forward(dst, src, scratch, bsScratch, p.fourStep != nil)
+}
 
-	if p.kernelStrategy == fft.KernelRecursive {
-		return p.recursiveForward(dst, src, scratch)
+// forward runs the forward transform with the given scratch buffers and
+// applies the plan's normalization, which each executor folds into its final
+// pass. aux is the Bluestein scratch or the Rader or high-accuracy child
+// plan's workspace (nil = its pooled scratch); parallel selects the four-step
+// executor.
+func (p *Plan[T]) forward(dst, src, scratch, aux []T, parallel bool) error {
+	if p.high != nil {
+		// The float64 passes apply the normalization.
+		return p.highTransform(dst, src, scratch, aux, false)
 	}
 
-	// Zero-dispatch codelet path (highest priority)
-	if p.forwardCodelet != nil {
-		p.forwardCodelet(dst, src, p.codeletTwiddleForward, scratch)
-		return nil
+	if p.kernelStrategy == fft.KernelBluestein {
+		// Normalization is fused into the final chirp multiply.
+		return p.bluesteinForward(dst, src, scratch, aux)
 	}
 
-	if p.kernelStrategy == fft.KernelStockham && fft.StockhamPackedAvailable() {
-		if fft.ForwardStockhamPacked(dst, src, p.twiddle, scratch, p.packedTwiddle4) {
-			return nil
-		}
+	switch {
+	case p.kernelStrategy == fft.KernelRader:
+		return p.raderTransform(dst, src, scratch, aux, false, p.forwardScale)
+	case parallel:
+		return p.fourStep.transform(dst, src, scratch, false, p.forwardScale)
+	default:
+		return p.forwardDispatch(dst, src, scratch, p.forwardScale)
 	}
+}
 
-	// Fallback kernel dispatch
-	if p.forwardKernel != nil && p.forwardKernel(dst, src, p.twiddle, scratch) {
+// forwardDispatch runs the bound forward codelet or kernel and multiplies the
+// result by scale. The mixed-radix and packed Stockham kernels take scale
+// into their final pass; codelets and the fallback kernels have their
+// scaling compiled in, so for them scale costs one extra sweep.
+func (p *Plan[T]) forwardDispatch(dst, src, scratch []T, scale float64) error {
+	switch {
+	case p.kernelStrategy == fft.KernelRecursive:
+		if err := p.recursiveForward(dst, src, scratch); err != nil {
+			return err
+		}
+	case p.forwardCodelet != nil:
+		// Zero-dispatch codelet path (highest priority)
+		p.forwardCodelet(dst, src, p.codeletTwiddleForward, scratch)
+	case p.radices != nil && fft.MixedRadixForward(dst, src, p.twiddle, scratch, p.radices, scale):
 		return nil
+	case p.kernelStrategy == fft.KernelStockham && fft.StockhamPackedAvailable() &&
+		fft.ForwardStockhamPacked(dst, src, p.twiddle, scratch, p.packedTwiddle4, scale):
+		return nil
+	case p.forwardKernel != nil && p.forwardKernel(dst, src, p.twiddle, scratch):
+		// Fallback kernel dispatch
+	default:
+		return ErrNotImplemented
 	}
 
-	return ErrNotImplemented
+	scaleComplexInPlace(dst, scale)
+
+	return nil
 }
 
 // Inverse computes the inverse (frequency-to-time) FFT.
@@ -374,6 +453,9 @@ func (p *Plan[T]) Forward(dst, src []T) error {
 //
 //	x[n] = (1/N) * Σ X[k] * exp(2πink/N) for n = 0..N-1
 //
+// The 1/N factor shown is the default NormBackward convention; other
+// PlanOptions.Normalization settings replace it.
+//
 // dst and src must have length equal to Plan.Len().
 // dst and src may point to the same slice for in-place operation.
 //
@@ -385,37 +467,66 @@ func (p *Plan[T]) Inverse(dst, src []T) error {
 		return err
 	}
 
+	if p.meta.Workspace == WorkspaceExternal {
+		return ErrWorkspaceRequired
+	}
+
 	scratch, _, bsScratch, set := p.getScratch()
 	if set != nil {
 		defer p.scratchPool.Put(set)
 	}
 
-	if p.kernelStrategy == fft.KernelBluestein {
-		return p.bluesteinInverse(dst, src, scratch, bsScratch)
-	}
+	return p.inverse(dst, src, scratch, bsScratch, p.fourStep != nil)
+}
 
-	if p.kernelStrategy == fft.KernelRecursive {
-		return p.recursiveInverse(dst, src, scratch)
+// inverse runs the inverse transform with the given scratch buffers and
+// applies the plan's normalization. aux and parallel are as for forward.
+func (p *Plan[T]) inverse(dst, src, scratch, aux []T, parallel bool) error {
+	if p.high != nil {
+		return p.highTransform(dst, src, scratch, aux, true)
 	}
 
-	// Zero-dispatch codelet path (highest priority)
-	if p.inverseCodelet != nil {
-		p.inverseCodelet(dst, src, p.codeletTwiddleInverse, scratch)
-		return nil
+	if p.kernelStrategy == fft.KernelBluestein {
+		// Normalization is fused into the final chirp multiply.
+		return p.bluesteinInverse(dst, src, scratch, aux)
 	}
 
-	if p.kernelStrategy == fft.KernelStockham && fft.StockhamPackedAvailable() {
-		if fft.InverseStockhamPacked(dst, src, p.twiddle, scratch, p.packedTwiddle4Inv) {
-			return nil
-		}
+	switch {
+	case p.kernelStrategy == fft.KernelRader:
+		return p.raderTransform(dst, src, scratch, aux, true, p.inverseScale)
+	case parallel:
+		return p.fourStep.transform(dst, src, scratch, true, p.inverseScale)
+	default:
+		return p.inverseDispatch(dst, src, scratch, p.inverseScale)
 	}
+}
 
-	// Fallback kernel dispatch
-	if p.inverseKernel != nil && p.inverseKernel(dst, src, p.twiddle, scratch) {
+// inverseDispatch runs the bound inverse codelet or kernel, including its
+// built-in 1/N scaling, and multiplies the result by scale as forwardDispatch
+// does.
+func (p *Plan[T]) inverseDispatch(dst, src, scratch []T, scale float64) error {
+	switch {
+	case p.kernelStrategy == fft.KernelRecursive:
+		if err := p.recursiveInverse(dst, src, scratch); err != nil {
+			return err
+		}
+	case p.inverseCodelet != nil:
+		// Zero-dispatch codelet path (highest priority)
+		p.inverseCodelet(dst, src, p.codeletTwiddleInverse, scratch)
+	case p.radices != nil && fft.MixedRadixInverse(dst, src, p.twiddle, scratch, p.radices, scale):
+		return nil
+	case p.kernelStrategy == fft.KernelStockham && fft.StockhamPackedAvailable() &&
+		fft.InverseStockhamPacked(dst, src, p.twiddle, scratch, p.packedTwiddle4Inv, scale):
 		return nil
+	case p.inverseKernel != nil && p.inverseKernel(dst, src, p.twiddle, scratch):
+		// Fallback kernel dispatch
+	default:
+		return ErrNotImplemented
 	}
 
-	return ErrNotImplemented
+	scaleComplexInPlace(dst, scale)
+
+	return nil
 }
 
 // InPlace computes the forward FFT in-place, modifying the input slice directly.
@@ -450,12 +561,17 @@ func (p *Plan[T]) InverseInPlace(data []T) error {
 // Violating these requirements causes undefined behavior or panic.
 // Use Forward() for the safe, validated path.
 func (p *Plan[T]) ForwardUnsafe(dst, src []T) {
-	if p.forwardCodelet != nil {
+	switch {
+	case p.forwardCodelet != nil:
 		p.forwardCodelet(dst, src, p.codeletTwiddleForward, p.scratch)
+	case p.radices != nil:
+		fft.MixedRadixForward(dst, src, p.twiddle, p.scratch, p.radices, p.forwardScale)
 		return
+	default:
+		p.forwardKernel(dst, src, p.twiddle, p.scratch)
 	}
 
-	p.forwardKernel(dst, src, p.twiddle, p.scratch)
+	scaleComplexInPlace(dst[:p.n], p.forwardScale)
 }
 
 // InverseUnsafe performs the inverse FFT without any validation.
@@ -470,12 +586,17 @@ func (p *Plan[T]) ForwardUnsafe(dst, src []T) {
 // Violating these requirements causes undefined behavior or panic.
 // Use Inverse() for the safe, validated path.
 func (p *Plan[T]) InverseUnsafe(dst, src []T) {
-	if p.inverseCodelet != nil {
+	switch {
+	case p.inverseCodelet != nil:
 		p.inverseCodelet(dst, src, p.codeletTwiddleInverse, p.scratch)
+	case p.radices != nil:
+		fft.MixedRadixInverse(dst, src, p.twiddle, p.scratch, p.radices, p.inverseScale)
 		return
+	default:
+		p.inverseKernel(dst, src, p.twiddle, p.scratch)
 	}
 
-	p.inverseKernel(dst, src, p.twiddle, p.scratch)
+	scaleComplexInPlace(dst[:p.n], p.inverseScale)
 }
 
 // Transform computes either forward or inverse FFT based on the inverse flag.
@@ -497,6 +618,7 @@ func (p *Plan[T]) Transform(dst, src []T, inverse bool) error {
 //
 // dst and src must have length >= count * Plan.Len().
 // dst and src may point to the same slice for in-place batch operation.
+// With PlanOptions.Workers > 1 the transforms are split across goroutines.
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrInvalidLength if count < 1.
@@ -515,6 +637,10 @@ func (p *Plan[T]) ForwardBatch(dst, src []T, count int) error {
 		return ErrLengthMismatch
 	}
 
+	if p.meta.Workers > 1 && count > 1 {
+		return p.transformBatchParallel(dst, src, count, false)
+	}
+
 	for i := range count {
 		start := i * p.n
 
@@ -538,6 +664,7 @@ func (p *Plan[T]) ForwardBatch(dst, src []T, count int) error {
 //
 // dst and src must have length >= count * Plan.Len().
 // dst and src may point to the same slice for in-place batch operation.
+// With PlanOptions.Workers > 1 the transforms are split across goroutines.
 //
 // Returns ErrNilSlice if dst or src is nil.
 // Returns ErrInvalidLength if count < 1.
@@ -556,6 +683,10 @@ func (p *Plan[T]) InverseBatch(dst, src []T, count int) error {
 		return ErrLengthMismatch
 	}
 
+	if p.meta.Workers > 1 && count > 1 {
+		return p.transformBatchParallel(dst, src, count, true)
+	}
+
 	for i := range count {
 		start := i * p.n
 
@@ -602,7 +733,10 @@ func NewPlanWithOptions[T Complex](n int, opts PlanOptions) (*Plan[T], error) {
 	return newPlanWithFeatures[T](n, cpu.DetectFeatures(), normalizePlanOptions(opts))
 }
 
-func allocateScratchSet[T Complex](n int, strategy KernelStrategy, bluesteinM int, decompStrategy *fft.DecomposeStrategy, standardScratchSize int) *scratchSet[T] {
+// allocateScratchSet allocates one set of per-call buffers. Bluestein plans
+// need bluesteinM elements of scratch plus bluesteinWork elements of
+// workspace for their convolution plan.
+func allocateScratchSet[T Complex](n int, strategy KernelStrategy, bluesteinM, bluesteinWork int, decompStrategy *fft.DecomposeStrategy, standardScratchSize int) *scratchSet[T] {
 	var (
 		zero                    T
 		scratch                 []T
@@ -641,7 +775,7 @@ func allocateScratchSet[T Complex](n int, strategy KernelStrategy, bluesteinM in
 			stridedScratch = ss
 			stridedBacking = stridedRaw
 
-			bsAligned, bsRaw := mem.AllocAlignedComplex64(scratchSize)
+			bsAligned, bsRaw := mem.AllocAlignedComplex64(bluesteinWork)
 
 			bs, ok3 := any(bsAligned).([]T)
 			if !ok3 {
@@ -671,7 +805,7 @@ func allocateScratchSet[T Complex](n int, strategy KernelStrategy, bluesteinM in
 			stridedScratch = ss
 			stridedBacking = stridedRaw
 
-			bsAligned, bsRaw := mem.AllocAlignedComplex128(scratchSize)
+			bsAligned, bsRaw := mem.AllocAlignedComplex128(bluesteinWork)
 
 			bs, ok3 := any(bsAligned).([]T)
 			if !ok3 {
@@ -683,7 +817,7 @@ func allocateScratchSet[T Complex](n int, strategy KernelStrategy, bluesteinM in
 		default:
 			scratch = make([]T, scratchSize)
 			stridedScratch = make([]T, n)
-			bluesteinScratch = make([]T, scratchSize)
+			bluesteinScratch = make([]T, bluesteinWork)
 		}
 	} else if useRecursive {
 		scratchSize := fft.ScratchSizeRecursive(decompStrategy)
@@ -796,6 +930,27 @@ func allocateScratchSet[T Complex](n int, strategy KernelStrategy, bluesteinM in
 	}
 }
 
+// setMixedRadixSchedule records the stage schedule of plans that run the
+// mixed-radix kernels, preferring the hinted radices.
+func (p *Plan[T]) setMixedRadixSchedule(estimate fft.PlanEstimate[T], hints []int) {
+	if m.IsPowerOf2(p.n) || estimate.ForwardCodelet != nil {
+		return
+	}
+
+	switch estimate.Strategy {
+	case fft.KernelBluestein, fft.KernelRader, fft.KernelRecursive, fft.KernelPFA:
+		return
+	}
+
+	p.radices = fft.MixedRadixSchedule[T](p.n, hints)
+	p.meta.Radices = append([]int(nil), p.radices...)
+
+	// Codelet leaves take their twiddles and scratch after the kernel's.
+	if size := fft.MixedRadixScratchSize[T](p.n, p.radices); p.radices != nil && size > p.scratchLen {
+		p.growScratch(size)
+	}
+}
+
 func standardScratchSize(n int, algorithm string) int {
 	if strings.Contains(algorithm, "sixstep64x128") {
 		return 2 * n
@@ -809,6 +964,20 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		return nil, ErrInvalidLength
 	}
 
+	if opts.Accuracy == AccuracyHigh && isSingle[T]() {
+		return newHighAccuracyPlan[T](n, features, opts)
+	}
+
+	// Rader's algorithm only exists for odd primes
+	if opts.Strategy == KernelRader && !fft.RaderApplicable(n) {
+		opts.Strategy = KernelAuto
+	}
+
+	// For primes the measuring planners time Rader against Bluestein
+	if opts.Planner != PlannerEstimate && opts.Strategy == KernelAuto && fft.RaderApplicable(n) {
+		return measurePrime[T](n, features, opts)
+	}
+
 	// Choose planning strategy based on mode
 	var estimate fft.PlanEstimate[T]
 
@@ -837,6 +1006,7 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 
 	useBluestein := estimate.Strategy == fft.KernelBluestein
 	useRecursive := estimate.Strategy == fft.KernelRecursive
+	useRader := estimate.Strategy == fft.KernelRader
 	strategy := estimate.Strategy
 
 	// Get fallback kernels (used when no codelet is available)
@@ -849,12 +1019,12 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 
 		// Bluestein specific
 		bluesteinM         int
+		bluesteinWork      int
 		bluesteinChirp     []T
 		bluesteinChirpInv  []T
 		bluesteinFilter    []T
 		bluesteinFilterInv []T
-		bluesteinTwiddle   []T
-		bluesteinBitrev    []int
+		bluesteinPlan      *Plan[T]
 
 		// Recursive decomposition specific
 		decompStrategy *fft.DecomposeStrategy
@@ -862,32 +1032,32 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 
 	// Pre-calculate configuration
 	if useBluestein {
-		bluesteinM = m.NextPowerOfTwo(2*n - 1)
+		var err error
+
+		bluesteinPlan, err = newBluesteinPlan[T](n, features, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		bluesteinM = bluesteinPlan.n
+		bluesteinWork = bluesteinPlan.WorkspaceSize()
 	} else if useRecursive {
 		codeletSizes := []int{4, 8, 16, 32, 64, 128, 256, 512}
 		cacheSize := 32768 // L1 cache size estimate
 		decompStrategy = fft.PlanDecomposition(n, codeletSizes, cacheSize)
 	}
 
-	// Allocate initial scratch set for setup (Bluestein filter computation)
+	// Allocate the initial scratch set, which seeds the pool
 	scratchSize := standardScratchSize(n, estimate.Algorithm)
-	setupScratch := allocateScratchSet[T](n, strategy, bluesteinM, decompStrategy, scratchSize)
+	setupScratch := allocateScratchSet[T](n, strategy, bluesteinM, bluesteinWork, decompStrategy, scratchSize)
 
 	if useBluestein {
-		// Compute Bluestein tables
-		bluesteinChirp = fft.ComputeChirpSequence[T](n)
+		var err error
 
-		bluesteinChirpInv = make([]T, n)
-		for i, v := range bluesteinChirp {
-			bluesteinChirpInv[i] = fft.ConjugateOf(v)
+		bluesteinChirp, bluesteinChirpInv, bluesteinFilter, bluesteinFilterInv, err = bluesteinTables[T](n, bluesteinM)
+		if err != nil {
+			return nil, err
 		}
-
-		bluesteinTwiddle = fft.ComputeTwiddleFactors[T](bluesteinM)
-		bluesteinBitrev = fft.ComputeBitReversalIndices(bluesteinM)
-
-		// Compute filters using the pre-allocated scratch buffer
-		bluesteinFilter = fft.ComputeBluesteinFilter(n, bluesteinM, bluesteinChirp, bluesteinTwiddle, setupScratch.bluesteinScratch)
-		bluesteinFilterInv = fft.ComputeBluesteinFilter(n, bluesteinM, bluesteinChirpInv, bluesteinTwiddle, setupScratch.bluesteinScratch)
 	} else if useRecursive {
 		// Generate twiddles for recursive decomposition
 		var twiddleSize int
@@ -954,13 +1124,17 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		}
 	}
 
-	// Create pool and put the setup scratch into it
-	scratchPool := &sync.Pool{
-		New: func() any {
-			return allocateScratchSet[T](n, strategy, bluesteinM, decompStrategy, scratchSize)
-		},
+	// Create pool and put the setup scratch into it. External-workspace
+	// plans keep no scratch at all; the setup set is simply dropped.
+	var scratchPool *sync.Pool
+	if opts.Workspace != WorkspaceExternal {
+		scratchPool = &sync.Pool{
+			New: func() any {
+				return allocateScratchSet[T](n, strategy, bluesteinM, bluesteinWork, decompStrategy, scratchSize)
+			},
+		}
+		scratchPool.Put(setupScratch)
 	}
-	scratchPool.Put(setupScratch)
 
 	p := &Plan[T]{
 		n:                     n,
@@ -969,6 +1143,7 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		codeletTwiddleInverse: twiddle,
 		scratch:               nil, // Use pool
 		stridedScratch:        nil, // Use pool
+		scratchLen:            len(setupScratch.scratch),
 		bitrev:                planBitReversal(n, estimate),
 		forwardCodelet:        estimate.ForwardCodelet,
 		inverseCodelet:        estimate.InverseCodelet,
@@ -984,19 +1159,33 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		bluesteinChirpInv:     bluesteinChirpInv,
 		bluesteinFilter:       bluesteinFilter,
 		bluesteinFilterInv:    bluesteinFilterInv,
-		bluesteinTwiddle:      bluesteinTwiddle,
-		bluesteinBitrev:       bluesteinBitrev,
+		bluesteinPlan:         bluesteinPlan,
 		bluesteinScratch:      nil, // Use pool
 		meta: PlanMeta{
-			Planner:  opts.Planner,
-			Strategy: strategy,
-			Batch:    opts.Batch,
-			Stride:   opts.Stride,
-			InPlace:  opts.InPlace,
+			Planner:       opts.Planner,
+			Strategy:      strategy,
+			Batch:         opts.Batch,
+			Stride:        opts.Stride,
+			InPlace:       opts.InPlace,
+			Normalization: opts.Normalization,
+			Workers:       opts.Workers,
+			Workspace:     opts.Workspace,
 		},
 	}
 
-	if !useBluestein {
+	p.forwardScale, p.inverseScale = normalizationScales(opts.Normalization, n)
+	p.split = newSplitKernel[T](n, features)
+	p.setMixedRadixSchedule(estimate, opts.Radices)
+	p.meta.BluesteinSize = bluesteinM
+
+	if useRader {
+		err := p.initRader(features, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !useBluestein && !useRader {
 		p.packedTwiddle4 = fft.ComputePackedTwiddles[T](n, 4, p.twiddle)
 		p.packedTwiddle4Inv = fft.ConjugatePackedTwiddles(p.packedTwiddle4)
 		p.packedTwiddle8 = fft.ComputePackedTwiddles[T](n, 8, p.twiddle)
@@ -1005,6 +1194,23 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 
 	p.codeletTwiddleForward, p.codeletTwiddleInverse, p.codeletTwiddleForwardBacking, p.codeletTwiddleInverseBacking = prepareCodeletTwiddles(n, p.twiddle, estimate)
 
+	p.fourStep = newFourStep(p, features, opts)
+	if p.fourStep != nil && opts.Planner != PlannerEstimate && opts.Strategy == KernelAuto && !p.fourStepFaster() {
+		p.fourStep = nil
+	}
+
+	// Verify once the final executor is bound, so both paths are checked.
+	if opts.Verify {
+		err := p.verify(features, opts, func(opts PlanOptions) (*Plan[T], error) {
+			return newPlanWithFeatures[T](n, features, opts)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p.meta.FourStep = p.fourStep != nil
+
 	return p, nil
 }
 
@@ -1060,6 +1266,12 @@ func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts Pla
 
 	opts = normalizePlanOptions(opts)
 	features := cpu.DetectFeatures()
+
+	// High-accuracy plans own no pooled buffers of their own.
+	if opts.Accuracy == AccuracyHigh && isSingle[T]() {
+		return newHighAccuracyPlan[T](n, features, opts)
+	}
+
 	estimate := fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
 
 	strategy := estimate.Strategy
@@ -1086,6 +1298,7 @@ func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts Pla
 		codeletTwiddleInverse: twiddle,
 		scratch:               scratch,
 		stridedScratch:        stridedScratch,
+		scratchLen:            max(scratchSize, n),
 		bitrev:                bitrev,
 		forwardCodelet:        estimate.ForwardCodelet,
 		inverseCodelet:        estimate.InverseCodelet,
@@ -1099,14 +1312,20 @@ func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts Pla
 		pool:                  pool,
 		scratchPool:           nil, // No internal pool for pooled plans
 		meta: PlanMeta{
-			Planner:  opts.Planner,
-			Strategy: strategy,
-			Batch:    opts.Batch,
-			Stride:   opts.Stride,
-			InPlace:  opts.InPlace,
+			Planner:       opts.Planner,
+			Strategy:      strategy,
+			Batch:         opts.Batch,
+			Stride:        opts.Stride,
+			InPlace:       opts.InPlace,
+			Normalization: opts.Normalization,
+			Workers:       opts.Workers,
 		},
 	}
 
+	p.forwardScale, p.inverseScale = normalizationScales(opts.Normalization, n)
+	p.split = newSplitKernel[T](n, features)
+	p.setMixedRadixSchedule(estimate, opts.Radices)
+
 	p.packedTwiddle4 = fft.ComputePackedTwiddles[T](n, 4, p.twiddle)
 	p.packedTwiddle4Inv = fft.ConjugatePackedTwiddles(p.packedTwiddle4)
 	p.packedTwiddle8 = fft.ComputePackedTwiddles[T](n, 8, p.twiddle)
@@ -1114,6 +1333,16 @@ func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts Pla
 
 	p.codeletTwiddleForward, p.codeletTwiddleInverse, p.codeletTwiddleForwardBacking, p.codeletTwiddleInverseBacking = prepareCodeletTwiddles(n, p.twiddle, estimate)
 
+	if opts.Verify {
+		err := p.verify(features, opts, func(opts PlanOptions) (*Plan[T], error) {
+			return NewPlanFromPoolWithOptions[T](n, pool, opts)
+		})
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+	}
+
 	return p, nil
 }
 
@@ -1298,6 +1527,14 @@ func (p *Plan[T]) Close() {
 // Cloned Plans are never pooled, even if the original was.
 // Calling Close() on a cloned Plan is a no-op.
 func (p *Plan[T]) Clone() *Plan[T] {
+	if p.meta.Workspace == WorkspaceExternal {
+		// Nothing to copy: external-workspace plans own no scratch.
+		c := *p
+		c.pool = nil
+
+		return &c
+	}
+
 	var (
 		zero                    T
 		scratch                 []T
@@ -1308,13 +1545,7 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		bluesteinScratchBacking []byte
 	)
 
-	scratchSize := p.n
-	switch p.kernelStrategy {
-	case fft.KernelBluestein:
-		scratchSize = p.bluesteinM
-	case fft.KernelRecursive:
-		scratchSize = fft.ScratchSizeRecursive(p.decompStrategy)
-	}
+	scratchSize := p.scratchLen
 
 	switch any(zero).(type) {
 	case complex64:
@@ -1327,7 +1558,7 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		stridedScratchBacking = stridedRaw
 
 		if p.kernelStrategy == fft.KernelBluestein {
-			bsAligned, bsRaw := mem.AllocAlignedComplex64(p.bluesteinM)
+			bsAligned, bsRaw := mem.AllocAlignedComplex64(p.bluesteinPlan.WorkspaceSize())
 			bluesteinScratch = any(bsAligned).([]T)
 			bluesteinScratchBacking = bsRaw
 		}
@@ -1341,7 +1572,7 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		stridedScratchBacking = stridedRaw
 
 		if p.kernelStrategy == fft.KernelBluestein {
-			bsAligned, bsRaw := mem.AllocAlignedComplex128(p.bluesteinM)
+			bsAligned, bsRaw := mem.AllocAlignedComplex128(p.bluesteinPlan.WorkspaceSize())
 			bluesteinScratch = any(bsAligned).([]T)
 			bluesteinScratchBacking = bsRaw
 		}
@@ -1350,7 +1581,7 @@ func (p *Plan[T]) Clone() *Plan[T] {
 
 		stridedScratch = make([]T, p.n)
 		if p.kernelStrategy == fft.KernelBluestein {
-			bluesteinScratch = make([]T, p.bluesteinM)
+			bluesteinScratch = make([]T, p.bluesteinPlan.WorkspaceSize())
 		}
 	}
 
@@ -1359,8 +1590,9 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		twiddle:                      p.twiddle, // Shared (immutable)
 		codeletTwiddleForward:        p.codeletTwiddleForward,
 		codeletTwiddleInverse:        p.codeletTwiddleInverse,
-		scratch:                      scratch,             // New allocation (FIXED)
-		stridedScratch:               stridedScratch,      // New allocation
+		scratch:                      scratch,        // New allocation (FIXED)
+		stridedScratch:               stridedScratch, // New allocation
+		scratchLen:                   p.scratchLen,
 		bitrev:                       p.bitrev,            // Shared (immutable)
 		packedTwiddle4:               p.packedTwiddle4,    // Shared (immutable)
 		packedTwiddle4Inv:            p.packedTwiddle4Inv, // Shared (immutable)
@@ -1372,8 +1604,13 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		forwardKernel:                p.forwardKernel,
 		inverseKernel:                p.inverseKernel,
 		kernelStrategy:               p.kernelStrategy,
+		radices:                      p.radices,
 		decompStrategy:               p.decompStrategy,
+		fourStep:                     p.fourStep,
 		meta:                         p.meta,
+		forwardScale:                 p.forwardScale,
+		inverseScale:                 p.inverseScale,
+		split:                        p.split,
 		twiddleBacking:               p.twiddleBacking, // Shared reference (keeps original alive)
 		codeletTwiddleForwardBacking: p.codeletTwiddleForwardBacking,
 		codeletTwiddleInverseBacking: p.codeletTwiddleInverseBacking,
@@ -1388,9 +1625,18 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		bluesteinChirpInv:       p.bluesteinChirpInv,
 		bluesteinFilter:         p.bluesteinFilter,
 		bluesteinFilterInv:      p.bluesteinFilterInv,
-		bluesteinTwiddle:        p.bluesteinTwiddle,
-		bluesteinBitrev:         p.bluesteinBitrev,
+		bluesteinPlan:           p.bluesteinPlan,         // Shared (runs in bluesteinScratch)
 		bluesteinScratch:        bluesteinScratch,        // New allocation
 		bluesteinScratchBacking: bluesteinScratchBacking, // New allocation
+
+		// Rader fields (the child plan is pooled, so sharing it is safe)
+		raderPlan:      p.raderPlan,
+		raderFilter:    p.raderFilter,
+		raderFilterInv: p.raderFilterInv,
+		raderPerm:      p.raderPerm,
+		raderPermInv:   p.raderPermInv,
+
+		// The high-accuracy child plans are pooled as well
+		high: p.high,
 	}
 }
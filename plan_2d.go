@@ -26,6 +26,20 @@ type Plan2D[T Complex] struct {
 	colScratch []T      // Column scratch buffer for strided transforms (size=rows)
 	options    PlanOptions
 
+	// forwardScale/inverseScale implement PlanOptions.Normalization and are
+	// fused into the final copy to dst.
+	forwardScale float64
+	inverseScale float64
+
+	// batchWorkers splits the PlanOptions.Batch loop across workers with
+	// pooled workspaces when PlanOptions.Workers > 1 (nil otherwise).
+	batchWorkers *multiDimBatch[T, *Plan2D[T]]
+
+	// passes splits the row and column passes across per-worker lanes of
+	// 1D plans and column buffers; lanes[0] uses rowPlan, colPlan and
+	// colScratch.
+	passes laneSet[plan2DLane[T]]
+
 	// Transpose support for square matrices
 	transposePairs []fft.TransposePair
 
@@ -58,6 +72,8 @@ func NewPlan2DWithOptions[T Complex](rows, cols int, opts PlanOptions) (*Plan2D[
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	// Child plans keep the default convention; the combined factor is applied once.
+	childOpts.Normalization = NormBackward
 
 	// Create 1D plans for rows and columns
 	rowPlan, err := newPlanWithFeatures[T](cols, features, childOpts)
@@ -70,44 +86,31 @@ func NewPlan2DWithOptions[T Complex](rows, cols int, opts PlanOptions) (*Plan2D[
 		return nil, err
 	}
 
-	// Allocate scratch buffer (aligned for SIMD)
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
 	totalSize := rows * cols
 
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
+	p := &Plan2D[T]{
+		rows:    rows,
+		cols:    cols,
+		rowPlan: rowPlan,
+		colPlan: colPlan,
+		options: opts,
 	}
 
-	// Allocate column scratch buffer for strided transforms
-	colScratch := make([]T, rows)
-
-	p := &Plan2D[T]{
-		rows:           rows,
-		cols:           cols,
-		rowPlan:        rowPlan,
-		colPlan:        colPlan,
-		scratch:        scratch,
-		colScratch:     colScratch,
-		scratchBacking: scratchBacking,
-		options:        opts,
+	// External-workspace plans carve these buffers from the caller's workspace.
+	if opts.Workspace != WorkspaceExternal {
+		p.allocScratch()
 	}
 
+	p.forwardScale, p.inverseScale = normalizationScales(opts.Normalization, totalSize)
+
 	// Pre-compute transpose pairs for square matrices (optimization)
 	if rows == cols {
 		p.transposePairs = fft.ComputeSquareTransposePairs(rows)
 	}
 
+	p.initLanes(opts.Workers)
+	p.batchWorkers = newMultiDimBatch[T](p, opts)
+
 	return p, nil
 }
 
@@ -168,6 +171,10 @@ func (p *Plan2D[T]) Forward(dst, src []T) error {
 		return err
 	}
 
+	if p.batchWorkers != nil && batch > 1 {
+		return p.batchWorkers.run(dst, src, p.Len(), batch, stride, false)
+	}
+
 	for b := range batch {
 		srcOff := b * stride
 
@@ -203,6 +210,10 @@ func (p *Plan2D[T]) Inverse(dst, src []T) error {
 		return err
 	}
 
+	if p.batchWorkers != nil && batch > 1 {
+		return p.batchWorkers.run(dst, src, p.Len(), batch, stride, true)
+	}
+
 	for b := range batch {
 		srcOff := b * stride
 
@@ -240,39 +251,77 @@ func (p *Plan2D[T]) InverseInPlace(data []T) error {
 //
 // This allows multiple goroutines to perform transforms concurrently.
 func (p *Plan2D[T]) Clone() *Plan2D[T] {
-	// Allocate new scratch buffer
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	totalSize := p.rows * p.cols
+	c := p.clone()
+	c.initLanes(c.options.Workers)
+	c.batchWorkers = newMultiDimBatch[T](c, c.options)
 
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	}
-
-	// Allocate column scratch buffer for strided transforms
-	colScratch := make([]T, p.rows)
+	return c
+}
 
-	return &Plan2D[T]{
+// clone copies the plan without batch workers and with a single lane.
+func (p *Plan2D[T]) clone() *Plan2D[T] {
+	c := &Plan2D[T]{
 		rows:           p.rows,
 		cols:           p.cols,
 		rowPlan:        p.rowPlan.Clone(),
 		colPlan:        p.colPlan.Clone(),
-		scratch:        scratch,
-		colScratch:     colScratch,
-		scratchBacking: scratchBacking,
 		transposePairs: p.transposePairs, // Shared (immutable)
 		options:        p.options,
+		forwardScale:   p.forwardScale,
+		inverseScale:   p.inverseScale,
 	}
+
+	if p.scratch != nil {
+		c.allocScratch()
+	}
+
+	c.initLanes(1)
+
+	return c
+}
+
+// allocScratch allocates the working matrix (aligned for SIMD) and the
+// column buffer for strided transforms.
+func (p *Plan2D[T]) allocScratch() {
+	switch any(p.scratch).(type) {
+	case []complex64:
+		s, b := mem.AllocAlignedComplex64(p.rows * p.cols)
+		p.scratch = any(s).([]T)
+		p.scratchBacking = b
+	case []complex128:
+		s, b := mem.AllocAlignedComplex128(p.rows * p.cols)
+		p.scratch = any(s).([]T)
+		p.scratchBacking = b
+	}
+
+	p.colScratch = make([]T, p.rows)
+}
+
+// plan2DLane holds the resources one worker needs for the 2D passes and the
+// matrix it is working on.
+type plan2DLane[T Complex] struct {
+	rowPlan, colPlan *Plan[T]
+	colScratch       []T
+
+	data    []T
+	forward bool
+
+	// work is the 1D plans' workspace (nil = their pooled scratch).
+	work []T
+}
+
+func (l plan2DLane[T]) clone() plan2DLane[T] {
+	return plan2DLane[T]{
+		rowPlan:    l.rowPlan.Clone(),
+		colPlan:    l.colPlan.Clone(),
+		colScratch: make([]T, len(l.colScratch)),
+	}
+}
+
+// initLanes sets up one lane per worker.
+func (p *Plan2D[T]) initLanes(workers int) {
+	own := plan2DLane[T]{rowPlan: p.rowPlan, colPlan: p.colPlan, colScratch: p.colScratch}
+	p.passes.init(p, own, workers, max(p.rows, p.cols))
 }
 
 // validate checks that dst and src have the correct length for this plan.
@@ -294,108 +343,130 @@ func (p *Plan2D[T]) validate(dst, src []T) error {
 	return nil
 }
 
-// transformColumnsViaTranspose transforms columns using transpose for square matrices.
-// This is more cache-friendly than strided access.
-func (p *Plan2D[T]) transformColumnsViaTranspose(data []T, forward bool) {
-	// Transpose: columns become rows
-	fft.ApplyTransposePairs(data, p.transposePairs)
-
-	// Transform each column (now a row)
-	for row := range p.rows {
-		rowData := data[row*p.cols : (row+1)*p.cols]
-		if forward {
-			_ = p.colPlan.InPlace(rowData)
-		} else {
-			_ = p.colPlan.InverseInPlace(rowData)
-		}
-	}
-
-	// Transpose back
-	fft.ApplyTransposePairs(data, p.transposePairs)
-}
+const (
+	plan2DRows = iota
+	plan2DTranspose
+	plan2DColumnRows
+	plan2DColumns
+)
 
-// transformColumnsStrided transforms columns using strided access for non-square matrices.
-func (p *Plan2D[T]) transformColumnsStrided(data []T, forward bool) {
-	colData := p.colScratch
+func (p *Plan2D[T]) transformLines(l plan2DLane[T], pass, lo, hi int) error {
+	data := l.data
 
-	for col := range p.cols {
-		// Extract column
-		for row := range p.rows {
-			colData[row] = data[row*p.cols+col]
+	switch pass {
+	case plan2DRows, plan2DColumnRows:
+		// Rows of the matrix; after a square transpose these are the columns.
+		plan := l.rowPlan
+		if pass == plan2DColumnRows {
+			plan = l.colPlan
 		}
 
-		// Transform column
-		if forward {
-			_ = p.colPlan.InPlace(colData)
-		} else {
-			_ = p.colPlan.InverseInPlace(colData)
+		for row := lo; row < hi; row++ {
+			rowData := data[row*p.cols : (row+1)*p.cols]
+			if err := plan.transformWith(rowData, rowData, l.work, !l.forward); err != nil {
+				return err
+			}
 		}
-
-		// Write back
-		for row := range p.rows {
-			data[row*p.cols+col] = colData[row]
+	case plan2DTranspose:
+		fft.ApplyTransposePairs(data, p.transposePairs[lo:hi])
+	case plan2DColumns:
+		colData := l.colScratch
+
+		for col := lo; col < hi; col++ {
+			// Extract column
+			for row := range p.rows {
+				colData[row] = data[row*p.cols+col]
+			}
+
+			if err := l.colPlan.transformWith(colData, colData, l.work, !l.forward); err != nil {
+				return err
+			}
+
+			// Write back
+			for row := range p.rows {
+				data[row*p.cols+col] = colData[row]
+			}
 		}
 	}
+
+	return nil
 }
 
-func (p *Plan2D[T]) forwardSingle(dst, src []T) error {
-	err := p.validate(dst, src)
+// transformData transforms the rows and then the columns of the lanes' data,
+// serially on l when l is non-nil.
+func (p *Plan2D[T]) transformData(l *plan2DLane[T]) error {
+	// Transform rows
+	err := p.passes.run(l, plan2DRows, p.rows)
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
-	copy(work, src)
-
-	// Transform rows
-	for row := range p.rows {
-		rowData := work[row*p.cols : (row+1)*p.cols]
+	// Transform columns
+	if p.rows != p.cols {
+		return p.passes.run(l, plan2DColumns, p.cols)
+	}
 
-		err := p.rowPlan.InPlace(rowData)
-		if err != nil {
-			return err
-		}
+	// Transpose so that columns become rows; this is more cache-friendly
+	// than strided access.
+	err = p.passes.run(l, plan2DTranspose, len(p.transposePairs))
+	if err != nil {
+		return err
 	}
 
-	// Transform columns
-	if p.rows == p.cols {
-		p.transformColumnsViaTranspose(work, true)
-	} else {
-		p.transformColumnsStrided(work, true)
+	err = p.passes.run(l, plan2DColumnRows, p.rows)
+	if err != nil {
+		return err
 	}
 
-	copy(dst, work)
+	return p.passes.run(l, plan2DTranspose, len(p.transposePairs))
+}
 
-	return nil
+func (p *Plan2D[T]) forwardSingle(dst, src []T) error {
+	return p.transformSingle(dst, src, true)
 }
 
 func (p *Plan2D[T]) inverseSingle(dst, src []T) error {
+	return p.transformSingle(dst, src, false)
+}
+
+func (p *Plan2D[T]) transformSingle(dst, src []T, forward bool) error {
 	err := p.validate(dst, src)
 	if err != nil {
 		return err
 	}
 
+	if p.scratch == nil {
+		return ErrWorkspaceRequired
+	}
+
 	work := p.scratch
 	copy(work, src)
 
-	// Transform rows (inverse)
-	for row := range p.rows {
-		rowData := work[row*p.cols : (row+1)*p.cols]
+	for i := range p.passes.lanes {
+		p.passes.lanes[i].data = work
+		p.passes.lanes[i].forward = forward
+	}
+
+	err = p.transformData(nil)
 
-		err := p.rowPlan.InverseInPlace(rowData)
-		if err != nil {
-			return err
-		}
+	for i := range p.passes.lanes {
+		p.passes.lanes[i].data = nil
 	}
 
-	// Transform columns (inverse)
-	if p.rows == p.cols {
-		p.transformColumnsViaTranspose(work, false)
-	} else {
-		p.transformColumnsStrided(work, false)
+	if err != nil {
+		return err
 	}
 
-	copy(dst, work)
+	p.scaleCopy(dst, work, forward)
 
 	return nil
 }
+
+func (p *Plan2D[T]) scaleCopy(dst, work []T, forward bool) {
+	scale := p.inverseScale
+	if forward {
+		scale = p.forwardScale
+	}
+
+	scaleCopyComplex(dst, work, scale)
+}
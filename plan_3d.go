@@ -27,6 +27,20 @@ type Plan3D[T Complex] struct {
 	dimScratch           []T      // Dimension scratch buffer for strided transforms (size=max(height,depth))
 	options              PlanOptions
 
+	// forwardScale/inverseScale implement PlanOptions.Normalization and are
+	// fused into the final copy to dst.
+	forwardScale float64
+	inverseScale float64
+
+	// batchWorkers splits the PlanOptions.Batch loop across workers with
+	// pooled workspaces when PlanOptions.Workers > 1 (nil otherwise).
+	batchWorkers *multiDimBatch[T, *Plan3D[T]]
+
+	// passes splits the width, height and depth passes across per-worker
+	// lanes of 1D plans and line buffers; lanes[0] uses the plan's own plans
+	// and dimScratch.
+	passes laneSet[plan3DLane[T]]
+
 	// backing keeps aligned scratch buffer alive for GC
 	scratchBacking []byte
 }
@@ -58,6 +72,8 @@ func NewPlan3DWithOptions[T Complex](depth, height, width int, opts PlanOptions)
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	// Child plans keep the default convention; the combined factor is applied once.
+	childOpts.Normalization = NormBackward
 
 	// Create 1D plans for each dimension
 	widthPlan, err := newPlanWithFeatures[T](width, features, childOpts)
@@ -75,45 +91,29 @@ func NewPlan3DWithOptions[T Complex](depth, height, width int, opts PlanOptions)
 		return nil, err
 	}
 
-	// Allocate scratch buffer (aligned for SIMD)
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
 	totalSize := depth * height * width
 
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	}
-
-	// Allocate dimension scratch buffer for strided transforms
-	dimScratchSize := height
-	if depth > height {
-		dimScratchSize = depth
-	}
-
-	dimScratch := make([]T, dimScratchSize)
-
-	return &Plan3D[T]{
-		depth:          depth,
-		height:         height,
-		width:          width,
-		widthPlan:      widthPlan,
-		heightPlan:     heightPlan,
-		depthPlan:      depthPlan,
-		scratch:        scratch,
-		dimScratch:     dimScratch,
-		scratchBacking: scratchBacking,
-		options:        opts,
-	}, nil
+	p := &Plan3D[T]{
+		depth:      depth,
+		height:     height,
+		width:      width,
+		widthPlan:  widthPlan,
+		heightPlan: heightPlan,
+		depthPlan:  depthPlan,
+		options:    opts,
+	}
+
+	// External-workspace plans carve these buffers from the caller's workspace.
+	if opts.Workspace != WorkspaceExternal {
+		p.allocScratch()
+	}
+
+	p.forwardScale, p.inverseScale = normalizationScales(opts.Normalization, totalSize)
+
+	p.initLanes(opts.Workers)
+	p.batchWorkers = newMultiDimBatch[T](p, opts)
+
+	return p, nil
 }
 
 // NewPlan3D32 creates a new 3D FFT plan using complex64 precision.
@@ -180,6 +180,10 @@ func (p *Plan3D[T]) Forward(dst, src []T) error {
 		return err
 	}
 
+	if p.batchWorkers != nil && batch > 1 {
+		return p.batchWorkers.run(dst, src, p.Len(), batch, stride, false)
+	}
+
 	for b := range batch {
 		srcOff := b * stride
 
@@ -217,6 +221,10 @@ func (p *Plan3D[T]) Inverse(dst, src []T) error {
 		return err
 	}
 
+	if p.batchWorkers != nil && batch > 1 {
+		return p.batchWorkers.run(dst, src, p.Len(), batch, stride, true)
+	}
+
 	for b := range batch {
 		srcOff := b * stride
 
@@ -254,47 +262,86 @@ func (p *Plan3D[T]) InverseInPlace(data []T) error {
 //
 // This allows multiple goroutines to perform transforms concurrently.
 func (p *Plan3D[T]) Clone() *Plan3D[T] {
-	// Allocate new scratch buffer
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
+	c := p.clone()
+	c.initLanes(c.options.Workers)
+	c.batchWorkers = newMultiDimBatch[T](c, c.options)
 
-	totalSize := p.depth * p.height * p.width
+	return c
+}
 
-	switch any(scratch).(type) {
+// clone copies the plan without batch workers and with a single lane.
+func (p *Plan3D[T]) clone() *Plan3D[T] {
+	c := &Plan3D[T]{
+		depth:        p.depth,
+		height:       p.height,
+		width:        p.width,
+		widthPlan:    p.widthPlan.Clone(),
+		heightPlan:   p.heightPlan.Clone(),
+		depthPlan:    p.depthPlan.Clone(),
+		options:      p.options,
+		forwardScale: p.forwardScale,
+		inverseScale: p.inverseScale,
+	}
+
+	if p.scratch != nil {
+		c.allocScratch()
+	}
+
+	c.initLanes(1)
+
+	return c
+}
+
+// allocScratch allocates the working volume (aligned for SIMD) and the line
+// buffer for strided transforms.
+func (p *Plan3D[T]) allocScratch() {
+	switch any(p.scratch).(type) {
 	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
+		s, b := mem.AllocAlignedComplex64(p.depth * p.height * p.width)
+		p.scratch = any(s).([]T)
+		p.scratchBacking = b
 	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
+		s, b := mem.AllocAlignedComplex128(p.depth * p.height * p.width)
+		p.scratch = any(s).([]T)
+		p.scratchBacking = b
 	}
 
-	// Allocate dimension scratch buffer for strided transforms
-	dimScratchSize := p.height
-	if p.depth > p.height {
-		dimScratchSize = p.depth
-	}
+	p.dimScratch = make([]T, max(p.height, p.depth))
+}
 
-	dimScratch := make([]T, dimScratchSize)
+// plan3DLane holds the resources one worker needs for the 3D passes and the
+// volume it is working on.
+type plan3DLane[T Complex] struct {
+	widthPlan, heightPlan, depthPlan *Plan[T]
+	dimScratch                       []T
+
+	data    []T
+	forward bool
+
+	// work is the 1D plans' workspace (nil = their pooled scratch).
+	work []T
+}
 
-	return &Plan3D[T]{
-		depth:          p.depth,
-		height:         p.height,
-		width:          p.width,
-		widthPlan:      p.widthPlan.Clone(),
-		heightPlan:     p.heightPlan.Clone(),
-		depthPlan:      p.depthPlan.Clone(),
-		scratch:        scratch,
-		dimScratch:     dimScratch,
-		scratchBacking: scratchBacking,
-		options:        p.options,
+func (l plan3DLane[T]) clone() plan3DLane[T] {
+	return plan3DLane[T]{
+		widthPlan:  l.widthPlan.Clone(),
+		heightPlan: l.heightPlan.Clone(),
+		depthPlan:  l.depthPlan.Clone(),
+		dimScratch: make([]T, len(l.dimScratch)),
 	}
 }
 
+// initLanes sets up one lane per worker.
+func (p *Plan3D[T]) initLanes(workers int) {
+	own := plan3DLane[T]{
+		widthPlan:  p.widthPlan,
+		heightPlan: p.heightPlan,
+		depthPlan:  p.depthPlan,
+		dimScratch: p.dimScratch,
+	}
+	p.passes.init(p, own, workers, p.height*max(p.depth, p.width))
+}
+
 // validate checks that dst and src have the correct length for this plan.
 func (p *Plan3D[T]) validate(dst, src []T) error {
 	expectedLen := p.depth * p.height * p.width
@@ -314,109 +361,155 @@ func (p *Plan3D[T]) validate(dst, src []T) error {
 	return nil
 }
 
+const (
+	plan3DWidth = iota
+	plan3DHeight
+	plan3DDepth
+)
+
+func (p *Plan3D[T]) transformLines(l plan3DLane[T], pass, lo, hi int) error {
+	switch pass {
+	case plan3DWidth:
+		return p.transformWidth(l, lo, hi)
+	case plan3DHeight:
+		return p.transformHeight(l, lo, hi)
+	default:
+		return p.transformDepth(l, lo, hi)
+	}
+}
+
+// transformData transforms the lanes' data along width, height and depth,
+// serially on l when l is non-nil.
+func (p *Plan3D[T]) transformData(l *plan3DLane[T]) error {
+	err := p.passes.run(l, plan3DWidth, p.depth*p.height)
+	if err != nil {
+		return err
+	}
+
+	err = p.passes.run(l, plan3DHeight, p.depth*p.width)
+	if err != nil {
+		return err
+	}
+
+	return p.passes.run(l, plan3DDepth, p.height*p.width)
+}
+
 // transformWidth transforms along the width dimension (innermost).
-// Each row of width elements is transformed in-place.
-func (p *Plan3D[T]) transformWidth(data []T, forward bool) {
-	for d := range p.depth {
-		for h := range p.height {
-			offset := d*p.height*p.width + h*p.width
-
-			rowData := data[offset : offset+p.width]
-			if forward {
-				_ = p.widthPlan.InPlace(rowData)
-			} else {
-				_ = p.widthPlan.InverseInPlace(rowData)
-			}
+// Line i is the row of width elements starting at i*width, transformed in-place.
+func (p *Plan3D[T]) transformWidth(l plan3DLane[T], lo, hi int) error {
+	data := l.data
+
+	for i := lo; i < hi; i++ {
+		rowData := data[i*p.width : (i+1)*p.width]
+		if err := l.widthPlan.transformWith(rowData, rowData, l.work, !l.forward); err != nil {
+			return err
 		}
 	}
+
+	return nil
 }
 
 // transformHeight transforms along the height dimension (middle).
-// For each depth slice, columns along height are extracted, transformed, and written back.
-func (p *Plan3D[T]) transformHeight(data []T, forward bool) {
-	colData := p.dimScratch[:p.height]
-
-	for d := range p.depth {
-		for w := range p.width {
-			// Extract column along height
-			for h := range p.height {
-				colData[h] = data[d*p.height*p.width+h*p.width+w]
-			}
-
-			// Transform column
-			if forward {
-				_ = p.heightPlan.InPlace(colData)
-			} else {
-				_ = p.heightPlan.InverseInPlace(colData)
-			}
-
-			// Write back
-			for h := range p.height {
-				data[d*p.height*p.width+h*p.width+w] = colData[h]
-			}
+// Line i = d*width + w is the column along height at depth d and width w;
+// it is extracted, transformed, and written back.
+func (p *Plan3D[T]) transformHeight(l plan3DLane[T], lo, hi int) error {
+	data := l.data
+	colData := l.dimScratch[:p.height]
+
+	for i := lo; i < hi; i++ {
+		base := (i/p.width)*p.height*p.width + i%p.width
+
+		// Extract column along height
+		for h := range p.height {
+			colData[h] = data[base+h*p.width]
+		}
+
+		if err := l.heightPlan.transformWith(colData, colData, l.work, !l.forward); err != nil {
+			return err
+		}
+
+		// Write back
+		for h := range p.height {
+			data[base+h*p.width] = colData[h]
 		}
 	}
+
+	return nil
 }
 
 // transformDepth transforms along the depth dimension (outermost).
-// For each (height, width) position, a slice along depth is extracted, transformed, and written back.
-func (p *Plan3D[T]) transformDepth(data []T, forward bool) {
-	depthData := p.dimScratch[:p.depth]
-
-	for h := range p.height {
-		for w := range p.width {
-			// Extract slice along depth
-			for d := range p.depth {
-				depthData[d] = data[d*p.height*p.width+h*p.width+w]
-			}
-
-			// Transform depth slice
-			if forward {
-				_ = p.depthPlan.InPlace(depthData)
-			} else {
-				_ = p.depthPlan.InverseInPlace(depthData)
-			}
-
-			// Write back
-			for d := range p.depth {
-				data[d*p.height*p.width+h*p.width+w] = depthData[d]
-			}
+// Line i = h*width + w is the slice along depth at that (height, width)
+// position; it is extracted, transformed, and written back.
+func (p *Plan3D[T]) transformDepth(l plan3DLane[T], lo, hi int) error {
+	data := l.data
+	depthData := l.dimScratch[:p.depth]
+	planeSize := p.height * p.width
+
+	for i := lo; i < hi; i++ {
+		// Extract slice along depth
+		for d := range p.depth {
+			depthData[d] = data[d*planeSize+i]
+		}
+
+		if err := l.depthPlan.transformWith(depthData, depthData, l.work, !l.forward); err != nil {
+			return err
+		}
+
+		// Write back
+		for d := range p.depth {
+			data[d*planeSize+i] = depthData[d]
 		}
 	}
+
+	return nil
 }
 
 func (p *Plan3D[T]) forwardSingle(dst, src []T) error {
+	return p.transformSingle(dst, src, true)
+}
+
+func (p *Plan3D[T]) inverseSingle(dst, src []T) error {
+	return p.transformSingle(dst, src, false)
+}
+
+func (p *Plan3D[T]) transformSingle(dst, src []T, forward bool) error {
 	err := p.validate(dst, src)
 	if err != nil {
 		return err
 	}
 
+	if p.scratch == nil {
+		return ErrWorkspaceRequired
+	}
+
 	work := p.scratch
 	copy(work, src)
 
-	p.transformWidth(work, true)
-	p.transformHeight(work, true)
-	p.transformDepth(work, true)
+	for i := range p.passes.lanes {
+		p.passes.lanes[i].data = work
+		p.passes.lanes[i].forward = forward
+	}
 
-	copy(dst, work)
+	err = p.transformData(nil)
 
-	return nil
-}
+	for i := range p.passes.lanes {
+		p.passes.lanes[i].data = nil
+	}
 
-func (p *Plan3D[T]) inverseSingle(dst, src []T) error {
-	err := p.validate(dst, src)
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
-	copy(work, src)
+	p.scaleCopy(dst, work, forward)
 
-	p.transformWidth(work, false)
-	p.transformHeight(work, false)
-	p.transformDepth(work, false)
+	return nil
+}
 
-	copy(dst, work)
+func (p *Plan3D[T]) scaleCopy(dst, work []T, forward bool) {
+	scale := p.inverseScale
+	if forward {
+		scale = p.forwardScale
+	}
 
-	return nil
+	scaleCopyComplex(dst, work, scale)
 }
@@ -152,3 +152,423 @@ func assertNoAllocs(t *testing.T, label string, run func() error) {
 		t.Fatalf("%s allocated %.2f per run, want 0", label, allocs)
 	}
 }
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestPlanRealTOddTransformsNoAllocs(t *testing.T) {
+	// 15 runs mixed-radix, 17 runs Bluestein.
+	for _, n := range []int{15, 17} {
+		plan, err := NewPlanReal32(n)
+		if err != nil {
+			t.Fatalf("NewPlanReal32(%d) returned error: %v", n, err)
+		}
+
+		src := make([]float32, n)
+		for i := range src {
+			src[i] = float32(i) * 0.25
+		}
+
+		freq := make([]complex64, plan.SpectrumLen())
+		out := make([]float32, n)
+
+		assertNoAllocs(t, "Forward", func() error {
+			return plan.Forward(freq, src)
+		})
+		assertNoAllocs(t, "Inverse", func() error {
+			return plan.Inverse(out, freq)
+		})
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestForwardSplit_NoAllocs(t *testing.T) {
+	for _, n := range []int{1024, 17} {
+		plan, err := NewPlan32(n)
+		if err != nil {
+			t.Fatalf("NewPlan32(%d) failed: %v", n, err)
+		}
+
+		re := make([]float32, n)
+		im := make([]float32, n)
+
+		assertNoAllocs(t, "ForwardSplit", func() error {
+			return ForwardSplit(plan, re, im, re, im)
+		})
+		assertNoAllocs(t, "InverseSplit", func() error {
+			return InverseSplit(plan, re, im, re, im)
+		})
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestPlanRealTForwardMany_NoAllocs(t *testing.T) {
+	const (
+		n        = 64
+		channels = 2
+	)
+
+	plan, err := NewPlanReal32(n)
+	if err != nil {
+		t.Fatalf("NewPlanReal32 failed: %v", err)
+	}
+
+	src := make([]float32, n*channels)
+	spectra := make([]complex64, plan.SpectrumLen()*channels)
+	out := make([]float32, n*channels)
+
+	// The first call allocates the gather buffers.
+	_ = plan.ForwardMany(spectra, src, channels, channels, 1, channels, 1)
+
+	assertNoAllocs(t, "ForwardMany", func() error {
+		return plan.ForwardMany(spectra, src, channels, channels, 1, channels, 1)
+	})
+	assertNoAllocs(t, "InverseMany", func() error {
+		return plan.InverseMany(out, spectra, channels, channels, 1, channels, 1)
+	})
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestParallelBatch_NoAllocs(t *testing.T) {
+	const (
+		n     = 256
+		count = 8
+	)
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Workers: 4})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	data := make([]complex64, n*count)
+
+	plan2D, err := NewPlan2DWithOptions[complex64](16, 16, PlanOptions{Batch: count, Workers: 4})
+	if err != nil {
+		t.Fatalf("NewPlan2DWithOptions failed: %v", err)
+	}
+
+	data2D := make([]complex64, plan2D.Len()*count)
+
+	// Warm up the helper goroutines and pools.
+	_ = plan.ForwardBatch(data, data, count)
+	_ = plan2D.Forward(data2D, data2D)
+
+	assertNoAllocs(t, "ForwardBatch", func() error {
+		return plan.ForwardBatch(data, data, count)
+	})
+	assertNoAllocs(t, "Plan2D.Forward", func() error {
+		return plan2D.Forward(data2D, data2D)
+	})
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestParallelPasses_NoAllocs(t *testing.T) {
+	opts := PlanOptions{Workers: 4}
+
+	plan2D, err := NewPlan2DWithOptions[complex64](24, 16, opts)
+	if err != nil {
+		t.Fatalf("NewPlan2DWithOptions failed: %v", err)
+	}
+
+	plan3D, err := NewPlan3DWithOptions[complex64](4, 8, 8, opts)
+	if err != nil {
+		t.Fatalf("NewPlan3DWithOptions failed: %v", err)
+	}
+
+	planND, err := NewPlanNDWithOptions[complex64]([]int{4, 4, 8}, opts)
+	if err != nil {
+		t.Fatalf("NewPlanNDWithOptions failed: %v", err)
+	}
+
+	real2D, err := NewPlanReal2DWithOptions(16, 24, opts)
+	if err != nil {
+		t.Fatalf("NewPlanReal2DWithOptions failed: %v", err)
+	}
+
+	data2D := make([]complex64, plan2D.Len())
+	data3D := make([]complex64, plan3D.Len())
+	dataND := make([]complex64, planND.Len())
+	samples := make([]float32, real2D.Len())
+	spectrum := make([]complex64, real2D.SpectrumLen())
+
+	// Warm up the helper goroutines and pools.
+	_ = plan2D.Forward(data2D, data2D)
+	_ = plan3D.Forward(data3D, data3D)
+	_ = planND.Forward(dataND, dataND)
+	_ = real2D.Forward(spectrum, samples)
+
+	assertNoAllocs(t, "Plan2D.Forward", func() error {
+		return plan2D.Forward(data2D, data2D)
+	})
+	assertNoAllocs(t, "Plan3D.Inverse", func() error {
+		return plan3D.Inverse(data3D, data3D)
+	})
+	assertNoAllocs(t, "PlanND.Forward", func() error {
+		return planND.Forward(dataND, dataND)
+	})
+	assertNoAllocs(t, "PlanReal2D.Inverse", func() error {
+		return real2D.Inverse(samples, spectrum)
+	})
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestWorkspace_NoAllocs(t *testing.T) {
+	opts := PlanOptions{Workspace: WorkspaceExternal}
+
+	plan, err := NewPlanWithOptions[complex64](1000, opts)
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	realPlan, err := NewPlanRealTWithOptions[float32, complex64](256, opts)
+	if err != nil {
+		t.Fatalf("NewPlanRealTWithOptions failed: %v", err)
+	}
+
+	legacyReal, err := NewPlanRealWithOptions(256, opts)
+	if err != nil {
+		t.Fatalf("NewPlanRealWithOptions failed: %v", err)
+	}
+
+	plan2D, err := NewPlan2DWithOptions[complex64](12, 16, opts)
+	if err != nil {
+		t.Fatalf("NewPlan2DWithOptions failed: %v", err)
+	}
+
+	planND, err := NewPlanNDWithOptions[complex64]([]int{4, 6, 8}, opts)
+	if err != nil {
+		t.Fatalf("NewPlanNDWithOptions failed: %v", err)
+	}
+
+	real3D, err := NewPlanReal3DWithOptions(4, 4, 16, opts)
+	if err != nil {
+		t.Fatalf("NewPlanReal3DWithOptions failed: %v", err)
+	}
+
+	work := NewWorkspace[complex64](max(plan.WorkspaceSize(), realPlan.WorkspaceSize(), legacyReal.WorkspaceSize(),
+		plan2D.WorkspaceSize(), planND.WorkspaceSize(), real3D.WorkspaceSize()))
+	data := make([]complex64, 1000)
+	samples := make([]float32, 256)
+	spectrum := make([]complex64, realPlan.SpectrumLen())
+
+	assertNoAllocs(t, "Plan.ForwardWithWorkspace", func() error {
+		return plan.ForwardWithWorkspace(data, data, work)
+	})
+	assertNoAllocs(t, "Plan.InverseWithWorkspace", func() error {
+		return plan.InverseWithWorkspace(data, data, work)
+	})
+	assertNoAllocs(t, "PlanRealT.ForwardWithWorkspace", func() error {
+		return realPlan.ForwardWithWorkspace(spectrum, samples, work)
+	})
+	assertNoAllocs(t, "PlanReal.InverseWithWorkspace", func() error {
+		return legacyReal.InverseWithWorkspace(samples, spectrum, work)
+	})
+	assertNoAllocs(t, "Plan2D.InverseWithWorkspace", func() error {
+		return plan2D.InverseWithWorkspace(data[:plan2D.Len()], data[:plan2D.Len()], work)
+	})
+	assertNoAllocs(t, "PlanND.ForwardWithWorkspace", func() error {
+		return planND.ForwardWithWorkspace(data[:planND.Len()], data[:planND.Len()], work)
+	})
+	assertNoAllocs(t, "PlanReal3D.ForwardWithWorkspace", func() error {
+		return real3D.ForwardWithWorkspace(data[:real3D.SpectrumLen()], samples, work)
+	})
+
+	// Mixed-radix sizes whose sub-transforms run SIMD codelets take the
+	// codelets' twiddles and scratch from the workspace too.
+	plan128, err := NewPlanWithOptions[complex128](1000, opts)
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	data128 := make([]complex128, 1000)
+	work128 := NewWorkspace[complex128](plan128.WorkspaceSize())
+
+	assertNoAllocs(t, "Plan[complex128].ForwardWithWorkspace", func() error {
+		return plan128.ForwardWithWorkspace(data128, data128, work128)
+	})
+	assertNoAllocs(t, "Plan[complex128].InverseWithWorkspace", func() error {
+		return plan128.InverseWithWorkspace(data128, data128, work128)
+	})
+
+	// High-accuracy plans take the intermediate, the float64 blocks and the
+	// child plans' workspace from work; 8190 runs as columns and rows.
+	highOpts := PlanOptions{Workspace: WorkspaceExternal, Accuracy: AccuracyHigh}
+
+	high, err := NewPlanWithOptions[complex64](8190, highOpts)
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	highReal, err := NewPlanRealTWithOptions[float32, complex64](16380, highOpts)
+	if err != nil {
+		t.Fatalf("NewPlanRealTWithOptions failed: %v", err)
+	}
+
+	highWork := NewWorkspace[complex64](max(high.WorkspaceSize(), highReal.WorkspaceSize()))
+	highData := make([]complex64, 8190)
+	highSamples := make([]float32, 16380)
+	highSpectrum := make([]complex64, highReal.SpectrumLen())
+
+	assertNoAllocs(t, "high Plan.ForwardWithWorkspace", func() error {
+		return high.ForwardWithWorkspace(highData, highData, highWork)
+	})
+	assertNoAllocs(t, "high PlanRealT.ForwardWithWorkspace", func() error {
+		return highReal.ForwardWithWorkspace(highSpectrum, highSamples, highWork)
+	})
+	assertNoAllocs(t, "high PlanRealT.InverseWithWorkspace", func() error {
+		return highReal.InverseWithWorkspace(highSamples, highSpectrum, highWork)
+	})
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestRader_NoAllocs(t *testing.T) {
+	plan, err := NewPlanWithOptions[complex64](257, PlanOptions{Strategy: KernelRader})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	data := make([]complex64, 257)
+	work := NewWorkspace[complex64](plan.WorkspaceSize())
+
+	assertNoAllocs(t, "Forward", func() error {
+		return plan.Forward(data, data)
+	})
+	assertNoAllocs(t, "InverseWithWorkspace", func() error {
+		return plan.InverseWithWorkspace(data, data, work)
+	})
+}
+
+func TestMixedRadixOdd_NoAllocs(t *testing.T) {
+	plan, err := NewPlanWithOptions[complex64](4004, PlanOptions{Radices: []int{7}})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	data := make([]complex64, 4004)
+
+	assertNoAllocs(t, "Forward", func() error {
+		return plan.Forward(data, data)
+	})
+	assertNoAllocs(t, "Inverse", func() error {
+		return plan.Inverse(data, data)
+	})
+}
+
+func TestPFA_NoAllocs(t *testing.T) {
+	plan, err := NewPlanT[complex128](1001)
+	if err != nil {
+		t.Fatalf("NewPlanT failed: %v", err)
+	}
+
+	data := make([]complex128, 1001)
+
+	assertNoAllocs(t, "Forward", func() error {
+		return plan.Forward(data, data)
+	})
+	assertNoAllocs(t, "Inverse", func() error {
+		return plan.Inverse(data, data)
+	})
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestPlanDCT_NoAllocs(t *testing.T) {
+	for _, kind := range []TrigType{TypeI, TypeII, TypeIII, TypeIV} {
+		plan, err := NewPlanDCT[float64](255, kind)
+		if err != nil {
+			t.Fatalf("NewPlanDCT failed: %v", err)
+		}
+
+		data := make([]float64, 255)
+
+		assertNoAllocs(t, "DCT-"+kind.String()+" Forward", func() error {
+			return plan.Forward(data, data)
+		})
+		assertNoAllocs(t, "DCT-"+kind.String()+" Inverse", func() error {
+			return plan.Inverse(data, data)
+		})
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestMDCT_NoAllocs(t *testing.T) {
+	mdct, err := NewMDCT[float64](256, WindowKBD)
+	if err != nil {
+		t.Fatalf("NewMDCT failed: %v", err)
+	}
+
+	ola := mdct.NewOverlapAdd()
+	frame := make([]float64, 256)
+	coeffs := make([]float64, 128)
+	out := make([]float64, 128)
+
+	assertNoAllocs(t, "Forward", func() error {
+		return mdct.Forward(coeffs, frame)
+	})
+	assertNoAllocs(t, "Inverse", func() error {
+		return mdct.Inverse(frame, coeffs)
+	})
+	assertNoAllocs(t, "Synthesize", func() error {
+		return ola.Synthesize(out, coeffs)
+	})
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestPlanDHT_NoAllocs(t *testing.T) {
+	for _, dims := range [][]int{{255}, {16, 32}, {3, 4, 5}} {
+		plan, err := NewPlanDHTND[float64](dims)
+		if err != nil {
+			t.Fatalf("NewPlanDHTND failed: %v", err)
+		}
+
+		data := make([]float64, plan.Len())
+
+		assertNoAllocs(t, "Forward", func() error {
+			return plan.Forward(data, data)
+		})
+		assertNoAllocs(t, "Inverse", func() error {
+			return plan.Inverse(data, data)
+		})
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestPlanRealTPacked_NoAllocs(t *testing.T) {
+	for _, format := range allRealFormats {
+		plan, err := NewPlanRealTWithOptions[float32, complex64](128, PlanOptions{RealFormat: format})
+		if err != nil {
+			t.Fatalf("NewPlanRealTWithOptions failed: %v", err)
+		}
+
+		data := make([]float32, plan.PackedLen())
+
+		assertNoAllocs(t, format.String()+" ForwardInPlace", func() error {
+			return plan.ForwardInPlace(data)
+		})
+		assertNoAllocs(t, format.String()+" InverseInPlace", func() error {
+			return plan.InverseInPlace(data)
+		})
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestPlanReal2DT_NoAllocs(t *testing.T) {
+	plan, err := NewPlanReal2D64(32, 30)
+	if err != nil {
+		t.Fatalf("NewPlanReal2D64 failed: %v", err)
+	}
+
+	data := make([]float64, plan.Len())
+	spec := make([]complex128, plan.SpectrumLen())
+	full := make([]complex128, plan.Len())
+
+	assertNoAllocs(t, "Forward", func() error {
+		return plan.Forward(spec, data)
+	})
+	assertNoAllocs(t, "Inverse", func() error {
+		return plan.Inverse(data, spec)
+	})
+	assertNoAllocs(t, "ForwardFull", func() error {
+		return plan.ForwardFull(full, data)
+	})
+	assertNoAllocs(t, "InverseFull", func() error {
+		return plan.InverseFull(data, full)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"math/cmplx"
 	"strings"
 	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
 )
 
 // TestInverseInPlace tests the InverseInPlace method.
@@ -119,6 +121,7 @@ func TestKernelStrategy(t *testing.T) {
 		{"Stockham", 256, KernelStockham},
 		{"SixStep", 4096, KernelSixStep},
 		{"EightStep", 16384, KernelEightStep},
+		{"SplitRadix", 1024, KernelSplitRadix},
 	}
 
 	for _, tt := range tests {
@@ -152,6 +155,49 @@ func TestKernelStrategy(t *testing.T) {
 	}
 }
 
+func TestKernelSplitRadix_MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{16, 512, 4096} {
+		plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelSplitRadix})
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions(%d) failed: %v", n, err)
+		}
+
+		if got := plan.KernelStrategy(); got != KernelSplitRadix {
+			t.Fatalf("n=%d: KernelStrategy() = %v, want KernelSplitRadix", n, got)
+		}
+
+		if !strings.Contains(plan.String(), "SplitRadix") {
+			t.Errorf("n=%d: String() = %q, want SplitRadix", n, plan.String())
+		}
+
+		src := randomComplex128Slice(n, uint64(n))
+		want := reference.NaiveDFT128(src)
+		got := make([]complex128, n)
+
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		for i := range want {
+			if cmplx.Abs(got[i]-want[i]) > 1e-9*float64(n) {
+				t.Fatalf("n=%d: forward[%d] = %v, want %v", n, i, got[i], want[i])
+			}
+		}
+
+		if err := plan.Inverse(got, got); err != nil {
+			t.Fatalf("Inverse failed: %v", err)
+		}
+
+		for i := range src {
+			if cmplx.Abs(got[i]-src[i]) > 1e-12*float64(n) {
+				t.Fatalf("n=%d: round trip[%d] = %v, want %v", n, i, got[i], src[i])
+			}
+		}
+	}
+}
+
 func TestNewPlanWithOptions_ForcedStrategyOverridesCodelet(t *testing.T) {
 	t.Parallel()
 
@@ -59,6 +59,10 @@ func BenchmarkPlanForward_8192_Recursive(b *testing.B) {
 func BenchmarkPlanForward_16384_Recursive(b *testing.B) {
 	benchmarkPlanForwardWithOptions(b, 16384, PlanOptions{Strategy: KernelRecursive})
 }
+func BenchmarkPlanForward_4194304(b *testing.B) { benchmarkPlanForward(b, 1<<22) }
+func BenchmarkPlanForward_4194304_Parallel(b *testing.B) {
+	benchmarkPlanForwardWithOptions(b, 1<<22, PlanOptions{Workers: -1})
+}
 
 // Inverse FFT benchmarks for various sizes.
 func BenchmarkPlanInverse_8(b *testing.B)     { benchmarkPlanInverse(b, 8) }
@@ -1,9 +1,145 @@
 package algofft
 
 import (
+	"time"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
 	"github.com/cwbudde/algo-fft/internal/fft"
+	m "github.com/cwbudde/algo-fft/internal/math"
 )
 
+// bluesteinMeasureCandidates is how many of the cheapest padded sizes the
+// measuring planners time.
+const bluesteinMeasureCandidates = 3
+
+// newBluesteinPlan chooses the convolution length M >= 2n-1 of a Bluestein
+// plan and returns the unnormalized M-point plan that runs the convolution.
+// PlannerEstimate takes the cheapest 5-smooth size by the planner's cost
+// model; the measuring planners time the best few candidates and keep the
+// fastest.
+func newBluesteinPlan[T Complex](n int, features cpu.Features, opts PlanOptions) (*Plan[T], error) {
+	const iters = 3
+
+	sizes := fft.BluesteinSizes[T](n, features)
+	if opts.Planner == PlannerEstimate {
+		sizes = sizes[:1]
+	} else {
+		sizes = sizes[:min(len(sizes), bluesteinMeasureCandidates)]
+	}
+
+	var (
+		best     *Plan[T]
+		bestTime time.Duration
+	)
+
+	for _, size := range sizes {
+		// The parent passes its own scratch as workspace, so the child needs
+		// no pool.
+		plan, err := newPlanWithFeatures[T](size, features, PlanOptions{
+			Normalization: NormNone,
+			Wisdom:        opts.Wisdom,
+			Workspace:     WorkspaceExternal,
+			Verify:        opts.Verify,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(sizes) == 1 {
+			return plan, nil
+		}
+
+		buf := make([]T, size)
+		work := NewWorkspace[T](plan.WorkspaceSize())
+
+		elapsed := timeRuns(iters, func() error {
+			err := plan.ForwardWithWorkspace(buf, buf, work)
+			if err != nil {
+				return err
+			}
+
+			return plan.InverseWithWorkspace(buf, buf, work)
+		})
+		if best == nil || elapsed < bestTime {
+			best, bestTime = plan, elapsed
+		}
+	}
+
+	return best, nil
+}
+
+// bluesteinTables computes the chirp sequences of a Bluestein plan and the
+// pre-transformed convolution filters of length size, which carry the
+// 1/size of the unnormalized inverse convolution transform. The filters are
+// computed in double precision regardless of T.
+func bluesteinTables[T Complex](n, size int) (chirp, chirpInv, filter, filterInv []T, err error) {
+	ref, err := NewPlanWithOptions[complex128](size, PlanOptions{Normalization: NormNone})
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	chirp128 := fft.ComputeChirpSequence[complex128](n)
+	b := make([]complex128, size)
+	bInv := make([]complex128, size)
+
+	// b[k] = conj(chirp[k]), mirrored to b[size-k] for the circular convolution.
+	for k, w := range chirp128 {
+		b[k] = fft.ConjugateOf(w)
+		bInv[k] = w
+
+		if k > 0 {
+			b[size-k] = b[k]
+			bInv[size-k] = w
+		}
+	}
+
+	if err := ref.Forward(b, b); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := ref.Forward(bInv, bInv); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	chirp = make([]T, n)
+	chirpInv = make([]T, n)
+
+	for k, w := range chirp128 {
+		chirp[k] = m.ComplexFromFloat64[T](real(w), imag(w))
+		chirpInv[k] = m.ComplexFromFloat64[T](real(w), -imag(w))
+	}
+
+	scale := 1 / float64(size)
+
+	filter = make([]T, size)
+	filterInv = make([]T, size)
+
+	for i := range b {
+		filter[i] = m.ComplexFromFloat64[T](real(b[i])*scale, imag(b[i])*scale)
+		filterInv[i] = m.ComplexFromFloat64[T](real(bInv[i])*scale, imag(bInv[i])*scale)
+	}
+
+	return chirp, chirpInv, filter, filterInv, nil
+}
+
+// bluesteinConvolve circularly convolves scratch[:M] with a pre-transformed
+// filter through the plan's M-point convolution plan, using work as its
+// workspace.
+func (p *Plan[T]) bluesteinConvolve(scratch, filter, work []T) error {
+	buf := scratch[:p.bluesteinM]
+
+	err := p.bluesteinPlan.ForwardWithWorkspace(buf, buf, work)
+	if err != nil {
+		return err
+	}
+
+	for i := range buf {
+		buf[i] *= filter[i]
+	}
+
+	return p.bluesteinPlan.InverseWithWorkspace(buf, buf, work)
+}
+
 func (p *Plan[T]) bluesteinForward(dst, src, scratch, bluesteinScratch []T) error {
 	for i := range p.n {
 		scratch[i] = src[i] * p.bluesteinChirp[i]
@@ -14,10 +150,19 @@ func (p *Plan[T]) bluesteinForward(dst, src, scratch, bluesteinScratch []T) erro
 		scratch[i] = zero
 	}
 
-	fft.BluesteinConvolution(
-		scratch, scratch, p.bluesteinFilter,
-		p.bluesteinTwiddle, bluesteinScratch,
-	)
+	err := p.bluesteinConvolve(scratch, p.bluesteinFilter, bluesteinScratch)
+	if err != nil {
+		return err
+	}
+
+	if p.forwardScale != 1 {
+		scale := complexScale[T](p.forwardScale)
+		for i := range p.n {
+			dst[i] = scratch[i] * p.bluesteinChirp[i] * scale
+		}
+
+		return nil
+	}
 
 	for i := range p.n {
 		dst[i] = scratch[i] * p.bluesteinChirp[i]
@@ -36,20 +181,14 @@ func (p *Plan[T]) bluesteinInverse(dst, src, scratch, bluesteinScratch []T) erro
 		scratch[i] = zero
 	}
 
-	fft.BluesteinConvolution(
-		scratch, scratch, p.bluesteinFilterInv,
-		p.bluesteinTwiddle, bluesteinScratch,
-	)
-
-	var scale T
-
-	switch any(zero).(type) {
-	case complex64:
-		scale = any(complex(float32(1.0/float64(p.n)), 0)).(T)
-	case complex128:
-		scale = any(complex(1.0/float64(p.n), 0)).(T)
+	err := p.bluesteinConvolve(scratch, p.bluesteinFilterInv, bluesteinScratch)
+	if err != nil {
+		return err
 	}
 
+	// The inverse normalization (1/N by default) is fused into the final chirp multiply.
+	scale := complexScale[T](p.inverseScale / float64(p.n))
+
 	for i := range p.n {
 		dst[i] = scratch[i] * p.bluesteinChirpInv[i] * scale
 	}
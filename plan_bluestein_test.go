@@ -79,7 +79,7 @@ func TestNewPlan_Bluestein(t *testing.T) {
 	t.Parallel()
 
 	// Prime lengths trigger Bluestein
-	primes := []int{7, 11, 13, 17}
+	primes := []int{17, 19, 23, 31}
 	for _, n := range primes {
 		t.Run("complex64_"+itoa(n), func(t *testing.T) {
 			t.Parallel()
@@ -235,6 +235,64 @@ func TestBluestein_LargePrimes(t *testing.T) {
 	}
 }
 
+func TestBluestein_PaddedSize(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{19, 97, 1019, 4099} {
+		for _, planner := range []PlannerMode{PlannerEstimate, PlannerMeasure} {
+			for _, policy := range []WorkspacePolicy{WorkspaceAuto, WorkspaceExternal} {
+				plan, err := NewPlanWithOptions[complex128](n, PlanOptions{
+					Strategy:  KernelBluestein,
+					Planner:   planner,
+					Workspace: policy,
+				})
+				if err != nil {
+					t.Fatalf("NewPlanWithOptions(%d) failed: %v", n, err)
+				}
+
+				size := plan.Meta().BluesteinSize
+				if size < 2*n-1 || size > 4*n {
+					t.Fatalf("n=%d planner=%v: BluesteinSize = %d, want in [%d, %d]", n, planner, size, 2*n-1, 4*n)
+				}
+
+				src := randomComplex128Slice(n, uint64(n))
+				want := reference.NaiveDFT128(src)
+				got := make([]complex128, n)
+				work := NewWorkspace[complex128](plan.WorkspaceSize())
+
+				if err := plan.ForwardWithWorkspace(got, src, work); err != nil {
+					t.Fatalf("ForwardWithWorkspace failed: %v", err)
+				}
+
+				for i := range want {
+					if cmplx.Abs(got[i]-want[i]) > 1e-9*float64(n) {
+						t.Fatalf("n=%d planner=%v M=%d: forward[%d] = %v, want %v", n, planner, size, i, got[i], want[i])
+					}
+				}
+
+				if err := plan.InverseWithWorkspace(got, got, work); err != nil {
+					t.Fatalf("InverseWithWorkspace failed: %v", err)
+				}
+
+				for i := range src {
+					if cmplx.Abs(got[i]-src[i]) > 1e-12*float64(n) {
+						t.Fatalf("n=%d planner=%v M=%d: round trip[%d] = %v, want %v", n, planner, size, i, got[i], src[i])
+					}
+				}
+			}
+		}
+	}
+
+	plan, err := NewPlanT[complex64](64)
+	if err != nil {
+		t.Fatalf("NewPlanT failed: %v", err)
+	}
+
+	if size := plan.Meta().BluesteinSize; size != 0 {
+		t.Errorf("power-of-two plan: BluesteinSize = %d, want 0", size)
+	}
+}
+
 // TestBluestein_MatchesReference validates Bluestein FFT against naive DFT.
 // This is the critical correctness test - it proves the FFT computes the right answer,
 // not just that it's invertible.
@@ -0,0 +1,381 @@
+package algofft
+
+import (
+	"math"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+)
+
+// TrigType selects the DCT or DST variant of a PlanDCT or PlanDST.
+//
+// The unnormalized definitions follow FFTW (REDFTxx/RODFTxx); for a
+// length-N input x and 0 <= k < N:
+//
+//	DCT-I:   y[k] = x[0] + (-1)^k x[N-1] + 2 Σ_{n=1}^{N-2} x[n] cos(πnk/(N-1))
+//	DCT-II:  y[k] = 2 Σ x[n] cos(π(n+½)k/N)
+//	DCT-III: y[k] = x[0] + 2 Σ_{n=1}^{N-1} x[n] cos(πn(k+½)/N)
+//	DCT-IV:  y[k] = 2 Σ x[n] cos(π(n+½)(k+½)/N)
+//	DST-I:   y[k] = 2 Σ x[n] sin(π(n+1)(k+1)/(N+1))
+//	DST-II:  y[k] = 2 Σ x[n] sin(π(n+½)(k+1)/N)
+//	DST-III: y[k] = (-1)^k x[N-1] + 2 Σ_{n=0}^{N-2} x[n] sin(π(n+1)(k+½)/N)
+//	DST-IV:  y[k] = 2 Σ x[n] sin(π(n+½)(k+½)/N)
+type TrigType uint8
+
+const (
+	TypeI TrigType = iota + 1
+	TypeII
+	TypeIII
+	TypeIV
+)
+
+// String returns the roman numeral of the type.
+func (t TrigType) String() string {
+	switch t {
+	case TypeI:
+		return "I"
+	case TypeII:
+		return "II"
+	case TypeIII:
+		return "III"
+	case TypeIV:
+		return "IV"
+	default:
+		return "unknown"
+	}
+}
+
+// inverse returns the type whose transform inverts t: DCT/DST-II and -III
+// invert each other, types I and IV are their own inverses.
+func (t TrigType) inverse() TrigType {
+	switch t {
+	case TypeII:
+		return TypeIII
+	case TypeIII:
+		return TypeII
+	default:
+		return t
+	}
+}
+
+// PlanDCT is a pre-computed discrete cosine transform plan of one TrigType
+// for float32 or float64 data.
+//
+// Types I to III run on a real FFT (PlanRealT) through its pack/unpack
+// recombination, with the pre- and post-twiddles fused into the copies in
+// and out; type IV runs on a complex FFT of half the length (odd lengths: of
+// twice the length). Transforms do not allocate.
+//
+// Normalization follows PlanOptions.Normalization with N replaced by the
+// logical DFT length M of the type: 2(N-1) for type I and 2N otherwise, so
+// that Inverse(Forward(x)) == x by default. NormOrtho makes the transform
+// orthonormal, matching scipy.fft's norm="ortho": the boundary terms of types
+// I to III are additionally weighted by √2.
+//
+// A PlanDCT reuses internal buffers and is not safe for concurrent use.
+type PlanDCT[F Float] struct {
+	trig trigTransform[F]
+}
+
+// PlanDST is a pre-computed discrete sine transform plan; see PlanDCT. The
+// logical DFT length is 2(N+1) for type I and 2N otherwise.
+type PlanDST[F Float] struct {
+	trig trigTransform[F]
+}
+
+// NewPlanDCT creates a DCT plan of the given type for length n.
+func NewPlanDCT[F Float](n int, kind TrigType) (*PlanDCT[F], error) {
+	return NewPlanDCTWithOptions[F](n, kind, PlanOptions{})
+}
+
+// NewPlanDCTWithOptions creates a DCT plan with explicit planner options.
+//
+// Returns ErrInvalidLength if n < 1, or n < 2 for type I.
+// Returns ErrInvalidTrigType if kind is not TypeI to TypeIV.
+func NewPlanDCTWithOptions[F Float](n int, kind TrigType, opts PlanOptions) (*PlanDCT[F], error) {
+	trig, err := newTrigTransform[F](n, kind, false, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlanDCT[F]{trig: trig}, nil
+}
+
+// NewPlanDST creates a DST plan of the given type for length n.
+func NewPlanDST[F Float](n int, kind TrigType) (*PlanDST[F], error) {
+	return NewPlanDSTWithOptions[F](n, kind, PlanOptions{})
+}
+
+// NewPlanDSTWithOptions creates a DST plan with explicit planner options.
+//
+// Returns ErrInvalidLength if n < 1.
+// Returns ErrInvalidTrigType if kind is not TypeI to TypeIV.
+func NewPlanDSTWithOptions[F Float](n int, kind TrigType, opts PlanOptions) (*PlanDST[F], error) {
+	trig, err := newTrigTransform[F](n, kind, true, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlanDST[F]{trig: trig}, nil
+}
+
+// Len returns the transform length.
+func (p *PlanDCT[F]) Len() int {
+	return p.trig.length()
+}
+
+// Type returns the DCT type.
+func (p *PlanDCT[F]) Type() TrigType {
+	return p.trig.trigType()
+}
+
+// Forward computes the DCT of src into dst. Both must have length Len(); dst
+// may alias src.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if either length differs from Len().
+func (p *PlanDCT[F]) Forward(dst, src []F) error {
+	return p.trig.transform(dst, src, false)
+}
+
+// Inverse computes the inverse of Forward: the DCT of the inverse type
+// (type III for type II and vice versa), scaled per the plan's
+// normalization.
+func (p *PlanDCT[F]) Inverse(dst, src []F) error {
+	return p.trig.transform(dst, src, true)
+}
+
+// Len returns the transform length.
+func (p *PlanDST[F]) Len() int {
+	return p.trig.length()
+}
+
+// Type returns the DST type.
+func (p *PlanDST[F]) Type() TrigType {
+	return p.trig.trigType()
+}
+
+// Forward computes the DST of src into dst. See PlanDCT.Forward.
+func (p *PlanDST[F]) Forward(dst, src []F) error {
+	return p.trig.transform(dst, src, false)
+}
+
+// Inverse computes the inverse of Forward. See PlanDCT.Inverse.
+func (p *PlanDST[F]) Inverse(dst, src []F) error {
+	return p.trig.transform(dst, src, true)
+}
+
+// trigTransform hides the complex type of trigPlan behind the float type.
+type trigTransform[F Float] interface {
+	length() int
+	trigType() TrigType
+	transform(dst, src []F, inverse bool) error
+}
+
+// trigPlan implements the DCT and DST types on a real or complex FFT plan.
+// Intermediate twiddle products are formed in float64.
+type trigPlan[F Float, C Complex] struct {
+	n    int
+	kind TrigType
+	sine bool
+
+	real  *PlanRealT[F, C] // types I to III
+	cplx  *Plan[C]         // type IV
+	input []F              // real FFT input, length M for type I, N otherwise
+	spec  []C              // real FFT spectrum, or type IV complex buffer
+
+	// Types II/III: twiddle[k] = e^{-iπk/(2N)} for k <= N/2.
+	// Type IV: the pre- and post-twiddles of the half- or double-length FFT.
+	twiddle, post []complex128
+
+	forwardScale, inverseScale float64
+	ortho                      bool
+}
+
+func newTrigTransform[F Float](n int, kind TrigType, sine bool, opts PlanOptions) (trigTransform[F], error) {
+	opts = normalizePlanOptions(opts)
+
+	var zero F
+	switch any(zero).(type) {
+	case float32:
+		plan, err := newTrigPlan[float32, complex64](n, kind, sine, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return any(plan).(trigTransform[F]), nil
+	default:
+		plan, err := newTrigPlan[float64, complex128](n, kind, sine, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return any(plan).(trigTransform[F]), nil
+	}
+}
+
+func newTrigPlan[F Float, C Complex](n int, kind TrigType, sine bool, opts PlanOptions) (*trigPlan[F, C], error) {
+	if kind < TypeI || kind > TypeIV {
+		return nil, ErrInvalidTrigType
+	}
+
+	if n < 1 || (kind == TypeI && !sine && n < 2) {
+		return nil, ErrInvalidLength
+	}
+
+	// Logical DFT length for normalization
+	size := 2 * n
+
+	if kind == TypeI {
+		size = 2 * (n - 1)
+		if sine {
+			size = 2 * (n + 1)
+		}
+	}
+
+	forwardScale, inverseScale := normalizationScales(opts.Normalization, size)
+
+	p := &trigPlan[F, C]{
+		n:            n,
+		kind:         kind,
+		sine:         sine,
+		forwardScale: forwardScale,
+		inverseScale: inverseScale / float64(size),
+		ortho:        opts.Normalization == NormOrtho,
+	}
+
+	// The child plans are unnormalized; scaling happens on the way out.
+	childOpts := opts
+	childOpts.Batch = 0
+	childOpts.Stride = 0
+	childOpts.InPlace = false
+	childOpts.Workspace = WorkspaceAuto
+	childOpts.Normalization = NormNone
+
+	features := cpu.DetectFeatures()
+
+	var err error
+
+	switch kind {
+	case TypeI:
+		p.real, err = newPlanRealTWithFeatures[F, C](size, features, childOpts)
+		p.input = make([]F, size)
+	case TypeII, TypeIII:
+		p.real, err = newPlanRealTWithFeatures[F, C](n, features, childOpts)
+		p.input = make([]F, n)
+		p.twiddle = trigTwiddles(n/2+1, func(k int) float64 { return -math.Pi * float64(k) / float64(2*n) })
+	case TypeIV:
+		err = p.initTypeIV(features, childOpts)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if p.real != nil {
+		p.spec = make([]C, p.real.SpectrumLen())
+	}
+
+	return p, nil
+}
+
+// initTypeIV sets up type IV: even lengths pack x[2m] + i·x[N-1-2m] into a
+// half-length complex FFT; odd lengths evaluate the 2N-point DFT of the
+// pre-twiddled input.
+func (p *trigPlan[F, C]) initTypeIV(features cpu.Features, opts PlanOptions) error {
+	n := p.n
+	quarter := float64(4 * n)
+
+	var (
+		err     error
+		fftSize int
+	)
+
+	if n%2 == 0 {
+		fftSize = n / 2
+		p.twiddle = trigTwiddles(fftSize, func(m int) float64 { return -math.Pi * float64(4*m+1) / quarter })
+		p.post = trigTwiddles(fftSize, func(m int) float64 { return -math.Pi * float64(m) / float64(n) })
+	} else {
+		fftSize = 2 * n
+		p.twiddle = trigTwiddles(n, func(j int) float64 { return -math.Pi * float64(j) / float64(2*n) })
+		p.post = trigTwiddles(n, func(k int) float64 { return -math.Pi * float64(2*k+1) / quarter })
+	}
+
+	p.cplx, err = newPlanWithFeatures[C](fftSize, features, opts)
+	p.spec = make([]C, fftSize)
+
+	return err
+}
+
+// trigTwiddles returns e^{i·angle(k)} for k < count.
+func trigTwiddles(count int, angle func(k int) float64) []complex128 {
+	twiddle := make([]complex128, count)
+	for k := range twiddle {
+		sin, cos := math.Sincos(angle(k))
+		twiddle[k] = complex(cos, sin)
+	}
+
+	return twiddle
+}
+
+func (p *trigPlan[F, C]) length() int {
+	return p.n
+}
+
+func (p *trigPlan[F, C]) trigType() TrigType {
+	return p.kind
+}
+
+func (p *trigPlan[F, C]) transform(dst, src []F, inverse bool) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.n || len(src) != p.n {
+		return ErrLengthMismatch
+	}
+
+	kind, scale := p.kind, p.forwardScale
+	if inverse {
+		kind, scale = kind.inverse(), p.inverseScale
+	}
+
+	// Orthonormal boundary weights: the input side here, the output side
+	// after the transform.
+	edge := 1.0
+	if p.ortho && (kind == TypeIII || kind == TypeI && !p.sine) {
+		edge = math.Sqrt2
+	}
+
+	var err error
+
+	switch kind {
+	case TypeI:
+		if p.sine {
+			err = p.dstI(dst, src, scale)
+		} else {
+			err = p.dctI(dst, src, scale, edge)
+		}
+	case TypeII:
+		err = p.typeII(dst, src, scale)
+	case TypeIII:
+		err = p.typeIII(dst, src, scale, edge)
+	case TypeIV:
+		err = p.typeIV(dst, src, scale)
+	}
+
+	if err != nil || !p.ortho {
+		return err
+	}
+
+	switch {
+	case kind == TypeI && !p.sine:
+		dst[0] /= math.Sqrt2
+		dst[p.n-1] /= math.Sqrt2
+	case kind == TypeII && p.sine:
+		dst[p.n-1] /= math.Sqrt2
+	case kind == TypeII:
+		dst[0] /= math.Sqrt2
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// naiveTrig evaluates the FFTW definitions listed on TrigType.
+func naiveTrig(x []float64, kind TrigType, sine bool) []float64 {
+	n := len(x)
+	y := make([]float64, n)
+
+	for k := range n {
+		var sum float64
+
+		for j, v := range x {
+			fj, fk := float64(j), float64(k)
+
+			switch {
+			case kind == TypeI && !sine:
+				switch j {
+				case 0:
+					sum += v
+				case n - 1:
+					sum += v * math.Pow(-1, fk)
+				default:
+					sum += 2 * v * math.Cos(math.Pi*fj*fk/float64(n-1))
+				}
+			case kind == TypeI:
+				sum += 2 * v * math.Sin(math.Pi*(fj+1)*(fk+1)/float64(n+1))
+			case kind == TypeII && !sine:
+				sum += 2 * v * math.Cos(math.Pi*(fj+0.5)*fk/float64(n))
+			case kind == TypeII:
+				sum += 2 * v * math.Sin(math.Pi*(fj+0.5)*(fk+1)/float64(n))
+			case kind == TypeIII && !sine:
+				if j == 0 {
+					sum += v
+				} else {
+					sum += 2 * v * math.Cos(math.Pi*fj*(fk+0.5)/float64(n))
+				}
+			case kind == TypeIII:
+				if j == n-1 {
+					sum += v * math.Pow(-1, fk)
+				} else {
+					sum += 2 * v * math.Sin(math.Pi*(fj+1)*(fk+0.5)/float64(n))
+				}
+			case !sine:
+				sum += 2 * v * math.Cos(math.Pi*(fj+0.5)*(fk+0.5)/float64(n))
+			default:
+				sum += 2 * v * math.Sin(math.Pi*(fj+0.5)*(fk+0.5)/float64(n))
+			}
+		}
+
+		y[k] = sum
+	}
+
+	return y
+}
+
+// newTrigForTest returns the Forward and Inverse methods of a DCT or DST plan.
+func newTrigForTest[F Float](t *testing.T, n int, kind TrigType, sine bool, opts PlanOptions) (forward, inverse func(dst, src []F) error) {
+	t.Helper()
+
+	if sine {
+		plan, err := NewPlanDSTWithOptions[F](n, kind, opts)
+		if err != nil {
+			t.Fatalf("NewPlanDSTWithOptions(%d, %v) failed: %v", n, kind, err)
+		}
+
+		return plan.Forward, plan.Inverse
+	}
+
+	plan, err := NewPlanDCTWithOptions[F](n, kind, opts)
+	if err != nil {
+		t.Fatalf("NewPlanDCTWithOptions(%d, %v) failed: %v", n, kind, err)
+	}
+
+	return plan.Forward, plan.Inverse
+}
+
+func trigName(kind TrigType, sine bool) string {
+	if sine {
+		return "DST-" + kind.String()
+	}
+
+	return "DCT-" + kind.String()
+}
+
+func TestPlanDCT_MatchesDefinition(t *testing.T) {
+	t.Parallel()
+
+	for _, sine := range []bool{false, true} {
+		for _, kind := range []TrigType{TypeI, TypeII, TypeIII, TypeIV} {
+			for _, n := range []int{1, 2, 3, 4, 5, 8, 15, 16, 31, 64} {
+				if kind == TypeI && !sine && n < 2 {
+					continue
+				}
+
+				name := fmt.Sprintf("%s/%d", trigName(kind, sine), n)
+				src := make([]float64, n)
+
+				for i, v := range randomComplex128Slice(n, uint64(n)) {
+					src[i] = real(v)
+				}
+
+				forward, inverse := newTrigForTest[float64](t, n, kind, sine, PlanOptions{})
+				got := make([]float64, n)
+
+				if err := forward(got, src); err != nil {
+					t.Fatalf("%s: Forward failed: %v", name, err)
+				}
+
+				want := naiveTrig(src, kind, sine)
+				for k := range want {
+					if math.Abs(got[k]-want[k]) > 1e-10*float64(n) {
+						t.Fatalf("%s: y[%d] = %v, want %v", name, k, got[k], want[k])
+					}
+				}
+
+				// In place round trip
+				if err := inverse(got, got); err != nil {
+					t.Fatalf("%s: Inverse failed: %v", name, err)
+				}
+
+				for i := range src {
+					if math.Abs(got[i]-src[i]) > 1e-12*float64(n) {
+						t.Fatalf("%s: round trip[%d] = %v, want %v", name, i, got[i], src[i])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestPlanDCT_Normalization(t *testing.T) {
+	t.Parallel()
+
+	const n = 12
+
+	src := make([]float32, n)
+	for i := range src {
+		src[i] = float32(math.Sin(float64(i)*0.7)) + 0.25
+	}
+
+	norm := func(x []float32) float64 {
+		var sum float64
+		for _, v := range x {
+			sum += float64(v) * float64(v)
+		}
+
+		return math.Sqrt(sum)
+	}
+
+	for _, sine := range []bool{false, true} {
+		for _, kind := range []TrigType{TypeI, TypeII, TypeIII, TypeIV} {
+			name := trigName(kind, sine)
+
+			// Orthonormal transforms preserve the Euclidean norm.
+			forward, _ := newTrigForTest[float32](t, n, kind, sine, PlanOptions{Normalization: NormOrtho})
+			got := make([]float32, n)
+
+			if err := forward(got, src); err != nil {
+				t.Fatalf("%s: Forward failed: %v", name, err)
+			}
+
+			if math.Abs(norm(got)-norm(src)) > 1e-5*norm(src) {
+				t.Errorf("%s ortho: |y| = %v, |x| = %v", name, norm(got), norm(src))
+			}
+
+			for _, mode := range []Normalization{NormBackward, NormForward, NormOrtho} {
+				forward, inverse := newTrigForTest[float32](t, n, kind, sine, PlanOptions{Normalization: mode})
+
+				if err := forward(got, src); err != nil {
+					t.Fatalf("%s: Forward failed: %v", name, err)
+				}
+
+				if err := inverse(got, got); err != nil {
+					t.Fatalf("%s: Inverse failed: %v", name, err)
+				}
+
+				assertScaledFloat32(t, got, src, 1, 1e-5, name+" "+mode.String())
+			}
+		}
+	}
+}
+
+func TestPlanDCT_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPlanDCT[float64](1, TypeI); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("DCT-I of length 1: %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := NewPlanDST[float32](0, TypeII); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("length 0: %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := NewPlanDCT[float64](8, TrigType(5)); !errors.Is(err, ErrInvalidTrigType) {
+		t.Errorf("type 5: %v, want ErrInvalidTrigType", err)
+	}
+
+	plan, err := NewPlanDST[float64](8, TypeIV)
+	if err != nil {
+		t.Fatalf("NewPlanDST failed: %v", err)
+	}
+
+	if plan.Len() != 8 || plan.Type() != TypeIV {
+		t.Errorf("Len/Type = %d/%v, want 8/IV", plan.Len(), plan.Type())
+	}
+
+	if err := plan.Forward(nil, make([]float64, 8)); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: %v, want ErrNilSlice", err)
+	}
+
+	if err := plan.Inverse(make([]float64, 8), make([]float64, 7)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short src: %v, want ErrLengthMismatch", err)
+	}
+}
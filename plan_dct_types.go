@@ -0,0 +1,226 @@
+package algofft
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Per-type DCT/DST algorithms of trigPlan. Each reads all of src before
+// writing dst, so the two may alias, and scales the output by scale.
+//
+// The sine transforms of types II to IV reuse the cosine ones through
+//
+//	DST-II(x)[k]  = DCT-II((-1)^n x[n])[N-1-k]
+//	DST-III(x)[k] = (-1)^k DCT-III(x[N-1-n])[k]
+//	DST-IV(x)[k]  = (-1)^k DCT-IV(x[N-1-n])[k]
+
+// dctI evaluates DCT-I as the real FFT of the even extension
+// x[0], ..., x[N-1], x[N-2], ..., x[1]; edge weights x[0] and x[N-1].
+func (p *trigPlan[F, C]) dctI(dst, src []F, scale, edge float64) error {
+	n := p.n
+	input := p.input
+
+	input[0] = F(float64(src[0]) * edge)
+	input[n-1] = F(float64(src[n-1]) * edge)
+
+	for j := 1; j < n-1; j++ {
+		input[j] = src[j]
+		input[len(input)-j] = src[j]
+	}
+
+	err := p.real.Forward(p.spec, input)
+	if err != nil {
+		return err
+	}
+
+	for k := range dst {
+		dst[k] = F(real(complex128(p.spec[k])) * scale)
+	}
+
+	return nil
+}
+
+// dstI evaluates DST-I as the real FFT of the odd extension
+// 0, x[0], ..., x[N-1], 0, -x[N-1], ..., -x[0].
+func (p *trigPlan[F, C]) dstI(dst, src []F, scale float64) error {
+	n := p.n
+	input := p.input
+
+	input[0] = 0
+	input[n+1] = 0
+
+	for j, v := range src {
+		input[1+j] = v
+		input[len(input)-1-j] = -v
+	}
+
+	err := p.real.Forward(p.spec, input)
+	if err != nil {
+		return err
+	}
+
+	for k := range dst {
+		dst[k] = F(-imag(complex128(p.spec[k+1])) * scale)
+	}
+
+	return nil
+}
+
+// typeII evaluates DCT-II (Makhoul): the real FFT V of the reordering
+// x[0], x[2], ..., x[3], x[1] gives y[k] = 2·Re(W^k V[k]) and
+// y[N-k] = -2·Im(W^k V[k]) with W = e^{-iπ/(2N)}.
+func (p *trigPlan[F, C]) typeII(dst, src []F, scale float64) error {
+	n := p.n
+	input := p.input
+
+	for k := 0; 2*k < n; k++ {
+		input[k] = src[2*k]
+	}
+
+	for k := 0; 2*k+1 < n; k++ {
+		v := src[2*k+1]
+		if p.sine {
+			v = -v
+		}
+
+		input[n-1-k] = v
+	}
+
+	err := p.real.Forward(p.spec, input)
+	if err != nil {
+		return err
+	}
+
+	// The sine transform reverses the output order.
+	first, step := 0, 1
+	if p.sine {
+		first, step = n-1, -1
+	}
+
+	scale *= 2
+	dst[first] = F(real(complex128(p.spec[0])) * scale)
+
+	for k := 1; 2*k <= n; k++ {
+		z := p.twiddle[k] * complex128(p.spec[k])
+		dst[first+step*k] = F(real(z) * scale)
+
+		if 2*k != n {
+			dst[first+step*(n-k)] = F(-imag(z) * scale)
+		}
+	}
+
+	return nil
+}
+
+// typeIII evaluates DCT-III by inverting typeII: V[k] = W^-k (x[k] - i·x[N-k])
+// and V[0] = x[0]; edge weights x[0].
+func (p *trigPlan[F, C]) typeIII(dst, src []F, scale, edge float64) error {
+	n := p.n
+
+	// The sine transform reverses the input order.
+	at := func(k int) float64 {
+		if p.sine {
+			return float64(src[n-1-k])
+		}
+
+		return float64(src[k])
+	}
+
+	p.spec[0] = C(complex(at(0)*edge, 0))
+
+	for k := 1; 2*k <= n; k++ {
+		if 2*k == n {
+			// W^(-N/2)·(1-i) = √2: the Nyquist bin is exactly real.
+			p.spec[k] = C(complex(math.Sqrt2*at(k), 0))
+			continue
+		}
+
+		p.spec[k] = C(cmplx.Conj(p.twiddle[k]) * complex(at(k), -at(n-k)))
+	}
+
+	input := p.input
+
+	err := p.real.Inverse(input, p.spec)
+	if err != nil {
+		return err
+	}
+
+	odd := scale
+	if p.sine {
+		odd = -scale
+	}
+
+	for k := 0; 2*k < n; k++ {
+		dst[2*k] = F(float64(input[k]) * scale)
+	}
+
+	for k := 0; 2*k+1 < n; k++ {
+		dst[2*k+1] = F(float64(input[n-1-k]) * odd)
+	}
+
+	return nil
+}
+
+// typeIV evaluates DCT-IV. For even N, v[m] = (x[2m] + i·x[N-1-2m])·e^{-iπ(4m+1)/(4N)}
+// is transformed at length N/2 and c[m] = V[m]·e^{-iπm/N} gives y[2m] = 2·Re c[m]
+// and y[N-1-2m] = -2·Im c[m]. For odd N, y[k] = 2·Re(e^{-iπ(2k+1)/(4N)}·T[k])
+// where T is the 2N-point DFT of x[n]·e^{-iπn/(2N)}.
+func (p *trigPlan[F, C]) typeIV(dst, src []F, scale float64) error {
+	n := p.n
+	buf := p.spec
+
+	// The sine transform reverses the input order and negates odd outputs.
+	at := func(j int) float64 {
+		if p.sine {
+			return float64(src[n-1-j])
+		}
+
+		return float64(src[j])
+	}
+
+	odd := 2 * scale
+	if p.sine {
+		odd = -odd
+	}
+
+	if n%2 == 0 {
+		for m := range buf {
+			buf[m] = C(complex(at(2*m), at(n-1-2*m)) * p.twiddle[m])
+		}
+
+		err := p.cplx.Forward(buf, buf)
+		if err != nil {
+			return err
+		}
+
+		for m, v := range buf {
+			c := complex128(v) * p.post[m]
+			dst[2*m] = F(real(c) * 2 * scale)
+			dst[n-1-2*m] = F(-imag(c) * odd)
+		}
+
+		return nil
+	}
+
+	for j := range n {
+		buf[j] = C(complex(at(j), 0) * p.twiddle[j])
+	}
+
+	clear(buf[n:])
+
+	err := p.cplx.Forward(buf, buf)
+	if err != nil {
+		return err
+	}
+
+	for k := range n {
+		factor := 2 * scale
+		if k%2 == 1 {
+			factor = odd
+		}
+
+		dst[k] = F(real(p.post[k]*complex128(buf[k])) * factor)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package algofft
+
+import (
+	"fmt"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+)
+
+// PlanDHT is a pre-computed discrete Hartley transform plan for real data of
+// one or more dimensions:
+//
+//	H[k] = Σ_n x[n] cas(2π Σ_d n_d k_d / N_d),  cas θ = cos θ + sin θ
+//
+// The DHT is real-to-real and its own inverse up to 1/N. It is read off the
+// half spectrum of a real FFT, H[k] = Re X[k] - Im X[k], using
+// X[-k] = conj X[k] for the bins the half spectrum omits, so no full complex
+// transform is computed. Plans of any rank run on PlanRealT along the last
+// dimension and complex plans along the others, like PlanReal2DT and
+// PlanReal3DT.
+//
+// Normalization follows PlanOptions.Normalization, so that
+// Inverse(Forward(x)) == x by default. Transforms do not allocate.
+//
+// A PlanDHT reuses internal buffers and is not safe for concurrent use.
+type PlanDHT[F Float] struct {
+	dht dhtTransform[F]
+}
+
+// NewPlanDHT creates a 1D DHT plan for length n.
+func NewPlanDHT[F Float](n int) (*PlanDHT[F], error) {
+	return NewPlanDHTNDWithOptions[F]([]int{n}, PlanOptions{})
+}
+
+// NewPlanDHTWithOptions creates a 1D DHT plan with explicit planner options.
+func NewPlanDHTWithOptions[F Float](n int, opts PlanOptions) (*PlanDHT[F], error) {
+	return NewPlanDHTNDWithOptions[F]([]int{n}, opts)
+}
+
+// NewPlanDHTND creates a DHT plan for row-major data of the given dimensions.
+func NewPlanDHTND[F Float](dims []int) (*PlanDHT[F], error) {
+	return NewPlanDHTNDWithOptions[F](dims, PlanOptions{})
+}
+
+// NewPlanDHTNDWithOptions creates an N-D DHT plan with explicit planner
+// options.
+//
+// Returns ErrInvalidLength if dims is empty or any dimension is < 1.
+func NewPlanDHTNDWithOptions[F Float](dims []int, opts PlanOptions) (*PlanDHT[F], error) {
+	dht, err := newDHTTransform[F](dims, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlanDHT[F]{dht: dht}, nil
+}
+
+// Len returns the total number of elements.
+func (p *PlanDHT[F]) Len() int {
+	return p.dht.length()
+}
+
+// Dims returns a copy of the dimensions.
+func (p *PlanDHT[F]) Dims() []int {
+	return p.dht.dimensions()
+}
+
+// Forward computes the DHT of src into dst. Both must have length Len(); dst
+// may alias src.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if either length differs from Len().
+func (p *PlanDHT[F]) Forward(dst, src []F) error {
+	return p.dht.transform(dst, src, false)
+}
+
+// Inverse computes the inverse of Forward: the DHT again, scaled per the
+// plan's normalization (by 1/N by default).
+func (p *PlanDHT[F]) Inverse(dst, src []F) error {
+	return p.dht.transform(dst, src, true)
+}
+
+// dhtTransform hides the complex type of dhtPlan behind the float type.
+type dhtTransform[F Float] interface {
+	length() int
+	dimensions() []int
+	transform(dst, src []F, inverse bool) error
+}
+
+// dhtPlan computes the DHT from the half spectrum of a real FFT.
+type dhtPlan[F Float, C Complex] struct {
+	dims           []int
+	n              int
+	last, halfLast int // last dimension and its half-spectrum width
+
+	// half computes the unnormalized half spectrum on a realGrid.
+	half func(dst []C, src []F) error
+
+	spec  []C   // half spectrum, dims[:rank-1] × halfLast
+	index []int // multi-index of the current row
+
+	forwardScale, inverseScale float64
+}
+
+func newDHTTransform[F Float](dims []int, opts PlanOptions) (dhtTransform[F], error) {
+	opts = normalizePlanOptions(opts)
+
+	var zero F
+	switch any(zero).(type) {
+	case float32:
+		plan, err := newDHTPlan[float32, complex64](dims, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return any(plan).(dhtTransform[F]), nil
+	default:
+		plan, err := newDHTPlan[float64, complex128](dims, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return any(plan).(dhtTransform[F]), nil
+	}
+}
+
+func newDHTPlan[F Float, C Complex](dims []int, opts PlanOptions) (*dhtPlan[F, C], error) {
+	if len(dims) == 0 {
+		return nil, ErrInvalidLength
+	}
+
+	n := 1
+
+	for i, d := range dims {
+		if d <= 0 {
+			return nil, fmt.Errorf("dimension %d has invalid size %d: %w", i, d, ErrInvalidLength)
+		}
+
+		n *= d
+	}
+
+	rank := len(dims)
+	last := dims[rank-1]
+	forwardScale, inverseScale := normalizationScales(opts.Normalization, n)
+
+	p := &dhtPlan[F, C]{
+		dims:         append([]int(nil), dims...),
+		n:            n,
+		last:         last,
+		halfLast:     last/2 + 1,
+		index:        make([]int, rank-1),
+		forwardScale: forwardScale,
+		inverseScale: inverseScale / float64(n),
+	}
+	p.spec = make([]C, n/last*p.halfLast)
+
+	// Both directions run unnormalized forward real FFTs.
+	childOpts := opts
+	childOpts.Batch = 0
+	childOpts.Stride = 0
+	childOpts.InPlace = false
+	childOpts.Workspace = WorkspaceAuto
+	childOpts.Normalization = NormBackward
+
+	grid, err := newRealGrid[F, C](dims, cpu.DetectFeatures(), childOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	p.half = grid.forwardSingle
+
+	return p, nil
+}
+
+func (p *dhtPlan[F, C]) length() int {
+	return p.n
+}
+
+func (p *dhtPlan[F, C]) dimensions() []int {
+	return append([]int(nil), p.dims...)
+}
+
+func (p *dhtPlan[F, C]) transform(dst, src []F, inverse bool) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.n || len(src) != p.n {
+		return ErrLengthMismatch
+	}
+
+	err := p.half(p.spec, src)
+	if err != nil {
+		return err
+	}
+
+	scale := p.forwardScale
+	if inverse {
+		scale = p.inverseScale
+	}
+
+	// Bins past the half spectrum come from the conjugate at the negated
+	// index: H[r, k] = Re X[-r, N-k] + Im X[-r, N-k].
+	lead := p.dims[:len(p.dims)-1]
+	index := p.index
+	clear(index)
+
+	for row := range p.n / p.last {
+		mirror := 0
+		for d, size := range lead {
+			mirror = mirror*size + (size-index[d])%size
+		}
+
+		bins := p.spec[row*p.halfLast : (row+1)*p.halfLast]
+		mirrored := p.spec[mirror*p.halfLast : (mirror+1)*p.halfLast]
+		out := dst[row*p.last : (row+1)*p.last]
+
+		for k, v := range bins {
+			c := complex128(v)
+			out[k] = F((real(c) - imag(c)) * scale)
+		}
+
+		for k := p.halfLast; k < p.last; k++ {
+			c := complex128(mirrored[p.last-k])
+			out[k] = F((real(c) + imag(c)) * scale)
+		}
+
+		// Advance the row-major multi-index of the next row
+		for d := len(lead) - 1; d >= 0; d-- {
+			index[d]++
+			if index[d] < lead[d] {
+				break
+			}
+
+			index[d] = 0
+		}
+	}
+
+	return nil
+}
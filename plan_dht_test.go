@@ -0,0 +1,195 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// referenceDHT derives the DHT of x (row-major, of the given dimensions)
+// from the reference DFT: H[k] = Re X[k] - Im X[k].
+func referenceDHT(x []float64, dims []int) []float64 {
+	input := make([]complex128, len(x))
+	for i, v := range x {
+		input[i] = complex(v, 0)
+	}
+
+	index := make([]int, len(dims))
+	want := make([]float64, len(x))
+
+	for k := range want {
+		unravelIndex(index, dims, k)
+		bin := dftBin(input, dims, index, false)
+		want[k] = real(bin) - imag(bin)
+	}
+
+	return want
+}
+
+func TestPlanDHT_MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, dims := range [][]int{
+		{1}, {2}, {7}, {16}, {45}, {128},
+		{4, 6}, {5, 3}, {3, 4, 5}, {2, 3, 4, 6}, {4, 1, 8},
+	} {
+		name := fmt.Sprintf("%v", dims)
+
+		plan, err := NewPlanDHTND[float64](dims)
+		if err != nil {
+			t.Fatalf("%s: NewPlanDHTND failed: %v", name, err)
+		}
+
+		n := plan.Len()
+		src := make([]float64, n)
+
+		for i, v := range randomComplex128Slice(n, uint64(n)) {
+			src[i] = real(v)
+		}
+
+		got := make([]float64, n)
+
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("%s: Forward failed: %v", name, err)
+		}
+
+		for k, want := range referenceDHT(src, dims) {
+			if math.Abs(got[k]-want) > 1e-12*float64(n) {
+				t.Fatalf("%s: H[%d] = %v, want %v", name, k, got[k], want)
+			}
+		}
+
+		// In place round trip
+		if err := plan.Inverse(got, got); err != nil {
+			t.Fatalf("%s: Inverse failed: %v", name, err)
+		}
+
+		for i := range src {
+			if math.Abs(got[i]-src[i]) > 1e-13*float64(n) {
+				t.Fatalf("%s: round trip[%d] = %v, want %v", name, i, got[i], src[i])
+			}
+		}
+	}
+}
+
+func TestPlanDHT_Float32(t *testing.T) {
+	t.Parallel()
+
+	for _, dims := range [][]int{{64}, {8, 12}, {6, 7}, {4, 4, 8}, {2, 2, 2, 4}} {
+		name := fmt.Sprintf("%v", dims)
+
+		plan, err := NewPlanDHTND[float32](dims)
+		if err != nil {
+			t.Fatalf("%s: NewPlanDHTND failed: %v", name, err)
+		}
+
+		n := plan.Len()
+		src := randomFloat32Slice(n, 5)
+		src64 := make([]float64, n)
+
+		for i, v := range src {
+			src64[i] = float64(v)
+		}
+
+		want := referenceDHT(src64, dims)
+		ref := make([]float32, n)
+
+		for i, v := range want {
+			ref[i] = float32(v)
+		}
+
+		got := make([]float32, n)
+
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("%s: Forward failed: %v", name, err)
+		}
+
+		assertScaledFloat32(t, got, ref, 1, 1e-4, name+" forward")
+
+		if err := plan.Inverse(got, got); err != nil {
+			t.Fatalf("%s: Inverse failed: %v", name, err)
+		}
+
+		assertScaledFloat32(t, got, src, 1, 1e-5, name+" round trip")
+	}
+}
+
+func TestPlanDHT_Normalization(t *testing.T) {
+	t.Parallel()
+
+	dims := []int{6, 10}
+	src := make([]float64, 60)
+
+	for i, v := range randomComplex128Slice(60, 3) {
+		src[i] = real(v)
+	}
+
+	want := referenceDHT(src, dims)
+
+	for _, mode := range []Normalization{NormBackward, NormForward, NormOrtho, NormNone} {
+		plan, err := NewPlanDHTNDWithOptions[float64](dims, PlanOptions{Normalization: mode})
+		if err != nil {
+			t.Fatalf("%v: NewPlanDHTNDWithOptions failed: %v", mode, err)
+		}
+
+		forwardScale, inverseScale := normalizationScales(mode, 60)
+		got := make([]float64, 60)
+
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("%v: Forward failed: %v", mode, err)
+		}
+
+		for k := range want {
+			if math.Abs(got[k]-want[k]*forwardScale) > 1e-12 {
+				t.Fatalf("%v: H[%d] = %v, want %v", mode, k, got[k], want[k]*forwardScale)
+			}
+		}
+
+		if err := plan.Inverse(got, got); err != nil {
+			t.Fatalf("%v: Inverse failed: %v", mode, err)
+		}
+
+		for i := range src {
+			if math.Abs(got[i]-src[i]*forwardScale*inverseScale) > 1e-12 {
+				t.Fatalf("%v: round trip[%d] = %v, want %v", mode, i, got[i], src[i]*forwardScale*inverseScale)
+			}
+		}
+	}
+}
+
+func TestPlanDHT_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPlanDHT[float64](0); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("length 0: %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := NewPlanDHTND[float32](nil); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("no dims: %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := NewPlanDHTND[float32]([]int{4, -1}); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("negative dim: %v, want ErrInvalidLength", err)
+	}
+
+	dims := []int{3, 8}
+
+	plan, err := NewPlanDHTND[float64](dims)
+	if err != nil {
+		t.Fatalf("NewPlanDHTND failed: %v", err)
+	}
+
+	dims[0] = 5
+	if got := plan.Dims(); plan.Len() != 24 || got[0] != 3 || got[1] != 8 {
+		t.Errorf("Len/Dims = %d/%v, want 24/[3 8]", plan.Len(), got)
+	}
+
+	if err := plan.Forward(nil, make([]float64, 24)); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: %v, want ErrNilSlice", err)
+	}
+
+	if err := plan.Inverse(make([]float64, 24), make([]float64, 23)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short src: %v, want ErrLengthMismatch", err)
+	}
+}
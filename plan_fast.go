@@ -31,6 +31,12 @@ type FastPlan[T Complex] struct {
 
 	forwardFunc fft.CodeletFunc[T]
 	inverseFunc fft.CodeletFunc[T]
+
+	// forwardScale/inverseScale implement PlanOptions.Normalization (1 = no
+	// extra work). Codelets have their scaling compiled in, so any other
+	// factor costs one sweep over the output.
+	forwardScale float64
+	inverseScale float64
 }
 
 // NewFastPlan creates an optimized FFT plan with pre-resolved dispatch.
@@ -44,6 +50,13 @@ type FastPlan[T Complex] struct {
 //	    regularPlan, _ := algofft.NewPlanT[complex64](256)
 //	}
 func NewFastPlan[T Complex](n int) (*FastPlan[T], error) {
+	return NewFastPlanWithOptions[T](n, PlanOptions{})
+}
+
+// NewFastPlanWithOptions creates an optimized FFT plan honouring opts.Normalization.
+// The codelet is always chosen by the registry; planner, strategy and layout
+// options are ignored because FastPlan has no fallback dispatch.
+func NewFastPlanWithOptions[T Complex](n int, opts PlanOptions) (*FastPlan[T], error) {
 	if n < 1 || !m.IsPowerOf2(n) {
 		return nil, ErrInvalidLength
 	}
@@ -86,6 +99,8 @@ func NewFastPlan[T Complex](n int) (*FastPlan[T], error) {
 		scratchBacking = scb
 	}
 
+	opts = normalizePlanOptions(opts)
+
 	fp := &FastPlan[T]{
 		n:                     n,
 		twiddle:               twiddle,
@@ -98,6 +113,8 @@ func NewFastPlan[T Complex](n int) (*FastPlan[T], error) {
 		inverseFunc:           estimate.InverseCodelet,
 	}
 
+	fp.forwardScale, fp.inverseScale = normalizationScales(opts.Normalization, n)
+
 	fp.codeletTwiddleForward, fp.codeletTwiddleInverse, fp.codeletTwiddleForwardBacking, fp.codeletTwiddleInverseBacking = prepareCodeletTwiddles(n, twiddle, estimate)
 
 	return fp, nil
@@ -112,22 +129,26 @@ func (fp *FastPlan[T]) Len() int {
 // Caller guarantees: len(dst) >= n, len(src) >= n, slices non-nil.
 func (fp *FastPlan[T]) Forward(dst, src []T) {
 	fp.forwardFunc(dst, src, fp.codeletTwiddleForward, fp.scratch)
+	scaleComplexInPlace(dst[:fp.n], fp.forwardScale)
 }
 
 // Inverse performs the inverse FFT without validation.
 // Caller guarantees: len(dst) >= n, len(src) >= n, slices non-nil.
 func (fp *FastPlan[T]) Inverse(dst, src []T) {
 	fp.inverseFunc(dst, src, fp.codeletTwiddleInverse, fp.scratch)
+	scaleComplexInPlace(dst[:fp.n], fp.inverseScale)
 }
 
 // InPlace performs the forward FFT in-place without validation.
 // Caller guarantees: len(data) >= n, slice non-nil.
 func (fp *FastPlan[T]) InPlace(data []T) {
 	fp.forwardFunc(data, data, fp.codeletTwiddleForward, fp.scratch)
+	scaleComplexInPlace(data[:fp.n], fp.forwardScale)
 }
 
 // InverseInPlace performs the inverse FFT in-place without validation.
 // Caller guarantees: len(data) >= n, slice non-nil.
 func (fp *FastPlan[T]) InverseInPlace(data []T) {
 	fp.inverseFunc(data, data, fp.codeletTwiddleInverse, fp.scratch)
+	scaleComplexInPlace(data[:fp.n], fp.inverseScale)
 }
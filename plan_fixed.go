@@ -0,0 +1,103 @@
+package algofft
+
+import (
+	"github.com/cwbudde/algo-fft/internal/fft"
+	m "github.com/cwbudde/algo-fft/internal/math"
+)
+
+// PlanFixed is a pre-computed fixed-point FFT plan for power-of-two lengths,
+// for integer signal chains that must match fixed-point hardware bit for bit.
+//
+// Samples are Q15 (int16) or Q31 (int32) in split-complex layout, as for
+// ForwardSplit. The transform is a radix-2 DIT over bit-reversed input with
+// quantized twiddles and rounded int64 products. Every stage uses block
+// floating point: when the block's largest magnitude leaves too little
+// headroom, the whole block is shifted right by one or two bits before the
+// stage, so the transform never overflows. Forward and Inverse return the
+// total applied exponent; the floating-point result is dst * 2^exponent.
+//
+// Results depend only on the input, never on the CPU, so they are
+// reproducible across platforms. A PlanFixed holds no mutable state and is
+// safe for concurrent use.
+type PlanFixed[T Fixed] struct {
+	n          int
+	twRe, twIm []T
+	bitrev     []int
+}
+
+// NewPlanFixed creates a fixed-point FFT plan for length n, which must be a
+// power of two.
+//
+// Returns ErrInvalidLength if n is not a power of two.
+func NewPlanFixed[T Fixed](n int) (*PlanFixed[T], error) {
+	if n < 1 || !m.IsPowerOf2(n) {
+		return nil, ErrInvalidLength
+	}
+
+	twRe, twIm := fft.ComputeFixedTwiddles[T](n)
+
+	return &PlanFixed[T]{
+		n:      n,
+		twRe:   twRe,
+		twIm:   twIm,
+		bitrev: m.ComputeBitReversalIndices(n),
+	}, nil
+}
+
+// NewPlanQ15 creates a Q15 (int16) fixed-point FFT plan for length n.
+func NewPlanQ15(n int) (*PlanFixed[int16], error) {
+	return NewPlanFixed[int16](n)
+}
+
+// NewPlanQ31 creates a Q31 (int32) fixed-point FFT plan for length n.
+func NewPlanQ31(n int) (*PlanFixed[int32], error) {
+	return NewPlanFixed[int32](n)
+}
+
+// Len returns the FFT length.
+func (p *PlanFixed[T]) Len() int {
+	return p.n
+}
+
+// Forward computes the unnormalized forward FFT of split-complex fixed-point
+// data and returns the block exponent: DFT(srcRe + i*srcIm) ≈
+// (dstRe + i*dstIm) * 2^exponent.
+//
+// All four slices must have length p.Len(). dst may alias src for in-place
+// operation.
+//
+// Returns ErrNilSlice if any slice is nil.
+// Returns ErrLengthMismatch if any slice length differs from Len().
+func (p *PlanFixed[T]) Forward(dstRe, dstIm, srcRe, srcIm []T) (int, error) {
+	err := p.validate(dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return 0, err
+	}
+
+	return fft.ForwardFixed(dstRe, dstIm, srcRe, srcIm, p.twRe, p.twIm, p.bitrev), nil
+}
+
+// Inverse computes the inverse FFT of split-complex fixed-point data and
+// returns the block exponent, including the 1/N factor: IDFT(srcRe + i*srcIm)
+// ≈ (dstRe + i*dstIm) * 2^exponent. The exponent is usually negative.
+// See Forward for the slice requirements.
+func (p *PlanFixed[T]) Inverse(dstRe, dstIm, srcRe, srcIm []T) (int, error) {
+	err := p.validate(dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		return 0, err
+	}
+
+	return fft.InverseFixed(dstRe, dstIm, srcRe, srcIm, p.twRe, p.twIm, p.bitrev), nil
+}
+
+func (p *PlanFixed[T]) validate(dstRe, dstIm, srcRe, srcIm []T) error {
+	if dstRe == nil || dstIm == nil || srcRe == nil || srcIm == nil {
+		return ErrNilSlice
+	}
+
+	if len(dstRe) != p.n || len(dstIm) != p.n || len(srcRe) != p.n || len(srcIm) != p.n {
+		return ErrLengthMismatch
+	}
+
+	return nil
+}
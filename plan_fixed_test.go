@@ -0,0 +1,142 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"slices"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+func TestPlanFixed_Q15MatchesFloat(t *testing.T) {
+	t.Parallel()
+
+	const n = 256
+
+	plan, err := NewPlanQ15(n)
+	if err != nil {
+		t.Fatalf("NewPlanQ15 failed: %v", err)
+	}
+
+	if plan.Len() != n {
+		t.Errorf("Len() = %d, want %d", plan.Len(), n)
+	}
+
+	srcRe := make([]int16, n)
+	srcIm := make([]int16, n)
+	src := make([]complex128, n)
+
+	for i, v := range randomComplex128Slice(n, 19) {
+		srcRe[i] = int16(real(v) * 20000)
+		srcIm[i] = int16(imag(v) * 20000)
+		src[i] = complex(float64(srcRe[i]), float64(srcIm[i]))
+	}
+
+	dstRe := make([]int16, n)
+	dstIm := make([]int16, n)
+
+	exponent, err := plan.Forward(dstRe, dstIm, srcRe, srcIm)
+	if err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if exponent <= 0 {
+		t.Errorf("exponent = %d, want block scaling for %d-point random input", exponent, n)
+	}
+
+	want := reference.NaiveDFT128(src)
+
+	var maxErr, maxRef float64
+
+	for k := range want {
+		got := complex(math.Ldexp(float64(dstRe[k]), exponent), math.Ldexp(float64(dstIm[k]), exponent))
+		maxErr = max(maxErr, cmplx.Abs(got-want[k]))
+		maxRef = max(maxRef, cmplx.Abs(want[k]))
+	}
+
+	if maxErr > 5e-3*maxRef {
+		t.Errorf("forward error %g relative to %g", maxErr, maxRef)
+	}
+
+	// Bit-exact: the same input gives the same mantissas and exponent,
+	// in place as well as out of place.
+	again, err := plan.Forward(srcRe, srcIm, srcRe, srcIm)
+	if err != nil {
+		t.Fatalf("in-place Forward failed: %v", err)
+	}
+
+	if again != exponent || !slices.Equal(srcRe, dstRe) || !slices.Equal(srcIm, dstIm) {
+		t.Error("in-place Forward differs from out-of-place Forward")
+	}
+}
+
+func TestPlanFixed_Q31RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const n = 1024
+
+	plan, err := NewPlanQ31(n)
+	if err != nil {
+		t.Fatalf("NewPlanQ31 failed: %v", err)
+	}
+
+	re := make([]int32, n)
+	im := make([]int32, n)
+	original := make([]int32, n)
+
+	for i, v := range randomComplex128Slice(n, 31) {
+		re[i] = int32(real(v) * 1e9)
+		im[i] = int32(imag(v) * 1e9)
+	}
+
+	copy(original, re)
+
+	forward, err := plan.Forward(re, im, re, im)
+	if err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	inverse, err := plan.Inverse(re, im, re, im)
+	if err != nil {
+		t.Fatalf("Inverse failed: %v", err)
+	}
+
+	if inverse >= 0 {
+		t.Errorf("inverse exponent = %d, want the 1/N factor included", inverse)
+	}
+
+	for i, v := range re {
+		got := math.Ldexp(float64(v), forward+inverse)
+		if math.Abs(got-float64(original[i])) > 1e-6*1e9 {
+			t.Fatalf("round trip[%d] = %v, want %d", i, got, original[i])
+		}
+	}
+}
+
+func TestPlanFixed_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, -4, 12, 100} {
+		if _, err := NewPlanQ15(n); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("NewPlanQ15(%d) error = %v, want ErrInvalidLength", n, err)
+		}
+	}
+
+	plan, err := NewPlanFixed[int16](16)
+	if err != nil {
+		t.Fatalf("NewPlanFixed failed: %v", err)
+	}
+
+	buf := make([]int16, 16)
+	short := make([]int16, 8)
+
+	if _, err := plan.Forward(buf, nil, buf, buf); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("Forward(nil) error = %v, want ErrNilSlice", err)
+	}
+
+	if _, err := plan.Inverse(buf, buf, short, buf); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Inverse(short) error = %v, want ErrLengthMismatch", err)
+	}
+}
@@ -0,0 +1,353 @@
+package algofft
+
+import (
+	"math/bits"
+	"sync"
+	"time"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+	m "github.com/cwbudde/algo-fft/internal/math"
+)
+
+// Large power-of-two transforms can run a parallel four-step algorithm when
+// PlanOptions.Workers > 1. For n = n1*n2, with input index j = j1 + n1*j2 and
+// output index k = k2 + n2*k1:
+//
+//	X[k] = Σ_j1 W_n1^(j1*k1) · W_n^(j1*k2) · Σ_j2 x[j] · W_n2^(j2*k2)
+//
+// The input is transposed into n1 rows of length n2, each row is transformed
+// and multiplied by W_n^(j1*k2), the matrix is transposed into n2 rows of
+// length n1, each row is transformed again, and a final transpose restores
+// natural order. Every pass is split across the plan's workers; the row FFTs
+// use ordinary serial sub-plans, which draw their own pooled scratch.
+
+// fourStepMinSize is the smallest size for which the planner picks the
+// parallel four-step path on its own. It matches the six-step threshold.
+const fourStepMinSize = 1 << 18
+
+// fourStepTile is the block edge used by the cache-blocked transposes.
+const fourStepTile = 32
+
+type fourStep[T Complex] struct {
+	n, n1, n2 int
+
+	plan1 *Plan[T] // length n1, second pass
+	plan2 *Plan[T] // length n2, first pass
+
+	// twiddle holds W_n^k for k < n (shared with the owning plan).
+	twiddle []T
+	workers int
+}
+
+// newFourStep returns the parallel four-step executor for p, or nil if the
+// plan does not qualify: it needs several workers, a power-of-two size and
+// either an explicit six/eight-step strategy or n >= fourStepMinSize.
+func newFourStep[T Complex](p *Plan[T], features cpu.Features, opts PlanOptions) *fourStep[T] {
+	if opts.Workers <= 1 || p.n < 16 || !m.IsPowerOf2(p.n) || len(p.twiddle) < p.n {
+		return nil
+	}
+
+	switch opts.Strategy {
+	case KernelSixStep, KernelEightStep:
+	case KernelAuto:
+		if p.n < fourStepMinSize {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	n1 := 1 << (bits.TrailingZeros(uint(p.n)) / 2)
+	n2 := p.n / n1
+
+	childOpts := PlanOptions{Planner: opts.Planner, Wisdom: opts.Wisdom, Verify: opts.Verify}
+
+	plan1, err := newPlanWithFeatures[T](n1, features, childOpts)
+	if err != nil {
+		return nil
+	}
+
+	plan2 := plan1
+	if n2 != n1 {
+		plan2, err = newPlanWithFeatures[T](n2, features, childOpts)
+		if err != nil {
+			return nil
+		}
+	}
+
+	return &fourStep[T]{
+		n:       p.n,
+		n1:      n1,
+		n2:      n2,
+		plan1:   plan1,
+		plan2:   plan2,
+		twiddle: p.twiddle,
+		workers: opts.Workers,
+	}
+}
+
+// transform runs the four-step FFT from src into dst using work (len >= n)
+// as the intermediate buffer. The inverse includes the 1/n scaling of the
+// sub-plans, matching the built-in scaling of the serial kernels; scale is
+// multiplied in with the twist factors of the first row pass.
+func (f *fourStep[T]) transform(dst, src, work []T, inverse bool, scale float64) error {
+	work = work[:f.n]
+
+	// bufA receives the first transpose; when dst aliases src it must not be
+	// dst, and the result is copied back at the end.
+	bufA, bufB := dst, work
+	if sameSliceStrided(dst, src) {
+		bufA, bufB = work, dst
+	}
+
+	task := getFourStepTask[T]()
+	defer putFourStepTask(task)
+
+	task.f = f
+	task.inverse = inverse
+	task.scale = scale
+
+	task.transpose(src, bufA, f.n2, f.n1)
+	task.rows(bufA, f.plan2, f.n1, true)
+	task.transpose(bufA, bufB, f.n1, f.n2)
+	task.rows(bufB, f.plan1, f.n2, false)
+	task.transpose(bufB, bufA, f.n2, f.n1)
+
+	if !sameSliceStrided(bufA, dst) {
+		task.copy(dst, bufA)
+	}
+
+	return task.err
+}
+
+type fourStepPhase uint8
+
+const (
+	fourStepTranspose fourStepPhase = iota
+	fourStepRows
+	fourStepCopy
+)
+
+// fourStepTask carries the state of one four-step pass to the workers.
+type fourStepTask[T Complex] struct {
+	f       *fourStep[T]
+	inverse bool
+	scale   float64
+
+	phase      fourStepPhase
+	dst, src   []T
+	rowCount   int
+	rowLen     int
+	plan       *Plan[T]
+	applyTwist bool
+
+	mu  sync.Mutex
+	err error
+}
+
+//nolint:gochecknoglobals
+var (
+	fourStepTasks64  = sync.Pool{New: func() any { return new(fourStepTask[complex64]) }}
+	fourStepTasks128 = sync.Pool{New: func() any { return new(fourStepTask[complex128]) }}
+)
+
+func getFourStepTask[T Complex]() *fourStepTask[T] {
+	var zero T
+	switch any(zero).(type) {
+	case complex64:
+		task, _ := any(fourStepTasks64.Get()).(*fourStepTask[T])
+		return task
+	default:
+		task, _ := any(fourStepTasks128.Get()).(*fourStepTask[T])
+		return task
+	}
+}
+
+func putFourStepTask[T Complex](task *fourStepTask[T]) {
+	task.f = nil
+	task.dst, task.src = nil, nil
+	task.plan = nil
+	task.err = nil
+
+	var zero T
+	switch any(zero).(type) {
+	case complex64:
+		fourStepTasks64.Put(task)
+	default:
+		fourStepTasks128.Put(task)
+	}
+}
+
+// transpose writes the rows×cols matrix src into dst as cols×rows.
+func (t *fourStepTask[T]) transpose(src, dst []T, rows, cols int) {
+	t.phase = fourStepTranspose
+	t.src, t.dst = src, dst
+	t.rowCount, t.rowLen = rows, cols
+	t.run(rows)
+}
+
+// rows transforms count contiguous rows of data in place with plan.
+func (t *fourStepTask[T]) rows(data []T, plan *Plan[T], count int, applyTwist bool) {
+	t.phase = fourStepRows
+	t.src, t.dst = data, data
+	t.rowCount, t.rowLen = count, plan.n
+	t.plan = plan
+	t.applyTwist = applyTwist
+	t.run(count)
+}
+
+func (t *fourStepTask[T]) copy(dst, src []T) {
+	t.phase = fourStepCopy
+	t.src, t.dst = src, dst
+	t.rowCount, t.rowLen = len(src), 1
+	t.run(len(src))
+}
+
+func (t *fourStepTask[T]) run(items int) {
+	chunks := min(t.f.workers, items)
+	runParallel(chunks, chunks, t)
+}
+
+func (t *fourStepTask[T]) runChunk(c int) {
+	chunks := min(t.f.workers, t.rowCount)
+	lo, hi := chunkRange(t.rowCount, chunks, c)
+
+	switch t.phase {
+	case fourStepTranspose:
+		transposeRows(t.dst, t.src, t.rowCount, t.rowLen, lo, hi)
+	case fourStepRows:
+		t.transformRows(lo, hi)
+	case fourStepCopy:
+		copy(t.dst[lo:hi], t.src[lo:hi])
+	}
+}
+
+func (t *fourStepTask[T]) transformRows(lo, hi int) {
+	n := t.f.n
+	rowLen := t.rowLen
+
+	for r := lo; r < hi; r++ {
+		row := t.dst[r*rowLen : (r+1)*rowLen]
+
+		var err error
+		if t.inverse {
+			err = t.plan.Inverse(row, row)
+		} else {
+			err = t.plan.Forward(row, row)
+		}
+
+		if err != nil {
+			t.mu.Lock()
+			if t.err == nil {
+				t.err = err
+			}
+			t.mu.Unlock()
+
+			return
+		}
+
+		if !t.applyTwist {
+			continue
+		}
+
+		if t.scale != 1 {
+			t.twistScaled(row, r)
+			continue
+		}
+
+		if r == 0 {
+			continue
+		}
+
+		// Multiply element k2 of row j1 by W_n^(j1*k2); n is a power of two.
+		for k, idx := 1, r; k < rowLen; k, idx = k+1, idx+r {
+			w := t.f.twiddle[idx&(n-1)]
+			if t.inverse {
+				w = m.ConjugateOf(w)
+			}
+
+			row[k] *= w
+		}
+	}
+}
+
+// twistScaled applies the twist factors of row j1 together with the
+// normalization, so every element picks up the scale in this one pass.
+func (t *fourStepTask[T]) twistScaled(row []T, j1 int) {
+	n := t.f.n
+	scale := complexScale[T](t.scale)
+
+	for k, v := range row {
+		w := t.f.twiddle[(j1*k)&(n-1)]
+		if t.inverse {
+			w = m.ConjugateOf(w)
+		}
+
+		row[k] = v * (w * scale)
+	}
+}
+
+// transposeRows transposes source rows [lo, hi) of the rows×cols matrix src
+// into dst, in cache-sized tiles.
+func transposeRows[T any](dst, src []T, rows, cols, lo, hi int) {
+	for r0 := lo; r0 < hi; r0 += fourStepTile {
+		r1 := min(r0+fourStepTile, hi)
+
+		for c0 := 0; c0 < cols; c0 += fourStepTile {
+			c1 := min(c0+fourStepTile, cols)
+
+			for r := r0; r < r1; r++ {
+				for c := c0; c < c1; c++ {
+					dst[c*rows+r] = src[r*cols+c]
+				}
+			}
+		}
+	}
+}
+
+// fourStepFaster times the serial kernel against the parallel four-step path
+// and reports whether the latter wins. Used by PlannerMeasure and above.
+func (p *Plan[T]) fourStepFaster() bool {
+	const iters = 3
+
+	src := make([]T, p.n)
+	dst := make([]T, p.n)
+
+	for i := range src {
+		src[i] = m.ComplexFromFloat64[T](float64(i%16)/16, float64((i+1)%16)/16)
+	}
+
+	scratch, _, _, set := p.getScratch()
+	if set != nil {
+		defer p.scratchPool.Put(set)
+	}
+
+	serial := timeRuns(iters, func() error { return p.forwardDispatch(dst, src, scratch, 1) })
+	parallel := timeRuns(iters, func() error { return p.fourStep.transform(dst, src, scratch, false, 1) })
+
+	return parallel < serial
+}
+
+// timeRuns returns the best of iters timed calls after one warmup call, or
+// the maximum duration if run fails.
+func timeRuns(iters int, run func() error) time.Duration {
+	const failed = time.Duration(1<<63 - 1)
+
+	if run() != nil {
+		return failed
+	}
+
+	best := failed
+
+	for range iters {
+		start := time.Now()
+
+		if run() != nil {
+			return failed
+		}
+
+		best = min(best, time.Since(start))
+	}
+
+	return best
+}
@@ -0,0 +1,121 @@
+package algofft
+
+import (
+	"math/cmplx"
+	"testing"
+)
+
+func TestPlanFourStep_MatchesSerial(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		n    int
+		opts PlanOptions
+	}{
+		{"forced-square", 1024, PlanOptions{Strategy: KernelSixStep, Workers: 4}},
+		{"forced-nonsquare", 2048, PlanOptions{Strategy: KernelEightStep, Workers: 3}},
+		{"auto-threshold", fourStepMinSize, PlanOptions{Workers: 4}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			parallel, err := NewPlanWithOptions[complex128](tc.n, tc.opts)
+			if err != nil {
+				t.Fatalf("NewPlanWithOptions failed: %v", err)
+			}
+
+			if !parallel.Meta().FourStep {
+				t.Fatal("Meta().FourStep = false, want true")
+			}
+
+			serial, err := NewPlan64(tc.n)
+			if err != nil {
+				t.Fatalf("NewPlan64 failed: %v", err)
+			}
+
+			src := randomComplex128Slice(tc.n, uint64(tc.n))
+			want := make([]complex128, tc.n)
+			got := make([]complex128, tc.n)
+
+			_ = serial.Forward(want, src)
+
+			if err := parallel.Forward(got, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			assertScaledComplex128(t, got, want, 1, 1e-9, "forward")
+
+			// In place through a clone, which uses fixed scratch.
+			clone := parallel.Clone()
+			copy(got, src)
+
+			if err := clone.InPlace(got); err != nil {
+				t.Fatalf("InPlace failed: %v", err)
+			}
+
+			assertScaledComplex128(t, got, want, 1, 1e-9, "in-place forward")
+
+			if err := parallel.Inverse(got, got); err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			assertScaledComplex128(t, got, src, 1, 1e-9, "round trip")
+		})
+	}
+}
+
+func TestPlanFourStep_Selection(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		n    int
+		opts PlanOptions
+	}{
+		{"serial", fourStepMinSize, PlanOptions{}},
+		{"below-threshold", 4096, PlanOptions{Workers: 4}},
+		{"non-power-of-two", 3 * 1024, PlanOptions{Strategy: KernelSixStep, Workers: 4}},
+		{"other-strategy", 1024, PlanOptions{Strategy: KernelStockham, Workers: 4}},
+	}
+
+	for _, tc := range cases {
+		plan, err := NewPlanWithOptions[complex64](tc.n, tc.opts)
+		if err != nil {
+			t.Fatalf("%s: NewPlanWithOptions failed: %v", tc.name, err)
+		}
+
+		if plan.Meta().FourStep {
+			t.Errorf("%s: Meta().FourStep = true, want false", tc.name)
+		}
+	}
+}
+
+func TestPlanFourStep_Measure(t *testing.T) {
+	t.Parallel()
+
+	const n = fourStepMinSize
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Planner: PlannerMeasure, Workers: 2})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	// Either path may win; the result must be correct regardless.
+	src := make([]complex64, n)
+	src[1] = 1
+
+	dst := make([]complex64, n)
+	if err := plan.Forward(dst, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	for _, k := range []int{0, 1, n / 3, n - 1} {
+		want := cmplx.Rect(1, -2*3.141592653589793*float64(k)/n)
+		if cmplx.Abs(complex128(dst[k])-want) > 1e-4 {
+			t.Fatalf("dst[%d] = %v, want %v (FourStep=%v)", k, dst[k], want, plan.Meta().FourStep)
+		}
+	}
+}
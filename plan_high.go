@@ -0,0 +1,576 @@
+package algofft
+
+import (
+	"math"
+	"math/bits"
+	"sync"
+	"unsafe"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+)
+
+// AccuracyMode selects the internal precision of complex64 plans.
+type AccuracyMode uint8
+
+const (
+	// AccuracyDefault computes in the plan's own precision.
+	AccuracyDefault AccuracyMode = iota
+
+	// AccuracyHigh keeps complex64 data in memory but computes butterflies
+	// and twiddles in float64. Sizes up to 4096 run as one float64 block
+	// that stays in cache. Larger sizes N = R·C run a four-step transform:
+	// R-point float64 column transforms and float64 twiddles into a
+	// complex64 intermediate, then C-point float64 row transforms. Only one
+	// column or row is widened at a time, so the passes over memory move
+	// complex64 data, and each value is rounded to complex64 twice instead
+	// of once per butterfly stage. Plans of complex128 ignore it.
+	AccuracyHigh
+)
+
+// highBlockMax is the largest size a high-accuracy transform runs as a
+// single float64 block (64 KiB of complex128 values). Larger sizes split into
+// columns and rows, except primes, which have no factors to split into.
+const highBlockMax = 4096
+
+// highAccuracy computes a complex64 transform of size n = rows·cols in
+// float64. rows == 1 runs it as a single block.
+type highAccuracy struct {
+	n, rows, cols int
+
+	// colPlan transforms the columns (size rows, or n for a single block),
+	// rowPlan the rows (size cols). Both are NormBackward float64 plans, so
+	// their inverses together supply the 1/n.
+	colPlan *Plan[complex128]
+	rowPlan *Plan[complex128]
+
+	// roots holds the twiddles W_n^m between the column and row passes.
+	roots unitRoots
+}
+
+// newHighAccuracy factors n and builds the float64 child plans.
+func newHighAccuracy(n int, features cpu.Features, opts PlanOptions) (*highAccuracy, error) {
+	childOpts := opts
+	childOpts.Batch = 0
+	childOpts.Stride = 0
+	childOpts.InPlace = false
+	childOpts.Normalization = NormBackward
+	childOpts.Accuracy = AccuracyDefault
+
+	h := &highAccuracy{n: n, rows: 1, cols: n}
+
+	if n > highBlockMax {
+		// The largest factor up to sqrt(n) keeps both blocks short.
+		for r := int(math.Sqrt(float64(n))); r > 1; r-- {
+			if n%r == 0 {
+				h.rows, h.cols = r, n/r
+
+				break
+			}
+		}
+	}
+
+	if h.rows == 1 {
+		plan, err := newPlanWithFeatures[complex128](n, features, childOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		h.colPlan, h.rowPlan = plan, plan
+
+		return h, nil
+	}
+
+	var err error
+
+	h.colPlan, err = newPlanWithFeatures[complex128](h.rows, features, childOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	h.rowPlan = h.colPlan
+	if h.cols != h.rows {
+		h.rowPlan, err = newPlanWithFeatures[complex128](h.cols, features, childOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	h.roots = newUnitRoots(n)
+
+	return h, nil
+}
+
+// scratchLen is the number of complex64 elements a transform needs: the
+// widened block, or the intermediate followed by a column and two rows.
+func (h *highAccuracy) scratchLen() int {
+	if h.rows == 1 {
+		return 2 * h.n
+	}
+
+	return h.n + 2*max(h.rows, 2*h.cols)
+}
+
+// workspaceSize is the number of complex128 elements the child plans need.
+func (h *highAccuracy) workspaceSize() int {
+	return max(h.colPlan.WorkspaceSize(), h.rowPlan.WorkspaceSize())
+}
+
+// algorithm names the kernel of a single block, or the four-step split.
+func (h *highAccuracy) algorithm() string {
+	if h.rows == 1 {
+		return h.colPlan.algorithm
+	}
+
+	return "fourstep_float64"
+}
+
+// strategy reports the strategy of a single block, or KernelSixStep.
+func (h *highAccuracy) strategy() KernelStrategy {
+	if h.rows == 1 {
+		return h.colPlan.kernelStrategy
+	}
+
+	return KernelSixStep
+}
+
+// highSource gathers the input of a high-accuracy transform: block[j] is
+// input element start+j*stride, widened to float64.
+type highSource interface {
+	gather(block []complex128, start, stride int)
+}
+
+// highSink receives the output of a high-accuracy transform one row at a
+// time: row[k2] is output bin k1+rows*k2. mirror is the row holding the bins
+// n-k (see mirrorIndex), which the real-input recombination pairs them with.
+type highSink interface {
+	store(k1, rows int, row, mirror []complex128)
+}
+
+// mirrorIndex returns the position in the mirror row of bin n-k, where k is
+// element k2 of row k1 and cols is the row length.
+func mirrorIndex(k1, k2, cols int) int {
+	if k1 == 0 {
+		return (cols - k2) % cols
+	}
+
+	return cols - 1 - k2
+}
+
+// highRun runs a high-accuracy transform from src to dst, multiplied by
+// scale on top of the NormBackward convention. The four-step twiddles carry
+// the scale; a single block takes one multiply pass in cache. scratch holds
+// scratchLen complex64 elements, work is the child plans' complex128
+// workspace or nil to use their pooled scratch. All of src is read before
+// dst is written, so they may alias.
+func highRun[T Complex, S highSource, D highSink](h *highAccuracy, dst D, src S, scratch, work []T, inverse bool, scale float64) error {
+	childWork := complex128View(work)
+
+	if h.rows == 1 {
+		block := complex128View(scratch)[:h.n]
+		src.gather(block, 0, 1)
+
+		err := h.colPlan.transformWith(block, block, childWork, inverse)
+		if err != nil {
+			return err
+		}
+
+		if scale != 1 {
+			for i := range block {
+				block[i] *= complex(scale, 0)
+			}
+		}
+
+		dst.store(0, 1, block, block)
+
+		return nil
+	}
+
+	rows, cols := h.rows, h.cols
+	mid := scratch[:h.n]
+	blocks := complex128View(scratch[h.n:])
+	column := blocks[:rows]
+
+	for j2 := range cols {
+		src.gather(column, j2, cols)
+
+		err := h.colPlan.transformWith(column, column, childWork, inverse)
+		if err != nil {
+			return err
+		}
+
+		// mid[k1][j2] = column[k1] * W_n^(j2*k1), conjugated for the inverse.
+		m := 0
+		for k1, v := range column {
+			w := h.roots.at(m)
+			if inverse {
+				w = complex(real(w), -imag(w))
+			}
+
+			mid[k1*cols+j2] = T(v * w * complex(scale, 0))
+
+			m += j2
+			if m >= h.n {
+				m -= h.n
+			}
+		}
+	}
+
+	row, mirror := blocks[:cols], blocks[cols:2*cols]
+
+	for k1 := 0; k1 <= rows/2; k1++ {
+		err := highRow(h, row, mid[k1*cols:(k1+1)*cols], childWork, inverse)
+		if err != nil {
+			return err
+		}
+
+		k1b := (rows - k1) % rows
+		if k1b == k1 {
+			dst.store(k1, rows, row, row)
+
+			continue
+		}
+
+		err = highRow(h, mirror, mid[k1b*cols:(k1b+1)*cols], childWork, inverse)
+		if err != nil {
+			return err
+		}
+
+		dst.store(k1, rows, row, mirror)
+		dst.store(k1b, rows, mirror, row)
+	}
+
+	return nil
+}
+
+// highRow widens one row of the intermediate into block and transforms it.
+func highRow[T Complex](h *highAccuracy, block []complex128, src []T, work []complex128, inverse bool) error {
+	for i, v := range src {
+		block[i] = complex128(v)
+	}
+
+	return h.rowPlan.transformWith(block, block, work, inverse)
+}
+
+// unitRoots computes W_n^m = exp(-2πim/n) in float64 as the product of two
+// short tables, coarse[m>>shift] * fine[m&(len(fine)-1)], about 2·sqrt(n)
+// values in all.
+type unitRoots struct {
+	coarse, fine []complex128
+	shift        uint
+}
+
+func newUnitRoots(n int) unitRoots {
+	shift := uint(bits.Len(uint(n))+1) / 2
+
+	root := func(m int) complex128 {
+		sin, cos := math.Sincos(-2 * math.Pi * float64(m) / float64(n))
+		return complex(cos, sin)
+	}
+
+	r := unitRoots{
+		coarse: make([]complex128, n>>shift+1),
+		fine:   make([]complex128, 1<<shift),
+		shift:  shift,
+	}
+
+	for i := range r.coarse {
+		r.coarse[i] = root(i << shift)
+	}
+
+	for i := range r.fine {
+		r.fine[i] = root(i)
+	}
+
+	return r
+}
+
+// at returns W_n^m for 0 <= m <= n.
+func (r *unitRoots) at(m int) complex128 {
+	return r.coarse[m>>r.shift] * r.fine[m&(len(r.fine)-1)]
+}
+
+// complex128View reinterprets a complex64 buffer as half as many complex128
+// values. Only high-accuracy plans, which are complex64, call it.
+func complex128View[T Complex](buf []T) []complex128 {
+	if len(buf) < 2 {
+		return nil
+	}
+
+	return unsafe.Slice((*complex128)(unsafe.Pointer(unsafe.SliceData(buf))), len(buf)/2)
+}
+
+// newHighAccuracyPlan builds a complex64 plan that computes in float64.
+func newHighAccuracyPlan[T Complex](n int, features cpu.Features, opts PlanOptions) (*Plan[T], error) {
+	high, err := newHighAccuracy(n, features, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	scratchSize := high.scratchLen()
+
+	var scratchPool *sync.Pool
+	if opts.Workspace != WorkspaceExternal {
+		scratchPool = &sync.Pool{
+			New: func() any {
+				return allocateScratchSet[T](n, KernelAuto, 0, 0, nil, scratchSize)
+			},
+		}
+	}
+
+	p := &Plan[T]{
+		n:              n,
+		scratchLen:     scratchSize,
+		algorithm:      high.algorithm(),
+		kernelStrategy: KernelAuto,
+		high:           high,
+		meta: PlanMeta{
+			Planner:       opts.Planner,
+			Strategy:      high.strategy(),
+			Batch:         opts.Batch,
+			Stride:        opts.Stride,
+			InPlace:       opts.InPlace,
+			Normalization: opts.Normalization,
+			Workers:       opts.Workers,
+			Workspace:     opts.Workspace,
+			Accuracy:      AccuracyHigh,
+		},
+		scratchPool: scratchPool,
+	}
+
+	p.forwardScale, p.inverseScale = normalizationScales(opts.Normalization, n)
+
+	return p, nil
+}
+
+// highTransform runs a high-accuracy plan's transform, including
+// normalization. scratch needs scratchLen elements; work is the child plans'
+// workspace, or nil to use their pooled scratch. dst and src may alias.
+func (p *Plan[T]) highTransform(dst, src, scratch, work []T, inverse bool) error {
+	scale := p.forwardScale
+	if inverse {
+		scale = p.inverseScale
+	}
+
+	return highRun(p.high, highComplex[T](dst), highComplex[T](src), scratch, work, inverse, scale)
+}
+
+// highComplex is interleaved complex storage.
+type highComplex[T Complex] []T
+
+func (x highComplex[T]) gather(block []complex128, start, stride int) {
+	for j := range block {
+		block[j] = complex128(x[start+j*stride])
+	}
+}
+
+func (x highComplex[T]) store(k1, rows int, row, _ []complex128) {
+	for k2, v := range row {
+		x[k1+rows*k2] = T(v)
+	}
+}
+
+// highSplit is split-complex storage.
+type highSplit[F Float] struct {
+	re, im []F
+}
+
+func (x highSplit[F]) gather(block []complex128, start, stride int) {
+	for j := range block {
+		i := start + j*stride
+		block[j] = complex(float64(x.re[i]), float64(x.im[i]))
+	}
+}
+
+func (x highSplit[F]) store(k1, rows int, row, _ []complex128) {
+	for k2, v := range row {
+		x.re[k1+rows*k2], x.im[k1+rows*k2] = F(real(v)), F(imag(v))
+	}
+}
+
+// newHighAccuracyRealPlan builds a float32 real plan that computes in
+// float64: the half-size complex transform runs as a high-accuracy transform
+// of the packed samples, and its last pass recombines the spectrum in
+// float64 before rounding. Odd sizes transform the full promoted signal.
+func newHighAccuracyRealPlan[F Float, C Complex](n int, features cpu.Features, opts PlanOptions) (*PlanRealT[F, C], error) {
+	size := n
+	if n%2 == 0 {
+		size = n / 2
+	}
+
+	high, err := newHighAccuracy(size, features, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardScale, inverseScale := normalizationScales(opts.Normalization, n)
+
+	p := &PlanRealT[F, C]{
+		n:            n,
+		half:         n / 2,
+		high:         high,
+		options:      opts,
+		forwardScale: forwardScale,
+		inverseScale: inverseScale,
+	}
+
+	if n%2 == 0 {
+		p.highRoots = newUnitRoots(n)
+	}
+
+	p.buf = newRealBuffer[C](p.bufLen(), opts)
+
+	return p, nil
+}
+
+// highForward runs a high-accuracy real forward transform through the
+// scratch buffer buf, multiplying the spectrum by scale.
+func (p *PlanRealT[F, C]) highForward(dst []C, src []F, buf, work []C, scale float64) error {
+	if p.n%2 != 0 {
+		return highRun(p.high, highHalfSpectrum[C](dst), highRealSignal[F](src), buf, work, false, scale)
+	}
+
+	spectrum := highRecombine[C]{x: dst, roots: &p.highRoots, half: p.half}
+
+	return highRun(p.high, spectrum, highPacked[F](src), buf, work, false, scale)
+}
+
+// highInverse runs a high-accuracy real inverse transform through the
+// scratch buffer buf. DC and Nyquist are checked with the complex64
+// tolerance and read as exactly real.
+func (p *PlanRealT[F, C]) highInverse(dst []F, src []C, buf, work []C) error {
+	even := p.n%2 == 0
+	if math.Abs(imag(complex128(src[0]))) > 1e-4 || even && math.Abs(imag(complex128(src[p.half]))) > 1e-4 {
+		return ErrInvalidSpectrum
+	}
+
+	if !even {
+		return highRun(p.high, highRealSignal[F](dst), highHermitian[C](src), buf, work, true, p.inverseScale)
+	}
+
+	spectrum := highRepack[C]{x: src, roots: &p.highRoots, half: p.half}
+
+	return highRun(p.high, highPacked[F](dst), spectrum, buf, work, true, p.inverseScale)
+}
+
+// highRealSignal is the real signal of an odd-size real plan: promoted to
+// complex on input, its real part kept on output.
+type highRealSignal[F Float] []F
+
+func (x highRealSignal[F]) gather(block []complex128, start, stride int) {
+	for j := range block {
+		block[j] = complex(float64(x[start+j*stride]), 0)
+	}
+}
+
+func (x highRealSignal[F]) store(k1, rows int, row, _ []complex128) {
+	for k2, v := range row {
+		x[k1+rows*k2] = F(real(v))
+	}
+}
+
+// highHalfSpectrum keeps the first len(x) bins of an odd-size real plan's
+// full spectrum.
+type highHalfSpectrum[C Complex] []C
+
+func (x highHalfSpectrum[C]) store(k1, rows int, row, _ []complex128) {
+	for k2, v := range row {
+		if k := k1 + rows*k2; k < len(x) {
+			x[k] = C(v)
+		}
+	}
+}
+
+// highHermitian expands the half spectrum of an odd-size real plan into the
+// full spectrum, X[n-k] = conj(X[k]), with a real DC bin.
+type highHermitian[C Complex] []C
+
+func (x highHermitian[C]) gather(block []complex128, start, stride int) {
+	n := 2*len(x) - 1
+
+	for j := range block {
+		switch k := start + j*stride; {
+		case k == 0:
+			block[j] = complex(real(complex128(x[0])), 0)
+		case k < len(x):
+			block[j] = complex128(x[k])
+		default:
+			v := complex128(x[n-k])
+			block[j] = complex(real(v), -imag(v))
+		}
+	}
+}
+
+// highPacked is the real signal of an even-size real plan, read and written
+// as N/2 complex values z[m] = x[2m] + i*x[2m+1].
+type highPacked[F Float] []F
+
+func (x highPacked[F]) gather(block []complex128, start, stride int) {
+	for j := range block {
+		m := 2 * (start + j*stride)
+		block[j] = complex(float64(x[m]), float64(x[m+1]))
+	}
+}
+
+func (x highPacked[F]) store(k1, rows int, row, _ []complex128) {
+	for k2, v := range row {
+		m := 2 * (k1 + rows*k2)
+		x[m], x[m+1] = F(real(v)), F(imag(v))
+	}
+}
+
+// highWeight returns U[k] = 0.5 * (1 + i*W_N^k), the recombination weight of
+// the real forward transform.
+func highWeight(roots *unitRoots, k int) complex128 {
+	w := roots.at(k)
+	return complex(0.5*(1-imag(w)), 0.5*real(w))
+}
+
+// highRecombine extracts an even-size real plan's spectrum from the float64
+// transform Y of the packed signal: with A = Y[k] and B = conj(Y[N/2-k]),
+// X[k] = A - U[k] * (A - B), and X[N/2] = Re(Y[0]) - Im(Y[0]).
+type highRecombine[C Complex] struct {
+	x     []C
+	roots *unitRoots
+	half  int
+}
+
+func (s highRecombine[C]) store(k1, rows int, row, mirror []complex128) {
+	for k2, a := range row {
+		k := k1 + rows*k2
+		if k == 0 {
+			s.x[0] = C(complex(real(a)+imag(a), 0))
+			s.x[s.half] = C(complex(real(a)-imag(a), 0))
+
+			continue
+		}
+
+		b := mirror[mirrorIndex(k1, k2, len(row))]
+		b = complex(real(b), -imag(b))
+		s.x[k] = C(a - highWeight(s.roots, k)*(a-b))
+	}
+}
+
+// highRepack computes the packed spectrum Z of an even-size real plan's
+// inverse from its half spectrum, in float64: inverting the recombination,
+// Z[k] = ((1-U[k])*X[k] - U[k]*conj(X[N/2-k])) * conj(1-2U[k]), with DC and
+// Nyquist read as real.
+type highRepack[C Complex] struct {
+	x     []C
+	roots *unitRoots
+	half  int
+}
+
+func (s highRepack[C]) gather(block []complex128, start, stride int) {
+	for j := range block {
+		k := start + j*stride
+		xk, xm := complex128(s.x[k]), complex128(s.x[s.half-k])
+
+		if k == 0 {
+			xk, xm = complex(real(xk), 0), complex(real(xm), 0)
+		}
+
+		u := highWeight(s.roots, k)
+		det := 1 - 2*u
+		block[j] = ((1-u)*xk - u*complex(real(xm), -imag(xm))) * complex(real(det), -imag(det))
+	}
+}
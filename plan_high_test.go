@@ -0,0 +1,260 @@
+package algofft
+
+import (
+	"testing"
+)
+
+func TestAccuracyHigh_Plan(t *testing.T) {
+	t.Parallel()
+
+	// 4099 is a prime above the single-block limit, 8190 = 90·91 and
+	// 65536 = 256·256 run as columns and rows.
+	for _, n := range []int{1, 60, 1009, 4096, 4099, 8190, 65536} {
+		plain, err := NewPlanWithOptions[complex64](n, PlanOptions{})
+		if err != nil {
+			t.Fatalf("n=%d: NewPlanWithOptions failed: %v", n, err)
+		}
+
+		high, err := NewPlanWithOptions[complex64](n, PlanOptions{Accuracy: AccuracyHigh})
+		if err != nil {
+			t.Fatalf("n=%d: NewPlanWithOptions(AccuracyHigh) failed: %v", n, err)
+		}
+
+		meta := high.Meta()
+		if meta.Accuracy != AccuracyHigh || plain.Meta().Accuracy != AccuracyDefault {
+			t.Errorf("n=%d: Accuracy = %v/%v, want high/default", n, meta.Accuracy, plain.Meta().Accuracy)
+		}
+
+		single := high.high.rows == 1
+
+		wantAlgorithm, wantStrategy := "fourstep_float64", KernelSixStep
+		if single {
+			wantAlgorithm, wantStrategy = high.high.colPlan.Algorithm(), high.high.colPlan.KernelStrategy()
+		}
+
+		if high.Algorithm() != wantAlgorithm || high.KernelStrategy() != wantStrategy {
+			t.Errorf("n=%d: Algorithm = %q, KernelStrategy = %v, want %q, %v",
+				n, high.Algorithm(), high.KernelStrategy(), wantAlgorithm, wantStrategy)
+		}
+
+		report, err := high.Accuracy(2)
+		if err != nil {
+			t.Fatalf("n=%d: Accuracy failed: %v", n, err)
+		}
+
+		checkAccuracyHighReport(t, n, single, report, 1e-7)
+
+		if n >= 4096 {
+			baseline, err := plain.Accuracy(2)
+			if err != nil {
+				t.Fatalf("n=%d: Accuracy failed: %v", n, err)
+			}
+
+			if report.RMSRelError >= baseline.RMSRelError/2 {
+				t.Errorf("n=%d: high rms=%g, default rms=%g, want at least 2x better", n, report.RMSRelError, baseline.RMSRelError)
+			}
+		}
+	}
+}
+
+func TestAccuracyHigh_PlanOptions(t *testing.T) {
+	t.Parallel()
+
+	// 512 runs as one float64 block, 8190 = 90·91 as columns and rows.
+	for _, n := range []int{512, 8190} {
+		t.Run(itoa(n), func(t *testing.T) {
+			t.Parallel()
+			checkAccuracyHighPlanOptions(t, n)
+		})
+	}
+}
+
+func checkAccuracyHighPlanOptions(t *testing.T, n int) {
+	t.Helper()
+
+	// Two roundings to complex64 against a rounded reference: a few ULPs.
+	const tol = 3e-7
+
+	src := randomComplex128Slice(n, 18)
+	src64 := make([]complex64, n)
+
+	for i, v := range src {
+		src64[i] = complex64(v)
+	}
+
+	ref, err := NewPlanWithOptions[complex128](n, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	want := make([]complex128, n)
+	for i, v := range src64 {
+		want[i] = complex128(v)
+	}
+
+	if err := ref.InPlace(want); err != nil {
+		t.Fatalf("InPlace failed: %v", err)
+	}
+
+	want64 := make([]complex64, n)
+	for i, v := range want {
+		want64[i] = complex64(v)
+	}
+
+	// External workspace
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{
+		Accuracy:      AccuracyHigh,
+		Normalization: NormOrtho,
+		Workspace:     WorkspaceExternal,
+	})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	got := make([]complex64, n)
+	work := NewWorkspace[complex64](plan.WorkspaceSize())
+
+	if err := plan.ForwardWithWorkspace(got, src64, work); err != nil {
+		t.Fatalf("ForwardWithWorkspace failed: %v", err)
+	}
+
+	assertScaledComplex64(t, got, want64, 1, tol, "external workspace")
+
+	if err := plan.Forward(got, src64); err == nil {
+		t.Error("Forward without workspace succeeded on a WorkspaceExternal plan")
+	}
+
+	// Clone, in place
+	pooled, err := NewPlanWithOptions[complex64](n, PlanOptions{Accuracy: AccuracyHigh, Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	clone := pooled.Clone()
+	copy(got, src64)
+
+	if err := clone.InPlace(got); err != nil {
+		t.Fatalf("InPlace failed: %v", err)
+	}
+
+	assertScaledComplex64(t, got, want64, 1, tol, "clone in place")
+
+	if err := clone.Inverse(got, got); err != nil {
+		t.Fatalf("Inverse failed: %v", err)
+	}
+
+	assertScaledComplex64(t, got, src64, 1, tol, "round trip")
+
+	// Batch
+	batchSrc := make([]complex64, 3*n)
+	batchDst := make([]complex64, 3*n)
+
+	for b := range 3 {
+		copy(batchSrc[b*n:], src64)
+	}
+
+	if err := pooled.ForwardBatch(batchDst, batchSrc, 3); err != nil {
+		t.Fatalf("ForwardBatch failed: %v", err)
+	}
+
+	for b := range 3 {
+		assertScaledComplex64(t, batchDst[b*n:(b+1)*n], want64, 1, tol, "batch")
+	}
+}
+
+// checkAccuracyHighReport checks a high-accuracy report. A single float64
+// block rounds once, to within half an ULP of the reference. Columns and rows
+// also round into the complex64 intermediate: the smallest components the
+// report counts can be off by a few hundred ULPs, but the mean ULP and the
+// RMS error stay close to the single-block figures.
+func checkAccuracyHighReport(t *testing.T, n int, single bool, report AccuracyReport, roundTrip float64) {
+	t.Helper()
+
+	maxULP, meanULP := 1.0, 0.5
+	if !single {
+		maxULP, meanULP, roundTrip = 1000, 2, 2*roundTrip
+	}
+
+	if report.MaxULP > maxULP || report.MeanULP > meanULP || report.RMSRelError > 5e-8 || report.RoundTripError > roundTrip {
+		t.Errorf("n=%d: high accuracy max ULP=%g mean ULP=%g rms=%g round trip=%g",
+			n, report.MaxULP, report.MeanULP, report.RMSRelError, report.RoundTripError)
+	}
+}
+
+func TestAccuracyHigh_IgnoredByComplex128(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanWithOptions[complex128](256, PlanOptions{Accuracy: AccuracyHigh})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if plan.high != nil || plan.Meta().Accuracy != AccuracyDefault {
+		t.Errorf("complex128 plan: high = %v, Accuracy = %v, want default", plan.high, plan.Meta().Accuracy)
+	}
+}
+
+func TestAccuracyHigh_PlanRealT(t *testing.T) {
+	t.Parallel()
+
+	// 16382 transforms 8191 packed values, a prime, as one block; 65536 and
+	// the odd 9045 = 67·135 run as columns and rows.
+	for _, n := range []int{2, 45, 1024, 9045, 16382, 65536} {
+		plain, err := NewPlanReal32WithOptions(n, PlanOptions{Normalization: NormOrtho})
+		if err != nil {
+			t.Fatalf("n=%d: NewPlanReal32WithOptions failed: %v", n, err)
+		}
+
+		high, err := NewPlanReal32WithOptions(n, PlanOptions{Accuracy: AccuracyHigh, Normalization: NormOrtho})
+		if err != nil {
+			t.Fatalf("n=%d: NewPlanReal32WithOptions(AccuracyHigh) failed: %v", n, err)
+		}
+
+		if high.high == nil {
+			t.Fatalf("n=%d: high-accuracy real plan has no float64 child", n)
+		}
+
+		report, err := high.Accuracy(2)
+		if err != nil {
+			t.Fatalf("n=%d: Accuracy failed: %v", n, err)
+		}
+
+		checkAccuracyHighReport(t, n, high.high.rows == 1, report, 1e-7)
+
+		if n >= 1024 {
+			baseline, err := plain.Accuracy(2)
+			if err != nil {
+				t.Fatalf("n=%d: Accuracy failed: %v", n, err)
+			}
+
+			if report.RMSRelError >= baseline.RMSRelError/2 {
+				t.Errorf("n=%d: high rms=%g, default rms=%g, want at least 2x better", n, report.RMSRelError, baseline.RMSRelError)
+			}
+		}
+
+		// The external workspace covers the widened buffers and the child.
+		external, err := NewPlanReal32WithOptions(n, PlanOptions{Accuracy: AccuracyHigh, Workspace: WorkspaceExternal})
+		if err != nil {
+			t.Fatalf("n=%d: NewPlanReal32WithOptions failed: %v", n, err)
+		}
+
+		src := make([]float32, n)
+		for i := range src {
+			src[i] = float32(i%7) - 3
+		}
+
+		spectrum := make([]complex64, external.SpectrumLen())
+		back := make([]float32, n)
+		work := NewWorkspace[complex64](external.WorkspaceSize())
+
+		if err := external.ForwardWithWorkspace(spectrum, src, work); err != nil {
+			t.Fatalf("n=%d: ForwardWithWorkspace failed: %v", n, err)
+		}
+
+		if err := external.InverseWithWorkspace(back, spectrum, work); err != nil {
+			t.Fatalf("n=%d: InverseWithWorkspace failed: %v", n, err)
+		}
+
+		assertScaledFloat32(t, back, src, 1, 1e-6, "external round trip")
+	}
+}
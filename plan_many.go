@@ -0,0 +1,249 @@
+package algofft
+
+// ForwardMany computes howMany forward FFTs over an arbitrary strided layout,
+// in the style of FFTW's advanced interface.
+//
+// Element i of transform b is read from src[b*inDist + i*inStride], and bin k
+// is written to dst[b*outDist + k*outStride]. For example, the columns of a
+// row-major rows×cols matrix are transformed with a rows-length plan and
+//
+//	p.ForwardMany(dst, src, cols, cols, 1, cols, 1)
+//
+// while back-to-back transforms (ForwardBatch) use stride 1 and dist Plan.Len().
+//
+// dst and src may be the same slice only if the input and output layouts are
+// identical; other overlapping layouts give undefined results.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidLength if howMany < 1.
+// Returns ErrInvalidStride if a stride is < 1 or a distance is < 0.
+// Returns ErrLengthMismatch if the layout addresses elements beyond either slice.
+func (p *Plan[T]) ForwardMany(dst, src []T, howMany, inStride, inDist, outStride, outDist int) error {
+	return p.transformMany(dst, src, howMany, inStride, inDist, outStride, outDist, false)
+}
+
+// InverseMany computes howMany inverse FFTs over an arbitrary strided layout.
+// See ForwardMany for the layout description and error conditions.
+func (p *Plan[T]) InverseMany(dst, src []T, howMany, inStride, inDist, outStride, outDist int) error {
+	return p.transformMany(dst, src, howMany, inStride, inDist, outStride, outDist, true)
+}
+
+func (p *Plan[T]) transformMany(dst, src []T, howMany, inStride, inDist, outStride, outDist int, inverse bool) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	err := validateManyLayout(len(src), p.n, howMany, inStride, inDist)
+	if err != nil {
+		return err
+	}
+
+	err = validateManyLayout(len(dst), p.n, howMany, outStride, outDist)
+	if err != nil {
+		return err
+	}
+
+	// Contiguous transforms need no gather/scatter.
+	if inStride == 1 && outStride == 1 {
+		for b := range howMany {
+			in := src[b*inDist : b*inDist+p.n]
+			out := dst[b*outDist : b*outDist+p.n]
+
+			if inverse {
+				err = p.Inverse(out, in)
+			} else {
+				err = p.Forward(out, in)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if p.meta.Workspace == WorkspaceExternal {
+		return ErrWorkspaceRequired
+	}
+
+	_, stridedScratch, _, set := p.getScratch()
+	if set != nil {
+		defer p.scratchPool.Put(set)
+	}
+
+	buffer := stridedScratch[:p.n]
+
+	for b := range howMany {
+		inOff := b * inDist
+		for i := range buffer {
+			buffer[i] = src[inOff+i*inStride]
+		}
+
+		if inverse {
+			err = p.Inverse(buffer, buffer)
+		} else {
+			err = p.Forward(buffer, buffer)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		outOff := b * outDist
+		for i, v := range buffer {
+			dst[outOff+i*outStride] = v
+		}
+	}
+
+	return nil
+}
+
+// validateManyLayout checks that howMany transforms of size elements, laid out
+// with the given stride and distance, fit in a slice of length avail.
+func validateManyLayout(avail, size, howMany, stride, dist int) error {
+	if howMany < 1 {
+		return ErrInvalidLength
+	}
+
+	if stride < 1 || dist < 0 {
+		return ErrInvalidStride
+	}
+
+	last := (howMany-1)*dist + (size-1)*stride
+	if last >= avail {
+		return ErrLengthMismatch
+	}
+
+	return nil
+}
+
+// ForwardMany computes howMany real-to-complex FFTs over an arbitrary strided
+// layout. Sample i of transform b is read from src[b*inDist + i*inStride] and
+// bin k (k < SpectrumLen()) is written to dst[b*outDist + k*outStride].
+//
+// Interleaved multichannel audio with c channels, for example, is transformed
+// per channel with inStride = c and inDist = 1.
+//
+// Strided layouts are gathered through buffers that the plan allocates on
+// first use and then reuses, so steady-state calls do not allocate.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidLength if howMany < 1.
+// Returns ErrInvalidStride if a stride is < 1 or a distance is < 0.
+// Returns ErrLengthMismatch if the layout addresses elements beyond either slice.
+func (p *PlanRealT[F, C]) ForwardMany(dst []C, src []F, howMany, inStride, inDist, outStride, outDist int) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	err := validateManyLayout(len(src), p.n, howMany, inStride, inDist)
+	if err != nil {
+		return err
+	}
+
+	err = validateManyLayout(len(dst), p.half+1, howMany, outStride, outDist)
+	if err != nil {
+		return err
+	}
+
+	in, out := p.manyBuffers(inStride, outStride)
+
+	for b := range howMany {
+		inOff := b * inDist
+		if inStride == 1 {
+			in = src[inOff : inOff+p.n]
+		} else {
+			for i := range in {
+				in[i] = src[inOff+i*inStride]
+			}
+		}
+
+		outOff := b * outDist
+		if outStride == 1 {
+			out = dst[outOff : outOff+p.half+1]
+		}
+
+		err = p.forwardSingle(out, in)
+		if err != nil {
+			return err
+		}
+
+		if outStride != 1 {
+			for k, v := range out {
+				dst[outOff+k*outStride] = v
+			}
+		}
+	}
+
+	return nil
+}
+
+// InverseMany computes howMany complex-to-real inverse FFTs over an arbitrary
+// strided layout. Bin k of transform b is read from src[b*inDist + k*inStride]
+// and sample i is written to dst[b*outDist + i*outStride].
+// See ForwardMany for the error conditions.
+func (p *PlanRealT[F, C]) InverseMany(dst []F, src []C, howMany, inStride, inDist, outStride, outDist int) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	err := validateManyLayout(len(src), p.half+1, howMany, inStride, inDist)
+	if err != nil {
+		return err
+	}
+
+	err = validateManyLayout(len(dst), p.n, howMany, outStride, outDist)
+	if err != nil {
+		return err
+	}
+
+	realBuf, spectrumBuf := p.manyBuffers(outStride, inStride)
+
+	for b := range howMany {
+		inOff := b * inDist
+		in := spectrumBuf
+
+		if inStride == 1 {
+			in = src[inOff : inOff+p.half+1]
+		} else {
+			for k := range in {
+				in[k] = src[inOff+k*inStride]
+			}
+		}
+
+		outOff := b * outDist
+		out := realBuf
+
+		if outStride == 1 {
+			out = dst[outOff : outOff+p.n]
+		}
+
+		err = p.inverseSingle(out, in)
+		if err != nil {
+			return err
+		}
+
+		if outStride != 1 {
+			for i, v := range out {
+				dst[outOff+i*outStride] = v
+			}
+		}
+	}
+
+	return nil
+}
+
+// manyBuffers returns the gather buffers for strided real and spectrum
+// layouts, allocating them on first use. A stride of 1 needs no buffer.
+func (p *PlanRealT[F, C]) manyBuffers(realStride, spectrumStride int) ([]F, []C) {
+	if realStride != 1 && p.manyReal == nil {
+		p.manyReal = make([]F, p.n)
+	}
+
+	if spectrumStride != 1 && p.manySpectrum == nil {
+		p.manySpectrum = make([]C, p.half+1)
+	}
+
+	return p.manyReal, p.manySpectrum
+}
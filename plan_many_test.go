@@ -0,0 +1,234 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPlanForwardMany_Columns(t *testing.T) {
+	t.Parallel()
+
+	const (
+		rows = 16
+		cols = 5
+	)
+
+	plan, err := NewPlan64(rows)
+	if err != nil {
+		t.Fatalf("NewPlan64 failed: %v", err)
+	}
+
+	src := randomComplex128Slice(rows*cols, 21)
+	dst := make([]complex128, rows*cols)
+
+	if err := plan.ForwardMany(dst, src, cols, cols, 1, cols, 1); err != nil {
+		t.Fatalf("ForwardMany failed: %v", err)
+	}
+
+	column := make([]complex128, rows)
+	want := make([]complex128, rows)
+
+	for c := range cols {
+		for r := range rows {
+			column[r] = src[r*cols+c]
+		}
+
+		if err := plan.Forward(want, column); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		for r := range rows {
+			if !complexNear128(dst[r*cols+c], want[r], 1e-10) {
+				t.Fatalf("column %d bin %d = %v, want %v", c, r, dst[r*cols+c], want[r])
+			}
+		}
+	}
+
+	// In-place with identical layouts restores the input.
+	if err := plan.InverseMany(dst, dst, cols, cols, 1, cols, 1); err != nil {
+		t.Fatalf("InverseMany failed: %v", err)
+	}
+
+	for i := range src {
+		if !complexNear128(dst[i], src[i], 1e-12) {
+			t.Fatalf("round trip[%d] = %v, want %v", i, dst[i], src[i])
+		}
+	}
+}
+
+func TestPlanForwardMany_MatchesBatch(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n     = 12
+		count = 4
+	)
+
+	plan, err := NewPlan64(n)
+	if err != nil {
+		t.Fatalf("NewPlan64 failed: %v", err)
+	}
+
+	src := randomComplex128Slice(n*count, 3)
+	want := make([]complex128, n*count)
+	got := make([]complex128, n*count)
+
+	if err := plan.ForwardBatch(want, src, count); err != nil {
+		t.Fatalf("ForwardBatch failed: %v", err)
+	}
+
+	if err := plan.ForwardMany(got, src, count, 1, n, 1, n); err != nil {
+		t.Fatalf("ForwardMany failed: %v", err)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPlanForwardMany_DifferentLayouts(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n       = 8
+		howMany = 3
+	)
+
+	plan, err := NewPlan64(n)
+	if err != nil {
+		t.Fatalf("NewPlan64 failed: %v", err)
+	}
+
+	// Interleaved input (stride howMany, dist 1) to contiguous output rows.
+	src := randomComplex128Slice(n*howMany, 4)
+	dst := make([]complex128, n*howMany)
+
+	if err := plan.ForwardMany(dst, src, howMany, howMany, 1, 1, n); err != nil {
+		t.Fatalf("ForwardMany failed: %v", err)
+	}
+
+	channel := make([]complex128, n)
+	want := make([]complex128, n)
+
+	for b := range howMany {
+		for i := range n {
+			channel[i] = src[i*howMany+b]
+		}
+
+		_ = plan.Forward(want, channel)
+
+		for k := range n {
+			if !complexNear128(dst[b*n+k], want[k], 1e-10) {
+				t.Fatalf("transform %d bin %d = %v, want %v", b, k, dst[b*n+k], want[k])
+			}
+		}
+	}
+}
+
+func TestPlanForwardMany_Errors(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlan32(8)
+	if err != nil {
+		t.Fatalf("NewPlan32 failed: %v", err)
+	}
+
+	buf := make([]complex64, 32)
+
+	tests := []struct {
+		name                                 string
+		howMany, inStride, inDist, outStride int
+		outDist                              int
+		want                                 error
+	}{
+		{"zero howMany", 0, 1, 8, 1, 8, ErrInvalidLength},
+		{"zero in stride", 2, 0, 8, 1, 8, ErrInvalidStride},
+		{"negative out stride", 2, 1, 8, -1, 8, ErrInvalidStride},
+		{"negative dist", 2, 1, -8, 1, 8, ErrInvalidStride},
+		{"input overrun", 5, 1, 8, 1, 1, ErrLengthMismatch},
+		{"output overrun", 2, 1, 1, 5, 1, ErrLengthMismatch},
+	}
+
+	for _, tc := range tests {
+		err := plan.ForwardMany(buf, buf, tc.howMany, tc.inStride, tc.inDist, tc.outStride, tc.outDist)
+		if !errors.Is(err, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	if err := plan.ForwardMany(nil, buf, 1, 1, 8, 1, 8); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: got %v, want ErrNilSlice", err)
+	}
+}
+
+func TestPlanRealTForwardMany_Multichannel(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n        = 32
+		channels = 3
+	)
+
+	plan, err := NewPlanReal64(n)
+	if err != nil {
+		t.Fatalf("NewPlanReal64 failed: %v", err)
+	}
+
+	specLen := plan.SpectrumLen()
+
+	// Interleaved frames: sample i of channel c at src[i*channels+c].
+	src := make([]float64, n*channels)
+	for i := range src {
+		src[i] = math.Sin(float64(i) * 0.37)
+	}
+
+	// Channel-interleaved spectra as well.
+	spectra := make([]complex128, specLen*channels)
+
+	if err := plan.ForwardMany(spectra, src, channels, channels, 1, channels, 1); err != nil {
+		t.Fatalf("ForwardMany failed: %v", err)
+	}
+
+	channel := make([]float64, n)
+	want := make([]complex128, specLen)
+
+	for c := range channels {
+		for i := range n {
+			channel[i] = src[i*channels+c]
+		}
+
+		if err := plan.Forward(want, channel); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		for k := range specLen {
+			if !complexNear128(spectra[k*channels+c], want[k], 1e-10) {
+				t.Fatalf("channel %d bin %d = %v, want %v", c, k, spectra[k*channels+c], want[k])
+			}
+		}
+	}
+
+	out := make([]float64, n*channels)
+	if err := plan.InverseMany(out, spectra, channels, channels, 1, channels, 1); err != nil {
+		t.Fatalf("InverseMany failed: %v", err)
+	}
+
+	for i := range src {
+		if math.Abs(out[i]-src[i]) > 1e-12 {
+			t.Fatalf("round trip[%d] = %v, want %v", i, out[i], src[i])
+		}
+	}
+
+	// Contiguous layouts go straight to the single-transform path.
+	contiguous := make([]complex128, specLen*channels)
+	if err := plan.ForwardMany(contiguous, out, 1, 1, 0, 1, 0); err != nil {
+		t.Fatalf("contiguous ForwardMany failed: %v", err)
+	}
+
+	if err := plan.ForwardMany(contiguous, src, channels, 0, 1, 1, specLen); !errors.Is(err, ErrInvalidStride) {
+		t.Fatalf("zero stride: got %v, want ErrInvalidStride", err)
+	}
+}
@@ -7,6 +7,39 @@ type PlanMeta struct {
 	Batch    int
 	Stride   int
 	InPlace  bool
+
+	// Normalization is the scaling convention applied by Forward/Inverse.
+	Normalization Normalization
+
+	// Workers is the resolved goroutine count for batched transforms.
+	Workers int
+
+	// FourStep reports whether large transforms run the parallel four-step
+	// path instead of the serial kernel.
+	FourStep bool
+
+	// Workspace is the scratch management policy the plan was built with.
+	Workspace WorkspacePolicy
+
+	// BluesteinSize is the padded convolution length M >= 2N-1 of Bluestein
+	// plans, chosen among 5-smooth sizes by cost model or, with
+	// PlannerMeasure and above, by timing; 0 for other algorithms.
+	BluesteinSize int
+
+	// Radices is the mixed-radix stage schedule, outermost stage first, for
+	// non-power-of-two sizes without a dedicated codelet; nil otherwise.
+	Radices []int
+
+	// Accuracy is AccuracyHigh for complex64 plans computing in float64.
+	Accuracy AccuracyMode
+
+	// Verified reports whether the plan passed PlanOptions.Verify.
+	Verified bool
+
+	// Rejected lists the codelets and forced kernel strategies
+	// PlanOptions.Verify rejected, in the order they were tried; Algorithm()
+	// names the one that passed.
+	Rejected []string
 }
 
 // Meta returns metadata about how the plan was constructed.
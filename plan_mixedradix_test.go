@@ -0,0 +1,201 @@
+package algofft
+
+import (
+	"math/cmplx"
+	"slices"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+func TestMixedRadix_OddRadicesMatchReference(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{7, 11, 13, 49, 121, 169, 1183, 1400, 2002, 3003, 4004} {
+		plan, err := NewPlanT[complex128](n)
+		if err != nil {
+			t.Fatalf("NewPlanT(%d) failed: %v", n, err)
+		}
+
+		if plan.KernelStrategy() == KernelBluestein {
+			t.Fatalf("n=%d: strategy = KernelBluestein, want mixed radix", n)
+		}
+
+		src := randomComplex128Slice(n, uint64(n))
+		want := reference.NaiveDFT128(src)
+		got := make([]complex128, n)
+
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		for i := range want {
+			if cmplx.Abs(got[i]-want[i]) > 1e-9*float64(n) {
+				t.Fatalf("n=%d: forward[%d] = %v, want %v", n, i, got[i], want[i])
+			}
+		}
+
+		if err := plan.Inverse(got, got); err != nil {
+			t.Fatalf("Inverse failed: %v", err)
+		}
+
+		for i := range src {
+			if cmplx.Abs(got[i]-src[i]) > 1e-12*float64(n) {
+				t.Fatalf("n=%d: round trip[%d] = %v, want %v", n, i, got[i], src[i])
+			}
+		}
+	}
+}
+
+func TestMixedRadix_OddRadicesForcedStrategies(t *testing.T) {
+	t.Parallel()
+
+	// Strategies that do not exist for a 7/11/13-smooth size must fall back
+	// to a kernel that computes the right transform.
+	strategies := []KernelStrategy{KernelDIT, KernelStockham, KernelRecursive, KernelSplitRadix, KernelPFA}
+
+	for _, strategy := range strategies {
+		for _, n := range []int{7, 11, 13, 77, 1001, 2002} {
+			plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: strategy})
+			if err != nil {
+				t.Fatalf("%v n=%d: NewPlanWithOptions failed: %v", strategy, n, err)
+			}
+
+			src := randomComplex128Slice(n, uint64(n))
+			want := reference.NaiveDFT128(src)
+			got := make([]complex128, n)
+
+			if err := plan.Forward(got, src); err != nil {
+				t.Fatalf("%v n=%d: Forward failed: %v", strategy, n, err)
+			}
+
+			for i := range want {
+				if cmplx.Abs(got[i]-want[i]) > 1e-9*float64(n) {
+					t.Fatalf("%v n=%d (%v): forward[%d] = %v, want %v", strategy, n, plan.KernelStrategy(), i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestMixedRadix_OddRadicesComplex64(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{169, 1400, 4004} {
+		plan, err := NewPlanT[complex64](n)
+		if err != nil {
+			t.Fatalf("NewPlanT(%d) failed: %v", n, err)
+		}
+
+		src128 := randomComplex128Slice(n, uint64(n))
+		want := reference.NaiveDFT128(src128)
+
+		src := make([]complex64, n)
+		for i, v := range src128 {
+			src[i] = complex64(v)
+		}
+
+		got := make([]complex64, n)
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		for i := range want {
+			if cmplx.Abs(complex128(got[i])-want[i]) > 1e-5*float64(n) {
+				t.Fatalf("n=%d: forward[%d] = %v, want %v", n, i, got[i], want[i])
+			}
+		}
+
+		if err := plan.Inverse(got, got); err != nil {
+			t.Fatalf("Inverse failed: %v", err)
+		}
+
+		for i := range src {
+			if cmplx.Abs(complex128(got[i]-src[i])) > 1e-4 {
+				t.Fatalf("n=%d: round trip[%d] = %v, want %v", n, i, got[i], src[i])
+			}
+		}
+	}
+}
+
+func TestMixedRadix_RadicesHints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n      int
+		hints  []int
+		prefix []int
+	}{
+		{180, []int{3}, []int{3}},
+		{180, []int{2, 3}, []int{2, 2, 3}},
+		{1400, []int{7, 2}, []int{7, 2, 2, 2}},
+		{4004, []int{7, 2}, []int{7, 2, 2}},
+		{180, []int{9, 3}, []int{3}}, // unsupported radix ignored
+	}
+
+	for _, tt := range tests {
+		ref, err := NewPlanT[complex128](tt.n)
+		if err != nil {
+			t.Fatalf("NewPlanT(%d) failed: %v", tt.n, err)
+		}
+
+		plan, err := NewPlanWithOptions[complex128](tt.n, PlanOptions{Radices: slices.Clone(tt.hints)})
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions(%d) failed: %v", tt.n, err)
+		}
+
+		radices := plan.Meta().Radices
+		if len(radices) < len(tt.prefix) || !slices.Equal(radices[:len(tt.prefix)], tt.prefix) {
+			t.Errorf("n=%d hints=%v: Meta().Radices = %v, want prefix %v", tt.n, tt.hints, radices, tt.prefix)
+		}
+
+		product := 1
+		for _, r := range radices {
+			product *= r
+		}
+
+		if product != tt.n {
+			t.Errorf("n=%d: schedule %v covers %d points", tt.n, radices, product)
+		}
+
+		src := randomComplex128Slice(tt.n, 5)
+		want := make([]complex128, tt.n)
+		got := make([]complex128, tt.n)
+
+		if err := ref.Forward(want, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		for i := range want {
+			if cmplx.Abs(got[i]-want[i]) > 1e-9*float64(tt.n) {
+				t.Fatalf("n=%d hints=%v: forward[%d] = %v, want %v", tt.n, tt.hints, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestMixedRadix_MetaRadices(t *testing.T) {
+	t.Parallel()
+
+	pow2, err := NewPlanT[complex64](1024)
+	if err != nil {
+		t.Fatalf("NewPlanT failed: %v", err)
+	}
+
+	if radices := pow2.Meta().Radices; radices != nil {
+		t.Errorf("power of two: Meta().Radices = %v, want nil", radices)
+	}
+
+	prime, err := NewPlanT[complex64](17)
+	if err != nil {
+		t.Fatalf("NewPlanT failed: %v", err)
+	}
+
+	if radices := prime.Meta().Radices; radices != nil {
+		t.Errorf("Bluestein: Meta().Radices = %v, want nil", radices)
+	}
+}
@@ -24,6 +24,19 @@ type PlanND[T Complex] struct {
 	strides []int      // Pre-computed strides for each dimension
 	options PlanOptions
 
+	// forwardScale/inverseScale implement PlanOptions.Normalization and are
+	// fused into the final copy to dst.
+	forwardScale float64
+	inverseScale float64
+
+	// batchWorkers splits the PlanOptions.Batch loop across workers with
+	// pooled workspaces when PlanOptions.Workers > 1 (nil otherwise).
+	batchWorkers *multiDimBatch[T, *PlanND[T]]
+
+	// passes splits the per-dimension passes across per-worker lanes of 1D
+	// plans and slice buffers; lanes[0] uses plans.
+	passes laneSet[planNDLane[T]]
+
 	// backing keeps aligned scratch buffer alive for GC
 	scratchBacking []byte
 }
@@ -74,6 +87,8 @@ func NewPlanNDWithOptions[T Complex](dims []int, opts PlanOptions) (*PlanND[T],
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	// Child plans keep the default convention; the combined factor is applied once.
+	childOpts.Normalization = NormBackward
 
 	// Create 1D plans for each dimension
 	plans := make([]*Plan[T], len(dims))
@@ -86,23 +101,6 @@ func NewPlanNDWithOptions[T Complex](dims []int, opts PlanOptions) (*PlanND[T],
 		plans[i] = plan
 	}
 
-	// Allocate scratch buffer (aligned for SIMD)
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	}
-
 	// Pre-compute strides for efficient indexing
 	strides := make([]int, len(dims))
 
@@ -112,14 +110,25 @@ func NewPlanNDWithOptions[T Complex](dims []int, opts PlanOptions) (*PlanND[T],
 		stride *= dimsCopy[i]
 	}
 
-	return &PlanND[T]{
-		dims:           dimsCopy,
-		plans:          plans,
-		scratch:        scratch,
-		strides:        strides,
-		scratchBacking: scratchBacking,
-		options:        opts,
-	}, nil
+	p := &PlanND[T]{
+		dims:    dimsCopy,
+		plans:   plans,
+		strides: strides,
+		options: opts,
+	}
+
+	// External-workspace plans carve the working buffer from the caller's
+	// workspace.
+	if opts.Workspace != WorkspaceExternal {
+		p.allocScratch()
+	}
+
+	p.forwardScale, p.inverseScale = normalizationScales(opts.Normalization, totalSize)
+
+	p.initLanes(opts.Workers)
+	p.batchWorkers = newMultiDimBatch[T](p, opts)
+
+	return p, nil
 }
 
 // NewPlanND32 creates a new N-dimensional FFT plan using complex64 precision.
@@ -195,6 +204,10 @@ func (p *PlanND[T]) Forward(dst, src []T) error {
 		return err
 	}
 
+	if p.batchWorkers != nil && batch > 1 {
+		return p.batchWorkers.run(dst, src, p.Len(), batch, stride, false)
+	}
+
 	for b := range batch {
 		srcOff := b * stride
 
@@ -228,6 +241,10 @@ func (p *PlanND[T]) Inverse(dst, src []T) error {
 		return err
 	}
 
+	if p.batchWorkers != nil && batch > 1 {
+		return p.batchWorkers.run(dst, src, p.Len(), batch, stride, true)
+	}
+
 	for b := range batch {
 		srcOff := b * stride
 
@@ -265,30 +282,17 @@ func (p *PlanND[T]) InverseInPlace(data []T) error {
 //
 // This allows multiple goroutines to perform transforms concurrently.
 func (p *PlanND[T]) Clone() *PlanND[T] {
-	// Allocate new scratch buffer
-	var (
-		scratch        []T
-		scratchBacking []byte
-	)
-
-	totalSize := p.Len()
+	c := p.clone()
+	c.initLanes(c.options.Workers)
+	c.batchWorkers = newMultiDimBatch[T](c, c.options)
 
-	switch any(scratch).(type) {
-	case []complex64:
-		s, b := mem.AllocAlignedComplex64(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	case []complex128:
-		s, b := mem.AllocAlignedComplex128(totalSize)
-		scratch = any(s).([]T)
-		scratchBacking = b
-	}
+	return c
+}
 
+// clone copies the plan without batch workers and with a single lane.
+func (p *PlanND[T]) clone() *PlanND[T] {
 	// Clone all 1D plans
-	plans := make([]*Plan[T], len(p.plans))
-	for i, plan := range p.plans {
-		plans[i] = plan.Clone()
-	}
+	plans := clonePlans(p.plans)
 
 	// Copy dimensions and strides
 	dims := make([]int, len(p.dims))
@@ -297,14 +301,75 @@ func (p *PlanND[T]) Clone() *PlanND[T] {
 	strides := make([]int, len(p.strides))
 	copy(strides, p.strides)
 
-	return &PlanND[T]{
-		dims:           dims,
-		plans:          plans,
-		scratch:        scratch,
-		strides:        strides,
-		scratchBacking: scratchBacking,
-		options:        p.options,
+	c := &PlanND[T]{
+		dims:         dims,
+		plans:        plans,
+		strides:      strides,
+		options:      p.options,
+		forwardScale: p.forwardScale,
+		inverseScale: p.inverseScale,
 	}
+
+	if p.scratch != nil {
+		c.allocScratch()
+	}
+
+	c.initLanes(1)
+
+	return c
+}
+
+// allocScratch allocates the working buffer (aligned for SIMD).
+func (p *PlanND[T]) allocScratch() {
+	switch any(p.scratch).(type) {
+	case []complex64:
+		s, b := mem.AllocAlignedComplex64(p.Len())
+		p.scratch = any(s).([]T)
+		p.scratchBacking = b
+	case []complex128:
+		s, b := mem.AllocAlignedComplex128(p.Len())
+		p.scratch = any(s).([]T)
+		p.scratchBacking = b
+	}
+}
+
+// maxDim returns the largest dimension, the length of the slice buffers.
+func (p *PlanND[T]) maxDim() int {
+	maxDim := 0
+	for _, d := range p.dims {
+		maxDim = max(maxDim, d)
+	}
+
+	return maxDim
+}
+
+// planNDLane holds the resources one worker needs for the N-D passes and the
+// array it is working on.
+type planNDLane[T Complex] struct {
+	plans     []*Plan[T]
+	sliceData []T // size = largest dimension
+
+	data    []T
+	forward bool
+
+	// work is the 1D plans' workspace (nil = their pooled scratch).
+	work []T
+}
+
+func (l planNDLane[T]) clone() planNDLane[T] {
+	return planNDLane[T]{plans: clonePlans(l.plans), sliceData: make([]T, len(l.sliceData))}
+}
+
+// initLanes sets up one lane per worker.
+func (p *PlanND[T]) initLanes(workers int) {
+	maxDim := p.maxDim()
+
+	own := planNDLane[T]{plans: p.plans}
+	if p.scratch != nil {
+		own.sliceData = make([]T, maxDim)
+	}
+
+	p.passes.init(p, own, workers, p.Len()/maxDim)
 }
 
 // validate checks that dst and src have the correct length for this plan.
@@ -326,37 +391,43 @@ func (p *PlanND[T]) validate(dst, src []T) error {
 	return nil
 }
 
-// transformDimension applies 1D FFT along the specified dimension.
-// This extracts slices along the dimension, transforms them, and writes back.
-func (p *PlanND[T]) transformDimension(data []T, dim int, forward bool) error {
-	dimSize := p.dims[dim]
-	plan := p.plans[dim]
+// transformDimension applies 1D FFT along the specified dimension, splitting
+// the slices along it across the plan's lanes, or serially on l when l is
+// non-nil.
+func (p *PlanND[T]) transformDimension(l *planNDLane[T], dim int) error {
+	// Total number of slices to process
+	totalSlices := p.Len() / p.dims[dim]
 
-	// Allocate buffer for one slice along this dimension
-	sliceData := make([]T, dimSize)
+	return p.passes.run(l, dim, totalSlices)
+}
 
-	// Total number of slices to process
-	totalSlices := p.Len() / dimSize
-
-	// Iterate through all slices along this dimension
-	for sliceIdx := range totalSlices {
-		// Extract slice
-		p.extractSlice(data, sliceData, sliceIdx, dim)
-
-		// Transform slice
-		var err error
-		if forward {
-			err = plan.InPlace(sliceData)
-		} else {
-			err = plan.InverseInPlace(sliceData)
+// transformLines transforms slices [lo, hi) along dimension pass: each slice
+// is extracted, transformed, and written back.
+func (p *PlanND[T]) transformLines(l planNDLane[T], pass, lo, hi int) error {
+	plan := l.plans[pass]
+	sliceData := l.sliceData[:p.dims[pass]]
+
+	for sliceIdx := lo; sliceIdx < hi; sliceIdx++ {
+		p.extractSlice(l.data, sliceData, sliceIdx, pass)
+
+		if err := plan.transformWith(sliceData, sliceData, l.work, !l.forward); err != nil {
+			return err
 		}
 
+		p.writeSlice(l.data, sliceData, sliceIdx, pass)
+	}
+
+	return nil
+}
+
+// transformData transforms the lanes' data along every dimension, innermost
+// first.
+func (p *PlanND[T]) transformData(l *planNDLane[T]) error {
+	for dim := len(p.dims) - 1; dim >= 0; dim-- {
+		err := p.transformDimension(l, dim)
 		if err != nil {
 			return err
 		}
-
-		// Write back
-		p.writeSlice(data, sliceData, sliceIdx, dim)
 	}
 
 	return nil
@@ -394,79 +465,70 @@ func (p *PlanND[T]) writeSlice(data, src []T, sliceIdx, dim int) {
 }
 
 func (p *PlanND[T]) forwardSingle(dst, src []T) error {
+	return p.transformSingle(dst, src, true)
+}
+
+func (p *PlanND[T]) inverseSingle(dst, src []T) error {
+	return p.transformSingle(dst, src, false)
+}
+
+func (p *PlanND[T]) transformSingle(dst, src []T, forward bool) error {
 	err := p.validate(dst, src)
 	if err != nil {
 		return err
 	}
 
+	if p.scratch == nil {
+		return ErrWorkspaceRequired
+	}
+
 	work := p.scratch
 	copy(work, src)
 
-	for dim := len(p.dims) - 1; dim >= 0; dim-- {
-		err = p.transformDimension(work, dim, true)
-		if err != nil {
-			return err
-		}
+	for i := range p.passes.lanes {
+		p.passes.lanes[i].data = work
+		p.passes.lanes[i].forward = forward
 	}
 
-	copy(dst, work)
+	err = p.transformData(nil)
 
-	return nil
-}
+	for i := range p.passes.lanes {
+		p.passes.lanes[i].data = nil
+	}
 
-func (p *PlanND[T]) inverseSingle(dst, src []T) error {
-	err := p.validate(dst, src)
 	if err != nil {
 		return err
 	}
 
-	work := p.scratch
-	copy(work, src)
+	p.scaleCopy(dst, work, forward)
 
-	for dim := len(p.dims) - 1; dim >= 0; dim-- {
-		err = p.transformDimension(work, dim, false)
-		if err != nil {
-			return err
-		}
-	}
+	return nil
+}
 
-	copy(dst, work)
+func (p *PlanND[T]) scaleCopy(dst, work []T, forward bool) {
+	scale := p.inverseScale
+	if forward {
+		scale = p.forwardScale
+	}
 
-	return nil
+	scaleCopyComplex(dst, work, scale)
 }
 
 // sliceIndexToOffset converts a linear slice index to the base offset in scratch buffer.
 // This computes the offset for the first element of the slice.
 func (p *PlanND[T]) sliceIndexToOffset(sliceIdx, dim int) int {
-	// Build array of "reduced" dimensions (all dims except the transform dimension)
-	reducedDims := make([]int, 0, len(p.dims)-1)
-	for d := range len(p.dims) {
-		if d != dim {
-			reducedDims = append(reducedDims, p.dims[d])
-		}
-	}
-
-	// Convert linear sliceIdx to coordinates in reduced space
-	coords := make([]int, len(reducedDims))
-
-	remaining := sliceIdx
-	for i := len(reducedDims) - 1; i >= 0; i-- {
-		coords[i] = remaining % reducedDims[i]
-		remaining /= reducedDims[i]
-	}
-
-	// Map reduced coordinates back to full coordinates and compute offset
+	// Decompose sliceIdx over all dimensions except dim, last dimension
+	// varying fastest; dim itself stays at 0 (first element along the axis).
 	offset := 0
-	reducedIdx := 0
+	remaining := sliceIdx
 
-	for d := range len(p.dims) {
+	for d := len(p.dims) - 1; d >= 0; d-- {
 		if d == dim {
-			// This dimension is set to 0 (first element along transform axis)
 			continue
 		}
 
-		offset += coords[reducedIdx] * p.strides[d]
-		reducedIdx++
+		offset += (remaining % p.dims[d]) * p.strides[d]
+		remaining /= p.dims[d]
 	}
 
 	return offset
@@ -0,0 +1,98 @@
+package algofft
+
+import (
+	m "github.com/cwbudde/algo-fft/internal/math"
+	"github.com/cwbudde/algo-fft/internal/ntt"
+)
+
+// PlanNTT is a pre-computed number-theoretic transform: the DFT over the
+// integers modulo a prime, where all arithmetic is exact. Convolutions of
+// integer sequences computed through it are exact modulo the prime.
+//
+// The modulus must be an odd prime p < 2^63 with n | p-1; 998244353
+// (119·2^23+1) is a common choice. Root tables are cached per length and
+// modulus, so creating a plan for a size that was used before is cheap.
+// A PlanNTT holds no mutable state and is safe for concurrent use.
+type PlanNTT struct {
+	table *ntt.Table
+}
+
+// NewPlanNTT creates a number-theoretic transform plan for length n, which
+// must be a power of two, modulo the given prime.
+//
+// Returns ErrInvalidLength if n is not a power of two.
+// Returns ErrInvalidModulus if modulus is not an odd prime below 2^63 with
+// n | modulus-1.
+func NewPlanNTT(n int, modulus uint64) (*PlanNTT, error) {
+	if n < 1 || !m.IsPowerOf2(n) {
+		return nil, ErrInvalidLength
+	}
+
+	if !ntt.Supports(n, modulus) {
+		return nil, ErrInvalidModulus
+	}
+
+	return &PlanNTT{table: ntt.CachedTable(n, modulus)}, nil
+}
+
+// Len returns the transform length.
+func (p *PlanNTT) Len() int {
+	return p.table.N
+}
+
+// Modulus returns the prime modulus.
+func (p *PlanNTT) Modulus() uint64 {
+	return p.table.Mod.P
+}
+
+// Forward computes the NTT of src into dst: dst[k] = Σ src[j]·w^(jk) mod p,
+// where w is the plan's primitive Len()-th root of unity. Inputs are reduced
+// modulo p first; outputs lie in [0, p). dst may alias src.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if either length differs from Len().
+func (p *PlanNTT) Forward(dst, src []uint64) error {
+	err := p.prepare(dst, src)
+	if err != nil {
+		return err
+	}
+
+	p.table.Forward(dst, dst)
+
+	return nil
+}
+
+// Inverse computes the inverse NTT of src into dst, including the 1/N factor,
+// so Inverse(Forward(x)) == x mod p. dst may alias src.
+func (p *PlanNTT) Inverse(dst, src []uint64) error {
+	err := p.prepare(dst, src)
+	if err != nil {
+		return err
+	}
+
+	p.table.Inverse(dst, dst)
+
+	return nil
+}
+
+// prepare validates the slices and copies src into dst, reduced modulo p.
+func (p *PlanNTT) prepare(dst, src []uint64) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.table.N || len(src) != p.table.N {
+		return ErrLengthMismatch
+	}
+
+	modulus := p.table.Mod.P
+	for i, v := range src {
+		if v >= modulus {
+			v %= modulus
+		}
+
+		dst[i] = v
+	}
+
+	return nil
+}
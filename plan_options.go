@@ -6,7 +6,7 @@ import "time"
 //
 // The planner modes form a hierarchy of increasing thoroughness:
 //   - PlannerEstimate: Use heuristics only (fast, no benchmarking)
-//   - PlannerMeasure: Quick benchmark testing DIT and Stockham strategies
+//   - PlannerMeasure: Quick benchmark testing DIT, Stockham and SplitRadix
 //   - PlannerPatient: Moderate benchmark including SixStep
 //   - PlannerExhaustive: Thorough benchmark testing all strategies
 //
@@ -20,11 +20,11 @@ const (
 	PlannerEstimate PlannerMode = iota
 
 	// PlannerMeasure runs quick micro-benchmarks (warmup=3, iters=10)
-	// testing DIT and Stockham strategies to find the faster one.
+	// testing DIT, Stockham and SplitRadix strategies to find the fastest.
 	PlannerMeasure
 
 	// PlannerPatient runs moderate micro-benchmarks (warmup=5, iters=50)
-	// testing DIT, Stockham, and SixStep strategies.
+	// testing DIT, Stockham, SplitRadix, and SixStep strategies.
 	PlannerPatient
 
 	// PlannerExhaustive runs thorough micro-benchmarks (warmup=10, iters=100)
@@ -33,13 +33,26 @@ const (
 )
 
 // WorkspacePolicy controls how executors manage scratch space.
-// Note: This feature is not yet implemented and will be added in a future release.
+//
+// Every plan also offers ForwardWithWorkspace/InverseWithWorkspace, which run
+// in a caller-provided buffer of WorkspaceSize() elements regardless of the
+// policy and never touch the plan's sync.Pool.
 type WorkspacePolicy uint8
 
 const (
-	WorkspaceAuto     WorkspacePolicy = iota // Not yet implemented
-	WorkspacePooled                          // Not yet implemented
-	WorkspaceExternal                        // Not yet implemented
+	// WorkspaceAuto lets the plan choose; currently the same as WorkspacePooled.
+	WorkspaceAuto WorkspacePolicy = iota
+
+	// WorkspacePooled draws per-call scratch from a sync.Pool owned by the
+	// plan, so Forward/Inverse are safe for concurrent use without allocating
+	// in steady state.
+	WorkspacePooled
+
+	// WorkspaceExternal keeps no scratch in the plan at all. Only the
+	// WithWorkspace methods (and paths that need no scratch) can run;
+	// Forward/Inverse return ErrWorkspaceRequired. External plans execute
+	// serially, ignoring Workers.
+	WorkspaceExternal
 )
 
 // PlanOptions controls planning decisions and execution layout.
@@ -52,7 +65,11 @@ type PlanOptions struct {
 	// to let the planner choose based on size and benchmarks.
 	Strategy KernelStrategy
 
-	// Radices hints at which radices to prefer for mixed-radix FFT.
+	// Radices hints at which radices to prefer for mixed-radix FFT. The
+	// supported radices (2, 3, 4, 5, 7, 11 and 13) are used first, in the
+	// given order and as often as they divide the size; the planner completes
+	// the schedule. Only non-power-of-two sizes without a dedicated codelet
+	// run mixed-radix; see PlanMeta.Radices for the schedule in use.
 	Radices []int
 
 	// Batch specifies the number of transforms to execute in a batch.
@@ -64,6 +81,26 @@ type PlanOptions struct {
 	// InPlace enables in-place transforms when possible.
 	InPlace bool
 
+	// Normalization selects the forward/inverse scaling convention.
+	// Default is NormBackward (forward unscaled, inverse scaled by 1/N).
+	Normalization Normalization
+
+	// Workers sets how many goroutines batched transforms may use:
+	// Plan.ForwardBatch/InverseBatch and the PlanOptions.Batch loop of
+	// Plan2D, Plan3D and PlanND. 0 or 1 runs serially (default); a negative
+	// value uses runtime.GOMAXPROCS(0). Parallel batch results are bitwise
+	// identical to the serial path.
+	//
+	// Plan2D, Plan3D and PlanND additionally split the row, column and slice
+	// passes of each transform across workers, each with its own 1D plans and
+	// line buffers.
+	//
+	// With more than one worker, single power-of-two transforms of 2^18
+	// points or more (or any power-of-two size with Strategy KernelSixStep or
+	// KernelEightStep) run a parallel four-step algorithm. PlannerMeasure and
+	// above keep it only if it beats the serial kernel; see PlanMeta.FourStep.
+	Workers int
+
 	// Wisdom provides a cache for storing and retrieving optimal kernel choices.
 	// When using PlannerMeasure or higher, benchmark results are automatically
 	// stored to this cache. When creating plans, cached decisions are used
@@ -71,8 +108,30 @@ type PlanOptions struct {
 	Wisdom WisdomStore
 
 	// Workspace controls how executors manage scratch space.
-	// Note: This feature is not yet implemented.
+	// Default is WorkspaceAuto (pooled per-call scratch).
 	Workspace WorkspacePolicy
+
+	// Accuracy selects the internal precision of complex64 plans. With
+	// AccuracyHigh, Plan[complex64] and the plans built on it (PlanRealT,
+	// PlanReal, multi-dimensional plans) keep complex64 data in memory but
+	// compute in float64 blocks, approaching complex128 accuracy; see
+	// AccuracyHigh and PlanMeta.Accuracy. Default is AccuracyDefault.
+	Accuracy AccuracyMode
+
+	// RealFormat selects the real-array spectrum layout of the packed
+	// PlanRealT methods (ForwardPacked, InversePacked, ForwardInPlace,
+	// InverseInPlace, PackSpectrum, UnpackSpectrum). Default is RealFormatCCS.
+	RealFormat RealFormat
+
+	// Verify checks the selected codelet or kernel at plan creation against
+	// a reference DFT of a deterministic random vector. A codelet that
+	// mismatches is rejected in favour of the next registered candidate, and
+	// a forced Strategy whose candidates all mismatch in favour of
+	// KernelAuto; see PlanMeta.Verified and PlanMeta.Rejected. Plan creation
+	// fails with ErrVerificationFailed if no candidate passes. Sub-plans
+	// (Bluestein, Rader, multi-dimensional and real plans) are verified as
+	// well.
+	Verify bool
 }
 
 // WisdomStore persists planner decisions for reuse.
@@ -127,6 +186,34 @@ func normalizePlanOptions(opts PlanOptions) PlanOptions {
 		opts.Stride = 0 // 0 means use default stride
 	}
 
+	// Negative worker counts select one worker per available CPU
+	opts.Workers = resolveWorkers(opts.Workers)
+
+	// Unknown workspace policies fall back to the default; external
+	// workspaces are a single buffer, so execution stays serial
+	if opts.Workspace > WorkspaceExternal {
+		opts.Workspace = WorkspaceAuto
+	}
+
+	if opts.Workspace == WorkspaceExternal {
+		opts.Workers = 0
+	}
+
+	// Unknown accuracy modes fall back to the default
+	if opts.Accuracy > AccuracyHigh {
+		opts.Accuracy = AccuracyDefault
+	}
+
+	// Unknown normalization conventions fall back to the default
+	if opts.Normalization > NormNone {
+		opts.Normalization = NormBackward
+	}
+
+	// Unknown packed real formats fall back to the default
+	if opts.RealFormat > RealFormatHalfComplex {
+		opts.RealFormat = RealFormatCCS
+	}
+
 	// Normalize radices: drop invalid entries (<= 1)
 	// If none remain, fall back to planner defaults by clearing the slice
 	if len(opts.Radices) > 0 {
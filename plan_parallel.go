@@ -0,0 +1,268 @@
+package algofft
+
+import "sync"
+
+// planBatchTask runs a slice of Plan.ForwardBatch/InverseBatch on one worker.
+// Each transform draws its own scratch set from the plan's scratchPool, so
+// workers never share scratch and the results match the serial path bitwise.
+type planBatchTask[T Complex] struct {
+	p        *Plan[T]
+	dst, src []T
+	count    int
+	chunks   int
+	inverse  bool
+
+	mu       sync.Mutex
+	err      error
+	errChunk int
+}
+
+//nolint:gochecknoglobals
+var (
+	planBatchTasks64  = sync.Pool{New: func() any { return new(planBatchTask[complex64]) }}
+	planBatchTasks128 = sync.Pool{New: func() any { return new(planBatchTask[complex128]) }}
+)
+
+func getPlanBatchTask[T Complex]() *planBatchTask[T] {
+	var zero T
+	switch any(zero).(type) {
+	case complex64:
+		task, _ := any(planBatchTasks64.Get()).(*planBatchTask[T])
+		return task
+	default:
+		task, _ := any(planBatchTasks128.Get()).(*planBatchTask[T])
+		return task
+	}
+}
+
+func putPlanBatchTask[T Complex](task *planBatchTask[T]) {
+	task.p = nil
+	task.dst, task.src = nil, nil
+	task.err = nil
+
+	var zero T
+	switch any(zero).(type) {
+	case complex64:
+		planBatchTasks64.Put(task)
+	default:
+		planBatchTasks128.Put(task)
+	}
+}
+
+func (t *planBatchTask[T]) runChunk(c int) {
+	lo, hi := chunkRange(t.count, t.chunks, c)
+	n := t.p.n
+
+	for i := lo; i < hi; i++ {
+		start := i * n
+		end := start + n
+
+		var err error
+		if t.inverse {
+			err = t.p.Inverse(t.dst[start:end], t.src[start:end])
+		} else {
+			err = t.p.Forward(t.dst[start:end], t.src[start:end])
+		}
+
+		if err != nil {
+			t.mu.Lock()
+			if t.err == nil || c < t.errChunk {
+				t.err, t.errChunk = err, c
+			}
+			t.mu.Unlock()
+
+			return
+		}
+	}
+}
+
+// transformBatchParallel splits a contiguous batch across the plan's workers.
+// Plans with fixed scratch (clones, pooled plans) cannot hand out per-worker
+// scratch and always run serially.
+func (p *Plan[T]) transformBatchParallel(dst, src []T, count int, inverse bool) error {
+	workers := min(p.meta.Workers, count)
+	if p.scratchPool == nil {
+		workers = 1
+	}
+
+	task := getPlanBatchTask[T]()
+	task.p = p
+	task.dst, task.src = dst, src
+	task.count = count
+	task.chunks = max(workers, 1)
+	task.inverse = inverse
+
+	runParallel(workers, task.chunks, task)
+
+	err := task.err
+	putPlanBatchTask(task)
+
+	return err
+}
+
+// multiDimBatch runs the PlanOptions.Batch loop of a multi-dimensional plan
+// across workers. The plan itself is shared; like Plan batches drawing scratch
+// sets from the plan's scratchPool, each chunk draws a private workspace from
+// the batch's pool and runs its entries serially in it.
+type multiDimBatch[T Complex, P workspaceTransformer[T]] struct {
+	plan    P
+	workers int
+	errs    []error
+	work    sync.Pool // *[]T of plan.WorkspaceSize() elements
+
+	dst, src     []T
+	size, stride int
+	batch        int
+	inverse      bool
+}
+
+// newMultiDimBatch returns the parallel batch runner for plan, or nil when
+// the batch runs serially. External-workspace plans own no scratch and keep
+// the serial loop, which reports ErrWorkspaceRequired.
+func newMultiDimBatch[T Complex, P workspaceTransformer[T]](plan P, opts PlanOptions) *multiDimBatch[T, P] {
+	workers := min(resolveWorkers(opts.Workers), opts.Batch)
+	if workers <= 1 || opts.Workspace == WorkspaceExternal {
+		return nil
+	}
+
+	size := plan.WorkspaceSize()
+	b := &multiDimBatch[T, P]{plan: plan, workers: workers, errs: make([]error, workers)}
+	b.work.New = func() any {
+		work := NewWorkspace[T](size)
+		return &work
+	}
+
+	return b
+}
+
+func (b *multiDimBatch[T, P]) runChunk(c int) {
+	lo, hi := chunkRange(b.batch, b.workers, c)
+
+	work, _ := b.work.Get().(*[]T)
+	defer b.work.Put(work)
+
+	for i := lo; i < hi; i++ {
+		off := i * b.stride
+
+		err := b.plan.transformWorkspace(b.dst[off:off+b.size], b.src[off:off+b.size], *work, !b.inverse)
+		if err != nil {
+			b.errs[c] = err
+			return
+		}
+	}
+}
+
+// run executes all batch entries, reporting the first error in batch order.
+func (b *multiDimBatch[T, P]) run(dst, src []T, size, batch, stride int, inverse bool) error {
+	last := (batch-1)*stride + size
+	if last > len(src) || last > len(dst) {
+		return ErrLengthMismatch
+	}
+
+	b.dst, b.src = dst, src
+	b.size, b.stride, b.batch = size, stride, batch
+	b.inverse = inverse
+
+	runParallel(b.workers, b.workers, b)
+
+	b.dst, b.src = nil, nil
+
+	var first error
+
+	for _, err := range b.errs {
+		if err != nil {
+			first = err
+			break
+		}
+	}
+
+	clear(b.errs)
+
+	return first
+}
+
+// planLane is the per-worker state of a multi-dimensional plan: its 1D
+// plans, line buffers and the data of the transform in flight. clone returns
+// a lane with cloned 1D plans and its own buffers, so no two lanes share
+// scratch.
+type planLane[L any] interface {
+	clone() L
+}
+
+// lineTransformer is implemented by the multi-dimensional plans:
+// transformLines processes lines [lo, hi) of the given pass using lane l.
+// Lanes are passed by value so that the serial lanes of the workspace
+// transforms stay on the caller's stack.
+type lineTransformer[L any] interface {
+	transformLines(l L, pass, lo, hi int) error
+}
+
+// laneSet splits the independent 1D lines (rows, columns, slices) of one
+// pass of a multi-dimensional transform across per-worker lanes. Lane c only
+// ever touches its own 1D plans and buffers, so lanes can run concurrently.
+// With a single lane every pass runs on the calling goroutine.
+type laneSet[L planLane[L]] struct {
+	owner lineTransformer[L]
+	lanes []L
+	errs  []error
+
+	pass, lines, chunks int
+}
+
+// init sets up one lane per worker, at most one per line: lanes[0] is own
+// and the others are clones of it.
+func (s *laneSet[L]) init(owner lineTransformer[L], own L, workers, lines int) {
+	workers = max(min(workers, lines), 1)
+
+	s.owner = owner
+	s.lanes = make([]L, workers)
+	s.errs = make([]error, workers)
+
+	s.lanes[0] = own
+	for i := 1; i < workers; i++ {
+		s.lanes[i] = own.clone()
+	}
+}
+
+// run runs one pass over lines lines, serially on l when l is non-nil and
+// split across the lanes otherwise. It returns the first error in line
+// order.
+func (s *laneSet[L]) run(l *L, pass, lines int) error {
+	if l != nil {
+		return s.owner.transformLines(*l, pass, 0, lines)
+	}
+
+	s.pass = pass
+	s.lines = lines
+	s.chunks = max(min(len(s.lanes), lines), 1)
+
+	runParallel(s.chunks, s.chunks, s)
+
+	var first error
+
+	for _, err := range s.errs {
+		if err != nil {
+			first = err
+			break
+		}
+	}
+
+	clear(s.errs)
+
+	return first
+}
+
+func (s *laneSet[L]) runChunk(c int) {
+	lo, hi := chunkRange(s.lines, s.chunks, c)
+	s.errs[c] = s.owner.transformLines(s.lanes[c], s.pass, lo, hi)
+}
+
+// clonePlans returns clones of plans.
+func clonePlans[T Complex](plans []*Plan[T]) []*Plan[T] {
+	clones := make([]*Plan[T], len(plans))
+	for i, plan := range plans {
+		clones[i] = plan.Clone()
+	}
+
+	return clones
+}
@@ -0,0 +1,322 @@
+package algofft
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestPlanOptionsWorkers_Resolved(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanWithOptions[complex64](64, PlanOptions{Workers: -1})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if got, want := plan.Meta().Workers, runtime.GOMAXPROCS(0); got != want {
+		t.Fatalf("Meta().Workers = %d, want %d", got, want)
+	}
+}
+
+func TestPlanForwardBatch_ParallelMatchesSerial(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{64, 96, 17} {
+		for _, count := range []int{1, 2, 7, 16} {
+			serial, err := NewPlan64(n)
+			if err != nil {
+				t.Fatalf("NewPlan64(%d) failed: %v", n, err)
+			}
+
+			parallel, err := NewPlanWithOptions[complex128](n, PlanOptions{Workers: 4})
+			if err != nil {
+				t.Fatalf("NewPlanWithOptions(%d) failed: %v", n, err)
+			}
+
+			src := randomComplex128Slice(n*count, uint64(n*count))
+			want := make([]complex128, n*count)
+			got := make([]complex128, n*count)
+
+			if err := serial.ForwardBatch(want, src, count); err != nil {
+				t.Fatalf("serial ForwardBatch failed: %v", err)
+			}
+
+			if err := parallel.ForwardBatch(got, src, count); err != nil {
+				t.Fatalf("parallel ForwardBatch failed: %v", err)
+			}
+
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("n=%d count=%d: forward[%d] = %v, want %v", n, count, i, got[i], want[i])
+				}
+			}
+
+			if err := serial.InverseBatch(want, want, count); err != nil {
+				t.Fatalf("serial InverseBatch failed: %v", err)
+			}
+
+			if err := parallel.InverseBatch(got, got, count); err != nil {
+				t.Fatalf("parallel InverseBatch failed: %v", err)
+			}
+
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("n=%d count=%d: inverse[%d] = %v, want %v", n, count, i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestPlanForwardBatch_ParallelConcurrentCallers(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n     = 128
+		count = 8
+	)
+
+	plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Workers: 3})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	src := make([]complex64, n*count)
+	for i := range src {
+		src[i] = complex(float32(i%13), float32(i%7))
+	}
+
+	want := make([]complex64, n*count)
+	if err := plan.ForwardBatch(want, src, count); err != nil {
+		t.Fatalf("ForwardBatch failed: %v", err)
+	}
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 4)
+
+	for range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			got := make([]complex64, n*count)
+			for range 20 {
+				if err := plan.ForwardBatch(got, src, count); err != nil {
+					errs <- err
+					return
+				}
+
+				for i := range want {
+					if got[i] != want[i] {
+						errs <- errors.New("concurrent parallel batch result differs")
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
+
+func TestMultiDimBatch_ParallelMatchesSerial(t *testing.T) {
+	t.Parallel()
+
+	const batch = 5
+
+	serialOpts := PlanOptions{Batch: batch}
+	parallelOpts := PlanOptions{Batch: batch, Workers: 3}
+
+	s2, _ := NewPlan2DWithOptions[complex128](8, 6, serialOpts)
+	p2, _ := NewPlan2DWithOptions[complex128](8, 6, parallelOpts)
+	s3, _ := NewPlan3DWithOptions[complex128](4, 4, 6, serialOpts)
+	p3, _ := NewPlan3DWithOptions[complex128](4, 4, 6, parallelOpts)
+	sn, _ := NewPlanNDWithOptions[complex128]([]int{3, 4, 5}, serialOpts)
+	pn, _ := NewPlanNDWithOptions[complex128]([]int{3, 4, 5}, parallelOpts)
+
+	cases := []struct {
+		name              string
+		size              int
+		serialFwd, parFwd func(dst, src []complex128) error
+		serialInv, parInv func(dst, src []complex128) error
+	}{
+		{"2D", s2.Len(), s2.Forward, p2.Forward, s2.Inverse, p2.Inverse},
+		{"2DClone", s2.Len(), s2.Forward, p2.Clone().Forward, s2.Inverse, p2.Clone().Inverse},
+		{"3D", s3.Len(), s3.Forward, p3.Forward, s3.Inverse, p3.Inverse},
+		{"ND", sn.Len(), sn.Forward, pn.Forward, sn.Inverse, pn.Inverse},
+	}
+
+	for _, tc := range cases {
+		src := randomComplex128Slice(tc.size*batch, uint64(tc.size))
+		want := make([]complex128, len(src))
+		got := make([]complex128, len(src))
+
+		if err := tc.serialFwd(want, src); err != nil {
+			t.Fatalf("%s serial Forward failed: %v", tc.name, err)
+		}
+
+		if err := tc.parFwd(got, src); err != nil {
+			t.Fatalf("%s parallel Forward failed: %v", tc.name, err)
+		}
+
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("%s forward[%d] = %v, want %v", tc.name, i, got[i], want[i])
+			}
+		}
+
+		_ = tc.serialInv(want, want)
+		_ = tc.parInv(got, got)
+
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("%s inverse[%d] = %v, want %v", tc.name, i, got[i], want[i])
+			}
+		}
+
+		if err := tc.parFwd(got[:tc.size], src); !errors.Is(err, ErrLengthMismatch) {
+			t.Fatalf("%s short dst: got %v, want ErrLengthMismatch", tc.name, err)
+		}
+	}
+
+	// Workers share the plan and its 1D plans instead of cloning them.
+	if p2.batchWorkers.plan != p2 || p3.batchWorkers.plan != p3 || pn.batchWorkers.plan != pn {
+		t.Error("batch workers do not share the owning plan")
+	}
+}
+
+func TestMultiDimBatch_ExternalWorkspaceStaysSerial(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlan2DWithOptions[complex64](4, 4, PlanOptions{Batch: 3, Workers: 3, Workspace: WorkspaceExternal})
+	if err != nil {
+		t.Fatalf("NewPlan2DWithOptions failed: %v", err)
+	}
+
+	data := make([]complex64, 3*plan.Len())
+	if err := plan.Forward(data, data); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("Forward = %v, want ErrWorkspaceRequired", err)
+	}
+}
+
+func TestMultiDimPasses_ParallelMatchesSerial(t *testing.T) {
+	t.Parallel()
+
+	serialOpts := PlanOptions{}
+	parallelOpts := PlanOptions{Workers: 4}
+
+	square, _ := NewPlan2DWithOptions[complex128](16, 16, serialOpts)
+	squarePar, _ := NewPlan2DWithOptions[complex128](16, 16, parallelOpts)
+	rect, _ := NewPlan2DWithOptions[complex128](12, 20, serialOpts)
+	rectPar, _ := NewPlan2DWithOptions[complex128](12, 20, parallelOpts)
+	vol, _ := NewPlan3DWithOptions[complex128](5, 6, 8, serialOpts)
+	volPar, _ := NewPlan3DWithOptions[complex128](5, 6, 8, parallelOpts)
+	nd, _ := NewPlanNDWithOptions[complex128]([]int{3, 4, 5, 6}, serialOpts)
+	ndPar, _ := NewPlanNDWithOptions[complex128]([]int{3, 4, 5, 6}, parallelOpts)
+
+	cases := []struct {
+		name              string
+		size              int
+		serialFwd, parFwd func(dst, src []complex128) error
+		serialInv, parInv func(dst, src []complex128) error
+	}{
+		{"2D-square", square.Len(), square.Forward, squarePar.Forward, square.Inverse, squarePar.Inverse},
+		{"2D-rect", rect.Len(), rect.Forward, rectPar.Forward, rect.Inverse, rectPar.Inverse},
+		{"2D-clone", rect.Len(), rect.Forward, rectPar.Clone().Forward, rect.Inverse, rectPar.Clone().Inverse},
+		{"3D", vol.Len(), vol.Forward, volPar.Forward, vol.Inverse, volPar.Inverse},
+		{"3D-clone", vol.Len(), vol.Forward, volPar.Clone().Forward, vol.Inverse, volPar.Clone().Inverse},
+		{"ND", nd.Len(), nd.Forward, ndPar.Forward, nd.Inverse, ndPar.Inverse},
+		{"ND-clone", nd.Len(), nd.Forward, ndPar.Clone().Forward, nd.Inverse, ndPar.Clone().Inverse},
+	}
+
+	for _, tc := range cases {
+		src := randomComplex128Slice(tc.size, uint64(tc.size)+7)
+		want := make([]complex128, tc.size)
+		got := make([]complex128, tc.size)
+
+		if err := tc.serialFwd(want, src); err != nil {
+			t.Fatalf("%s serial Forward failed: %v", tc.name, err)
+		}
+
+		copy(got, src)
+
+		if err := tc.parFwd(got, got); err != nil {
+			t.Fatalf("%s parallel Forward failed: %v", tc.name, err)
+		}
+
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("%s forward[%d] = %v, want %v", tc.name, i, got[i], want[i])
+			}
+		}
+
+		_ = tc.serialInv(want, want)
+		_ = tc.parInv(got, got)
+
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("%s inverse[%d] = %v, want %v", tc.name, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestRealGridPasses_ParallelMatchesSerial(t *testing.T) {
+	t.Parallel()
+
+	serialOpts := PlanOptions{Batch: 2}
+	parallelOpts := PlanOptions{Batch: 2, Workers: 4}
+
+	rect, _ := NewPlanReal2D64WithOptions(12, 21, serialOpts)
+	rectPar, _ := NewPlanReal2D64WithOptions(12, 21, parallelOpts)
+	vol, _ := NewPlanReal3D64WithOptions(5, 6, 8, serialOpts)
+	volPar, _ := NewPlanReal3D64WithOptions(5, 6, 8, parallelOpts)
+
+	cases := []struct {
+		name              string
+		size, specLen     int
+		serialFwd, parFwd func(dst []complex128, src []float64) error
+		serialInv, parInv func(dst []float64, src []complex128) error
+	}{
+		{"2D", rect.Len(), rect.SpectrumLen(), rect.Forward, rectPar.Forward, rect.Inverse, rectPar.Inverse},
+		{"2D-clone", rect.Len(), rect.SpectrumLen(), rect.Forward, rectPar.Clone().Forward, rect.Inverse, rectPar.Clone().Inverse},
+		{"3D", vol.Len(), vol.SpectrumLen(), vol.Forward, volPar.Forward, vol.Inverse, volPar.Inverse},
+	}
+
+	for _, tc := range cases {
+		src := randomFloat64Slice(2*tc.size, uint64(tc.size)+7)
+		want := make([]complex128, 2*tc.specLen)
+		got := make([]complex128, 2*tc.specLen)
+
+		if err := tc.serialFwd(want, src); err != nil {
+			t.Fatalf("%s serial Forward failed: %v", tc.name, err)
+		}
+
+		if err := tc.parFwd(got, src); err != nil {
+			t.Fatalf("%s parallel Forward failed: %v", tc.name, err)
+		}
+
+		assertEqualComplex128(t, got, want, tc.name+" forward")
+
+		wantOut := make([]float64, len(src))
+		gotOut := make([]float64, len(src))
+
+		_ = tc.serialInv(wantOut, want)
+		_ = tc.parInv(gotOut, got)
+
+		for i := range wantOut {
+			if gotOut[i] != wantOut[i] {
+				t.Fatalf("%s inverse[%d] = %v, want %v", tc.name, i, gotOut[i], wantOut[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package algofft
+
+import (
+	"math/cmplx"
+	"strings"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+func TestPFA_MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{14, 33, 21, 35, 105, 1001} {
+		for _, norm := range []Normalization{NormBackward, NormOrtho, NormNone} {
+			plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Normalization: norm})
+			if err != nil {
+				t.Fatalf("NewPlanWithOptions(%d) failed: %v", n, err)
+			}
+
+			if plan.KernelStrategy() != KernelPFA {
+				t.Fatalf("n=%d: strategy = %v, want KernelPFA", n, plan.KernelStrategy())
+			}
+
+			fwdScale, invScale := normalizationScales(norm, n)
+			src := randomComplex128Slice(n, uint64(n))
+			want := reference.NaiveDFT128(src)
+			got := make([]complex128, n)
+
+			if err := plan.Forward(got, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			for i := range want {
+				if cmplx.Abs(got[i]-want[i]*complex(fwdScale, 0)) > 1e-9*float64(n) {
+					t.Fatalf("n=%d norm=%d: forward[%d] = %v, want %v", n, norm, i, got[i], want[i]*complex(fwdScale, 0))
+				}
+			}
+
+			// Inverse runs in place; NaiveIDFT128 includes the default 1/N.
+			wantInv := reference.NaiveIDFT128(src)
+			copy(got, src)
+
+			if err := plan.Inverse(got, got); err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			for i := range wantInv {
+				if cmplx.Abs(got[i]-wantInv[i]*complex(invScale, 0)) > 1e-9*invScale {
+					t.Fatalf("n=%d norm=%d: inverse[%d] = %v, want %v", n, norm, i, got[i], wantInv[i]*complex(invScale, 0))
+				}
+			}
+		}
+	}
+}
+
+func TestPFA_ForcedStrategy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n    int
+		want bool
+	}{
+		{60, true},    // 4 * 3 * 5
+		{1400, false}, // 8 has no single butterfly
+		{17, false},
+		{256, false},
+	}
+
+	for _, tt := range tests {
+		plan, err := NewPlanWithOptions[complex64](tt.n, PlanOptions{Strategy: KernelPFA})
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions(%d) failed: %v", tt.n, err)
+		}
+
+		if got := plan.KernelStrategy() == KernelPFA; got != tt.want {
+			t.Errorf("n=%d: strategy = %v, want PFA = %v", tt.n, plan.KernelStrategy(), tt.want)
+		}
+
+		if tt.want && !strings.Contains(plan.String(), "PFA") {
+			t.Errorf("n=%d: String() = %q, want PFA", tt.n, plan.String())
+		}
+	}
+}
+
+func TestPFA_MatchesMixedRadix(t *testing.T) {
+	t.Parallel()
+
+	const n = 2310 // 2 * 3 * 5 * 7 * 11
+
+	pfa, err := NewPlanT[complex64](n)
+	if err != nil {
+		t.Fatalf("NewPlanT failed: %v", err)
+	}
+
+	mixed, err := NewPlanWithOptions[complex64](n, PlanOptions{Strategy: KernelDIT})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if pfa.KernelStrategy() != KernelPFA || mixed.KernelStrategy() == KernelPFA {
+		t.Fatalf("strategies = %v, %v, want PFA and mixed radix", pfa.KernelStrategy(), mixed.KernelStrategy())
+	}
+
+	src := make([]complex64, n)
+	for i, v := range randomComplex128Slice(n, 11) {
+		src[i] = complex64(v)
+	}
+
+	want := make([]complex64, n)
+	got := make([]complex64, n)
+
+	if err := mixed.Forward(want, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := pfa.Forward(got, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	for i := range want {
+		if cmplx.Abs(complex128(got[i]-want[i])) > 1e-3 {
+			t.Fatalf("forward[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPFA_MeasureRecordsWisdom(t *testing.T) {
+	t.Parallel()
+
+	wisdom := &memoryWisdom{entries: make(map[WisdomKey]WisdomEntry)}
+
+	measured, err := NewPlanWithOptions[complex128](105, PlanOptions{Planner: PlannerMeasure, Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if len(wisdom.entries) != 1 {
+		t.Fatalf("wisdom has %d entries, want 1", len(wisdom.entries))
+	}
+
+	replayed, err := NewPlanWithOptions[complex128](105, PlanOptions{Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if replayed.KernelStrategy() != measured.KernelStrategy() {
+		t.Errorf("replayed strategy = %v, want %v", replayed.KernelStrategy(), measured.KernelStrategy())
+	}
+}
@@ -147,7 +147,7 @@ func TestPlan_Close_Pooled(t *testing.T) {
 func TestPlanPooled_InvalidLength(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewPlanPooled[complex64](98) // Includes unsupported prime factor
+	_, err := NewPlanPooled[complex64](102) // Includes unsupported prime factor 17
 	if !errors.Is(err, ErrInvalidLength) {
 		t.Errorf("expected ErrInvalidLength, got %v", err)
 	}
@@ -0,0 +1,57 @@
+package algofft
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+// TestPortableStockham_ForceGeneric checks that generic-only plans, as on
+// targets without SIMD codelets, run the portable Stockham codelets.
+func TestPortableStockham_ForceGeneric(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{ForceGeneric: true, Architecture: runtime.GOARCH}
+
+	for _, n := range []int{64, 512, 2048} {
+		plan, err := newPlanWithFeatures[complex64](n, features, PlanOptions{})
+		if err != nil {
+			t.Fatalf("newPlanWithFeatures(%d) failed: %v", n, err)
+		}
+
+		if !strings.HasSuffix(plan.Algorithm(), "_portable") || plan.KernelStrategy() != KernelStockham {
+			t.Fatalf("n=%d: algorithm = %q (%v), want portable Stockham", n, plan.Algorithm(), plan.KernelStrategy())
+		}
+
+		src := make([]complex64, n)
+		for i, v := range randomComplex128Slice(n, uint64(n)) {
+			src[i] = complex64(v)
+		}
+
+		got := make([]complex64, n)
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		assertScaledComplex64(t, got, reference.NaiveDFT(src), 1, 1e-4, "forward")
+
+		if err := plan.Inverse(got, got); err != nil {
+			t.Fatalf("Inverse failed: %v", err)
+		}
+
+		assertScaledComplex64(t, got, src, 1, 1e-5, "round trip")
+	}
+
+	// Forcing DIT still selects a DIT codelet.
+	plan, err := newPlanWithFeatures[complex128](2048, features, PlanOptions{Strategy: KernelDIT})
+	if err != nil {
+		t.Fatalf("newPlanWithFeatures failed: %v", err)
+	}
+
+	if !strings.HasPrefix(plan.Algorithm(), "dit2048") {
+		t.Errorf("forced DIT: algorithm = %q, want a dit2048 codelet", plan.Algorithm())
+	}
+}
@@ -0,0 +1,213 @@
+package algofft
+
+import (
+	"time"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+	"github.com/cwbudde/algo-fft/internal/fft"
+	m "github.com/cwbudde/algo-fft/internal/math"
+)
+
+// Rader's algorithm maps a prime-length DFT onto a cyclic convolution of
+// length N-1. With g a generator of the multiplicative group modulo N,
+// a[q] = x[g^q] and b[q] = W_N^(g^-q):
+//
+//	X[0]      = Σ x[n]
+//	X[g^-r]   = x[0] + (a ⊛ b)[r]   for r = 0..N-2
+//
+// The convolution runs through a child plan of length L = N-1 when N-1
+// factors into the mixed-radix kernels. Otherwise L is a smooth length of at
+// least 2N-3: a is zero-padded and b repeated at the negative indices, so
+// the first N-1 outputs of the length-L cyclic convolution equal a ⊛ b.
+
+// initRader builds the child plan, the index permutations and the
+// pre-transformed convolution filters of a Rader plan.
+func (p *Plan[T]) initRader(features cpu.Features, opts PlanOptions) error {
+	n := p.n
+	g := m.PrimitiveRoot(n)
+	size := fft.RaderConvolutionLength[T](n, features)
+
+	// The child runs unnormalized; the 1/L of its inverse is folded into
+	// the filters below.
+	child, err := NewPlanWithOptions[T](size, PlanOptions{
+		Planner:       opts.Planner,
+		Normalization: NormNone,
+		Wisdom:        opts.Wisdom,
+		Workspace:     opts.Workspace,
+		Verify:        opts.Verify,
+	})
+	if err != nil {
+		return err
+	}
+
+	perm := make([]int, n-1)
+	permInv := make([]int, n-1)
+	gInv := m.PowMod(g, n-2, n)
+
+	for q, fwd, inv := 0, 1, 1; q < n-1; q++ {
+		perm[q] = fwd
+		permInv[q] = inv
+		fwd = fwd * g % n
+		inv = inv * gInv % n
+	}
+
+	// Filters are computed in double precision regardless of T.
+	ref, err := NewPlanWithOptions[complex128](size, PlanOptions{Normalization: NormNone})
+	if err != nil {
+		return err
+	}
+
+	filter := make([]complex128, size)
+	filterInv := make([]complex128, size)
+	twiddle := fft.ComputeTwiddleFactors[complex128](n)
+
+	for q, k := range permInv {
+		filter[q] = twiddle[k]
+		filterInv[q] = fft.ConjugateOf(twiddle[k])
+
+		// b[-q] of the padded convolution
+		if size != n-1 && q > 0 {
+			filter[size-(n-1)+q] = filter[q]
+			filterInv[size-(n-1)+q] = filterInv[q]
+		}
+	}
+
+	if err := ref.Forward(filter, filter); err != nil {
+		return err
+	}
+
+	if err := ref.Forward(filterInv, filterInv); err != nil {
+		return err
+	}
+
+	scale := 1 / float64(size)
+
+	p.raderFilter = make([]T, size)
+	p.raderFilterInv = make([]T, size)
+
+	for i := range filter {
+		p.raderFilter[i] = m.ComplexFromFloat64[T](real(filter[i])*scale, imag(filter[i])*scale)
+		p.raderFilterInv[i] = m.ComplexFromFloat64[T](real(filterInv[i])*scale, imag(filterInv[i])*scale)
+	}
+
+	p.raderPlan = child
+	p.raderPerm = perm
+	p.raderPermInv = permInv
+
+	// The padded convolution runs in the plan's scratch.
+	if size > p.scratchLen {
+		p.growScratch(size)
+	}
+
+	return nil
+}
+
+// raderTransform computes a prime-length DFT via Rader's algorithm, with the
+// kernels' built-in scaling (1/N on the inverse) times scale, applied in the
+// output scatter. scratch needs L elements; work is the child plan's
+// workspace, or nil to use its pooled scratch. dst and src may alias.
+func (p *Plan[T]) raderTransform(dst, src, scratch, work []T, inverse bool, scale float64) error {
+	a := scratch[:len(p.raderFilter)]
+	x0 := src[0]
+	sum := x0
+
+	for q, k := range p.raderPerm {
+		a[q] = src[k]
+		sum += a[q]
+	}
+
+	clear(a[p.n-1:])
+
+	err := p.raderPlan.transformWith(a, a, work, false)
+	if err != nil {
+		return err
+	}
+
+	filter := p.raderFilter
+	if inverse {
+		filter = p.raderFilterInv
+	}
+
+	for i := range a {
+		a[i] *= filter[i]
+	}
+
+	err = p.raderPlan.transformWith(a, a, work, true)
+	if err != nil {
+		return err
+	}
+
+	if inverse {
+		scale /= float64(p.n)
+	}
+
+	if scale != 1 {
+		factor := complexScale[T](scale)
+
+		dst[0] = sum * factor
+		for r, k := range p.raderPermInv {
+			dst[k] = (x0 + a[r]) * factor
+		}
+
+		return nil
+	}
+
+	dst[0] = sum
+	for r, k := range p.raderPermInv {
+		dst[k] = x0 + a[r]
+	}
+
+	return nil
+}
+
+// measurePrime builds both a Rader and a Bluestein plan for the prime n,
+// times them and returns the faster one, recording the choice to wisdom.
+func measurePrime[T Complex](n int, features cpu.Features, opts PlanOptions) (*Plan[T], error) {
+	const iters = 3
+
+	candidates := make([]*Plan[T], 0, 2)
+
+	for _, strategy := range []KernelStrategy{KernelRader, KernelBluestein} {
+		// Forcing the strategy skips this function on the way back in; the
+		// planner mode still tunes the Bluestein padding and Rader child.
+		candOpts := opts
+		candOpts.Strategy = strategy
+		candOpts.Wisdom = nil
+
+		plan, err := newPlanWithFeatures[T](n, features, candOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, plan)
+	}
+
+	src := make([]T, n)
+	dst := make([]T, n)
+
+	for i := range src {
+		src[i] = m.ComplexFromFloat64[T](float64(i%16)/16, float64((i+1)%16)/16)
+	}
+
+	var (
+		best     *Plan[T]
+		bestTime time.Duration
+	)
+
+	for _, plan := range candidates {
+		work := NewWorkspace[T](plan.WorkspaceSize())
+
+		elapsed := timeRuns(iters, func() error { return plan.ForwardWithWorkspace(dst, src, work) })
+		if best == nil || elapsed < bestTime {
+			best, bestTime = plan, elapsed
+		}
+	}
+
+	best.meta.Planner = opts.Planner
+
+	if opts.Wisdom != nil {
+		fft.RecordWisdom[T](n, features, wisdomAdapter{opts.Wisdom}, best.Algorithm())
+	}
+
+	return best, nil
+}
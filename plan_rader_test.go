@@ -0,0 +1,259 @@
+package algofft
+
+import (
+	"math/cmplx"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+func TestRader_MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	// 47, 107 and 1019 have a rough n-1 and run a zero-padded convolution.
+	for _, n := range []int{3, 5, 17, 41, 47, 97, 107, 257, 641, 1019} {
+		for _, norm := range []Normalization{NormBackward, NormOrtho, NormNone} {
+			plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelRader, Normalization: norm})
+			if err != nil {
+				t.Fatalf("NewPlanWithOptions(%d) failed: %v", n, err)
+			}
+
+			if plan.KernelStrategy() != KernelRader {
+				t.Fatalf("n=%d: strategy = %v, want KernelRader", n, plan.KernelStrategy())
+			}
+
+			fwdScale, invScale := normalizationScales(norm, n)
+			src := randomComplex128Slice(n, uint64(n))
+			want := reference.NaiveDFT128(src)
+			got := make([]complex128, n)
+
+			if err := plan.Forward(got, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			for i := range want {
+				if cmplx.Abs(got[i]-want[i]*complex(fwdScale, 0)) > 1e-9*float64(n) {
+					t.Fatalf("n=%d norm=%d: forward[%d] = %v, want %v", n, norm, i, got[i], want[i]*complex(fwdScale, 0))
+				}
+			}
+
+			// Inverse runs in place; NaiveIDFT128 includes the default 1/N.
+			wantInv := reference.NaiveIDFT128(src)
+			copy(got, src)
+
+			if err := plan.Inverse(got, got); err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			for i := range wantInv {
+				if cmplx.Abs(got[i]-wantInv[i]*complex(invScale, 0)) > 1e-9*invScale {
+					t.Fatalf("n=%d norm=%d: inverse[%d] = %v, want %v", n, norm, i, got[i], wantInv[i]*complex(invScale, 0))
+				}
+			}
+		}
+	}
+}
+
+func TestRader_Complex64RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanWithOptions[complex64](257, PlanOptions{Strategy: KernelRader})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	src := make([]complex64, 257)
+	for i, v := range randomComplex128Slice(257, 7) {
+		src[i] = complex64(v)
+	}
+
+	freq := make([]complex64, 257)
+	back := make([]complex64, 257)
+
+	if err := plan.Forward(freq, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := plan.Clone().Inverse(back, freq); err != nil {
+		t.Fatalf("Inverse failed: %v", err)
+	}
+
+	for i := range src {
+		if cmplx.Abs(complex128(back[i]-src[i])) > 1e-5 {
+			t.Fatalf("round trip[%d] = %v, want %v", i, back[i], src[i])
+		}
+	}
+}
+
+func TestRader_StrategySelection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n        int
+		strategy KernelStrategy
+		want     KernelStrategy
+	}{
+		{17, KernelAuto, KernelBluestein},   // below RaderMinSize
+		{257, KernelAuto, KernelRader},      // 256 = 2^8
+		{641, KernelAuto, KernelRader},      // 640 = 2^7 * 5
+		{1153, KernelAuto, KernelRader},     // 1152 = 2^7 * 3^2
+		{1009, KernelAuto, KernelRader},     // 1008 = 2^4 * 3^2 * 7
+		{1019, KernelAuto, KernelBluestein}, // 1018 = 2 * 509
+		{257, KernelBluestein, KernelBluestein},
+		{17, KernelRader, KernelRader},
+		{1019, KernelRader, KernelRader}, // padded convolution
+		{60, KernelRader, KernelDIT},     // not prime, ignored
+	}
+
+	for _, tt := range tests {
+		plan, err := NewPlanWithOptions[complex64](tt.n, PlanOptions{Strategy: tt.strategy})
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions(%d) failed: %v", tt.n, err)
+		}
+
+		if got := plan.KernelStrategy(); got != tt.want {
+			t.Errorf("n=%d forced=%v: strategy = %v, want %v", tt.n, tt.strategy, got, tt.want)
+		}
+	}
+}
+
+func TestRader_MeasureRecordsWisdom(t *testing.T) {
+	t.Parallel()
+
+	wisdom := &memoryWisdom{entries: make(map[WisdomKey]WisdomEntry)}
+
+	measured, err := NewPlanWithOptions[complex64](257, PlanOptions{Planner: PlannerMeasure, Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	strategy := measured.KernelStrategy()
+	if strategy != KernelRader && strategy != KernelBluestein {
+		t.Fatalf("measured strategy = %v, want Rader or Bluestein", strategy)
+	}
+
+	if meta := measured.Meta(); meta.Planner != PlannerMeasure {
+		t.Errorf("Meta().Planner = %v, want PlannerMeasure", meta.Planner)
+	}
+
+	if len(wisdom.entries) != 1 {
+		t.Fatalf("wisdom has %d entries, want 1", len(wisdom.entries))
+	}
+
+	// An estimating planner replays the measured decision.
+	replayed, err := NewPlanWithOptions[complex64](257, PlanOptions{Wisdom: wisdom})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if replayed.KernelStrategy() != strategy {
+		t.Errorf("replayed strategy = %v, want %v", replayed.KernelStrategy(), strategy)
+	}
+}
+
+func TestRader_Workspace(t *testing.T) {
+	t.Parallel()
+
+	// 1019 runs a padded convolution that needs more scratch than n.
+	for _, n := range []int{641, 1019} {
+		ref, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelRader})
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions failed: %v", err)
+		}
+
+		plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelRader, Workspace: WorkspaceExternal})
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions failed: %v", err)
+		}
+
+		work := NewWorkspace[complex128](plan.WorkspaceSize())
+		src := randomComplex128Slice(n, 3)
+		want := make([]complex128, n)
+		got := make([]complex128, n)
+
+		if err := ref.Forward(want, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		if err := plan.ForwardWithWorkspace(got, src, work); err != nil {
+			t.Fatalf("n=%d: ForwardWithWorkspace failed: %v", n, err)
+		}
+
+		assertEqualComplex128(t, got, want, "forward")
+
+		if err := ref.InverseWithWorkspace(want, want, NewWorkspace[complex128](ref.WorkspaceSize())); err != nil {
+			t.Fatalf("InverseWithWorkspace failed: %v", err)
+		}
+
+		if err := plan.InverseWithWorkspace(got, got, work); err != nil {
+			t.Fatalf("n=%d: InverseWithWorkspace failed: %v", n, err)
+		}
+
+		assertEqualComplex128(t, got, want, "inverse")
+	}
+}
+
+func TestRader_MeasureRoughPrime(t *testing.T) {
+	t.Parallel()
+
+	// 4098 = 2·3·683: Rader needs a padded convolution, and the measuring
+	// planner times it against Bluestein.
+	const n = 4099
+
+	measured, err := NewPlanWithOptions[complex128](n, PlanOptions{Planner: PlannerMeasure})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if strategy := measured.KernelStrategy(); strategy != KernelRader && strategy != KernelBluestein {
+		t.Fatalf("measured strategy = %v, want Rader or Bluestein", strategy)
+	}
+
+	rader, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelRader})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if size := rader.raderPlan.Len(); size < 2*n-3 {
+		t.Fatalf("convolution length = %d, want >= %d", size, 2*n-3)
+	}
+
+	src := randomComplex128Slice(n, 11)
+	want := make([]complex128, n)
+	got := make([]complex128, n)
+
+	if err := measured.Forward(want, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := rader.Forward(got, src); err != nil {
+		t.Fatalf("Rader Forward failed: %v", err)
+	}
+
+	for i := range want {
+		if cmplx.Abs(got[i]-want[i]) > 1e-9*float64(n) {
+			t.Fatalf("forward[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+// memoryWisdom is a minimal WisdomStore for planner tests.
+type memoryWisdom struct {
+	entries map[WisdomKey]WisdomEntry
+}
+
+func (w *memoryWisdom) LookupWisdom(size int, precision uint8, cpuFeatures uint64) (string, bool) {
+	entry, ok := w.entries[WisdomKey{Size: size, Precision: precision, CPUFeatures: cpuFeatures}]
+
+	return entry.Algorithm, ok
+}
+
+func (w *memoryWisdom) Lookup(key WisdomKey) (WisdomEntry, bool) {
+	entry, ok := w.entries[key]
+
+	return entry, ok
+}
+
+func (w *memoryWisdom) Store(entry WisdomEntry) {
+	w.entries[entry.Key] = entry
+}
@@ -24,6 +24,11 @@ type PlanReal struct {
 	weight  []complex64
 	buf     []complex64
 	options PlanOptions
+
+	// forwardScale/inverseScale implement PlanOptions.Normalization and are
+	// fused into the pack (forward) and unpack (inverse) copies.
+	forwardScale float64
+	inverseScale float64
 }
 
 // NewPlanReal creates a new real FFT plan for length n.
@@ -46,7 +51,10 @@ func newPlanRealWithFeatures(n int, features cpu.Features, opts PlanOptions) (*P
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	// The real-FFT pack/unpack path uses the child complex plan in-place on p.buf.
+	// External workspaces also cover the child plan, so it keeps no scratch either.
 	childOpts.InPlace = true
+	// Normalization is applied once by the real plan, not by the half-size child.
+	childOpts.Normalization = NormBackward
 
 	plan, err := newPlanWithFeatures[complex64](n/2, features, childOpts)
 	if err != nil {
@@ -61,13 +69,17 @@ func newPlanRealWithFeatures(n int, features cpu.Features, opts PlanOptions) (*P
 		weight[k] = complex64(complex(0.5*(1+math.Sin(theta)), 0.5*math.Cos(theta)))
 	}
 
+	forwardScale, inverseScale := normalizationScales(opts.Normalization, n)
+
 	return &PlanReal{
-		n:       n,
-		half:    n / 2,
-		plan:    plan,
-		weight:  weight,
-		buf:     make([]complex64, n/2),
-		options: opts,
+		n:            n,
+		half:         n / 2,
+		plan:         plan,
+		weight:       weight,
+		buf:          newRealBuffer[complex64](n/2, opts),
+		options:      opts,
+		forwardScale: forwardScale,
+		inverseScale: inverseScale,
 	}, nil
 }
 
@@ -84,12 +96,19 @@ func (p *PlanReal) SpectrumLen() int {
 // Forward computes the real-to-complex FFT.
 // dst must have length N/2+1 and src must have length N.
 func (p *PlanReal) Forward(dst []complex64, src []float32) error {
+	return p.forwardBatch(dst, src, p.buf, nil, p.forwardScale)
+}
+
+// forwardBatch runs Forward's batch/stride loop with the given pack buffer
+// and child-plan workspace (nil = the child plan's pooled scratch),
+// multiplying the spectrum by scale.
+func (p *PlanReal) forwardBatch(dst []complex64, src []float32, buf, work []complex64, scale float64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.forwardSingle(dst, src)
+		return p.forwardWith(dst, src, buf, work, scale)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.n, p.half+1, p.options)
@@ -105,7 +124,7 @@ func (p *PlanReal) Forward(dst []complex64, src []float32) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.forwardSingle(dst[dstOff:dstOff+p.half+1], src[srcOff:srcOff+p.n])
+		err = p.forwardWith(dst[dstOff:dstOff+p.half+1], src[srcOff:srcOff+p.n], buf, work, scale)
 		if err != nil {
 			return err
 		}
@@ -114,41 +133,34 @@ func (p *PlanReal) Forward(dst []complex64, src []float32) error {
 	return nil
 }
 
-// ForwardNormalized computes the real-to-complex FFT and scales the result by 1/N.
+// ForwardNormalized computes the real-to-complex FFT scaled by 1/N. The
+// factor replaces the plan's PlanOptions.Normalization for this call instead
+// of compounding with it, and is applied while packing the input.
 func (p *PlanReal) ForwardNormalized(dst []complex64, src []float32) error {
-	err := p.Forward(dst, src)
-	if err != nil {
-		return err
-	}
-
-	scale := float32(1.0 / float64(p.n))
-	scaleSpectrumComplex64(dst, scale)
-
-	return nil
+	return p.forwardBatch(dst, src, p.buf, nil, 1/float64(p.n))
 }
 
-// ForwardUnitary computes the real-to-complex FFT and scales the result by 1/sqrt(N).
+// ForwardUnitary computes the real-to-complex FFT scaled by 1/sqrt(N),
+// replacing PlanOptions.Normalization like ForwardNormalized.
 func (p *PlanReal) ForwardUnitary(dst []complex64, src []float32) error {
-	err := p.Forward(dst, src)
-	if err != nil {
-		return err
-	}
-
-	scale := float32(1.0 / math.Sqrt(float64(p.n)))
-	scaleSpectrumComplex64(dst, scale)
-
-	return nil
+	return p.forwardBatch(dst, src, p.buf, nil, 1/math.Sqrt(float64(p.n)))
 }
 
 // Inverse computes the complex-to-real inverse FFT.
 // dst must have length N and src must have length N/2+1.
 func (p *PlanReal) Inverse(dst []float32, src []complex64) error {
+	return p.inverseBatch(dst, src, p.buf, nil)
+}
+
+// inverseBatch runs Inverse's batch/stride loop with the given pack buffer
+// and child-plan workspace (nil = the child plan's pooled scratch).
+func (p *PlanReal) inverseBatch(dst []float32, src, buf, work []complex64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.inverseSingle(dst, src)
+		return p.inverseWith(dst, src, buf, work, p.inverseScale)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.n, p.half+1, p.options)
@@ -164,7 +176,7 @@ func (p *PlanReal) Inverse(dst []float32, src []complex64) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.inverseSingle(dst[dstOff:dstOff+p.n], src[srcOff:srcOff+p.half+1])
+		err = p.inverseWith(dst[dstOff:dstOff+p.n], src[srcOff:srcOff+p.half+1], buf, work, p.inverseScale)
 		if err != nil {
 			return err
 		}
@@ -173,7 +185,9 @@ func (p *PlanReal) Inverse(dst []float32, src []complex64) error {
 	return nil
 }
 
-func (p *PlanReal) forwardSingle(dst []complex64, src []float32) error {
+// forwardWith runs a single forward transform through the pack buffer buf,
+// multiplying the spectrum by scale as part of the pack copy.
+func (p *PlanReal) forwardWith(dst []complex64, src []float32, buf, work []complex64, scale float64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -182,15 +196,19 @@ func (p *PlanReal) forwardSingle(dst []complex64, src []float32) error {
 		return ErrLengthMismatch
 	}
 
+	if buf == nil {
+		return ErrWorkspaceRequired
+	}
+
 	srcAsComplex := unsafe.Slice((*complex64)(unsafe.Pointer(&src[0])), p.half)
-	copy(p.buf, srcAsComplex)
+	scaleCopyComplex(buf, srcAsComplex, scale)
 
-	err := p.plan.Forward(p.buf, p.buf)
+	err := p.plan.transformWith(buf, buf, work, false)
 	if err != nil {
 		return err
 	}
 
-	y0 := p.buf[0]
+	y0 := buf[0]
 	y0r := real(y0)
 	y0i := imag(y0)
 	dst[0] = complex(y0r+y0i, 0)
@@ -201,8 +219,8 @@ func (p *PlanReal) forwardSingle(dst []complex64, src []float32) error {
 	// With A[k] = Y[k], B[k] = conj(Y[N/2-k]), and U[k] = 0.5 * (1 + i*W_N^k),
 	// the spectrum is recovered via: X[k] = A[k] - U[k] * (A[k] - B[k]).
 	for k := 1; k < p.half; k++ {
-		a := p.buf[k]
-		bSrc := p.buf[p.half-k]
+		a := buf[k]
+		bSrc := buf[p.half-k]
 		b := complex(real(bSrc), -imag(bSrc)) // conj(Y[N/2-k])
 
 		c := p.weight[k] * (a - b)
@@ -212,7 +230,9 @@ func (p *PlanReal) forwardSingle(dst []complex64, src []float32) error {
 	return nil
 }
 
-func (p *PlanReal) inverseSingle(dst []float32, src []complex64) error {
+// inverseWith runs a single inverse transform through the pack buffer buf,
+// multiplying the output by scale as part of the unpack copy.
+func (p *PlanReal) inverseWith(dst []float32, src, buf, work []complex64, scale float64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -221,29 +241,25 @@ func (p *PlanReal) inverseSingle(dst []float32, src []complex64) error {
 		return ErrLengthMismatch
 	}
 
+	if buf == nil {
+		return ErrWorkspaceRequired
+	}
+
 	const spectrumEps = 1e-4
 
 	if math.Abs(float64(imag(src[0]))) > spectrumEps || math.Abs(float64(imag(src[p.half]))) > spectrumEps {
 		return ErrInvalidSpectrum
 	}
 
-	fft.RepackInverseComplex64(p.buf, src, p.weight)
+	fft.RepackInverseComplex64(buf, src, p.weight)
 
-	err := p.plan.Inverse(p.buf, p.buf)
+	err := p.plan.transformWith(buf, buf, work, true)
 	if err != nil {
 		return err
 	}
 
 	dstAsComplex := unsafe.Slice((*complex64)(unsafe.Pointer(&dst[0])), p.half)
-	copy(dstAsComplex, p.buf)
+	scaleCopyComplex(dstAsComplex, buf, scale)
 
 	return nil
 }
-
-func scaleSpectrumComplex64(dst []complex64, scale float32) {
-	if scale == 1 {
-		return
-	}
-
-	fft.ScaleComplex64InPlace(dst, scale)
-}
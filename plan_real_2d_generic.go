@@ -0,0 +1,129 @@
+package algofft
+
+import (
+	"fmt"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+)
+
+// PlanReal2DT is a generic pre-computed 2D real FFT plan for float32 or
+// float64 input matrices. The forward transform exploits conjugate symmetry
+// by computing only the non-redundant half of the spectrum along the last
+// dimension.
+//
+// Type parameters:
+//   - F: float type (float32 or float64)
+//   - C: complex type (complex64 or complex128), must match F
+//
+// The 2D real FFT uses the row-column decomposition algorithm:
+// - Forward: Real FFT on rows (produces M×(N/2+1) complex), then complex FFT on columns
+// - Inverse: Complex IFFT on columns, then real IFFT on rows
+//
+// Data layout:
+// - Input (real): row-major M×N array
+// - Compact output: row-major M×(N/2+1) array
+// - Full output: row-major M×N array (with redundant conjugate pairs).
+//
+// Any number of columns is supported: odd widths keep (cols+1)/2 bins per
+// row. Transforms do not allocate, and PlanOptions.Workers splits the row
+// and column passes across goroutines. Inverse ignores the imaginary parts
+// of the DC and Nyquist columns that a Hermitian spectrum cancels.
+//
+// A PlanReal2DT reuses internal buffers; for concurrent use, create separate
+// plans via Clone() for each goroutine.
+type PlanReal2DT[F Float, C Complex] struct {
+	grid *realGrid[F, C]
+}
+
+// NewPlanReal2DT creates a 2D real FFT plan for a rows×cols real matrix.
+//
+// Example:
+//
+//	plan, err := algofft.NewPlanReal2DT[float64, complex128](512, 512)
+func NewPlanReal2DT[F Float, C Complex](rows, cols int) (*PlanReal2DT[F, C], error) {
+	return NewPlanReal2DTWithOptions[F, C](rows, cols, PlanOptions{})
+}
+
+// NewPlanReal2DTWithOptions creates a 2D real FFT plan with explicit planner
+// options. Returns ErrInvalidLength if rows or cols is < 1.
+func NewPlanReal2DTWithOptions[F Float, C Complex](rows, cols int, opts PlanOptions) (*PlanReal2DT[F, C], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, ErrInvalidLength
+	}
+
+	grid, err := newRealGrid[F, C]([]int{rows, cols}, cpu.DetectFeatures(), normalizePlanOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlanReal2DT[F, C]{grid: grid}, nil
+}
+
+// Rows returns the number of rows in the input matrix.
+func (p *PlanReal2DT[F, C]) Rows() int {
+	return p.grid.dims[0]
+}
+
+// Cols returns the number of columns in the input matrix.
+func (p *PlanReal2DT[F, C]) Cols() int {
+	return p.grid.dims[1]
+}
+
+// Len returns the total number of real input elements (rows × cols).
+func (p *PlanReal2DT[F, C]) Len() int {
+	return p.grid.n
+}
+
+// SpectrumLen returns the total number of complex values in compact output (rows × (cols/2+1)).
+func (p *PlanReal2DT[F, C]) SpectrumLen() int {
+	return p.grid.specLen()
+}
+
+// String returns a human-readable description of the PlanReal2DT for debugging.
+func (p *PlanReal2DT[F, C]) String() string {
+	return fmt.Sprintf("PlanReal2DT[%s](%dx%d → %dx%d)", realTypeNames[C](), p.Rows(), p.Cols(), p.Rows(), p.grid.halfLast)
+}
+
+// Forward computes the 2D real FFT in compact format: rows×(cols/2+1) bins.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+func (p *PlanReal2DT[F, C]) Forward(dst []C, src []F) error {
+	return p.grid.forward(dst, src)
+}
+
+// ForwardFull computes the 2D real FFT with full rows×cols spectrum output,
+// filling the redundant half from conjugate symmetry.
+func (p *PlanReal2DT[F, C]) ForwardFull(dst []C, src []F) error {
+	return p.grid.forwardFull(dst, src)
+}
+
+// Inverse computes the 2D real IFFT from the compact half-spectrum. src is
+// left unchanged.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+func (p *PlanReal2DT[F, C]) Inverse(dst []F, src []C) error {
+	return p.grid.inverse(dst, src)
+}
+
+// InverseFull computes the 2D real IFFT from a full rows×cols spectrum.
+// Only the non-redundant half is used; the rest is ignored.
+func (p *PlanReal2DT[F, C]) InverseFull(dst []F, src []C) error {
+	return p.grid.inverseFull(dst, src)
+}
+
+// Clone creates an independent copy of the plan, with its own buffers and
+// child plans, for use in another goroutine.
+func (p *PlanReal2DT[F, C]) Clone() *PlanReal2DT[F, C] {
+	return &PlanReal2DT[F, C]{grid: p.grid.clone()}
+}
+
+// realTypeNames returns the float→complex type pair of a real plan.
+func realTypeNames[C Complex]() string {
+	if isSingle[C]() {
+		return "float32→complex64"
+	}
+
+	return "float64→complex128"
+}
@@ -0,0 +1,270 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// referenceRealSpectrum evaluates the full DFT of the real row-major data x
+// with the reference dftBin.
+func referenceRealSpectrum(x []float64, dims []int) []complex128 {
+	input := make([]complex128, len(x))
+	for i, v := range x {
+		input[i] = complex(v, 0)
+	}
+
+	index := make([]int, len(dims))
+	want := make([]complex128, len(x))
+
+	for k := range want {
+		unravelIndex(index, dims, k)
+		want[k] = dftBin(input, dims, index, false)
+	}
+
+	return want
+}
+
+// randomFloat64Slice returns n values in [-1, 1) from the shared seeded
+// generator.
+func randomFloat64Slice(n int, seed uint64) []float64 {
+	out := make([]float64, n)
+	for i, v := range randomComplex128Slice(n, seed) {
+		out[i] = real(v)
+	}
+
+	return out
+}
+
+func TestPlanReal2DT_MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range [][2]int{{1, 1}, {1, 8}, {4, 1}, {8, 8}, {5, 6}, {6, 7}, {16, 12}, {3, 45}} {
+		rows, cols := size[0], size[1]
+		name := fmt.Sprintf("%dx%d", rows, cols)
+
+		plan, err := NewPlanReal2D64(rows, cols)
+		if err != nil {
+			t.Fatalf("%s: NewPlanReal2D64 failed: %v", name, err)
+		}
+
+		halfCols := cols/2 + 1
+		if plan.Rows() != rows || plan.Cols() != cols || plan.Len() != rows*cols || plan.SpectrumLen() != rows*halfCols {
+			t.Fatalf("%s: Rows/Cols/Len/SpectrumLen = %d/%d/%d/%d", name, plan.Rows(), plan.Cols(), plan.Len(), plan.SpectrumLen())
+		}
+
+		src := randomFloat64Slice(rows*cols, uint64(rows*cols))
+		want := referenceRealSpectrum(src, []int{rows, cols})
+		tol := 1e-12 * float64(rows*cols)
+
+		compact := make([]complex128, plan.SpectrumLen())
+		if err := plan.Forward(compact, src); err != nil {
+			t.Fatalf("%s: Forward failed: %v", name, err)
+		}
+
+		for r := range rows {
+			for c := range halfCols {
+				if got := compact[r*halfCols+c]; cmplx.Abs(got-want[r*cols+c]) > tol {
+					t.Fatalf("%s: X[%d,%d] = %v, want %v", name, r, c, got, want[r*cols+c])
+				}
+			}
+		}
+
+		full := make([]complex128, rows*cols)
+		if err := plan.ForwardFull(full, src); err != nil {
+			t.Fatalf("%s: ForwardFull failed: %v", name, err)
+		}
+
+		for k := range want {
+			if cmplx.Abs(full[k]-want[k]) > tol {
+				t.Fatalf("%s: full X[%d] = %v, want %v", name, k, full[k], want[k])
+			}
+		}
+
+		got := make([]float64, rows*cols)
+
+		for _, inverse := range []func() error{
+			func() error { return plan.Inverse(got, compact) },
+			func() error { return plan.InverseFull(got, full) },
+		} {
+			clear(got)
+
+			if err := inverse(); err != nil {
+				t.Fatalf("%s: inverse failed: %v", name, err)
+			}
+
+			for i := range src {
+				if math.Abs(got[i]-src[i]) > tol {
+					t.Fatalf("%s: round trip[%d] = %v, want %v", name, i, got[i], src[i])
+				}
+			}
+		}
+	}
+}
+
+func TestPlanReal2DT_Float32MatchesPlanReal2D(t *testing.T) {
+	t.Parallel()
+
+	generic, err := NewPlanReal2DT[float32, complex64](8, 16)
+	if err != nil {
+		t.Fatalf("NewPlanReal2DT failed: %v", err)
+	}
+
+	legacy, err := NewPlanReal2D(8, 16)
+	if err != nil {
+		t.Fatalf("NewPlanReal2D failed: %v", err)
+	}
+
+	src := randomFloat32Slice(128, 21)
+	got := make([]complex64, generic.SpectrumLen())
+	want := make([]complex64, legacy.SpectrumLen())
+
+	if err := generic.Forward(got, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := legacy.Forward(want, src); err != nil {
+		t.Fatalf("PlanReal2D.Forward failed: %v", err)
+	}
+
+	assertScaledComplex64(t, got, want, 1, 1e-5, "forward")
+
+	if s := generic.String(); s != "PlanReal2DT[float32→complex64](8x16 → 8x9)" {
+		t.Errorf("String = %q", s)
+	}
+}
+
+func TestPlanReal2DT_NormalizationAndBatch(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols, batch = 6, 10, 3
+
+	src := randomFloat64Slice(batch*rows*cols, 8)
+
+	for _, mode := range []Normalization{NormBackward, NormForward, NormOrtho, NormNone} {
+		plan, err := NewPlanReal2D64WithOptions(rows, cols, PlanOptions{Normalization: mode, Batch: batch})
+		if err != nil {
+			t.Fatalf("%v: NewPlanReal2D64WithOptions failed: %v", mode, err)
+		}
+
+		forwardScale, inverseScale := normalizationScales(mode, rows*cols)
+		spec := make([]complex128, batch*plan.SpectrumLen())
+
+		if err := plan.Forward(spec, src); err != nil {
+			t.Fatalf("%v: Forward failed: %v", mode, err)
+		}
+
+		for b := range batch {
+			want := referenceRealSpectrum(src[b*rows*cols:(b+1)*rows*cols], []int{rows, cols})
+			got := spec[b*plan.SpectrumLen():]
+
+			for r := range rows {
+				for c := range cols/2 + 1 {
+					expected := want[r*cols+c] * complex(forwardScale, 0)
+					if cmplx.Abs(got[r*(cols/2+1)+c]-expected) > 1e-10 {
+						t.Fatalf("%v batch %d: X[%d,%d] = %v, want %v", mode, b, r, c, got[r*(cols/2+1)+c], expected)
+					}
+				}
+			}
+		}
+
+		back := make([]float64, len(src))
+		if err := plan.Inverse(back, spec); err != nil {
+			t.Fatalf("%v: Inverse failed: %v", mode, err)
+		}
+
+		for i := range src {
+			if want := src[i] * forwardScale * inverseScale; math.Abs(back[i]-want) > 1e-10 {
+				t.Fatalf("%v: round trip[%d] = %v, want %v", mode, i, back[i], want)
+			}
+		}
+	}
+}
+
+func TestPlanReal2DT_LargeValuesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Column passes leave rounding residue far above the row plan's absolute
+	// DC check at this magnitude.
+	plan, err := NewPlanReal2D64(128, 130)
+	if err != nil {
+		t.Fatalf("NewPlanReal2D64 failed: %v", err)
+	}
+
+	src := randomFloat64Slice(plan.Len(), 4)
+	for i := range src {
+		src[i] = 4096 + 1e3*src[i]
+	}
+
+	spec := make([]complex128, plan.SpectrumLen())
+	back := make([]float64, plan.Len())
+
+	if err := plan.Forward(spec, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := plan.Inverse(back, spec); err != nil {
+		t.Fatalf("Inverse failed: %v", err)
+	}
+
+	for i := range src {
+		if math.Abs(back[i]-src[i]) > 1e-9 {
+			t.Fatalf("round trip[%d] = %v, want %v", i, back[i], src[i])
+		}
+	}
+}
+
+func TestPlanReal2DT_Clone(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanReal2D64(12, 9)
+	if err != nil {
+		t.Fatalf("NewPlanReal2D64 failed: %v", err)
+	}
+
+	clone := plan.Clone()
+	src := randomFloat64Slice(108, 2)
+	want := make([]complex128, plan.SpectrumLen())
+	got := make([]complex128, clone.SpectrumLen())
+
+	if err := plan.Forward(want, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := clone.Forward(got, src); err != nil {
+		t.Fatalf("clone Forward failed: %v", err)
+	}
+
+	assertScaledComplex128(t, got, want, 1, 0, "clone")
+
+	if &clone.grid.spec[0] == &plan.grid.spec[0] || clone.grid.rowPlan == plan.grid.rowPlan {
+		t.Error("clone shares buffers or child plans")
+	}
+}
+
+func TestPlanReal2DT_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPlanReal2D64(0, 8); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("rows 0: %v, want ErrInvalidLength", err)
+	}
+
+	plan, err := NewPlanReal2D64(4, 8)
+	if err != nil {
+		t.Fatalf("NewPlanReal2D64 failed: %v", err)
+	}
+
+	if err := plan.Forward(nil, make([]float64, 32)); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: %v, want ErrNilSlice", err)
+	}
+
+	if err := plan.Forward(make([]complex128, 19), make([]float64, 32)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short spectrum: %v, want ErrLengthMismatch", err)
+	}
+
+	if err := plan.InverseFull(make([]float64, 32), make([]complex128, 20)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("compact as full: %v, want ErrLengthMismatch", err)
+	}
+}
@@ -0,0 +1,110 @@
+package algofft
+
+import (
+	"fmt"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+)
+
+// PlanReal3DT is a generic pre-computed 3D real FFT plan for float32 or
+// float64 input volumes, computing the non-redundant half of the spectrum
+// along the last dimension.
+//
+// The 3D real FFT uses the dimension-by-dimension decomposition algorithm:
+// - Forward: Real FFT along width (innermost), then complex FFT along height and depth
+// - Inverse: Complex IFFT along depth and height, then real IFFT along width
+//
+// Data layout:
+// - Input (real): row-major D×H×W array
+// - Compact output: row-major D×H×(W/2+1) array
+// - Full output: row-major D×H×W array (with redundant conjugate pairs).
+//
+// See PlanReal2DT for odd widths, Workers and concurrent use.
+type PlanReal3DT[F Float, C Complex] struct {
+	grid *realGrid[F, C]
+}
+
+// NewPlanReal3DT creates a 3D real FFT plan for a depth×height×width real
+// volume.
+func NewPlanReal3DT[F Float, C Complex](depth, height, width int) (*PlanReal3DT[F, C], error) {
+	return NewPlanReal3DTWithOptions[F, C](depth, height, width, PlanOptions{})
+}
+
+// NewPlanReal3DTWithOptions creates a 3D real FFT plan with explicit planner
+// options. Returns ErrInvalidLength if any dimension is < 1.
+func NewPlanReal3DTWithOptions[F Float, C Complex](depth, height, width int, opts PlanOptions) (*PlanReal3DT[F, C], error) {
+	if depth <= 0 || height <= 0 || width <= 0 {
+		return nil, ErrInvalidLength
+	}
+
+	grid, err := newRealGrid[F, C]([]int{depth, height, width}, cpu.DetectFeatures(), normalizePlanOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlanReal3DT[F, C]{grid: grid}, nil
+}
+
+// Depth returns the depth dimension of the input volume.
+func (p *PlanReal3DT[F, C]) Depth() int {
+	return p.grid.dims[0]
+}
+
+// Height returns the height dimension of the input volume.
+func (p *PlanReal3DT[F, C]) Height() int {
+	return p.grid.dims[1]
+}
+
+// Width returns the width dimension of the input volume.
+func (p *PlanReal3DT[F, C]) Width() int {
+	return p.grid.dims[2]
+}
+
+// Len returns the total number of real input elements (depth × height × width).
+func (p *PlanReal3DT[F, C]) Len() int {
+	return p.grid.n
+}
+
+// SpectrumLen returns the total number of complex values in compact output.
+func (p *PlanReal3DT[F, C]) SpectrumLen() int {
+	return p.grid.specLen()
+}
+
+// String returns a human-readable description of the PlanReal3DT for debugging.
+func (p *PlanReal3DT[F, C]) String() string {
+	return fmt.Sprintf("PlanReal3DT[%s](%dx%dx%d → %dx%dx%d)", realTypeNames[C](),
+		p.Depth(), p.Height(), p.Width(), p.Depth(), p.Height(), p.grid.halfLast)
+}
+
+// Forward computes the 3D real FFT in compact format:
+// depth×height×(width/2+1) bins.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if slice lengths don't match plan dimensions.
+func (p *PlanReal3DT[F, C]) Forward(dst []C, src []F) error {
+	return p.grid.forward(dst, src)
+}
+
+// ForwardFull computes the 3D real FFT with full depth×height×width
+// spectrum output, filling the redundant half from conjugate symmetry.
+func (p *PlanReal3DT[F, C]) ForwardFull(dst []C, src []F) error {
+	return p.grid.forwardFull(dst, src)
+}
+
+// Inverse computes the 3D real IFFT from the compact half-spectrum. src is
+// left unchanged.
+func (p *PlanReal3DT[F, C]) Inverse(dst []F, src []C) error {
+	return p.grid.inverse(dst, src)
+}
+
+// InverseFull computes the 3D real IFFT from a full spectrum. Only the
+// non-redundant half is used; the rest is ignored.
+func (p *PlanReal3DT[F, C]) InverseFull(dst []F, src []C) error {
+	return p.grid.inverseFull(dst, src)
+}
+
+// Clone creates an independent copy of the plan, with its own buffers and
+// child plans, for use in another goroutine.
+func (p *PlanReal3DT[F, C]) Clone() *PlanReal3DT[F, C] {
+	return &PlanReal3DT[F, C]{grid: p.grid.clone()}
+}
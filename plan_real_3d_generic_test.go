@@ -0,0 +1,135 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestPlanReal3DT_MatchesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range [][3]int{{1, 1, 1}, {2, 3, 4}, {4, 4, 8}, {3, 5, 7}, {2, 1, 6}} {
+		depth, height, width := size[0], size[1], size[2]
+		name := fmt.Sprintf("%dx%dx%d", depth, height, width)
+
+		plan, err := NewPlanReal3D64(depth, height, width)
+		if err != nil {
+			t.Fatalf("%s: NewPlanReal3D64 failed: %v", name, err)
+		}
+
+		halfWidth := width/2 + 1
+		if plan.Depth() != depth || plan.Height() != height || plan.Width() != width || plan.SpectrumLen() != depth*height*halfWidth {
+			t.Fatalf("%s: Depth/Height/Width/SpectrumLen = %d/%d/%d/%d", name, plan.Depth(), plan.Height(), plan.Width(), plan.SpectrumLen())
+		}
+
+		n := plan.Len()
+		src := randomFloat64Slice(n, uint64(n))
+		want := referenceRealSpectrum(src, []int{depth, height, width})
+		tol := 1e-12 * float64(n)
+
+		compact := make([]complex128, plan.SpectrumLen())
+		if err := plan.Forward(compact, src); err != nil {
+			t.Fatalf("%s: Forward failed: %v", name, err)
+		}
+
+		for row := range depth * height {
+			for w := range halfWidth {
+				if got := compact[row*halfWidth+w]; cmplx.Abs(got-want[row*width+w]) > tol {
+					t.Fatalf("%s: X[%d,%d] = %v, want %v", name, row, w, got, want[row*width+w])
+				}
+			}
+		}
+
+		full := make([]complex128, n)
+		if err := plan.ForwardFull(full, src); err != nil {
+			t.Fatalf("%s: ForwardFull failed: %v", name, err)
+		}
+
+		for k := range want {
+			if cmplx.Abs(full[k]-want[k]) > tol {
+				t.Fatalf("%s: full X[%d] = %v, want %v", name, k, full[k], want[k])
+			}
+		}
+
+		got := make([]float64, n)
+		if err := plan.InverseFull(got, full); err != nil {
+			t.Fatalf("%s: InverseFull failed: %v", name, err)
+		}
+
+		for i := range src {
+			if math.Abs(got[i]-src[i]) > tol {
+				t.Fatalf("%s: round trip[%d] = %v, want %v", name, i, got[i], src[i])
+			}
+		}
+	}
+}
+
+func TestPlanReal3DT_Float32(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanReal3DTWithOptions[float32, complex64](4, 6, 10, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlanReal3DTWithOptions failed: %v", err)
+	}
+
+	legacy, err := NewPlanReal3DWithOptions(4, 6, 10, PlanOptions{Normalization: NormOrtho})
+	if err != nil {
+		t.Fatalf("NewPlanReal3DWithOptions failed: %v", err)
+	}
+
+	src := randomFloat32Slice(plan.Len(), 13)
+	got := make([]complex64, plan.SpectrumLen())
+	want := make([]complex64, legacy.SpectrumLen())
+
+	if err := plan.Forward(got, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	if err := legacy.Forward(want, src); err != nil {
+		t.Fatalf("PlanReal3D.Forward failed: %v", err)
+	}
+
+	assertScaledComplex64(t, got, want, 1, 1e-5, "forward")
+
+	back := make([]float32, plan.Len())
+	if err := plan.Inverse(back, got); err != nil {
+		t.Fatalf("Inverse failed: %v", err)
+	}
+
+	assertScaledFloat32(t, back, src, 1, 1e-5, "round trip")
+
+	clone := plan.Clone()
+	if err := clone.Forward(want, src); err != nil {
+		t.Fatalf("clone Forward failed: %v", err)
+	}
+
+	assertScaledComplex64(t, want, got, 1, 0, "clone")
+}
+
+func TestPlanReal3DT_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPlanReal3D64(2, -1, 4); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("height -1: %v, want ErrInvalidLength", err)
+	}
+
+	plan, err := NewPlanReal3D64(2, 2, 4)
+	if err != nil {
+		t.Fatalf("NewPlanReal3D64 failed: %v", err)
+	}
+
+	if s := plan.String(); s != "PlanReal3DT[float64→complex128](2x2x4 → 2x2x3)" {
+		t.Errorf("String = %q", s)
+	}
+
+	if err := plan.Inverse(make([]float64, 16), nil); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil src: %v, want ErrNilSlice", err)
+	}
+
+	if err := plan.ForwardFull(make([]complex128, 12), make([]float64, 16)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("compact as full: %v, want ErrLengthMismatch", err)
+	}
+}
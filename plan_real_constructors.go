@@ -41,3 +41,27 @@ func NewPlanReal64(n int) (*PlanRealT[float64, complex128], error) {
 func NewPlanReal64WithOptions(n int, opts PlanOptions) (*PlanRealT[float64, complex128], error) {
 	return NewPlanRealTWithOptions[float64, complex128](n, opts)
 }
+
+// NewPlanReal2D64 creates a double-precision 2D real FFT plan for a
+// rows×cols float64 matrix. This is equivalent to
+// NewPlanReal2DT[float64, complex128](rows, cols).
+func NewPlanReal2D64(rows, cols int) (*PlanReal2DT[float64, complex128], error) {
+	return NewPlanReal2DT[float64, complex128](rows, cols)
+}
+
+// NewPlanReal2D64WithOptions creates a double-precision 2D real FFT plan with planner options.
+func NewPlanReal2D64WithOptions(rows, cols int, opts PlanOptions) (*PlanReal2DT[float64, complex128], error) {
+	return NewPlanReal2DTWithOptions[float64, complex128](rows, cols, opts)
+}
+
+// NewPlanReal3D64 creates a double-precision 3D real FFT plan for a
+// depth×height×width float64 volume. This is equivalent to
+// NewPlanReal3DT[float64, complex128](depth, height, width).
+func NewPlanReal3D64(depth, height, width int) (*PlanReal3DT[float64, complex128], error) {
+	return NewPlanReal3DT[float64, complex128](depth, height, width)
+}
+
+// NewPlanReal3D64WithOptions creates a double-precision 3D real FFT plan with planner options.
+func NewPlanReal3D64WithOptions(depth, height, width int, opts PlanOptions) (*PlanReal3DT[float64, complex128], error) {
+	return NewPlanReal3DTWithOptions[float64, complex128](depth, height, width, opts)
+}
@@ -67,9 +67,9 @@ func TestNewPlanReal32WithOptions_InvalidLength(t *testing.T) {
 		t.Error("expected error for n=0, got nil")
 	}
 
-	_, err = NewPlanReal32WithOptions(7, PlanOptions{})
+	_, err = NewPlanReal32WithOptions(-4, PlanOptions{})
 	if err == nil {
-		t.Error("expected error for odd n, got nil")
+		t.Error("expected error for negative n, got nil")
 	}
 }
 
@@ -82,9 +82,9 @@ func TestNewPlanReal64WithOptions_InvalidLength(t *testing.T) {
 		t.Error("expected error for n=0, got nil")
 	}
 
-	_, err = NewPlanReal64WithOptions(7, PlanOptions{})
+	_, err = NewPlanReal64WithOptions(-4, PlanOptions{})
 	if err == nil {
-		t.Error("expected error for odd n, got nil")
+		t.Error("expected error for negative n, got nil")
 	}
 }
 
@@ -20,6 +20,8 @@ import (
 //	X[k] = conj(X[N-k]) for k = 1..N/2-1
 //
 // Index 0 is DC and index N/2 is Nyquist (purely real for even N).
+// Odd N is supported as well; the spectrum then has (N+1)/2 bins and no
+// Nyquist bin.
 type PlanRealT[F Float, C Complex] struct {
 	n    int
 	half int
@@ -28,6 +30,26 @@ type PlanRealT[F Float, C Complex] struct {
 	weight  []C
 	buf     []C
 	options PlanOptions
+
+	// forwardScale/inverseScale implement PlanOptions.Normalization and are
+	// fused into the pack (forward) and unpack (inverse) copies.
+	forwardScale float64
+	inverseScale float64
+
+	// manyReal/manySpectrum are gather buffers for strided ForwardMany and
+	// InverseMany layouts, allocated on first use.
+	manyReal     []F
+	manySpectrum []C
+
+	// packed holds the complex spectrum of the packed methods for formats
+	// other than RealFormatCCS, allocated on first use.
+	packed []C
+
+	// high computes a PlanOptions.AccuracyHigh float32 plan in float64 (nil
+	// otherwise), with highRoots for the recombination weights; plan and
+	// weight are then unused.
+	high      *highAccuracy
+	highRoots unitRoots
 }
 
 // NewPlanRealT creates a new generic real FFT plan for length n.
@@ -51,15 +73,42 @@ func NewPlanRealTWithOptions[F Float, C Complex](n int, opts PlanOptions) (*Plan
 }
 
 func newPlanRealTWithFeatures[F Float, C Complex](n int, features cpu.Features, opts PlanOptions) (*PlanRealT[F, C], error) {
-	if n < 2 || n%2 != 0 {
+	if n < 1 {
 		return nil, ErrInvalidLength
 	}
 
+	if opts.Accuracy == AccuracyHigh && isSingle[C]() {
+		return newHighAccuracyRealPlan[F, C](n, features, opts)
+	}
+
 	childOpts := opts
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	// The real-FFT pack/unpack path uses the child complex plan in-place on p.buf.
+	// External workspaces also cover the child plan, so it keeps no scratch either.
 	childOpts.InPlace = true
+	// Normalization is applied once by the real plan, not by the half-size child.
+	childOpts.Normalization = NormBackward
+
+	forwardScale, inverseScale := normalizationScales(opts.Normalization, n)
+
+	if n%2 != 0 {
+		// Odd lengths run a full-size complex transform on the promoted input.
+		plan, err := newPlanWithFeatures[C](n, features, childOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		return &PlanRealT[F, C]{
+			n:            n,
+			half:         n / 2,
+			plan:         plan,
+			buf:          newRealBuffer[C](n, opts),
+			options:      opts,
+			forwardScale: forwardScale,
+			inverseScale: inverseScale,
+		}, nil
+	}
 
 	plan, err := newPlanWithFeatures[C](n/2, features, childOpts)
 	if err != nil {
@@ -87,21 +136,48 @@ func newPlanRealTWithFeatures[F Float, C Complex](n int, features cpu.Features,
 	}
 
 	return &PlanRealT[F, C]{
-		n:       n,
-		half:    n / 2,
-		plan:    plan,
-		weight:  weight,
-		buf:     make([]C, n/2),
-		options: opts,
+		n:            n,
+		half:         n / 2,
+		plan:         plan,
+		weight:       weight,
+		buf:          newRealBuffer[C](n/2, opts),
+		options:      opts,
+		forwardScale: forwardScale,
+		inverseScale: inverseScale,
 	}, nil
 }
 
+// newRealBuffer allocates the pack buffer, which WorkspaceExternal plans
+// carve from the caller's workspace instead.
+func newRealBuffer[C Complex](n int, opts PlanOptions) []C {
+	if opts.Workspace == WorkspaceExternal {
+		return nil
+	}
+
+	return make([]C, n)
+}
+
+// clone returns a copy of p with its own pack buffer and child plans, for
+// the multi-dimensional plans' Clone methods. High-accuracy child plans are
+// pooled and stay shared.
+func (p *PlanRealT[F, C]) clone() *PlanRealT[F, C] {
+	c := *p
+	c.buf = newRealBuffer[C](p.bufLen(), p.options)
+	c.manyReal, c.manySpectrum, c.packed = nil, nil, nil
+
+	if p.plan != nil {
+		c.plan = p.plan.Clone()
+	}
+
+	return &c
+}
+
 // Len returns the number of real samples for this plan.
 func (p *PlanRealT[F, C]) Len() int {
 	return p.n
 }
 
-// SpectrumLen returns the number of complex frequency bins (N/2+1).
+// SpectrumLen returns the number of complex frequency bins (N/2+1, rounded down).
 func (p *PlanRealT[F, C]) SpectrumLen() int {
 	return p.half + 1
 }
@@ -109,12 +185,19 @@ func (p *PlanRealT[F, C]) SpectrumLen() int {
 // Forward computes the real-to-complex FFT.
 // dst must have length N/2+1 and src must have length N.
 func (p *PlanRealT[F, C]) Forward(dst []C, src []F) error {
+	return p.forwardBatch(dst, src, p.buf, nil, p.forwardScale)
+}
+
+// forwardBatch runs Forward's batch/stride loop with the given pack buffer
+// and child-plan workspace (nil = the child plan's pooled scratch). The
+// spectrum is multiplied by scale.
+func (p *PlanRealT[F, C]) forwardBatch(dst []C, src []F, buf, work []C, scale float64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.forwardSingle(dst, src)
+		return p.forwardWith(dst, src, buf, work, scale)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.n, p.half+1, p.options)
@@ -130,7 +213,7 @@ func (p *PlanRealT[F, C]) Forward(dst []C, src []F) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.forwardSingle(dst[dstOff:dstOff+p.half+1], src[srcOff:srcOff+p.n])
+		err = p.forwardWith(dst[dstOff:dstOff+p.half+1], src[srcOff:srcOff+p.n], buf, work, scale)
 		if err != nil {
 			return err
 		}
@@ -140,6 +223,12 @@ func (p *PlanRealT[F, C]) Forward(dst []C, src []F) error {
 }
 
 func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
+	return p.forwardWith(dst, src, p.buf, nil, p.forwardScale)
+}
+
+// forwardWith runs a single forward transform through the pack buffer buf,
+// multiplying the spectrum by scale.
+func (p *PlanRealT[F, C]) forwardWith(dst []C, src []F, buf, work []C, scale float64) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -148,31 +237,44 @@ func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
 		return ErrLengthMismatch
 	}
 
+	if buf == nil {
+		return ErrWorkspaceRequired
+	}
+
+	if p.high != nil {
+		return p.highForward(dst, src, buf, work, scale)
+	}
+
+	if p.n%2 != 0 {
+		return p.forwardOdd(dst, src, buf, work, scale)
+	}
+
 	// Pack real samples into complex buffer: z[k] = src[2k] + i*src[2k+1]
 	// Memory layout of []float32{r0,i0,r1,i1,...} is identical to []complex64,
 	// so we can use unsafe.Slice to reinterpret and copy efficiently.
+	// The transform is linear, so the scale is applied here.
 	var zero C
 	switch any(zero).(type) {
 	case complex64:
 		srcF32 := any(src).([]float32)
-		bufC64 := any(p.buf).([]complex64)
+		bufC64 := any(buf).([]complex64)
 		srcAsComplex := unsafe.Slice((*complex64)(unsafe.Pointer(&srcF32[0])), p.half)
-		copy(bufC64, srcAsComplex)
+		scaleCopyComplex(bufC64, srcAsComplex, scale)
 	case complex128:
 		srcF64 := any(src).([]float64)
-		bufC128 := any(p.buf).([]complex128)
+		bufC128 := any(buf).([]complex128)
 		srcAsComplex := unsafe.Slice((*complex128)(unsafe.Pointer(&srcF64[0])), p.half)
-		copy(bufC128, srcAsComplex)
+		scaleCopyComplex(bufC128, srcAsComplex, scale)
 	}
 
 	// Perform N/2 complex FFT
-	err := p.plan.Forward(p.buf, p.buf)
+	err := p.plan.transformWith(buf, buf, work, false)
 	if err != nil {
 		return err
 	}
 
 	// Extract DC and Nyquist bins
-	y0 := p.buf[0]
+	y0 := buf[0]
 
 	switch any(zero).(type) {
 	case complex64:
@@ -197,7 +299,7 @@ func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
 	// the spectrum is recovered via: X[k] = A[k] - U[k] * (A[k] - B[k]).
 	switch any(zero).(type) {
 	case complex64:
-		bufC64 := any(p.buf).([]complex64)
+		bufC64 := any(buf).([]complex64)
 		dstC64 := any(dst).([]complex64)
 
 		weightC64 := any(p.weight).([]complex64)
@@ -210,7 +312,7 @@ func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
 			dstC64[k] = a - c
 		}
 	case complex128:
-		bufC128 := any(p.buf).([]complex128)
+		bufC128 := any(buf).([]complex128)
 		dstC128 := any(dst).([]complex128)
 
 		weightC128 := any(p.weight).([]complex128)
@@ -227,41 +329,34 @@ func (p *PlanRealT[F, C]) forwardSingle(dst []C, src []F) error {
 	return nil
 }
 
-// ForwardNormalized computes the real-to-complex FFT and scales the result by 1/N.
+// ForwardNormalized computes the real-to-complex FFT scaled by 1/N. The
+// factor replaces the plan's PlanOptions.Normalization for this call instead
+// of compounding with it, and is applied while packing the input.
 func (p *PlanRealT[F, C]) ForwardNormalized(dst []C, src []F) error {
-	err := p.Forward(dst, src)
-	if err != nil {
-		return err
-	}
-
-	scale := 1.0 / float64(p.n)
-	scaleSpectrumGeneric(dst, scale)
-
-	return nil
+	return p.forwardBatch(dst, src, p.buf, nil, 1/float64(p.n))
 }
 
-// ForwardUnitary computes the real-to-complex FFT and scales the result by 1/sqrt(N).
+// ForwardUnitary computes the real-to-complex FFT scaled by 1/sqrt(N),
+// replacing PlanOptions.Normalization like ForwardNormalized.
 func (p *PlanRealT[F, C]) ForwardUnitary(dst []C, src []F) error {
-	err := p.Forward(dst, src)
-	if err != nil {
-		return err
-	}
-
-	scale := 1.0 / math.Sqrt(float64(p.n))
-	scaleSpectrumGeneric(dst, scale)
-
-	return nil
+	return p.forwardBatch(dst, src, p.buf, nil, 1/math.Sqrt(float64(p.n)))
 }
 
 // Inverse computes the complex-to-real inverse FFT.
 // dst must have length N and src must have length N/2+1.
 func (p *PlanRealT[F, C]) Inverse(dst []F, src []C) error {
+	return p.inverseBatch(dst, src, p.buf, nil)
+}
+
+// inverseBatch runs Inverse's batch/stride loop with the given pack buffer
+// and child-plan workspace (nil = the child plan's pooled scratch).
+func (p *PlanRealT[F, C]) inverseBatch(dst []F, src []C, buf, work []C) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
 
 	if p.options.Batch <= 1 && p.options.Stride <= 0 {
-		return p.inverseSingle(dst, src)
+		return p.inverseWith(dst, src, buf, work)
 	}
 
 	batch, strideIn, strideOut, err := resolveBatchStrideReal(p.n, p.half+1, p.options)
@@ -277,7 +372,7 @@ func (p *PlanRealT[F, C]) Inverse(dst []F, src []C) error {
 			return ErrLengthMismatch
 		}
 
-		err = p.inverseSingle(dst[dstOff:dstOff+p.n], src[srcOff:srcOff+p.half+1])
+		err = p.inverseWith(dst[dstOff:dstOff+p.n], src[srcOff:srcOff+p.half+1], buf, work)
 		if err != nil {
 			return err
 		}
@@ -287,6 +382,11 @@ func (p *PlanRealT[F, C]) Inverse(dst []F, src []C) error {
 }
 
 func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
+	return p.inverseWith(dst, src, p.buf, nil)
+}
+
+// inverseWith runs a single inverse transform through the pack buffer buf.
+func (p *PlanRealT[F, C]) inverseWith(dst []F, src []C, buf, work []C) error {
 	if dst == nil || src == nil {
 		return ErrNilSlice
 	}
@@ -295,6 +395,18 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 		return ErrLengthMismatch
 	}
 
+	if buf == nil {
+		return ErrWorkspaceRequired
+	}
+
+	if p.high != nil {
+		return p.highInverse(dst, src, buf, work)
+	}
+
+	if p.n%2 != 0 {
+		return p.inverseOdd(dst, src, buf, work)
+	}
+
 	// Validate DC and Nyquist are real (imaginary parts near zero)
 	var zero C
 
@@ -319,18 +431,18 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 	switch any(zero).(type) {
 	case complex64:
 		srcC64 := any(src).([]complex64)
-		bufC64 := any(p.buf).([]complex64)
+		bufC64 := any(buf).([]complex64)
 		weightC64 := any(p.weight).([]complex64)
 		fft.RepackInverseComplex64(bufC64, srcC64, weightC64)
 	case complex128:
 		srcC128 := any(src).([]complex128)
-		bufC128 := any(p.buf).([]complex128)
+		bufC128 := any(buf).([]complex128)
 		weightC128 := any(p.weight).([]complex128)
 		fft.RepackInverseComplex128(bufC128, srcC128, weightC128)
 	}
 
 	// Inverse N/2 complex FFT
-	err := p.plan.Inverse(p.buf, p.buf)
+	err := p.plan.transformWith(buf, buf, work, true)
 	if err != nil {
 		return err
 	}
@@ -338,17 +450,18 @@ func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 	// Unpack complex buffer to real output
 	// Memory layout of []complex64 is identical to []float32{r0,i0,r1,i1,...},
 	// so we can use unsafe.Slice to reinterpret and copy efficiently.
+	// The inverse normalization is applied as part of this copy.
 	switch any(zero).(type) {
 	case complex64:
-		bufC64 := any(p.buf).([]complex64)
+		bufC64 := any(buf).([]complex64)
 		dstF32 := any(dst).([]float32)
 		dstAsComplex := unsafe.Slice((*complex64)(unsafe.Pointer(&dstF32[0])), p.half)
-		copy(dstAsComplex, bufC64)
+		scaleCopyComplex(dstAsComplex, bufC64, p.inverseScale)
 	case complex128:
-		bufC128 := any(p.buf).([]complex128)
+		bufC128 := any(buf).([]complex128)
 		dstF64 := any(dst).([]float64)
 		dstAsComplex := unsafe.Slice((*complex128)(unsafe.Pointer(&dstF64[0])), p.half)
-		copy(dstAsComplex, bufC128)
+		scaleCopyComplex(dstAsComplex, bufC128, p.inverseScale)
 	}
 
 	return nil
@@ -0,0 +1,480 @@
+package algofft
+
+import (
+	"github.com/cwbudde/algo-fft/internal/cpu"
+)
+
+// realGrid is the multi-dimensional real FFT shared by PlanReal2DT,
+// PlanReal3DT (and their float32 wrappers PlanReal2D and PlanReal3D) and
+// PlanDHT: a PlanRealT along the last dimension, then complex FFTs along each
+// leading dimension of the compact half spectrum (dims[:rank-1] ×
+// (last/2+1), row-major). Callers validate dims.
+type realGrid[F Float, C Complex] struct {
+	dims           []int
+	n              int
+	last, halfLast int   // last dimension and its half-spectrum width
+	strides        []int // spectrum stride of each leading dimension
+
+	rowPlan   *PlanRealT[F, C] // last dimension
+	axisPlans []*Plan[C]       // leading dimensions
+	spec      []C              // compact spectrum scratch (nil for WorkspaceExternal)
+	index     []int            // multi-index of the current row
+	options   PlanOptions
+
+	// forwardScale/inverseScale implement PlanOptions.Normalization on top
+	// of the unnormalized forward and 1/N-scaled inverse children; both are
+	// fused into the row pass.
+	forwardScale float64
+	inverseScale float64
+
+	// passes splits the row and column passes across per-worker lanes of
+	// child plans and column buffers; lanes[0] uses rowPlan and axisPlans.
+	passes laneSet[realGridLane[F, C]]
+}
+
+func newRealGrid[F Float, C Complex](dims []int, features cpu.Features, opts PlanOptions) (*realGrid[F, C], error) {
+	rank := len(dims)
+	last := dims[rank-1]
+	n := 1
+
+	for _, d := range dims {
+		n *= d
+	}
+
+	childOpts := opts
+	childOpts.Batch = 0
+	childOpts.Stride = 0
+	childOpts.InPlace = false
+	// Child plans keep the default convention; the combined factor is applied once.
+	childOpts.Normalization = NormBackward
+
+	rowPlan, err := newPlanRealTWithFeatures[F, C](last, features, childOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	axisPlans := make([]*Plan[C], rank-1)
+
+	for d, size := range dims[:rank-1] {
+		axisPlans[d], err = newPlanWithFeatures[C](size, features, childOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	g := &realGrid[F, C]{
+		dims:      append([]int(nil), dims...),
+		n:         n,
+		last:      last,
+		halfLast:  last/2 + 1,
+		strides:   make([]int, rank-1),
+		rowPlan:   rowPlan,
+		axisPlans: axisPlans,
+		index:     make([]int, rank-1),
+		options:   opts,
+	}
+
+	stride := g.halfLast
+	for d := rank - 2; d >= 0; d-- {
+		g.strides[d] = stride
+		stride *= dims[d]
+	}
+
+	// External-workspace plans carve the spectrum from the caller's workspace.
+	g.spec = newRealBuffer[C](g.specLen(), opts)
+	g.forwardScale, g.inverseScale = normalizationScales(opts.Normalization, n)
+	g.initLanes(opts.Workers)
+
+	return g, nil
+}
+
+// specLen returns the length of the compact spectrum.
+func (g *realGrid[F, C]) specLen() int {
+	return g.n / g.last * g.halfLast
+}
+
+// axisLen returns the longest leading dimension, the length of the column
+// buffers.
+func (g *realGrid[F, C]) axisLen() int {
+	longest := 0
+	for _, d := range g.dims[:len(g.axisPlans)] {
+		longest = max(longest, d)
+	}
+
+	return longest
+}
+
+// clone returns a grid with its own buffers and child plans.
+func (g *realGrid[F, C]) clone() *realGrid[F, C] {
+	c := *g
+	c.rowPlan = g.rowPlan.clone()
+	c.axisPlans = clonePlans(g.axisPlans)
+	c.spec = newRealBuffer[C](g.specLen(), g.options)
+	c.index = make([]int, len(g.index))
+	c.initLanes(c.options.Workers)
+
+	return &c
+}
+
+// realGridLane holds the resources one worker needs for the passes of a
+// realGrid and the arrays it is working on.
+type realGridLane[F Float, C Complex] struct {
+	rowPlan   *PlanRealT[F, C]
+	axisPlans []*Plan[C]
+	column    []C // size = longest leading dimension
+
+	real    []F // src (forward) or dst (inverse)
+	spec    []C // compact spectrum, transformed in place
+	forward bool
+
+	// work is the child plans' workspace (nil = their own scratch).
+	work []C
+}
+
+func (l realGridLane[F, C]) clone() realGridLane[F, C] {
+	return realGridLane[F, C]{
+		rowPlan:   l.rowPlan.clone(),
+		axisPlans: clonePlans(l.axisPlans),
+		column:    make([]C, len(l.column)),
+	}
+}
+
+// initLanes sets up one lane per worker.
+func (g *realGrid[F, C]) initLanes(workers int) {
+	own := realGridLane[F, C]{rowPlan: g.rowPlan, axisPlans: g.axisPlans}
+	if g.spec != nil {
+		own.column = make([]C, g.axisLen())
+	}
+
+	g.passes.init(g, own, workers, g.n/g.last)
+}
+
+// workspaceSize returns the number of elements the workspace methods need:
+// the spectrum scratch, a column buffer and the largest child workspace.
+func (g *realGrid[F, C]) workspaceSize() int {
+	child := g.rowPlan.WorkspaceSize()
+	for _, plan := range g.axisPlans {
+		child = max(child, plan.WorkspaceSize())
+	}
+
+	return workspaceAlign[C](g.specLen()) + workspaceAlign[C](g.axisLen()) + child
+}
+
+// forward runs the compact forward transform, including the
+// PlanOptions batch/stride loop.
+func (g *realGrid[F, C]) forward(dst []C, src []F) error {
+	return g.transform(src, dst, g.spec, nil, true)
+}
+
+// inverse runs the compact inverse transform, including the PlanOptions
+// batch/stride loop. src is left unchanged; imaginary parts of the DC and
+// Nyquist columns that a Hermitian spectrum cancels are ignored.
+func (g *realGrid[F, C]) inverse(dst []F, src []C) error {
+	return g.transform(dst, src, g.spec, nil, false)
+}
+
+// forwardSingle runs a single compact forward transform.
+func (g *realGrid[F, C]) forwardSingle(dst []C, src []F) error {
+	return g.transformSingle(src, dst, g.spec, nil, true)
+}
+
+// transformWorkspace runs the batch/stride loop serially in the
+// caller-provided workspace work.
+func (g *realGrid[F, C]) transformWorkspace(realData []F, spec, work []C, forward bool) error {
+	if realData == nil || spec == nil {
+		return ErrNilSlice
+	}
+
+	err := checkWorkspace(work, g.workspaceSize())
+	if err != nil {
+		return err
+	}
+
+	scratch, rest := carveWorkspace(work, g.specLen())
+	column, rest := carveWorkspace(rest, g.axisLen())
+
+	l := realGridLane[F, C]{
+		rowPlan:   g.rowPlan,
+		axisPlans: g.axisPlans,
+		column:    column,
+		work:      rest,
+	}
+
+	return g.transform(realData, spec, scratch, &l, forward)
+}
+
+// transform runs the PlanOptions batch/stride loop over the real arrays and
+// their compact spectra, on l or split across the plan's lanes when l is
+// nil. scratch holds the inverse's copy of the spectrum.
+func (g *realGrid[F, C]) transform(realData []F, spec, scratch []C, l *realGridLane[F, C], forward bool) error {
+	if realData == nil || spec == nil {
+		return ErrNilSlice
+	}
+
+	if g.options.Batch <= 1 && g.options.Stride <= 0 {
+		return g.transformSingle(realData, spec, scratch, l, forward)
+	}
+
+	specLen := g.specLen()
+
+	batch, strideReal, strideSpec, err := resolveBatchStrideReal(g.n, specLen, g.options)
+	if err != nil {
+		return err
+	}
+
+	for b := range batch {
+		realOff := b * strideReal
+		specOff := b * strideSpec
+
+		if realOff+g.n > len(realData) || specOff+specLen > len(spec) {
+			return ErrLengthMismatch
+		}
+
+		err = g.transformSingle(realData[realOff:realOff+g.n], spec[specOff:specOff+specLen], scratch, l, forward)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *realGrid[F, C]) transformSingle(realData []F, spec, scratch []C, l *realGridLane[F, C], forward bool) error {
+	if realData == nil || spec == nil {
+		return ErrNilSlice
+	}
+
+	if len(realData) != g.n || len(spec) != g.specLen() {
+		return ErrLengthMismatch
+	}
+
+	if scratch == nil {
+		return ErrWorkspaceRequired
+	}
+
+	// The forward transform works in dst; the inverse leaves src unchanged.
+	data := spec
+	if !forward {
+		data = scratch[:len(spec)]
+		copy(data, spec)
+	}
+
+	if l != nil {
+		l.real, l.spec, l.forward = realData, data, forward
+		return g.transformData(l, forward)
+	}
+
+	for i := range g.passes.lanes {
+		g.passes.lanes[i].real = realData
+		g.passes.lanes[i].spec = data
+		g.passes.lanes[i].forward = forward
+	}
+
+	err := g.transformData(nil, forward)
+
+	for i := range g.passes.lanes {
+		g.passes.lanes[i].real, g.passes.lanes[i].spec = nil, nil
+	}
+
+	return err
+}
+
+// transformData runs the row pass and the passes along the leading
+// dimensions, in that order for the forward transform and reversed for the
+// inverse.
+func (g *realGrid[F, C]) transformData(l *realGridLane[F, C], forward bool) error {
+	rows := g.n / g.last
+
+	if forward {
+		err := g.passes.run(l, g.rowPass(), rows)
+		if err != nil {
+			return err
+		}
+	}
+
+	for d := len(g.axisPlans) - 1; d >= 0; d-- {
+		err := g.passes.run(l, d, g.specLen()/g.dims[d])
+		if err != nil {
+			return err
+		}
+	}
+
+	if forward {
+		return nil
+	}
+
+	return g.passes.run(l, g.rowPass(), rows)
+}
+
+// rowPass is the pass index of the row pass; passes below it run along the
+// leading dimension of the same index.
+func (g *realGrid[F, C]) rowPass() int {
+	return len(g.axisPlans)
+}
+
+// transformLines transforms rows [lo, hi) in the row pass, or columns
+// [lo, hi) along the leading dimension pass.
+func (g *realGrid[F, C]) transformLines(l realGridLane[F, C], pass, lo, hi int) error {
+	if pass == g.rowPass() {
+		return g.transformRows(l, lo, hi)
+	}
+
+	plan := l.axisPlans[pass]
+	column := l.column[:g.dims[pass]]
+	stride := g.strides[pass]
+
+	for line := lo; line < hi; line++ {
+		offset := line/stride*stride*len(column) + line%stride
+
+		for j := range column {
+			column[j] = l.spec[offset+j*stride]
+		}
+
+		if err := plan.transformWith(column, column, l.work, !l.forward); err != nil {
+			return err
+		}
+
+		for j, v := range column {
+			l.spec[offset+j*stride] = v
+		}
+	}
+
+	return nil
+}
+
+// transformRows runs the real FFTs along the last dimension for rows
+// [lo, hi), applying the plan's normalization.
+func (g *realGrid[F, C]) transformRows(l realGridLane[F, C], lo, hi int) error {
+	buf, work := l.rowPlan.buf, l.work
+	if work != nil {
+		buf, work = carveWorkspace(work, l.rowPlan.bufLen())
+	}
+
+	if l.forward {
+		for row := lo; row < hi; row++ {
+			bins := l.spec[row*g.halfLast : (row+1)*g.halfLast]
+
+			err := l.rowPlan.forwardWith(bins, l.real[row*g.last:(row+1)*g.last], buf, work, g.forwardScale)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// The DC and Nyquist bins of each row are real for Hermitian input; drop
+	// the rounding residue of the column passes, which grows with the data
+	// and would otherwise trip the row plan's absolute check.
+	nyquist := g.halfLast - 1
+	if g.last%2 != 0 {
+		nyquist = 0
+	}
+
+	scale := F(g.inverseScale)
+
+	for row := lo; row < hi; row++ {
+		bins := l.spec[row*g.halfLast : (row+1)*g.halfLast]
+		bins[0] = C(complex(real(complex128(bins[0])), 0))
+		bins[nyquist] = C(complex(real(complex128(bins[nyquist])), 0))
+
+		out := l.real[row*g.last : (row+1)*g.last]
+
+		err := l.rowPlan.inverseWith(out, bins, buf, work)
+		if err != nil {
+			return err
+		}
+
+		if scale != 1 {
+			for i := range out {
+				out[i] *= scale
+			}
+		}
+	}
+
+	return nil
+}
+
+// mirrorRow returns the flat row index of the negated leading multi-index
+// g.index, whose bins complete the half spectrum of the current row.
+func (g *realGrid[F, C]) mirrorRow() int {
+	mirror := 0
+	for d, size := range g.dims[:len(g.index)] {
+		mirror = mirror*size + (size-g.index[d])%size
+	}
+
+	return mirror
+}
+
+// nextRow advances g.index to the next row in row-major order.
+func (g *realGrid[F, C]) nextRow() {
+	for d := len(g.index) - 1; d >= 0; d-- {
+		g.index[d]++
+		if g.index[d] < g.dims[d] {
+			return
+		}
+
+		g.index[d] = 0
+	}
+}
+
+// forwardFull computes the full N-point spectrum, filling the bins past the
+// half spectrum from X[-k] = conj(X[k]).
+func (g *realGrid[F, C]) forwardFull(dst []C, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != g.n || len(dst) != g.n {
+		return ErrLengthMismatch
+	}
+
+	if g.spec == nil {
+		return ErrWorkspaceRequired
+	}
+
+	err := g.forwardSingle(g.spec, src)
+	if err != nil {
+		return err
+	}
+
+	clear(g.index)
+
+	for row := range g.n / g.last {
+		mirror := g.spec[g.mirrorRow()*g.halfLast:]
+		out := dst[row*g.last : (row+1)*g.last]
+
+		copy(out, g.spec[row*g.halfLast:(row+1)*g.halfLast])
+
+		for col := g.halfLast; col < g.last; col++ {
+			v := complex128(mirror[g.last-col])
+			out[col] = C(complex(real(v), -imag(v)))
+		}
+
+		g.nextRow()
+	}
+
+	return nil
+}
+
+// inverseFull computes the inverse from a full spectrum, using only its
+// non-redundant half.
+func (g *realGrid[F, C]) inverseFull(dst []F, src []C) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != g.n || len(dst) != g.n {
+		return ErrLengthMismatch
+	}
+
+	if g.spec == nil {
+		return ErrWorkspaceRequired
+	}
+
+	for row := range g.n / g.last {
+		copy(g.spec[row*g.halfLast:(row+1)*g.halfLast], src[row*g.last:])
+	}
+
+	return g.transformSingle(dst, g.spec, g.spec, nil, false)
+}
@@ -0,0 +1,110 @@
+package algofft
+
+import "math"
+
+// Odd-length real FFTs cannot use the N/2 pack trick, so the plan owns a
+// full-length complex plan (mixed-radix or Bluestein, whichever the planner
+// selects) and a length-N complex buffer. The half-spectrum contract is the
+// same: N/2+1 bins, where N/2 rounds down and there is no Nyquist bin.
+
+// forwardOdd computes the half-spectrum of an odd-length real signal.
+func (p *PlanRealT[F, C]) forwardOdd(dst []C, src []F, buf, work []C, scale float64) error {
+	// Promote to complex; the scale is applied here.
+	var zero C
+	switch any(zero).(type) {
+	case complex64:
+		srcF32 := any(src).([]float32)
+		bufC64 := any(buf).([]complex64)
+
+		factor := float32(scale)
+		for i, v := range srcF32 {
+			bufC64[i] = complex(v*factor, 0)
+		}
+	case complex128:
+		srcF64 := any(src).([]float64)
+		bufC128 := any(buf).([]complex128)
+
+		for i, v := range srcF64 {
+			bufC128[i] = complex(v*scale, 0)
+		}
+	}
+
+	err := p.plan.transformWith(buf, buf, work, false)
+	if err != nil {
+		return err
+	}
+
+	copy(dst, buf[:p.half+1])
+
+	return nil
+}
+
+// inverseOdd reconstructs an odd-length real signal from its half-spectrum.
+func (p *PlanRealT[F, C]) inverseOdd(dst []F, src []C, buf, work []C) error {
+	var zero C
+
+	// Only DC must be real; odd lengths have no Nyquist bin.
+	switch any(zero).(type) {
+	case complex64:
+		srcC64 := any(src).([]complex64)
+		if math.Abs(float64(imag(srcC64[0]))) > 1e-4 {
+			return ErrInvalidSpectrum
+		}
+	case complex128:
+		srcC128 := any(src).([]complex128)
+		if math.Abs(imag(srcC128[0])) > 1e-12 {
+			return ErrInvalidSpectrum
+		}
+	}
+
+	// Rebuild the full Hermitian spectrum: X[N-k] = conj(X[k]).
+	switch any(zero).(type) {
+	case complex64:
+		srcC64 := any(src).([]complex64)
+		bufC64 := any(buf).([]complex64)
+
+		bufC64[0] = complex(real(srcC64[0]), 0)
+		for k := 1; k <= p.half; k++ {
+			v := srcC64[k]
+			bufC64[k] = v
+			bufC64[p.n-k] = complex(real(v), -imag(v))
+		}
+	case complex128:
+		srcC128 := any(src).([]complex128)
+		bufC128 := any(buf).([]complex128)
+
+		bufC128[0] = complex(real(srcC128[0]), 0)
+		for k := 1; k <= p.half; k++ {
+			v := srcC128[k]
+			bufC128[k] = v
+			bufC128[p.n-k] = complex(real(v), -imag(v))
+		}
+	}
+
+	err := p.plan.transformWith(buf, buf, work, true)
+	if err != nil {
+		return err
+	}
+
+	// Keep the real part; the inverse normalization is applied here.
+	switch any(zero).(type) {
+	case complex64:
+		bufC64 := any(buf).([]complex64)
+		dstF32 := any(dst).([]float32)
+
+		scale := float32(p.inverseScale)
+		for i := range dstF32 {
+			dstF32[i] = real(bufC64[i]) * scale
+		}
+	case complex128:
+		bufC128 := any(buf).([]complex128)
+		dstF64 := any(dst).([]float64)
+
+		scale := p.inverseScale
+		for i := range dstF64 {
+			dstF64[i] = real(bufC128[i]) * scale
+		}
+	}
+
+	return nil
+}
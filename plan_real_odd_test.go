@@ -0,0 +1,128 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+var oddRealSizes = []int{1, 3, 5, 7, 9, 15, 17, 21, 97, 243, 1001}
+
+func TestPlanRealT_OddLengthCorrectness64(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range oddRealSizes {
+		t.Run("Size"+itoa(n), func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanReal64(n)
+			if err != nil {
+				t.Fatalf("NewPlanReal64(%d) failed: %v", n, err)
+			}
+
+			if got, want := plan.SpectrumLen(), n/2+1; got != want {
+				t.Fatalf("SpectrumLen() = %d, want %d", got, want)
+			}
+
+			input := make([]float64, n)
+			for i := range input {
+				input[i] = math.Sin(float64(i)*0.37) + 0.25*float64(i%5)
+			}
+
+			spectrum := make([]complex128, plan.SpectrumLen())
+
+			err = plan.Forward(spectrum, input)
+			if err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			ref := reference.NaiveDFT128(complexify64(input))
+			for k := range spectrum {
+				if !complexNear128(ref[k], spectrum[k], 1e-9*float64(n)) {
+					t.Errorf("bin[%d]: got %v, want %v (diff=%g)", k, spectrum[k], ref[k], cmplx.Abs(spectrum[k]-ref[k]))
+				}
+			}
+
+			output := make([]float64, n)
+
+			err = plan.Inverse(output, spectrum)
+			if err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			for i := range input {
+				if math.Abs(output[i]-input[i]) > 1e-10 {
+					t.Errorf("output[%d] = %v, want %v", i, output[i], input[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPlanRealT_OddLengthRoundTrip32(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range oddRealSizes {
+		t.Run("Size"+itoa(n), func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlanReal32(n)
+			if err != nil {
+				t.Fatalf("NewPlanReal32(%d) failed: %v", n, err)
+			}
+
+			input := make([]float32, n)
+			for i := range input {
+				input[i] = float32(math.Cos(float64(i) * 0.91))
+			}
+
+			spectrum := make([]complex64, plan.SpectrumLen())
+			output := make([]float32, n)
+
+			err = plan.Forward(spectrum, input)
+			if err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			err = plan.Inverse(output, spectrum)
+			if err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			for i := range input {
+				if abs32(output[i]-input[i]) > 1e-4 {
+					t.Errorf("output[%d] = %v, want %v", i, output[i], input[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPlanRealT_OddLengthInvalidSpectrum(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanReal64(9)
+	if err != nil {
+		t.Fatalf("NewPlanReal64(9) failed: %v", err)
+	}
+
+	spectrum := make([]complex128, plan.SpectrumLen())
+	spectrum[0] = complex(1, 1)
+
+	err = plan.Inverse(make([]float64, 9), spectrum)
+	if !errors.Is(err, ErrInvalidSpectrum) {
+		t.Fatalf("Inverse with complex DC: got %v, want ErrInvalidSpectrum", err)
+	}
+
+	// The last bin is an ordinary bin for odd N and may be complex.
+	spectrum[0] = 1
+	spectrum[plan.SpectrumLen()-1] = complex(0, 1)
+
+	err = plan.Inverse(make([]float64, 9), spectrum)
+	if err != nil {
+		t.Fatalf("Inverse with complex last bin failed: %v", err)
+	}
+}
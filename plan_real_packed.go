@@ -0,0 +1,299 @@
+package algofft
+
+import "unsafe"
+
+// RealFormat selects how the packed PlanRealT methods lay out the half
+// spectrum of a length-N real signal in a real array. With X[k] = R_k + i·I_k
+// and h = N/2 (rounded down), the formats drop the imaginary parts that are
+// always zero (I_0, and I_h for even N) to varying degrees:
+//
+//	CCS:         R_0, 0, R_1, I_1, ..., R_h, I_h            (2h+2 values)
+//	Pack:        R_0, R_1, I_1, ..., R_{h-1}, I_{h-1}, R_h  (N values; odd N ends with R_h, I_h)
+//	Perm:        R_0, R_h, R_1, I_1, ..., R_{h-1}, I_{h-1}  (N values; odd N as Pack)
+//	HalfComplex: R_0, R_1, ..., R_h, I_{(N+1)/2-1}, ..., I_1 (N values)
+type RealFormat uint8
+
+const (
+	// RealFormatCCS is IPP's CCS layout and FFTW's in-place r2c layout. It
+	// has the memory layout of the complex spectrum Forward returns.
+	RealFormatCCS RealFormat = iota
+
+	// RealFormatPack is IPP's Pack layout.
+	RealFormatPack
+
+	// RealFormatPerm is IPP's Perm layout: Pack with the Nyquist value moved
+	// to index 1.
+	RealFormatPerm
+
+	// RealFormatHalfComplex is FFTW's r2hc half-complex layout.
+	RealFormatHalfComplex
+)
+
+// String returns the format name.
+func (f RealFormat) String() string {
+	switch f {
+	case RealFormatCCS:
+		return "CCS"
+	case RealFormatPack:
+		return "Pack"
+	case RealFormatPerm:
+		return "Perm"
+	case RealFormatHalfComplex:
+		return "HalfComplex"
+	default:
+		return "unknown"
+	}
+}
+
+// packedLen returns the number of real values of a length-n spectrum in
+// format.
+func packedLen(n int, format RealFormat) int {
+	if format == RealFormatCCS {
+		return 2 * (n/2 + 1)
+	}
+
+	return n
+}
+
+// binIndex returns where format stores the real and imaginary part of bin k
+// of a length-n spectrum; im is -1 for the parts it omits as always zero.
+func binIndex(n int, format RealFormat, k int) (re, im int) {
+	half := n / 2
+	nyquist := n%2 == 0 && k == half
+
+	switch {
+	case format == RealFormatCCS:
+		return 2 * k, 2*k + 1
+	case k == 0:
+		return 0, -1
+	case format == RealFormatHalfComplex && nyquist:
+		return half, -1
+	case format == RealFormatHalfComplex:
+		return k, n - k
+	case format == RealFormatPerm && nyquist:
+		return 1, -1
+	case format == RealFormatPerm && n%2 == 0:
+		return 2 * k, 2*k + 1
+	case nyquist:
+		return n - 1, -1
+	default:
+		return 2*k - 1, 2 * k
+	}
+}
+
+// packSpectrum writes the half spectrum src of a length-n signal to dst in
+// format.
+func packSpectrum[F Float, C Complex](dst []F, src []C, n int, format RealFormat) {
+	for k, v := range src {
+		re, im := binIndex(n, format, k)
+		c := complex128(v)
+
+		dst[re] = F(real(c))
+		if im >= 0 {
+			dst[im] = F(imag(c))
+		}
+	}
+}
+
+// unpackSpectrum reads the half spectrum of a length-n signal from src in
+// format into dst.
+func unpackSpectrum[F Float, C Complex](dst []C, src []F, n int, format RealFormat) {
+	for k := range dst {
+		re, im := binIndex(n, format, k)
+
+		var v complex128
+		if im >= 0 {
+			v = complex(float64(src[re]), float64(src[im]))
+		} else {
+			v = complex(float64(src[re]), 0)
+		}
+
+		dst[k] = C(v)
+	}
+}
+
+// complexView reinterprets an interleaved real buffer as half as many
+// complex values of the matching precision.
+func complexView[F Float, C Complex](buf []F) []C {
+	return unsafe.Slice((*C)(unsafe.Pointer(unsafe.SliceData(buf))), len(buf)/2)
+}
+
+// ConvertRealFormat rewrites the packed half spectrum of a length-n real
+// signal from srcFormat in src to dstFormat in dst. dst must not overlap src.
+//
+// Returns ErrInvalidLength if n < 1.
+// Returns ErrInvalidRealFormat if either format is unknown.
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if a length differs from the packed length of its
+// format.
+func ConvertRealFormat[F Float](dst []F, dstFormat RealFormat, src []F, srcFormat RealFormat, n int) error {
+	if n < 1 {
+		return ErrInvalidLength
+	}
+
+	if dstFormat > RealFormatHalfComplex || srcFormat > RealFormatHalfComplex {
+		return ErrInvalidRealFormat
+	}
+
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != packedLen(n, dstFormat) || len(src) != packedLen(n, srcFormat) {
+		return ErrLengthMismatch
+	}
+
+	for k := range n/2 + 1 {
+		srcRe, srcIm := binIndex(n, srcFormat, k)
+		dstRe, dstIm := binIndex(n, dstFormat, k)
+
+		dst[dstRe] = src[srcRe]
+
+		switch {
+		case dstIm < 0:
+		case srcIm < 0:
+			dst[dstIm] = 0
+		default:
+			dst[dstIm] = src[srcIm]
+		}
+	}
+
+	return nil
+}
+
+// Format returns the layout of the packed methods, PlanOptions.RealFormat.
+func (p *PlanRealT[F, C]) Format() RealFormat {
+	return p.options.RealFormat
+}
+
+// PackedLen returns the number of real values of a packed spectrum: N for
+// the Pack, Perm and HalfComplex formats and 2(N/2+1) for CCS, which is
+// N+2 for even N.
+func (p *PlanRealT[F, C]) PackedLen() int {
+	return packedLen(p.n, p.options.RealFormat)
+}
+
+// ForwardPacked computes the real FFT of src into dst in the plan's
+// RealFormat. src must have length N and dst length PackedLen(). dst may
+// alias src (see ForwardInPlace). Unlike Forward, it transforms a single
+// signal regardless of PlanOptions.Batch.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if a length is wrong.
+func (p *PlanRealT[F, C]) ForwardPacked(dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != p.n || len(dst) != p.PackedLen() {
+		return ErrLengthMismatch
+	}
+
+	// CCS is the complex spectrum's memory; the transform reads all of src
+	// before writing, so it runs on dst directly.
+	if p.options.RealFormat == RealFormatCCS {
+		return p.forwardWith(complexView[F, C](dst), src, p.buf, nil, p.forwardScale)
+	}
+
+	spectrum := p.packedSpectrum()
+
+	err := p.forwardWith(spectrum, src, p.buf, nil, p.forwardScale)
+	if err != nil {
+		return err
+	}
+
+	packSpectrum(dst, spectrum, p.n, p.options.RealFormat)
+
+	return nil
+}
+
+// InversePacked computes the inverse real FFT of the packed spectrum src
+// into dst. src must have length PackedLen() and dst length N. dst may alias
+// src (see InverseInPlace).
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrLengthMismatch if a length is wrong.
+// Returns ErrInvalidSpectrum if a CCS spectrum has non-real DC or Nyquist
+// bins.
+func (p *PlanRealT[F, C]) InversePacked(dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.n || len(src) != p.PackedLen() {
+		return ErrLengthMismatch
+	}
+
+	if p.options.RealFormat == RealFormatCCS {
+		return p.inverseWith(dst, complexView[F, C](src), p.buf, nil)
+	}
+
+	spectrum := p.packedSpectrum()
+	unpackSpectrum(spectrum, src, p.n, p.options.RealFormat)
+
+	return p.inverseWith(dst, spectrum, p.buf, nil)
+}
+
+// ForwardInPlace transforms the N samples at the start of data into the
+// packed spectrum occupying all of data, which must have length PackedLen():
+// N+2 for the (even N) CCS layout FFTW and IPP use for in-place real
+// transforms, N for the other formats.
+func (p *PlanRealT[F, C]) ForwardInPlace(data []F) error {
+	if len(data) < p.n {
+		return ErrLengthMismatch
+	}
+
+	return p.ForwardPacked(data, data[:p.n])
+}
+
+// InverseInPlace is the inverse of ForwardInPlace: it transforms the packed
+// spectrum in data (length PackedLen()) into N samples at its start.
+func (p *PlanRealT[F, C]) InverseInPlace(data []F) error {
+	if len(data) < p.n {
+		return ErrLengthMismatch
+	}
+
+	return p.InversePacked(data[:p.n], data)
+}
+
+// PackSpectrum writes the N/2+1 bins of a Forward spectrum in src to dst in
+// the plan's RealFormat. dst must have length PackedLen(); omitted imaginary
+// parts are dropped.
+func (p *PlanRealT[F, C]) PackSpectrum(dst []F, src []C) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.PackedLen() || len(src) != p.half+1 {
+		return ErrLengthMismatch
+	}
+
+	packSpectrum(dst, src, p.n, p.options.RealFormat)
+
+	return nil
+}
+
+// UnpackSpectrum reads a packed spectrum in the plan's RealFormat into the
+// N/2+1 bins Inverse takes. Imaginary parts the format omits become zero.
+func (p *PlanRealT[F, C]) UnpackSpectrum(dst []C, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.half+1 || len(src) != p.PackedLen() {
+		return ErrLengthMismatch
+	}
+
+	unpackSpectrum(dst, src, p.n, p.options.RealFormat)
+
+	return nil
+}
+
+// packedSpectrum returns the complex spectrum buffer of the packed methods.
+func (p *PlanRealT[F, C]) packedSpectrum() []C {
+	if p.packed == nil {
+		p.packed = make([]C, p.half+1)
+	}
+
+	return p.packed
+}
@@ -0,0 +1,241 @@
+package algofft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+var allRealFormats = []RealFormat{RealFormatCCS, RealFormatPack, RealFormatPerm, RealFormatHalfComplex}
+
+// expectedPacked lays out a half spectrum as documented on RealFormat.
+func expectedPacked(spectrum []complex128, n int, format RealFormat) []float64 {
+	half := n / 2
+	even := n%2 == 0
+
+	var out []float64
+
+	switch format {
+	case RealFormatCCS:
+		for _, v := range spectrum {
+			out = append(out, real(v), imag(v))
+		}
+	case RealFormatPack, RealFormatPerm:
+		out = append(out, real(spectrum[0]))
+		if even && format == RealFormatPerm {
+			out = append(out, real(spectrum[half]))
+		}
+
+		for k := 1; k < (n+1)/2; k++ {
+			out = append(out, real(spectrum[k]), imag(spectrum[k]))
+		}
+
+		if even && format == RealFormatPack {
+			out = append(out, real(spectrum[half]))
+		}
+	case RealFormatHalfComplex:
+		for k := 0; k <= half; k++ {
+			out = append(out, real(spectrum[k]))
+		}
+
+		for k := (n+1)/2 - 1; k >= 1; k-- {
+			out = append(out, imag(spectrum[k]))
+		}
+	}
+
+	return out
+}
+
+func TestPlanRealT_Packed(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range allRealFormats {
+		for _, n := range []int{1, 2, 3, 8, 15, 16, 60} {
+			name := fmt.Sprintf("%v/%d", format, n)
+
+			plan, err := NewPlanRealTWithOptions[float64, complex128](n, PlanOptions{RealFormat: format})
+			if err != nil {
+				t.Fatalf("%s: NewPlanRealTWithOptions failed: %v", name, err)
+			}
+
+			if plan.Format() != format {
+				t.Fatalf("%s: Format = %v", name, plan.Format())
+			}
+
+			src := make([]float64, n)
+			for i, v := range randomComplex128Slice(n, uint64(n)) {
+				src[i] = real(v)
+			}
+
+			spectrum := make([]complex128, plan.SpectrumLen())
+			if err := plan.Forward(spectrum, src); err != nil {
+				t.Fatalf("%s: Forward failed: %v", name, err)
+			}
+
+			want := expectedPacked(spectrum, n, format)
+			if plan.PackedLen() != len(want) {
+				t.Fatalf("%s: PackedLen = %d, want %d", name, plan.PackedLen(), len(want))
+			}
+
+			got := make([]float64, plan.PackedLen())
+			if err := plan.ForwardPacked(got, src); err != nil {
+				t.Fatalf("%s: ForwardPacked failed: %v", name, err)
+			}
+
+			for i := range want {
+				if math.Abs(got[i]-want[i]) > 1e-12 {
+					t.Fatalf("%s: packed[%d] = %v, want %v", name, i, got[i], want[i])
+				}
+			}
+
+			back := make([]float64, n)
+			if err := plan.InversePacked(back, got); err != nil {
+				t.Fatalf("%s: InversePacked failed: %v", name, err)
+			}
+
+			for i := range src {
+				if math.Abs(back[i]-src[i]) > 1e-12 {
+					t.Fatalf("%s: round trip[%d] = %v, want %v", name, i, back[i], src[i])
+				}
+			}
+
+			// PackSpectrum/UnpackSpectrum agree with the transforms.
+			packed := make([]float64, plan.PackedLen())
+			if err := plan.PackSpectrum(packed, spectrum); err != nil {
+				t.Fatalf("%s: PackSpectrum failed: %v", name, err)
+			}
+
+			unpacked := make([]complex128, plan.SpectrumLen())
+			if err := plan.UnpackSpectrum(unpacked, packed); err != nil {
+				t.Fatalf("%s: UnpackSpectrum failed: %v", name, err)
+			}
+
+			// Only the (zero) imaginary parts of DC and Nyquist may be lost.
+			for k := range spectrum {
+				if cmplx.Abs(unpacked[k]-spectrum[k]) > 1e-12 {
+					t.Fatalf("%s: unpacked[%d] = %v, want %v", name, k, unpacked[k], spectrum[k])
+				}
+			}
+		}
+	}
+}
+
+func TestPlanRealT_InPlace(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range allRealFormats {
+		for _, n := range []int{16, 45, 256} {
+			name := fmt.Sprintf("%v/%d", format, n)
+
+			plan, err := NewPlanRealTWithOptions[float32, complex64](n, PlanOptions{RealFormat: format, Normalization: NormOrtho})
+			if err != nil {
+				t.Fatalf("%s: NewPlanRealTWithOptions failed: %v", name, err)
+			}
+
+			src := randomFloat32Slice(n, 11)
+			want := make([]float32, plan.PackedLen())
+
+			if err := plan.ForwardPacked(want, src); err != nil {
+				t.Fatalf("%s: ForwardPacked failed: %v", name, err)
+			}
+
+			data := make([]float32, plan.PackedLen())
+			copy(data, src)
+
+			if err := plan.ForwardInPlace(data); err != nil {
+				t.Fatalf("%s: ForwardInPlace failed: %v", name, err)
+			}
+
+			assertScaledFloat32(t, data, want, 1, 1e-6, name+" forward")
+
+			if err := plan.InverseInPlace(data); err != nil {
+				t.Fatalf("%s: InverseInPlace failed: %v", name, err)
+			}
+
+			assertScaledFloat32(t, data[:n], src, 1, 1e-5, name+" round trip")
+		}
+	}
+}
+
+func TestConvertRealFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 2, 7, 12} {
+		packed := make(map[RealFormat][]float64)
+
+		for _, format := range allRealFormats {
+			plan, err := NewPlanRealTWithOptions[float64, complex128](n, PlanOptions{RealFormat: format})
+			if err != nil {
+				t.Fatalf("NewPlanRealTWithOptions failed: %v", err)
+			}
+
+			src := make([]float64, n)
+			for i, v := range randomComplex128Slice(n, 9) {
+				src[i] = real(v)
+			}
+
+			packed[format] = make([]float64, plan.PackedLen())
+			if err := plan.ForwardPacked(packed[format], src); err != nil {
+				t.Fatalf("ForwardPacked failed: %v", err)
+			}
+		}
+
+		for _, from := range allRealFormats {
+			for _, to := range allRealFormats {
+				got := make([]float64, len(packed[to]))
+				if err := ConvertRealFormat(got, to, packed[from], from, n); err != nil {
+					t.Fatalf("%d %v→%v: ConvertRealFormat failed: %v", n, from, to, err)
+				}
+
+				for i, want := range packed[to] {
+					if math.Abs(got[i]-want) > 1e-12 {
+						t.Fatalf("%d %v→%v: [%d] = %v, want %v", n, from, to, i, got[i], want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestPlanRealT_PackedErrors(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanRealTWithOptions[float64, complex128](8, PlanOptions{RealFormat: RealFormat(9)})
+	if err != nil {
+		t.Fatalf("NewPlanRealTWithOptions failed: %v", err)
+	}
+
+	if plan.Format() != RealFormatCCS || plan.PackedLen() != 10 {
+		t.Errorf("unknown format: Format/PackedLen = %v/%d, want CCS/10", plan.Format(), plan.PackedLen())
+	}
+
+	if err := plan.ForwardPacked(nil, make([]float64, 8)); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dst: %v, want ErrNilSlice", err)
+	}
+
+	if err := plan.ForwardInPlace(make([]float64, 8)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("in place without room: %v, want ErrLengthMismatch", err)
+	}
+
+	if err := plan.InversePacked(make([]float64, 8), make([]float64, 9)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short spectrum: %v, want ErrLengthMismatch", err)
+	}
+
+	if err := plan.PackSpectrum(make([]float64, 10), make([]complex128, 4)); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short bins: %v, want ErrLengthMismatch", err)
+	}
+
+	if err := ConvertRealFormat(make([]float64, 8), RealFormat(7), make([]float64, 8), RealFormatPack, 8); !errors.Is(err, ErrInvalidRealFormat) {
+		t.Errorf("unknown format: %v, want ErrInvalidRealFormat", err)
+	}
+
+	if err := ConvertRealFormat(make([]float64, 8), RealFormatPerm, make([]float64, 8), RealFormatCCS, 8); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short CCS: %v, want ErrLengthMismatch", err)
+	}
+
+	if err := ConvertRealFormat[float32](nil, RealFormatPerm, nil, RealFormatPack, 0); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("n = 0: %v, want ErrInvalidLength", err)
+	}
+}
@@ -59,16 +59,20 @@ func (p *Plan[T]) transformStrided(dst, src []T, stride int, inverse bool) error
 	//nolint:nestif
 	if canUseStridedDIT {
 		if inverse {
-			if fft.InverseStridedDIT(dst, src, p.twiddle, p.bitrev, stride, p.n) {
+			if fft.InverseStridedDIT(dst, src, p.twiddle, p.bitrev, stride, p.n, p.inverseScale) {
 				return nil
 			}
 		} else {
-			if fft.ForwardStridedDIT(dst, src, p.twiddle, p.bitrev, stride, p.n) {
+			if fft.ForwardStridedDIT(dst, src, p.twiddle, p.bitrev, stride, p.n, p.forwardScale) {
 				return nil
 			}
 		}
 	}
 
+	if p.meta.Workspace == WorkspaceExternal {
+		return ErrWorkspaceRequired
+	}
+
 	_, stridedScratch, _, set := p.getScratch()
 	if set != nil {
 		defer p.scratchPool.Put(set)
@@ -0,0 +1,254 @@
+package algofft
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+	"github.com/cwbudde/algo-fft/internal/fft"
+	m "github.com/cwbudde/algo-fft/internal/math"
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+// Plan self-verification (PlanOptions.Verify).
+//
+// Sizes up to verifyNaiveMaxSize are compared bin by bin against the O(n²)
+// reference DFT. Above it, verifySampleBins output bins spread over the
+// spectrum are evaluated directly in float64, which keeps plan creation
+// O(n) while still catching kernels that compute the wrong transform.
+const (
+	verifyNaiveMaxSize = 1024
+	verifySampleBins   = 32
+
+	// verifySeed fixes the input vector, so verification is reproducible.
+	verifySeed = 0x5EED
+
+	// Maximum error relative to the largest reference bin. Wrong kernels are
+	// off by O(1); rounding stays orders of magnitude below these.
+	verifyTolerance64  = 1e-3
+	verifyTolerance128 = 1e-9
+)
+
+// verify checks the plan's forward and inverse transforms against the
+// reference DFT. A bound codelet that fails is recorded in PlanMeta.Rejected
+// and replaced by the next CodeletRegistry entry, down to the plain kernel
+// strategy. If that kernel fails too and opts forced it, it is recorded as
+// well and the plan is rebuilt by rebuild with KernelAuto, since twiddles and
+// scratch depend on the strategy. A failing four-step executor is dropped in
+// favor of the serial path. Returns ErrVerificationFailed if no candidate
+// passes.
+func (p *Plan[T]) verify(features cpu.Features, opts PlanOptions, rebuild func(PlanOptions) (*Plan[T], error)) error {
+	for !p.verifyTransforms(false) {
+		p.meta.Rejected = append(p.meta.Rejected, p.algorithm)
+
+		if p.forwardCodelet == nil {
+			if opts.Strategy == KernelAuto {
+				return ErrVerificationFailed
+			}
+
+			return p.verifyFallback(opts, rebuild)
+		}
+
+		estimate := fft.FallbackPlan[T](p.n, features, opts.Strategy, p.meta.Rejected)
+		p.rebind(estimate, features, opts.Radices)
+
+		// The scratch was sized for the original choice.
+		if size := standardScratchSize(p.n, estimate.Algorithm); size > p.scratchLen {
+			p.growScratch(size)
+		}
+	}
+
+	if p.fourStep != nil && !p.verifyTransforms(true) {
+		p.meta.Rejected = append(p.meta.Rejected, verifyFourStepName)
+		p.meta.FourStep = false
+		p.fourStep = nil
+	}
+
+	p.meta.Verified = true
+
+	return nil
+}
+
+// verifyFallback replaces p with a plan rebuilt with KernelAuto, which
+// verifies itself, after the forced strategy's candidates all failed.
+func (p *Plan[T]) verifyFallback(opts PlanOptions, rebuild func(PlanOptions) (*Plan[T], error)) error {
+	opts.Strategy = KernelAuto
+	opts.Verify = true
+
+	fallback, err := rebuild(opts)
+	if err != nil {
+		return err
+	}
+
+	fallback.meta.Rejected = append(p.meta.Rejected, fallback.meta.Rejected...)
+
+	p.Close()
+	*p = *fallback
+
+	return nil
+}
+
+// verifyFourStepName records a rejected four-step executor in PlanMeta.Rejected.
+const verifyFourStepName = "fourstep"
+
+// growScratch enlarges the kernel scratch to size elements. Pooled sets are
+// replaced by a fresh pool; plans with fixed scratch get a new buffer.
+func (p *Plan[T]) growScratch(size int) {
+	n, strategy, decomp := p.n, p.kernelStrategy, p.decompStrategy
+	newSet := func() *scratchSet[T] {
+		return allocateScratchSet[T](n, strategy, 0, 0, decomp, size)
+	}
+
+	p.scratchLen = size
+
+	if p.scratchPool != nil {
+		p.scratchPool = &sync.Pool{New: func() any { return newSet() }}
+	}
+
+	if p.scratch != nil {
+		set := newSet()
+		p.scratch, p.scratchBacking = set.scratch, set.scratchBacking
+	}
+}
+
+// rebind replaces the plan's codelet or kernel with estimate.
+func (p *Plan[T]) rebind(estimate fft.PlanEstimate[T], features cpu.Features, hints []int) {
+	kernels := fft.SelectKernelsWithStrategy[T](features, estimate.Strategy)
+
+	p.forwardCodelet = estimate.ForwardCodelet
+	p.inverseCodelet = estimate.InverseCodelet
+	p.algorithm = estimate.Algorithm
+	p.forwardKernel = kernels.Forward
+	p.inverseKernel = kernels.Inverse
+	p.kernelStrategy = estimate.Strategy
+	p.meta.Strategy = estimate.Strategy
+
+	p.radices = nil
+	p.meta.Radices = nil
+	p.setMixedRadixSchedule(estimate, hints)
+
+	p.codeletTwiddleForward, p.codeletTwiddleInverse, p.codeletTwiddleForwardBacking, p.codeletTwiddleInverseBacking = prepareCodeletTwiddles(p.n, p.twiddle, estimate)
+}
+
+// verifyTransforms runs both directions on a deterministic random vector and
+// reports whether they match the reference within tolerance. parallel
+// selects the four-step executor instead of the serial path.
+func (p *Plan[T]) verifyTransforms(parallel bool) bool {
+	n := p.n
+	rng := rand.New(rand.NewPCG(verifySeed, uint64(n))) //nolint:gosec
+
+	src := make([]T, n)
+	input := make([]complex128, n)
+
+	for i := range src {
+		src[i] = m.ComplexFromFloat64[T](rng.Float64()*2-1, rng.Float64()*2-1)
+		input[i] = complex128(src[i])
+	}
+
+	dst := make([]T, n)
+	forwardScale, inverseScale := normalizationScales(p.meta.Normalization, n)
+
+	scratch, aux, err := p.workspaceBuffers(dst, src, NewWorkspace[T](p.WorkspaceSize()))
+	if err != nil {
+		return false
+	}
+
+	if p.forward(dst, src, scratch, aux, parallel) != nil || !verifyMatches(dst, input, forwardScale, false) {
+		return false
+	}
+
+	if p.inverse(dst, src, scratch, aux, parallel) != nil || !verifyMatches(dst, input, inverseScale, true) {
+		return false
+	}
+
+	return true
+}
+
+// verifyMatches compares got against the scaled DFT (or 1/N-scaled inverse
+// DFT) of input, on every bin for small sizes and on sampled bins otherwise.
+func verifyMatches[T Complex](got []T, input []complex128, scale float64, inverse bool) bool {
+	n := len(input)
+
+	var (
+		bins []int
+		want []complex128
+	)
+
+	if n <= verifyNaiveMaxSize {
+		want = reference.NaiveDFT128(input)
+		if inverse {
+			want = reference.NaiveIDFT128(input)
+		}
+
+		bins = make([]int, n)
+		for k := range bins {
+			bins[k] = k
+		}
+	} else {
+		bins = make([]int, verifySampleBins)
+		want = make([]complex128, n)
+
+		for i := range bins {
+			k := (i*n/verifySampleBins + i) % n
+			bins[i] = k
+			want[k] = dftBin(input, []int{n}, []int{k}, inverse)
+		}
+	}
+
+	var maxErr, maxRef float64
+
+	for _, k := range bins {
+		expected := want[k] * complex(scale, 0)
+		maxErr = max(maxErr, cmplx.Abs(complex128(got[k])-expected))
+		maxRef = max(maxRef, cmplx.Abs(expected))
+	}
+
+	tolerance := verifyTolerance128
+	if _, ok := any(got[0]).(complex64); ok {
+		tolerance = verifyTolerance64
+	}
+
+	return maxErr <= tolerance*maxRef
+}
+
+// dftBin evaluates the DFT of x (row-major, of the given dimensions) at the
+// multi-index bin directly. Each exponent is reduced modulo its dimension so
+// the angle stays exact. The inverse includes 1/N.
+func dftBin(x []complex128, dims, bin []int, inverse bool) complex128 {
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+
+	index := make([]int, len(dims))
+
+	var sum complex128
+
+	for _, v := range x {
+		var turns float64
+		for d, n := range dims {
+			turns += float64(bin[d]*index[d]%n) / float64(n)
+		}
+
+		sin, cos := math.Sincos(sign * 2 * math.Pi * turns)
+		sum += v * complex(cos, sin)
+
+		// Advance the row-major multi-index of the next element
+		for d := len(dims) - 1; d >= 0; d-- {
+			index[d]++
+			if index[d] < dims[d] {
+				break
+			}
+
+			index[d] = 0
+		}
+	}
+
+	if inverse {
+		sum /= complex(float64(len(x)), 0)
+	}
+
+	return sum
+}
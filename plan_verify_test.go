@@ -0,0 +1,264 @@
+package algofft
+
+import (
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+	"github.com/cwbudde/algo-fft/internal/reference"
+)
+
+// brokenCodelet stands in for a miscompiled kernel: it copies its input.
+func brokenCodelet[T Complex](dst, src, twiddle, scratch []T) {
+	copy(dst, src)
+}
+
+func brokenKernel[T Complex](dst, src, twiddle, scratch []T) bool {
+	copy(dst, src)
+	return true
+}
+
+func TestVerify_PassingPlans(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 8, 60, 64, 97, 257, 2048, 4096} {
+		plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Verify: true, Normalization: NormOrtho})
+		if err != nil {
+			t.Fatalf("n=%d: NewPlanWithOptions failed: %v", n, err)
+		}
+
+		meta := plan.Meta()
+		if !meta.Verified || len(meta.Rejected) != 0 {
+			t.Errorf("n=%d: Verified = %v, Rejected = %v, want verified without rejections", n, meta.Verified, meta.Rejected)
+		}
+	}
+
+	plan, err := NewPlanWithOptions[complex128](1024, PlanOptions{})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if plan.Meta().Verified {
+		t.Error("plan without Verify reports Verified")
+	}
+}
+
+func TestVerify_RejectsBrokenCodelet(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{ForceGeneric: true, Architecture: runtime.GOARCH}
+
+	plan, err := newPlanWithFeatures[complex128](64, features, PlanOptions{})
+	if err != nil {
+		t.Fatalf("newPlanWithFeatures failed: %v", err)
+	}
+
+	selected := plan.Algorithm()
+	if plan.forwardCodelet == nil {
+		t.Fatalf("no codelet bound for size 64 (algorithm %q)", selected)
+	}
+
+	plan.forwardCodelet = brokenCodelet[complex128]
+	plan.algorithm = "broken64"
+
+	if err := plan.verify(features, PlanOptions{}, rebuildWith[complex128](64, features)); err != nil {
+		t.Fatalf("verify failed: %v", err)
+	}
+
+	meta := plan.Meta()
+	if !meta.Verified || !slices.Equal(meta.Rejected, []string{"broken64"}) {
+		t.Errorf("Verified = %v, Rejected = %v, want [broken64]", meta.Verified, meta.Rejected)
+	}
+
+	// The first real candidate takes over and passes.
+	if plan.Algorithm() != selected {
+		t.Errorf("algorithm = %q, want %q", plan.Algorithm(), selected)
+	}
+
+	src := randomComplex128Slice(64, 64)
+	got := make([]complex128, 64)
+
+	if err := plan.Forward(got, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	assertScaledComplex128(t, got, reference.NaiveDFT128(src), 1, 1e-9, "forward")
+}
+
+func TestVerify_BrokenKernelFails(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.DetectFeatures()
+
+	plan, err := NewPlanWithOptions[complex64](2048, PlanOptions{Strategy: KernelDIT})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	plan.forwardCodelet = nil
+	plan.inverseCodelet = nil
+	plan.forwardKernel = brokenKernel[complex64]
+
+	// Verified as if the planner had chosen the kernel itself, there is
+	// nothing left to fall back to.
+	err = plan.verify(features, PlanOptions{}, rebuildWith[complex64](2048, features))
+	if !errors.Is(err, ErrVerificationFailed) {
+		t.Fatalf("verify = %v, want ErrVerificationFailed", err)
+	}
+
+	if plan.Meta().Verified {
+		t.Error("failed plan reports Verified")
+	}
+}
+
+func TestVerify_BrokenForcedKernelFallsBack(t *testing.T) {
+	t.Parallel()
+
+	const n = 2048
+
+	features := cpu.DetectFeatures()
+	opts := PlanOptions{Strategy: KernelDIT}
+
+	plan, err := NewPlanWithOptions[complex64](n, opts)
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	plan.forwardCodelet = nil
+	plan.inverseCodelet = nil
+	plan.forwardKernel = brokenKernel[complex64]
+	plan.algorithm = "broken_dit"
+
+	if err := plan.verify(features, opts, rebuildWith[complex64](n, features)); err != nil {
+		t.Fatalf("verify failed: %v", err)
+	}
+
+	meta := plan.Meta()
+	if !meta.Verified || len(meta.Rejected) == 0 || meta.Rejected[0] != "broken_dit" {
+		t.Errorf("Verified = %v, Rejected = %v, want verified with broken_dit rejected first", meta.Verified, meta.Rejected)
+	}
+
+	src := make([]complex64, n)
+	for i, v := range randomComplex128Slice(n, 16) {
+		src[i] = complex64(v)
+	}
+
+	got := make([]complex64, n)
+	if err := plan.Forward(got, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	assertScaledComplex64(t, got, reference.NaiveDFT(src), 1, 1e-4, "forward")
+}
+
+func TestVerify_ForcedStrategies(t *testing.T) {
+	t.Parallel()
+
+	// Forced strategies that do not exist for a size must still produce a
+	// verified plan with the right transform.
+	for _, n := range []int{5, 15, 1001} {
+		plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Strategy: KernelRecursive, Verify: true})
+		if err != nil {
+			t.Fatalf("n=%d: NewPlanWithOptions failed: %v", n, err)
+		}
+
+		if !plan.Meta().Verified {
+			t.Errorf("n=%d: plan not verified", n)
+		}
+
+		src := randomComplex128Slice(n, uint64(n))
+		got := make([]complex128, n)
+
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("n=%d: Forward failed: %v", n, err)
+		}
+
+		assertScaledComplex128(t, got, reference.NaiveDFT128(src), 1, 1e-9*float64(n), "n="+itoa(n))
+	}
+}
+
+// rebuildWith returns the rebuild function newPlanWithFeatures hands to
+// verify.
+func rebuildWith[T Complex](n int, features cpu.Features) func(PlanOptions) (*Plan[T], error) {
+	return func(opts PlanOptions) (*Plan[T], error) {
+		return newPlanWithFeatures[T](n, features, opts)
+	}
+}
+
+func TestVerify_CoversFourStep(t *testing.T) {
+	t.Parallel()
+
+	opts := PlanOptions{Strategy: KernelSixStep, Workers: 4, Verify: true}
+
+	plan, err := NewPlanWithOptions[complex128](1024, opts)
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	meta := plan.Meta()
+	if !meta.Verified || !meta.FourStep {
+		t.Fatalf("Verified = %v, FourStep = %v, want both", meta.Verified, meta.FourStep)
+	}
+
+	// A broken row plan only affects the four-step executor, which verify
+	// must drop in favor of the serial path.
+	plan.fourStep.plan2.forwardCodelet = brokenCodelet[complex128]
+
+	if err := plan.verify(cpu.DetectFeatures(), opts, rebuildWith[complex128](1024, cpu.DetectFeatures())); err != nil {
+		t.Fatalf("verify failed: %v", err)
+	}
+
+	meta = plan.Meta()
+	if meta.FourStep || plan.fourStep != nil || !slices.Equal(meta.Rejected, []string{verifyFourStepName}) {
+		t.Errorf("FourStep = %v, Rejected = %v, want serial path with [%s]", meta.FourStep, meta.Rejected, verifyFourStepName)
+	}
+}
+
+func TestVerify_GrowScratch(t *testing.T) {
+	t.Parallel()
+
+	pooled, err := NewPlanT[complex64](256)
+	if err != nil {
+		t.Fatalf("NewPlanT failed: %v", err)
+	}
+
+	fixed, err := NewPlanPooled[complex64](256)
+	if err != nil {
+		t.Fatalf("NewPlanPooled failed: %v", err)
+	}
+	defer fixed.Close()
+
+	src := make([]complex64, 256)
+	for i, v := range randomComplex128Slice(256, 5) {
+		src[i] = complex64(v)
+	}
+
+	want := make([]complex64, 256)
+
+	if err := pooled.Forward(want, src); err != nil {
+		t.Fatalf("Forward failed: %v", err)
+	}
+
+	for _, plan := range []*Plan[complex64]{pooled, fixed} {
+		size := plan.WorkspaceSize()
+		plan.growScratch(512)
+
+		scratch, _, _, set := plan.getScratch()
+		if len(scratch) != 512 || plan.WorkspaceSize() <= size {
+			t.Errorf("scratch = %d, WorkspaceSize = %d, want grown to 512", len(scratch), plan.WorkspaceSize())
+		}
+
+		if set != nil {
+			plan.scratchPool.Put(set)
+		}
+
+		got := make([]complex64, 256)
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		assertScaledComplex64(t, got, want, 1, 1e-5, "grown scratch")
+	}
+}
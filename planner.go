@@ -72,3 +72,18 @@ func (p *Planner) PlanReal(n int) (*PlanReal, error) {
 func (p *Planner) PlanReal2D(rows, cols int) (*PlanReal2D, error) {
 	return NewPlanReal2DWithOptions(rows, cols, p.opts)
 }
+
+// PlanReal3D builds a 3D real FFT plan using the planner's options.
+func (p *Planner) PlanReal3D(depth, height, width int) (*PlanReal3D, error) {
+	return NewPlanReal3DWithOptions(depth, height, width, p.opts)
+}
+
+// PlanReal2D64 builds a 2D float64 real FFT plan using the planner's options.
+func (p *Planner) PlanReal2D64(rows, cols int) (*PlanReal2DT[float64, complex128], error) {
+	return NewPlanReal2DTWithOptions[float64, complex128](rows, cols, p.opts)
+}
+
+// PlanReal3D64 builds a 3D float64 real FFT plan using the planner's options.
+func (p *Planner) PlanReal3D64(depth, height, width int) (*PlanReal3DT[float64, complex128], error) {
+	return NewPlanReal3DTWithOptions[float64, complex128](depth, height, width, p.opts)
+}
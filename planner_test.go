@@ -1,6 +1,7 @@
 package algofft
 
 import (
+	"math"
 	"testing"
 )
 
@@ -587,3 +588,57 @@ func TestPlanner_ConcurrentPlanCreation(t *testing.T) {
 	<-done
 	<-done
 }
+
+func TestPlanner_PlanReal2D64(t *testing.T) {
+	t.Parallel()
+
+	planner := NewPlanner(PlanOptions{Normalization: NormOrtho})
+
+	plan, err := planner.PlanReal2D64(8, 6)
+	if err != nil {
+		t.Fatalf("PlanReal2D64(8, 6) failed: %v", err)
+	}
+
+	if plan.Rows() != 8 || plan.Cols() != 6 {
+		t.Errorf("plan dims = %dx%d, want 8x6", plan.Rows(), plan.Cols())
+	}
+
+	// Test the plan works and honors the planner's options
+	src := make([]float64, 8*6)
+	src[0] = 1
+	dst := make([]complex128, plan.SpectrumLen())
+
+	err = plan.Forward(dst, src)
+	if err != nil {
+		t.Fatalf("Forward() failed: %v", err)
+	}
+
+	if want := 1 / math.Sqrt(48); math.Abs(real(dst[0])-want) > 1e-12 {
+		t.Errorf("dst[0] = %v, want %v", dst[0], want)
+	}
+}
+
+func TestPlanner_PlanReal3D64(t *testing.T) {
+	t.Parallel()
+
+	planner := NewPlanner(PlanOptions{})
+
+	plan, err := planner.PlanReal3D64(4, 4, 8)
+	if err != nil {
+		t.Fatalf("PlanReal3D64(4, 4, 8) failed: %v", err)
+	}
+
+	if plan.Depth() != 4 || plan.Height() != 4 || plan.Width() != 8 {
+		t.Errorf("plan dims = %dx%dx%d, want 4x4x8", plan.Depth(), plan.Height(), plan.Width())
+	}
+
+	// Test the plan works
+	src := make([]float64, 4*4*8)
+	src[0] = 1
+	dst := make([]complex128, plan.SpectrumLen())
+
+	err = plan.Forward(dst, src)
+	if err != nil {
+		t.Errorf("Forward() failed: %v", err)
+	}
+}
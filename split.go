@@ -0,0 +1,327 @@
+package algofft
+
+import (
+	"unsafe"
+
+	"github.com/cwbudde/algo-fft/internal/cpu"
+	"github.com/cwbudde/algo-fft/internal/fft"
+)
+
+// Split-complex (planar) layout keeps real and imaginary parts in separate
+// slices: z[i] = re[i] + i*im[i]. Only power-of-two plans have native
+// split-complex kernels, which run directly on those slices with the
+// normalization folded into their first stage. Other sizes interleave into
+// the plan's strided scratch buffer, run the interleaved plan there and
+// deinterleave on the way out, so callers never copy by hand but the two
+// copies remain.
+//
+// Go methods cannot declare their own type parameters, so the split entry
+// points are package functions taking the plan. The float type F must match
+// the plan precision (float32 for complex64, float64 for complex128);
+// otherwise ErrPrecisionMismatch is returned.
+
+// newSplitKernel returns the *fft.SplitKernel[float32|float64] matching T for
+// power-of-two n, resolved for the plan's features, and nil for sizes that
+// use the interleaved fallback.
+func newSplitKernel[T Complex](n int, features cpu.Features) any {
+	var zero T
+	switch any(zero).(type) {
+	case complex64:
+		if kernel := fft.NewSplitKernel[float32](n, features); kernel != nil {
+			return kernel
+		}
+	case complex128:
+		if kernel := fft.NewSplitKernel[float64](n, features); kernel != nil {
+			return kernel
+		}
+	}
+
+	return nil
+}
+
+// ForwardSplit computes the forward FFT of split-complex data:
+// (dstRe, dstIm) = FFT(srcRe + i*srcIm).
+//
+// All four slices must have length p.Len(). dst may alias src for in-place
+// operation. Normalization follows the plan's PlanOptions.Normalization.
+//
+// Returns ErrNilSlice if any slice is nil.
+// Returns ErrLengthMismatch if any slice length differs from Plan.Len().
+// Returns ErrPrecisionMismatch if F does not match the plan precision.
+func ForwardSplit[F Float, C Complex](p *Plan[C], dstRe, dstIm, srcRe, srcIm []F) error {
+	return transformSplit(p, dstRe, dstIm, srcRe, srcIm, false)
+}
+
+// InverseSplit computes the inverse FFT of split-complex data:
+// (dstRe, dstIm) = IFFT(srcRe + i*srcIm).
+//
+// All four slices must have length p.Len(). dst may alias src for in-place
+// operation. Normalization follows the plan's PlanOptions.Normalization
+// (1/N by default).
+func InverseSplit[F Float, C Complex](p *Plan[C], dstRe, dstIm, srcRe, srcIm []F) error {
+	return transformSplit(p, dstRe, dstIm, srcRe, srcIm, true)
+}
+
+// ForwardSplitBatch computes count forward FFTs on sequential split-complex
+// data: transform i reads src[i*n:(i+1)*n] and writes dst[i*n:(i+1)*n] in both
+// the real and imaginary slices.
+//
+// Returns ErrInvalidLength if count < 1 and ErrLengthMismatch if any slice is
+// shorter than count * Plan.Len().
+func ForwardSplitBatch[F Float, C Complex](p *Plan[C], dstRe, dstIm, srcRe, srcIm []F, count int) error {
+	return transformSplitBatch(p, dstRe, dstIm, srcRe, srcIm, count, false)
+}
+
+// InverseSplitBatch computes count inverse FFTs on sequential split-complex
+// data. See ForwardSplitBatch for the layout.
+func InverseSplitBatch[F Float, C Complex](p *Plan[C], dstRe, dstIm, srcRe, srcIm []F, count int) error {
+	return transformSplitBatch(p, dstRe, dstIm, srcRe, srcIm, count, true)
+}
+
+func transformSplitBatch[F Float, C Complex](p *Plan[C], dstRe, dstIm, srcRe, srcIm []F, count int, inverse bool) error {
+	if dstRe == nil || dstIm == nil || srcRe == nil || srcIm == nil {
+		return ErrNilSlice
+	}
+
+	if count < 1 {
+		return ErrInvalidLength
+	}
+
+	required := count * p.n
+	if len(dstRe) < required || len(dstIm) < required || len(srcRe) < required || len(srcIm) < required {
+		return ErrLengthMismatch
+	}
+
+	for i := range count {
+		start := i * p.n
+		end := start + p.n
+
+		err := transformSplit(p, dstRe[start:end], dstIm[start:end], srcRe[start:end], srcIm[start:end], inverse)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func transformSplit[F Float, C Complex](p *Plan[C], dstRe, dstIm, srcRe, srcIm []F, inverse bool) error {
+	if dstRe == nil || dstIm == nil || srcRe == nil || srcIm == nil {
+		return ErrNilSlice
+	}
+
+	if len(dstRe) != p.n || len(dstIm) != p.n || len(srcRe) != p.n || len(srcIm) != p.n {
+		return ErrLengthMismatch
+	}
+
+	if !splitPrecisionMatches[F, C]() {
+		return ErrPrecisionMismatch
+	}
+
+	return transformSplitScaled(p, dstRe, dstIm, srcRe, srcIm, inverse, 1)
+}
+
+// transformSplitScaled runs the split-complex transform of the validated
+// slices, multiplied by scale on top of the plan's normalization. The scale
+// rides on the kernel's first stage, the high-accuracy twiddles or the copy
+// out of scratch.
+func transformSplitScaled[F Float, C Complex](p *Plan[C], dstRe, dstIm, srcRe, srcIm []F, inverse bool, scale float64) error {
+	kernelScale := p.forwardScale * scale
+	if inverse {
+		kernelScale = p.inverseScale * scale
+	}
+
+	if p.high != nil {
+		return transformSplitHigh(p, dstRe, dstIm, srcRe, srcIm, inverse, kernelScale)
+	}
+
+	switch kernel := p.split.(type) {
+	case *fft.SplitKernel[float32]:
+		dRe, dIm := any(dstRe).([]float32), any(dstIm).([]float32)
+		sRe, sIm := any(srcRe).([]float32), any(srcIm).([]float32)
+
+		if inverse {
+			kernel.Inverse(dRe, dIm, sRe, sIm, kernelScale)
+		} else {
+			kernel.Forward(dRe, dIm, sRe, sIm, kernelScale)
+		}
+
+		return nil
+	case *fft.SplitKernel[float64]:
+		dRe, dIm := any(dstRe).([]float64), any(dstIm).([]float64)
+		sRe, sIm := any(srcRe).([]float64), any(srcIm).([]float64)
+
+		if inverse {
+			kernel.Inverse(dRe, dIm, sRe, sIm, kernelScale)
+		} else {
+			kernel.Forward(dRe, dIm, sRe, sIm, kernelScale)
+		}
+
+		return nil
+	}
+
+	// No native kernel for this size: gather into the strided scratch buffer
+	// and run the interleaved plan there.
+	if p.meta.Workspace == WorkspaceExternal {
+		return ErrWorkspaceRequired
+	}
+
+	scratch, stridedScratch, aux, set := p.getScratch()
+	if set != nil {
+		defer p.scratchPool.Put(set)
+	}
+
+	buffer := stridedScratch[:p.n]
+	for i := range buffer {
+		buffer[i] = splitToComplex[C](srcRe[i], srcIm[i])
+	}
+
+	var err error
+	if inverse {
+		err = p.inverse(buffer, buffer, scratch, aux, p.fourStep != nil)
+	} else {
+		err = p.forward(buffer, buffer, scratch, aux, p.fourStep != nil)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	factor := F(scale)
+	for i, v := range buffer {
+		re, im := complexToSplit[F](v)
+		dstRe[i], dstIm[i] = re*factor, im*factor
+	}
+
+	return nil
+}
+
+// transformSplitHigh runs a high-accuracy plan's float64 passes directly on
+// the split arrays, multiplied by scale.
+func transformSplitHigh[F Float, C Complex](p *Plan[C], dstRe, dstIm, srcRe, srcIm []F, inverse bool, scale float64) error {
+	if p.meta.Workspace == WorkspaceExternal {
+		return ErrWorkspaceRequired
+	}
+
+	scratch, _, aux, set := p.getScratch()
+	if set != nil {
+		defer p.scratchPool.Put(set)
+	}
+
+	return highRun(p.high, highSplit[F]{dstRe, dstIm}, highSplit[F]{srcRe, srcIm}, scratch, aux, inverse, scale)
+}
+
+// splitPrecisionMatches reports whether F is the component type of C.
+func splitPrecisionMatches[F Float, C Complex]() bool {
+	var (
+		f F
+		c C
+	)
+
+	switch any(c).(type) {
+	case complex64:
+		_, ok := any(f).(float32)
+		return ok
+	case complex128:
+		_, ok := any(f).(float64)
+		return ok
+	}
+
+	return false
+}
+
+func splitToComplex[C Complex, F Float](re, im F) C {
+	return C(complex(float64(re), float64(im)))
+}
+
+func complexToSplit[F Float, C Complex](v C) (F, F) {
+	c := complex128(v)
+	return F(real(c)), F(imag(c))
+}
+
+// ForwardSplit2D computes the 2D FFT of a row-major split-complex matrix.
+// All four slices must have length p.Len(); dst may alias src.
+//
+// Rows are transformed directly in the caller's slices. Columns are gathered
+// into the plan's column scratch buffer one at a time.
+func ForwardSplit2D[F Float, C Complex](p *Plan2D[C], dstRe, dstIm, srcRe, srcIm []F) error {
+	return transformSplit2D(p, dstRe, dstIm, srcRe, srcIm, false)
+}
+
+// InverseSplit2D computes the 2D IFFT of a row-major split-complex matrix.
+// All four slices must have length p.Len(); dst may alias src.
+func InverseSplit2D[F Float, C Complex](p *Plan2D[C], dstRe, dstIm, srcRe, srcIm []F) error {
+	return transformSplit2D(p, dstRe, dstIm, srcRe, srcIm, true)
+}
+
+func transformSplit2D[F Float, C Complex](p *Plan2D[C], dstRe, dstIm, srcRe, srcIm []F, inverse bool) error {
+	if dstRe == nil || dstIm == nil || srcRe == nil || srcIm == nil {
+		return ErrNilSlice
+	}
+
+	total := p.Len()
+	if len(dstRe) != total || len(dstIm) != total || len(srcRe) != total || len(srcIm) != total {
+		return ErrLengthMismatch
+	}
+
+	if !splitPrecisionMatches[F, C]() {
+		return ErrPrecisionMismatch
+	}
+
+	if p.colScratch == nil {
+		return ErrWorkspaceRequired
+	}
+
+	for row := range p.rows {
+		lo, hi := row*p.cols, (row+1)*p.cols
+
+		err := transformSplitScaled(p.rowPlan, dstRe[lo:hi], dstIm[lo:hi], srcRe[lo:hi], srcIm[lo:hi], inverse, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The plan's normalization rides on the column transforms.
+	scale := p.forwardScale
+	if inverse {
+		scale = p.inverseScale
+	}
+
+	// The column scratch holds p.rows complex values, i.e. 2*p.rows floats.
+	colRe, colIm := splitView[F](p.colScratch)
+
+	for col := range p.cols {
+		for row := range p.rows {
+			colRe[row] = dstRe[row*p.cols+col]
+			colIm[row] = dstIm[row*p.cols+col]
+		}
+
+		err := transformSplitScaled(p.colPlan, colRe, colIm, colRe, colIm, inverse, scale)
+		if err != nil {
+			return err
+		}
+
+		for row := range p.rows {
+			dstRe[row*p.cols+col] = colRe[row]
+			dstIm[row*p.cols+col] = colIm[row]
+		}
+	}
+
+	return nil
+}
+
+// splitView reinterprets a complex buffer of n values as two float slices of
+// length n. The precision of F must match C.
+func splitView[F Float, C Complex](buf []C) ([]F, []F) {
+	n := len(buf)
+
+	var zero C
+	switch any(zero).(type) {
+	case complex64:
+		floats := unsafe.Slice((*float32)(unsafe.Pointer(unsafe.SliceData(buf))), 2*n)
+		return any(floats[:n]).([]F), any(floats[n:]).([]F)
+	default:
+		floats := unsafe.Slice((*float64)(unsafe.Pointer(unsafe.SliceData(buf))), 2*n)
+		return any(floats[:n]).([]F), any(floats[n:]).([]F)
+	}
+}
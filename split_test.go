@@ -0,0 +1,290 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func splitFromComplex128(data []complex128) (re, im []float64) {
+	re = make([]float64, len(data))
+	im = make([]float64, len(data))
+
+	for i, v := range data {
+		re[i], im[i] = real(v), imag(v)
+	}
+
+	return re, im
+}
+
+func assertSplitMatches128(t *testing.T, re, im []float64, want []complex128, tol float64, what string) {
+	t.Helper()
+
+	for i, w := range want {
+		if math.Abs(re[i]-real(w)) > tol || math.Abs(im[i]-imag(w)) > tol {
+			t.Fatalf("%s[%d] = (%v, %v), want %v", what, i, re[i], im[i], w)
+		}
+	}
+}
+
+func TestForwardSplit_MatchesInterleaved(t *testing.T) {
+	t.Parallel()
+
+	// Powers of two use the split kernels, the rest the scratch fallback.
+	for _, n := range []int{1, 2, 4, 8, 16, 64, 1024, 12, 17} {
+		t.Run("Size"+itoa(n), func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := NewPlan64(n)
+			if err != nil {
+				t.Fatalf("NewPlan64(%d) failed: %v", n, err)
+			}
+
+			src := randomComplex128Slice(n, uint64(n)+11)
+			want := make([]complex128, n)
+
+			if err := plan.Forward(want, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			srcRe, srcIm := splitFromComplex128(src)
+			dstRe := make([]float64, n)
+			dstIm := make([]float64, n)
+
+			if err := ForwardSplit(plan, dstRe, dstIm, srcRe, srcIm); err != nil {
+				t.Fatalf("ForwardSplit failed: %v", err)
+			}
+
+			assertSplitMatches128(t, dstRe, dstIm, want, 1e-9*float64(n), "forward")
+
+			// In-place inverse must restore the input.
+			if err := InverseSplit(plan, dstRe, dstIm, dstRe, dstIm); err != nil {
+				t.Fatalf("InverseSplit failed: %v", err)
+			}
+
+			assertSplitMatches128(t, dstRe, dstIm, src, 1e-12*float64(n), "round trip")
+		})
+	}
+}
+
+func TestForwardSplit_Complex64(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{8, 32, 256, 4096, 15} {
+		plan, err := NewPlan32(n)
+		if err != nil {
+			t.Fatalf("NewPlan32(%d) failed: %v", n, err)
+		}
+
+		src := make([]complex64, n)
+		re := make([]float32, n)
+		im := make([]float32, n)
+
+		for i := range src {
+			re[i] = float32(math.Sin(float64(i) * 0.3))
+			im[i] = float32(math.Cos(float64(i) * 0.7))
+			src[i] = complex(re[i], im[i])
+		}
+
+		want := make([]complex64, n)
+		if err := plan.Forward(want, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		if err := ForwardSplit(plan, re, im, re, im); err != nil {
+			t.Fatalf("ForwardSplit failed: %v", err)
+		}
+
+		tol := 1e-5 * math.Sqrt(float64(n)) * math.Log2(float64(n))
+		for i, w := range want {
+			if math.Abs(float64(re[i]-real(w))) > tol || math.Abs(float64(im[i]-imag(w))) > tol {
+				t.Fatalf("n=%d bin[%d] = (%v, %v), want %v", n, i, re[i], im[i], w)
+			}
+		}
+	}
+}
+
+func TestForwardSplit_Normalization(t *testing.T) {
+	t.Parallel()
+
+	// 64 runs the native kernel with the scale in its first stage, 60 the
+	// scratch fallback with the scale in the copy out.
+	for _, n := range []int{64, 60} {
+		for _, mode := range []Normalization{NormBackward, NormForward, NormOrtho, NormNone} {
+			plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Normalization: mode})
+			if err != nil {
+				t.Fatalf("NewPlanWithOptions failed: %v", err)
+			}
+
+			src := randomComplex128Slice(n, 5)
+			want := make([]complex128, n)
+			back := make([]complex128, n)
+
+			if err := plan.Forward(want, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			if err := plan.Inverse(back, want); err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			re, im := splitFromComplex128(src)
+			if err := ForwardSplit(plan, re, im, re, im); err != nil {
+				t.Fatalf("ForwardSplit failed: %v", err)
+			}
+
+			assertSplitMatches128(t, re, im, want, 1e-10, "n="+itoa(n)+" "+mode.String()+" forward")
+
+			if err := InverseSplit(plan, re, im, re, im); err != nil {
+				t.Fatalf("InverseSplit failed: %v", err)
+			}
+
+			assertSplitMatches128(t, re, im, back, 1e-10, "n="+itoa(n)+" "+mode.String()+" round trip")
+		}
+	}
+}
+
+func TestForwardSplit_HighAccuracy(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1024, 60, 8190} {
+		plan, err := NewPlanWithOptions[complex64](n, PlanOptions{Accuracy: AccuracyHigh, Normalization: NormOrtho})
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions failed: %v", err)
+		}
+
+		src := make([]complex64, n)
+		for i, v := range randomComplex128Slice(n, 17) {
+			src[i] = complex64(v)
+		}
+
+		want := make([]complex64, n)
+
+		if err := plan.Forward(want, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		re := make([]float32, n)
+		im := make([]float32, n)
+
+		for i, v := range src {
+			re[i], im[i] = real(v), imag(v)
+		}
+
+		if err := ForwardSplit(plan, re, im, re, im); err != nil {
+			t.Fatalf("ForwardSplit failed: %v", err)
+		}
+
+		// Both paths run the same float64 passes.
+		for k, w := range want {
+			if math.Abs(float64(re[k]-real(w))) > 1e-6 || math.Abs(float64(im[k]-imag(w))) > 1e-6 {
+				t.Fatalf("n=%d bin[%d] = (%v, %v), want %v", n, k, re[k], im[k], w)
+			}
+		}
+	}
+}
+
+func TestForwardSplitBatch(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n     = 32
+		count = 3
+	)
+
+	plan, err := NewPlan64(n)
+	if err != nil {
+		t.Fatalf("NewPlan64 failed: %v", err)
+	}
+
+	src := randomComplex128Slice(n*count, 9)
+	want := make([]complex128, n*count)
+
+	if err := plan.ForwardBatch(want, src, count); err != nil {
+		t.Fatalf("ForwardBatch failed: %v", err)
+	}
+
+	re, im := splitFromComplex128(src)
+	dstRe := make([]float64, n*count)
+	dstIm := make([]float64, n*count)
+
+	if err := ForwardSplitBatch(plan, dstRe, dstIm, re, im, count); err != nil {
+		t.Fatalf("ForwardSplitBatch failed: %v", err)
+	}
+
+	assertSplitMatches128(t, dstRe, dstIm, want, 1e-10, "batch forward")
+
+	if err := InverseSplitBatch(plan, dstRe, dstIm, dstRe, dstIm, count); err != nil {
+		t.Fatalf("InverseSplitBatch failed: %v", err)
+	}
+
+	assertSplitMatches128(t, dstRe, dstIm, src, 1e-12, "batch round trip")
+
+	if err := ForwardSplitBatch(plan, dstRe, dstIm, re, im, 0); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("count=0: got %v, want ErrInvalidLength", err)
+	}
+
+	if err := ForwardSplitBatch(plan, dstRe[:n], dstIm, re, im, count); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short dst: got %v, want ErrLengthMismatch", err)
+	}
+}
+
+func TestForwardSplit2D(t *testing.T) {
+	t.Parallel()
+
+	for _, dims := range [][2]int{{8, 8}, {4, 16}, {6, 10}} {
+		rows, cols := dims[0], dims[1]
+
+		plan, err := NewPlan2DWithOptions[complex128](rows, cols, PlanOptions{Normalization: NormForward})
+		if err != nil {
+			t.Fatalf("NewPlan2DWithOptions failed: %v", err)
+		}
+
+		src := randomComplex128Slice(rows*cols, uint64(rows*cols))
+		want := make([]complex128, rows*cols)
+
+		if err := plan.Forward(want, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		re, im := splitFromComplex128(src)
+		dstRe := make([]float64, rows*cols)
+		dstIm := make([]float64, rows*cols)
+
+		if err := ForwardSplit2D(plan, dstRe, dstIm, re, im); err != nil {
+			t.Fatalf("ForwardSplit2D failed: %v", err)
+		}
+
+		assertSplitMatches128(t, dstRe, dstIm, want, 1e-10, "2D forward")
+
+		if err := InverseSplit2D(plan, dstRe, dstIm, dstRe, dstIm); err != nil {
+			t.Fatalf("InverseSplit2D failed: %v", err)
+		}
+
+		assertSplitMatches128(t, dstRe, dstIm, src, 1e-12, "2D round trip")
+	}
+}
+
+func TestForwardSplit_Errors(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlan32(16)
+	if err != nil {
+		t.Fatalf("NewPlan32 failed: %v", err)
+	}
+
+	f32 := make([]float32, 16)
+	f64 := make([]float64, 16)
+
+	if err := ForwardSplit(plan, f64, f64, f64, f64); !errors.Is(err, ErrPrecisionMismatch) {
+		t.Errorf("float64 with complex64 plan: got %v, want ErrPrecisionMismatch", err)
+	}
+
+	if err := ForwardSplit(plan, nil, f32, f32, f32); !errors.Is(err, ErrNilSlice) {
+		t.Errorf("nil dstRe: got %v, want ErrNilSlice", err)
+	}
+
+	if err := ForwardSplit(plan, f32[:8], f32, f32, f32); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short dstRe: got %v, want ErrLengthMismatch", err)
+	}
+}
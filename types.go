@@ -9,3 +9,8 @@ type Complex = fftypes.Complex
 // Float is a type constraint for floating-point types used in real FFT operations.
 // The canonical definition is in internal/fftypes.
 type Float = fftypes.Float
+
+// Fixed is a type constraint for fixed-point sample types used by PlanFixed:
+// int16 (Q15) and int32 (Q31).
+// The canonical definition is in internal/fftypes.
+type Fixed = fftypes.Fixed
@@ -0,0 +1,563 @@
+package algofft
+
+import (
+	"unsafe"
+
+	mem "github.com/cwbudde/algo-fft/internal/memory"
+)
+
+// Caller-provided workspaces let a plan run without any scratch of its own
+// (PlanOptions.Workspace = WorkspaceExternal) or bypass its scratch pool on a
+// per-call basis. A workspace is a flat slice of at least WorkspaceSize()
+// elements starting on a WorkspaceAlignment boundary; plans carve it into
+// aligned segments for their own buffers and those of their child plans.
+//
+// The WithWorkspace methods never touch sync.Pool and run serially, so
+// concurrent calls on one plan are safe as long as each uses its own
+// workspace.
+
+// WorkspaceAlignment is the byte alignment required of caller-provided
+// workspaces. NewWorkspace returns suitably aligned slices.
+const WorkspaceAlignment = mem.AlignmentBytes
+
+// NewWorkspace allocates a zeroed workspace of n elements aligned to
+// WorkspaceAlignment, e.g. NewWorkspace[complex64](plan.WorkspaceSize()).
+func NewWorkspace[T Complex](n int) []T {
+	if n < 0 {
+		return nil
+	}
+
+	var zero T
+	switch any(zero).(type) {
+	case complex64:
+		work, _ := mem.AllocAlignedComplex64(n)
+		ws, _ := any(work).([]T)
+
+		return ws
+	default:
+		work, _ := mem.AllocAlignedComplex128(n)
+		ws, _ := any(work).([]T)
+
+		return ws
+	}
+}
+
+// workspaceAlign rounds n elements up to a whole number of alignment blocks,
+// so that the next segment carved from a workspace stays aligned.
+func workspaceAlign[T any](n int) int {
+	var zero T
+
+	block := WorkspaceAlignment / int(unsafe.Sizeof(zero))
+
+	return (n + block - 1) / block * block
+}
+
+// checkWorkspace validates a caller-provided workspace against size elements.
+func checkWorkspace[T any](work []T, size int) error {
+	if work == nil {
+		return ErrNilSlice
+	}
+
+	if len(work) < size {
+		return ErrWorkspaceTooSmall
+	}
+
+	if uintptr(unsafe.Pointer(unsafe.SliceData(work)))%WorkspaceAlignment != 0 {
+		return ErrWorkspaceMisaligned
+	}
+
+	return nil
+}
+
+// carveWorkspace splits the first n elements off work and returns them along
+// with the aligned remainder.
+func carveWorkspace[T any](work []T, n int) (seg, rest []T) {
+	return work[:n:n], work[min(workspaceAlign[T](n), len(work)):]
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need. It covers every buffer the kernels use, down to
+// the twiddles and scratch of SIMD codelets run inside the mixed-radix
+// recursion, so transforms with a workspace never allocate.
+func (p *Plan[T]) WorkspaceSize() int {
+	size := workspaceAlign[T](p.scratchLen)
+	if p.high != nil {
+		// complex128 workspace, two elements per value
+		return size + 2*p.high.workspaceSize()
+	}
+
+	switch p.kernelStrategy {
+	case KernelBluestein:
+		size += p.bluesteinPlan.WorkspaceSize()
+	case KernelRader:
+		size += p.raderPlan.WorkspaceSize()
+	}
+
+	return size
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, using work as
+// scratch space instead of the plan's own buffers.
+//
+// work must hold at least WorkspaceSize() elements and start on a
+// WorkspaceAlignment boundary (see NewWorkspace). Its contents are
+// overwritten. The transform runs serially and never touches sync.Pool.
+//
+// Returns ErrNilSlice if dst, src or work is nil.
+// Returns ErrLengthMismatch if slice lengths don't match Plan dimensions.
+// Returns ErrWorkspaceTooSmall or ErrWorkspaceMisaligned for an unusable work.
+func (p *Plan[T]) ForwardWithWorkspace(dst, src, work []T) error {
+	scratch, bsScratch, err := p.workspaceBuffers(dst, src, work)
+	if err != nil {
+		return err
+	}
+
+	return p.forward(dst, src, scratch, bsScratch, false)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See ForwardWithWorkspace for the requirements on work.
+func (p *Plan[T]) InverseWithWorkspace(dst, src, work []T) error {
+	scratch, bsScratch, err := p.workspaceBuffers(dst, src, work)
+	if err != nil {
+		return err
+	}
+
+	return p.inverse(dst, src, scratch, bsScratch, false)
+}
+
+// workspaceBuffers validates the arguments of a WithWorkspace call and
+// carves the kernel scratch and the Bluestein, Rader or high-accuracy child
+// workspace from work.
+func (p *Plan[T]) workspaceBuffers(dst, src, work []T) (scratch, bsScratch []T, err error) {
+	err = p.validateSlices(dst, src)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = checkWorkspace(work, p.WorkspaceSize())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scratch, rest := carveWorkspace(work, p.scratchLen)
+
+	switch p.kernelStrategy {
+	case KernelBluestein:
+		bsScratch = rest[:p.bluesteinPlan.WorkspaceSize()]
+	case KernelRader:
+		bsScratch = rest
+	}
+
+	if p.high != nil {
+		bsScratch = rest
+	}
+
+	return scratch, bsScratch, nil
+}
+
+// transformWith runs a single transform in work, or with the plan's own
+// scratch when work is nil. Composite plans use it for their child plans.
+func (p *Plan[T]) transformWith(dst, src, work []T, inverse bool) error {
+	switch {
+	case work == nil && inverse:
+		return p.Inverse(dst, src)
+	case work == nil:
+		return p.Forward(dst, src)
+	case inverse:
+		return p.InverseWithWorkspace(dst, src, work)
+	default:
+		return p.ForwardWithWorkspace(dst, src, work)
+	}
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the pack buffer plus the inner complex plan's
+// workspace.
+func (p *PlanRealT[F, C]) WorkspaceSize() int {
+	if p.high != nil {
+		// complex128 workspace, two elements per value
+		return workspaceAlign[C](p.bufLen()) + 2*p.high.workspaceSize()
+	}
+
+	return workspaceAlign[C](p.bufLen()) + p.plan.WorkspaceSize()
+}
+
+// bufLen is the length of the pack buffer: N/2 for even N, N for odd N, and
+// the float64 transform's scratch in high-accuracy plans.
+func (p *PlanRealT[F, C]) bufLen() int {
+	if p.high != nil {
+		return p.high.scratchLen()
+	}
+
+	if p.n%2 != 0 {
+		return p.n
+	}
+
+	return p.half
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. See Plan.ForwardWithWorkspace for the requirements on
+// work.
+func (p *PlanRealT[F, C]) ForwardWithWorkspace(dst []C, src []F, work []C) error {
+	buf, rest, err := p.workspaceBuffers(work)
+	if err != nil {
+		return err
+	}
+
+	return p.forwardBatch(dst, src, buf, rest, p.forwardScale)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanRealT[F, C]) InverseWithWorkspace(dst []F, src []C, work []C) error {
+	buf, rest, err := p.workspaceBuffers(work)
+	if err != nil {
+		return err
+	}
+
+	return p.inverseBatch(dst, src, buf, rest)
+}
+
+func (p *PlanRealT[F, C]) workspaceBuffers(work []C) (buf, rest []C, err error) {
+	err = checkWorkspace(work, p.WorkspaceSize())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf, rest = carveWorkspace(work, p.bufLen())
+
+	return buf, rest, nil
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the N/2 pack buffer plus the inner complex
+// plan's workspace.
+func (p *PlanReal) WorkspaceSize() int {
+	return workspaceAlign[complex64](p.half) + p.plan.WorkspaceSize()
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. See Plan.ForwardWithWorkspace for the requirements on
+// work.
+func (p *PlanReal) ForwardWithWorkspace(dst []complex64, src []float32, work []complex64) error {
+	err := checkWorkspace(work, p.WorkspaceSize())
+	if err != nil {
+		return err
+	}
+
+	buf, rest := carveWorkspace(work, p.half)
+
+	return p.forwardBatch(dst, src, buf, rest, p.forwardScale)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal) InverseWithWorkspace(dst []float32, src []complex64, work []complex64) error {
+	err := checkWorkspace(work, p.WorkspaceSize())
+	if err != nil {
+		return err
+	}
+
+	buf, rest := carveWorkspace(work, p.half)
+
+	return p.inverseBatch(dst, src, buf, rest)
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the compact spectrum, a column buffer and the
+// largest 1D plan workspace.
+func (p *PlanReal2DT[F, C]) WorkspaceSize() int {
+	return p.grid.workspaceSize()
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. The passes run serially regardless of Workers. See
+// Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal2DT[F, C]) ForwardWithWorkspace(dst []C, src []F, work []C) error {
+	return p.grid.transformWorkspace(src, dst, work, true)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal2DT[F, C]) InverseWithWorkspace(dst []F, src []C, work []C) error {
+	return p.grid.transformWorkspace(dst, src, work, false)
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the compact spectrum, a column buffer and the
+// largest 1D plan workspace.
+func (p *PlanReal3DT[F, C]) WorkspaceSize() int {
+	return p.grid.workspaceSize()
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. The passes run serially regardless of Workers. See
+// Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal3DT[F, C]) ForwardWithWorkspace(dst []C, src []F, work []C) error {
+	return p.grid.transformWorkspace(src, dst, work, true)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal3DT[F, C]) InverseWithWorkspace(dst []F, src []C, work []C) error {
+	return p.grid.transformWorkspace(dst, src, work, false)
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the compact spectrum, a column buffer and the
+// largest 1D plan workspace.
+func (p *PlanReal2D) WorkspaceSize() int {
+	return p.plan.WorkspaceSize()
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. The passes run serially regardless of Workers. See
+// Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal2D) ForwardWithWorkspace(dst []complex64, src []float32, work []complex64) error {
+	return p.plan.ForwardWithWorkspace(dst, src, work)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal2D) InverseWithWorkspace(dst []float32, src []complex64, work []complex64) error {
+	return p.plan.InverseWithWorkspace(dst, src, work)
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the compact spectrum, a column buffer and the
+// largest 1D plan workspace.
+func (p *PlanReal3D) WorkspaceSize() int {
+	return p.plan.WorkspaceSize()
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. The passes run serially regardless of Workers. See
+// Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal3D) ForwardWithWorkspace(dst []complex64, src []float32, work []complex64) error {
+	return p.plan.ForwardWithWorkspace(dst, src, work)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanReal3D) InverseWithWorkspace(dst []float32, src []complex64, work []complex64) error {
+	return p.plan.InverseWithWorkspace(dst, src, work)
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the working matrix, a column buffer for
+// non-square matrices and the largest 1D plan workspace.
+func (p *Plan2D[T]) WorkspaceSize() int {
+	size := workspaceAlign[T](p.Len())
+	if p.rows != p.cols {
+		size += workspaceAlign[T](p.rows)
+	}
+
+	return size + max(p.rowPlan.WorkspaceSize(), p.colPlan.WorkspaceSize())
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. The passes run serially regardless of Workers. See
+// Plan.ForwardWithWorkspace for the requirements on work.
+func (p *Plan2D[T]) ForwardWithWorkspace(dst, src, work []T) error {
+	return transformBatchWorkspace(p, p.options, dst, src, work, true)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *Plan2D[T]) InverseWithWorkspace(dst, src, work []T) error {
+	return transformBatchWorkspace(p, p.options, dst, src, work, false)
+}
+
+func (p *Plan2D[T]) transformWorkspace(dst, src, work []T, forward bool) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	data, rest := carveWorkspace(work, p.Len())
+
+	var colScratch []T
+	if p.rows != p.cols {
+		colScratch, rest = carveWorkspace(rest, p.rows)
+	}
+
+	l := plan2DLane[T]{
+		rowPlan:    p.rowPlan,
+		colPlan:    p.colPlan,
+		colScratch: colScratch,
+		data:       data,
+		forward:    forward,
+		work:       rest,
+	}
+
+	copy(data, src)
+
+	err = p.transformData(&l)
+	if err != nil {
+		return err
+	}
+
+	p.scaleCopy(dst, data, forward)
+
+	return nil
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the working volume, a line buffer and the
+// largest 1D plan workspace.
+func (p *Plan3D[T]) WorkspaceSize() int {
+	child := max(p.widthPlan.WorkspaceSize(), p.heightPlan.WorkspaceSize(), p.depthPlan.WorkspaceSize())
+
+	return workspaceAlign[T](p.Len()) + workspaceAlign[T](max(p.height, p.depth)) + child
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. The passes run serially regardless of Workers. See
+// Plan.ForwardWithWorkspace for the requirements on work.
+func (p *Plan3D[T]) ForwardWithWorkspace(dst, src, work []T) error {
+	return transformBatchWorkspace(p, p.options, dst, src, work, true)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *Plan3D[T]) InverseWithWorkspace(dst, src, work []T) error {
+	return transformBatchWorkspace(p, p.options, dst, src, work, false)
+}
+
+func (p *Plan3D[T]) transformWorkspace(dst, src, work []T, forward bool) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	data, rest := carveWorkspace(work, p.Len())
+	dimScratch, rest := carveWorkspace(rest, max(p.height, p.depth))
+
+	l := plan3DLane[T]{
+		widthPlan:  p.widthPlan,
+		heightPlan: p.heightPlan,
+		depthPlan:  p.depthPlan,
+		dimScratch: dimScratch,
+		data:       data,
+		forward:    forward,
+		work:       rest,
+	}
+
+	copy(data, src)
+
+	err = p.transformData(&l)
+	if err != nil {
+		return err
+	}
+
+	p.scaleCopy(dst, data, forward)
+
+	return nil
+}
+
+// WorkspaceSize returns the number of elements ForwardWithWorkspace and
+// InverseWithWorkspace need: the working array, a slice buffer and the
+// largest 1D plan workspace.
+func (p *PlanND[T]) WorkspaceSize() int {
+	child := 0
+	for _, plan := range p.plans {
+		child = max(child, plan.WorkspaceSize())
+	}
+
+	return workspaceAlign[T](p.Len()) + workspaceAlign[T](p.maxDim()) + child
+}
+
+// ForwardWithWorkspace computes the same transform as Forward, including the
+// PlanOptions batch/stride loop, using work as scratch space instead of the
+// plan's own buffers. The passes run serially regardless of Workers. See
+// Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanND[T]) ForwardWithWorkspace(dst, src, work []T) error {
+	return transformBatchWorkspace(p, p.options, dst, src, work, true)
+}
+
+// InverseWithWorkspace computes the same transform as Inverse, using work as
+// scratch space. See Plan.ForwardWithWorkspace for the requirements on work.
+func (p *PlanND[T]) InverseWithWorkspace(dst, src, work []T) error {
+	return transformBatchWorkspace(p, p.options, dst, src, work, false)
+}
+
+func (p *PlanND[T]) transformWorkspace(dst, src, work []T, forward bool) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	data, rest := carveWorkspace(work, p.Len())
+	sliceData, rest := carveWorkspace(rest, p.maxDim())
+
+	l := planNDLane[T]{
+		plans:     p.plans,
+		sliceData: sliceData,
+		data:      data,
+		forward:   forward,
+		work:      rest,
+	}
+
+	copy(data, src)
+
+	err = p.transformData(&l)
+	if err != nil {
+		return err
+	}
+
+	p.scaleCopy(dst, data, forward)
+
+	return nil
+}
+
+// workspaceTransformer is a multi-dimensional plan that can run a single
+// transform in a caller-provided workspace.
+type workspaceTransformer[T Complex] interface {
+	Len() int
+	WorkspaceSize() int
+	transformWorkspace(dst, src, work []T, forward bool) error
+}
+
+// transformBatchWorkspace validates work and runs the PlanOptions batch/stride
+// loop of a multi-dimensional plan serially in it.
+func transformBatchWorkspace[T Complex, P workspaceTransformer[T]](p P, opts PlanOptions, dst, src, work []T, forward bool) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	err := checkWorkspace(work, p.WorkspaceSize())
+	if err != nil {
+		return err
+	}
+
+	size := p.Len()
+
+	batch, stride, err := resolveBatchStride(size, opts)
+	if err != nil {
+		return err
+	}
+
+	for b := range batch {
+		off := b * stride
+		if off+size > len(src) || off+size > len(dst) {
+			return ErrLengthMismatch
+		}
+
+		err = p.transformWorkspace(dst[off:off+size], src[off:off+size], work, forward)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,418 @@
+package algofft
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPlanWorkspace_MatchesForward(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 64, 96, 17, 1000} {
+		for _, policy := range []WorkspacePolicy{WorkspaceAuto, WorkspaceExternal} {
+			ref, err := NewPlan64(n)
+			if err != nil {
+				t.Fatalf("NewPlan64(%d) failed: %v", n, err)
+			}
+
+			plan, err := NewPlanWithOptions[complex128](n, PlanOptions{Workspace: policy})
+			if err != nil {
+				t.Fatalf("NewPlanWithOptions(%d) failed: %v", n, err)
+			}
+
+			work := NewWorkspace[complex128](plan.WorkspaceSize())
+			src := randomComplex128Slice(n, uint64(n))
+			want := make([]complex128, n)
+			got := make([]complex128, n)
+
+			if err := ref.Forward(want, src); err != nil {
+				t.Fatalf("Forward failed: %v", err)
+			}
+
+			if err := plan.ForwardWithWorkspace(got, src, work); err != nil {
+				t.Fatalf("n=%d policy=%d: ForwardWithWorkspace failed: %v", n, policy, err)
+			}
+
+			assertEqualComplex128(t, got, want, "forward")
+
+			if err := ref.Inverse(want, want); err != nil {
+				t.Fatalf("Inverse failed: %v", err)
+			}
+
+			if err := plan.InverseWithWorkspace(got, got, work); err != nil {
+				t.Fatalf("n=%d policy=%d: InverseWithWorkspace failed: %v", n, policy, err)
+			}
+
+			assertEqualComplex128(t, got, want, "inverse")
+		}
+	}
+}
+
+func TestPlanWorkspace_Validation(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanWithOptions[complex64](256, PlanOptions{Workspace: WorkspaceExternal})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	data := make([]complex64, 256)
+	work := NewWorkspace[complex64](plan.WorkspaceSize() + 1)
+
+	tests := []struct {
+		name string
+		work []complex64
+		want error
+	}{
+		{"nil", nil, ErrNilSlice},
+		{"short", work[:plan.WorkspaceSize()-1], ErrWorkspaceTooSmall},
+		{"misaligned", work[1:], ErrWorkspaceMisaligned},
+	}
+
+	for _, tt := range tests {
+		err := plan.ForwardWithWorkspace(data, data, tt.work)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: ForwardWithWorkspace error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	if err := plan.ForwardWithWorkspace(data[:10], data, work); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("short dst: error = %v, want %v", err, ErrLengthMismatch)
+	}
+}
+
+func TestWorkspaceExternal_RequiresWorkspace(t *testing.T) {
+	t.Parallel()
+
+	opts := PlanOptions{Workspace: WorkspaceExternal, Workers: 4}
+
+	plan, err := NewPlanWithOptions[complex64](64, opts)
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions failed: %v", err)
+	}
+
+	if meta := plan.Meta(); meta.Workspace != WorkspaceExternal || meta.Workers != 0 {
+		t.Fatalf("Meta() = %+v, want external workspace and no workers", meta)
+	}
+
+	data := make([]complex64, 64)
+	if err := plan.Forward(data, data); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("Plan.Forward error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+
+	if err := plan.Clone().Inverse(data, data); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("cloned Plan.Inverse error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+
+	if err := plan.ForwardStrided(data, data, 1); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("Plan.ForwardStrided error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+
+	realPlan, err := NewPlanRealTWithOptions[float32, complex64](64, opts)
+	if err != nil {
+		t.Fatalf("NewPlanRealTWithOptions failed: %v", err)
+	}
+
+	if err := realPlan.Forward(make([]complex64, 33), make([]float32, 64)); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("PlanRealT.Forward error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+
+	plan2D, err := NewPlan2DWithOptions[complex64](8, 8, opts)
+	if err != nil {
+		t.Fatalf("NewPlan2DWithOptions failed: %v", err)
+	}
+
+	if err := plan2D.Clone().Forward(data, data); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("Plan2D.Forward error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+
+	plan3D, err := NewPlan3DWithOptions[complex64](4, 4, 4, opts)
+	if err != nil {
+		t.Fatalf("NewPlan3DWithOptions failed: %v", err)
+	}
+
+	if err := plan3D.Forward(data, data); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("Plan3D.Forward error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+
+	planND, err := NewPlanNDWithOptions[complex64]([]int{2, 4, 8}, opts)
+	if err != nil {
+		t.Fatalf("NewPlanNDWithOptions failed: %v", err)
+	}
+
+	if err := planND.Inverse(data, data); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("PlanND.Inverse error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+}
+
+func TestPlanRealWorkspace_MatchesForward(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{64, 45, 34} {
+		ref, err := NewPlanRealT[float64, complex128](n)
+		if err != nil {
+			t.Fatalf("NewPlanRealT(%d) failed: %v", n, err)
+		}
+
+		plan, err := NewPlanRealTWithOptions[float64, complex128](n, PlanOptions{Workspace: WorkspaceExternal})
+		if err != nil {
+			t.Fatalf("NewPlanRealTWithOptions(%d) failed: %v", n, err)
+		}
+
+		work := NewWorkspace[complex128](plan.WorkspaceSize())
+
+		src := make([]float64, n)
+		for i, v := range randomComplex128Slice(n, uint64(n)) {
+			src[i] = real(v)
+		}
+
+		want := make([]complex128, plan.SpectrumLen())
+		got := make([]complex128, plan.SpectrumLen())
+
+		if err := ref.Forward(want, src); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		if err := plan.ForwardWithWorkspace(got, src, work); err != nil {
+			t.Fatalf("n=%d: ForwardWithWorkspace failed: %v", n, err)
+		}
+
+		assertEqualComplex128(t, got, want, "spectrum")
+
+		wantOut := make([]float64, n)
+		gotOut := make([]float64, n)
+
+		if err := ref.Inverse(wantOut, want); err != nil {
+			t.Fatalf("Inverse failed: %v", err)
+		}
+
+		if err := plan.InverseWithWorkspace(gotOut, got, work); err != nil {
+			t.Fatalf("n=%d: InverseWithWorkspace failed: %v", n, err)
+		}
+
+		for i := range wantOut {
+			if gotOut[i] != wantOut[i] {
+				t.Fatalf("n=%d: inverse[%d] = %v, want %v", n, i, gotOut[i], wantOut[i])
+			}
+		}
+	}
+}
+
+func TestPlanReal32Workspace_MatchesForward(t *testing.T) {
+	t.Parallel()
+
+	const n = 96
+
+	ref, err := NewPlanReal(n)
+	if err != nil {
+		t.Fatalf("NewPlanReal failed: %v", err)
+	}
+
+	opts := PlanOptions{Workspace: WorkspaceExternal, Batch: 2}
+
+	plan, err := NewPlanRealWithOptions(n, opts)
+	if err != nil {
+		t.Fatalf("NewPlanRealWithOptions failed: %v", err)
+	}
+
+	src := randomFloat32Slice(2*n, 5)
+	got := make([]complex64, 2*plan.SpectrumLen())
+
+	if err := plan.Forward(got, src); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Fatalf("Forward error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+
+	work := NewWorkspace[complex64](plan.WorkspaceSize())
+	if err := plan.ForwardWithWorkspace(got, src, work); err != nil {
+		t.Fatalf("ForwardWithWorkspace failed: %v", err)
+	}
+
+	for b := range 2 {
+		want := make([]complex64, ref.SpectrumLen())
+		if err := ref.Forward(want, src[b*n:(b+1)*n]); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		assertScaledComplex64(t, got[b*ref.SpectrumLen():], want, 1, 0, "spectrum")
+	}
+
+	back := make([]float32, 2*n)
+	if err := plan.InverseWithWorkspace(back, got, work); err != nil {
+		t.Fatalf("InverseWithWorkspace failed: %v", err)
+	}
+
+	assertScaledFloat32(t, back, src, 1, 1e-5, "round trip")
+
+	if err := plan.InverseWithWorkspace(back, got, work[:1]); !errors.Is(err, ErrWorkspaceTooSmall) {
+		t.Errorf("short workspace error = %v, want %v", err, ErrWorkspaceTooSmall)
+	}
+
+}
+
+func TestRealGridWorkspace_MatchesForward(t *testing.T) {
+	t.Parallel()
+
+	opts := PlanOptions{Workspace: WorkspaceExternal, Batch: 2}
+
+	ref2D, _ := NewPlanReal2D64WithOptions(6, 9, PlanOptions{Batch: 2})
+	ext2D, _ := NewPlanReal2D64WithOptions(6, 9, opts)
+	ref3D, _ := NewPlanReal3D64WithOptions(3, 4, 6, PlanOptions{Batch: 2})
+	ext3D, _ := NewPlanReal3D64WithOptions(3, 4, 6, opts)
+
+	tests := []struct {
+		name          string
+		size, specLen int
+		forward       func(dst []complex128, src []float64) error
+		inverse       func(dst []float64, src []complex128) error
+		forwardW      func(dst []complex128, src []float64, work []complex128) error
+		inverseW      func(dst []float64, src []complex128, work []complex128) error
+		work          int
+	}{
+		{"2D", 54, ref2D.SpectrumLen(), ref2D.Forward, ref2D.Inverse, ext2D.ForwardWithWorkspace, ext2D.InverseWithWorkspace, ext2D.WorkspaceSize()},
+		{"3D", 72, ref3D.SpectrumLen(), ref3D.Forward, ref3D.Inverse, ext3D.ForwardWithWorkspace, ext3D.InverseWithWorkspace, ext3D.WorkspaceSize()},
+	}
+
+	for _, tt := range tests {
+		work := NewWorkspace[complex128](tt.work)
+		src := randomFloat64Slice(2*tt.size, uint64(tt.size))
+		want := make([]complex128, 2*tt.specLen)
+		got := make([]complex128, 2*tt.specLen)
+
+		if err := tt.forward(want, src); err != nil {
+			t.Fatalf("%s: Forward failed: %v", tt.name, err)
+		}
+
+		if err := tt.forwardW(got, src, work); err != nil {
+			t.Fatalf("%s: ForwardWithWorkspace failed: %v", tt.name, err)
+		}
+
+		assertEqualComplex128(t, got, want, tt.name+" forward")
+
+		wantOut := make([]float64, len(src))
+		gotOut := make([]float64, len(src))
+
+		if err := tt.inverse(wantOut, want); err != nil {
+			t.Fatalf("%s: Inverse failed: %v", tt.name, err)
+		}
+
+		if err := tt.inverseW(gotOut, got, work); err != nil {
+			t.Fatalf("%s: InverseWithWorkspace failed: %v", tt.name, err)
+		}
+
+		for i := range wantOut {
+			if gotOut[i] != wantOut[i] {
+				t.Fatalf("%s: inverse[%d] = %v, want %v", tt.name, i, gotOut[i], wantOut[i])
+			}
+		}
+
+		if err := tt.forwardW(got, src, work[:tt.work-1]); !errors.Is(err, ErrWorkspaceTooSmall) {
+			t.Errorf("%s: short workspace error = %v, want %v", tt.name, err, ErrWorkspaceTooSmall)
+		}
+	}
+
+	// The float32 wrappers run the same grid.
+	plan2D, err := NewPlanReal2DWithOptions(4, 8, opts)
+	if err != nil {
+		t.Fatalf("NewPlanReal2DWithOptions failed: %v", err)
+	}
+
+	src := randomFloat32Slice(2*plan2D.Len(), 7)
+	spec := make([]complex64, 2*plan2D.SpectrumLen())
+
+	if err := plan2D.Forward(spec, src); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("PlanReal2D.Forward error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+
+	work := NewWorkspace[complex64](plan2D.WorkspaceSize())
+	back := make([]float32, len(src))
+
+	if err := plan2D.ForwardWithWorkspace(spec, src, work); err != nil {
+		t.Fatalf("PlanReal2D.ForwardWithWorkspace failed: %v", err)
+	}
+
+	if err := plan2D.InverseWithWorkspace(back, spec, work); err != nil {
+		t.Fatalf("PlanReal2D.InverseWithWorkspace failed: %v", err)
+	}
+
+	assertScaledFloat32(t, back, src, 1, 1e-5, "PlanReal2D round trip")
+
+	plan3D, err := NewPlanReal3DWithOptions(2, 4, 8, opts)
+	if err != nil {
+		t.Fatalf("NewPlanReal3DWithOptions failed: %v", err)
+	}
+
+	if err := plan3D.Clone().ForwardFull(make([]complex64, 64), make([]float32, 64)); !errors.Is(err, ErrWorkspaceRequired) {
+		t.Errorf("PlanReal3D.ForwardFull error = %v, want %v", err, ErrWorkspaceRequired)
+	}
+}
+
+func TestMultiDimWorkspace_MatchesForward(t *testing.T) {
+	t.Parallel()
+
+	opts := PlanOptions{Workspace: WorkspaceExternal, Batch: 2}
+
+	ref2D, _ := NewPlan2DWithOptions[complex128](6, 10, PlanOptions{Batch: 2})
+	ext2D, _ := NewPlan2DWithOptions[complex128](6, 10, opts)
+	sq2D, _ := NewPlan2DWithOptions[complex128](8, 8, PlanOptions{Batch: 2})
+	sqExt2D, _ := NewPlan2DWithOptions[complex128](8, 8, opts)
+	ref3D, _ := NewPlan3DWithOptions[complex128](3, 4, 5, PlanOptions{Batch: 2})
+	ext3D, _ := NewPlan3DWithOptions[complex128](3, 4, 5, opts)
+	refND, _ := NewPlanNDWithOptions[complex128]([]int{2, 3, 4, 5}, PlanOptions{Batch: 2})
+	extND, _ := NewPlanNDWithOptions[complex128]([]int{2, 3, 4, 5}, opts)
+
+	tests := []struct {
+		name     string
+		size     int
+		forward  func(dst, src []complex128) error
+		inverse  func(dst, src []complex128) error
+		forwardW func(dst, src, work []complex128) error
+		inverseW func(dst, src, work []complex128) error
+		work     int
+	}{
+		{"2D", 60, ref2D.Forward, ref2D.Inverse, ext2D.ForwardWithWorkspace, ext2D.InverseWithWorkspace, ext2D.WorkspaceSize()},
+		{"2D square", 64, sq2D.Forward, sq2D.Inverse, sqExt2D.ForwardWithWorkspace, sqExt2D.InverseWithWorkspace, sqExt2D.WorkspaceSize()},
+		{"3D", 60, ref3D.Forward, ref3D.Inverse, ext3D.ForwardWithWorkspace, ext3D.InverseWithWorkspace, ext3D.WorkspaceSize()},
+		{"ND", 120, refND.Forward, refND.Inverse, extND.ForwardWithWorkspace, extND.InverseWithWorkspace, extND.WorkspaceSize()},
+	}
+
+	for _, tt := range tests {
+		work := NewWorkspace[complex128](tt.work)
+		src := randomComplex128Slice(2*tt.size, uint64(tt.size))
+		want := make([]complex128, len(src))
+		got := make([]complex128, len(src))
+
+		if err := tt.forward(want, src); err != nil {
+			t.Fatalf("%s: Forward failed: %v", tt.name, err)
+		}
+
+		if err := tt.forwardW(got, src, work); err != nil {
+			t.Fatalf("%s: ForwardWithWorkspace failed: %v", tt.name, err)
+		}
+
+		assertEqualComplex128(t, got, want, tt.name+" forward")
+
+		if err := tt.inverse(want, want); err != nil {
+			t.Fatalf("%s: Inverse failed: %v", tt.name, err)
+		}
+
+		if err := tt.inverseW(got, got, work); err != nil {
+			t.Fatalf("%s: InverseWithWorkspace failed: %v", tt.name, err)
+		}
+
+		assertEqualComplex128(t, got, want, tt.name+" inverse")
+
+		if err := tt.forwardW(got, src, work[:tt.work-1]); !errors.Is(err, ErrWorkspaceTooSmall) {
+			t.Errorf("%s: short workspace error = %v, want %v", tt.name, err, ErrWorkspaceTooSmall)
+		}
+	}
+}
+
+func assertEqualComplex128(t *testing.T, got, want []complex128, what string) {
+	t.Helper()
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s[%d] = %v, want %v", what, i, got[i], want[i])
+		}
+	}
+}